@@ -188,6 +188,10 @@ func TestDefaultExtensions(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			extension:     "redis_storage",
+			skipLifecycle: true, // Requires a running Redis server in order to complete life cycle testing
+		},
 		{
 			extension: "host_observer",
 			getConfigFn: func() component.Config {
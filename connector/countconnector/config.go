@@ -30,6 +30,16 @@ const (
 	defaultMetricDescLogs = "The number of log records observed."
 )
 
+// The types of the metrics.
+const (
+	metricTypeCount     = "count"
+	metricTypeSum       = "sum"
+	metricTypeHistogram = "histogram"
+)
+
+// defaultHistogramBuckets are the explicit bucket boundaries of the histograms with no buckets configured.
+var defaultHistogramBuckets = []float64{0, 5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 7500, 10000}
+
 // Config for the connector
 type Config struct {
 	Spans      map[string]MetricInfo `mapstructure:"spans"`
@@ -44,6 +54,13 @@ type MetricInfo struct {
 	Description string            `mapstructure:"description"`
 	Conditions  []string          `mapstructure:"conditions"`
 	Attributes  []AttributeConfig `mapstructure:"attributes"`
+	// Type is the type of the metric, one of count (the default), sum or histogram.
+	Type string `mapstructure:"type"`
+	// SourceAttribute is the numeric attribute summed, or recorded by the histogram. The telemetry
+	// without the attribute, or with a value that isn't a number, is ignored.
+	SourceAttribute string `mapstructure:"source_attribute"`
+	// Buckets are the explicit bucket boundaries of the histogram.
+	Buckets []float64 `mapstructure:"buckets"`
 }
 
 type AttributeConfig struct {
@@ -62,6 +79,9 @@ func (c *Config) Validate() error {
 		if err := info.validateAttributes(); err != nil {
 			return fmt.Errorf("spans attributes: metric %q: %w", name, err)
 		}
+		if err := info.validateType(); err != nil {
+			return fmt.Errorf("spans type: metric %q: %w", name, err)
+		}
 	}
 	for name, info := range c.SpanEvents {
 		if name == "" {
@@ -73,6 +93,9 @@ func (c *Config) Validate() error {
 		if err := info.validateAttributes(); err != nil {
 			return fmt.Errorf("spanevents attributes: metric %q: %w", name, err)
 		}
+		if err := info.validateType(); err != nil {
+			return fmt.Errorf("spanevents type: metric %q: %w", name, err)
+		}
 	}
 	for name, info := range c.Metrics {
 		if name == "" {
@@ -84,6 +107,9 @@ func (c *Config) Validate() error {
 		if len(info.Attributes) > 0 {
 			return fmt.Errorf("metrics attributes not supported: metric %q", name)
 		}
+		if info.Type != "" && info.Type != metricTypeCount {
+			return fmt.Errorf("metrics type not supported: metric %q", name)
+		}
 	}
 
 	for name, info := range c.DataPoints {
@@ -96,6 +122,9 @@ func (c *Config) Validate() error {
 		if err := info.validateAttributes(); err != nil {
 			return fmt.Errorf("spans attributes: metric %q: %w", name, err)
 		}
+		if err := info.validateType(); err != nil {
+			return fmt.Errorf("datapoints type: metric %q: %w", name, err)
+		}
 	}
 	for name, info := range c.Logs {
 		if name == "" {
@@ -107,6 +136,9 @@ func (c *Config) Validate() error {
 		if err := info.validateAttributes(); err != nil {
 			return fmt.Errorf("logs attributes: metric %q: %w", name, err)
 		}
+		if err := info.validateType(); err != nil {
+			return fmt.Errorf("logs type: metric %q: %w", name, err)
+		}
 	}
 	return nil
 }
@@ -120,6 +152,59 @@ func (i *MetricInfo) validateAttributes() error {
 	return nil
 }
 
+func (i *MetricInfo) validateType() error {
+	switch i.Type {
+	case "", metricTypeCount:
+		if i.SourceAttribute != "" {
+			return fmt.Errorf("source_attribute requires a %s or %s type", metricTypeSum, metricTypeHistogram)
+		}
+	case metricTypeSum, metricTypeHistogram:
+		if i.SourceAttribute == "" {
+			return fmt.Errorf("source_attribute missing for the %s type", i.Type)
+		}
+	default:
+		return fmt.Errorf("unsupported type %q, expected one of %s, %s or %s", i.Type, metricTypeCount, metricTypeSum, metricTypeHistogram)
+	}
+	if len(i.Buckets) > 0 {
+		if i.Type != metricTypeHistogram {
+			return fmt.Errorf("buckets require the %s type", metricTypeHistogram)
+		}
+		for j := 1; j < len(i.Buckets); j++ {
+			if i.Buckets[j] <= i.Buckets[j-1] {
+				return fmt.Errorf("buckets must be sorted in increasing order")
+			}
+		}
+	}
+	return nil
+}
+
+// metricDescription returns the description of the metric. The sums and histograms with no
+// description get one describing their values, items naming the telemetry they observe.
+func (i *MetricInfo) metricDescription(items string) string {
+	if i.Description != "" {
+		return i.Description
+	}
+	switch i.Type {
+	case metricTypeSum:
+		return fmt.Sprintf("The sum of the %s attribute of the %s observed.", i.SourceAttribute, items)
+	case metricTypeHistogram:
+		return fmt.Sprintf("The distribution of the %s attribute of the %s observed.", i.SourceAttribute, items)
+	default:
+		return i.Description
+	}
+}
+
+// histogramBuckets returns the bucket boundaries of the histogram metrics.
+func (i *MetricInfo) histogramBuckets() []float64 {
+	if i.Type != metricTypeHistogram {
+		return nil
+	}
+	if len(i.Buckets) == 0 {
+		return defaultHistogramBuckets
+	}
+	return i.Buckets
+}
+
 var _ confmap.Unmarshaler = (*Config)(nil)
 
 // Unmarshal with custom logic to set default values.
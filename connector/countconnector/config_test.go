@@ -323,6 +323,40 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "values",
+			expect: &Config{
+				Spans: map[string]MetricInfo{
+					"http.server.duration": {
+						Description:     "The duration of the server spans.",
+						Type:            metricTypeHistogram,
+						SourceAttribute: "duration_ms",
+						Buckets:         []float64{10, 100, 1000},
+					},
+				},
+				SpanEvents: defaultSpanEventsConfig(),
+				Metrics:    defaultMetricsConfig(),
+				DataPoints: defaultDataPointsConfig(),
+				Logs: map[string]MetricInfo{
+					"http.request.duration.sum": {
+						Description: "The total duration of the requests.",
+						Conditions:  []string{`attributes["http.route"] != nil`},
+						Attributes: []AttributeConfig{
+							{
+								Key: "http.route",
+							},
+						},
+						Type:            metricTypeSum,
+						SourceAttribute: "duration_ms",
+					},
+					"http.request.duration": {
+						Description:     "The duration of the requests.",
+						Type:            metricTypeHistogram,
+						SourceAttribute: "duration_ms",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -463,6 +497,76 @@ func TestConfigErrors(t *testing.T) {
 			},
 			expect: fmt.Sprintf("logs condition: metric %q: unable to parse OTTL statement", defaultMetricNameLogs),
 		},
+		{
+			name: "invalid_type",
+			input: &Config{
+				Logs: map[string]MetricInfo{
+					defaultMetricNameLogs: {
+						Type: "gauge",
+					},
+				},
+			},
+			expect: fmt.Sprintf(`logs type: metric %q: unsupported type "gauge", expected one of count, sum or histogram`, defaultMetricNameLogs),
+		},
+		{
+			name: "missing_source_attribute",
+			input: &Config{
+				Spans: map[string]MetricInfo{
+					defaultMetricNameSpans: {
+						Type: metricTypeSum,
+					},
+				},
+			},
+			expect: fmt.Sprintf("spans type: metric %q: source_attribute missing for the sum type", defaultMetricNameSpans),
+		},
+		{
+			name: "source_attribute_count",
+			input: &Config{
+				SpanEvents: map[string]MetricInfo{
+					defaultMetricNameSpanEvents: {
+						SourceAttribute: "duration",
+					},
+				},
+			},
+			expect: fmt.Sprintf("spanevents type: metric %q: source_attribute requires a sum or histogram type", defaultMetricNameSpanEvents),
+		},
+		{
+			name: "buckets_sum",
+			input: &Config{
+				DataPoints: map[string]MetricInfo{
+					defaultMetricNameDataPoints: {
+						Type:            metricTypeSum,
+						SourceAttribute: "duration",
+						Buckets:         []float64{1, 2},
+					},
+				},
+			},
+			expect: fmt.Sprintf("datapoints type: metric %q: buckets require the histogram type", defaultMetricNameDataPoints),
+		},
+		{
+			name: "unsorted_buckets",
+			input: &Config{
+				Logs: map[string]MetricInfo{
+					defaultMetricNameLogs: {
+						Type:            metricTypeHistogram,
+						SourceAttribute: "duration",
+						Buckets:         []float64{1, 10, 10},
+					},
+				},
+			},
+			expect: fmt.Sprintf("logs type: metric %q: buckets must be sorted in increasing order", defaultMetricNameLogs),
+		},
+		{
+			name: "metrics_type",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					defaultMetricNameMetrics: {
+						Type: metricTypeSum,
+					},
+				},
+			},
+			expect: fmt.Sprintf("metrics type not supported: metric %q", defaultMetricNameMetrics),
+		},
 	}
 
 	for _, tc := range testCases {
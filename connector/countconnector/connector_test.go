@@ -13,6 +13,8 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/connector/connectortest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -658,3 +660,89 @@ func TestLogsToMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestLogsToMetricsValues(t *testing.T) {
+	cfg := &Config{
+		Logs: map[string]MetricInfo{
+			"http.request.duration.sum": {
+				Description: "The total duration of the requests.",
+				Conditions:  []string{`attributes["http.route"] != nil`},
+				Attributes: []AttributeConfig{
+					{
+						Key: "http.route",
+					},
+				},
+				Type:            metricTypeSum,
+				SourceAttribute: "duration_ms",
+			},
+			"http.request.duration": {
+				Type:            metricTypeHistogram,
+				SourceAttribute: "duration_ms",
+				Buckets:         []float64{10, 100},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	sink := &consumertest.MetricsSink{}
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(),
+		connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for _, attrs := range []map[string]any{
+		{"http.route": "/cart", "duration_ms": int64(5)},
+		{"http.route": "/cart", "duration_ms": 50.5},
+		{"http.route": "/pay", "duration_ms": "200"},
+		// no route: only part of the histogram
+		{"duration_ms": int64(10)},
+		// not numeric: ignored
+		{"http.route": "/cart", "duration_ms": "slow"},
+		{"http.route": "/cart"},
+	} {
+		require.NoError(t, records.AppendEmpty().Attributes().FromRaw(attrs))
+	}
+	require.NoError(t, conn.ConsumeLogs(context.Background(), logs))
+
+	require.Equal(t, 1, len(sink.AllMetrics()))
+	rms := sink.AllMetrics()[0].ResourceMetrics()
+	require.Equal(t, 1, rms.Len())
+	assert.Equal(t, map[string]any{"service.name": "checkout"}, rms.At(0).Resource().Attributes().AsRaw())
+
+	metrics := rms.At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		switch metric.Name() {
+		case "http.request.duration.sum":
+			assert.Equal(t, "The total duration of the requests.", metric.Description())
+			require.Equal(t, pmetric.MetricTypeSum, metric.Type())
+			assert.False(t, metric.Sum().IsMonotonic())
+			sums := map[string]float64{}
+			for j := 0; j < metric.Sum().DataPoints().Len(); j++ {
+				dp := metric.Sum().DataPoints().At(j)
+				route, _ := dp.Attributes().Get("http.route")
+				sums[route.Str()] = dp.DoubleValue()
+			}
+			assert.Equal(t, map[string]float64{"/cart": 55.5, "/pay": 200}, sums)
+		case "http.request.duration":
+			assert.Equal(t, "The distribution of the duration_ms attribute of the log records observed.", metric.Description())
+			require.Equal(t, pmetric.MetricTypeHistogram, metric.Type())
+			assert.Equal(t, pmetric.AggregationTemporalityDelta, metric.Histogram().AggregationTemporality())
+			require.Equal(t, 1, metric.Histogram().DataPoints().Len())
+			dp := metric.Histogram().DataPoints().At(0)
+			assert.Equal(t, 0, dp.Attributes().Len())
+			assert.Equal(t, uint64(4), dp.Count())
+			assert.Equal(t, 265.5, dp.Sum())
+			assert.Equal(t, float64(5), dp.Min())
+			assert.Equal(t, float64(200), dp.Max())
+			assert.Equal(t, []float64{10, 100}, dp.ExplicitBounds().AsRaw())
+			assert.Equal(t, []uint64{2, 1, 1}, dp.BucketCounts().AsRaw())
+		default:
+			t.Fatalf("unexpected metric %q", metric.Name())
+		}
+	}
+}
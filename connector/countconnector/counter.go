@@ -5,6 +5,8 @@ package countconnector // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"sort"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -33,6 +35,11 @@ type counter[K any] struct {
 type attrCounter struct {
 	attrs pcommon.Map
 	count uint64
+	// sum, min, max and bucketCounts aggregate the values of the sum and histogram metrics.
+	sum          float64
+	min          float64
+	max          float64
+	bucketCounts []uint64
 }
 
 func (c *counter[K]) update(ctx context.Context, attrs pcommon.Map, tCtx K) error {
@@ -52,22 +59,49 @@ func (c *counter[K]) update(ctx context.Context, attrs pcommon.Map, tCtx K) erro
 			continue
 		}
 
+		var value float64
+		if md.sourceAttr != "" {
+			var ok bool
+			if value, ok = numericValue(attrs, md.sourceAttr); !ok {
+				continue
+			}
+		}
+
 		// No conditions, so match all.
 		if md.condition == nil {
-			errors = multierr.Append(errors, c.increment(name, countAttrs))
+			errors = multierr.Append(errors, c.increment(name, countAttrs, value))
 			continue
 		}
 
 		if match, err := md.condition.Eval(ctx, tCtx); err != nil {
 			errors = multierr.Append(errors, err)
 		} else if match {
-			errors = multierr.Append(errors, c.increment(name, countAttrs))
+			errors = multierr.Append(errors, c.increment(name, countAttrs, value))
 		}
 	}
 	return errors
 }
 
-func (c *counter[K]) increment(metricName string, attrs pcommon.Map) error {
+// numericValue returns the value of the attribute if it is a number, or a string holding a number.
+func numericValue(attrs pcommon.Map, key string) (float64, bool) {
+	attrVal, ok := attrs.Get(key)
+	if !ok {
+		return 0, false
+	}
+	switch attrVal.Type() {
+	case pcommon.ValueTypeInt:
+		return float64(attrVal.Int()), true
+	case pcommon.ValueTypeDouble:
+		return attrVal.Double(), true
+	case pcommon.ValueTypeStr:
+		value, err := strconv.ParseFloat(attrVal.Str(), 64)
+		return value, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func (c *counter[K]) increment(metricName string, attrs pcommon.Map, value float64) error {
 	if _, ok := c.counts[metricName]; !ok {
 		c.counts[metricName] = make(map[[16]byte]*attrCounter)
 	}
@@ -77,11 +111,27 @@ func (c *counter[K]) increment(metricName string, attrs pcommon.Map) error {
 		key = pdatautil.MapHash(attrs)
 	}
 
-	if _, ok := c.counts[metricName][key]; !ok {
-		c.counts[metricName][key] = &attrCounter{attrs: attrs}
+	ac, ok := c.counts[metricName][key]
+	if !ok {
+		ac = &attrCounter{attrs: attrs, min: value, max: value}
+		if buckets := c.metricDefs[metricName].buckets; len(buckets) > 0 {
+			ac.bucketCounts = make([]uint64, len(buckets)+1)
+		}
+		c.counts[metricName][key] = ac
 	}
 
-	c.counts[metricName][key].count++
+	ac.count++
+	ac.sum += value
+	if value < ac.min {
+		ac.min = value
+	}
+	if value > ac.max {
+		ac.max = value
+	}
+	if ac.bucketCounts != nil {
+		// The bucket i counts the values in (buckets[i-1], buckets[i]]
+		ac.bucketCounts[sort.SearchFloat64s(c.metricDefs[metricName].buckets, value)]++
+	}
 	return nil
 }
 
@@ -93,16 +143,56 @@ func (c *counter[K]) appendMetricsTo(metricSlice pmetric.MetricSlice) {
 		countMetric := metricSlice.AppendEmpty()
 		countMetric.SetName(name)
 		countMetric.SetDescription(md.desc)
-		sum := countMetric.SetEmptySum()
-		// The delta value is always positive, so a value accumulated downstream is monotonic
-		sum.SetIsMonotonic(true)
-		sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
-		for _, dpCount := range c.counts[name] {
-			dp := sum.DataPoints().AppendEmpty()
-			dpCount.attrs.CopyTo(dp.Attributes())
-			dp.SetIntValue(int64(dpCount.count))
-			// TODO determine appropriate start time
-			dp.SetTimestamp(pcommon.NewTimestampFromTime(c.timestamp))
+		switch md.metricType {
+		case metricTypeSum:
+			c.appendSumTo(countMetric, name)
+		case metricTypeHistogram:
+			c.appendHistogramTo(countMetric, name, md.buckets)
+		default:
+			c.appendCountTo(countMetric, name)
 		}
 	}
 }
+
+func (c *counter[K]) appendCountTo(countMetric pmetric.Metric, name string) {
+	sum := countMetric.SetEmptySum()
+	// The delta value is always positive, so a value accumulated downstream is monotonic
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for _, dpCount := range c.counts[name] {
+		dp := sum.DataPoints().AppendEmpty()
+		dpCount.attrs.CopyTo(dp.Attributes())
+		dp.SetIntValue(int64(dpCount.count))
+		// TODO determine appropriate start time
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(c.timestamp))
+	}
+}
+
+func (c *counter[K]) appendSumTo(sumMetric pmetric.Metric, name string) {
+	sum := sumMetric.SetEmptySum()
+	// The summed values can be negative, so the sum is not monotonic
+	sum.SetIsMonotonic(false)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for _, dpSum := range c.counts[name] {
+		dp := sum.DataPoints().AppendEmpty()
+		dpSum.attrs.CopyTo(dp.Attributes())
+		dp.SetDoubleValue(dpSum.sum)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(c.timestamp))
+	}
+}
+
+func (c *counter[K]) appendHistogramTo(histogramMetric pmetric.Metric, name string, buckets []float64) {
+	histogram := histogramMetric.SetEmptyHistogram()
+	histogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for _, dpHistogram := range c.counts[name] {
+		dp := histogram.DataPoints().AppendEmpty()
+		dpHistogram.attrs.CopyTo(dp.Attributes())
+		dp.SetCount(dpHistogram.count)
+		dp.SetSum(dpHistogram.sum)
+		dp.SetMin(dpHistogram.min)
+		dp.SetMax(dpHistogram.max)
+		dp.ExplicitBounds().FromRaw(buckets)
+		dp.BucketCounts().FromRaw(dpHistogram.bucketCounts)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(c.timestamp))
+	}
+}
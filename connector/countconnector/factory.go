@@ -51,8 +51,11 @@ func createTracesToMetrics(
 	spanMetricDefs := make(map[string]metricDef[ottlspan.TransformContext], len(c.Spans))
 	for name, info := range c.Spans {
 		md := metricDef[ottlspan.TransformContext]{
-			desc:  info.Description,
-			attrs: info.Attributes,
+			desc:       info.metricDescription("spans"),
+			attrs:      info.Attributes,
+			metricType: info.Type,
+			sourceAttr: info.SourceAttribute,
+			buckets:    info.histogramBuckets(),
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -65,8 +68,11 @@ func createTracesToMetrics(
 	spanEventMetricDefs := make(map[string]metricDef[ottlspanevent.TransformContext], len(c.SpanEvents))
 	for name, info := range c.SpanEvents {
 		md := metricDef[ottlspanevent.TransformContext]{
-			desc:  info.Description,
-			attrs: info.Attributes,
+			desc:       info.metricDescription("span events"),
+			attrs:      info.Attributes,
+			metricType: info.Type,
+			sourceAttr: info.SourceAttribute,
+			buckets:    info.histogramBuckets(),
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -108,8 +114,11 @@ func createMetricsToMetrics(
 	dataPointMetricDefs := make(map[string]metricDef[ottldatapoint.TransformContext], len(c.DataPoints))
 	for name, info := range c.DataPoints {
 		md := metricDef[ottldatapoint.TransformContext]{
-			desc:  info.Description,
-			attrs: info.Attributes,
+			desc:       info.metricDescription("data points"),
+			attrs:      info.Attributes,
+			metricType: info.Type,
+			sourceAttr: info.SourceAttribute,
+			buckets:    info.histogramBuckets(),
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -138,8 +147,11 @@ func createLogsToMetrics(
 	metricDefs := make(map[string]metricDef[ottllog.TransformContext], len(c.Logs))
 	for name, info := range c.Logs {
 		md := metricDef[ottllog.TransformContext]{
-			desc:  info.Description,
-			attrs: info.Attributes,
+			desc:       info.metricDescription("log records"),
+			attrs:      info.Attributes,
+			metricType: info.Type,
+			sourceAttr: info.SourceAttribute,
+			buckets:    info.histogramBuckets(),
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -156,7 +168,10 @@ func createLogsToMetrics(
 }
 
 type metricDef[K any] struct {
-	condition expr.BoolExpr[K]
-	desc      string
-	attrs     []AttributeConfig
+	condition  expr.BoolExpr[K]
+	desc       string
+	attrs      []AttributeConfig
+	metricType string
+	sourceAttr string
+	buckets    []float64
 }
@@ -16,6 +16,15 @@ var (
 	errNoPipelines        = errors.New("invalid route: no pipelines defined")
 	errUnexpectedConsumer = errors.New("expected consumer to be a connector router")
 	errNoTableItems       = errors.New("invalid routing table: the routing table is empty")
+	errInvalidContext     = errors.New("invalid route: context must be either \"resource\" or \"log\"")
+	errLogContext         = errors.New("invalid route: the \"log\" context is only supported in logs pipelines")
+)
+
+const (
+	// resourceContext evaluates the route statement once per resource.
+	resourceContext = "resource"
+	// logContext evaluates the route statement once per log record.
+	logContext = "log"
 )
 
 // Config defines configuration for the Routing processor.
@@ -58,17 +67,41 @@ func (c *Config) Validate() error {
 		if len(item.Pipelines) == 0 {
 			return errNoPipelines
 		}
+
+		switch item.Context {
+		case "", resourceContext, logContext:
+		default:
+			return errInvalidContext
+		}
 	}
 
 	return nil
 }
 
+// hasLogContext returns true if any route of the table is evaluated in the log context.
+func (c *Config) hasLogContext() bool {
+	for _, item := range c.Table {
+		if item.Context == logContext {
+			return true
+		}
+	}
+	return false
+}
+
 // RoutingTableItem specifies how data should be routed to the different pipelines
 type RoutingTableItem struct {
 	// Statement is a OTTL statement used for making a routing decision.
 	// Required when 'Value' isn't provided.
 	Statement string `mapstructure:"statement"`
 
+	// Context is the OTTL context the Statement is evaluated against.
+	// Valid values are `resource` and `log`. With `log`, the statement is evaluated for each log
+	// record, so it can match the log body, severity and attributes, and only the matching log
+	// records are routed. The `log` context is only supported in logs pipelines.
+	// The default value is `resource`.
+	// Optional.
+	Context string `mapstructure:"context"`
+
 	// Pipelines contains the list of pipelines to use when the value from the FromAttribute field
 	// matches this table item. When no pipelines are specified, the ones specified under
 	// DefaultPipelines are used, if any.
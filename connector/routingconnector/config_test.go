@@ -94,6 +94,13 @@ func TestLoadConfig(t *testing.T) {
 							component.NewIDWithName(component.DataTypeLogs, "otlp-globex"),
 						},
 					},
+					{
+						Statement: `route() where severity_number >= SEVERITY_NUMBER_ERROR`,
+						Context:   "log",
+						Pipelines: []component.ID{
+							component.NewIDWithName(component.DataTypeLogs, "otlp-errors"),
+						},
+					},
 				},
 			},
 		},
@@ -147,6 +154,21 @@ func TestValidateConfig(t *testing.T) {
 			},
 			error: "invalid route: no pipelines defined",
 		},
+		{
+			name: "invalid context",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Statement: `route() where attributes["attr"] == "acme"`,
+						Context:   "span",
+						Pipelines: []component.ID{
+							component.NewIDWithName(component.DataTypeTraces, "otlp"),
+						},
+					},
+				},
+			},
+			error: `invalid route: context must be either "resource" or "log"`,
+		},
 		{
 			name: "no routes provided",
 			config: &Config{
@@ -58,3 +58,26 @@ func TestCreationFailsWithIncorrectConsumer(t *testing.T) {
 	assert.ErrorIs(t, err, errUnexpectedConsumer)
 	assert.Nil(t, conn)
 }
+
+func TestCreationFailsWithLogContextInTracesPipeline(t *testing.T) {
+	cfg := &Config{
+		Table: []RoutingTableItem{{
+			Statement: `route() where severity_number >= SEVERITY_NUMBER_ERROR`,
+			Context:   "log",
+			Pipelines: []component.ID{
+				component.NewIDWithName(component.DataTypeTraces, "0"),
+			},
+		}},
+	}
+
+	router := connectortest.NewTracesRouter(
+		connectortest.WithNopTraces(component.NewIDWithName(component.DataTypeTraces, "0")),
+	)
+
+	factory := NewFactory()
+	conn, err := factory.CreateTracesToTraces(context.Background(),
+		connectortest.NewNopCreateSettings(), cfg, router.(consumer.Traces))
+
+	assert.ErrorIs(t, err, errLogContext)
+	assert.Nil(t, conn)
+}
@@ -14,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 )
 
@@ -72,7 +73,12 @@ func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 		rtx := ottlresource.NewTransformContext(rlogs.Resource())
 
 		noRoutesMatch := true
+		hasLogRoutes := false
 		for _, route := range c.router.routes {
+			if route.logStatement != nil {
+				hasLogRoutes = true
+				continue
+			}
 			_, isMatch, err := route.statement.Execute(ctx, rtx)
 			if err != nil {
 				if c.config.ErrorMode == ottl.PropagateError {
@@ -88,6 +94,15 @@ func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 
 		}
 
+		if hasLogRoutes {
+			// the log records are routed one by one, the ones not matched by any
+			// route only go to the default exporters if the resource wasn't routed
+			if err := c.routeLogRecords(ctx, groups, rlogs, noRoutesMatch); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if noRoutesMatch {
 			// no route conditions are matched, add resource logs to default exporters group
 			c.group(groups, c.router.defaultConsumer, rlogs)
@@ -99,6 +114,78 @@ func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	return errs
 }
 
+// routeLogRecords evaluates the routes in the log context against every log
+// record of the resource logs and groups each record with its resource and
+// scope for the consumers of the routes it matches. When routeToDefault is
+// true, the records matched by no route are grouped for the default consumer.
+func (c *logsConnector) routeLogRecords(
+	ctx context.Context,
+	groups map[consumer.Logs]plog.Logs,
+	rlogs plog.ResourceLogs,
+	routeToDefault bool,
+) error {
+	// the resource and scope logs created in each group for this resource logs
+	resources := make(map[consumer.Logs]plog.ResourceLogs)
+	for i := 0; i < rlogs.ScopeLogs().Len(); i++ {
+		slogs := rlogs.ScopeLogs().At(i)
+		scopes := make(map[consumer.Logs]plog.ScopeLogs)
+
+		groupRecord := func(consumer consumer.Logs, lr plog.LogRecord) {
+			if consumer == nil {
+				return
+			}
+			sl, ok := scopes[consumer]
+			if !ok {
+				rl, ok := resources[consumer]
+				if !ok {
+					group, ok := groups[consumer]
+					if !ok {
+						group = plog.NewLogs()
+						groups[consumer] = group
+					}
+					rl = group.ResourceLogs().AppendEmpty()
+					rlogs.Resource().CopyTo(rl.Resource())
+					rl.SetSchemaUrl(rlogs.SchemaUrl())
+					resources[consumer] = rl
+				}
+				sl = rl.ScopeLogs().AppendEmpty()
+				slogs.Scope().CopyTo(sl.Scope())
+				sl.SetSchemaUrl(slogs.SchemaUrl())
+				scopes[consumer] = sl
+			}
+			lr.CopyTo(sl.LogRecords().AppendEmpty())
+		}
+
+		for j := 0; j < slogs.LogRecords().Len(); j++ {
+			lr := slogs.LogRecords().At(j)
+			ltx := ottllog.NewTransformContext(lr, slogs.Scope(), rlogs.Resource())
+
+			matched := false
+			for _, route := range c.router.routes {
+				if route.logStatement == nil {
+					continue
+				}
+				_, isMatch, err := route.logStatement.Execute(ctx, ltx)
+				if err != nil {
+					if c.config.ErrorMode == ottl.PropagateError {
+						return err
+					}
+					continue
+				}
+				if isMatch {
+					matched = true
+					groupRecord(route.consumer, lr)
+				}
+			}
+
+			if !matched && routeToDefault {
+				groupRecord(c.router.defaultConsumer, lr)
+			}
+		}
+	}
+	return nil
+}
+
 func (c *logsConnector) group(
 	groups map[consumer.Logs]plog.Logs,
 	consumer consumer.Logs,
@@ -229,6 +229,168 @@ func TestLogsAreCorrectlySplitPerResourceAttributeWithOTTL(t *testing.T) {
 	})
 }
 
+func TestLogsAreCorrectlySplitPerLogRecordWithOTTL(t *testing.T) {
+	logsDefault := component.NewIDWithName(component.DataTypeLogs, "default")
+	logs0 := component.NewIDWithName(component.DataTypeLogs, "0")
+	logs1 := component.NewIDWithName(component.DataTypeLogs, "1")
+
+	cfg := &Config{
+		DefaultPipelines: []component.ID{logsDefault},
+		Table: []RoutingTableItem{
+			{
+				Statement: `route() where severity_number >= SEVERITY_NUMBER_ERROR`,
+				Context:   "log",
+				Pipelines: []component.ID{logs0},
+			},
+			{
+				Statement: `route() where ParseJSON(body)["event"] == "login"`,
+				Context:   "log",
+				Pipelines: []component.ID{logs1},
+			},
+			{
+				Statement: `route() where attributes["X-Tenant"] == "acme"`,
+				Pipelines: []component.ID{logs1},
+			},
+		},
+	}
+
+	var defaultSink, sink0, sink1 consumertest.LogsSink
+
+	router := connectortest.NewLogsRouter(
+		connectortest.WithLogsSink(logsDefault, &defaultSink),
+		connectortest.WithLogsSink(logs0, &sink0),
+		connectortest.WithLogsSink(logs1, &sink1),
+	)
+
+	resetSinks := func() {
+		defaultSink.Reset()
+		sink0.Reset()
+		sink1.Reset()
+	}
+
+	factory := NewFactory()
+	conn, err := factory.CreateLogsToLogs(
+		context.Background(),
+		connectortest.NewNopCreateSettings(),
+		cfg,
+		router.(consumer.Logs),
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, conn.Shutdown(context.Background()))
+	}()
+
+	t.Run("log records split between routes and default pipelines", func(t *testing.T) {
+		resetSinks()
+
+		l := plog.NewLogs()
+		rl := l.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("X-Tenant", "globex")
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName("scope")
+
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+		lr.Body().SetStr("failed")
+
+		lr = sl.LogRecords().AppendEmpty()
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		lr.Body().SetStr(`{"event":"login","user":"jane"}`)
+
+		lr = sl.LogRecords().AppendEmpty()
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		lr.Body().SetStr(`{"event":"logout","user":"jane"}`)
+
+		require.NoError(t, conn.ConsumeLogs(context.Background(), l))
+
+		require.Len(t, sink0.AllLogs(), 1)
+		assert.Equal(t, 1, sink0.AllLogs()[0].LogRecordCount())
+		rlog := sink0.AllLogs()[0].ResourceLogs().At(0)
+		attr, ok := rlog.Resource().Attributes().Get("X-Tenant")
+		assert.True(t, ok, "resource must be copied with the log records")
+		assert.Equal(t, "globex", attr.Str())
+		assert.Equal(t, "scope", rlog.ScopeLogs().At(0).Scope().Name())
+		assert.Equal(t, "failed", rlog.ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+
+		require.Len(t, sink1.AllLogs(), 1)
+		assert.Equal(t, 1, sink1.AllLogs()[0].LogRecordCount())
+		assert.Equal(t, `{"event":"login","user":"jane"}`,
+			sink1.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+
+		require.Len(t, defaultSink.AllLogs(), 1)
+		assert.Equal(t, 1, defaultSink.AllLogs()[0].LogRecordCount())
+		assert.Equal(t, `{"event":"logout","user":"jane"}`,
+			defaultSink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+	})
+
+	t.Run("log record matched by multiple routes", func(t *testing.T) {
+		resetSinks()
+
+		l := plog.NewLogs()
+		rl := l.ResourceLogs().AppendEmpty()
+		lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.SetSeverityNumber(plog.SeverityNumberFatal)
+		lr.Body().SetStr(`{"event":"login"}`)
+
+		require.NoError(t, conn.ConsumeLogs(context.Background(), l))
+
+		assert.Len(t, defaultSink.AllLogs(), 0)
+		assert.Len(t, sink0.AllLogs(), 1)
+		assert.Len(t, sink1.AllLogs(), 1)
+		assert.Equal(t, sink0.AllLogs(), sink1.AllLogs())
+	})
+
+	t.Run("unmatched log records of a routed resource", func(t *testing.T) {
+		resetSinks()
+
+		l := plog.NewLogs()
+		rl := l.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("X-Tenant", "acme")
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.LogRecords().AppendEmpty().SetSeverityNumber(plog.SeverityNumberInfo)
+		sl.LogRecords().AppendEmpty().SetSeverityNumber(plog.SeverityNumberError)
+
+		require.NoError(t, conn.ConsumeLogs(context.Background(), l))
+
+		assert.Len(t, defaultSink.AllLogs(), 0)
+		require.Len(t, sink0.AllLogs(), 1)
+		assert.Equal(t, 1, sink0.AllLogs()[0].LogRecordCount())
+		require.Len(t, sink1.AllLogs(), 1)
+		assert.Equal(t, 2, sink1.AllLogs()[0].LogRecordCount())
+	})
+
+	t.Run("log records of multiple scopes", func(t *testing.T) {
+		resetSinks()
+
+		l := plog.NewLogs()
+		rl := l.ResourceLogs().AppendEmpty()
+		for _, name := range []string{"first", "second"} {
+			sl := rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName(name)
+			sl.LogRecords().AppendEmpty().SetSeverityNumber(plog.SeverityNumberError)
+			sl.LogRecords().AppendEmpty().SetSeverityNumber(plog.SeverityNumberWarn)
+		}
+
+		require.NoError(t, conn.ConsumeLogs(context.Background(), l))
+
+		require.Len(t, sink0.AllLogs(), 1)
+		require.Equal(t, 1, sink0.AllLogs()[0].ResourceLogs().Len())
+		scopes := sink0.AllLogs()[0].ResourceLogs().At(0).ScopeLogs()
+		require.Equal(t, 2, scopes.Len())
+		assert.Equal(t, "first", scopes.At(0).Scope().Name())
+		assert.Equal(t, "second", scopes.At(1).Scope().Name())
+		assert.Equal(t, 1, scopes.At(0).LogRecords().Len())
+		assert.Equal(t, 1, scopes.At(1).LogRecords().Len())
+
+		require.Len(t, defaultSink.AllLogs(), 1)
+		assert.Equal(t, 2, defaultSink.AllLogs()[0].LogRecordCount())
+		assert.Len(t, sink1.AllLogs(), 0)
+	})
+}
+
 func TestLogsResourceAttributeDroppedByOTTL(t *testing.T) {
 	logsDefault := component.NewIDWithName(component.DataTypeLogs, "default")
 	logsOther := component.NewIDWithName(component.DataTypeLogs, "other")
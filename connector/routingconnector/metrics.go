@@ -32,6 +32,9 @@ func newMetricsConnector(
 	metrics consumer.Metrics,
 ) (*metricsConnector, error) {
 	cfg := config.(*Config)
+	if cfg.hasLogContext() {
+		return nil, errLogContext
+	}
 
 	mr, ok := metrics.(connector.MetricsRouter)
 	if !ok {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 )
 
@@ -26,8 +27,9 @@ type consumerProvider[C any] func(...component.ID) (C, error)
 // parameter C is expected to be one of: consumer.Traces, consumer.Metrics, or
 // consumer.Logs.
 type router[C any] struct {
-	logger *zap.Logger
-	parser ottl.Parser[ottlresource.TransformContext]
+	logger    *zap.Logger
+	parser    ottl.Parser[ottlresource.TransformContext]
+	logParser ottl.Parser[ottllog.TransformContext]
 
 	table  []RoutingTableItem
 	routes map[string]routingItem[C]
@@ -53,9 +55,19 @@ func newRouter[C any](
 		return nil, err
 	}
 
+	logParser, err := ottllog.NewParser(
+		common.Functions[ottllog.TransformContext](),
+		settings,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	r := &router[C]{
 		logger:           settings.Logger,
 		parser:           parser,
+		logParser:        logParser,
 		table:            table,
 		routes:           make(map[string]routingItem[C]),
 		consumerProvider: provider,
@@ -71,6 +83,8 @@ func newRouter[C any](
 type routingItem[C any] struct {
 	consumer  C
 	statement *ottl.Statement[ottlresource.TransformContext]
+	// logStatement is set instead of statement for the routes evaluated in the log context.
+	logStatement *ottl.Statement[ottllog.TransformContext]
 }
 
 func (r *router[C]) registerConsumers(defaultPipelineIDs []component.ID) error {
@@ -110,14 +124,17 @@ func (r *router[C]) registerDefaultConsumer(pipelineIDs []component.ID) error {
 // for each route
 func (r *router[C]) registerRouteConsumers() error {
 	for _, item := range r.table {
-		statement, err := r.getStatementFrom(item)
-		if err != nil {
-			return err
-		}
-
 		route, ok := r.routes[key(item)]
 		if !ok {
-			route.statement = statement
+			var err error
+			if item.Context == logContext {
+				route.logStatement, err = r.logParser.ParseStatement(item.Statement)
+			} else {
+				route.statement, err = r.getStatementFrom(item)
+			}
+			if err != nil {
+				return err
+			}
 		}
 
 		consumer, err := r.consumerProvider(item.Pipelines...)
@@ -147,5 +164,8 @@ func (r *router[C]) getStatementFrom(item RoutingTableItem) (*ottl.Statement[ott
 }
 
 func key(entry RoutingTableItem) string {
+	if entry.Context == logContext {
+		return logContext + ":" + entry.Statement
+	}
 	return entry.Statement
 }
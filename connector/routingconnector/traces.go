@@ -32,6 +32,9 @@ func newTracesConnector(
 	traces consumer.Traces,
 ) (*tracesConnector, error) {
 	cfg := config.(*Config)
+	if cfg.hasLogContext() {
+		return nil, errLogContext
+	}
 
 	tr, ok := traces.(connector.TracesRouter)
 	if !ok {
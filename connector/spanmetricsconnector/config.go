@@ -78,6 +78,17 @@ type ExponentialHistogramConfig struct {
 type ExplicitHistogramConfig struct {
 	// Buckets is the list of durations representing explicit histogram buckets.
 	Buckets []time.Duration `mapstructure:"buckets"`
+	// DimensionBuckets is the list of buckets to use instead of Buckets for specific dimension values.
+	// The first entry matching the dimensions of a data point is used.
+	DimensionBuckets []DimensionBucketsConfig `mapstructure:"dimension_buckets"`
+}
+
+// DimensionBucketsConfig defines explicit histogram buckets for the data points having all the given dimension values.
+type DimensionBucketsConfig struct {
+	// Dimensions maps dimension names to the values a data point must have, e.g. span.name: "GET /checkout".
+	Dimensions map[string]string `mapstructure:"dimensions"`
+	// Buckets is the list of durations representing explicit histogram buckets.
+	Buckets []time.Duration `mapstructure:"buckets"`
 }
 
 var _ component.ConfigValidator = (*Config)(nil)
@@ -99,6 +110,40 @@ func (c Config) Validate() error {
 	if c.Histogram.Explicit != nil && c.Histogram.Exponential != nil {
 		return errors.New("use either `explicit` or `exponential` buckets histogram")
 	}
+
+	if c.Histogram.Explicit != nil {
+		if err := c.validateDimensionBuckets(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDimensionBuckets checks that the dimension buckets only refer to dimensions of the metrics.
+func (c Config) validateDimensionBuckets() error {
+	dimensionNames := make(map[string]struct{})
+	for _, key := range []string{serviceNameKey, spanKindKey, statusCodeKey, spanNameKey} {
+		if !contains(c.ExcludeDimensions, key) {
+			dimensionNames[key] = struct{}{}
+		}
+	}
+	for _, d := range c.Dimensions {
+		dimensionNames[d.Name] = struct{}{}
+	}
+
+	for i, db := range c.Histogram.Explicit.DimensionBuckets {
+		if len(db.Dimensions) == 0 {
+			return fmt.Errorf("dimension_buckets[%d]: dimensions must not be empty", i)
+		}
+		if len(db.Buckets) == 0 {
+			return fmt.Errorf("dimension_buckets[%d]: buckets must not be empty", i)
+		}
+		for name := range db.Dimensions {
+			if _, ok := dimensionNames[name]; !ok {
+				return fmt.Errorf("dimension_buckets[%d]: unknown dimension %s", i, name)
+			}
+		}
+	}
 	return nil
 }
 
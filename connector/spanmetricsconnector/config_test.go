@@ -95,6 +95,44 @@ func TestLoadConfig(t *testing.T) {
 				Exemplars:              ExemplarsConfig{Enabled: true},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "dimension_buckets"),
+			expected: &Config{
+				AggregationTemporality: cumulative,
+				Dimensions:             []Dimension{{Name: "http.method"}},
+				DimensionsCacheSize:    defaultDimensionsCacheSize,
+				MetricsFlushInterval:   15 * time.Second,
+				Histogram: HistogramConfig{
+					Unit: defaultUnit,
+					Explicit: &ExplicitHistogramConfig{
+						Buckets: []time.Duration{
+							10 * time.Millisecond,
+							100 * time.Millisecond,
+							250 * time.Millisecond,
+						},
+						DimensionBuckets: []DimensionBucketsConfig{
+							{
+								Dimensions: map[string]string{"service.name": "checkout", "http.method": "POST"},
+								Buckets: []time.Duration{
+									100 * time.Millisecond,
+									500 * time.Millisecond,
+									time.Second,
+									5 * time.Second,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "dimension_buckets_unknown_dimension"),
+			errorMessage: "dimension_buckets[0]: unknown dimension http.method",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "dimension_buckets_empty_buckets"),
+			errorMessage: "dimension_buckets[0]: buckets must not be empty",
+		},
 	}
 
 	for _, tt := range tests {
@@ -129,6 +129,15 @@ func initHistogramMetrics(cfg Config) metrics.HistogramMetrics {
 	}
 
 	var bounds []float64
+	var overrides []metrics.BoundsOverride
+	if cfg.Histogram.Explicit != nil {
+		for _, db := range cfg.Histogram.Explicit.DimensionBuckets {
+			overrides = append(overrides, metrics.BoundsOverride{
+				Attributes: db.Dimensions,
+				Bounds:     durationsToUnits(db.Buckets, unitDivider(cfg.Histogram.Unit)),
+			})
+		}
+	}
 	if cfg.Histogram.Explicit != nil && cfg.Histogram.Explicit.Buckets != nil {
 		bounds = durationsToUnits(cfg.Histogram.Explicit.Buckets, unitDivider(cfg.Histogram.Unit))
 	} else {
@@ -143,7 +152,7 @@ func initHistogramMetrics(cfg Config) metrics.HistogramMetrics {
 		}
 	}
 
-	return metrics.NewExplicitHistogramMetrics(bounds)
+	return metrics.NewExplicitHistogramMetrics(bounds, overrides)
 }
 
 // unitDivider returns a unit divider to convert nanoseconds to milliseconds or seconds.
@@ -1170,7 +1170,7 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 		{
 			name:   "initialize histogram with no config provided",
 			config: Config{},
-			want:   metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsMs),
+			want:   metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsMs, nil),
 		},
 		{
 			name: "Disable histogram",
@@ -1188,7 +1188,7 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 					Unit: metrics.Milliseconds,
 				},
 			},
-			want: metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsMs),
+			want: metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsMs, nil),
 		},
 		{
 			name: "initialize explicit histogram with default bounds (seconds)",
@@ -1197,7 +1197,7 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 					Unit: metrics.Seconds,
 				},
 			},
-			want: metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsSeconds),
+			want: metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsSeconds, nil),
 		},
 		{
 			name: "initialize explicit histogram with bounds (seconds)",
@@ -1212,7 +1212,7 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 					},
 				},
 			},
-			want: metrics.NewExplicitHistogramMetrics([]float64{0.1, 1}),
+			want: metrics.NewExplicitHistogramMetrics([]float64{0.1, 1}, nil),
 		},
 		{
 			name: "initialize explicit histogram with bounds (ms)",
@@ -1227,7 +1227,30 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 					},
 				},
 			},
-			want: metrics.NewExplicitHistogramMetrics([]float64{100, 1000}),
+			want: metrics.NewExplicitHistogramMetrics([]float64{100, 1000}, nil),
+		},
+		{
+			name: "initialize explicit histogram with dimension buckets (ms)",
+			config: Config{
+				Histogram: HistogramConfig{
+					Unit: metrics.Milliseconds,
+					Explicit: &ExplicitHistogramConfig{
+						Buckets: []time.Duration{
+							100 * time.Millisecond,
+							1000 * time.Millisecond,
+						},
+						DimensionBuckets: []DimensionBucketsConfig{
+							{
+								Dimensions: map[string]string{spanNameKey: "GET /checkout"},
+								Buckets:    []time.Duration{250 * time.Millisecond},
+							},
+						},
+					},
+				},
+			},
+			want: metrics.NewExplicitHistogramMetrics([]float64{100, 1000}, []metrics.BoundsOverride{
+				{Attributes: map[string]string{spanNameKey: "GET /checkout"}, Bounds: []float64{250}},
+			}),
 		},
 		{
 			name: "initialize exponential histogram",
@@ -26,8 +26,26 @@ type Histogram interface {
 }
 
 type explicitHistogramMetrics struct {
-	metrics map[Key]*explicitHistogram
-	bounds  []float64
+	metrics   map[Key]*explicitHistogram
+	bounds    []float64
+	overrides []BoundsOverride
+}
+
+// BoundsOverride defines the bounds used instead of the default ones by the explicit
+// histograms whose attributes have all the given values.
+type BoundsOverride struct {
+	Attributes map[string]string
+	Bounds     []float64
+}
+
+func (o BoundsOverride) matches(attributes pcommon.Map) bool {
+	for k, v := range o.Attributes {
+		attr, ok := attributes.Get(k)
+		if !ok || attr.AsString() != v {
+			return false
+		}
+	}
+	return true
 }
 
 type exponentialHistogramMetrics struct {
@@ -60,21 +78,25 @@ func NewExponentialHistogramMetrics(maxSize int32) HistogramMetrics {
 	}
 }
 
-func NewExplicitHistogramMetrics(bounds []float64) HistogramMetrics {
+// NewExplicitHistogramMetrics creates explicit histograms with the given bounds. The bounds of
+// the first matching override, if any, are used instead.
+func NewExplicitHistogramMetrics(bounds []float64, overrides []BoundsOverride) HistogramMetrics {
 	return &explicitHistogramMetrics{
-		metrics: make(map[Key]*explicitHistogram),
-		bounds:  bounds,
+		metrics:   make(map[Key]*explicitHistogram),
+		bounds:    bounds,
+		overrides: overrides,
 	}
 }
 
 func (m *explicitHistogramMetrics) GetOrCreate(key Key, attributes pcommon.Map) Histogram {
 	h, ok := m.metrics[key]
 	if !ok {
+		bounds := m.boundsFor(attributes)
 		h = &explicitHistogram{
 			attributes:   attributes,
 			exemplars:    pmetric.NewExemplarSlice(),
-			bounds:       m.bounds,
-			bucketCounts: make([]uint64, len(m.bounds)+1),
+			bounds:       bounds,
+			bucketCounts: make([]uint64, len(bounds)+1),
 		}
 		m.metrics[key] = h
 	}
@@ -82,6 +104,15 @@ func (m *explicitHistogramMetrics) GetOrCreate(key Key, attributes pcommon.Map)
 	return h
 }
 
+func (m *explicitHistogramMetrics) boundsFor(attributes pcommon.Map) []float64 {
+	for _, o := range m.overrides {
+		if o.matches(attributes) {
+			return o.Bounds
+		}
+	}
+	return m.bounds
+}
+
 func (m *explicitHistogramMetrics) BuildMetrics(
 	metric pmetric.Metric,
 	start pcommon.Timestamp,
@@ -8,6 +8,7 @@ import (
 
 	"github.com/lightstep/go-expohisto/structure"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -103,3 +104,45 @@ func TestConnector_ExpoHistToExponentialDataPoint(t *testing.T) {
 		})
 	}
 }
+
+func TestExplicitHistogramMetricsBoundsOverrides(t *testing.T) {
+	hm := NewExplicitHistogramMetrics([]float64{10, 100}, []BoundsOverride{
+		{Attributes: map[string]string{"service.name": "checkout", "span.name": "pay"}, Bounds: []float64{250, 500, 1000}},
+		{Attributes: map[string]string{"service.name": "checkout"}, Bounds: []float64{50}},
+	})
+
+	newAttributes := func(service, span string) pcommon.Map {
+		attrs := pcommon.NewMap()
+		attrs.PutStr("service.name", service)
+		attrs.PutStr("span.name", span)
+		return attrs
+	}
+	hm.GetOrCreate("checkout-pay", newAttributes("checkout", "pay")).Observe(300)
+	hm.GetOrCreate("checkout-cart", newAttributes("checkout", "cart")).Observe(30)
+	hm.GetOrCreate("frontend-pay", newAttributes("frontend", "pay")).Observe(30)
+
+	metric := pmetric.NewMetric()
+	hm.BuildMetrics(metric, pcommon.Timestamp(0), pmetric.AggregationTemporalityCumulative)
+
+	got := map[string][]float64{}
+	counts := map[string][]uint64{}
+	dps := metric.Histogram().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		service, _ := dp.Attributes().Get("service.name")
+		span, _ := dp.Attributes().Get("span.name")
+		key := service.Str() + "-" + span.Str()
+		got[key] = dp.ExplicitBounds().AsRaw()
+		counts[key] = dp.BucketCounts().AsRaw()
+	}
+	assert.Equal(t, map[string][]float64{
+		"checkout-pay":  {250, 500, 1000},
+		"checkout-cart": {50},
+		"frontend-pay":  {10, 100},
+	}, got)
+	assert.Equal(t, map[string][]uint64{
+		"checkout-pay":  {0, 1, 0, 0},
+		"checkout-cart": {1, 0},
+		"frontend-pay":  {0, 1, 0},
+	}, counts)
+}
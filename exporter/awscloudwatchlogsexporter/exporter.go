@@ -219,6 +219,12 @@ func logToCWLog(resourceAttrs map[string]interface{}, log plog.LogRecord, config
 	// Evaluate go.elastic.co/fastjson as a replacement for encoding/json.
 	logGroupName := config.LogGroupName
 	logStreamName := config.LogStreamName
+	if hasPatterns(logGroupName) {
+		logGroupName = replacePatterns(logGroupName, resourceAttrs, invalidLogGroupChars)
+	}
+	if hasPatterns(logStreamName) {
+		logStreamName = replacePatterns(logStreamName, resourceAttrs, invalidLogStreamChars)
+	}
 
 	var bodyJSON []byte
 	var err error
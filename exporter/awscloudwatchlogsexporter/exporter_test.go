@@ -67,6 +67,24 @@ func TestLogToCWLog(t *testing.T) {
 				LogStreamName: "",
 			},
 		},
+		{
+			name:     "templated names",
+			resource: testResource(),
+			log:      testLogRecord(),
+			config: Config{
+				LogGroupName:  "/otel/{host}/{ServiceName}",
+				LogStreamName: "{node}-{PodName}",
+			},
+			want: cwlogs.Event{
+				GeneratedTime: time.Now(),
+				InputLogEvent: &cloudwatchlogs.InputLogEvent{
+					Timestamp: aws.Int64(1609719139),
+					Message:   aws.String(`{"body":"hello world","severity_number":5,"severity_text":"debug","dropped_attributes_count":4,"flags":1,"trace_id":"0102030405060708090a0b0c0d0e0f10","span_id":"0102030405060708","attributes":{"key1":1,"key2":"attr2"},"resource":{"host":"abc123","node":5}}`),
+				},
+				LogGroupName:  "/otel/abc123/undefined",
+				LogStreamName: "5-undefined",
+			},
+		},
 		{
 			name:     "no resource",
 			resource: pcommon.NewResource(),
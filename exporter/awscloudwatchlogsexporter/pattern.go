@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogsexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awscloudwatchlogsexporter"
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// patternKeyToAttributeMap maps the placeholders of the log group and stream names to the
+// resource attributes replacing them. The other placeholders are replaced by the resource
+// attribute of the same name, e.g. {tenant.id}.
+var patternKeyToAttributeMap = map[string]string{
+	"ServiceName":      "service.name",
+	"ServiceNamespace": "service.namespace",
+	"ClusterName":      "k8s.cluster.name",
+	"NamespaceName":    "k8s.namespace.name",
+	"NodeName":         "k8s.node.name",
+	"PodName":          "k8s.pod.name",
+	"ContainerName":    "k8s.container.name",
+	"TaskId":           "aws.ecs.task.id",
+	"HostName":         "host.name",
+}
+
+const undefinedPatternValue = "undefined"
+
+var (
+	patternRegexp = regexp.MustCompile(`\{([^{}]+)\}`)
+	// invalidLogGroupChars and invalidLogStreamChars match the characters not allowed in the names,
+	// see https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_CreateLogGroup.html
+	invalidLogGroupChars  = regexp.MustCompile(`[^a-zA-Z0-9_\-/.#]`)
+	invalidLogStreamChars = regexp.MustCompile(`[:*]`)
+)
+
+// hasPatterns returns whether the name is templated from resource attributes
+func hasPatterns(name string) bool {
+	return strings.Contains(name, "{")
+}
+
+// replacePatterns replaces the placeholders of the name with the values of the resource attributes,
+// the characters which are not allowed in the name being replaced with underscores. The missing
+// attributes are replaced with "undefined".
+func replacePatterns(name string, resourceAttrs map[string]interface{}, invalidChars *regexp.Regexp) string {
+	return patternRegexp.ReplaceAllStringFunc(name, func(pattern string) string {
+		key := pattern[1 : len(pattern)-1]
+		if attribute, ok := patternKeyToAttributeMap[key]; ok {
+			key = attribute
+		}
+		value, ok := resourceAttrs[key]
+		if !ok || value == nil || fmt.Sprint(value) == "" {
+			return undefinedPatternValue
+		}
+		return invalidChars.ReplaceAllString(fmt.Sprint(value), "_")
+	})
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchlogsexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplacePatterns(t *testing.T) {
+	resourceAttrs := map[string]interface{}{
+		"service.name":       "checkout",
+		"k8s.namespace.name": "shop",
+		"k8s.pod.name":       "checkout-7d9f:1",
+		"tenant.id":          "acme corp",
+		"empty":              "",
+	}
+
+	tests := []struct {
+		name     string
+		logGroup bool
+		template string
+		want     string
+	}{
+		{name: "well-known placeholders", logGroup: true, template: "/eks/{NamespaceName}/{ServiceName}", want: "/eks/shop/checkout"},
+		{name: "attribute placeholder", logGroup: true, template: "/tenants/{tenant.id}", want: "/tenants/acme_corp"},
+		{name: "missing attribute", logGroup: true, template: "/{ServiceName}/{ClusterName}", want: "/checkout/undefined"},
+		{name: "empty attribute", logGroup: true, template: "/{empty}", want: "/undefined"},
+		{name: "stream name", template: "{PodName}*{tenant.id}", want: "checkout-7d9f_1*acme corp"},
+		{name: "no placeholder", logGroup: true, template: "static", want: "static"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invalidChars := invalidLogStreamChars
+			if tt.logGroup {
+				invalidChars = invalidLogGroupChars
+			}
+			assert.Equal(t, tt.want, replacePatterns(tt.template, resourceAttrs, invalidChars))
+		})
+	}
+}
@@ -16,14 +16,22 @@ type S3UploaderConfig struct {
 	S3Bucket    string `mapstructure:"s3_bucket"`
 	S3Prefix    string `mapstructure:"s3_prefix"`
 	S3Partition string `mapstructure:"s3_partition"`
-	FilePrefix  string `mapstructure:"file_prefix"`
-	Endpoint    string `mapstructure:"endpoint"`
+	// S3PartitionTemplate is the template of the part of the S3 key between the prefix and
+	// the file name. When set, it replaces the time key of s3_partition. The template can
+	// contain the {year}, {month}, {day}, {hour} and {minute} placeholders, replaced by the
+	// UTC upload time, and {resource.<attribute>} placeholders, replaced by the value of the
+	// resource attribute, the data of the resources with different values being uploaded
+	// to different objects.
+	S3PartitionTemplate string `mapstructure:"s3_partition_template"`
+	FilePrefix          string `mapstructure:"file_prefix"`
+	Endpoint            string `mapstructure:"endpoint"`
 }
 
 type MarshalerType string
 
 const (
 	OtlpJSON MarshalerType = "otlp_json"
+	Parquet  MarshalerType = "parquet"
 )
 
 // Config contains the main configuration options for the s3 exporter
@@ -42,5 +50,10 @@ func (c *Config) Validate() error {
 	if c.S3Uploader.S3Bucket == "" {
 		errs = multierr.Append(errs, errors.New("bucket is required"))
 	}
+	if c.S3Uploader.S3PartitionTemplate != "" {
+		if _, err := newPartitioner(c.S3Uploader.S3PartitionTemplate); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
 	return errs
 }
@@ -115,6 +115,16 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			errExpected: errors.New("region is required"),
 		},
+		{
+			name: "invalid partition template",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.S3Uploader.S3PartitionTemplate = "year={year}/week={week}"
+				return c
+			}(),
+			errExpected: errors.New(`unknown placeholder "week" in partition template "year={year}/week={week}", expected one of year, month, day, hour, minute or resource.<attribute>`),
+		},
 	}
 
 	for _, tt := range tests {
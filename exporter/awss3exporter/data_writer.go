@@ -6,5 +6,7 @@ package awss3exporter // import "github.com/open-telemetry/opentelemetry-collect
 import "context"
 
 type dataWriter interface {
-	writeBuffer(ctx context.Context, buf []byte, config *Config, metadata string, format string) error
+	// writeBuffer uploads the buffer to the given partition, or to the time key of the
+	// configuration when the partition is empty.
+	writeBuffer(ctx context.Context, buf []byte, config *Config, partition string, metadata string, format string) error
 }
@@ -6,12 +6,14 @@ package awss3exporter // import "github.com/open-telemetry/opentelemetry-collect
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -20,6 +22,8 @@ type s3Exporter struct {
 	dataWriter dataWriter
 	logger     *zap.Logger
 	marshaler  marshaler
+	// partitioner is nil when the partitions are the time keys of s3_partition
+	partitioner *partitioner
 }
 
 func newS3Exporter(config *Config,
@@ -42,6 +46,11 @@ func newS3Exporter(config *Config,
 		logger:     logger,
 		marshaler:  m,
 	}
+	if config.S3Uploader.S3PartitionTemplate != "" {
+		if s3Exporter.partitioner, err = newPartitioner(config.S3Uploader.S3PartitionTemplate); err != nil {
+			return nil, err
+		}
+	}
 	return s3Exporter, nil
 }
 
@@ -50,30 +59,63 @@ func (e *s3Exporter) Capabilities() consumer.Capabilities {
 }
 
 func (e *s3Exporter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if e.partitioner == nil {
+		return e.writeMetrics(ctx, "", md)
+	}
+	var errs error
+	for partition, metrics := range e.partitioner.partitionMetrics(time.Now().UTC(), md) {
+		errs = multierr.Append(errs, e.writeMetrics(ctx, partition, metrics))
+	}
+	return errs
+}
+
+func (e *s3Exporter) writeMetrics(ctx context.Context, partition string, md pmetric.Metrics) error {
 	buf, err := e.marshaler.MarshalMetrics(md)
 
 	if err != nil {
 		return err
 	}
 
-	return e.dataWriter.writeBuffer(ctx, buf, e.config, "metrics", e.marshaler.format())
+	return e.dataWriter.writeBuffer(ctx, buf, e.config, partition, "metrics", e.marshaler.format())
 }
 
 func (e *s3Exporter) ConsumeLogs(ctx context.Context, logs plog.Logs) error {
+	if e.partitioner == nil {
+		return e.writeLogs(ctx, "", logs)
+	}
+	var errs error
+	for partition, ld := range e.partitioner.partitionLogs(time.Now().UTC(), logs) {
+		errs = multierr.Append(errs, e.writeLogs(ctx, partition, ld))
+	}
+	return errs
+}
+
+func (e *s3Exporter) writeLogs(ctx context.Context, partition string, logs plog.Logs) error {
 	buf, err := e.marshaler.MarshalLogs(logs)
 
 	if err != nil {
 		return err
 	}
 
-	return e.dataWriter.writeBuffer(ctx, buf, e.config, "logs", e.marshaler.format())
+	return e.dataWriter.writeBuffer(ctx, buf, e.config, partition, "logs", e.marshaler.format())
 }
 
 func (e *s3Exporter) ConsumeTraces(ctx context.Context, traces ptrace.Traces) error {
+	if e.partitioner == nil {
+		return e.writeTraces(ctx, "", traces)
+	}
+	var errs error
+	for partition, td := range e.partitioner.partitionTraces(time.Now().UTC(), traces) {
+		errs = multierr.Append(errs, e.writeTraces(ctx, partition, td))
+	}
+	return errs
+}
+
+func (e *s3Exporter) writeTraces(ctx context.Context, partition string, traces ptrace.Traces) error {
 	buf, err := e.marshaler.MarshalTraces(traces)
 	if err != nil {
 		return err
 	}
 
-	return e.dataWriter.writeBuffer(ctx, buf, e.config, "traces", e.marshaler.format())
+	return e.dataWriter.writeBuffer(ctx, buf, e.config, partition, "traces", e.marshaler.format())
 }
@@ -18,6 +18,12 @@ func TestMarshaler(t *testing.T) {
 		require.NotNil(t, m)
 		assert.Equal(t, m.format(), "json")
 	}
+	{
+		m, err := NewMarshaler("parquet", zap.NewNop())
+		assert.NoError(t, err)
+		require.NotNil(t, m)
+		assert.Equal(t, m.format(), "parquet")
+	}
 	{
 		m, err := NewMarshaler("unknown", zap.NewNop())
 		assert.Error(t, err)
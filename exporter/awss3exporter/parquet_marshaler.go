@@ -0,0 +1,384 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	// Athena doesn't support nanosecond timestamps, so the timestamps are stored in microseconds.
+	timestampType  = arrow.FixedWidthTypes.Timestamp_us
+	attributesType = arrow.MapOf(arrow.BinaryTypes.String, arrow.BinaryTypes.String)
+
+	commonFields = []arrow.Field{
+		{Name: "attributes", Type: attributesType},
+		{Name: "resource_attributes", Type: attributesType},
+		{Name: "scope_name", Type: arrow.BinaryTypes.String},
+		{Name: "scope_version", Type: arrow.BinaryTypes.String},
+	}
+
+	logsSchema = arrow.NewSchema(append([]arrow.Field{
+		{Name: "timestamp", Type: timestampType, Nullable: true},
+		{Name: "observed_timestamp", Type: timestampType, Nullable: true},
+		{Name: "severity_number", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "severity_text", Type: arrow.BinaryTypes.String},
+		{Name: "body", Type: arrow.BinaryTypes.String},
+		{Name: "trace_id", Type: arrow.BinaryTypes.String},
+		{Name: "span_id", Type: arrow.BinaryTypes.String},
+		{Name: "flags", Type: arrow.PrimitiveTypes.Uint32},
+	}, commonFields...), nil)
+
+	tracesSchema = arrow.NewSchema(append([]arrow.Field{
+		{Name: "trace_id", Type: arrow.BinaryTypes.String},
+		{Name: "span_id", Type: arrow.BinaryTypes.String},
+		{Name: "parent_span_id", Type: arrow.BinaryTypes.String},
+		{Name: "trace_state", Type: arrow.BinaryTypes.String},
+		{Name: "name", Type: arrow.BinaryTypes.String},
+		{Name: "kind", Type: arrow.BinaryTypes.String},
+		{Name: "start_timestamp", Type: timestampType, Nullable: true},
+		{Name: "end_timestamp", Type: timestampType, Nullable: true},
+		{Name: "duration_ns", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "status_code", Type: arrow.BinaryTypes.String},
+		{Name: "status_message", Type: arrow.BinaryTypes.String},
+	}, commonFields...), nil)
+
+	metricsSchema = arrow.NewSchema(append([]arrow.Field{
+		{Name: "name", Type: arrow.BinaryTypes.String},
+		{Name: "description", Type: arrow.BinaryTypes.String},
+		{Name: "unit", Type: arrow.BinaryTypes.String},
+		{Name: "type", Type: arrow.BinaryTypes.String},
+		{Name: "aggregation_temporality", Type: arrow.BinaryTypes.String},
+		{Name: "is_monotonic", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+		{Name: "start_timestamp", Type: timestampType, Nullable: true},
+		{Name: "timestamp", Type: timestampType, Nullable: true},
+		{Name: "value", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "count", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+		{Name: "sum", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "min", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "max", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "explicit_bounds", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64)},
+		{Name: "bucket_counts", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64)},
+	}, commonFields...), nil)
+)
+
+// parquetMarshaler marshals the telemetry into Parquet files with a row per log record, span
+// or metric data point, so that the files can be queried directly, for instance by Athena.
+type parquetMarshaler struct{}
+
+var _ marshaler = parquetMarshaler{}
+
+func (parquetMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	return writeParquet(logsSchema, func(b *array.RecordBuilder) {
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			rl := ld.ResourceLogs().At(i)
+			for j := 0; j < rl.ScopeLogs().Len(); j++ {
+				sl := rl.ScopeLogs().At(j)
+				for k := 0; k < sl.LogRecords().Len(); k++ {
+					lr := sl.LogRecords().At(k)
+					appendRow(b,
+						lr.Timestamp(),
+						lr.ObservedTimestamp(),
+						int32(lr.SeverityNumber()),
+						lr.SeverityText(),
+						lr.Body().AsString(),
+						traceIDString(lr.TraceID()),
+						spanIDString(lr.SpanID()),
+						uint32(lr.Flags()),
+						lr.Attributes(),
+						rl.Resource().Attributes(),
+						sl.Scope().Name(),
+						sl.Scope().Version(),
+					)
+				}
+			}
+		}
+	})
+}
+
+func (parquetMarshaler) MarshalTraces(td ptrace.Traces) ([]byte, error) {
+	return writeParquet(tracesSchema, func(b *array.RecordBuilder) {
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			rs := td.ResourceSpans().At(i)
+			for j := 0; j < rs.ScopeSpans().Len(); j++ {
+				ss := rs.ScopeSpans().At(j)
+				for k := 0; k < ss.Spans().Len(); k++ {
+					span := ss.Spans().At(k)
+					appendRow(b,
+						traceIDString(span.TraceID()),
+						spanIDString(span.SpanID()),
+						spanIDString(span.ParentSpanID()),
+						span.TraceState().AsRaw(),
+						span.Name(),
+						span.Kind().String(),
+						span.StartTimestamp(),
+						span.EndTimestamp(),
+						int64(span.EndTimestamp())-int64(span.StartTimestamp()),
+						span.Status().Code().String(),
+						span.Status().Message(),
+						span.Attributes(),
+						rs.Resource().Attributes(),
+						ss.Scope().Name(),
+						ss.Scope().Version(),
+					)
+				}
+			}
+		}
+	})
+}
+
+// metricRow contains the columns of a metric data point that depend on the metric type.
+type metricRow struct {
+	startTimestamp, timestamp pcommon.Timestamp
+	value                     any
+	count, sum, min, max      any
+	explicitBounds            []float64
+	bucketCounts              []uint64
+	attributes                pcommon.Map
+}
+
+func (parquetMarshaler) MarshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	return writeParquet(metricsSchema, func(b *array.RecordBuilder) {
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			rm := md.ResourceMetrics().At(i)
+			for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+				sm := rm.ScopeMetrics().At(j)
+				for k := 0; k < sm.Metrics().Len(); k++ {
+					metric := sm.Metrics().At(k)
+					temporality, isMonotonic := metricAggregation(metric)
+					for _, row := range metricRows(metric) {
+						appendRow(b,
+							metric.Name(),
+							metric.Description(),
+							metric.Unit(),
+							metric.Type().String(),
+							temporality,
+							isMonotonic,
+							row.startTimestamp,
+							row.timestamp,
+							row.value,
+							row.count,
+							row.sum,
+							row.min,
+							row.max,
+							row.explicitBounds,
+							row.bucketCounts,
+							row.attributes,
+							rm.Resource().Attributes(),
+							sm.Scope().Name(),
+							sm.Scope().Version(),
+						)
+					}
+				}
+			}
+		}
+	})
+}
+
+func (parquetMarshaler) format() string {
+	return "parquet"
+}
+
+// metricAggregation returns the aggregation temporality, and whether the metric is monotonic
+// for the sums.
+func metricAggregation(metric pmetric.Metric) (string, any) {
+	switch metric.Type() {
+	case pmetric.MetricTypeSum:
+		return metric.Sum().AggregationTemporality().String(), metric.Sum().IsMonotonic()
+	case pmetric.MetricTypeHistogram:
+		return metric.Histogram().AggregationTemporality().String(), nil
+	case pmetric.MetricTypeExponentialHistogram:
+		return metric.ExponentialHistogram().AggregationTemporality().String(), nil
+	default:
+		return "", nil
+	}
+}
+
+// metricRows returns the rows of the data points of the metric. The quantiles of the summaries
+// and the buckets of the exponential histograms are not included.
+func metricRows(metric pmetric.Metric) []metricRow {
+	var rows []metricRow
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge, pmetric.MetricTypeSum:
+		var dps pmetric.NumberDataPointSlice
+		if metric.Type() == pmetric.MetricTypeSum {
+			dps = metric.Sum().DataPoints()
+		} else {
+			dps = metric.Gauge().DataPoints()
+		}
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := metricRow{startTimestamp: dp.StartTimestamp(), timestamp: dp.Timestamp(), attributes: dp.Attributes()}
+			switch dp.ValueType() {
+			case pmetric.NumberDataPointValueTypeInt:
+				row.value = float64(dp.IntValue())
+			case pmetric.NumberDataPointValueTypeDouble:
+				row.value = dp.DoubleValue()
+			}
+			rows = append(rows, row)
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := metricRow{
+				startTimestamp: dp.StartTimestamp(),
+				timestamp:      dp.Timestamp(),
+				count:          dp.Count(),
+				explicitBounds: dp.ExplicitBounds().AsRaw(),
+				bucketCounts:   dp.BucketCounts().AsRaw(),
+				attributes:     dp.Attributes(),
+			}
+			if dp.HasSum() {
+				row.sum = dp.Sum()
+			}
+			if dp.HasMin() {
+				row.min = dp.Min()
+			}
+			if dp.HasMax() {
+				row.max = dp.Max()
+			}
+			rows = append(rows, row)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := metricRow{
+				startTimestamp: dp.StartTimestamp(),
+				timestamp:      dp.Timestamp(),
+				count:          dp.Count(),
+				attributes:     dp.Attributes(),
+			}
+			if dp.HasSum() {
+				row.sum = dp.Sum()
+			}
+			if dp.HasMin() {
+				row.min = dp.Min()
+			}
+			if dp.HasMax() {
+				row.max = dp.Max()
+			}
+			rows = append(rows, row)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			rows = append(rows, metricRow{
+				startTimestamp: dp.StartTimestamp(),
+				timestamp:      dp.Timestamp(),
+				count:          dp.Count(),
+				sum:            dp.Sum(),
+				attributes:     dp.Attributes(),
+			})
+		}
+	}
+	return rows
+}
+
+// writeParquet encodes the rows appended by fill into a Parquet file.
+func writeParquet(schema *arrow.Schema, fill func(b *array.RecordBuilder)) ([]byte, error) {
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+	fill(b)
+	record := b.NewRecord()
+	defer record.Release()
+
+	var buf bytes.Buffer
+	props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy))
+	w, err := pqarrow.NewFileWriter(schema, &buf, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	if err = w.Write(record); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// appendRow appends the values to the fields of the record builder, in the order of the schema.
+func appendRow(b *array.RecordBuilder, values ...any) {
+	for i, value := range values {
+		appendValue(b.Field(i), value)
+	}
+}
+
+func appendValue(b array.Builder, value any) {
+	if value == nil {
+		b.AppendNull()
+		return
+	}
+	switch b := b.(type) {
+	case *array.StringBuilder:
+		b.Append(value.(string))
+	case *array.BooleanBuilder:
+		b.Append(value.(bool))
+	case *array.Int32Builder:
+		b.Append(value.(int32))
+	case *array.Int64Builder:
+		b.Append(value.(int64))
+	case *array.Uint32Builder:
+		b.Append(value.(uint32))
+	case *array.Uint64Builder:
+		b.Append(value.(uint64))
+	case *array.Float64Builder:
+		b.Append(value.(float64))
+	case *array.TimestampBuilder:
+		if ts := value.(pcommon.Timestamp); ts != 0 {
+			b.Append(arrow.Timestamp(ts / 1000))
+		} else {
+			b.AppendNull()
+		}
+	case *array.MapBuilder:
+		attrs := value.(pcommon.Map)
+		b.Append(true)
+		keys := b.KeyBuilder().(*array.StringBuilder)
+		items := b.ItemBuilder().(*array.StringBuilder)
+		attrs.Range(func(k string, v pcommon.Value) bool {
+			keys.Append(k)
+			items.Append(v.AsString())
+			return true
+		})
+	case *array.ListBuilder:
+		b.Append(true)
+		switch values := value.(type) {
+		case []float64:
+			b.ValueBuilder().(*array.Float64Builder).AppendValues(values, nil)
+		case []uint64:
+			b.ValueBuilder().(*array.Uint64Builder).AppendValues(values, nil)
+		}
+	default:
+		panic(fmt.Sprintf("unsupported parquet column type %s", b.Type()))
+	}
+}
+
+func traceIDString(id pcommon.TraceID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return id.String()
+}
+
+func spanIDString(id pcommon.SpanID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func readParquet(t *testing.T, buf []byte) arrow.Table {
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buf), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	t.Cleanup(table.Release)
+	return table
+}
+
+// column returns the first chunk of the column with the given name.
+func column(t *testing.T, table arrow.Table, name string) arrow.Array {
+	indices := table.Schema().FieldIndices(name)
+	require.Len(t, indices, 1, "column %s", name)
+	return table.Column(indices[0]).Data().Chunk(0)
+}
+
+func TestParquetMarshalLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("checkout.logger")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1654257420681895000))
+	lr.SetSeverityNumber(plog.SeverityNumberError)
+	lr.SetSeverityText("ERROR")
+	lr.Body().SetStr("payment failed")
+	lr.Attributes().PutInt("http.status_code", 500)
+	lr.SetTraceID([16]byte{1, 2, 3, 4})
+	sl.LogRecords().AppendEmpty().Body().SetStr("no timestamp")
+
+	buf, err := parquetMarshaler{}.MarshalLogs(ld)
+	require.NoError(t, err)
+
+	table := readParquet(t, buf)
+	require.Equal(t, int64(2), table.NumRows())
+
+	timestamps := column(t, table, "timestamp").(*array.Timestamp)
+	assert.Equal(t, arrow.Timestamp(1654257420681895), timestamps.Value(0))
+	assert.True(t, timestamps.IsNull(1))
+	assert.Equal(t, "payment failed", column(t, table, "body").(*array.String).Value(0))
+	assert.Equal(t, int32(plog.SeverityNumberError), column(t, table, "severity_number").(*array.Int32).Value(0))
+	assert.Equal(t, "01020304000000000000000000000000", column(t, table, "trace_id").(*array.String).Value(0))
+	assert.Equal(t, "", column(t, table, "trace_id").(*array.String).Value(1))
+	assert.Equal(t, "checkout.logger", column(t, table, "scope_name").(*array.String).Value(0))
+
+	attributes := column(t, table, "attributes").(*array.Map)
+	assert.Equal(t, "http.status_code", attributes.Keys().(*array.String).Value(0))
+	assert.Equal(t, "500", attributes.Items().(*array.String).Value(0))
+	resourceAttributes := column(t, table, "resource_attributes").(*array.Map)
+	start, end := resourceAttributes.ValueOffsets(1)
+	assert.Equal(t, int64(1), end-start, "each row has the resource attributes")
+}
+
+func TestParquetMarshalTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("GET /cart")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetTraceID([16]byte{1})
+	span.SetSpanID([8]byte{2})
+	span.SetStartTimestamp(pcommon.Timestamp(1000000))
+	span.SetEndTimestamp(pcommon.Timestamp(3500000))
+	span.Status().SetCode(ptrace.StatusCodeError)
+
+	buf, err := parquetMarshaler{}.MarshalTraces(td)
+	require.NoError(t, err)
+
+	table := readParquet(t, buf)
+	require.Equal(t, int64(1), table.NumRows())
+	assert.Equal(t, "GET /cart", column(t, table, "name").(*array.String).Value(0))
+	assert.Equal(t, "Server", column(t, table, "kind").(*array.String).Value(0))
+	assert.Equal(t, "0200000000000000", column(t, table, "span_id").(*array.String).Value(0))
+	assert.Equal(t, "", column(t, table, "parent_span_id").(*array.String).Value(0))
+	assert.Equal(t, int64(2500000), column(t, table, "duration_ns").(*array.Int64).Value(0))
+	assert.Equal(t, "Error", column(t, table, "status_code").(*array.String).Value(0))
+}
+
+func TestParquetMarshalMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+
+	sum := metrics.AppendEmpty()
+	sum.SetName("requests")
+	sum.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.Sum().SetIsMonotonic(true)
+	sum.Sum().DataPoints().AppendEmpty().SetIntValue(42)
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("latency")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := histogram.Histogram().DataPoints().AppendEmpty()
+	dp.SetCount(3)
+	dp.SetSum(12.5)
+	dp.ExplicitBounds().FromRaw([]float64{1, 10})
+	dp.BucketCounts().FromRaw([]uint64{1, 1, 1})
+
+	buf, err := parquetMarshaler{}.MarshalMetrics(md)
+	require.NoError(t, err)
+
+	table := readParquet(t, buf)
+	require.Equal(t, int64(2), table.NumRows())
+
+	assert.Equal(t, "Sum", column(t, table, "type").(*array.String).Value(0))
+	assert.Equal(t, "Cumulative", column(t, table, "aggregation_temporality").(*array.String).Value(0))
+	isMonotonic := column(t, table, "is_monotonic").(*array.Boolean)
+	assert.True(t, isMonotonic.Value(0))
+	assert.True(t, isMonotonic.IsNull(1))
+
+	values := column(t, table, "value").(*array.Float64)
+	assert.Equal(t, float64(42), values.Value(0))
+	assert.True(t, values.IsNull(1))
+
+	counts := column(t, table, "count").(*array.Uint64)
+	assert.True(t, counts.IsNull(0))
+	assert.Equal(t, uint64(3), counts.Value(1))
+	assert.Equal(t, 12.5, column(t, table, "sum").(*array.Float64).Value(1))
+	assert.True(t, column(t, table, "min").IsNull(1))
+
+	bounds := column(t, table, "explicit_bounds").(*array.List)
+	start, end := bounds.ValueOffsets(1)
+	assert.Equal(t, []float64{1, 10}, bounds.ListValues().(*array.Float64).Float64Values()[start:end])
+	bucketCounts := column(t, table, "bucket_counts").(*array.List)
+	start, end = bucketCounts.ValueOffsets(1)
+	assert.Equal(t, []uint64{1, 1, 1}, bucketCounts.ListValues().(*array.Uint64).Uint64Values()[start:end])
+}
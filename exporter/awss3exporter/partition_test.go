@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestPartition(t *testing.T) {
+	tm := time.Date(2022, 6, 5, 7, 3, 0, 0, time.UTC)
+
+	p, err := newPartitioner("service={resource.service.name}/year={year}/month={month}/day={day}/hour={hour}/minute={minute}")
+	require.NoError(t, err)
+	assert.True(t, p.resourceAttributes)
+
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("service.name", "checkout/v2")
+	assert.Equal(t, "service=checkout%2Fv2/year=2022/month=06/day=05/hour=07/minute=03", p.partition(tm, res))
+
+	// the resources without the attribute are in the default partition
+	assert.Equal(t, "service=__HIVE_DEFAULT_PARTITION__/year=2022/month=06/day=05/hour=07/minute=03", p.partition(tm, pcommon.NewResource()))
+
+	p, err = newPartitioner("dt={year}-{month}-{day}")
+	require.NoError(t, err)
+	assert.False(t, p.resourceAttributes)
+	assert.Equal(t, "dt=2022-06-05", p.partition(tm, res))
+}
+
+func TestPartitionInvalidTemplate(t *testing.T) {
+	_, err := newPartitioner("year={year")
+	assert.EqualError(t, err, `unclosed placeholder in partition template "year={year"`)
+
+	_, err = newPartitioner("{resource.}")
+	assert.Error(t, err)
+}
+
+func TestPartitionLogs(t *testing.T) {
+	tm := time.Date(2022, 6, 5, 7, 3, 0, 0, time.UTC)
+	p, err := newPartitioner("service={resource.service.name}/hour={hour}")
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	for _, service := range []string{"checkout", "cart", "checkout"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", service)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(service)
+	}
+
+	partitions := p.partitionLogs(tm, ld)
+	require.Len(t, partitions, 2)
+	assert.Equal(t, 2, partitions["service=checkout/hour=07"].ResourceLogs().Len())
+	assert.Equal(t, 1, partitions["service=cart/hour=07"].ResourceLogs().Len())
+
+	// the logs are not split when the partitions don't depend on the resources
+	p, err = newPartitioner("hour={hour}")
+	require.NoError(t, err)
+	partitions = p.partitionLogs(tm, ld)
+	require.Len(t, partitions, 1)
+	assert.Equal(t, ld, partitions["hour=07"])
+}
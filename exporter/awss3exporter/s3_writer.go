@@ -38,10 +38,13 @@ func randomInRange(low, hi int) int {
 }
 
 func getS3Key(time time.Time, keyPrefix string, partition string, filePrefix string, metadata string, fileformat string) string {
-	timeKey := getTimeKey(time, partition)
+	return getPartitionS3Key(keyPrefix, getTimeKey(time, partition), filePrefix, metadata, fileformat)
+}
+
+func getPartitionS3Key(keyPrefix string, partitionKey string, filePrefix string, metadata string, fileformat string) string {
 	randomID := randomInRange(100000000, 999999999)
 
-	s3Key := keyPrefix + "/" + timeKey + "/" + filePrefix + metadata + "_" + strconv.Itoa(randomID) + "." + fileformat
+	s3Key := keyPrefix + "/" + partitionKey + "/" + filePrefix + metadata + "_" + strconv.Itoa(randomID) + "." + fileformat
 
 	return s3Key
 }
@@ -59,11 +62,16 @@ func getSessionConfig(config *Config) *aws.Config {
 	return sessionConfig
 }
 
-func (s3writer *s3Writer) writeBuffer(_ context.Context, buf []byte, config *Config, metadata string, format string) error {
-	now := time.Now()
-	key := getS3Key(now,
-		config.S3Uploader.S3Prefix, config.S3Uploader.S3Partition,
-		config.S3Uploader.FilePrefix, metadata, format)
+func (s3writer *s3Writer) writeBuffer(_ context.Context, buf []byte, config *Config, partition string, metadata string, format string) error {
+	var key string
+	if partition != "" {
+		key = getPartitionS3Key(config.S3Uploader.S3Prefix, partition,
+			config.S3Uploader.FilePrefix, metadata, format)
+	} else {
+		key = getS3Key(time.Now(),
+			config.S3Uploader.S3Prefix, config.S3Uploader.S3Partition,
+			config.S3Uploader.FilePrefix, metadata, format)
+	}
 
 	// create a reader from data data in memory
 	reader := bytes.NewReader(buf)
@@ -22,6 +22,12 @@ type Config struct {
 	IndexAllAttributes bool `mapstructure:"index_all_attributes"`
 
 	LogGroupNames []string `mapstructure:"aws_log_groups"`
+	// Set to true to export the spans whose trace IDs are not X-Ray trace IDs, such as the random trace IDs
+	// of W3C-native applications, instead of dropping them. Their 128-bit trace IDs are converted without
+	// validating the timestamp of their first 32 bits, and the original trace IDs of the spans and of their links
+	// are stored in the `otel` metadata namespace.
+	// Default value: false
+	TraceIDCompatibility bool `mapstructure:"trace_id_compatibility"`
 	// TelemetryConfig contains the options for telemetry collection.
 	TelemetryConfig telemetry.Config `mapstructure:"telemetry,omitempty"`
 }
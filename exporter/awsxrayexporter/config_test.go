@@ -45,9 +45,10 @@ func TestLoadConfig(t *testing.T) {
 					ResourceARN:           "arn:aws:ec2:us-east1:123456789:instance/i-293hiuhe0u",
 					RoleARN:               "arn:aws:iam::123456789:role/monitoring-EKS-NodeInstanceRole",
 				},
-				IndexedAttributes:  []string{"indexed_attr_0", "indexed_attr_1"},
-				IndexAllAttributes: false,
-				LogGroupNames:      []string{"group1", "group2"},
+				IndexedAttributes:    []string{"indexed_attr_0", "indexed_attr_1"},
+				IndexAllAttributes:   false,
+				LogGroupNames:        []string{"group1", "group2"},
+				TraceIDCompatibility: true,
 			},
 		},
 	}
@@ -55,6 +55,16 @@ const (
 const (
 	traceIDLength    = 35 // fixed length of aws trace id
 	identifierOffset = 11 // offset of identifier within traceID
+
+	// maxTraceIDAge of 28 days.  AWS has a 30 day limit, let's be conservative rather than
+	// hit the limit
+	maxTraceIDAge = 60 * 60 * 24 * 28
+	// maxTraceIDSkew allows for 5m of clock skew
+	maxTraceIDSkew = 60 * 5
+
+	// otelMetadataNamespace is the metadata namespace of the original trace IDs
+	// that are not X-Ray trace IDs
+	otelMetadataNamespace = "otel"
 )
 
 var (
@@ -62,8 +72,8 @@ var (
 )
 
 // MakeSegmentDocumentString converts an OpenTelemetry Span to an X-Ray Segment and then serialzies to JSON
-func MakeSegmentDocumentString(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string) (string, error) {
-	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs, logGroupNames)
+func MakeSegmentDocumentString(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, traceIDCompatibility bool) (string, error) {
+	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, traceIDCompatibility)
 	if err != nil {
 		return "", err
 	}
@@ -76,8 +86,12 @@ func MakeSegmentDocumentString(span ptrace.Span, resource pcommon.Resource, inde
 	return jsonStr, nil
 }
 
-// MakeSegment converts an OpenTelemetry Span to an X-Ray Segment
-func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string) (*awsxray.Segment, error) {
+// MakeSegment converts an OpenTelemetry Span to an X-Ray Segment.
+//
+// With traceIDCompatibility, the trace IDs that are not X-Ray trace IDs, such as the random
+// W3C trace IDs, are converted without validating their timestamp, and are kept in the
+// otel metadata namespace so that the traces remain connected.
+func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, traceIDCompatibility bool) (*awsxray.Segment, error) {
 	var segmentType string
 
 	storeResource := true
@@ -89,7 +103,7 @@ func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []str
 	}
 
 	// convert trace id
-	traceID, err := convertToAmazonTraceID(span.TraceID())
+	traceID, err := convertToAmazonTraceID(span.TraceID(), traceIDCompatibility)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +121,7 @@ func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []str
 		sqlfiltered, sql                                   = makeSQL(span, awsfiltered)
 		additionalAttrs                                    = addSpecialAttributes(sqlfiltered, indexedAttrs, attributes)
 		user, annotations, metadata                        = makeXRayAttributes(additionalAttrs, resource, storeResource, indexedAttrs, indexAllAttrs)
-		spanLinks, makeSpanLinkErr                         = makeSpanLinks(span.Links())
+		spanLinks, makeSpanLinkErr                         = makeSpanLinks(span.Links(), traceIDCompatibility)
 		name                                               string
 		namespace                                          string
 	)
@@ -205,6 +219,10 @@ func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []str
 		namespace = "remote"
 	}
 
+	if traceIDCompatibility {
+		metadata = addTraceIDMetadata(metadata, span)
+	}
+
 	return &awsxray.Segment{
 		ID:          awsxray.String(traceutil.SpanIDToHexOrEmptyString(span.SpanID())),
 		TraceID:     awsxray.String(traceID),
@@ -284,6 +302,13 @@ func determineAwsOrigin(resource pcommon.Resource) string {
 	return ""
 }
 
+// isAmazonTraceID returns whether the trace ID is an X-Ray trace ID, whose first
+// 4 bytes are the epoch of the original request within the accepted range.
+func isAmazonTraceID(traceID pcommon.TraceID) bool {
+	delta := time.Now().Unix() - int64(binary.BigEndian.Uint32(traceID[0:4]))
+	return delta <= maxTraceIDAge && delta >= -maxTraceIDSkew
+}
+
 // convertToAmazonTraceID converts a trace ID to the Amazon format.
 //
 // A trace ID unique identifier that connects all segments and subsegments
@@ -295,19 +320,12 @@ func determineAwsOrigin(resource pcommon.Resource) string {
 //   - For example, 10:00AM December 2nd, 2016 PST in epoch time is 1480615200 seconds,
 //     or 58406520 in hexadecimal.
 //   - A 96-bit identifier for the trace, globally unique, in 24 hexadecimal digits.
-func convertToAmazonTraceID(traceID pcommon.TraceID) (string, error) {
-	const (
-		// maxAge of 28 days.  AWS has a 30 day limit, let's be conservative rather than
-		// hit the limit
-		maxAge = 60 * 60 * 24 * 28
-
-		// maxSkew allows for 5m of clock skew
-		maxSkew = 60 * 5
-	)
-
+//
+// With skipTimestampValidation, the trace IDs whose first 4 bytes are not a valid epoch are
+// converted as well, keeping their 128 bits.
+func convertToAmazonTraceID(traceID pcommon.TraceID, skipTimestampValidation bool) (string, error) {
 	var (
 		content      = [traceIDLength]byte{}
-		epochNow     = time.Now().Unix()
 		traceIDBytes = traceID
 		epoch        = int64(binary.BigEndian.Uint32(traceIDBytes[0:4]))
 		b            = [4]byte{}
@@ -318,7 +336,7 @@ func convertToAmazonTraceID(traceID pcommon.TraceID) (string, error) {
 	// past 30 days.
 	//
 	// In that case, we return invalid traceid error
-	if delta := epochNow - epoch; delta > maxAge || delta < -maxSkew {
+	if !skipTimestampValidation && !isAmazonTraceID(traceID) {
 		return "", fmt.Errorf("invalid xray traceid: %s", traceID)
 	}
 
@@ -333,6 +351,38 @@ func convertToAmazonTraceID(traceID pcommon.TraceID) (string, error) {
 	return string(content[0:traceIDLength]), nil
 }
 
+// addTraceIDMetadata adds the original trace IDs of the span and of its links that are not
+// X-Ray trace IDs to the otel metadata namespace, so that they can be correlated with the
+// traces of the W3C-native applications.
+func addTraceIDMetadata(metadata map[string]map[string]interface{}, span ptrace.Span) map[string]map[string]interface{} {
+	otelMetadata := map[string]interface{}{}
+	if !isAmazonTraceID(span.TraceID()) {
+		otelMetadata["trace_id"] = span.TraceID().String()
+	}
+
+	var links []interface{}
+	for i := 0; i < span.Links().Len(); i++ {
+		if link := span.Links().At(i); !isAmazonTraceID(link.TraceID()) {
+			links = append(links, map[string]interface{}{
+				"trace_id": link.TraceID().String(),
+				"span_id":  link.SpanID().String(),
+			})
+		}
+	}
+	if len(links) > 0 {
+		otelMetadata["links"] = links
+	}
+
+	if len(otelMetadata) == 0 {
+		return metadata
+	}
+	if metadata == nil {
+		metadata = map[string]map[string]interface{}{}
+	}
+	metadata[otelMetadataNamespace] = otelMetadata
+	return metadata
+}
+
 func timestampToFloatSeconds(ts pcommon.Timestamp) float64 {
 	return float64(ts) / float64(time.Second)
 }
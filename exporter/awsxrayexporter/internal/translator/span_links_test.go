@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -26,16 +27,16 @@ func TestSpanLinkSimple(t *testing.T) {
 	spanLink.SetTraceID(traceID)
 	spanLink.SetSpanID(newSegmentID())
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
 
-	var convertedTraceID, _ = convertToAmazonTraceID(traceID)
+	var convertedTraceID, _ = convertToAmazonTraceID(traceID, false)
 
 	assert.Equal(t, 1, len(segment.Links))
 	assert.Equal(t, spanLink.SpanID().String(), *segment.Links[0].SpanID)
 	assert.Equal(t, convertedTraceID, *segment.Links[0].TraceID)
 	assert.Equal(t, 0, len(segment.Links[0].Attributes))
 
-	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil)
+	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
 
 	assert.True(t, strings.Contains(jsonStr, "links"))
 	assert.False(t, strings.Contains(jsonStr, "attributes"))
@@ -50,11 +51,11 @@ func TestSpanLinkEmpty(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeOk, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
 
 	assert.Equal(t, 0, len(segment.Links))
 
-	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil)
+	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
 
 	assert.False(t, strings.Contains(jsonStr, "links"))
 }
@@ -76,15 +77,47 @@ func TestOldSpanLinkError(t *testing.T) {
 	spanLink.SetTraceID(traceID)
 	spanLink.SetSpanID(newSegmentID())
 
-	_, error1 := MakeSegment(span, resource, nil, false, nil)
+	_, error1 := MakeSegment(span, resource, nil, false, nil, false)
 
 	assert.NotNil(t, error1)
 
-	_, error2 := MakeSegmentDocumentString(span, resource, nil, false, nil)
+	_, error2 := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
 
 	assert.NotNil(t, error2)
 }
 
+func TestOldSpanLinkTraceIDCompatibility(t *testing.T) {
+	spanName := "ProcessingMessage"
+	parentSpanID := newSegmentID()
+	attributes := make(map[string]interface{})
+	resource := constructDefaultResource()
+	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeOk, "OK", attributes)
+
+	const maxAge = 60 * 60 * 24 * 30
+	ExpiredEpoch := time.Now().Unix() - maxAge - 1
+
+	var traceID = newTraceID()
+	binary.BigEndian.PutUint32(traceID[0:4], uint32(ExpiredEpoch))
+
+	spanLink := span.Links().AppendEmpty()
+	spanLink.SetTraceID(traceID)
+	spanLink.SetSpanID(newSegmentID())
+
+	segment, err := MakeSegment(span, resource, nil, false, nil, true)
+	require.NoError(t, err)
+
+	var convertedTraceID, _ = convertToAmazonTraceID(traceID, true)
+	require.Len(t, segment.Links, 1)
+	assert.Equal(t, convertedTraceID, *segment.Links[0].TraceID)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"trace_id": traceID.String(),
+			"span_id":  spanLink.SpanID().String(),
+		},
+	}, segment.Metadata["otel"]["links"])
+	assert.NotContains(t, segment.Metadata["otel"], "trace_id", "Must not store the X-Ray trace id of the span")
+}
+
 func TestTwoSpanLinks(t *testing.T) {
 	spanName := "ProcessingMessage"
 	parentSpanID := newSegmentID()
@@ -106,10 +139,10 @@ func TestTwoSpanLinks(t *testing.T) {
 	spanLink2.SetSpanID(newSegmentID())
 	spanLink2.Attributes().PutInt("myKey2", 1234)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
 
-	var convertedTraceID1, _ = convertToAmazonTraceID(traceID1)
-	var convertedTraceID2, _ = convertToAmazonTraceID(traceID2)
+	var convertedTraceID1, _ = convertToAmazonTraceID(traceID1, false)
+	var convertedTraceID2, _ = convertToAmazonTraceID(traceID2, false)
 
 	assert.Equal(t, 2, len(segment.Links))
 	assert.Equal(t, spanLink1.SpanID().String(), *segment.Links[0].SpanID)
@@ -123,7 +156,7 @@ func TestTwoSpanLinks(t *testing.T) {
 	assert.Equal(t, 1, len(segment.Links[0].Attributes))
 	assert.Equal(t, int64(1234), segment.Links[1].Attributes["myKey2"])
 
-	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil)
+	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
 
 	assert.True(t, strings.Contains(jsonStr, "attributes"))
 	assert.True(t, strings.Contains(jsonStr, "links"))
@@ -170,7 +203,7 @@ func TestSpanLinkComplexAttributes(t *testing.T) {
 	slice4.AppendEmpty().SetDouble(2.718)
 	slice4.AppendEmpty().SetDouble(1.618)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
 
 	assert.Equal(t, 1, len(segment.Links))
 	assert.Equal(t, 8, len(segment.Links[0].Attributes))
@@ -196,7 +229,7 @@ func TestSpanLinkComplexAttributes(t *testing.T) {
 	assert.Equal(t, 2.718, segment.Links[0].Attributes["myKey8"].([]interface{})[0])
 	assert.Equal(t, 1.618, segment.Links[0].Attributes["myKey8"].([]interface{})[1])
 
-	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil)
+	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
 
 	assert.True(t, strings.Contains(jsonStr, "links"))
 
@@ -27,7 +27,7 @@ func TestWriterPoolBasic(t *testing.T) {
 	assert.Equal(t, size, w.buffer.Cap())
 	assert.Equal(t, 0, w.buffer.Len())
 	resource := pcommon.NewResource()
-	segment, _ := MakeSegment(span, resource, nil, false, nil)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
 	require.NoError(t, w.Encode(*segment))
 	jsonStr := w.String()
 	assert.Equal(t, len(jsonStr), w.buffer.Len())
@@ -42,7 +42,7 @@ func BenchmarkWithoutPool(b *testing.B) {
 		b.StartTimer()
 		buffer := bytes.NewBuffer(make([]byte, 0, 2048))
 		encoder := json.NewEncoder(buffer)
-		segment, _ := MakeSegment(span, pcommon.NewResource(), nil, false, nil)
+		segment, _ := MakeSegment(span, pcommon.NewResource(), nil, false, nil, false)
 		err := encoder.Encode(*segment)
 		assert.NoError(b, err)
 		logger.Info(buffer.String())
@@ -57,7 +57,7 @@ func BenchmarkWithPool(b *testing.B) {
 		span := constructWriterPoolSpan()
 		b.StartTimer()
 		w := wp.borrow()
-		segment, _ := MakeSegment(span, pcommon.NewResource(), nil, false, nil)
+		segment, _ := MakeSegment(span, pcommon.NewResource(), nil, false, nil, false)
 		err := w.Encode(*segment)
 		assert.Nil(b, err)
 		logger.Info(w.String())
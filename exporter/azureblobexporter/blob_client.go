@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+)
+
+// blobClient stages and commits the blocks of the block blobs.
+type blobClient interface {
+	stageBlock(ctx context.Context, container string, blob string, blockID string, data []byte) error
+	commitBlockList(ctx context.Context, container string, blob string, blockIDs []string) error
+}
+
+type azureBlobClient struct {
+	client *azblob.Client
+}
+
+var _ blobClient = (*azureBlobClient)(nil)
+
+func newAzureBlobClient(cfg *Config) (blobClient, error) {
+	var client *azblob.Client
+	var err error
+	switch cfg.Auth.Type {
+	case ConnectionString:
+		client, err = azblob.NewClientFromConnectionString(string(cfg.Auth.ConnectionString), nil)
+	case SAS:
+		client, err = azblob.NewClientWithNoCredential(sasURL(cfg.URL, string(cfg.Auth.SASToken)), nil)
+	case ManagedIdentity:
+		options := &azidentity.ManagedIdentityCredentialOptions{}
+		if cfg.Auth.ClientID != "" {
+			options.ID = azidentity.ClientID(cfg.Auth.ClientID)
+		}
+		var cred *azidentity.ManagedIdentityCredential
+		if cred, err = azidentity.NewManagedIdentityCredential(options); err != nil {
+			return nil, fmt.Errorf("failed to create the managed identity credential: %w", err)
+		}
+		client, err = azblob.NewClient(cfg.URL, cred, nil)
+	default:
+		return nil, fmt.Errorf("unknown auth type %q", cfg.Auth.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the blob storage client: %w", err)
+	}
+	return &azureBlobClient{client: client}, nil
+}
+
+// sasURL returns the service URL authenticated by the shared access signature token.
+func sasURL(serviceURL string, token string) string {
+	token = strings.TrimPrefix(token, "?")
+	if strings.Contains(serviceURL, "?") {
+		return serviceURL + "&" + token
+	}
+	return serviceURL + "?" + token
+}
+
+func (c *azureBlobClient) stageBlock(ctx context.Context, container string, blob string, blockID string, data []byte) error {
+	_, err := c.client.ServiceClient().NewContainerClient(container).NewBlockBlobClient(blob).
+		StageBlock(ctx, blockID, streaming.NopCloser(bytes.NewReader(data)), nil)
+	return err
+}
+
+func (c *azureBlobClient) commitBlockList(ctx context.Context, container string, blob string, blockIDs []string) error {
+	_, err := c.client.ServiceClient().NewContainerClient(container).NewBlockBlobClient(blob).
+		CommitBlockList(ctx, blockIDs, nil)
+	return err
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.uber.org/multierr"
+)
+
+// AuthType is the way the exporter authenticates to the storage account.
+type AuthType string
+
+const (
+	// ConnectionString authenticates with the connection string of the storage account.
+	ConnectionString AuthType = "connection_string"
+	// SAS authenticates with a shared access signature token.
+	SAS AuthType = "sas"
+	// ManagedIdentity authenticates with the system or user assigned managed identity of the host.
+	ManagedIdentity AuthType = "managed_identity"
+)
+
+// MarshalerType is the format of the telemetry written to the blobs.
+type MarshalerType string
+
+const (
+	// OtlpJSON writes each batch as a line of OTLP JSON, the blobs being JSON Lines files.
+	OtlpJSON MarshalerType = "otlp_json"
+	// OtlpProto writes each batch as an OTLP protobuf message. The concatenated messages
+	// of a blob are read as a single OTLP export request.
+	OtlpProto MarshalerType = "otlp_proto"
+)
+
+// AuthConfig defines how the exporter authenticates to the storage account.
+type AuthConfig struct {
+	// Type is one of connection_string, sas or managed_identity.
+	Type AuthType `mapstructure:"type"`
+	// ConnectionString is the connection string of the storage account, for the connection_string type.
+	ConnectionString configopaque.String `mapstructure:"connection_string"`
+	// SASToken is the shared access signature token, for the sas type.
+	SASToken configopaque.String `mapstructure:"sas_token"`
+	// ClientID is the client ID of the user assigned identity, for the managed_identity type.
+	// The system assigned identity is used when it is empty.
+	ClientID string `mapstructure:"client_id"`
+}
+
+// ContainerConfig defines the containers of the blobs of each signal.
+type ContainerConfig struct {
+	Logs    string `mapstructure:"logs"`
+	Metrics string `mapstructure:"metrics"`
+	Traces  string `mapstructure:"traces"`
+}
+
+// BlobConfig defines the names of the blobs and when a new blob is started.
+type BlobConfig struct {
+	// Prefix is the virtual directory of the blobs in their container.
+	Prefix string `mapstructure:"prefix"`
+	// PartitionTemplate is the template of the virtual path between the prefix and the blob
+	// name. It can contain the {year}, {month}, {day}, {hour} and {minute} placeholders,
+	// replaced by the UTC time of the write, and {resource.<attribute>} placeholders, replaced
+	// by the value of the resource attribute.
+	PartitionTemplate string `mapstructure:"partition_template"`
+	// MaxSize is the size in bytes after which a new blob is started.
+	MaxSize int64 `mapstructure:"max_size"`
+	// MaxAge is the duration after which a new blob is started, whatever its size.
+	MaxAge time.Duration `mapstructure:"max_age"`
+}
+
+// Config defines the configuration of the Azure Blob Storage exporter.
+type Config struct {
+	exporterhelper.TimeoutSettings `mapstructure:",squash"`
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
+
+	// URL is the blob service URL of the storage account, such as
+	// https://<account>.blob.core.windows.net. It is not used with a connection string.
+	URL       string          `mapstructure:"url"`
+	Auth      AuthConfig      `mapstructure:"auth"`
+	Container ContainerConfig `mapstructure:"container"`
+	Blob      BlobConfig      `mapstructure:"blob"`
+	Marshaler MarshalerType   `mapstructure:"marshaler"`
+}
+
+func (c *Config) Validate() error {
+	var errs error
+	switch c.Auth.Type {
+	case ConnectionString:
+		if c.Auth.ConnectionString == "" {
+			errs = multierr.Append(errs, errors.New("connection_string is required with the connection_string auth type"))
+		}
+	case SAS:
+		if c.Auth.SASToken == "" {
+			errs = multierr.Append(errs, errors.New("sas_token is required with the sas auth type"))
+		}
+	case ManagedIdentity:
+	default:
+		errs = multierr.Append(errs, fmt.Errorf("unknown auth type %q, expected one of connection_string, sas or managed_identity", c.Auth.Type))
+	}
+	if c.Auth.Type != ConnectionString && c.URL == "" {
+		errs = multierr.Append(errs, fmt.Errorf("url is required with the %s auth type", c.Auth.Type))
+	}
+	if c.Container.Logs == "" || c.Container.Metrics == "" || c.Container.Traces == "" {
+		errs = multierr.Append(errs, errors.New("the containers of the logs, metrics and traces are required"))
+	}
+	if _, err := newPartitioner(c.Blob.PartitionTemplate); err != nil {
+		errs = multierr.Append(errs, err)
+	}
+	if c.Blob.MaxSize <= 0 {
+		errs = multierr.Append(errs, errors.New("max_size must be positive"))
+	}
+	if c.Blob.MaxAge <= 0 {
+		errs = multierr.Append(errs, errors.New("max_age must be positive"))
+	}
+	switch c.Marshaler {
+	case OtlpJSON, OtlpProto:
+	case "parquet":
+		// a Parquet file ends with its footer, the batches can't be appended to the blobs
+		errs = multierr.Append(errs, errors.New("the parquet marshaler is not supported, the blobs are appended with each batch"))
+	default:
+		errs = multierr.Append(errs, fmt.Errorf("unknown marshaler %q, expected one of otlp_json or otlp_proto", c.Marshaler))
+	}
+	return errs
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	timeout := exporterhelper.NewDefaultTimeoutSettings()
+	timeout.Timeout = 10 * time.Second
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+		errorMsg string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
+				QueueSettings:   exporterhelper.NewDefaultQueueSettings(),
+				RetrySettings:   exporterhelper.NewDefaultRetrySettings(),
+				Auth: AuthConfig{
+					Type:             ConnectionString,
+					ConnectionString: "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=a2V5;EndpointSuffix=core.windows.net",
+				},
+				Container: ContainerConfig{Logs: "logs", Metrics: "metrics", Traces: "traces"},
+				Blob: BlobConfig{
+					PartitionTemplate: "year={year}/month={month}/day={day}/hour={hour}",
+					MaxSize:           64 * 1024 * 1024,
+					MaxAge:            5 * time.Minute,
+				},
+				Marshaler: OtlpJSON,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sas"),
+			expected: &Config{
+				TimeoutSettings: timeout,
+				QueueSettings:   exporterhelper.NewDefaultQueueSettings(),
+				RetrySettings:   exporterhelper.NewDefaultRetrySettings(),
+				URL:             "https://account.blob.core.windows.net",
+				Auth: AuthConfig{
+					Type:     SAS,
+					SASToken: "sv=2022-11-02&ss=b&srt=co&sp=wac&sig=c2ln",
+				},
+				Container: ContainerConfig{Logs: "archive-logs", Metrics: "archive-metrics", Traces: "archive-traces"},
+				Blob: BlobConfig{
+					Prefix:            "otel",
+					PartitionTemplate: "service={resource.service.name}/dt={year}-{month}-{day}",
+					MaxSize:           1048576,
+					MaxAge:            time.Minute,
+				},
+				Marshaler: OtlpProto,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "managed_identity"),
+			expected: &Config{
+				TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
+				QueueSettings:   exporterhelper.NewDefaultQueueSettings(),
+				RetrySettings:   exporterhelper.NewDefaultRetrySettings(),
+				URL:             "https://account.blob.core.windows.net",
+				Auth: AuthConfig{
+					Type:     ManagedIdentity,
+					ClientID: "00000000-0000-0000-0000-000000000000",
+				},
+				Container: ContainerConfig{Logs: "logs", Metrics: "metrics", Traces: "traces"},
+				Blob: BlobConfig{
+					PartitionTemplate: "year={year}/month={month}/day={day}/hour={hour}",
+					MaxSize:           64 * 1024 * 1024,
+					MaxAge:            5 * time.Minute,
+				},
+				Marshaler: OtlpJSON,
+			},
+		},
+		{
+			id:       component.NewIDWithName(metadata.Type, "missing_url"),
+			errorMsg: "url is required with the managed_identity auth type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.errorMsg != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMsg)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Auth.Type = SAS
+	cfg.Container.Traces = ""
+	cfg.Blob.PartitionTemplate = "{week}"
+	cfg.Blob.MaxSize = 0
+	cfg.Blob.MaxAge = 0
+	cfg.Marshaler = "parquet"
+
+	assert.EqualError(t, cfg.Validate(), "sas_token is required with the sas auth type; "+
+		"url is required with the sas auth type; "+
+		"the containers of the logs, metrics and traces are required; "+
+		`unknown placeholder "week" in partition template "{week}", expected one of year, month, day, hour, minute or resource.<attribute>; `+
+		"max_size must be positive; "+
+		"max_age must be positive; "+
+		"the parquet marshaler is not supported, the blobs are appended with each batch")
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.Auth.ConnectionString = "UseDevelopmentStorage=true"
+	cfg.Marshaler = "avro"
+	assert.EqualError(t, cfg.Validate(), `unknown marshaler "avro", expected one of otlp_json or otlp_proto`)
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.Auth.Type = "shared_key"
+	assert.EqualError(t, cfg.Validate(), `unknown auth type "shared_key", expected one of connection_string, sas or managed_identity; `+
+		`url is required with the shared_key auth type`)
+}
+
+func TestSASURL(t *testing.T) {
+	assert.Equal(t, "https://account.blob.core.windows.net?sv=1&sig=2", sasURL("https://account.blob.core.windows.net", "?sv=1&sig=2"))
+	assert.Equal(t, "https://account.blob.core.windows.net?a=b&sv=1&sig=2", sasURL("https://account.blob.core.windows.net?a=b", "sv=1&sig=2"))
+}
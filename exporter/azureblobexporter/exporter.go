@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+type blobExporter struct {
+	config      *Config
+	logger      *zap.Logger
+	marshaler   *marshaler
+	partitioner *partitioner
+	newClient   func(cfg *Config) (blobClient, error)
+	writer      *blobWriter
+}
+
+func newBlobExporter(config *Config, params exporter.CreateSettings) (*blobExporter, error) {
+	m, err := newMarshaler(config.Marshaler)
+	if err != nil {
+		return nil, err
+	}
+	p, err := newPartitioner(config.Blob.PartitionTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return &blobExporter{
+		config:      config,
+		logger:      params.Logger,
+		marshaler:   m,
+		partitioner: p,
+		newClient:   newAzureBlobClient,
+	}, nil
+}
+
+func (e *blobExporter) start(_ context.Context, _ component.Host) error {
+	client, err := e.newClient(e.config)
+	if err != nil {
+		return err
+	}
+	e.writer = newBlobWriter(client, e.config, e.marshaler.extension)
+	return nil
+}
+
+// consumeLogs writes the partitions independently, so that only the partitions which failed to be
+// written are retried. The partitions which failed to be marshaled are dropped, as their permanent
+// errors would prevent the retry of the other partitions.
+func (e *blobExporter) consumeLogs(ctx context.Context, ld plog.Logs) error {
+	var errs, permanentErrs error
+	failed := plog.NewLogs()
+	for partition, logs := range e.partitioner.partitionLogs(time.Now().UTC(), ld) {
+		buf, err := e.marshaler.marshalLogs(logs)
+		if err != nil {
+			e.logDropped(partition, err, logs.LogRecordCount())
+			permanentErrs = multierr.Append(permanentErrs, consumererror.NewPermanent(err))
+			continue
+		}
+		if err = e.writer.write(ctx, e.config.Container.Logs, partition, "logs", buf); err != nil {
+			errs = multierr.Append(errs, err)
+			logs.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+		}
+	}
+	if errs == nil {
+		return permanentErrs
+	}
+	return consumererror.NewLogs(errs, failed)
+}
+
+// consumeMetrics writes the partitions independently, as consumeLogs.
+func (e *blobExporter) consumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	var errs, permanentErrs error
+	failed := pmetric.NewMetrics()
+	for partition, metrics := range e.partitioner.partitionMetrics(time.Now().UTC(), md) {
+		buf, err := e.marshaler.marshalMetrics(metrics)
+		if err != nil {
+			e.logDropped(partition, err, metrics.DataPointCount())
+			permanentErrs = multierr.Append(permanentErrs, consumererror.NewPermanent(err))
+			continue
+		}
+		if err = e.writer.write(ctx, e.config.Container.Metrics, partition, "metrics", buf); err != nil {
+			errs = multierr.Append(errs, err)
+			metrics.ResourceMetrics().MoveAndAppendTo(failed.ResourceMetrics())
+		}
+	}
+	if errs == nil {
+		return permanentErrs
+	}
+	return consumererror.NewMetrics(errs, failed)
+}
+
+// consumeTraces writes the partitions independently, as consumeLogs.
+func (e *blobExporter) consumeTraces(ctx context.Context, td ptrace.Traces) error {
+	var errs, permanentErrs error
+	failed := ptrace.NewTraces()
+	for partition, traces := range e.partitioner.partitionTraces(time.Now().UTC(), td) {
+		buf, err := e.marshaler.marshalTraces(traces)
+		if err != nil {
+			e.logDropped(partition, err, traces.SpanCount())
+			permanentErrs = multierr.Append(permanentErrs, consumererror.NewPermanent(err))
+			continue
+		}
+		if err = e.writer.write(ctx, e.config.Container.Traces, partition, "traces", buf); err != nil {
+			errs = multierr.Append(errs, err)
+			traces.ResourceSpans().MoveAndAppendTo(failed.ResourceSpans())
+		}
+	}
+	if errs == nil {
+		return permanentErrs
+	}
+	return consumererror.NewTraces(errs, failed)
+}
+
+func (e *blobExporter) logDropped(partition string, err error, items int) {
+	e.logger.Error("Exporting the partition failed. The error is not retryable. Dropping data.",
+		zap.String("partition", partition),
+		zap.Error(err),
+		zap.Int("dropped_items", items))
+}
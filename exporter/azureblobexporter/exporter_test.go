@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestExporter(t *testing.T, cfg *Config, client blobClient) *blobExporter {
+	exp, err := newBlobExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	exp.newClient = func(*Config) (blobClient, error) { return client, nil }
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	return exp
+}
+
+func testLogs(services ...string) plog.Logs {
+	ld := plog.NewLogs()
+	for _, service := range services {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", service)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("message of " + service)
+	}
+	return ld
+}
+
+func TestConsumeLogsJSON(t *testing.T) {
+	client := newFakeBlobClient()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Blob.PartitionTemplate = "service={resource.service.name}"
+	exp := newTestExporter(t, cfg, client)
+
+	require.NoError(t, exp.consumeLogs(context.Background(), testLogs("checkout", "cart")))
+	require.NoError(t, exp.consumeLogs(context.Background(), testLogs("checkout")))
+
+	blobs := client.committedBlobs()
+	require.Len(t, blobs, 2)
+	assert.True(t, strings.HasPrefix(blobs[0], "logs/service=cart/logs_"))
+	assert.True(t, strings.HasPrefix(blobs[1], "logs/service=checkout/logs_"))
+	assert.True(t, strings.HasSuffix(blobs[1], ".jsonl"))
+
+	// each batch is a line of the blob
+	lines := bytes.Split(bytes.TrimSuffix(client.committed[blobs[1]], []byte("\n")), []byte("\n"))
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		ld, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(line)
+		require.NoError(t, err)
+		assert.Equal(t, 1, ld.LogRecordCount())
+	}
+}
+
+func TestConsumeProto(t *testing.T) {
+	client := newFakeBlobClient()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Marshaler = OtlpProto
+	cfg.Blob.PartitionTemplate = ""
+	exp := newTestExporter(t, cfg, client)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+
+	require.NoError(t, exp.consumeTraces(context.Background(), td))
+	require.NoError(t, exp.consumeTraces(context.Background(), td))
+	require.NoError(t, exp.consumeMetrics(context.Background(), md))
+
+	blobs := client.committedBlobs()
+	require.Len(t, blobs, 2)
+	assert.Regexp(t, `^metrics/metrics_\d{8}T\d{6}Z_[0-9a-f]{8}\.binpb$`, blobs[0])
+	assert.Regexp(t, `^traces/traces_\d{8}T\d{6}Z_[0-9a-f]{8}\.binpb$`, blobs[1])
+
+	// the concatenated messages are read as a single request
+	traces, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(client.committed[blobs[1]])
+	require.NoError(t, err)
+	assert.Equal(t, 2, traces.ResourceSpans().Len())
+	assert.Equal(t, 2, traces.SpanCount())
+
+	metrics, err := (&pmetric.ProtoUnmarshaler{}).UnmarshalMetrics(client.committed[blobs[0]])
+	require.NoError(t, err)
+	assert.Equal(t, 1, metrics.DataPointCount())
+}
+
+func TestConsumeStageError(t *testing.T) {
+	client := newFakeBlobClient()
+	client.stageErr = assert.AnError
+	exp := newTestExporter(t, createDefaultConfig().(*Config), client)
+
+	assert.ErrorIs(t, exp.consumeLogs(context.Background(), testLogs("checkout")), assert.AnError)
+	assert.Empty(t, client.committedBlobs())
+}
+
+// failingBlobClient fails the stage of the blocks of the blobs in the virtual directory.
+type failingBlobClient struct {
+	*fakeBlobClient
+	dir string
+}
+
+func (c *failingBlobClient) stageBlock(ctx context.Context, container string, blob string, blockID string, data []byte) error {
+	if strings.HasPrefix(blob, c.dir+"/") {
+		return assert.AnError
+	}
+	return c.fakeBlobClient.stageBlock(ctx, container, blob, blockID, data)
+}
+
+func TestConsumePartitionError(t *testing.T) {
+	client := &failingBlobClient{fakeBlobClient: newFakeBlobClient(), dir: "service=cart"}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Blob.PartitionTemplate = "service={resource.service.name}"
+	exp := newTestExporter(t, cfg, client)
+
+	err := exp.consumeLogs(context.Background(), testLogs("checkout", "cart", "payment"))
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.False(t, consumererror.IsPermanent(err))
+
+	// only the failed partition is retried
+	var logsErr consumererror.Logs
+	require.ErrorAs(t, err, &logsErr)
+	failed := logsErr.Data()
+	require.Equal(t, 1, failed.ResourceLogs().Len())
+	service, _ := failed.ResourceLogs().At(0).Resource().Attributes().Get("service.name")
+	assert.Equal(t, "cart", service.Str())
+
+	blobs := client.committedBlobs()
+	require.Len(t, blobs, 2)
+	assert.True(t, strings.HasPrefix(blobs[0], "logs/service=checkout/logs_"))
+	assert.True(t, strings.HasPrefix(blobs[1], "logs/service=payment/logs_"))
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter/internal/metadata"
+)
+
+const (
+	defaultPartitionTemplate = "year={year}/month={month}/day={day}/hour={hour}"
+	defaultMaxSize           = 64 * 1024 * 1024
+	defaultMaxAge            = 5 * time.Minute
+)
+
+// NewFactory creates a factory for the Azure Blob Storage exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
+		QueueSettings:   exporterhelper.NewDefaultQueueSettings(),
+		RetrySettings:   exporterhelper.NewDefaultRetrySettings(),
+		Auth: AuthConfig{
+			Type: ConnectionString,
+		},
+		Container: ContainerConfig{
+			Logs:    "logs",
+			Metrics: "metrics",
+			Traces:  "traces",
+		},
+		Blob: BlobConfig{
+			PartitionTemplate: defaultPartitionTemplate,
+			MaxSize:           defaultMaxSize,
+			MaxAge:            defaultMaxAge,
+		},
+		Marshaler: OtlpJSON,
+	}
+}
+
+func createLogsExporter(ctx context.Context,
+	params exporter.CreateSettings,
+	config component.Config) (exporter.Logs, error) {
+	cfg := config.(*Config)
+	exp, err := newBlobExporter(cfg, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return exporterhelper.NewLogsExporter(ctx, params,
+		config,
+		exp.consumeLogs,
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(cfg.TimeoutSettings),
+		exporterhelper.WithRetry(cfg.RetrySettings),
+		exporterhelper.WithQueue(cfg.QueueSettings))
+}
+
+func createMetricsExporter(ctx context.Context,
+	params exporter.CreateSettings,
+	config component.Config) (exporter.Metrics, error) {
+	cfg := config.(*Config)
+	exp, err := newBlobExporter(cfg, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return exporterhelper.NewMetricsExporter(ctx, params,
+		config,
+		exp.consumeMetrics,
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(cfg.TimeoutSettings),
+		exporterhelper.WithRetry(cfg.RetrySettings),
+		exporterhelper.WithQueue(cfg.QueueSettings))
+}
+
+func createTracesExporter(ctx context.Context,
+	params exporter.CreateSettings,
+	config component.Config) (exporter.Traces, error) {
+	cfg := config.(*Config)
+	exp, err := newBlobExporter(cfg, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return exporterhelper.NewTracesExporter(ctx, params,
+		config,
+		exp.consumeTraces,
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(cfg.TimeoutSettings),
+		exporterhelper.WithRetry(cfg.RetrySettings),
+		exporterhelper.WithQueue(cfg.QueueSettings))
+}
@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	cfg := createDefaultConfig()
+	assert.NotNil(t, cfg, "failed to create default config")
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+}
+
+func TestCreateExporters(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Auth.ConnectionString = "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=a2V5;EndpointSuffix=core.windows.net"
+	params := exportertest.NewNopCreateSettings()
+
+	logs, err := createLogsExporter(context.Background(), params, cfg)
+	require.NoError(t, err)
+	metrics, err := createMetricsExporter(context.Background(), params, cfg)
+	require.NoError(t, err)
+	traces, err := createTracesExporter(context.Background(), params, cfg)
+	require.NoError(t, err)
+
+	// the client is created at start without connecting to the storage account
+	for _, exp := range []component.Component{logs, metrics, traces} {
+		require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	}
+}
+
+func TestStartInvalidConnectionString(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Auth.ConnectionString = "invalid"
+
+	exp, err := createLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.ErrorContains(t, exp.Start(context.Background(), componenttest.NewNopHost()), "failed to create the blob storage client")
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// marshaler marshals the batches into the blocks appended to the blobs.
+type marshaler struct {
+	logsMarshaler    plog.Marshaler
+	metricsMarshaler pmetric.Marshaler
+	tracesMarshaler  ptrace.Marshaler
+	// separator is appended to each marshaled batch
+	separator []byte
+	// extension is the file extension of the blob names
+	extension string
+}
+
+func newMarshaler(mType MarshalerType) (*marshaler, error) {
+	switch mType {
+	case OtlpJSON:
+		return &marshaler{
+			logsMarshaler:    &plog.JSONMarshaler{},
+			metricsMarshaler: &pmetric.JSONMarshaler{},
+			tracesMarshaler:  &ptrace.JSONMarshaler{},
+			separator:        []byte("\n"),
+			extension:        "jsonl",
+		}, nil
+	case OtlpProto:
+		// the concatenation of serialized protobuf messages is the serialization of their
+		// merge, which concatenates the repeated resource fields of the export requests
+		return &marshaler{
+			logsMarshaler:    &plog.ProtoMarshaler{},
+			metricsMarshaler: &pmetric.ProtoMarshaler{},
+			tracesMarshaler:  &ptrace.ProtoMarshaler{},
+			extension:        "binpb",
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown marshaler %q", mType)
+}
+
+func (m *marshaler) marshalLogs(ld plog.Logs) ([]byte, error) {
+	buf, err := m.logsMarshaler.MarshalLogs(ld)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, m.separator...), nil
+}
+
+func (m *marshaler) marshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	buf, err := m.metricsMarshaler.MarshalMetrics(md)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, m.separator...), nil
+}
+
+func (m *marshaler) marshalTraces(td ptrace.Traces) ([]byte, error) {
+	buf, err := m.tracesMarshaler.MarshalTraces(td)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, m.separator...), nil
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	resourcePlaceholderPrefix = "resource."
+
+	// defaultPartitionValue is the value of the partitions of the resources without the
+	// attribute, which Hive and Spark read as a null value.
+	defaultPartitionValue = "__HIVE_DEFAULT_PARTITION__"
+)
+
+// partitionSegment is either a literal part of the partition template, or a placeholder
+// replaced by a part of the time or by the value of a resource attribute.
+type partitionSegment struct {
+	literal string
+	// timeFormat formats the time for the time placeholders
+	timeFormat func(time.Time) string
+	// resourceAttribute is the resource attribute of the resource placeholders
+	resourceAttribute string
+}
+
+var timePlaceholders = map[string]func(time.Time) string{
+	"year":   func(t time.Time) string { return fmt.Sprintf("%d", t.Year()) },
+	"month":  func(t time.Time) string { return fmt.Sprintf("%02d", t.Month()) },
+	"day":    func(t time.Time) string { return fmt.Sprintf("%02d", t.Day()) },
+	"hour":   func(t time.Time) string { return fmt.Sprintf("%02d", t.Hour()) },
+	"minute": func(t time.Time) string { return fmt.Sprintf("%02d", t.Minute()) },
+}
+
+// partitioner renders the partition part of the blob names from the partition template.
+type partitioner struct {
+	segments           []partitionSegment
+	resourceAttributes bool
+}
+
+// newPartitioner parses a partition template such as
+// "service={resource.service.name}/year={year}/month={month}/day={day}/hour={hour}".
+func newPartitioner(template string) (*partitioner, error) {
+	p := &partitioner{}
+	rest := template
+	for rest != "" {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			p.segments = append(p.segments, partitionSegment{literal: rest})
+			break
+		}
+		if start > 0 {
+			p.segments = append(p.segments, partitionSegment{literal: rest[:start]})
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed placeholder in partition template %q", template)
+		}
+		placeholder := rest[start+1 : start+end]
+		rest = rest[start+end+1:]
+
+		if format, ok := timePlaceholders[placeholder]; ok {
+			p.segments = append(p.segments, partitionSegment{timeFormat: format})
+			continue
+		}
+		if attr := strings.TrimPrefix(placeholder, resourcePlaceholderPrefix); attr != placeholder && attr != "" {
+			p.segments = append(p.segments, partitionSegment{resourceAttribute: attr})
+			p.resourceAttributes = true
+			continue
+		}
+		return nil, fmt.Errorf("unknown placeholder %q in partition template %q, expected one of year, month, day, hour, minute or resource.<attribute>", placeholder, template)
+	}
+	return p, nil
+}
+
+// partition renders the partition of the data of the given resource uploaded at the given time.
+func (p *partitioner) partition(t time.Time, res pcommon.Resource) string {
+	var sb strings.Builder
+	for _, segment := range p.segments {
+		switch {
+		case segment.timeFormat != nil:
+			sb.WriteString(segment.timeFormat(t))
+		case segment.resourceAttribute != "":
+			value := defaultPartitionValue
+			if v, ok := res.Attributes().Get(segment.resourceAttribute); ok && v.AsString() != "" {
+				value = url.PathEscape(v.AsString())
+			}
+			sb.WriteString(value)
+		default:
+			sb.WriteString(segment.literal)
+		}
+	}
+	return sb.String()
+}
+
+// partitionLogs groups the resource logs by partition. The logs are not copied when the
+// partitions don't depend on the resource attributes.
+func (p *partitioner) partitionLogs(t time.Time, ld plog.Logs) map[string]plog.Logs {
+	if !p.resourceAttributes {
+		return map[string]plog.Logs{p.partition(t, pcommon.NewResource()): ld}
+	}
+	partitions := make(map[string]plog.Logs)
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		key := p.partition(t, rl.Resource())
+		logs, ok := partitions[key]
+		if !ok {
+			logs = plog.NewLogs()
+			partitions[key] = logs
+		}
+		rl.CopyTo(logs.ResourceLogs().AppendEmpty())
+	}
+	return partitions
+}
+
+// partitionTraces groups the resource spans by partition. The traces are not copied when
+// the partitions don't depend on the resource attributes.
+func (p *partitioner) partitionTraces(t time.Time, td ptrace.Traces) map[string]ptrace.Traces {
+	if !p.resourceAttributes {
+		return map[string]ptrace.Traces{p.partition(t, pcommon.NewResource()): td}
+	}
+	partitions := make(map[string]ptrace.Traces)
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		key := p.partition(t, rs.Resource())
+		traces, ok := partitions[key]
+		if !ok {
+			traces = ptrace.NewTraces()
+			partitions[key] = traces
+		}
+		rs.CopyTo(traces.ResourceSpans().AppendEmpty())
+	}
+	return partitions
+}
+
+// partitionMetrics groups the resource metrics by partition. The metrics are not copied
+// when the partitions don't depend on the resource attributes.
+func (p *partitioner) partitionMetrics(t time.Time, md pmetric.Metrics) map[string]pmetric.Metrics {
+	if !p.resourceAttributes {
+		return map[string]pmetric.Metrics{p.partition(t, pcommon.NewResource()): md}
+	}
+	partitions := make(map[string]pmetric.Metrics)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		key := p.partition(t, rm.Resource())
+		metrics, ok := partitions[key]
+		if !ok {
+			metrics = pmetric.NewMetrics()
+			partitions[key] = metrics
+		}
+		rm.CopyTo(metrics.ResourceMetrics().AppendEmpty())
+	}
+	return partitions
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"path"
+	"sync"
+	"time"
+)
+
+// maxBlocks is the maximum number of committed blocks of a block blob.
+const maxBlocks = 50000
+
+// openBlob is a block blob receiving new blocks. Its mutex is held while a block is
+// staged and committed, the block list of the blob being committed in order.
+type openBlob struct {
+	mu        sync.Mutex
+	container string
+	name      string
+	blockIDs  []string
+	size      int64
+	created   time.Time
+}
+
+// full returns whether the blob reached its maximum size or number of blocks.
+func (b *openBlob) full(maxSize int64) bool {
+	return b.size >= maxSize || len(b.blockIDs) >= maxBlocks
+}
+
+// blobWriter appends the marshaled batches as blocks of block blobs, each batch being
+// committed along with the previous blocks of its blob. A new blob is started when the
+// blob reaches its maximum size, number of blocks or age.
+type blobWriter struct {
+	client    blobClient
+	prefix    string
+	extension string
+	maxSize   int64
+	maxAge    time.Duration
+	now       func() time.Time
+
+	// mu guards the active blobs, it is not held while the blocks are written.
+	mu sync.Mutex
+	// active are the blobs receiving the new blocks, by container and virtual directory
+	active map[string]*openBlob
+}
+
+func newBlobWriter(client blobClient, cfg *Config, extension string) *blobWriter {
+	return &blobWriter{
+		client:    client,
+		prefix:    cfg.Blob.Prefix,
+		extension: extension,
+		maxSize:   cfg.Blob.MaxSize,
+		maxAge:    cfg.Blob.MaxAge,
+		now:       time.Now,
+		active:    map[string]*openBlob{},
+	}
+}
+
+// write stages the data as a new block of the open blob of the partition and signal, and
+// commits it. The batch is only written once committed: a block whose stage or commit failed
+// is staged again with the next batch of the blob, replacing it.
+func (w *blobWriter) write(ctx context.Context, container string, partition string, signal string, data []byte) error {
+	key, blob := w.openBlob(container, partition, signal)
+	blob.mu.Lock()
+	for blob.full(w.maxSize) {
+		// the blob was filled by a concurrent write, which closed it
+		blob.mu.Unlock()
+		key, blob = w.openBlob(container, partition, signal)
+		blob.mu.Lock()
+	}
+	defer blob.mu.Unlock()
+
+	id := blockID(len(blob.blockIDs))
+	if err := w.client.stageBlock(ctx, blob.container, blob.name, id, data); err != nil {
+		return fmt.Errorf("failed to stage a block of the blob %q: %w", blob.name, err)
+	}
+	blockIDs := append(blob.blockIDs[:len(blob.blockIDs):len(blob.blockIDs)], id)
+	if err := w.client.commitBlockList(ctx, blob.container, blob.name, blockIDs); err != nil {
+		return fmt.Errorf("failed to commit the blocks of the blob %q: %w", blob.name, err)
+	}
+	blob.blockIDs = blockIDs
+	blob.size += int64(len(data))
+
+	if blob.full(w.maxSize) {
+		w.closeBlob(key, blob)
+	}
+	return nil
+}
+
+// openBlob returns the blob receiving the blocks of the partition and signal, a new blob
+// replacing the blob which reached its maximum age.
+func (w *blobWriter) openBlob(container string, partition string, signal string) (string, *openBlob) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	dir := path.Join(w.prefix, partition)
+	key := container + "/" + dir + "/" + signal
+	now := w.now()
+	blob, ok := w.active[key]
+	if !ok || now.Sub(blob.created) >= w.maxAge {
+		blob = &openBlob{
+			container: container,
+			name:      w.blobName(dir, signal),
+			created:   now,
+		}
+		w.active[key] = blob
+	}
+	return key, blob
+}
+
+// closeBlob stops appending the blocks to the blob, unless it was already replaced.
+func (w *blobWriter) closeBlob(key string, blob *openBlob) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.active[key] == blob {
+		delete(w.active, key)
+	}
+}
+
+// blobName returns a new blob name in the virtual directory, such as
+// "logs/year=2023/month=08/day=01/hour=07/logs_20230801T071502Z_5f0c6d2e.jsonl".
+func (w *blobWriter) blobName(dir string, signal string) string {
+	name := fmt.Sprintf("%s_%s_%08x.%s", signal, w.now().UTC().Format("20060102T150405Z"), rand.Uint32(), w.extension)
+	return path.Join(dir, name)
+}
+
+// blockID returns the ID of the block at the index. The IDs of the blocks of a blob
+// must have the same length.
+func blockID(index int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%06d", index)))
+}
@@ -0,0 +1,223 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBlobClient keeps the staged and committed blocks in memory.
+type fakeBlobClient struct {
+	mu        sync.Mutex
+	staged    map[string]map[string][]byte
+	committed map[string][]byte
+	stageErr  error
+	commitErr error
+}
+
+func newFakeBlobClient() *fakeBlobClient {
+	return &fakeBlobClient{
+		staged:    map[string]map[string][]byte{},
+		committed: map[string][]byte{},
+	}
+}
+
+func (c *fakeBlobClient) stageBlock(_ context.Context, container string, blob string, blockID string, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stageErr != nil {
+		return c.stageErr
+	}
+	key := container + "/" + blob
+	if c.staged[key] == nil {
+		c.staged[key] = map[string][]byte{}
+	}
+	c.staged[key][blockID] = append([]byte{}, data...)
+	return nil
+}
+
+func (c *fakeBlobClient) commitBlockList(_ context.Context, container string, blob string, blockIDs []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.commitErr != nil {
+		return c.commitErr
+	}
+	key := container + "/" + blob
+	var content []byte
+	for _, id := range blockIDs {
+		data, ok := c.staged[key][id]
+		if !ok {
+			return errors.New("block not staged")
+		}
+		content = append(content, data...)
+	}
+	c.committed[key] = content
+	return nil
+}
+
+func (c *fakeBlobClient) committedBlobs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var names []string
+	for name := range c.committed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func newTestWriter(client blobClient, maxSize int64, maxAge time.Duration) *blobWriter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Blob.Prefix = "otel"
+	cfg.Blob.MaxSize = maxSize
+	cfg.Blob.MaxAge = maxAge
+	return newBlobWriter(client, cfg, "jsonl")
+}
+
+func TestWriterCommitsEachBatch(t *testing.T) {
+	client := newFakeBlobClient()
+	w := newTestWriter(client, 10, time.Hour)
+
+	require.NoError(t, w.write(context.Background(), "logs", "hour=07", "logs", []byte("first\n")))
+	blobs := client.committedBlobs()
+	require.Len(t, blobs, 1)
+	assert.Regexp(t, `^logs/otel/hour=07/logs_\d{8}T\d{6}Z_[0-9a-f]{8}\.jsonl$`, blobs[0])
+	assert.Equal(t, "first\n", string(client.committed[blobs[0]]))
+
+	require.NoError(t, w.write(context.Background(), "logs", "hour=07", "logs", []byte("second\n")))
+	require.Equal(t, blobs, client.committedBlobs())
+	assert.Equal(t, "first\nsecond\n", string(client.committed[blobs[0]]))
+
+	// the blob reached its maximum size, the next blocks are appended to a new blob
+	require.NoError(t, w.write(context.Background(), "logs", "hour=07", "logs", []byte("third\n")))
+	assert.Len(t, client.committedBlobs(), 2)
+	assert.Equal(t, "first\nsecond\n", string(client.committed[blobs[0]]))
+}
+
+func TestWriterMaxAge(t *testing.T) {
+	client := newFakeBlobClient()
+	w := newTestWriter(client, 1024, time.Minute)
+	now := time.Date(2023, 8, 1, 7, 15, 2, 0, time.UTC)
+	w.now = func() time.Time { return now }
+
+	require.NoError(t, w.write(context.Background(), "logs", "hour=07", "logs", []byte("first\n")))
+	require.NoError(t, w.write(context.Background(), "logs", "hour=08", "logs", []byte("other\n")))
+	now = now.Add(30 * time.Second)
+	require.NoError(t, w.write(context.Background(), "logs", "hour=07", "logs", []byte("second\n")))
+	blobs := client.committedBlobs()
+	require.Len(t, blobs, 2)
+	assert.Regexp(t, `^logs/otel/hour=07/logs_20230801T071502Z_[0-9a-f]{8}\.jsonl$`, blobs[0])
+	assert.Regexp(t, `^logs/otel/hour=08/logs_20230801T071502Z_[0-9a-f]{8}\.jsonl$`, blobs[1])
+	assert.Equal(t, "first\nsecond\n", string(client.committed[blobs[0]]))
+
+	// the blob reached its maximum age, the next blocks are appended to a new blob
+	now = now.Add(30 * time.Second)
+	require.NoError(t, w.write(context.Background(), "logs", "hour=07", "logs", []byte("third\n")))
+	blobs = client.committedBlobs()
+	require.Len(t, blobs, 3)
+	assert.Regexp(t, `^logs/otel/hour=07/logs_20230801T071602Z_[0-9a-f]{8}\.jsonl$`, blobs[1])
+	assert.Equal(t, "third\n", string(client.committed[blobs[1]]))
+}
+
+func TestWriterCommitError(t *testing.T) {
+	client := newFakeBlobClient()
+	client.commitErr = errors.New("unavailable")
+	w := newTestWriter(client, 1024, time.Hour)
+
+	// the batch is only written once committed
+	assert.ErrorContains(t, w.write(context.Background(), "traces", "", "traces", []byte("failed\n")), "unavailable")
+	assert.Empty(t, client.committedBlobs())
+
+	// the block of the failed batch is replaced by the next batch
+	client.commitErr = nil
+	require.NoError(t, w.write(context.Background(), "traces", "", "traces", []byte("spans\n")))
+	blobs := client.committedBlobs()
+	require.Len(t, blobs, 1)
+	assert.Equal(t, "spans\n", string(client.committed[blobs[0]]))
+}
+
+func TestWriterStageError(t *testing.T) {
+	client := newFakeBlobClient()
+	client.stageErr = errors.New("unavailable")
+	w := newTestWriter(client, 1024, time.Hour)
+
+	assert.ErrorContains(t, w.write(context.Background(), "metrics", "", "metrics", []byte("points\n")), "unavailable")
+
+	// the retried batch is staged in the same blob
+	client.stageErr = nil
+	require.NoError(t, w.write(context.Background(), "metrics", "", "metrics", []byte("points\n")))
+	blobs := client.committedBlobs()
+	require.Len(t, blobs, 1)
+	assert.Equal(t, "points\n", string(client.committed[blobs[0]]))
+}
+
+// blockingBlobClient blocks the stage of the blocks of the blob until it is released.
+type blockingBlobClient struct {
+	*fakeBlobClient
+	blocked string
+	release chan struct{}
+}
+
+func (c *blockingBlobClient) stageBlock(ctx context.Context, container string, blob string, blockID string, data []byte) error {
+	if strings.HasPrefix(blob, c.blocked) {
+		<-c.release
+	}
+	return c.fakeBlobClient.stageBlock(ctx, container, blob, blockID, data)
+}
+
+func TestWriterPartitionsConcurrently(t *testing.T) {
+	client := &blockingBlobClient{fakeBlobClient: newFakeBlobClient(), blocked: "otel/hour=07/", release: make(chan struct{})}
+	w := newTestWriter(client, 1024, time.Hour)
+
+	done := make(chan error)
+	go func() {
+		done <- w.write(context.Background(), "logs", "hour=07", "logs", []byte("blocked\n"))
+	}()
+
+	// the other partitions are written while a write is in progress
+	require.Eventually(t, func() bool {
+		return w.write(context.Background(), "logs", "hour=08", "logs", []byte("other\n")) == nil
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, client.committedBlobs(), 1)
+
+	close(client.release)
+	require.NoError(t, <-done)
+	assert.Len(t, client.committedBlobs(), 2)
+}
+
+func TestWriterConcurrentWrites(t *testing.T) {
+	client := newFakeBlobClient()
+	w := newTestWriter(client, 6*5, time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, w.write(context.Background(), "logs", "", "logs", []byte("batch\n")))
+		}()
+	}
+	wg.Wait()
+
+	// every batch is committed once, the blobs holding at most 5 batches
+	blobs := client.committedBlobs()
+	require.Len(t, blobs, 4)
+	for _, blob := range blobs {
+		assert.Equal(t, strings.Repeat("batch\n", 5), string(client.committed[blob]))
+	}
+}
+
+func TestBlockID(t *testing.T) {
+	assert.Equal(t, len(blockID(0)), len(blockID(maxBlocks-1)))
+	assert.NotEqual(t, blockID(1), blockID(2))
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net"
 	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
 // Defaults for not specified configuration settings.
@@ -27,6 +29,16 @@ type Config struct {
 	// data to the Carbon/Graphite backend.
 	// The default value is defined by the DefaultSendTimeout constant.
 	Timeout time.Duration `mapstructure:"timeout"`
+
+	// MetricPathTemplate is the template of the metric paths, in which the
+	// {metric} placeholder is replaced by the metric name, and the other
+	// placeholders, such as {host.name}, by the value of the resource
+	// attribute. The metric paths are the metric names by default.
+	MetricPathTemplate string `mapstructure:"metric_path_template"`
+
+	// ResourceToTelemetrySettings defines configuration for converting the
+	// resource attributes to tags of the metrics.
+	ResourceToTelemetrySettings resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
 }
 
 func (cfg *Config) Validate() error {
@@ -41,5 +53,11 @@ func (cfg *Config) Validate() error {
 		return errors.New("exporter requires a positive timeout")
 	}
 
+	if cfg.MetricPathTemplate != "" {
+		if _, err := parseMetricPathTemplate(cfg.MetricPathTemplate); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
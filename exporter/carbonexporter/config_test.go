@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -35,8 +36,12 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "allsettings"),
 			expected: &Config{
-				Endpoint: "localhost:8080",
-				Timeout:  10 * time.Second,
+				Endpoint:           "localhost:8080",
+				Timeout:            10 * time.Second,
+				MetricPathTemplate: "{service.name}.{host.name}.{metric}",
+				ResourceToTelemetrySettings: resourcetotelemetry.Settings{
+					Enabled: true,
+				},
 			},
 		},
 	}
@@ -80,6 +85,26 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "metric_path_template",
+			config: &Config{
+				MetricPathTemplate: "otel.{service.name}.{metric}",
+			},
+		},
+		{
+			name: "metric_path_template_without_metric",
+			config: &Config{
+				MetricPathTemplate: "otel.{service.name}",
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric_path_template_unclosed_placeholder",
+			config: &Config{
+				MetricPathTemplate: "{service.name.{metric}",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
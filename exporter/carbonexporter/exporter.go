@@ -12,20 +12,34 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
 // newCarbonExporter returns a new Carbon exporter.
 func newCarbonExporter(cfg *Config, set exporter.CreateSettings) (exporter.Metrics, error) {
+	var template *metricPathTemplate
+	if cfg.MetricPathTemplate != "" {
+		var err error
+		if template, err = parseMetricPathTemplate(cfg.MetricPathTemplate); err != nil {
+			return nil, err
+		}
+	}
 	sender := carbonSender{
 		connPool: newTCPConnPool(cfg.Endpoint, cfg.Timeout),
+		template: template,
 	}
 
-	return exporterhelper.NewMetricsExporter(
+	exp, err := exporterhelper.NewMetricsExporter(
 		context.TODO(),
 		set,
 		cfg,
 		sender.pushMetricsData,
 		exporterhelper.WithShutdown(sender.Shutdown))
+	if err != nil {
+		return nil, err
+	}
+	return resourcetotelemetry.WrapMetricsExporter(cfg.ResourceToTelemetrySettings, exp), nil
 }
 
 // carbonSender is the struct tying the translation function and the TCP
@@ -33,10 +47,12 @@ func newCarbonExporter(cfg *Config, set exporter.CreateSettings) (exporter.Metri
 // the exporter can leverage the helper and get consistent observability.
 type carbonSender struct {
 	connPool *connPool
+	// template builds the metric paths when not nil
+	template *metricPathTemplate
 }
 
 func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) error {
-	lines := metricDataToPlaintext(md)
+	lines := metricDataToPlaintext(md, cs.template)
 
 	if _, err := cs.connPool.Write([]byte(lines)); err != nil {
 		// Use the sum of converted and dropped since the write failed for all.
@@ -69,7 +69,10 @@ const (
 //     a single Carbon metric.
 //   - number of time series successfully converted to carbon.
 //   - number of time series that could not be converted to Carbon.
-func metricDataToPlaintext(md pmetric.Metrics) string {
+//
+// The metric names are replaced by their path built from the template, when
+// not nil.
+func metricDataToPlaintext(md pmetric.Metrics, template *metricPathTemplate) string {
 	if md.DataPointCount() == 0 {
 		return ""
 	}
@@ -86,15 +89,16 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 					// TODO: log error info
 					continue
 				}
+				metricPath := template.path(metric.Name(), rm.Resource().Attributes())
 				switch metric.Type() {
 				case pmetric.MetricTypeGauge:
-					formatNumberDataPoints(&sb, metric.Name(), metric.Gauge().DataPoints())
+					formatNumberDataPoints(&sb, metricPath, metric.Gauge().DataPoints())
 				case pmetric.MetricTypeSum:
-					formatNumberDataPoints(&sb, metric.Name(), metric.Sum().DataPoints())
+					formatNumberDataPoints(&sb, metricPath, metric.Sum().DataPoints())
 				case pmetric.MetricTypeHistogram:
-					formatHistogramDataPoints(&sb, metric.Name(), metric.Histogram().DataPoints())
+					formatHistogramDataPoints(&sb, metricPath, metric.Histogram().DataPoints())
 				case pmetric.MetricTypeSummary:
-					formatSummaryDataPoints(&sb, metric.Name(), metric.Summary().DataPoints())
+					formatSummaryDataPoints(&sb, metricPath, metric.Summary().DataPoints())
 				}
 			}
 		}
@@ -235,7 +239,7 @@ func buildPath(name string, attributes pcommon.Map) string {
 		if value == "" {
 			value = tagValueEmptyPlaceholder
 		}
-		sb.WriteString(tagPrefix + sanitizeTagKey(k) + tagKeyValueSeparator + value)
+		sb.WriteString(tagPrefix + sanitizeTagKey(k) + tagKeyValueSeparator + sanitizeTagValue(value))
 		return true
 	})
 
@@ -103,6 +103,16 @@ func TestBuildPath(t *testing.T) {
 			}(),
 			want: "int_value;k=1",
 		},
+		{
+			name: "invalid_value",
+			attributes: func() pcommon.Map {
+				attr := pcommon.NewMap()
+				attr.PutStr("k0", "a;b")
+				attr.PutStr("k1", "~c")
+				return attr
+			}(),
+			want: "invalid_value;k0=a" + string(sanitizedRune) + "b;k1=" + string(sanitizedRune) + "c",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -276,7 +286,7 @@ func TestToPlaintext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotLines := metricDataToPlaintext(tt.metricsDataFn())
+			gotLines := metricDataToPlaintext(tt.metricsDataFn(), nil)
 			got := strings.Split(gotLines, "\n")
 			got = got[:len(got)-1]
 			assert.Equal(t, tt.wantLinesCount, len(got))
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	// metricPlaceholder is the placeholder of the metric name in the metric path templates.
+	metricPlaceholder = "metric"
+
+	// missingAttributeSegment replaces the resource attributes missing from the resources
+	// in the metric paths.
+	missingAttributeSegment = "unknown"
+)
+
+var errMissingMetricPlaceholder = errors.New("metric_path_template requires the {metric} placeholder")
+
+// metricPathTemplate builds the metric paths from the metric names and the attributes of
+// their resource, such as "{service.name}.{host.name}.{metric}".
+type metricPathTemplate struct {
+	parts []pathTemplatePart
+}
+
+// pathTemplatePart is a literal part of the template or, when placeholder is set, the
+// placeholder of the metric name or of a resource attribute.
+type pathTemplatePart struct {
+	literal     string
+	placeholder string
+}
+
+// parseMetricPathTemplate parses the template, which has a {metric} placeholder, and
+// whose other placeholders are resource attribute keys.
+func parseMetricPathTemplate(template string) (*metricPathTemplate, error) {
+	t := &metricPathTemplate{}
+	hasMetric := false
+	for rest := template; rest != ""; {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			t.parts = append(t.parts, pathTemplatePart{literal: rest})
+			break
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("metric_path_template %q has an unclosed placeholder", template)
+		}
+		end += start
+		placeholder := rest[start+1 : end]
+		if placeholder == "" {
+			return nil, fmt.Errorf("metric_path_template %q has an empty placeholder", template)
+		}
+		if start > 0 {
+			t.parts = append(t.parts, pathTemplatePart{literal: rest[:start]})
+		}
+		t.parts = append(t.parts, pathTemplatePart{placeholder: placeholder})
+		hasMetric = hasMetric || placeholder == metricPlaceholder
+		rest = rest[end+1:]
+	}
+	if !hasMetric {
+		return nil, errMissingMetricPlaceholder
+	}
+	return t, nil
+}
+
+// path returns the path of the metric of the resource, the metric name when the template
+// is nil. The resource attributes are sanitized to be single path nodes.
+func (t *metricPathTemplate) path(metricName string, resource pcommon.Map) string {
+	if t == nil {
+		return metricName
+	}
+	var sb strings.Builder
+	for _, part := range t.parts {
+		switch part.placeholder {
+		case "":
+			sb.WriteString(part.literal)
+		case metricPlaceholder:
+			sb.WriteString(metricName)
+		default:
+			value, ok := resource.Get(part.placeholder)
+			if !ok || value.AsString() == "" {
+				sb.WriteString(missingAttributeSegment)
+				continue
+			}
+			sb.WriteString(sanitizePathNode(value.AsString()))
+		}
+	}
+	return sb.String()
+}
+
+// sanitizePathNode removes the characters separating the path nodes, and the
+// characters invalid in the metric paths, from the path node.
+func sanitizePathNode(node string) string {
+	mapRune := func(r rune) rune {
+		switch r {
+		case '.', ' ', '\t', '\n', ';':
+			return sanitizedRune
+		default:
+			return r
+		}
+	}
+
+	return strings.Map(mapRune, node)
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package carbonexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestMetricPathTemplate(t *testing.T) {
+	resource := pcommon.NewMap()
+	resource.PutStr("service.name", "checkout")
+	resource.PutStr("host.name", "web-1.example.com")
+	resource.PutInt("shard", 3)
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{
+			name:     "metric_only",
+			template: "{metric}",
+			want:     "my.metric",
+		},
+		{
+			name:     "resource_attributes",
+			template: "{service.name}.{host.name}.{metric}",
+			want:     "checkout.web-1_example_com.my.metric",
+		},
+		{
+			name:     "literals",
+			template: "otel.{service.name}.shard{shard}.{metric}.raw",
+			want:     "otel.checkout.shard3.my.metric.raw",
+		},
+		{
+			name:     "missing_attribute",
+			template: "{deployment.environment}.{metric}",
+			want:     "unknown.my.metric",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := parseMetricPathTemplate(tt.template)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, template.path("my.metric", resource))
+		})
+	}
+}
+
+func TestParseMetricPathTemplateErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+	}{
+		{
+			name:     "missing_metric",
+			template: "{service.name}.{host.name}",
+		},
+		{
+			name:     "unclosed_placeholder",
+			template: "{service.name}.{metric",
+		},
+		{
+			name:     "empty_placeholder",
+			template: "{}.{metric}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseMetricPathTemplate(tt.template)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestToPlaintextWithMetricPathTemplate(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetIntValue(42)
+	dp.SetTimestamp(pcommon.Timestamp(1e9))
+	dp.Attributes().PutStr("route", "/cart;checkout")
+
+	template, err := parseMetricPathTemplate("{service.name}.{metric}")
+	require.NoError(t, err)
+	assert.Equal(t, "checkout.requests;route=/cart_checkout 42 1\n", metricDataToPlaintext(md, template))
+}
@@ -8,10 +8,13 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal"
 )
 
 // Config defines configuration for Elastic exporter.
@@ -39,7 +42,25 @@ type Config struct {
 	// MetricsTableName is the table name for metrics. default is `otel_metrics`.
 	MetricsTableName string `mapstructure:"metrics_table_name"`
 	// TTLDays is The data time-to-live in days, 0 means no ttl.
+	// Deprecated: Use 'ttl' instead.
 	TTLDays uint `mapstructure:"ttl_days"`
+	// TTL is The data time-to-live example 30m, 48h. 0 means no ttl.
+	TTL time.Duration `mapstructure:"ttl"`
+	// TableEngine is the table engine of the created tables. default is `MergeTree()`.
+	TableEngine TableEngine `mapstructure:"table_engine"`
+	// PartitionBy is the time granularity of the table partitions, one of hour, day, week, month or none. default is `day`.
+	PartitionBy string `mapstructure:"partition_by"`
+	// AsyncInsert enables the ClickHouse asynchronous inserts, the server buffers the inserted rows
+	// and writes them in larger parts.
+	AsyncInsert bool `mapstructure:"async_insert"`
+}
+
+// TableEngine defines the ENGINE clause of the tables created by the exporter.
+type TableEngine struct {
+	// Name is the table engine name, for example `ReplicatedMergeTree`.
+	Name string `mapstructure:"name"`
+	// Params are the table engine parameters, for example `'/clickhouse/tables/{shard}/{database}/{table}', '{replica}'`.
+	Params string `mapstructure:"params"`
 }
 
 // QueueSettings is a subset of exporterhelper.QueueSettings.
@@ -48,11 +69,17 @@ type QueueSettings struct {
 	QueueSize int `mapstructure:"queue_size"`
 }
 
-const defaultDatabase = "default"
+const (
+	defaultDatabase    = "default"
+	defaultTableEngine = "MergeTree"
+)
 
 var (
 	errConfigNoEndpoint      = errors.New("endpoint must be specified")
 	errConfigInvalidEndpoint = errors.New("endpoint must be url format")
+	errConfigTTLConflict     = errors.New("ttl_days and ttl can't be both specified")
+	errConfigNegativeTTL     = errors.New("ttl must not be negative")
+	errConfigNoTableEngine   = errors.New("table_engine::name must be specified")
 )
 
 // Validate the clickhouse server configuration.
@@ -60,6 +87,18 @@ func (cfg *Config) Validate() (err error) {
 	if cfg.Endpoint == "" {
 		err = multierr.Append(err, errConfigNoEndpoint)
 	}
+	if cfg.TTLDays > 0 && cfg.TTL != 0 {
+		err = multierr.Append(err, errConfigTTLConflict)
+	}
+	if cfg.TTL < 0 {
+		err = multierr.Append(err, errConfigNegativeTTL)
+	}
+	if cfg.TableEngine.Name == "" {
+		err = multierr.Append(err, errConfigNoTableEngine)
+	}
+	if !internal.IsValidPartitionBy(cfg.PartitionBy) {
+		err = multierr.Append(err, fmt.Errorf("partition_by %q must be one of hour, day, week, month or none", cfg.PartitionBy))
+	}
 	dsn, e := cfg.buildDSN(cfg.Database)
 	if e != nil {
 		err = multierr.Append(err, e)
@@ -95,6 +134,17 @@ func (cfg *Config) buildDSN(database string) (string, error) {
 		queryParams.Set(k, v)
 	}
 
+	// Enable async inserts, unless configured through the connection params. The inserts wait
+	// for the buffered rows to be flushed so that failed inserts are retried.
+	if cfg.AsyncInsert {
+		if !queryParams.Has("async_insert") {
+			queryParams.Set("async_insert", "1")
+		}
+		if !queryParams.Has("wait_for_async_insert") {
+			queryParams.Set("wait_for_async_insert", "1")
+		}
+	}
+
 	// Enable TLS if scheme is https. This flag is necessary to support https connections.
 	if dsnURL.Scheme == "https" {
 		queryParams.Set("secure", "true")
@@ -125,6 +175,22 @@ func (cfg *Config) buildDSN(database string) (string, error) {
 	return dsnURL.String(), nil
 }
 
+// ttl returns the data time-to-live, from ttl or from the deprecated ttl_days.
+func (cfg *Config) ttl() time.Duration {
+	if cfg.TTLDays > 0 {
+		return time.Duration(cfg.TTLDays) * 24 * time.Hour
+	}
+	return cfg.TTL
+}
+
+func (cfg *Config) tableSchema() internal.TableSchema {
+	return internal.TableSchema{
+		Engine:      fmt.Sprintf("%s(%s)", cfg.TableEngine.Name, cfg.TableEngine.Params),
+		TTL:         cfg.ttl(),
+		PartitionBy: cfg.PartitionBy,
+	}
+}
+
 func (cfg *Config) buildDB(database string) (*sql.DB, error) {
 	dsn, err := cfg.buildDSN(database)
 	if err != nil {
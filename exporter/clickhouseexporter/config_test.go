@@ -65,8 +65,23 @@ func TestLoadConfig(t *testing.T) {
 				QueueSettings: QueueSettings{
 					QueueSize: 100,
 				},
+				TableEngine: TableEngine{Name: "MergeTree"},
+				PartitionBy: "day",
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "schema"),
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoint = defaultEndpoint
+				cfg.TTL = 72 * time.Hour
+				cfg.TableEngine = TableEngine{
+					Name:   "ReplicatedMergeTree",
+					Params: "'/clickhouse/tables/{shard}/{database}/{table}', '{replica}'",
+				}
+				cfg.PartitionBy = "month"
+				cfg.AsyncInsert = true
+			}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -99,6 +114,7 @@ func TestConfig_buildDSN(t *testing.T) {
 		Password         string
 		Database         string
 		ConnectionParams map[string]string
+		AsyncInsert      bool
 	}
 	type args struct {
 		database string
@@ -227,6 +243,23 @@ func TestConfig_buildDSN(t *testing.T) {
 			args: args{},
 			want: "clickhouse://127.0.0.1:9000/default?foo=bar&secure=true",
 		},
+		{
+			name: "Enable async inserts",
+			fields: fields{
+				Endpoint:    defaultEndpoint,
+				AsyncInsert: true,
+			},
+			want: "clickhouse://127.0.0.1:9000/default?async_insert=1&wait_for_async_insert=1",
+		},
+		{
+			name: "Async inserts settings from connection parameters",
+			fields: fields{
+				Endpoint:         defaultEndpoint,
+				AsyncInsert:      true,
+				ConnectionParams: map[string]string{"wait_for_async_insert": "0"},
+			},
+			want: "clickhouse://127.0.0.1:9000/default?async_insert=1&wait_for_async_insert=0",
+		},
 		{
 			name: "support replace database in DSN to default database",
 			fields: fields{
@@ -246,6 +279,7 @@ func TestConfig_buildDSN(t *testing.T) {
 				Password:         tt.fields.Password,
 				Database:         tt.fields.Database,
 				ConnectionParams: tt.fields.ConnectionParams,
+				AsyncInsert:      tt.fields.AsyncInsert,
 			}
 			got, err := cfg.buildDSN(tt.args.database)
 
@@ -266,3 +300,74 @@ func TestConfig_buildDSN(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  func(*Config)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: func(*Config) {},
+		},
+		{
+			name: "ttl and ttl_days",
+			config: func(cfg *Config) {
+				cfg.TTLDays = 3
+				cfg.TTL = time.Hour
+			},
+			wantErr: errConfigTTLConflict.Error(),
+		},
+		{
+			name: "negative ttl",
+			config: func(cfg *Config) {
+				cfg.TTL = -time.Hour
+			},
+			wantErr: errConfigNegativeTTL.Error(),
+		},
+		{
+			name: "no table engine",
+			config: func(cfg *Config) {
+				cfg.TableEngine.Name = ""
+			},
+			wantErr: errConfigNoTableEngine.Error(),
+		},
+		{
+			name: "invalid partition",
+			config: func(cfg *Config) {
+				cfg.PartitionBy = "year"
+			},
+			wantErr: `partition_by "year" must be one of hour, day, week, month or none`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := withDefaultConfig(tt.config)
+			cfg.Endpoint = defaultEndpoint
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestConfig_tableSchema(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.TTLDays = 3
+	})
+	schema := cfg.tableSchema()
+	assert.Equal(t, "MergeTree()", schema.Engine)
+	assert.Equal(t, 72*time.Hour, schema.TTL)
+	assert.Equal(t, "day", schema.PartitionBy)
+
+	cfg.TTLDays = 0
+	cfg.TTL = 30 * time.Minute
+	cfg.TableEngine = TableEngine{Name: "ReplacingMergeTree", Params: "Timestamp"}
+	schema = cfg.tableSchema()
+	assert.Equal(t, "ReplacingMergeTree(Timestamp)", schema.Engine)
+	assert.Equal(t, 30*time.Minute, schema.TTL)
+}
@@ -191,9 +191,9 @@ CREATE TABLE IF NOT EXISTS %s (
      INDEX idx_span_attr_key mapKeys(SpanAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
      INDEX idx_span_attr_value mapValues(SpanAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
      INDEX idx_duration Duration TYPE minmax GRANULARITY 1
-) ENGINE MergeTree()
+) ENGINE %s
+%s
 %s
-PARTITION BY toDate(Timestamp)
 ORDER BY (ServiceName, SpanName, toUnixTimestamp(Timestamp), TraceId)
 SETTINGS index_granularity=8192, ttl_only_drop_parts = 1;
 `
@@ -254,7 +254,7 @@ create table IF NOT EXISTS %s_trace_id_ts (
      Start DateTime64(9) CODEC(Delta, ZSTD(1)),
      End DateTime64(9) CODEC(Delta, ZSTD(1)),
      INDEX idx_trace_id TraceId TYPE bloom_filter(0.01) GRANULARITY 1
-) ENGINE MergeTree()
+) ENGINE %s
 %s
 ORDER BY (TraceId, toUnixTimestamp(Start))
 SETTINGS index_granularity=8192;
@@ -291,19 +291,14 @@ func renderInsertTracesSQL(cfg *Config) string {
 }
 
 func renderCreateTracesTableSQL(cfg *Config) string {
-	var ttlExpr string
-	if cfg.TTLDays > 0 {
-		ttlExpr = fmt.Sprintf(`TTL toDateTime(Timestamp) + toIntervalDay(%d)`, cfg.TTLDays)
-	}
-	return fmt.Sprintf(createTracesTableSQL, cfg.TracesTableName, ttlExpr)
+	schema := cfg.tableSchema()
+	return fmt.Sprintf(createTracesTableSQL, cfg.TracesTableName, schema.Engine,
+		schema.TTLExpr("Timestamp"), schema.PartitionExpr("Timestamp"))
 }
 
 func renderCreateTraceIDTsTableSQL(cfg *Config) string {
-	var ttlExpr string
-	if cfg.TTLDays > 0 {
-		ttlExpr = fmt.Sprintf(`TTL toDateTime(Start) + toIntervalDay(%d)`, cfg.TTLDays)
-	}
-	return fmt.Sprintf(createTraceIDTsTableSQL, cfg.TracesTableName, ttlExpr)
+	schema := cfg.tableSchema()
+	return fmt.Sprintf(createTraceIDTsTableSQL, cfg.TracesTableName, schema.Engine, schema.TTLExpr("Start"))
 }
 
 func renderTraceIDTsMaterializedViewSQL(cfg *Config) string {
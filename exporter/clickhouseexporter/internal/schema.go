@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal"
+
+import (
+	"fmt"
+	"time"
+)
+
+// partition granularities of the tables
+const (
+	PartitionByHour  = "hour"
+	PartitionByDay   = "day"
+	PartitionByWeek  = "week"
+	PartitionByMonth = "month"
+	PartitionByNone  = "none"
+)
+
+var partitionExpressions = map[string]string{
+	PartitionByHour:  "toStartOfHour(%s)",
+	PartitionByDay:   "toDate(%s)",
+	PartitionByWeek:  "toMonday(%s)",
+	PartitionByMonth: "toYYYYMM(%s)",
+	PartitionByNone:  "",
+}
+
+// IsValidPartitionBy returns true if partitionBy is a supported partition granularity.
+func IsValidPartitionBy(partitionBy string) bool {
+	_, ok := partitionExpressions[partitionBy]
+	return ok
+}
+
+// TableSchema holds the configurable clauses of the tables created by the exporter.
+type TableSchema struct {
+	// Engine is the table engine with its parameters, for example `MergeTree()`.
+	Engine string
+	// TTL is the time-to-live of the rows, 0 means no ttl.
+	TTL time.Duration
+	// PartitionBy is the time granularity of the partitions.
+	PartitionBy string
+}
+
+// TTLExpr renders the TTL clause of a table with the given time column.
+func (s TableSchema) TTLExpr(timeField string) string {
+	if s.TTL <= 0 {
+		return ""
+	}
+	switch {
+	case s.TTL%(24*time.Hour) == 0:
+		return fmt.Sprintf(`TTL toDateTime(%s) + toIntervalDay(%d)`, timeField, s.TTL/(24*time.Hour))
+	case s.TTL%time.Hour == 0:
+		return fmt.Sprintf(`TTL toDateTime(%s) + toIntervalHour(%d)`, timeField, s.TTL/time.Hour)
+	case s.TTL%time.Minute == 0:
+		return fmt.Sprintf(`TTL toDateTime(%s) + toIntervalMinute(%d)`, timeField, s.TTL/time.Minute)
+	default:
+		return fmt.Sprintf(`TTL toDateTime(%s) + toIntervalSecond(%d)`, timeField, s.TTL/time.Second)
+	}
+}
+
+// PartitionExpr renders the PARTITION BY clause of a table with the given time column.
+func (s TableSchema) PartitionExpr(timeField string) string {
+	expr := partitionExpressions[s.PartitionBy]
+	if expr == "" {
+		return ""
+	}
+	return "PARTITION BY " + fmt.Sprintf(expr, timeField)
+}
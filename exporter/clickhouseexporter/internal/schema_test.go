@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableSchema_TTLExpr(t *testing.T) {
+	tests := []struct {
+		ttl  time.Duration
+		want string
+	}{
+		{ttl: 0, want: ""},
+		{ttl: 72 * time.Hour, want: "TTL toDateTime(Timestamp) + toIntervalDay(3)"},
+		{ttl: 12 * time.Hour, want: "TTL toDateTime(Timestamp) + toIntervalHour(12)"},
+		{ttl: 90 * time.Minute, want: "TTL toDateTime(Timestamp) + toIntervalMinute(90)"},
+		{ttl: 45 * time.Second, want: "TTL toDateTime(Timestamp) + toIntervalSecond(45)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ttl.String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, TableSchema{TTL: tt.ttl}.TTLExpr("Timestamp"))
+		})
+	}
+}
+
+func TestTableSchema_PartitionExpr(t *testing.T) {
+	tests := map[string]string{
+		PartitionByHour:  "PARTITION BY toStartOfHour(TimeUnix)",
+		PartitionByDay:   "PARTITION BY toDate(TimeUnix)",
+		PartitionByWeek:  "PARTITION BY toMonday(TimeUnix)",
+		PartitionByMonth: "PARTITION BY toYYYYMM(TimeUnix)",
+		PartitionByNone:  "",
+	}
+	for partitionBy, want := range tests {
+		assert.True(t, IsValidPartitionBy(partitionBy))
+		assert.Equal(t, want, TableSchema{PartitionBy: partitionBy}.PartitionExpr("TimeUnix"))
+	}
+	assert.False(t, IsValidPartitionBy("year"))
+}
@@ -50,11 +50,25 @@ type Config struct {
 	// fall back to pure TracesIndex, if 'elasticsearch.index.prefix' or 'elasticsearch.index.suffix' are not found in resource or attribute (prio: resource > attribute)
 	TracesDynamicIndex DynamicIndexSetting `mapstructure:"traces_dynamic_index"`
 
+	// LogsDataStream routes the log records to the logs-<dataset>-<namespace> data streams, taking
+	// precedence over LogsIndex and LogsDynamicIndex.
+	LogsDataStream DataStreamSettings `mapstructure:"logs_data_stream"`
+	// TracesDataStream routes the spans to the traces-<dataset>-<namespace> data streams, taking
+	// precedence over TracesIndex and TracesDynamicIndex.
+	TracesDataStream DataStreamSettings `mapstructure:"traces_data_stream"`
+
+	// IndexTemplate configures the index templates of the data streams created on start.
+	IndexTemplate IndexTemplateSettings `mapstructure:"index_template"`
+
 	// Pipeline configures the ingest node pipeline name that should be used to process the
 	// events.
 	//
 	// https://www.elastic.co/guide/en/elasticsearch/reference/current/ingest.html
 	Pipeline string `mapstructure:"pipeline"`
+	// LogsPipeline overrides Pipeline for the log records.
+	LogsPipeline string `mapstructure:"logs_pipeline"`
+	// TracesPipeline overrides Pipeline for the spans.
+	TracesPipeline string `mapstructure:"traces_pipeline"`
 
 	HTTPClientSettings `mapstructure:",squash"`
 	Discovery          DiscoverySettings `mapstructure:"discover"`
@@ -67,6 +81,34 @@ type DynamicIndexSetting struct {
 	Enabled bool `mapstructure:"enabled"`
 }
 
+// DataStreamSettings defines the routing of the events to the data streams following the
+// data stream naming scheme <type>-<dataset>-<namespace>.
+//
+// https://www.elastic.co/blog/an-introduction-to-the-elastic-data-stream-naming-scheme
+type DataStreamSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Dataset is used when neither the resource nor the event has a `data_stream.dataset` attribute.
+	Dataset string `mapstructure:"dataset"`
+
+	// Namespace is used when neither the resource nor the event has a `data_stream.namespace` attribute.
+	Namespace string `mapstructure:"namespace"`
+}
+
+// IndexTemplateSettings defines the index templates the exporter creates on start for the
+// data streams it writes to, if they don't exist yet.
+type IndexTemplateSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// ILMPolicy is the index lifecycle management policy applied to the backing indices
+	// of the data streams. The policy must exist in Elasticsearch.
+	ILMPolicy string `mapstructure:"ilm_policy"`
+
+	// Priority of the index templates, which must be higher than the priority of the
+	// built-in templates matching the same data streams.
+	Priority int `mapstructure:"priority"`
+}
+
 type HTTPClientSettings struct {
 	Authentication AuthenticationSettings `mapstructure:",squash"`
 
@@ -222,5 +264,29 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("unknown mapping mode %v", cfg.Mapping.Mode)
 	}
 
+	if err := cfg.LogsDataStream.validate("logs_data_stream"); err != nil {
+		return err
+	}
+	if err := cfg.TracesDataStream.validate("traces_data_stream"); err != nil {
+		return err
+	}
+
+	if cfg.IndexTemplate.Priority < 0 {
+		return errors.New("index_template::priority must not be negative")
+	}
+
+	return nil
+}
+
+func (ds *DataStreamSettings) validate(name string) error {
+	if !ds.Enabled {
+		return nil
+	}
+	if ds.Dataset == "" || ds.Dataset != sanitizeDataStreamField(ds.Dataset) {
+		return fmt.Errorf("%s::dataset %q is not a valid data stream dataset", name, ds.Dataset)
+	}
+	if ds.Namespace == "" || ds.Namespace != sanitizeDataStreamField(ds.Namespace) {
+		return fmt.Errorf("%s::namespace %q is not a valid data stream namespace", name, ds.Namespace)
+	}
 	return nil
 }
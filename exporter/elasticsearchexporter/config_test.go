@@ -38,6 +38,17 @@ func TestLoad_DeprecatedIndexConfigOption(t *testing.T) {
 		Index:       "my_log_index",
 		LogsIndex:   "logs-generic-default",
 		TracesIndex: "traces-generic-default",
+		LogsDataStream: DataStreamSettings{
+			Dataset:   "generic",
+			Namespace: "default",
+		},
+		TracesDataStream: DataStreamSettings{
+			Dataset:   "generic",
+			Namespace: "default",
+		},
+		IndexTemplate: IndexTemplateSettings{
+			Priority: 200,
+		},
 		Pipeline:    "mypipeline",
 		HTTPClientSettings: HTTPClientSettings{
 			Authentication: AuthenticationSettings{
@@ -100,6 +111,17 @@ func TestLoadConfig(t *testing.T) {
 				Index:       "",
 				LogsIndex:   "logs-generic-default",
 				TracesIndex: "trace_index",
+				LogsDataStream: DataStreamSettings{
+					Dataset:   "generic",
+					Namespace: "default",
+				},
+				TracesDataStream: DataStreamSettings{
+					Dataset:   "generic",
+					Namespace: "default",
+				},
+				IndexTemplate: IndexTemplateSettings{
+					Priority: 200,
+				},
 				Pipeline:    "mypipeline",
 				HTTPClientSettings: HTTPClientSettings{
 					Authentication: AuthenticationSettings{
@@ -145,6 +167,17 @@ func TestLoadConfig(t *testing.T) {
 				Index:       "",
 				LogsIndex:   "my_log_index",
 				TracesIndex: "traces-generic-default",
+				LogsDataStream: DataStreamSettings{
+					Dataset:   "generic",
+					Namespace: "default",
+				},
+				TracesDataStream: DataStreamSettings{
+					Dataset:   "generic",
+					Namespace: "default",
+				},
+				IndexTemplate: IndexTemplateSettings{
+					Priority: 200,
+				},
 				Pipeline:    "mypipeline",
 				HTTPClientSettings: HTTPClientSettings{
 					Authentication: AuthenticationSettings{
@@ -176,6 +209,26 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id:         component.NewIDWithName(metadata.Type, "datastream"),
+			configFile: "config.yaml",
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://localhost:9200"}
+				cfg.LogsDataStream = DataStreamSettings{
+					Enabled:   true,
+					Dataset:   "myapp",
+					Namespace: "production",
+				}
+				cfg.TracesDataStream.Enabled = true
+				cfg.IndexTemplate = IndexTemplateSettings{
+					Enabled:   true,
+					ILMPolicy: "otel-policy",
+					Priority:  300,
+				}
+				cfg.Pipeline = "mypipeline"
+				cfg.LogsPipeline = "logspipeline"
+			}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -196,6 +249,53 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+func TestConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		config func(*Config)
+		err    string
+	}{
+		"invalid logs dataset": {
+			config: func(cfg *Config) {
+				cfg.LogsDataStream.Enabled = true
+				cfg.LogsDataStream.Dataset = "my-app"
+			},
+			err: `logs_data_stream::dataset "my-app" is not a valid data stream dataset`,
+		},
+		"empty traces namespace": {
+			config: func(cfg *Config) {
+				cfg.TracesDataStream.Enabled = true
+				cfg.TracesDataStream.Namespace = ""
+			},
+			err: `traces_data_stream::namespace "" is not a valid data stream namespace`,
+		},
+		"invalid dataset of disabled data stream": {
+			config: func(cfg *Config) {
+				cfg.LogsDataStream.Dataset = "My App"
+			},
+		},
+		"negative index template priority": {
+			config: func(cfg *Config) {
+				cfg.IndexTemplate.Priority = -1
+			},
+			err: "index_template::priority must not be negative",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			cfg := withDefaultConfig(tt.config)
+			cfg.Endpoints = []string{"http://localhost:9200"}
+			err := cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
 func withDefaultConfig(fns ...func(*Config)) *Config {
 	cfg := createDefaultConfig().(*Config)
 	for _, fn := range fns {
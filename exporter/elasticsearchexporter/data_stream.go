@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// data stream attribute key constants
+const (
+	dataStreamDataset   = "data_stream.dataset"
+	dataStreamNamespace = "data_stream.namespace"
+)
+
+// data stream type constants
+const (
+	dataStreamTypeLogs   = "logs"
+	dataStreamTypeTraces = "traces"
+)
+
+// maxDataStreamFieldLength is the maximum length of the dataset and of the namespace.
+const maxDataStreamFieldLength = 100
+
+// dataStreamReplacer replaces the characters that are not allowed in data stream names.
+// The dash is the separator of the data stream name parts and is not allowed either.
+var dataStreamReplacer = strings.NewReplacer(
+	"\\", "_", "/", "_", "*", "_", "?", "_", "\"", "_", "<", "_", ">", "_",
+	"|", "_", " ", "_", ",", "_", "#", "_", ":", "_", "-", "_",
+)
+
+// sanitizeDataStreamField makes the dataset or the namespace a valid part of a data stream name.
+func sanitizeDataStreamField(field string) string {
+	field = dataStreamReplacer.Replace(strings.ToLower(field))
+	if len(field) > maxDataStreamFieldLength {
+		field = field[:maxDataStreamFieldLength]
+	}
+	return field
+}
+
+// dataStreamName returns the name of the data stream of the event, built from the
+// `data_stream.dataset` and `data_stream.namespace` attributes (prio: resource > attribute)
+// or from the configured defaults.
+func dataStreamName(dsType string, settings DataStreamSettings, resource attrGetter, record attrGetter) string {
+	dataset := getFromBothResourceAndAttribute(dataStreamDataset, resource, record)
+	if dataset = sanitizeDataStreamField(dataset); dataset == "" {
+		dataset = settings.Dataset
+	}
+	namespace := getFromBothResourceAndAttribute(dataStreamNamespace, resource, record)
+	if namespace = sanitizeDataStreamField(namespace); namespace == "" {
+		namespace = settings.Namespace
+	}
+	return fmt.Sprintf("%s-%s-%s", dsType, dataset, namespace)
+}
+
+// indexTemplateName returns the name of the index template created for the data streams of the given type.
+func indexTemplateName(dsType string) string {
+	return "otel-" + dsType
+}
+
+type indexTemplate struct {
+	IndexPatterns []string               `json:"index_patterns"`
+	DataStream    struct{}               `json:"data_stream"`
+	Priority      int                    `json:"priority"`
+	Template      *indexTemplateSettings `json:"template,omitempty"`
+}
+
+type indexTemplateSettings struct {
+	Settings map[string]string `json:"settings"`
+}
+
+func newIndexTemplate(dsType string, settings IndexTemplateSettings) indexTemplate {
+	tmpl := indexTemplate{
+		IndexPatterns: []string{dsType + "-*-*"},
+		Priority:      settings.Priority,
+	}
+	if settings.ILMPolicy != "" {
+		tmpl.Template = &indexTemplateSettings{
+			Settings: map[string]string{"index.lifecycle.name": settings.ILMPolicy},
+		}
+	}
+	return tmpl
+}
+
+// ensureIndexTemplate creates the index template of the data streams of the given type,
+// unless a template with the same name already exists.
+func ensureIndexTemplate(ctx context.Context, logger *zap.Logger, client *esClientCurrent, dsType string, settings IndexTemplateSettings) error {
+	name := indexTemplateName(dsType)
+
+	resp, err := client.Indices.ExistsIndexTemplate(name, client.Indices.ExistsIndexTemplate.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to check the index template %q: %w", name, err)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		logger.Debug("Index template already exists", zap.String("name", name))
+		return nil
+	case http.StatusNotFound:
+	default:
+		return fmt.Errorf("failed to check the index template %q: %s", name, resp.Status())
+	}
+
+	body, err := json.Marshal(newIndexTemplate(dsType, settings))
+	if err != nil {
+		return err
+	}
+	resp, err = client.Indices.PutIndexTemplate(name, bytes.NewReader(body),
+		client.Indices.PutIndexTemplate.WithContext(ctx),
+		client.Indices.PutIndexTemplate.WithCreate(true))
+	if err != nil {
+		return fmt.Errorf("failed to create the index template %q: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		return fmt.Errorf("failed to create the index template %q: %s", name, resp.String())
+	}
+	logger.Info("Created index template", zap.String("name", name))
+	return nil
+}
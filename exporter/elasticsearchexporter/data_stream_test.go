@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestDataStreamName(t *testing.T) {
+	settings := DataStreamSettings{Enabled: true, Dataset: "generic", Namespace: "default"}
+
+	tests := map[string]struct {
+		resource map[string]string
+		record   map[string]string
+		want     string
+	}{
+		"defaults": {
+			want: "logs-generic-default",
+		},
+		"resource has priority": {
+			resource: map[string]string{dataStreamDataset: "nginx", dataStreamNamespace: "prod"},
+			record:   map[string]string{dataStreamDataset: "ignored"},
+			want:     "logs-nginx-prod",
+		},
+		"record attributes": {
+			record: map[string]string{dataStreamNamespace: "staging"},
+			want:   "logs-generic-staging",
+		},
+		"sanitized": {
+			resource: map[string]string{dataStreamDataset: "My-App:Access", dataStreamNamespace: "eu west"},
+			want:     "logs-my_app_access-eu_west",
+		},
+		"too long": {
+			resource: map[string]string{dataStreamDataset: strings.Repeat("a", 120)},
+			want:     "logs-" + strings.Repeat("a", maxDataStreamFieldLength) + "-default",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			resource := pcommon.NewResource()
+			fillResourceAttributeMap(resource.Attributes(), tt.resource)
+			record := pcommon.NewResource()
+			fillResourceAttributeMap(record.Attributes(), tt.record)
+
+			assert.Equal(t, tt.want, dataStreamName(dataStreamTypeLogs, settings, resource, record))
+		})
+	}
+}
+
+// newIndexTemplateTestServer serves the index template API, with the given existing templates.
+func newIndexTemplateTestServer(t *testing.T, existing ...string) (*httptest.Server, map[string]json.RawMessage) {
+	var mu sync.Mutex
+	templates := map[string]json.RawMessage{}
+	for _, name := range existing {
+		templates[name] = json.RawMessage(`{}`)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("X-Elastic-Product", "Elasticsearch")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"version": map[string]interface{}{"number": currentESVersion},
+		})
+	})
+	mux.HandleFunc("/_index_template/", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("X-Elastic-Product", "Elasticsearch")
+		name := strings.TrimPrefix(req.URL.Path, "/_index_template/")
+
+		mu.Lock()
+		defer mu.Unlock()
+		_, ok := templates[name]
+		switch req.Method {
+		case http.MethodHead:
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodPut:
+			if ok {
+				http.Error(w, "already exists", http.StatusBadRequest)
+				return
+			}
+			assert.Equal(t, "true", req.URL.Query().Get("create"))
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			templates[name] = body
+			_, _ = w.Write([]byte(`{"acknowledged":true}`))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, templates
+}
+
+func TestExporter_StartCreatesIndexTemplate(t *testing.T) {
+	t.Run("create template", func(t *testing.T) {
+		server, templates := newIndexTemplateTestServer(t)
+
+		exporter := newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.LogsDataStream.Enabled = true
+			cfg.IndexTemplate.Enabled = true
+			cfg.IndexTemplate.ILMPolicy = "otel-policy"
+		})
+		require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+
+		require.Contains(t, templates, "otel-logs")
+		assert.JSONEq(t, `{
+			"index_patterns": ["logs-*-*"],
+			"data_stream": {},
+			"priority": 200,
+			"template": {"settings": {"index.lifecycle.name": "otel-policy"}}
+		}`, string(templates["otel-logs"]))
+	})
+
+	t.Run("keep existing template", func(t *testing.T) {
+		server, templates := newIndexTemplateTestServer(t, "otel-traces")
+
+		exporter := newTestTracesExporter(t, server.URL, func(cfg *Config) {
+			cfg.TracesDataStream.Enabled = true
+			cfg.IndexTemplate.Enabled = true
+		})
+		require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+
+		assert.JSONEq(t, `{}`, string(templates["otel-traces"]))
+	})
+
+	t.Run("template bootstrap disabled", func(t *testing.T) {
+		server, templates := newIndexTemplateTestServer(t)
+
+		exporter := newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.LogsDataStream.Enabled = true
+		})
+		require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+
+		assert.Empty(t, templates)
+	})
+}
+
+func TestEnsureIndexTemplateError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("X-Elastic-Product", "Elasticsearch")
+		http.Error(w, "forbidden", http.StatusForbidden)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	cfg := withTestTracesExporterConfig(func(cfg *Config) {
+		cfg.Retry.Enabled = false
+	})(server.URL)
+	client, err := newElasticsearchClient(zaptest.NewLogger(t), cfg)
+	require.NoError(t, err)
+
+	err = ensureIndexTemplate(context.Background(), zaptest.NewLogger(t), client, dataStreamTypeLogs, cfg.IndexTemplate)
+	assert.ErrorContains(t, err, `failed to check the index template "otel-logs"`)
+}
@@ -136,14 +136,14 @@ func newTransport(config *Config, tlsCfg *tls.Config) *http.Transport {
 	return transport
 }
 
-func newBulkIndexer(logger *zap.Logger, client *elasticsearch7.Client, config *Config) (esBulkIndexerCurrent, error) {
+func newBulkIndexer(logger *zap.Logger, client *elasticsearch7.Client, config *Config, pipeline string) (esBulkIndexerCurrent, error) {
 	// TODO: add debug logger
 	return esutil7.NewBulkIndexer(esutil7.BulkIndexerConfig{
 		NumWorkers:    config.NumWorkers,
 		FlushBytes:    config.Flush.Bytes,
 		FlushInterval: config.Flush.Interval,
 		Client:        client,
-		Pipeline:      config.Pipeline,
+		Pipeline:      pipeline,
 		Timeout:       config.Timeout,
 
 		OnError: func(_ context.Context, err error) {
@@ -21,6 +21,12 @@ const (
 	// The value of "type" key in configuration.
 	defaultLogsIndex   = "logs-generic-default"
 	defaultTracesIndex = "traces-generic-default"
+
+	defaultDataStreamDataset   = "generic"
+	defaultDataStreamNamespace = "default"
+	// defaultIndexTemplatePriority is higher than the priority of the built-in
+	// logs-*-* and traces-*-* index templates of Elasticsearch.
+	defaultIndexTemplatePriority = 200
 )
 
 // NewFactory creates a factory for Elastic exporter.
@@ -44,6 +50,17 @@ func createDefaultConfig() component.Config {
 		Index:       "",
 		LogsIndex:   defaultLogsIndex,
 		TracesIndex: defaultTracesIndex,
+		LogsDataStream: DataStreamSettings{
+			Dataset:   defaultDataStreamDataset,
+			Namespace: defaultDataStreamNamespace,
+		},
+		TracesDataStream: DataStreamSettings{
+			Dataset:   defaultDataStreamDataset,
+			Namespace: defaultDataStreamNamespace,
+		},
+		IndexTemplate: IndexTemplateSettings{
+			Priority: defaultIndexTemplatePriority,
+		},
 		Retry: RetrySettings{
 			Enabled:         true,
 			MaxRequests:     3,
@@ -81,6 +98,7 @@ func createLogsExporter(
 		set,
 		cfg,
 		exporter.pushLogsData,
+		exporterhelper.WithStart(exporter.Start),
 		exporterhelper.WithShutdown(exporter.Shutdown),
 		exporterhelper.WithQueue(cf.QueueSettings),
 	)
@@ -100,6 +118,7 @@ func createTracesExporter(ctx context.Context,
 		set,
 		cfg,
 		exporter.pushTraceData,
+		exporterhelper.WithStart(exporter.Start),
 		exporterhelper.WithShutdown(exporter.Shutdown),
 		exporterhelper.WithQueue(cf.QueueSettings))
 }
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/multierr"
@@ -20,6 +21,8 @@ type elasticsearchLogsExporter struct {
 
 	index        string
 	dynamicIndex bool
+	dataStream   DataStreamSettings
+	template     IndexTemplateSettings
 	maxAttempts  int
 
 	client      *esClientCurrent
@@ -41,7 +44,11 @@ func newLogsExporter(logger *zap.Logger, cfg *Config) (*elasticsearchLogsExporte
 		return nil, err
 	}
 
-	bulkIndexer, err := newBulkIndexer(logger, client, cfg)
+	pipeline := cfg.Pipeline
+	if cfg.LogsPipeline != "" {
+		pipeline = cfg.LogsPipeline
+	}
+	bulkIndexer, err := newBulkIndexer(logger, client, cfg, pipeline)
 	if err != nil {
 		return nil, err
 	}
@@ -64,12 +71,22 @@ func newLogsExporter(logger *zap.Logger, cfg *Config) (*elasticsearchLogsExporte
 
 		index:        indexStr,
 		dynamicIndex: cfg.LogsDynamicIndex.Enabled,
+		dataStream:   cfg.LogsDataStream,
+		template:     cfg.IndexTemplate,
 		maxAttempts:  maxAttempts,
 		model:        model,
 	}
 	return esLogsExp, nil
 }
 
+// Start creates the index template of the data streams if the bootstrap is enabled.
+func (e *elasticsearchLogsExporter) Start(ctx context.Context, _ component.Host) error {
+	if !e.dataStream.Enabled || !e.template.Enabled {
+		return nil
+	}
+	return ensureIndexTemplate(ctx, e.logger, e.client, dataStreamTypeLogs, e.template)
+}
+
 func (e *elasticsearchLogsExporter) Shutdown(ctx context.Context) error {
 	return e.bulkIndexer.Close(ctx)
 }
@@ -101,7 +118,10 @@ func (e *elasticsearchLogsExporter) pushLogsData(ctx context.Context, ld plog.Lo
 
 func (e *elasticsearchLogsExporter) pushLogRecord(ctx context.Context, resource pcommon.Resource, record plog.LogRecord) error {
 	fIndex := e.index
-	if e.dynamicIndex {
+	switch {
+	case e.dataStream.Enabled:
+		fIndex = dataStreamName(dataStreamTypeLogs, e.dataStream, resource, record)
+	case e.dynamicIndex:
 		prefix := getFromBothResourceAndAttribute(indexPrefix, resource, record)
 		suffix := getFromBothResourceAndAttribute(indexSuffix, resource, record)
 
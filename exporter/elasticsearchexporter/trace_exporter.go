@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/multierr"
@@ -20,6 +21,8 @@ type elasticsearchTracesExporter struct {
 
 	index        string
 	dynamicIndex bool
+	dataStream   DataStreamSettings
+	template     IndexTemplateSettings
 	maxAttempts  int
 
 	client      *esClientCurrent
@@ -37,7 +40,11 @@ func newTracesExporter(logger *zap.Logger, cfg *Config) (*elasticsearchTracesExp
 		return nil, err
 	}
 
-	bulkIndexer, err := newBulkIndexer(logger, client, cfg)
+	pipeline := cfg.Pipeline
+	if cfg.TracesPipeline != "" {
+		pipeline = cfg.TracesPipeline
+	}
+	bulkIndexer, err := newBulkIndexer(logger, client, cfg, pipeline)
 	if err != nil {
 		return nil, err
 	}
@@ -56,11 +63,21 @@ func newTracesExporter(logger *zap.Logger, cfg *Config) (*elasticsearchTracesExp
 
 		index:        cfg.TracesIndex,
 		dynamicIndex: cfg.TracesDynamicIndex.Enabled,
+		dataStream:   cfg.TracesDataStream,
+		template:     cfg.IndexTemplate,
 		maxAttempts:  maxAttempts,
 		model:        model,
 	}, nil
 }
 
+// Start creates the index template of the data streams if the bootstrap is enabled.
+func (e *elasticsearchTracesExporter) Start(ctx context.Context, _ component.Host) error {
+	if !e.dataStream.Enabled || !e.template.Enabled {
+		return nil
+	}
+	return ensureIndexTemplate(ctx, e.logger, e.client, dataStreamTypeTraces, e.template)
+}
+
 func (e *elasticsearchTracesExporter) Shutdown(ctx context.Context) error {
 	return e.bulkIndexer.Close(ctx)
 }
@@ -93,7 +110,10 @@ func (e *elasticsearchTracesExporter) pushTraceData(
 
 func (e *elasticsearchTracesExporter) pushTraceRecord(ctx context.Context, resource pcommon.Resource, span ptrace.Span) error {
 	fIndex := e.index
-	if e.dynamicIndex {
+	switch {
+	case e.dataStream.Enabled:
+		fIndex = dataStreamName(dataStreamTypeTraces, e.dataStream, resource, span)
+	case e.dynamicIndex:
 		prefix := getFromBothResourceAndAttribute(indexPrefix, resource, span)
 		suffix := getFromBothResourceAndAttribute(indexSuffix, resource, span)
 
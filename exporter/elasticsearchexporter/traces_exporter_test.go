@@ -200,6 +200,35 @@ func TestExporter_PushTraceRecord(t *testing.T) {
 		rec.WaitItems(1)
 	})
 
+	t.Run("publish with data stream routing", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+
+			jsonVal := map[string]interface{}{}
+			assert.NoError(t, json.Unmarshal(docs[0].Action, &jsonVal))
+
+			create := jsonVal["create"].(map[string]interface{})
+			assert.Equal(t, "traces-nginx-production", create["_index"].(string))
+
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestTracesExporter(t, server.URL, func(cfg *Config) {
+			cfg.TracesDataStream.Enabled = true
+			cfg.TracesDataStream.Namespace = "production"
+		})
+
+		mustSendTracesWithAttributes(t, exporter,
+			map[string]string{
+				dataStreamDataset: "nginx",
+			},
+			map[string]string{},
+		)
+
+		rec.WaitItems(1)
+	})
+
 	t.Run("retry http request", func(t *testing.T) {
 		failures := 0
 		rec := newBulkRecorder()
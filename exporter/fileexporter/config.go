@@ -38,6 +38,10 @@ type Config struct {
 	// FlushInterval is the duration between flushes.
 	// See time.ParseDuration for valid values.
 	FlushInterval time.Duration `mapstructure:"flush_interval"`
+
+	// Header enables writing a header at the start of each file, describing the format,
+	// compression and framing of the data, the signals exported and the collector version.
+	Header bool `mapstructure:"header"`
 }
 
 // Rotation an option to rolling log files
@@ -67,6 +67,15 @@ func TestLoadConfig(t *testing.T) {
 				FlushInterval: time.Second,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "header"),
+			expected: &Config{
+				Path:          "./foo",
+				FormatType:    formatTypeJSON,
+				FlushInterval: time.Second,
+				Header:        true,
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "rotation_with_custom_settings"),
 			expected: &Config{
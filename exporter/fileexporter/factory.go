@@ -8,6 +8,8 @@ import (
 	"io"
 	"os"
 
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
@@ -21,6 +23,8 @@ import (
 const (
 	// the number of old log files to retain
 	defaultMaxBackups = 100
+	// the size of a file before lumberjack rotates it
+	defaultMaxMegabytes = 100
 
 	// the format of encoded telemetry data
 	formatTypeJSON  = "json"
@@ -32,6 +36,7 @@ const (
 
 // NewFactory creates a factory for OTLP exporter.
 func NewFactory() exporter.Factory {
+	_ = view.Register(MetricViews()...)
 	return exporter.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
@@ -58,8 +63,9 @@ func createTracesExporter(
 		return nil, err
 	}
 	fe := exporters.GetOrAdd(cfg, func() component.Component {
-		return newFileExporter(conf, writer)
+		return newFileExporter(conf, writer, set)
 	})
+	fe.Unwrap().(*fileExporter).addSignal(component.DataTypeTraces)
 	return exporterhelper.NewTracesExporter(
 		ctx,
 		set,
@@ -82,8 +88,9 @@ func createMetricsExporter(
 		return nil, err
 	}
 	fe := exporters.GetOrAdd(cfg, func() component.Component {
-		return newFileExporter(conf, writer)
+		return newFileExporter(conf, writer, set)
 	})
+	fe.Unwrap().(*fileExporter).addSignal(component.DataTypeMetrics)
 	return exporterhelper.NewMetricsExporter(
 		ctx,
 		set,
@@ -106,8 +113,9 @@ func createLogsExporter(
 		return nil, err
 	}
 	fe := exporters.GetOrAdd(cfg, func() component.Component {
-		return newFileExporter(conf, writer)
+		return newFileExporter(conf, writer, set)
 	})
+	fe.Unwrap().(*fileExporter).addSignal(component.DataTypeLogs)
 	return exporterhelper.NewLogsExporter(
 		ctx,
 		set,
@@ -119,8 +127,8 @@ func createLogsExporter(
 	)
 }
 
-func newFileExporter(conf *Config, writer io.WriteCloser) *fileExporter {
-	return &fileExporter{
+func newFileExporter(conf *Config, writer io.WriteCloser, set exporter.CreateSettings) *fileExporter {
+	fe := &fileExporter{
 		path:             conf.Path,
 		formatType:       conf.FormatType,
 		file:             writer,
@@ -131,7 +139,12 @@ func newFileExporter(conf *Config, writer io.WriteCloser) *fileExporter {
 		compression:      conf.Compression,
 		compressor:       buildCompressor(conf.Compression),
 		flushInterval:    conf.FlushInterval,
+		exporterTag:      tag.Upsert(exporterTagKey, set.ID.String()),
 	}
+	if conf.Header {
+		fe.header = newFileHeader(conf, set.BuildInfo.Version)
+	}
+	return fe
 }
 
 func buildFileWriter(cfg *Config) (io.WriteCloser, error) {
@@ -142,13 +155,13 @@ func buildFileWriter(cfg *Config) (io.WriteCloser, error) {
 		}
 		return newBufferedWriteCloser(f), nil
 	}
-	return &lumberjack.Logger{
+	return newRotatingWriter(&lumberjack.Logger{
 		Filename:   cfg.Path,
 		MaxSize:    cfg.Rotation.MaxMegabytes,
 		MaxAge:     cfg.Rotation.MaxDays,
 		MaxBackups: cfg.Rotation.MaxBackups,
 		LocalTime:  cfg.Rotation.LocalTime,
-	}, nil
+	}), nil
 }
 
 // This is the map of already created File exporters for particular configurations.
@@ -7,9 +7,12 @@ import (
 	"context"
 	"encoding/binary"
 	"io"
+	"sort"
 	"sync"
 	"time"
 
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -52,33 +55,119 @@ type fileExporter struct {
 	flushInterval time.Duration
 	flushTicker   *time.Ticker
 	stopTicker    chan struct{}
+
+	// header is written at the start of each file, if enabled.
+	header *fileHeader
+	// signals lists the data types exported to the file.
+	signals []string
+
+	exporterTag tag.Mutator
+	// fileSize is the size of the current file, when it is not rotated.
+	fileSize int64
+	// rotations is the number of rotations already recorded.
+	rotations int64
+}
+
+// addSignal records that the given data type is exported to the file.
+func (e *fileExporter) addSignal(dataType component.DataType) {
+	for _, s := range e.signals {
+		if s == string(dataType) {
+			return
+		}
+	}
+	e.signals = append(e.signals, string(dataType))
+	sort.Strings(e.signals)
+}
+
+// writeHeader writes the file header, or hands it to the writer if it writes one per file.
+func (e *fileExporter) writeHeader() error {
+	e.header.Signals = e.signals
+	encoded, err := e.header.marshal()
+	if err != nil {
+		return err
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if rw, ok := e.file.(*rotatingWriter); ok {
+		rw.header = encoded
+		return nil
+	}
+	n, err := e.file.Write(encoded)
+	e.fileSize += int64(n)
+	return err
 }
 
-func (e *fileExporter) consumeTraces(_ context.Context, td ptrace.Traces) error {
+func (e *fileExporter) consumeTraces(ctx context.Context, td ptrace.Traces) error {
 	buf, err := e.tracesMarshaler.MarshalTraces(td)
 	if err != nil {
 		return err
 	}
 	buf = e.compressor(buf)
-	return e.exporter(e, buf)
+	return e.export(ctx, buf)
 }
 
-func (e *fileExporter) consumeMetrics(_ context.Context, md pmetric.Metrics) error {
+func (e *fileExporter) consumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 	buf, err := e.metricsMarshaler.MarshalMetrics(md)
 	if err != nil {
 		return err
 	}
 	buf = e.compressor(buf)
-	return e.exporter(e, buf)
+	return e.export(ctx, buf)
 }
 
-func (e *fileExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
+func (e *fileExporter) consumeLogs(ctx context.Context, ld plog.Logs) error {
 	buf, err := e.logsMarshaler.MarshalLogs(ld)
 	if err != nil {
 		return err
 	}
 	buf = e.compressor(buf)
-	return e.exporter(e, buf)
+	return e.export(ctx, buf)
+}
+
+// export writes an encoded batch to the file and records the write in the exporter's metrics.
+func (e *fileExporter) export(ctx context.Context, buf []byte) error {
+	start := time.Now()
+	err := e.exporter(e, buf)
+	latency := float64(time.Since(start)) / float64(time.Millisecond)
+
+	success := "true"
+	if err != nil {
+		success = "false"
+	}
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{e.exporterTag, tag.Upsert(successTagKey, success)}, mWriteLatency.M(latency))
+	if err != nil {
+		return err
+	}
+
+	written := int64(len(buf))
+	if e.framing() == framingLines {
+		written++
+	} else {
+		written += 4
+	}
+	measurements := []stats.Measurement{mWrittenBytes.M(written)}
+
+	e.mutex.Lock()
+	if rw, ok := e.file.(*rotatingWriter); ok {
+		measurements = append(measurements, mFileSize.M(rw.size))
+		if rotations := rw.rotations - e.rotations; rotations > 0 {
+			measurements = append(measurements, mRotations.M(rotations))
+			e.rotations = rw.rotations
+		}
+	} else {
+		e.fileSize += written
+		measurements = append(measurements, mFileSize.M(e.fileSize))
+	}
+	e.mutex.Unlock()
+
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{e.exporterTag}, measurements...)
+	return nil
+}
+
+// framing returns how encoded batches are delimited in the file.
+func (e *fileExporter) framing() string {
+	return framingFor(&Config{FormatType: e.formatType, Compression: e.compression})
 }
 
 func exportMessageAsLine(e *fileExporter, buf []byte) error {
@@ -135,8 +224,13 @@ func (e *fileExporter) startFlusher() {
 	}()
 }
 
-// Start starts the flush timer if set.
+// Start writes the file header and starts the flush timer if set.
 func (e *fileExporter) Start(context.Context, component.Host) error {
+	if e.header != nil {
+		if err := e.writeHeader(); err != nil {
+			return err
+		}
+	}
 	if e.flushInterval > 0 {
 		e.startFlusher()
 	}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import "encoding/json"
+
+const (
+	// headerVersion is the version of the header layout.
+	headerVersion = 1
+
+	framingLines          = "lines"
+	framingLengthPrefixed = "length_prefixed"
+)
+
+// fileHeader describes how the data following it in a file is encoded.
+// It is written as a single JSON line, whatever the format of the data.
+type fileHeader struct {
+	Version          int      `json:"version"`
+	Format           string   `json:"format"`
+	Compression      string   `json:"compression,omitempty"`
+	Framing          string   `json:"framing"`
+	Signals          []string `json:"signals"`
+	CollectorVersion string   `json:"collector_version,omitempty"`
+}
+
+func newFileHeader(cfg *Config, collectorVersion string) *fileHeader {
+	return &fileHeader{
+		Version:          headerVersion,
+		Format:           cfg.FormatType,
+		Compression:      cfg.Compression,
+		Framing:          framingFor(cfg),
+		CollectorVersion: collectorVersion,
+	}
+}
+
+// framingFor returns how encoded batches are delimited in files written with cfg.
+func framingFor(cfg *Config) string {
+	if cfg.FormatType == formatTypeProto || cfg.Compression != "" {
+		return framingLengthPrefixed
+	}
+	return framingLines
+}
+
+func (h *fileHeader) marshal() ([]byte, error) {
+	encoded, err := json.Marshal(struct {
+		Header *fileHeader `json:"otel_file_header"`
+	}{h})
+	if err != nil {
+		return nil, err
+	}
+	return append(encoded, '\n'), nil
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+func TestFileHeader(t *testing.T) {
+	tests := []struct {
+		name            string
+		formatType      string
+		compression     string
+		rotation        *Rotation
+		expectedFraming string
+		unmarshaler     ptrace.Unmarshaler
+	}{
+		{
+			name:            "json",
+			formatType:      formatTypeJSON,
+			expectedFraming: framingLines,
+			unmarshaler:     &ptrace.JSONUnmarshaler{},
+		},
+		{
+			name:            "json with compression",
+			formatType:      formatTypeJSON,
+			compression:     compressionZSTD,
+			expectedFraming: framingLengthPrefixed,
+			unmarshaler:     &ptrace.JSONUnmarshaler{},
+		},
+		{
+			name:            "proto with rotation",
+			formatType:      formatTypeProto,
+			rotation:        &Rotation{MaxMegabytes: 1, MaxBackups: defaultMaxBackups},
+			expectedFraming: framingLengthPrefixed,
+			unmarshaler:     &ptrace.ProtoUnmarshaler{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Path:        tempFileName(t),
+				FormatType:  tt.formatType,
+				Compression: tt.compression,
+				Rotation:    tt.rotation,
+				Header:      true,
+			}
+			factory := NewFactory()
+			set := exportertest.NewNopCreateSettings()
+			te, err := factory.CreateTracesExporter(context.Background(), set, cfg)
+			require.NoError(t, err)
+			_, err = factory.CreateLogsExporter(context.Background(), set, cfg)
+			require.NoError(t, err)
+
+			td := testdata.GenerateTracesTwoSpansSameResource()
+			require.NoError(t, te.Start(context.Background(), componenttest.NewNopHost()))
+			require.NoError(t, te.ConsumeTraces(context.Background(), td))
+			require.NoError(t, te.Shutdown(context.Background()))
+
+			fi, err := os.Open(cfg.Path)
+			require.NoError(t, err)
+			defer fi.Close()
+			br := bufio.NewReader(fi)
+
+			header, err := readFileHeader(br)
+			require.NoError(t, err)
+			require.NotNil(t, header)
+			assert.Equal(t, &fileHeader{
+				Version:          headerVersion,
+				Format:           tt.formatType,
+				Compression:      tt.compression,
+				Framing:          tt.expectedFraming,
+				Signals:          []string{"logs", "traces"},
+				CollectorVersion: set.BuildInfo.Version,
+			}, header)
+
+			// The data follows the header
+			var buf []byte
+			if header.Framing == framingLines {
+				buf, _, err = readJSONMessage(br)
+			} else {
+				buf, _, err = readMessageFromStream(br)
+			}
+			require.NoError(t, err)
+			buf, err = buildUnCompressor(header.Compression)(buf)
+			require.NoError(t, err)
+			got, err := tt.unmarshaler.UnmarshalTraces(buf)
+			require.NoError(t, err)
+			assert.EqualValues(t, td, got)
+		})
+	}
+}
+
+func TestReadFileHeaderWithoutHeader(t *testing.T) {
+	br := bufio.NewReader(bytes.NewBufferString(`{"resourceSpans":[]}` + "\n"))
+	header, err := readFileHeader(br)
+	require.NoError(t, err)
+	require.Nil(t, header)
+
+	// Nothing is consumed
+	line, _, err := br.ReadLine()
+	require.NoError(t, err)
+	require.Equal(t, `{"resourceSpans":[]}`, string(line))
+}
+
+// headerPrefix starts the first line of every file written with a header.
+var headerPrefix = []byte(`{"otel_file_header":`)
+
+// readFileHeader reads the header at the start of a file. It returns nil if the file has no header,
+// in which case nothing is consumed from br.
+func readFileHeader(br *bufio.Reader) (*fileHeader, error) {
+	prefix, err := br.Peek(len(headerPrefix))
+	if err != nil || !bytes.Equal(prefix, headerPrefix) {
+		return nil, nil
+	}
+	line, err := br.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+	var decoded struct {
+		Header *fileHeader `json:"otel_file_header"`
+	}
+	if err = json.Unmarshal(line, &decoded); err != nil {
+		return nil, err
+	}
+	if decoded.Header == nil {
+		return nil, errors.New("empty file header")
+	}
+	return decoded.Header, nil
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	mWrittenBytes = stats.Int64("fileexporter_written_bytes", "Number of bytes written to files", stats.UnitBytes)
+	mFileSize     = stats.Int64("fileexporter_file_size", "Size of the file currently written to", stats.UnitBytes)
+	mRotations    = stats.Int64("fileexporter_rotations", "Number of times the file was rotated", stats.UnitDimensionless)
+	mWriteLatency = stats.Float64("fileexporter_write_latency", "Time taken to write a batch to the file", stats.UnitMilliseconds)
+
+	exporterTagKey = tag.MustNewKey("exporter")
+	successTagKey  = tag.MustNewKey("success")
+)
+
+// MetricViews returns the metrics views related to the file exporter.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        mWrittenBytes.Name(),
+			Measure:     mWrittenBytes,
+			Description: mWrittenBytes.Description(),
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{exporterTagKey},
+		},
+		{
+			Name:        mFileSize.Name(),
+			Measure:     mFileSize,
+			Description: mFileSize.Description(),
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{exporterTagKey},
+		},
+		{
+			Name:        mRotations.Name(),
+			Measure:     mRotations,
+			Description: mRotations.Description(),
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{exporterTagKey},
+		},
+		{
+			Name:        mWriteLatency.Name(),
+			Measure:     mWriteLatency,
+			Description: mWriteLatency.Description(),
+			Aggregation: view.Distribution(0, 1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000),
+			TagKeys:     []tag.Key{exporterTagKey, successTagKey},
+		},
+	}
+}
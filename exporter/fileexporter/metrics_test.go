@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+func TestMetricViews(t *testing.T) {
+	views := MetricViews()
+	require.Len(t, views, 4)
+	for _, v := range views {
+		assert.NotEmpty(t, v.Name)
+		assert.NotEmpty(t, v.Description)
+		assert.NotNil(t, v.Aggregation)
+	}
+}
+
+func TestExportRecordsMetrics(t *testing.T) {
+	// start from empty views, in case they were registered by NewFactory
+	views := MetricViews()
+	view.Unregister(views...)
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	cfg := &Config{
+		Path:       filepath.Join(t.TempDir(), "file.json"),
+		FormatType: formatTypeJSON,
+		Rotation:   &Rotation{MaxMegabytes: 1},
+	}
+	writer, err := buildFileWriter(cfg)
+	require.NoError(t, err)
+	fe := newFileExporter(cfg, writer, exportertest.NewNopCreateSettings())
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+
+	td := testdata.GenerateTracesTwoSpansSameResource()
+	require.NoError(t, fe.consumeTraces(context.Background(), td))
+	require.NoError(t, fe.consumeTraces(context.Background(), td))
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	buf, err := (&ptrace.JSONMarshaler{}).MarshalTraces(td)
+	require.NoError(t, err)
+	expected := 2 * int64(len(buf)+1)
+
+	rows, err := view.RetrieveData(mWrittenBytes.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(expected), rows[0].Data.(*view.SumData).Value)
+
+	rows, err = view.RetrieveData(mFileSize.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(expected), rows[0].Data.(*view.LastValueData).Value)
+
+	rows, err = view.RetrieveData(mWriteLatency.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(2), rows[0].Data.(*view.DistributionData).Count)
+
+	rows, err = view.RetrieveData(mRotations.Name())
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+}
+
+func TestExportRecordsRotations(t *testing.T) {
+	views := MetricViews()
+	view.Unregister(views...)
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	cfg := &Config{
+		Path:       filepath.Join(t.TempDir(), "file.json"),
+		FormatType: formatTypeJSON,
+		Rotation:   &Rotation{MaxMegabytes: 1},
+	}
+	writer, err := buildFileWriter(cfg)
+	require.NoError(t, err)
+	fe := newFileExporter(cfg, writer, exportertest.NewNopCreateSettings())
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+
+	buf := make([]byte, megabyte/2)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, fe.export(context.Background(), buf))
+	}
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	rows, err := view.RetrieveData(mRotations.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(2), rows[0].Data.(*view.SumData).Value)
+
+	rows, err = view.RetrieveData(mFileSize.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(megabyte/2+1), rows[0].Data.(*view.LastValueData).Value)
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"os"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const megabyte = 1024 * 1024
+
+// rotatingWriter writes to files rotated by lumberjack. It rotates files itself, before
+// lumberjack would, so that it knows the size of the current file and when a new file
+// starts, at which point it writes the file header.
+type rotatingWriter struct {
+	*lumberjack.Logger
+	// header is written at the start of each file, if set.
+	header []byte
+	// size is the size of the current file, or -1 until the first write.
+	size int64
+	// rotations is the number of times a file was rotated.
+	rotations int64
+}
+
+func newRotatingWriter(logger *lumberjack.Logger) *rotatingWriter {
+	return &rotatingWriter{Logger: logger, size: -1}
+}
+
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	if w.size < 0 {
+		// lumberjack appends to an existing file, unless the write would make it too large
+		w.size = 0
+		if info, err := os.Stat(w.Filename); err == nil {
+			w.size = info.Size()
+		}
+		if w.size > 0 && w.size+int64(len(p)) >= w.maxSize() {
+			w.size = w.maxSize()
+		}
+	}
+
+	if w.size > 0 && w.size+int64(len(p)) > w.maxSize() {
+		if err := w.Logger.Rotate(); err != nil {
+			return 0, err
+		}
+		w.size = 0
+		w.rotations++
+	}
+	if w.size == 0 && len(w.header) > 0 {
+		n, err := w.Logger.Write(w.header)
+		w.size += int64(n)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.Logger.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *rotatingWriter) maxSize() int64 {
+	if w.MaxSize == 0 {
+		return int64(defaultMaxMegabytes * megabyte)
+	}
+	return int64(w.MaxSize) * megabyte
+}
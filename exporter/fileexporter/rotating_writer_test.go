@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+func TestRotatingWriter(t *testing.T) {
+	dir := t.TempDir()
+	hw := newRotatingWriter(&lumberjack.Logger{
+		Filename: filepath.Join(dir, "data.json"),
+		MaxSize:  1,
+	})
+	hw.header = []byte(`{"otel_file_header":{}}` + "\n")
+
+	chunk := bytes.Repeat([]byte("a"), 400*1024)
+	for i := 0; i < 3; i++ {
+		_, err := hw.Write(chunk)
+		require.NoError(t, err)
+	}
+	require.NoError(t, hw.Close())
+	require.Equal(t, int64(1), hw.rotations)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	for _, f := range files {
+		data, err := os.ReadFile(filepath.Join(dir, f.Name()))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data, hw.header), "%s does not start with the header", f.Name())
+		assert.Equal(t, 1, bytes.Count(data, hw.header))
+	}
+}
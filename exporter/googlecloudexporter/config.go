@@ -4,6 +4,7 @@
 package googlecloudexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter"
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/collector"
@@ -18,11 +19,58 @@ type Config struct {
 	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
 	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
 	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
+
+	// LogRouting routes logs to log names and projects based on their resource attributes.
+	LogRouting LogRoutingConfig `mapstructure:"log_routing"`
+}
+
+// LogRoutingConfig defines how logs are routed based on their resource attributes.
+type LogRoutingConfig struct {
+	// ProjectAttribute is the resource attribute holding the project logs are sent to.
+	// Logs without it are sent to the configured project.
+	ProjectAttribute string `mapstructure:"project_attribute"`
+
+	// LogNameAttribute is the resource attribute holding the log name of the log entries
+	// that don't have the `gcp.log_name` attribute set.
+	LogNameAttribute string `mapstructure:"log_name_attribute"`
+
+	// Projects defines the credentials used to send logs to specific projects.
+	// Projects not listed use the credentials of the exporter.
+	Projects map[string]ProjectCredentials `mapstructure:"projects"`
+}
+
+// ProjectCredentials defines the credentials used to send logs to a project.
+type ProjectCredentials struct {
+	// CredentialsFile is the path to a service account key file.
+	CredentialsFile string `mapstructure:"credentials_file"`
+
+	// Impersonate is the service account to impersonate.
+	Impersonate collector.ImpersonateConfig `mapstructure:"impersonate"`
 }
 
 func (cfg *Config) Validate() error {
 	if err := collector.ValidateConfig(cfg.Config); err != nil {
 		return fmt.Errorf("googlecloud exporter settings are invalid :%w", err)
 	}
+	return cfg.LogRouting.Validate()
+}
+
+func (cfg *LogRoutingConfig) Validate() error {
+	if len(cfg.Projects) > 0 && cfg.ProjectAttribute == "" {
+		return errors.New("log_routing projects require a project_attribute")
+	}
+	for project, creds := range cfg.Projects {
+		if project == "" {
+			return errors.New("log_routing projects must not be empty")
+		}
+		if (creds.CredentialsFile == "") == (creds.Impersonate.TargetPrincipal == "") {
+			return fmt.Errorf("log_routing project %q: exactly one of credentials_file or impersonate must be set", project)
+		}
+	}
 	return nil
 }
+
+// enabled reports whether logs are routed rather than sent as they are.
+func (cfg *LogRoutingConfig) enabled() bool {
+	return cfg.ProjectAttribute != "" || cfg.LogNameAttribute != ""
+}
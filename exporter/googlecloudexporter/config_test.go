@@ -78,10 +78,70 @@ func TestLoadConfig(t *testing.T) {
 				NumConsumers: 2,
 				QueueSize:    10,
 			},
+			LogRouting: LogRoutingConfig{
+				ProjectAttribute: "k8s.namespace.name",
+				LogNameAttribute: "service.name",
+				Projects: map[string]ProjectCredentials{
+					"team-a": {CredentialsFile: "/etc/otel/team-a.json"},
+					"team-b": {Impersonate: collector.ImpersonateConfig{TargetPrincipal: "logs@team-b.iam.gserviceaccount.com"}},
+				},
+			},
 		},
 		sanitize(cfg.(*Config)))
 }
 
+func TestLogRoutingValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     LogRoutingConfig
+		wantErr string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name: "log name only",
+			cfg:  LogRoutingConfig{LogNameAttribute: "service.name"},
+		},
+		{
+			name: "projects without attribute",
+			cfg: LogRoutingConfig{
+				Projects: map[string]ProjectCredentials{"team-a": {CredentialsFile: "team-a.json"}},
+			},
+			wantErr: "log_routing projects require a project_attribute",
+		},
+		{
+			name: "project without credentials",
+			cfg: LogRoutingConfig{
+				ProjectAttribute: "project",
+				Projects:         map[string]ProjectCredentials{"team-a": {}},
+			},
+			wantErr: `log_routing project "team-a": exactly one of credentials_file or impersonate must be set`,
+		},
+		{
+			name: "project with both credentials",
+			cfg: LogRoutingConfig{
+				ProjectAttribute: "project",
+				Projects: map[string]ProjectCredentials{"team-a": {
+					CredentialsFile: "team-a.json",
+					Impersonate:     collector.ImpersonateConfig{TargetPrincipal: "logs@team-a.iam.gserviceaccount.com"},
+				}},
+			},
+			wantErr: `log_routing project "team-a": exactly one of credentials_file or impersonate must be set`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
 func sanitize(cfg *Config) *Config {
 	cfg.Config.MetricConfig.MapMonitoredResource = nil
 	cfg.Config.MetricConfig.GetMetricName = nil
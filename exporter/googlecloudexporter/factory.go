@@ -58,7 +58,13 @@ func createLogsExporter(
 	params exporter.CreateSettings,
 	cfg component.Config) (exporter.Logs, error) {
 	eCfg := cfg.(*Config)
-	logsExporter, err := collector.NewGoogleCloudLogsExporter(ctx, eCfg.Config, params.TelemetrySettings.Logger)
+	var logsExporter logsPusher
+	var err error
+	if eCfg.LogRouting.enabled() {
+		logsExporter, err = newRoutingLogsExporter(ctx, eCfg, params.TelemetrySettings.Logger, newGoogleCloudLogsPusher(params.TelemetrySettings.Logger))
+	} else {
+		logsExporter, err = collector.NewGoogleCloudLogsExporter(ctx, eCfg.Config, params.TelemetrySettings.Logger)
+	}
 	if err != nil {
 		return nil, err
 	}
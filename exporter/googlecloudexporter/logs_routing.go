@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlecloudexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/collector"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/api/option"
+)
+
+// projectIDAttributeKey is the resource attribute the Google Cloud
+// logs exporter reads the destination project from.
+const projectIDAttributeKey = "gcp.project.id"
+
+// logsPusher is the subset of the Google Cloud logs exporter used
+// to send the routed logs.
+type logsPusher interface {
+	PushLogs(ctx context.Context, ld plog.Logs) error
+	Shutdown(ctx context.Context) error
+}
+
+type newLogsPusherFunc func(ctx context.Context, cfg collector.Config) (logsPusher, error)
+
+func newGoogleCloudLogsPusher(log *zap.Logger) newLogsPusherFunc {
+	return func(ctx context.Context, cfg collector.Config) (logsPusher, error) {
+		return collector.NewGoogleCloudLogsExporter(ctx, cfg, log)
+	}
+}
+
+// routingLogsExporter fans out logs to an exporter per destination project,
+// so that each project uses its own credentials.
+type routingLogsExporter struct {
+	cfg         *Config
+	logger      *zap.Logger
+	newExporter newLogsPusherFunc
+
+	// defaultExporter sends the logs without a project attribute, and to the
+	// projects without credentials of their own.
+	defaultExporter logsPusher
+
+	mu        sync.Mutex
+	exporters map[string]logsPusher
+}
+
+func newRoutingLogsExporter(ctx context.Context, cfg *Config, logger *zap.Logger, newExporter newLogsPusherFunc) (*routingLogsExporter, error) {
+	defaultExporter, err := newExporter(ctx, cfg.Config)
+	if err != nil {
+		return nil, err
+	}
+	return &routingLogsExporter{
+		cfg:             cfg,
+		logger:          logger,
+		newExporter:     newExporter,
+		defaultExporter: defaultExporter,
+		exporters:       make(map[string]logsPusher),
+	}, nil
+}
+
+// PushLogs sends the logs of each project with the exporter of the project. Only the logs of
+// the projects failing with a retryable error are returned to be retried, so that the logs
+// already sent to the other projects aren't sent again.
+func (e *routingLogsExporter) PushLogs(ctx context.Context, ld plog.Logs) error {
+	var errs, permanentErrs error
+	failed := plog.NewLogs()
+	for project, logs := range e.route(ld) {
+		err := e.pushProjectLogs(ctx, project, logs)
+		switch {
+		case err == nil:
+		case consumererror.IsPermanent(err):
+			e.logger.Error("Exporting the logs of the project failed. The error is not retryable. Dropping data.",
+				zap.String("project", project),
+				zap.Error(err),
+				zap.Int("dropped_items", logs.LogRecordCount()))
+			permanentErrs = multierr.Append(permanentErrs, err)
+		default:
+			errs = multierr.Append(errs, err)
+			logs.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+		}
+	}
+	if errs == nil {
+		return permanentErrs
+	}
+	// The permanent errors are left out, as they would prevent the retry of the other logs.
+	return consumererror.NewLogs(errs, failed)
+}
+
+func (e *routingLogsExporter) pushProjectLogs(ctx context.Context, project string, logs plog.Logs) error {
+	exp, err := e.exporter(ctx, project)
+	if err != nil {
+		return err
+	}
+	return exp.PushLogs(ctx, logs)
+}
+
+// route splits the logs by destination project, setting the log name
+// of the log records from the resource attributes.
+func (e *routingLogsExporter) route(ld plog.Logs) map[string]plog.Logs {
+	routed := make(map[string]plog.Logs)
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		project := ""
+		if e.cfg.LogRouting.ProjectAttribute != "" {
+			if v, ok := rl.Resource().Attributes().Get(e.cfg.LogRouting.ProjectAttribute); ok {
+				project = v.AsString()
+			}
+		}
+
+		logs, ok := routed[project]
+		if !ok {
+			logs = plog.NewLogs()
+			routed[project] = logs
+		}
+		dest := logs.ResourceLogs().AppendEmpty()
+		rl.CopyTo(dest)
+		if project != "" {
+			dest.Resource().Attributes().PutStr(projectIDAttributeKey, project)
+		}
+		e.setLogName(dest)
+	}
+	return routed
+}
+
+func (e *routingLogsExporter) setLogName(rl plog.ResourceLogs) {
+	if e.cfg.LogRouting.LogNameAttribute == "" {
+		return
+	}
+	logName, ok := rl.Resource().Attributes().Get(e.cfg.LogRouting.LogNameAttribute)
+	if !ok || logName.AsString() == "" {
+		return
+	}
+	for i := 0; i < rl.ScopeLogs().Len(); i++ {
+		records := rl.ScopeLogs().At(i).LogRecords()
+		for j := 0; j < records.Len(); j++ {
+			attrs := records.At(j).Attributes()
+			if _, ok := attrs.Get(collector.LogNameAttributeKey); !ok {
+				attrs.PutStr(collector.LogNameAttributeKey, logName.AsString())
+			}
+		}
+	}
+}
+
+// exporter returns the exporter sending logs to the project,
+// creating it on first use for projects with their own credentials.
+func (e *routingLogsExporter) exporter(ctx context.Context, project string) (logsPusher, error) {
+	creds, ok := e.cfg.LogRouting.Projects[project]
+	if !ok {
+		return e.defaultExporter, nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if exp, ok := e.exporters[project]; ok {
+		return exp, nil
+	}
+	exp, err := e.newExporter(ctx, projectConfig(e.cfg.Config, project, creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the exporter of project %q: %w", project, err)
+	}
+	e.exporters[project] = exp
+	return exp, nil
+}
+
+func (e *routingLogsExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	errs := e.defaultExporter.Shutdown(ctx)
+	for _, exp := range e.exporters {
+		errs = multierr.Append(errs, exp.Shutdown(ctx))
+	}
+	return errs
+}
+
+// projectConfig returns the configuration of the exporter sending logs
+// to the project using its credentials.
+func projectConfig(cfg collector.Config, project string, creds ProjectCredentials) collector.Config {
+	cfg.ProjectID = project
+	cfg.ImpersonateConfig = creds.Impersonate
+	if creds.CredentialsFile != "" {
+		file := creds.CredentialsFile
+		cfg.LogConfig.ClientConfig.GetClientOptions = func() []option.ClientOption {
+			return []option.ClientOption{option.WithCredentialsFile(file)}
+		}
+	}
+	return cfg
+}
@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlecloudexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/collector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+type fakeLogsPusher struct {
+	cfg      collector.Config
+	logs     []plog.Logs
+	err      error
+	shutdown bool
+}
+
+func (f *fakeLogsPusher) PushLogs(_ context.Context, ld plog.Logs) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.logs = append(f.logs, ld)
+	return nil
+}
+
+func (f *fakeLogsPusher) Shutdown(context.Context) error {
+	f.shutdown = true
+	return nil
+}
+
+func newTestRoutingLogsExporter(t *testing.T, routing LogRoutingConfig) (*routingLogsExporter, map[string]*fakeLogsPusher) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ProjectID = "default-project"
+	cfg.LogRouting = routing
+
+	pushers := make(map[string]*fakeLogsPusher)
+	exp, err := newRoutingLogsExporter(context.Background(), cfg, zap.NewNop(), func(_ context.Context, cfg collector.Config) (logsPusher, error) {
+		p := &fakeLogsPusher{cfg: cfg}
+		pushers[cfg.ProjectID] = p
+		return p, nil
+	})
+	require.NoError(t, err)
+	return exp, pushers
+}
+
+func appendResourceLogs(ld plog.Logs, attrs map[string]string, recordLogNames ...string) {
+	rl := ld.ResourceLogs().AppendEmpty()
+	for k, v := range attrs {
+		rl.Resource().Attributes().PutStr(k, v)
+	}
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for _, name := range recordLogNames {
+		record := records.AppendEmpty()
+		if name != "" {
+			record.Attributes().PutStr(collector.LogNameAttributeKey, name)
+		}
+	}
+}
+
+func TestRoutingLogsExporterProjects(t *testing.T) {
+	exp, pushers := newTestRoutingLogsExporter(t, LogRoutingConfig{
+		ProjectAttribute: "project",
+		Projects: map[string]ProjectCredentials{
+			"team-a": {CredentialsFile: "team-a.json"},
+		},
+	})
+
+	ld := plog.NewLogs()
+	appendResourceLogs(ld, map[string]string{"project": "team-a"}, "")
+	appendResourceLogs(ld, map[string]string{"project": "team-b"}, "")
+	appendResourceLogs(ld, map[string]string{"project": "team-a"}, "")
+	appendResourceLogs(ld, nil, "")
+	require.NoError(t, exp.PushLogs(context.Background(), ld))
+
+	require.Len(t, pushers, 2)
+	teamA := pushers["team-a"]
+	require.NotNil(t, teamA)
+	require.Len(t, teamA.logs, 1)
+	assert.Equal(t, 2, teamA.logs[0].ResourceLogs().Len())
+	require.NotNil(t, teamA.cfg.LogConfig.ClientConfig.GetClientOptions)
+	assert.Len(t, teamA.cfg.LogConfig.ClientConfig.GetClientOptions(), 1)
+
+	// Projects without credentials of their own share the default exporter,
+	// which sends them to the project of their gcp.project.id attribute.
+	def := pushers["default-project"]
+	require.NotNil(t, def)
+	require.Len(t, def.logs, 2)
+	var projects []string
+	for _, logs := range def.logs {
+		require.Equal(t, 1, logs.ResourceLogs().Len())
+		project, ok := logs.ResourceLogs().At(0).Resource().Attributes().Get(projectIDAttributeKey)
+		if ok {
+			projects = append(projects, project.Str())
+		}
+	}
+	assert.Equal(t, []string{"team-b"}, projects)
+
+	// The exporter of a project is only created once.
+	require.NoError(t, exp.PushLogs(context.Background(), ld))
+	assert.Same(t, teamA, pushers["team-a"])
+	assert.Len(t, teamA.logs, 2)
+
+	// The logs passed to the exporter are not modified.
+	_, ok := ld.ResourceLogs().At(0).Resource().Attributes().Get(projectIDAttributeKey)
+	assert.False(t, ok)
+
+	require.NoError(t, exp.Shutdown(context.Background()))
+	assert.True(t, teamA.shutdown)
+	assert.True(t, def.shutdown)
+}
+
+func TestRoutingLogsExporterLogName(t *testing.T) {
+	exp, pushers := newTestRoutingLogsExporter(t, LogRoutingConfig{
+		LogNameAttribute: "service.name",
+	})
+
+	ld := plog.NewLogs()
+	appendResourceLogs(ld, map[string]string{"service.name": "checkout"}, "", "explicit")
+	appendResourceLogs(ld, nil, "")
+	require.NoError(t, exp.PushLogs(context.Background(), ld))
+
+	def := pushers["default-project"]
+	require.Len(t, def.logs, 1)
+	logs := def.logs[0]
+	require.Equal(t, 2, logs.ResourceLogs().Len())
+
+	records := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	name, ok := records.At(0).Attributes().Get(collector.LogNameAttributeKey)
+	require.True(t, ok)
+	assert.Equal(t, "checkout", name.Str())
+	name, ok = records.At(1).Attributes().Get(collector.LogNameAttributeKey)
+	require.True(t, ok)
+	assert.Equal(t, "explicit", name.Str())
+
+	_, ok = logs.ResourceLogs().At(1).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(collector.LogNameAttributeKey)
+	assert.False(t, ok)
+}
+
+func TestRoutingLogsExporterCreateError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.LogRouting = LogRoutingConfig{
+		ProjectAttribute: "project",
+		Projects: map[string]ProjectCredentials{
+			"team-a": {CredentialsFile: "team-a.json"},
+		},
+	}
+	def := &fakeLogsPusher{}
+	exp, err := newRoutingLogsExporter(context.Background(), cfg, zap.NewNop(), func(_ context.Context, cfg collector.Config) (logsPusher, error) {
+		if cfg.ProjectID == "team-a" {
+			return nil, errors.New("invalid credentials")
+		}
+		return def, nil
+	})
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	appendResourceLogs(ld, map[string]string{"project": "team-a"}, "")
+	appendResourceLogs(ld, nil, "")
+	assert.ErrorContains(t, exp.PushLogs(context.Background(), ld), `project "team-a"`)
+	assert.Len(t, def.logs, 1)
+}
+
+func TestRoutingLogsExporterPartialFailure(t *testing.T) {
+	exp, pushers := newTestRoutingLogsExporter(t, LogRoutingConfig{
+		ProjectAttribute: "project",
+		Projects: map[string]ProjectCredentials{
+			"team-a": {CredentialsFile: "team-a.json"},
+			"team-b": {CredentialsFile: "team-b.json"},
+			"team-c": {CredentialsFile: "team-c.json"},
+		},
+	})
+
+	ld := plog.NewLogs()
+	appendResourceLogs(ld, map[string]string{"project": "team-a"}, "")
+	appendResourceLogs(ld, map[string]string{"project": "team-b"}, "")
+	appendResourceLogs(ld, map[string]string{"project": "team-c"}, "")
+	require.NoError(t, exp.PushLogs(context.Background(), ld))
+
+	pushers["team-b"].err = errors.New("unavailable")
+	pushers["team-c"].err = consumererror.NewPermanent(errors.New("permission denied"))
+	err := exp.PushLogs(context.Background(), ld)
+	require.ErrorContains(t, err, "unavailable")
+	assert.False(t, consumererror.IsPermanent(err), "the permanent errors must not prevent the retry")
+
+	// Only the logs of the project failing with a retryable error are retried.
+	var logsErr consumererror.Logs
+	require.ErrorAs(t, err, &logsErr)
+	retried := logsErr.Data()
+	require.Equal(t, 1, retried.ResourceLogs().Len())
+	project, ok := retried.ResourceLogs().At(0).Resource().Attributes().Get("project")
+	require.True(t, ok)
+	assert.Equal(t, "team-b", project.Str())
+
+	pushers["team-b"].err = nil
+	require.NoError(t, exp.PushLogs(context.Background(), retried))
+	assert.Len(t, pushers["team-a"].logs, 2)
+	assert.Len(t, pushers["team-b"].logs, 2)
+
+	// Without retryable errors, the permanent errors are returned.
+	err = exp.PushLogs(context.Background(), ld)
+	require.ErrorContains(t, err, "permission denied")
+	assert.True(t, consumererror.IsPermanent(err))
+}
+
+func TestProjectConfig(t *testing.T) {
+	cfg := collector.DefaultConfig()
+	cfg.ProjectID = "default-project"
+	cfg.ImpersonateConfig.TargetPrincipal = "collector@default-project.iam.gserviceaccount.com"
+
+	pc := projectConfig(cfg, "team-a", ProjectCredentials{
+		Impersonate: collector.ImpersonateConfig{TargetPrincipal: "logs@team-a.iam.gserviceaccount.com"},
+	})
+	assert.Equal(t, "team-a", pc.ProjectID)
+	assert.Equal(t, "logs@team-a.iam.gserviceaccount.com", pc.ImpersonateConfig.TargetPrincipal)
+	assert.Nil(t, pc.LogConfig.ClientConfig.GetClientOptions)
+
+	pc = projectConfig(cfg, "team-b", ProjectCredentials{CredentialsFile: "team-b.json"})
+	assert.Equal(t, "team-b", pc.ProjectID)
+	assert.Empty(t, pc.ImpersonateConfig.TargetPrincipal)
+	assert.NotNil(t, pc.LogConfig.ClientConfig.GetClientOptions)
+
+	// The configuration of the exporter is left untouched.
+	assert.Equal(t, "default-project", cfg.ProjectID)
+	assert.Nil(t, cfg.LogConfig.ClientConfig.GetClientOptions)
+}
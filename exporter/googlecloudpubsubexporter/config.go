@@ -29,7 +29,7 @@ type Config struct {
 
 	// The fully qualified resource name of the Pubsub topic
 	Topic string `mapstructure:"topic"`
-	// Compression of the payload (only gzip or is supported, no compression is the default)
+	// Compression of the payload (gzip, zstd or snappy, no compression is the default)
 	Compression string `mapstructure:"compression"`
 	// Watermark defines the watermark (the ce-time attribute on the message) behavior
 	Watermark WatermarkConfig `mapstructure:"watermark"`
@@ -68,10 +68,14 @@ func (config *Config) parseCompression() (compression, error) {
 	switch config.Compression {
 	case "gzip":
 		return gZip, nil
+	case "zstd":
+		return zstdCompression, nil
+	case "snappy":
+		return snappyCompression, nil
 	case "":
 		return uncompressed, nil
 	}
-	return uncompressed, fmt.Errorf("compression %v is not supported.  supported compression formats include [gzip]", config.Compression)
+	return uncompressed, fmt.Errorf("compression %v is not supported.  supported compression formats include [gzip,zstd,snappy]", config.Compression)
 }
 
 func (config *WatermarkConfig) parseWatermarkBehavior() (WatermarkBehavior, error) {
@@ -69,6 +69,10 @@ func TestCompressionConfigValidation(t *testing.T) {
 	assert.Error(t, c.Validate())
 	c.Compression = "gzip"
 	assert.NoError(t, c.Validate())
+	c.Compression = "zstd"
+	assert.NoError(t, c.Validate())
+	c.Compression = "snappy"
+	assert.NoError(t, c.Validate())
 	c.Compression = "none"
 	assert.Error(t, c.Validate())
 	c.Compression = ""
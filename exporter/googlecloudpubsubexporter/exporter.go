@@ -8,11 +8,14 @@ import (
 	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	pubsub "cloud.google.com/go/pubsub/apiv1"
 	"cloud.google.com/go/pubsub/apiv1/pubsubpb"
+	"github.com/golang/snappy"
 	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -25,6 +28,10 @@ import (
 
 const name = "googlecloudpubsub"
 
+// zstdEncoder compresses the messages with zstd, it is shared by the exporters as it holds
+// large buffers and can be used concurrently.
+var zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+
 type pubsubExporter struct {
 	logger               *zap.Logger
 	client               *pubsub.PublisherClient
@@ -56,10 +63,25 @@ const (
 type compression int
 
 const (
-	uncompressed compression = iota
-	gZip                     = iota
+	uncompressed      compression = iota
+	gZip                          = iota
+	zstdCompression               = iota
+	snappyCompression             = iota
 )
 
+// contentEncoding returns the content-encoding attribute of the messages compressed with the compression.
+func (c compression) contentEncoding() string {
+	switch c {
+	case gZip:
+		return "gzip"
+	case zstdCompression:
+		return "zstd"
+	case snappyCompression:
+		return "snappy"
+	}
+	return ""
+}
+
 type WatermarkBehavior int
 
 const (
@@ -136,8 +158,8 @@ func (ex *pubsubExporter) publishMessage(ctx context.Context, encoding encoding,
 		attributes["ce-type"] = "org.opentelemetry.otlp.logs.v1"
 		attributes["content-type"] = "application/protobuf"
 	}
-	if ex.ceCompression == gZip {
-		attributes["content-encoding"] = "gzip"
+	if ex.ceCompression != uncompressed {
+		attributes["content-encoding"] = ex.ceCompression.contentEncoding()
 		data, err = ex.compress(data)
 		if err != nil {
 			return err
@@ -156,20 +178,28 @@ func (ex *pubsubExporter) publishMessage(ctx context.Context, encoding encoding,
 }
 
 func (ex *pubsubExporter) compress(payload []byte) ([]byte, error) {
-	if ex.ceCompression == gZip {
-		var buf bytes.Buffer
-		writer := gzip.NewWriter(&buf)
-		_, err := writer.Write(payload)
-		if err != nil {
-			return nil, err
-		}
-		err = writer.Close()
-		if err != nil {
-			return nil, err
-		}
-		return buf.Bytes(), nil
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	switch ex.ceCompression {
+	case gZip:
+		writer = gzip.NewWriter(&buf)
+	case zstdCompression:
+		return zstdEncoder.EncodeAll(payload, nil), nil
+	case snappyCompression:
+		// the payload is compressed with the snappy block format, as the snappy content encoding of OTLP/HTTP
+		return snappy.Encode(nil, payload), nil
+	default:
+		return payload, nil
+	}
+	_, err := writer.Write(payload)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, err
 	}
-	return payload, nil
+	return buf.Bytes(), nil
 }
 
 func (ex *pubsubExporter) consumeTraces(ctx context.Context, traces ptrace.Traces) error {
@@ -4,15 +4,23 @@
 package googlecloudpubsubexporter
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"fmt"
+	"io"
 	"testing"
 	"time"
 
 	pb "cloud.google.com/go/pubsub/apiv1/pubsubpb"
 	"cloud.google.com/go/pubsub/pstest"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -79,6 +87,14 @@ func TestExporterDefaultSettings(t *testing.T) {
 }
 
 func TestExporterCompression(t *testing.T) {
+	for _, compression := range []string{"gzip", "zstd", "snappy"} {
+		t.Run(compression, func(t *testing.T) {
+			testExporterCompression(t, compression)
+		})
+	}
+}
+
+func testExporterCompression(t *testing.T, compression string) {
 	ctx := context.Background()
 	// Start a fake server running locally.
 	srv := pstest.NewServer()
@@ -99,11 +115,89 @@ func TestExporterCompression(t *testing.T) {
 	exporterConfig.TimeoutSettings = exporterhelper.TimeoutSettings{
 		Timeout: 12 * time.Second,
 	}
-	exporterConfig.Compression = "gzip"
+	exporterConfig.Compression = compression
 	exporter := ensureExporter(exportertest.NewNopCreateSettings(), exporterConfig)
 	assert.NoError(t, exporter.start(ctx, nil))
 	assert.NoError(t, exporter.consumeTraces(ctx, ptrace.NewTraces()))
 	assert.NoError(t, exporter.consumeMetrics(ctx, pmetric.NewMetrics()))
 	assert.NoError(t, exporter.consumeLogs(ctx, plog.NewLogs()))
 	assert.NoError(t, exporter.shutdown(ctx))
+
+	messages := srv.Messages()
+	require.Len(t, messages, 3)
+	for _, message := range messages {
+		assert.Equal(t, compression, message.Attributes["content-encoding"])
+	}
+}
+
+func TestExporterCompress(t *testing.T) {
+	payload := testPayload(t)
+	decompress := map[compression]func([]byte) ([]byte, error){
+		uncompressed: func(data []byte) ([]byte, error) { return data, nil },
+		gZip: func(data []byte) ([]byte, error) {
+			reader, err := gzip.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			return io.ReadAll(reader)
+		},
+		zstdCompression: func(data []byte) ([]byte, error) {
+			reader, err := zstd.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			defer reader.Close()
+			return io.ReadAll(reader)
+		},
+		snappyCompression: func(data []byte) ([]byte, error) {
+			return snappy.Decode(nil, data)
+		},
+	}
+	for c, decompress := range decompress {
+		t.Run(c.contentEncoding(), func(t *testing.T) {
+			exporter := &pubsubExporter{ceCompression: c}
+			compressed, err := exporter.compress(payload)
+			require.NoError(t, err)
+			decompressed, err := decompress(compressed)
+			require.NoError(t, err)
+			assert.Equal(t, payload, decompressed)
+		})
+	}
+}
+
+func BenchmarkExporterCompress(b *testing.B) {
+	payload := testPayload(b)
+	for _, c := range []compression{gZip, zstdCompression, snappyCompression} {
+		exporter := &pubsubExporter{ceCompression: c}
+		b.Run(c.contentEncoding(), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(payload)))
+			for i := 0; i < b.N; i++ {
+				compressed, err := exporter.compress(payload)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.ReportMetric(float64(len(compressed))/float64(len(payload)), "ratio")
+			}
+		})
+	}
+}
+
+// testPayload returns the marshaled traces of a batch of spans.
+func testPayload(tb testing.TB) []byte {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < 100; i++ {
+		span := spans.AppendEmpty()
+		span.SetName(fmt.Sprintf("GET /api/cart/%d", i))
+		span.SetTraceID(pcommon.TraceID([16]byte{1, 2, 3, byte(i)}))
+		span.SetSpanID(pcommon.SpanID([8]byte{4, 5, 6, byte(i)}))
+		span.Attributes().PutStr("http.method", "GET")
+		span.Attributes().PutInt("http.status_code", 200)
+	}
+	payload, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(traces)
+	require.NoError(tb, err)
+	return payload
 }
@@ -4,7 +4,9 @@ go 1.19
 
 require (
 	cloud.google.com/go/pubsub v1.33.0
+	github.com/golang/snappy v0.0.4
 	github.com/google/uuid v1.3.0
+	github.com/klauspost/compress v1.16.7
 	github.com/stretchr/testify v1.8.4
 	go.opentelemetry.io/collector/component v0.82.0
 	go.opentelemetry.io/collector/confmap v0.82.0
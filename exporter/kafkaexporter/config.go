@@ -37,6 +37,21 @@ type Config struct {
 
 	// Authentication defines used authentication mechanism.
 	Authentication Authentication `mapstructure:"auth"`
+
+	// PartitionBy defines how messages are keyed, which decides the partition they are written to.
+	PartitionBy PartitionBy `mapstructure:"partition_by"`
+}
+
+// PartitionBy defines the key set on produced messages.
+// When nothing is configured, messages are sent without a key.
+type PartitionBy struct {
+	// TraceID splits traces per trace and keys each message by its trace ID,
+	// so that all spans of a trace are written to the same partition. Only used for traces.
+	TraceID bool `mapstructure:"trace_id"`
+
+	// ResourceAttributes groups data by the values of these resource attributes and keys
+	// each message with them. Resources that have none of the attributes are sent without a key.
+	ResourceAttributes []string `mapstructure:"resource_attributes"`
 }
 
 // Metadata defines configuration for retrieving metadata from the broker.
@@ -100,6 +115,15 @@ func (cfg *Config) Validate() error {
 		return err
 	}
 
+	if cfg.PartitionBy.TraceID && len(cfg.PartitionBy.ResourceAttributes) > 0 {
+		return fmt.Errorf("partition_by.trace_id and partition_by.resource_attributes cannot be used together")
+	}
+	for _, attr := range cfg.PartitionBy.ResourceAttributes {
+		if attr == "" {
+			return fmt.Errorf("partition_by.resource_attributes cannot contain empty names")
+		}
+	}
+
 	return validateSASLConfig(cfg.Authentication.SASL)
 }
 
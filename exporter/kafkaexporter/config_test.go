@@ -74,6 +74,9 @@ func TestLoadConfig(t *testing.T) {
 					RequiredAcks:    sarama.WaitForAll,
 					Compression:     "none",
 				},
+				PartitionBy: PartitionBy{
+					ResourceAttributes: []string{"service.name"},
+				},
 			},
 		},
 		{
@@ -132,6 +135,9 @@ func TestLoadConfig(t *testing.T) {
 					RequiredAcks:    sarama.WaitForAll,
 					Compression:     "none",
 				},
+				PartitionBy: PartitionBy{
+					ResourceAttributes: []string{"service.name"},
+				},
 			},
 		},
 	}
@@ -161,6 +167,25 @@ func TestValidate_err_compression(t *testing.T) {
 	assert.EqualError(t, err, "producer.compression should be one of 'none', 'gzip', 'snappy', 'lz4', or 'zstd'. configured value idk")
 }
 
+func TestValidate_err_partition_by(t *testing.T) {
+	config := &Config{
+		Producer: Producer{
+			Compression: "none",
+		},
+		PartitionBy: PartitionBy{
+			TraceID:            true,
+			ResourceAttributes: []string{"service.name"},
+		},
+	}
+
+	err := config.Validate()
+	assert.EqualError(t, err, "partition_by.trace_id and partition_by.resource_attributes cannot be used together")
+
+	config.PartitionBy = PartitionBy{ResourceAttributes: []string{""}}
+	err = config.Validate()
+	assert.EqualError(t, err, "partition_by.resource_attributes cannot contain empty names")
+}
+
 func TestValidate_sasl_username(t *testing.T) {
 	config := &Config{
 		Producer: Producer{
@@ -21,10 +21,11 @@ var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
 
 // kafkaTracesProducer uses sarama to produce trace messages to Kafka.
 type kafkaTracesProducer struct {
-	producer  sarama.SyncProducer
-	topic     string
-	marshaler TracesMarshaler
-	logger    *zap.Logger
+	producer    sarama.SyncProducer
+	topic       string
+	marshaler   TracesMarshaler
+	partitionBy PartitionBy
+	logger      *zap.Logger
 }
 
 type kafkaErrors struct {
@@ -37,7 +38,7 @@ func (ke kafkaErrors) Error() string {
 }
 
 func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, e.topic)
+	messages, err := marshalPartitionedTraces(e.marshaler, td, e.topic, e.partitionBy)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -60,14 +61,15 @@ func (e *kafkaTracesProducer) Close(context.Context) error {
 
 // kafkaMetricsProducer uses sarama to produce metrics messages to kafka
 type kafkaMetricsProducer struct {
-	producer  sarama.SyncProducer
-	topic     string
-	marshaler MetricsMarshaler
-	logger    *zap.Logger
+	producer    sarama.SyncProducer
+	topic       string
+	marshaler   MetricsMarshaler
+	partitionBy PartitionBy
+	logger      *zap.Logger
 }
 
 func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, e.topic)
+	messages, err := marshalPartitionedMetrics(e.marshaler, md, e.topic, e.partitionBy)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -90,14 +92,15 @@ func (e *kafkaMetricsProducer) Close(context.Context) error {
 
 // kafkaLogsProducer uses sarama to produce logs messages to kafka
 type kafkaLogsProducer struct {
-	producer  sarama.SyncProducer
-	topic     string
-	marshaler LogsMarshaler
-	logger    *zap.Logger
+	producer    sarama.SyncProducer
+	topic       string
+	marshaler   LogsMarshaler
+	partitionBy PartitionBy
+	logger      *zap.Logger
 }
 
 func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, e.topic)
+	messages, err := marshalPartitionedLogs(e.marshaler, ld, e.topic, e.partitionBy)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -168,10 +171,11 @@ func newMetricsExporter(config Config, set exporter.CreateSettings, marshalers m
 	}
 
 	return &kafkaMetricsProducer{
-		producer:  producer,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
+		producer:    producer,
+		topic:       config.Topic,
+		marshaler:   marshaler,
+		partitionBy: config.PartitionBy,
+		logger:      set.Logger,
 	}, nil
 
 }
@@ -187,10 +191,11 @@ func newTracesExporter(config Config, set exporter.CreateSettings, marshalers ma
 		return nil, err
 	}
 	return &kafkaTracesProducer{
-		producer:  producer,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
+		producer:    producer,
+		topic:       config.Topic,
+		marshaler:   marshaler,
+		partitionBy: config.PartitionBy,
+		logger:      set.Logger,
 	}, nil
 }
 
@@ -205,10 +210,11 @@ func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[
 	}
 
 	return &kafkaLogsProducer{
-		producer:  producer,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
+		producer:    producer,
+		topic:       config.Topic,
+		marshaler:   marshaler,
+		partitionBy: config.PartitionBy,
+		logger:      set.Logger,
 	}, nil
 
 }
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"strings"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
+)
+
+// keySeparator joins the values of the resource attributes used as a message key.
+const keySeparator = "|"
+
+// marshalPartitionedTraces splits the traces according to partitionBy, marshals each
+// part and keys the resulting messages so that they land on a deterministic partition.
+func marshalPartitionedTraces(marshaler TracesMarshaler, td ptrace.Traces, topic string, partitionBy PartitionBy) ([]*sarama.ProducerMessage, error) {
+	switch {
+	case partitionBy.TraceID:
+		var messages []*sarama.ProducerMessage
+		for _, trace := range batchpersignal.SplitTraces(td) {
+			msgs, err := marshaler.Marshal(trace, topic)
+			if err != nil {
+				return nil, err
+			}
+			setMessagesKey(msgs, traceIDKey(trace))
+			messages = append(messages, msgs...)
+		}
+		return messages, nil
+	case len(partitionBy.ResourceAttributes) > 0:
+		var keys []string
+		parts := map[string]ptrace.Traces{}
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			rs := td.ResourceSpans().At(i)
+			key := resourceAttributesKey(rs.Resource(), partitionBy.ResourceAttributes)
+			part, ok := parts[key]
+			if !ok {
+				part = ptrace.NewTraces()
+				parts[key] = part
+				keys = append(keys, key)
+			}
+			rs.CopyTo(part.ResourceSpans().AppendEmpty())
+		}
+		var messages []*sarama.ProducerMessage
+		for _, key := range keys {
+			msgs, err := marshaler.Marshal(parts[key], topic)
+			if err != nil {
+				return nil, err
+			}
+			setMessagesKey(msgs, key)
+			messages = append(messages, msgs...)
+		}
+		return messages, nil
+	default:
+		return marshaler.Marshal(td, topic)
+	}
+}
+
+// marshalPartitionedMetrics groups the resource metrics by the configured resource
+// attributes and keys the messages of each group with the attribute values.
+func marshalPartitionedMetrics(marshaler MetricsMarshaler, md pmetric.Metrics, topic string, partitionBy PartitionBy) ([]*sarama.ProducerMessage, error) {
+	if len(partitionBy.ResourceAttributes) == 0 {
+		return marshaler.Marshal(md, topic)
+	}
+	var keys []string
+	parts := map[string]pmetric.Metrics{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		key := resourceAttributesKey(rm.Resource(), partitionBy.ResourceAttributes)
+		part, ok := parts[key]
+		if !ok {
+			part = pmetric.NewMetrics()
+			parts[key] = part
+			keys = append(keys, key)
+		}
+		rm.CopyTo(part.ResourceMetrics().AppendEmpty())
+	}
+	var messages []*sarama.ProducerMessage
+	for _, key := range keys {
+		msgs, err := marshaler.Marshal(parts[key], topic)
+		if err != nil {
+			return nil, err
+		}
+		setMessagesKey(msgs, key)
+		messages = append(messages, msgs...)
+	}
+	return messages, nil
+}
+
+// marshalPartitionedLogs groups the resource logs by the configured resource
+// attributes and keys the messages of each group with the attribute values.
+func marshalPartitionedLogs(marshaler LogsMarshaler, ld plog.Logs, topic string, partitionBy PartitionBy) ([]*sarama.ProducerMessage, error) {
+	if len(partitionBy.ResourceAttributes) == 0 {
+		return marshaler.Marshal(ld, topic)
+	}
+	var keys []string
+	parts := map[string]plog.Logs{}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		key := resourceAttributesKey(rl.Resource(), partitionBy.ResourceAttributes)
+		part, ok := parts[key]
+		if !ok {
+			part = plog.NewLogs()
+			parts[key] = part
+			keys = append(keys, key)
+		}
+		rl.CopyTo(part.ResourceLogs().AppendEmpty())
+	}
+	var messages []*sarama.ProducerMessage
+	for _, key := range keys {
+		msgs, err := marshaler.Marshal(parts[key], topic)
+		if err != nil {
+			return nil, err
+		}
+		setMessagesKey(msgs, key)
+		messages = append(messages, msgs...)
+	}
+	return messages, nil
+}
+
+// resourceAttributesKey returns the values of the given attributes joined by keySeparator,
+// or an empty string if the resource has none of them.
+func resourceAttributesKey(resource pcommon.Resource, attributes []string) string {
+	values := make([]string, len(attributes))
+	found := false
+	for i, attr := range attributes {
+		if v, ok := resource.Attributes().Get(attr); ok {
+			values[i] = v.AsString()
+			found = true
+		}
+	}
+	if !found {
+		return ""
+	}
+	return strings.Join(values, keySeparator)
+}
+
+// traceIDKey returns the hex encoded ID of the single trace contained in td.
+func traceIDKey(td ptrace.Traces) string {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			if spans := sss.At(j).Spans(); spans.Len() > 0 {
+				return spans.At(0).TraceID().String()
+			}
+		}
+	}
+	return ""
+}
+
+// setMessagesKey sets the key of the messages. An empty key leaves them unkeyed so
+// the producer picks a partition on its own.
+func setMessagesKey(messages []*sarama.ProducerMessage, key string) {
+	if key == "" {
+		return
+	}
+	for _, msg := range messages {
+		msg.Key = sarama.StringEncoder(key)
+	}
+}
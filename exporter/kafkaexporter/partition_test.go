@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func messageKeys(t *testing.T, messages []*sarama.ProducerMessage) []string {
+	keys := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		if msg.Key == nil {
+			keys = append(keys, "")
+			continue
+		}
+		key, err := msg.Key.Encode()
+		require.NoError(t, err)
+		keys = append(keys, string(key))
+	}
+	return keys
+}
+
+func TestMarshalPartitionedTraces_TraceID(t *testing.T) {
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	traceIDs := []pcommon.TraceID{
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+	}
+	for _, traceID := range traceIDs {
+		spans.AppendEmpty().SetTraceID(traceID)
+	}
+	spans.AppendEmpty().SetTraceID(traceIDs[0])
+
+	marshaler := newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding)
+	messages, err := marshalPartitionedTraces(marshaler, td, "spans", PartitionBy{TraceID: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{traceIDs[0].String(), traceIDs[1].String()}, messageKeys(t, messages))
+
+	unmarshaled, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(messages[0].Value.(sarama.ByteEncoder))
+	require.NoError(t, err)
+	assert.Equal(t, 2, unmarshaled.SpanCount())
+}
+
+func TestMarshalPartitionedTraces_ResourceAttributes(t *testing.T) {
+	td := ptrace.NewTraces()
+	for _, name := range []string{"a", "b", "a"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", name)
+		rs.Resource().Attributes().PutStr("service.namespace", "ns")
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	}
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	marshaler := newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding)
+	messages, err := marshalPartitionedTraces(marshaler, td, "spans", PartitionBy{
+		ResourceAttributes: []string{"service.namespace", "service.name"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns|a", "ns|b", ""}, messageKeys(t, messages))
+
+	unmarshaled, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(messages[0].Value.(sarama.ByteEncoder))
+	require.NoError(t, err)
+	assert.Equal(t, 2, unmarshaled.ResourceSpans().Len())
+}
+
+func TestMarshalPartitionedTraces_Default(t *testing.T) {
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	marshaler := newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding)
+	messages, err := marshalPartitionedTraces(marshaler, td, "spans", PartitionBy{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, messageKeys(t, messages))
+}
+
+func TestMarshalPartitionedTraces_Error(t *testing.T) {
+	expErr := fmt.Errorf("failed to marshal")
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	_, err := marshalPartitionedTraces(&tracesErrorMarshaler{err: expErr}, td, "spans", PartitionBy{TraceID: true})
+	assert.Equal(t, expErr, err)
+	_, err = marshalPartitionedTraces(&tracesErrorMarshaler{err: expErr}, td, "spans", PartitionBy{ResourceAttributes: []string{"service.name"}})
+	assert.Equal(t, expErr, err)
+}
+
+func TestMarshalPartitionedMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	for _, name := range []string{"a", "b"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", name)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	}
+
+	marshaler := newPdataMetricsMarshaler(&pmetric.ProtoMarshaler{}, defaultEncoding)
+	messages, err := marshalPartitionedMetrics(marshaler, md, "metrics", PartitionBy{
+		ResourceAttributes: []string{"service.name"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, messageKeys(t, messages))
+
+	messages, err = marshalPartitionedMetrics(marshaler, md, "metrics", PartitionBy{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, messageKeys(t, messages))
+}
+
+func TestMarshalPartitionedLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	for _, name := range []string{"a", "b"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", name)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	}
+
+	marshaler := newRawMarshaler()
+	messages, err := marshalPartitionedLogs(marshaler, ld, "logs", PartitionBy{
+		ResourceAttributes: []string{"service.name"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, messageKeys(t, messages))
+}
+
+func TestLogsDataPusher_partition_by(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		key, err := msg.Key.Encode()
+		if err != nil {
+			return err
+		}
+		if string(key) != "my-service" {
+			return fmt.Errorf("unexpected key %q", key)
+		}
+		return nil
+	})
+
+	p := kafkaLogsProducer{
+		producer:    producer,
+		marshaler:   newPdataLogsMarshaler(&plog.ProtoMarshaler{}, defaultEncoding),
+		partitionBy: PartitionBy{ResourceAttributes: []string{"service.name"}},
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "my-service")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	require.NoError(t, p.logsDataPusher(context.Background(), ld))
+}
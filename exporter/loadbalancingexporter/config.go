@@ -14,6 +14,7 @@ type routingKey int
 const (
 	traceIDRouting routingKey = iota
 	svcRouting
+	ottlRouting
 )
 
 // Config defines configuration for the exporter.
@@ -21,6 +22,20 @@ type Config struct {
 	Protocol   Protocol         `mapstructure:"protocol"`
 	Resolver   ResolverSettings `mapstructure:"resolver"`
 	RoutingKey string           `mapstructure:"routing_key"`
+	// RoutingExpression is the OTTL expression whose value is the routing key of the spans and logs,
+	// such as resource.attributes["tenant"]. It is used when the RoutingKey is "ottl".
+	RoutingExpression string           `mapstructure:"routing_expression"`
+	Eviction          EvictionSettings `mapstructure:"eviction"`
+}
+
+// EvictionSettings defines the eviction of the backends failing to receive the data. The data of an evicted
+// backend is sent to the next backends of the ring, until the backend is added back to the ring.
+type EvictionSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MaxFailures is the number of consecutive failed exports after which the backend is evicted
+	MaxFailures int `mapstructure:"max_failures"`
+	// Duration is how long the backend is evicted before it receives data again
+	Duration time.Duration `mapstructure:"duration"`
 }
 
 // Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
@@ -38,6 +53,9 @@ type ResolverSettings struct {
 // StaticResolver defines the configuration for the resolver providing a fixed list of backends
 type StaticResolver struct {
 	Hostnames []string `mapstructure:"hostnames"`
+	// Weights are the relative weights of the hostnames, the hostnames without weight having a weight of 100.
+	// A backend with a weight of 200 receives about twice the data of a backend with the default weight.
+	Weights map[string]int `mapstructure:"weights"`
 }
 
 // DNSResolver defines the configuration for the DNS resolver
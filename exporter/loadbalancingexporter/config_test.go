@@ -6,7 +6,9 @@ package loadbalancingexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
@@ -25,3 +27,23 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, component.UnmarshalConfig(sub, cfg))
 	require.NotNil(t, cfg)
 }
+
+func TestLoadConfigRoutingExpression(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "4").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	lbCfg := cfg.(*Config)
+	assert.Equal(t, "ottl", lbCfg.RoutingKey)
+	assert.Equal(t, `resource.attributes["tenant"]`, lbCfg.RoutingExpression)
+	assert.Equal(t, &StaticResolver{
+		Hostnames: []string{"endpoint-1", "endpoint-2"},
+		Weights:   map[string]int{"endpoint-2": 200},
+	}, lbCfg.Resolver.Static)
+	assert.Equal(t, EvictionSettings{Enabled: true, MaxFailures: 5, Duration: time.Minute}, lbCfg.Eviction)
+}
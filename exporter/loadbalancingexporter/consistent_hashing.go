@@ -29,7 +29,13 @@ type hashRing struct {
 
 // newHashRing builds a new immutable consistent hash ring based on the given endpoints.
 func newHashRing(endpoints []string) *hashRing {
-	items := positionsForEndpoints(endpoints, defaultWeight)
+	return newWeightedHashRing(endpoints, nil)
+}
+
+// newWeightedHashRing builds a new immutable consistent hash ring based on the given endpoints, each endpoint
+// having as many positions in the ring as its weight. The endpoints without weight have the default weight.
+func newWeightedHashRing(endpoints []string, weights map[string]int) *hashRing {
+	items := positionsForWeightedEndpoints(endpoints, weights, defaultWeight)
 	return &hashRing{
 		items: items,
 	}
@@ -105,6 +111,10 @@ func positionsFor(endpoint string, numPoints int) []position {
 		h := crc32.NewIEEE()
 		h.Write([]byte(endpoint))
 		h.Write([]byte{byte(i)})
+		// the positions above 255 are distinct from the lower ones, which are kept for the endpoints' default weight
+		for j := i >> 8; j > 0; j >>= 8 {
+			h.Write([]byte{byte(j)})
+		}
 		hash := h.Sum32()
 		pos := hash % maxPositions
 		res = append(res, position(pos))
@@ -115,10 +125,19 @@ func positionsFor(endpoint string, numPoints int) []position {
 
 // positionsForEndpoints calculates all the positions for all the given endpoints
 func positionsForEndpoints(endpoints []string, weight int) []ringItem {
+	return positionsForWeightedEndpoints(endpoints, nil, weight)
+}
+
+// positionsForWeightedEndpoints calculates all the positions for all the given endpoints, using the weight of
+// each endpoint, or the default weight for the endpoints without weight
+func positionsForWeightedEndpoints(endpoints []string, weights map[string]int, defaultWeight int) []ringItem {
 	var items []ringItem
 	positions := map[position]bool{} // tracking the used positions
 	for _, endpoint := range endpoints {
-		// for this initial implementation, we don't allow endpoints to have custom weights
+		weight, ok := weights[endpoint]
+		if !ok {
+			weight = defaultWeight
+		}
 		for _, pos := range positionsFor(endpoint, weight) {
 			// if this position is occupied already, skip this item
 			if _, found := positions[pos]; found {
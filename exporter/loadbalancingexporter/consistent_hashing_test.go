@@ -219,3 +219,31 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestWeightedHashRing(t *testing.T) {
+	endpoints := []string{"endpoint-1", "endpoint-2"}
+	weights := map[string]int{"endpoint-2": 300}
+
+	ring := newWeightedHashRing(endpoints, weights)
+
+	counts := map[string]int{}
+	for _, item := range ring.items {
+		counts[item.endpoint]++
+	}
+	// a few positions may be taken by the other endpoint already
+	assert.InDelta(t, defaultWeight, counts["endpoint-1"], 5)
+	assert.InDelta(t, 300, counts["endpoint-2"], 5)
+	assert.Equal(t, newHashRing([]string{"endpoint-1"}).items, newWeightedHashRing([]string{"endpoint-1"}, weights).items)
+}
+
+func TestPositionsForHighWeight(t *testing.T) {
+	positions := positionsFor("endpoint-1", 600)
+
+	// the positions above 255 don't repeat the lower ones
+	distinct := map[position]bool{}
+	for _, pos := range positions {
+		distinct[pos] = true
+	}
+	assert.Greater(t, len(distinct), 590)
+	assert.Equal(t, positionsFor("endpoint-1", defaultWeight), positions[:defaultWeight])
+}
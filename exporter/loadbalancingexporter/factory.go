@@ -7,6 +7,7 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"time"
 
 	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
@@ -36,6 +37,10 @@ func createDefaultConfig() component.Config {
 		Protocol: Protocol{
 			OTLP: *otlpDefaultCfg,
 		},
+		Eviction: EvictionSettings{
+			MaxFailures: 3,
+			Duration:    30 * time.Second,
+		},
 	}
 }
 
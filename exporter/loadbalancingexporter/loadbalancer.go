@@ -9,7 +9,10 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
 	"go.uber.org/zap"
@@ -17,11 +20,14 @@ import (
 
 const (
 	defaultPort = "4317"
+	// maxWeight is the maximum weight of a backend, its number of positions in the ring
+	maxWeight = 1000
 )
 
 var (
 	errNoResolver                = errors.New("no resolvers specified for the exporter")
 	errMultipleResolversProvided = errors.New("only one resolver should be specified")
+	errInvalidEviction           = errors.New("the eviction max_failures and duration must be positive")
 )
 
 var _ loadBalancer = (*loadBalancerImp)(nil)
@@ -32,14 +38,26 @@ type loadBalancer interface {
 	component.Component
 	Endpoint(identifier []byte) string
 	Exporter(endpoint string) (component.Component, error)
+	// ReportResult records the outcome of an export to the endpoint.
+	ReportResult(endpoint string, err error)
 }
 
 type loadBalancerImp struct {
 	logger *zap.Logger
 	host   component.Host
 
-	res  resolver
-	ring *hashRing
+	res     resolver
+	ring    *hashRing
+	weights map[string]int
+	// resolved are the last resolved endpoints, the ring holding the ones not evicted
+	resolved []string
+
+	eviction EvictionSettings
+	// evicted holds when the evicted endpoints are added back to the ring
+	evicted map[string]time.Time
+	// failures holds the consecutive failed exports of the endpoints
+	failures   map[string]int
+	healthLock sync.Mutex
 
 	componentFactory componentFactory
 	exporters        map[string]component.Component
@@ -56,13 +74,27 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 		return nil, errMultipleResolversProvided
 	}
 
+	if oCfg.Eviction.Enabled && (oCfg.Eviction.MaxFailures <= 0 || oCfg.Eviction.Duration <= 0) {
+		return nil, errInvalidEviction
+	}
+
 	var res resolver
+	var weights map[string]int
 	if oCfg.Resolver.Static != nil {
 		var err error
 		res, err = newStaticResolver(oCfg.Resolver.Static.Hostnames)
 		if err != nil {
 			return nil, err
 		}
+		for hostname, weight := range oCfg.Resolver.Static.Weights {
+			if !endpointFound(hostname, oCfg.Resolver.Static.Hostnames) {
+				return nil, fmt.Errorf("the weighted hostname %q is not one of the static hostnames", hostname)
+			}
+			if weight <= 0 || weight > maxWeight {
+				return nil, fmt.Errorf("invalid weight %d for the hostname %q, the weight should be between 1 and %d", weight, hostname, maxWeight)
+			}
+		}
+		weights = oCfg.Resolver.Static.Weights
 	}
 	if oCfg.Resolver.DNS != nil {
 		dnsLogger := params.Logger.With(zap.String("resolver", "dns"))
@@ -93,6 +125,10 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 	return &loadBalancerImp{
 		logger:           params.Logger,
 		res:              res,
+		weights:          weights,
+		eviction:         oCfg.Eviction,
+		evicted:          map[string]time.Time{},
+		failures:         map[string]int{},
 		componentFactory: factory,
 		exporters:        map[string]component.Component{},
 	}, nil
@@ -105,12 +141,19 @@ func (lb *loadBalancerImp) Start(ctx context.Context, host component.Host) error
 }
 
 func (lb *loadBalancerImp) onBackendChanges(resolved []string) {
-	newRing := newHashRing(resolved)
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+
+	lb.resolved = resolved
+	// the evicted endpoints that are no longer resolved are added to the ring again when they're resolved again
+	for endpoint := range lb.evicted {
+		if !endpointFound(endpoint, resolved) {
+			delete(lb.evicted, endpoint)
+		}
+	}
+	newRing := lb.newRing()
 
 	if !newRing.equal(lb.ring) {
-		lb.updateLock.Lock()
-		defer lb.updateLock.Unlock()
-
 		lb.ring = newRing
 
 		// TODO: set a timeout?
@@ -122,6 +165,17 @@ func (lb *loadBalancerImp) onBackendChanges(resolved []string) {
 	}
 }
 
+// newRing builds the ring of the resolved endpoints that are not evicted.
+func (lb *loadBalancerImp) newRing() *hashRing {
+	endpoints := make([]string, 0, len(lb.resolved))
+	for _, endpoint := range lb.resolved {
+		if _, evicted := lb.evicted[endpoint]; !evicted {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return newWeightedHashRing(endpoints, lb.weights)
+}
+
 func (lb *loadBalancerImp) addMissingExporters(ctx context.Context, endpoints []string) {
 	for _, endpoint := range endpoints {
 		endpoint = endpointWithPort(endpoint)
@@ -184,6 +238,68 @@ func (lb *loadBalancerImp) Endpoint(identifier []byte) string {
 	return lb.ring.endpointFor(identifier)
 }
 
+// ReportResult records the outcome of an export to the endpoint. When the eviction is enabled, the endpoint
+// is evicted from the ring after too many consecutive failed exports.
+func (lb *loadBalancerImp) ReportResult(endpoint string, err error) {
+	if !lb.eviction.Enabled {
+		return
+	}
+
+	lb.healthLock.Lock()
+	if err == nil {
+		delete(lb.failures, endpoint)
+		lb.healthLock.Unlock()
+		return
+	}
+	lb.failures[endpoint]++
+	evict := lb.failures[endpoint] >= lb.eviction.MaxFailures
+	if evict {
+		delete(lb.failures, endpoint)
+	}
+	lb.healthLock.Unlock()
+
+	if evict {
+		lb.evict(endpoint)
+	}
+}
+
+func (lb *loadBalancerImp) evict(endpoint string) {
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+
+	if _, evicted := lb.evicted[endpoint]; evicted || !endpointFound(endpoint, lb.resolved) {
+		return
+	}
+	// the data has to go somewhere, the last endpoint of the ring is never evicted
+	if len(lb.evicted)+1 >= len(lb.resolved) {
+		lb.logger.Warn("not evicting the last backend of the ring", zap.String("endpoint", endpoint))
+		return
+	}
+
+	readmitAt := time.Now().Add(lb.eviction.Duration)
+	lb.evicted[endpoint] = readmitAt
+	lb.ring = lb.newRing()
+	time.AfterFunc(lb.eviction.Duration, func() {
+		lb.readmit(endpoint, readmitAt)
+	})
+
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{tag.Upsert(endpointTagKey, endpoint)}, mNumEvictions.M(1))
+	lb.logger.Warn("evicted backend after failed exports", zap.String("endpoint", endpoint), zap.Duration("duration", lb.eviction.Duration))
+}
+
+// readmit adds the evicted endpoint back to the ring, unless it was evicted again in the meantime.
+func (lb *loadBalancerImp) readmit(endpoint string, readmitAt time.Time) {
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+
+	if at, evicted := lb.evicted[endpoint]; !evicted || !at.Equal(readmitAt) {
+		return
+	}
+	delete(lb.evicted, endpoint)
+	lb.ring = lb.newRing()
+	lb.logger.Info("added evicted backend back to the ring", zap.String("endpoint", endpoint))
+}
+
 func (lb *loadBalancerImp) Exporter(endpoint string) (component.Component, error) {
 	// NOTE: make rolling updates of next tier of collectors work. currently, this may cause
 	// data loss because the latest batches sent to outdated backend will never find their way out.
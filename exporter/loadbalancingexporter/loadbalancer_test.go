@@ -6,7 +6,9 @@ package loadbalancingexporter
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -389,6 +391,124 @@ func TestFailedExporterInRing(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestNewLoadBalancerInvalidWeights(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		weights map[string]int
+	}{
+		{name: "unknown hostname", weights: map[string]int{"endpoint-3": 100}},
+		{name: "zero weight", weights: map[string]int{"endpoint-1": 0}},
+		{name: "too high weight", weights: map[string]int{"endpoint-1": maxWeight + 1}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Resolver: ResolverSettings{
+					Static: &StaticResolver{Hostnames: []string{"endpoint-1", "endpoint-2"}, Weights: tt.weights},
+				},
+			}
+
+			p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, nil)
+
+			require.Nil(t, p)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestWeightedBackends(t *testing.T) {
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{Hostnames: []string{"endpoint-1", "endpoint-2"}, Weights: map[string]int{"endpoint-2": 300}},
+		},
+	}
+	componentFactory := func(ctx context.Context, endpoint string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	counts := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		counts[p.Endpoint([]byte(fmt.Sprintf("tenant-%d", i)))]++
+	}
+	assert.Greater(t, counts["endpoint-2"], 2*counts["endpoint-1"])
+}
+
+func TestNewLoadBalancerInvalidEviction(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.Eviction = EvictionSettings{Enabled: true}
+
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, nil)
+
+	require.Nil(t, p)
+	require.Equal(t, errInvalidEviction, err)
+}
+
+func TestEvictFailingBackend(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.Eviction = EvictionSettings{Enabled: true, MaxFailures: 2, Duration: 100 * time.Millisecond}
+	componentFactory := func(ctx context.Context, endpoint string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+
+	// test
+	p.ReportResult("endpoint-1", errors.New("unavailable"))
+	p.ReportResult("endpoint-1", nil)
+	p.ReportResult("endpoint-1", errors.New("unavailable"))
+	assert.Len(t, p.ring.items, 2*defaultWeight, "the failures aren't consecutive")
+
+	p.ReportResult("endpoint-1", errors.New("unavailable"))
+
+	// verify
+	p.updateLock.RLock()
+	assert.Len(t, p.ring.items, defaultWeight)
+	for _, item := range p.ring.items {
+		assert.Equal(t, "endpoint-2", item.endpoint)
+	}
+	p.updateLock.RUnlock()
+	assert.Len(t, p.exporters, 2, "the exporters of the evicted backends are kept")
+
+	// the last backend of the ring is never evicted
+	p.ReportResult("endpoint-2", errors.New("unavailable"))
+	p.ReportResult("endpoint-2", errors.New("unavailable"))
+	assert.Equal(t, "endpoint-2", p.Endpoint([]byte("key")))
+
+	// the backend is added back to the ring after the eviction
+	assert.Eventually(t, func() bool {
+		p.updateLock.RLock()
+		defer p.updateLock.RUnlock()
+		return len(p.ring.items) == 2*defaultWeight
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestEvictionDisabled(t *testing.T) {
+	cfg := simpleConfig()
+	componentFactory := func(ctx context.Context, endpoint string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+
+	for i := 0; i < 10; i++ {
+		p.ReportResult("endpoint-1", errors.New("unavailable"))
+	}
+
+	assert.Len(t, p.ring.items, 2*defaultWeight)
+}
+
 func newNopMockExporter() component.Component {
 	return mockComponent{}
 }
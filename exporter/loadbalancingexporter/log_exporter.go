@@ -21,12 +21,15 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 )
 
 var _ exporter.Logs = (*logExporterImp)(nil)
 
 type logExporterImp struct {
-	loadBalancer loadBalancer
+	loadBalancer      loadBalancer
+	routingExpression ottl.Getter[ottllog.TransformContext]
 
 	started    bool
 	shutdownWg sync.WaitGroup
@@ -44,9 +47,22 @@ func newLogsExporter(params exporter.CreateSettings, cfg component.Config) (*log
 		return nil, err
 	}
 
-	return &logExporterImp{
-		loadBalancer: lb,
-	}, nil
+	logExporter := logExporterImp{loadBalancer: lb}
+
+	// the logs are routed by trace ID, unless they're routed by the routing expression
+	if cfg.(*Config).RoutingKey == "ottl" {
+		if cfg.(*Config).RoutingExpression == "" {
+			return nil, errNoRoutingExpression
+		}
+		logExporter.routingExpression, err = parseRoutingExpression(cfg.(*Config).RoutingExpression, params.TelemetrySettings,
+			func(functions map[string]ottl.Factory[ottllog.TransformContext], set component.TelemetrySettings) (ottl.Parser[ottllog.TransformContext], error) {
+				return ottllog.NewParser(functions, set)
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &logExporter, nil
 }
 
 func (e *logExporterImp) Capabilities() consumer.Capabilities {
@@ -68,26 +84,38 @@ func (e *logExporterImp) Shutdown(context.Context) error {
 }
 
 func (e *logExporterImp) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	if e.routingExpression != nil {
+		return e.consumeLogsByRoutingKey(ctx, ld)
+	}
+
 	var errs error
 	batches := batchpersignal.SplitLogs(ld)
 	for _, batch := range batches {
-		errs = multierr.Append(errs, e.consumeLog(ctx, batch))
+		errs = multierr.Append(errs, e.consumeLog(ctx, batch, balancingKeyFromTraceID(traceIDFromLogs(batch))))
 	}
 
 	return errs
 }
 
-func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
-	traceID := traceIDFromLogs(ld)
-	balancingKey := traceID
-	if traceID == pcommon.NewTraceIDEmpty() {
-		// every log may not contain a traceID
-		// generate a random traceID as balancingKey
-		// so the log can be routed to a random backend
-		balancingKey = random()
+// consumeLogsByRoutingKey evaluates the routing expression for every log record, and exports together
+// the log records sharing a routing key. The log records for which the expression has no value are
+// routed by their trace ID.
+func (e *logExporterImp) consumeLogsByRoutingKey(ctx context.Context, ld plog.Logs) error {
+	batches, err := e.splitLogsByRoutingKey(ctx, ld)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for key, batch := range batches {
+		errs = multierr.Append(errs, e.consumeLog(ctx, batch.logs, []byte(key)))
 	}
 
-	endpoint := e.loadBalancer.Endpoint(balancingKey[:])
+	return errs
+}
+
+func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs, identifier []byte) error {
+	endpoint := e.loadBalancer.Endpoint(identifier)
 	exp, err := e.loadBalancer.Exporter(endpoint)
 	if err != nil {
 		return err
@@ -101,6 +129,7 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 	start := time.Now()
 	err = le.ConsumeLogs(ctx, ld)
 	duration := time.Since(start)
+	e.loadBalancer.ReportResult(endpoint, err)
 	if err == nil {
 		_ = stats.RecordWithTags(
 			ctx,
@@ -116,6 +145,77 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 	return err
 }
 
+// routedLogs are the log records sharing a routing key, along with the last resource and scope
+// they were appended to, so that the records of a resource and scope are kept together.
+type routedLogs struct {
+	logs          plog.Logs
+	resourceIndex int
+	scopeIndex    int
+}
+
+// splitLogsByRoutingKey groups the log records by the value of the routing expression for each of them.
+// The records without trace ID for which the expression has no value share a random key.
+func (e *logExporterImp) splitLogsByRoutingKey(ctx context.Context, ld plog.Logs) (map[string]*routedLogs, error) {
+	batches := map[string]*routedLogs{}
+	randomKey := random()
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				value, err := e.routingExpression.Get(ctx, ottllog.NewTransformContext(lr, sl.Scope(), rl.Resource()))
+				if err != nil {
+					return nil, fmt.Errorf("failed to evaluate the routing expression: %w", err)
+				}
+				key := routingKeyFromValue(value)
+				if key == "" {
+					balancingKey := randomKey
+					if !lr.TraceID().IsEmpty() {
+						balancingKey = lr.TraceID()
+					}
+					key = string(balancingKey[:])
+				}
+
+				batch, ok := batches[key]
+				if !ok {
+					batch = &routedLogs{logs: plog.NewLogs(), resourceIndex: -1, scopeIndex: -1}
+					batches[key] = batch
+				}
+				if batch.resourceIndex != i {
+					newRL := batch.logs.ResourceLogs().AppendEmpty()
+					rl.Resource().CopyTo(newRL.Resource())
+					newRL.SetSchemaUrl(rl.SchemaUrl())
+					batch.resourceIndex, batch.scopeIndex = i, -1
+				}
+				batchRL := batch.logs.ResourceLogs().At(batch.logs.ResourceLogs().Len() - 1)
+				if batch.scopeIndex != j {
+					newSL := batchRL.ScopeLogs().AppendEmpty()
+					sl.Scope().CopyTo(newSL.Scope())
+					newSL.SetSchemaUrl(sl.SchemaUrl())
+					batch.scopeIndex = j
+				}
+				batchSL := batchRL.ScopeLogs().At(batchRL.ScopeLogs().Len() - 1)
+				lr.CopyTo(batchSL.LogRecords().AppendEmpty())
+			}
+		}
+	}
+	return batches, nil
+}
+
+// balancingKeyFromTraceID returns the trace ID as the balancing key, or a random key when there is
+// no trace ID, so that the logs are routed to a random backend.
+func balancingKeyFromTraceID(traceID pcommon.TraceID) []byte {
+	balancingKey := traceID
+	if traceID == pcommon.NewTraceIDEmpty() {
+		// every log may not contain a traceID
+		// generate a random traceID as balancingKey
+		// so the log can be routed to a random backend
+		balancingKey = random()
+	}
+	return balancingKey[:]
+}
+
 func traceIDFromLogs(ld plog.Logs) pcommon.TraceID {
 	rl := ld.ResourceLogs()
 	if rl.Len() == 0 {
@@ -287,6 +287,179 @@ func TestLogsWithoutTraceID(t *testing.T) {
 	assert.Len(t, sink.AllLogs(), 1)
 }
 
+func TestConsumeLogsRoutingExpression(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.Resolver.Static.Hostnames = []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+	cfg.RoutingKey = "ottl"
+	cfg.RoutingExpression = `resource.attributes["tenant"]`
+
+	received := map[string]map[string]bool{}
+	var mu sync.Mutex
+	componentFactory := func(ctx context.Context, endpoint string) (component.Component, error) {
+		return newMockLogsExporter(func(ctx context.Context, ld plog.Logs) error {
+			mu.Lock()
+			defer mu.Unlock()
+			tenant, _ := ld.ResourceLogs().At(0).Resource().Attributes().Get("tenant")
+			if received[tenant.Str()] == nil {
+				received[tenant.Str()] = map[string]bool{}
+			}
+			received[tenant.Str()][endpoint] = true
+			return nil
+		}), nil
+	}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newLogsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+	p.loadBalancer = lb
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	for i := 0; i < 20; i++ {
+		for _, tenant := range []string{"acme", "globex"} {
+			logs := simpleLogWithID(pcommon.TraceID([16]byte{byte(i)}))
+			logs.ResourceLogs().At(0).Resource().Attributes().PutStr("tenant", tenant)
+			require.NoError(t, p.ConsumeLogs(context.Background(), logs))
+		}
+	}
+
+	// verify
+	assert.Len(t, received, 2)
+	for tenant, endpoints := range received {
+		assert.Len(t, endpoints, 1, "the logs of the tenant %s are sent to a single backend", tenant)
+	}
+}
+
+func TestConsumeLogsRoutingExpressionPerRecord(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.Resolver.Static.Hostnames = []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+	cfg.RoutingKey = "ottl"
+	cfg.RoutingExpression = `attributes["region"]`
+
+	received := map[string]map[string]bool{}
+	var records int
+	var mu sync.Mutex
+	componentFactory := func(ctx context.Context, endpoint string) (component.Component, error) {
+		return newMockLogsExporter(func(ctx context.Context, ld plog.Logs) error {
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, 1, ld.ResourceLogs().Len())
+			service, _ := ld.ResourceLogs().At(0).Resource().Attributes().Get("service.name")
+			assert.Equal(t, "checkout", service.Str())
+			lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+			for i := 0; i < lrs.Len(); i++ {
+				region, ok := lrs.At(i).Attributes().Get("region")
+				key := region.Str()
+				if !ok {
+					key = lrs.At(i).TraceID().String()
+				}
+				if received[key] == nil {
+					received[key] = map[string]bool{}
+				}
+				received[key][endpoint] = true
+				records++
+			}
+			return nil
+		}), nil
+	}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newLogsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+	p.loadBalancer = lb
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	for i := 0; i < 20; i++ {
+		logs := plog.NewLogs()
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", "checkout")
+		lrs := rl.ScopeLogs().AppendEmpty().LogRecords()
+		for _, region := range []string{"eu", "us", "ap"} {
+			lr := lrs.AppendEmpty()
+			lr.SetTraceID(pcommon.TraceID([16]byte{byte(i)}))
+			lr.Attributes().PutStr("region", region)
+		}
+		// the records without region are routed by their trace ID
+		lrs.AppendEmpty().SetTraceID(pcommon.TraceID([16]byte{1, 2, 3}))
+		require.NoError(t, p.ConsumeLogs(context.Background(), logs))
+	}
+
+	// verify
+	assert.Equal(t, 80, records)
+	assert.Len(t, received, 4)
+	for key, endpoints := range received {
+		assert.Len(t, endpoints, 1, "the logs of %s are sent to a single backend", key)
+	}
+}
+
+func TestSplitLogsByRoutingKey(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.RoutingKey = "ottl"
+	cfg.RoutingExpression = `attributes["region"]`
+	p, err := newLogsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	for _, service := range []string{"checkout", "cart"} {
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", service)
+		for _, scope := range []string{"a", "b"} {
+			sl := rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName(scope)
+			for _, region := range []string{"eu", "us", "eu"} {
+				sl.LogRecords().AppendEmpty().Attributes().PutStr("region", region)
+			}
+		}
+	}
+
+	batches, err := p.splitLogsByRoutingKey(context.Background(), logs)
+	require.NoError(t, err)
+	require.Len(t, batches, 2)
+	for region, count := range map[string]int{"eu": 8, "us": 4} {
+		batch := batches[region].logs
+		assert.Equal(t, count, batch.LogRecordCount())
+		// the records keep their resource and scope
+		require.Equal(t, 2, batch.ResourceLogs().Len())
+		for i, service := range []string{"checkout", "cart"} {
+			rl := batch.ResourceLogs().At(i)
+			name, _ := rl.Resource().Attributes().Get("service.name")
+			assert.Equal(t, service, name.Str())
+			require.Equal(t, 2, rl.ScopeLogs().Len())
+			assert.Equal(t, "a", rl.ScopeLogs().At(0).Scope().Name())
+			assert.Equal(t, "b", rl.ScopeLogs().At(1).Scope().Name())
+		}
+	}
+}
+
+func TestNewLogsExporterInvalidRoutingExpression(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.RoutingKey = "ottl"
+
+	_, err := newLogsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.Equal(t, errNoRoutingExpression, err)
+
+	cfg.RoutingExpression = `attributes[`
+	_, err = newLogsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.Error(t, err)
+}
+
 func TestRollingUpdatesWhenConsumeLogs(t *testing.T) {
 	t.Skip("Flaky Test - See https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/13331")
 
@@ -13,6 +13,7 @@ var (
 	mNumResolutions = stats.Int64("loadbalancer_num_resolutions", "Number of times the resolver triggered a new resolutions", stats.UnitDimensionless)
 	mNumBackends    = stats.Int64("loadbalancer_num_backends", "Current number of backends in use", stats.UnitDimensionless)
 	mBackendLatency = stats.Int64("loadbalancer_backend_latency", "Response latency in ms for the backends", stats.UnitMilliseconds)
+	mNumEvictions   = stats.Int64("loadbalancer_backend_evictions", "Number of times the backends were evicted after failing to receive data", stats.UnitDimensionless)
 
 	endpointTagKey      = tag.MustNewKey("endpoint")
 	successTrueMutator  = tag.Upsert(tag.MustNewKey("success"), "true")
@@ -69,5 +70,14 @@ func MetricViews() []*view.View {
 			},
 			Aggregation: view.Count(),
 		},
+		{
+			Name:        mNumEvictions.Name(),
+			Measure:     mNumEvictions,
+			Description: mNumEvictions.Description(),
+			TagKeys: []tag.Key{
+				tag.MustNewKey("endpoint"),
+			},
+			Aggregation: view.Count(),
+		},
 	}
 }
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+var errNoRoutingExpression = errors.New("the routing_expression is required with the ottl routing_key")
+
+// routingKeyFunction is the function wrapping the routing expression in an OTTL statement, the OTTL parser
+// only parsing statements. The statement is never executed, only the value of its argument is.
+const routingKeyFunction = "route_by"
+
+type routingKeyArguments[K any] struct {
+	Value ottl.Getter[K] `ottlarg:"0"`
+}
+
+// parseRoutingExpression parses the OTTL expression computing the routing key, which can use the OTTL
+// converters, such as Concat([resource.attributes["tenant"], attributes["region"]], "/").
+func parseRoutingExpression[K any](expression string, set component.TelemetrySettings, newParser func(map[string]ottl.Factory[K], component.TelemetrySettings) (ottl.Parser[K], error)) (ottl.Getter[K], error) {
+	var getter ottl.Getter[K]
+	functions := ottlfuncs.StandardConverters[K]()
+	functions[routingKeyFunction] = ottl.NewFactory(routingKeyFunction, &routingKeyArguments[K]{}, func(_ ottl.FunctionContext, args ottl.Arguments) (ottl.ExprFunc[K], error) {
+		routingArgs, ok := args.(*routingKeyArguments[K])
+		if !ok {
+			return nil, fmt.Errorf("%s args must be of type *routingKeyArguments[K]", routingKeyFunction)
+		}
+		getter = routingArgs.Value
+		return func(context.Context, K) (any, error) {
+			return nil, nil
+		}, nil
+	})
+
+	parser, err := newParser(functions, set)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := parser.ParseStatement(fmt.Sprintf("%s(%s)", routingKeyFunction, expression))
+	if err != nil || statement == nil || getter == nil {
+		return nil, fmt.Errorf("invalid routing_expression %q: %w", expression, err)
+	}
+	return getter, nil
+}
+
+// routingKeyFromValue returns the routing key of the value of the routing expression, an empty key
+// when the expression has no value.
+func routingKeyFromValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case pcommon.Value:
+		return v.AsString()
+	case pcommon.Map:
+		return fmt.Sprint(v.AsRaw())
+	case pcommon.Slice:
+		return fmt.Sprint(v.AsRaw())
+	default:
+		return fmt.Sprint(v)
+	}
+}
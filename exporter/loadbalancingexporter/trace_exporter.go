@@ -20,13 +20,16 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 )
 
 var _ exporter.Traces = (*traceExporterImp)(nil)
 
 type traceExporterImp struct {
-	loadBalancer loadBalancer
-	routingKey   routingKey
+	loadBalancer      loadBalancer
+	routingKey        routingKey
+	routingExpression ottl.Getter[ottlspan.TransformContext]
 
 	stopped    bool
 	shutdownWg sync.WaitGroup
@@ -49,6 +52,18 @@ func newTracesExporter(params exporter.CreateSettings, cfg component.Config) (*t
 	switch cfg.(*Config).RoutingKey {
 	case "service":
 		traceExporter.routingKey = svcRouting
+	case "ottl":
+		if cfg.(*Config).RoutingExpression == "" {
+			return nil, errNoRoutingExpression
+		}
+		traceExporter.routingKey = ottlRouting
+		traceExporter.routingExpression, err = parseRoutingExpression(cfg.(*Config).RoutingExpression, params.TelemetrySettings,
+			func(functions map[string]ottl.Factory[ottlspan.TransformContext], set component.TelemetrySettings) (ottl.Parser[ottlspan.TransformContext], error) {
+				return ottlspan.NewParser(functions, set)
+			})
+		if err != nil {
+			return nil, err
+		}
 	case "traceID", "":
 	default:
 		return nil, fmt.Errorf("unsupported routing_key: %s", cfg.(*Config).RoutingKey)
@@ -88,7 +103,7 @@ func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 
 func (e *traceExporterImp) consumeTrace(ctx context.Context, td ptrace.Traces) error {
 	var exp component.Component
-	routingIds, err := routingIdentifiersFromTraces(td, e.routingKey)
+	routingIds, err := e.routingIdentifiers(ctx, td)
 	if err != nil {
 		return err
 	}
@@ -107,6 +122,7 @@ func (e *traceExporterImp) consumeTrace(ctx context.Context, td ptrace.Traces) e
 		start := time.Now()
 		err = te.ConsumeTraces(ctx, td)
 		duration := time.Since(start)
+		e.loadBalancer.ReportResult(endpoint, err)
 
 		if err == nil {
 			_ = stats.RecordWithTags(
@@ -123,6 +139,28 @@ func (e *traceExporterImp) consumeTrace(ctx context.Context, td ptrace.Traces) e
 	return err
 }
 
+// routingIdentifiers returns the routing identifiers of the trace. With the ottl routing key, the trace is
+// routed by the value of the routing expression for its first span, or by its trace ID when it has no value.
+func (e *traceExporterImp) routingIdentifiers(ctx context.Context, td ptrace.Traces) (map[string]bool, error) {
+	if e.routingKey != ottlRouting {
+		return routingIdentifiersFromTraces(td, e.routingKey)
+	}
+	ids, err := routingIdentifiersFromTraces(td, traceIDRouting)
+	if err != nil {
+		return nil, err
+	}
+	rs := td.ResourceSpans().At(0)
+	ss := rs.ScopeSpans().At(0)
+	value, err := e.routingExpression.Get(ctx, ottlspan.NewTransformContext(ss.Spans().At(0), ss.Scope(), rs.Resource()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate the routing expression: %w", err)
+	}
+	if key := routingKeyFromValue(value); key != "" {
+		return map[string]bool{key: true}, nil
+	}
+	return ids, nil
+}
+
 func routingIdentifiersFromTraces(td ptrace.Traces, key routingKey) (map[string]bool, error) {
 	ids := make(map[string]bool)
 	rs := td.ResourceSpans()
@@ -191,6 +191,89 @@ func TestConsumeTracesServiceBased(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestNewTracesExporterRoutingExpression(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		expression string
+		err        bool
+	}{
+		{name: "attribute", expression: `resource.attributes["tenant"]`},
+		{name: "converter", expression: `Concat([resource.attributes["tenant"], attributes["region"]], "/")`},
+		{name: "missing", err: true},
+		{name: "invalid", expression: `resource.attributes[`, err: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := simpleConfig()
+			cfg.RoutingKey = "ottl"
+			cfg.RoutingExpression = tt.expression
+
+			p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, ottlRouting, p.routingKey)
+			assert.NotNil(t, p.routingExpression)
+		})
+	}
+}
+
+func TestRoutingExpressionIdentifiers(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.RoutingKey = "ottl"
+	cfg.RoutingExpression = `resource.attributes["tenant"]`
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("tenant", "acme")
+	appendSimpleTraceWithID(rs, [16]byte{1, 2, 3, 4})
+
+	ids, err := p.routingIdentifiers(context.Background(), traces)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"acme": true}, ids)
+
+	// the traces without routing key are routed by trace ID
+	ids, err = p.routingIdentifiers(context.Background(), simpleTraces())
+	require.NoError(t, err)
+	tid := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	assert.Equal(t, map[string]bool{string(tid[:]): true}, ids)
+}
+
+func TestConsumeTracesReportsResult(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.Resolver.Static.Hostnames = []string{"endpoint-1", "endpoint-2"}
+	cfg.Eviction = EvictionSettings{Enabled: true, MaxFailures: 1, Duration: time.Minute}
+	componentFactory := func(ctx context.Context, endpoint string) (component.Component, error) {
+		return newMockTracesExporter(func(ctx context.Context, td ptrace.Traces) error {
+			return errors.New("unavailable")
+		}), nil
+	}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	require.Error(t, p.ConsumeTraces(context.Background(), simpleTraces()))
+
+	// verify
+	lb.updateLock.RLock()
+	defer lb.updateLock.RUnlock()
+	assert.Len(t, lb.evicted, 1)
+	assert.Len(t, lb.ring.items, defaultWeight)
+}
+
 func TestServiceBasedRoutingForSameTraceId(t *testing.T) {
 	b := pcommon.TraceID([16]byte{1, 2, 3, 4})
 	for _, tt := range []struct {
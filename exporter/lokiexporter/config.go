@@ -9,6 +9,13 @@ import (
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
+)
+
+const (
+	protocolLoki = "loki"
+	protocolOTLP = "otlp"
 )
 
 // Config defines configuration for Loki exporter.
@@ -18,6 +25,30 @@ type Config struct {
 	exporterhelper.RetrySettings  `mapstructure:"retry_on_failure"`
 
 	DefaultLabelsEnabled map[string]bool `mapstructure:"default_labels_enabled"`
+
+	// Protocol is the protocol used to send the logs to Loki, either `loki` for the Loki push API,
+	// or `otlp` for the Loki OTLP endpoint. Defaults to `loki`.
+	Protocol string `mapstructure:"protocol"`
+
+	// Labels lists the resource and log attributes promoted to Loki labels, in addition to
+	// the ones listed by the `loki.resource.labels` and `loki.attribute.labels` hints.
+	Labels AttributesConfig `mapstructure:"labels"`
+
+	// StructuredMetadata lists the resource and log attributes sent as Loki structured metadata
+	// instead of being part of the log line. It requires Loki 2.9 or later.
+	StructuredMetadata AttributesConfig `mapstructure:"structured_metadata"`
+}
+
+// AttributesConfig lists resource and log attributes.
+type AttributesConfig struct {
+	// Resource lists the names of resource attributes.
+	Resource []string `mapstructure:"resource"`
+	// Attributes lists the names of log attributes.
+	Attributes []string `mapstructure:"attributes"`
+}
+
+func (c AttributesConfig) isEmpty() bool {
+	return len(c.Resource) == 0 && len(c.Attributes) == 0
 }
 
 func (c *Config) Validate() error {
@@ -28,5 +59,43 @@ func (c *Config) Validate() error {
 	if _, err := url.Parse(c.Endpoint); c.Endpoint == "" || err != nil {
 		return fmt.Errorf("\"endpoint\" must be a valid URL")
 	}
+
+	switch c.Protocol {
+	case "", protocolLoki:
+	case protocolOTLP:
+		if !c.Labels.isEmpty() || !c.StructuredMetadata.isEmpty() {
+			return fmt.Errorf("\"labels\" and \"structured_metadata\" are not supported with the %q protocol, Loki maps the attributes itself", protocolOTLP)
+		}
+	default:
+		return fmt.Errorf("\"protocol\" must be either %q or %q, got %q", protocolLoki, protocolOTLP, c.Protocol)
+	}
+
+	if err := checkDistinct(c.Labels.Resource, c.StructuredMetadata.Resource); err != nil {
+		return fmt.Errorf("resource attribute %w", err)
+	}
+	if err := checkDistinct(c.Labels.Attributes, c.StructuredMetadata.Attributes); err != nil {
+		return fmt.Errorf("log attribute %w", err)
+	}
 	return nil
 }
+
+// checkDistinct returns an error if an attribute is both a label and structured metadata.
+func checkDistinct(labels, structuredMetadata []string) error {
+	for _, label := range labels {
+		for _, metadata := range structuredMetadata {
+			if label == metadata {
+				return fmt.Errorf("%q can't be both a label and structured metadata", label)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Config) attributesMapping() loki.AttributesMapping {
+	return loki.AttributesMapping{
+		ResourceLabels:              c.Labels.Resource,
+		AttributeLabels:             c.Labels.Attributes,
+		ResourceStructuredMetadata:  c.StructuredMetadata.Resource,
+		AttributeStructuredMetadata: c.StructuredMetadata.Attributes,
+	}
+}
@@ -71,6 +71,35 @@ func TestLoadConfigNewExporter(t *testing.T) {
 					"instance": true,
 					"level":    false,
 				},
+				Protocol: "loki",
+				Labels: AttributesConfig{
+					Resource:   []string{"service.name"},
+					Attributes: []string{"http.method"},
+				},
+				StructuredMetadata: AttributesConfig{
+					Resource:   []string{"k8s.pod.name"},
+					Attributes: []string{"trace_id"},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "otlp"),
+			expected: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Headers:         map[string]configopaque.String{},
+					Endpoint:        "https://loki:3100/otlp/v1/logs",
+					WriteBufferSize: 512 * 1024,
+					Timeout:         30 * time.Second,
+				},
+				RetrySettings: exporterhelper.NewDefaultRetrySettings(),
+				QueueSettings: exporterhelper.NewDefaultQueueSettings(),
+				DefaultLabelsEnabled: map[string]bool{
+					"exporter": true,
+					"job":      true,
+					"instance": true,
+					"level":    true,
+				},
+				Protocol: "otlp",
 			},
 		},
 	}
@@ -115,6 +144,38 @@ func TestConfigValidate(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			desc: "Protocol is invalid",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://loki.example.com",
+				},
+				Protocol: "grpc",
+			},
+			err: fmt.Errorf(`"protocol" must be either "loki" or "otlp", got "grpc"`),
+		},
+		{
+			desc: "Labels with the otlp protocol",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://loki.example.com/otlp/v1/logs",
+				},
+				Protocol: "otlp",
+				Labels:   AttributesConfig{Resource: []string{"service.name"}},
+			},
+			err: fmt.Errorf(`"labels" and "structured_metadata" are not supported with the "otlp" protocol`),
+		},
+		{
+			desc: "Attribute is both a label and structured metadata",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://loki.example.com",
+				},
+				Labels:             AttributesConfig{Attributes: []string{"http.method"}},
+				StructuredMetadata: AttributesConfig{Attributes: []string{"http.method"}},
+			},
+			err: fmt.Errorf(`log attribute "http.method" can't be both a label and structured metadata`),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -19,6 +19,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
@@ -47,7 +48,11 @@ func newExporter(config *Config, settings component.TelemetrySettings) *lokiExpo
 }
 
 func (l *lokiExporter) pushLogData(ctx context.Context, ld plog.Logs) error {
-	requests := loki.LogsToLokiRequests(ld, l.config.DefaultLabelsEnabled)
+	if l.config.Protocol == protocolOTLP {
+		return l.pushOTLPLogData(ctx, ld)
+	}
+
+	requests := loki.LogsToLokiRequestsWithMapping(ld, l.config.DefaultLabelsEnabled, l.config.attributesMapping())
 
 	var errs error
 	for tenant, request := range requests {
@@ -76,11 +81,34 @@ func (l *lokiExporter) sendPushRequest(ctx context.Context, tenant string, reque
 		)
 	}
 
-	buf, err := encode(pushReq)
+	// the protobuf encoding doesn't support structured metadata
+	var buf []byte
+	var err error
+	contentType := "application/x-protobuf"
+	if request.HasStructuredMetadata() {
+		buf, err = request.EncodeJSON()
+		contentType = "application/json"
+	} else {
+		buf, err = encode(pushReq)
+	}
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+
+	return l.send(ctx, tenant, buf, contentType, ld)
+}
+
+// pushOTLPLogData sends the logs to the Loki OTLP endpoint, Loki mapping the resource
+// attributes to labels and the other attributes to structured metadata itself.
+func (l *lokiExporter) pushOTLPLogData(ctx context.Context, ld plog.Logs) error {
+	buf, err := plogotlp.NewExportRequestFromLogs(ld).MarshalProto()
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
+	return l.send(ctx, "", buf, "application/x-protobuf", ld)
+}
 
+func (l *lokiExporter) send(ctx context.Context, tenant string, buf []byte, contentType string, ld plog.Logs) error {
 	req, err := http.NewRequestWithContext(ctx, "POST", l.config.HTTPClientSettings.Endpoint, bytes.NewReader(buf))
 	if err != nil {
 		return consumererror.NewPermanent(err)
@@ -89,7 +117,7 @@ func (l *lokiExporter) sendPushRequest(ctx context.Context, tenant string, reque
 	for k, v := range l.config.HTTPClientSettings.Headers {
 		req.Header.Set(k, string(v))
 	}
-	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Type", contentType)
 	if len(tenant) > 0 {
 		req.Header.Set("X-Scope-OrgID", tenant)
 	}
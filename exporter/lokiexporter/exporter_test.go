@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 )
 
 func TestPushLogData(t *testing.T) {
@@ -337,3 +338,82 @@ func (p *badProtoForCoverage) ProtoMessage()  {}
 func (p *badProtoForCoverage) Marshal() (dAtA []byte, err error) {
 	return nil, fmt.Errorf("this is a bad proto")
 }
+
+func TestPushLogDataWithStructuredMetadata(t *testing.T) {
+	var contentType string
+	var payload []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		var err error
+		payload, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+		DefaultLabelsEnabled: map[string]bool{"exporter": false},
+		Labels:               AttributesConfig{Resource: []string{"service.name"}},
+		StructuredMetadata:   AttributesConfig{Attributes: []string{"order.id"}},
+	}
+
+	exp, err := NewFactory().CreateLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(1677592916000000000)
+	lr.Body().SetStr("order placed")
+	lr.Attributes().PutStr("order.id", "42")
+
+	require.NoError(t, exp.ConsumeLogs(context.Background(), ld))
+
+	assert.Equal(t, "application/json", contentType)
+	assert.JSONEq(t, `{"streams":[{
+		"stream":{"job":"checkout","service_name":"checkout"},
+		"values":[["1677592916000000000","{\"body\":\"order placed\"}",{"order_id":"42"}]]
+	}]}`, string(payload))
+
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func TestPushLogDataWithOTLPProtocol(t *testing.T) {
+	var contentType string
+	actual := plogotlp.NewExportRequest()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		payload, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, actual.UnmarshalProto(payload))
+	}))
+	defer ts.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ts.URL
+	cfg.Protocol = protocolOTLP
+	cfg.QueueSettings.Enabled = false
+
+	exp, err := NewFactory().CreateLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Body().SetStr("order placed")
+	lr.Attributes().PutStr("order.id", "42")
+
+	require.NoError(t, exp.ConsumeLogs(context.Background(), ld))
+
+	// the logs are sent as they are, Loki maps the attributes itself
+	assert.Equal(t, "application/x-protobuf", contentType)
+	assert.Equal(t, ld, actual.Logs())
+
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
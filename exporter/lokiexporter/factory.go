@@ -47,6 +47,7 @@ func createDefaultConfig() component.Config {
 			"instance": true,
 			"level":    true,
 		},
+		Protocol: protocolLoki,
 	}
 }
 
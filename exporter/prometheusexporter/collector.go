@@ -7,6 +7,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sort"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
@@ -31,6 +33,7 @@ type collector struct {
 	logger      *zap.Logger
 
 	sendTimestamps    bool
+	sendCreatedSeries bool
 	addMetricSuffixes bool
 	namespace         string
 	constLabels       prometheus.Labels
@@ -42,6 +45,7 @@ func newCollector(config *Config, logger *zap.Logger) *collector {
 		logger:            logger,
 		namespace:         prometheustranslator.CleanUpString(config.Namespace),
 		sendTimestamps:    config.SendTimestamps,
+		sendCreatedSeries: config.SendCreatedSeries,
 		constLabels:       config.ConstLabels,
 		addMetricSuffixes: config.AddMetricSuffixes,
 	}
@@ -63,6 +67,8 @@ func convertExemplars(exemplars pmetric.ExemplarSlice) []prometheus.Exemplar {
 			exemplarLabels["span_id"] = hex.EncodeToString(spanID[:])
 		}
 
+		addExemplarAttributes(exemplarLabels, e.FilteredAttributes())
+
 		var value float64
 		switch e.ValueType() {
 		case pmetric.ExemplarValueTypeDouble:
@@ -72,15 +78,64 @@ func convertExemplars(exemplars pmetric.ExemplarSlice) []prometheus.Exemplar {
 		}
 
 		result[i] = prometheus.Exemplar{
-			Value:     value,
-			Labels:    exemplarLabels,
-			Timestamp: e.Timestamp().AsTime(),
+			Value:  value,
+			Labels: exemplarLabels,
+		}
+		// the zero time is replaced by the time of the scrape
+		if e.Timestamp() != 0 {
+			result[i].Timestamp = e.Timestamp().AsTime()
 		}
-
 	}
 	return result
 }
 
+// addExemplarAttributes adds the filtered attributes of the exemplar to its labels, in the
+// order of their keys, as long as the labels don't exceed the maximum length of the exemplar
+// labels allowed by OpenMetrics.
+func addExemplarAttributes(labels prometheus.Labels, attributes pcommon.Map) {
+	if attributes.Len() == 0 {
+		return
+	}
+	runes := 0
+	for name, value := range labels {
+		runes += utf8.RuneCountInString(name) + utf8.RuneCountInString(value)
+	}
+	keys := make([]string, 0, attributes.Len())
+	attributes.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+	for _, k := range keys {
+		name := prometheustranslator.NormalizeLabel(k)
+		if _, ok := labels[name]; ok {
+			continue
+		}
+		v, _ := attributes.Get(k)
+		value := v.AsString()
+		n := utf8.RuneCountInString(name) + utf8.RuneCountInString(value)
+		if runes+n > prometheus.ExemplarMaxRunes {
+			continue
+		}
+		labels[name] = value
+		runes += n
+	}
+}
+
+// withExemplars adds the exemplars to the metric, the metric being reported without
+// exemplars when they are invalid.
+func (c *collector) withExemplars(m prometheus.Metric, exemplars []prometheus.Exemplar) prometheus.Metric {
+	if len(exemplars) == 0 {
+		return m
+	}
+	mWithExemplars, err := prometheus.NewMetricWithExemplars(m, exemplars...)
+	if err != nil {
+		c.logger.Debug("dropping invalid exemplars", zap.String("metric", m.Desc().String()), zap.Error(err))
+		return m
+	}
+	return mWithExemplars
+}
+
 // Describe is a no-op, because the collector dynamically allocates metrics.
 // https://github.com/prometheus/client_golang/blob/v1.9.0/prometheus/collector.go#L28-L40
 func (c *collector) Describe(_ chan<- *prometheus.Desc) {}
@@ -110,6 +165,16 @@ func (c *collector) convertMetric(metric pmetric.Metric, resourceAttrs pcommon.M
 }
 
 func (c *collector) getMetricMetadata(metric pmetric.Metric, attributes pcommon.Map, resourceAttrs pcommon.Map) (*prometheus.Desc, []string) {
+	keys, values := c.getMetricLabels(attributes, resourceAttrs)
+	return prometheus.NewDesc(
+		prometheustranslator.BuildCompliantName(metric, c.namespace, c.addMetricSuffixes),
+		metric.Description(),
+		keys,
+		c.constLabels,
+	), values
+}
+
+func (c *collector) getMetricLabels(attributes pcommon.Map, resourceAttrs pcommon.Map) ([]string, []string) {
 	keys := make([]string, 0, attributes.Len()+2) // +2 for job and instance labels.
 	values := make([]string, 0, attributes.Len()+2)
 
@@ -128,12 +193,46 @@ func (c *collector) getMetricMetadata(metric pmetric.Metric, attributes pcommon.
 		values = append(values, instance)
 	}
 
-	return prometheus.NewDesc(
-		prometheustranslator.BuildCompliantName(metric, c.namespace, c.addMetricSuffixes),
-		metric.Description(),
-		keys,
-		c.constLabels,
-	), values
+	return keys, values
+}
+
+// convertCreated returns the _created series of the counters, histograms and summaries, whose
+// value is the start time of the cumulative metric in seconds, as defined by OpenMetrics. It
+// returns nil for the other metrics, and the metrics without start time.
+func (c *collector) convertCreated(metric pmetric.Metric, resourceAttrs pcommon.Map) (prometheus.Metric, error) {
+	var start, ts pcommon.Timestamp
+	var attributes pcommon.Map
+	switch metric.Type() {
+	case pmetric.MetricTypeSum:
+		if !metric.Sum().IsMonotonic() {
+			return nil, nil
+		}
+		ip := metric.Sum().DataPoints().At(0)
+		start, ts, attributes = ip.StartTimestamp(), ip.Timestamp(), ip.Attributes()
+	case pmetric.MetricTypeHistogram:
+		ip := metric.Histogram().DataPoints().At(0)
+		start, ts, attributes = ip.StartTimestamp(), ip.Timestamp(), ip.Attributes()
+	case pmetric.MetricTypeSummary:
+		ip := metric.Summary().DataPoints().At(0)
+		start, ts, attributes = ip.StartTimestamp(), ip.Timestamp(), ip.Attributes()
+	default:
+		return nil, nil
+	}
+	if start == 0 {
+		return nil, nil
+	}
+
+	keys, values := c.getMetricLabels(attributes, resourceAttrs)
+	name := strings.TrimSuffix(prometheustranslator.BuildCompliantName(metric, c.namespace, c.addMetricSuffixes), "_total") + "_created"
+	desc := prometheus.NewDesc(name, metric.Description(), keys, c.constLabels)
+	m, err := prometheus.NewConstMetric(desc, prometheus.GaugeValue, float64(start)/1e9, values...)
+	if err != nil {
+		return nil, err
+	}
+	if c.sendTimestamps {
+		return prometheus.NewMetricWithTimestamp(ts.AsTime(), m), nil
+	}
+	return m, nil
 }
 
 func (c *collector) convertGauge(metric pmetric.Metric, resourceAttrs pcommon.Map) (prometheus.Metric, error) {
@@ -185,13 +284,7 @@ func (c *collector) convertSum(metric pmetric.Metric, resourceAttrs pcommon.Map)
 	if err != nil {
 		return nil, err
 	}
-
-	if len(exemplars) > 0 {
-		m, err = prometheus.NewMetricWithExemplars(m, exemplars...)
-		if err != nil {
-			return nil, err
-		}
-	}
+	m = c.withExemplars(m, exemplars)
 
 	if c.sendTimestamps {
 		return prometheus.NewMetricWithTimestamp(ip.Timestamp().AsTime(), m), nil
@@ -257,13 +350,7 @@ func (c *collector) convertDoubleHistogram(metric pmetric.Metric, resourceAttrs
 	if err != nil {
 		return nil, err
 	}
-
-	if len(exemplars) > 0 {
-		m, err = prometheus.NewMetricWithExemplars(m, exemplars...)
-		if err != nil {
-			return nil, err
-		}
-	}
+	m = c.withExemplars(m, exemplars)
 
 	if c.sendTimestamps {
 		return prometheus.NewMetricWithTimestamp(ip.Timestamp().AsTime(), m), nil
@@ -384,5 +471,18 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 
 		ch <- m
 		c.logger.Debug(fmt.Sprintf("metric served: %s", m.Desc().String()))
+
+		if !c.sendCreatedSeries {
+			continue
+		}
+		created, err := c.convertCreated(pMetric, rAttr)
+		if err != nil {
+			c.logger.Error(fmt.Sprintf("failed to convert the created series of metric %s: %s", pMetric.Name(), err.Error()))
+			continue
+		}
+		if created != nil {
+			ch <- created
+			c.logger.Debug(fmt.Sprintf("metric served: %s", created.Desc().String()))
+		}
 	}
 }
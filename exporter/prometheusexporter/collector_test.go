@@ -5,12 +5,14 @@ package prometheusexporter
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -217,6 +219,135 @@ func TestConvertMonotonicSumExemplar(t *testing.T) {
 	exemplarsEqual(t, exemplar, promCounter.GetExemplar())
 }
 
+func TestConvertExemplarAttributes(t *testing.T) {
+	exemplars := pmetric.NewExemplarSlice()
+	exemplar := exemplars.AppendEmpty()
+	setTestExemplarWithDoubleValue(exemplar, 3)
+	exemplar.FilteredAttributes().PutStr("http.method", "GET")
+	exemplar.FilteredAttributes().PutStr("a.long.attribute", strings.Repeat("x", 100))
+	exemplar.FilteredAttributes().PutInt("status", 200)
+
+	promExemplars := convertExemplars(exemplars)
+	require.Len(t, promExemplars, 1)
+	// the long attribute doesn't fit in the exemplar labels along with the trace and span IDs
+	assert.Equal(t, prometheus.Labels{
+		"trace_id":    "641d68e314a58152cc2581e7663435d1",
+		"span_id":     "7436d6ac76178623",
+		"http_method": "GET",
+		"status":      "200",
+	}, promExemplars[0].Labels)
+	assert.InDelta(t, 3.0, promExemplars[0].Value, 0.01)
+
+	_, err := prometheus.NewMetricWithExemplars(prometheus.MustNewConstMetric(
+		prometheus.NewDesc("test_total", "", nil, nil), prometheus.CounterValue, 1), promExemplars...)
+	require.NoError(t, err)
+}
+
+func TestConvertCreated(t *testing.T) {
+	start := pcommon.Timestamp(1690000000500000000)
+	ts := pcommon.Timestamp(1690000060000000000)
+
+	tests := []struct {
+		name        string
+		metric      func() pmetric.Metric
+		createdName string
+	}{
+		{
+			name: "monotonic sum",
+			metric: func() pmetric.Metric {
+				metric := pmetric.NewMetric()
+				metric.SetName("test_requests")
+				sum := metric.SetEmptySum()
+				sum.SetIsMonotonic(true)
+				sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+				dp := sum.DataPoints().AppendEmpty()
+				dp.SetIntValue(10)
+				dp.SetStartTimestamp(start)
+				dp.SetTimestamp(ts)
+				dp.Attributes().PutStr("label_1", "1")
+				return metric
+			},
+			createdName: "test_requests_created",
+		},
+		{
+			name: "histogram",
+			metric: func() pmetric.Metric {
+				metric := pmetric.NewMetric()
+				metric.SetName("test_duration")
+				metric.SetUnit("s")
+				h := metric.SetEmptyHistogram()
+				h.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+				dp := h.DataPoints().AppendEmpty()
+				dp.SetCount(1)
+				dp.SetStartTimestamp(start)
+				dp.SetTimestamp(ts)
+				dp.Attributes().PutStr("label_1", "1")
+				return metric
+			},
+			createdName: "test_duration_created",
+		},
+		{
+			name: "summary",
+			metric: func() pmetric.Metric {
+				metric := pmetric.NewMetric()
+				metric.SetName("test_summary")
+				dp := metric.SetEmptySummary().DataPoints().AppendEmpty()
+				dp.SetCount(1)
+				dp.SetStartTimestamp(start)
+				dp.SetTimestamp(ts)
+				dp.Attributes().PutStr("label_1", "1")
+				return metric
+			},
+			createdName: "test_summary_created",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := collector{
+				namespace:         "",
+				sendTimestamps:    true,
+				addMetricSuffixes: true,
+				logger:            zap.NewNop(),
+			}
+			m, err := c.convertCreated(tt.metric(), pcommon.NewMap())
+			require.NoError(t, err)
+			require.NotNil(t, m)
+			require.Contains(t, m.Desc().String(), fmt.Sprintf("fqName: %q", tt.createdName))
+
+			pbMetric := io_prometheus_client.Metric{}
+			require.NoError(t, m.Write(&pbMetric))
+			assert.InDelta(t, 1690000000.5, pbMetric.GetGauge().GetValue(), 0.001)
+			assert.Equal(t, ts.AsTime().UnixMilli(), pbMetric.GetTimestampMs())
+			require.Len(t, pbMetric.GetLabel(), 1)
+			assert.Equal(t, "label_1", pbMetric.GetLabel()[0].GetName())
+		})
+	}
+}
+
+func TestConvertCreatedSkipped(t *testing.T) {
+	c := collector{logger: zap.NewNop()}
+
+	gauge := pmetric.NewMetric()
+	gauge.SetName("test_gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetStartTimestamp(1)
+
+	nonMonotonic := pmetric.NewMetric()
+	nonMonotonic.SetName("test_sum")
+	nonMonotonic.SetEmptySum().DataPoints().AppendEmpty().SetStartTimestamp(1)
+
+	noStart := pmetric.NewMetric()
+	noStart.SetName("test_counter")
+	noStart.SetEmptySum().SetIsMonotonic(true)
+	noStart.Sum().DataPoints().AppendEmpty()
+
+	for _, metric := range []pmetric.Metric{gauge, nonMonotonic, noStart} {
+		m, err := c.convertCreated(metric, pcommon.NewMap())
+		require.NoError(t, err)
+		assert.Nil(t, m, metric.Name())
+	}
+}
+
 // errorCheckCore keeps track of logged errors
 type errorCheckCore struct {
 	errorMessages []string
@@ -26,6 +26,10 @@ type Config struct {
 	// SendTimestamps will send the underlying scrape timestamp with the export
 	SendTimestamps bool `mapstructure:"send_timestamps"`
 
+	// SendCreatedSeries will send the _created series of the counters, histograms and summaries,
+	// holding the start time of the metrics.
+	SendCreatedSeries bool `mapstructure:"send_created_series"`
+
 	// MetricExpiration defines how long metrics are kept without updates
 	MetricExpiration time.Duration `mapstructure:"metric_expiration"`
 
@@ -51,6 +51,7 @@ func TestLoadConfig(t *testing.T) {
 					"another label": "spaced value",
 				},
 				SendTimestamps:    true,
+				SendCreatedSeries: true,
 				MetricExpiration:  60 * time.Minute,
 				AddMetricSuffixes: false,
 			},
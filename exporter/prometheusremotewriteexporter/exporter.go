@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
@@ -22,8 +23,10 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/multierr"
+	"go.uber.org/zap"
 
 	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
@@ -31,6 +34,20 @@ import (
 
 const maxBatchByteSize = 3000000
 
+var nativeHistogramsFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"exporter.prometheusremotewritexporter.NativeHistograms",
+	featuregate.StageBeta,
+	featuregate.WithRegisterDescription("When enabled, exponential histograms are exported as Prometheus native histograms. "+
+		"When disabled, they are exported as classic histograms with explicit buckets."),
+	featuregate.WithRegisterReferenceURL("https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/16207"),
+)
+
+// errNativeHistogramsRejected is returned when the remote write endpoint rejects native histograms.
+// Only the rejected native histograms are then sent again, as classic histograms: the other time
+// series of the request were already sent apart from them. It is not permanent, so that the
+// requests of the WAL are retried too.
+var errNativeHistogramsRejected = errors.New("remote write endpoint rejected native histograms, falling back to classic histograms")
+
 // prwExporter converts OTLP metrics to Prometheus remote write TimeSeries and sends them to a remote endpoint.
 type prwExporter struct {
 	endpointURL     *url.URL
@@ -44,6 +61,9 @@ type prwExporter struct {
 
 	wal              *prweWAL
 	exporterSettings prometheusremotewrite.Settings
+
+	// nativeHistogramsRejected is set once the endpoint rejected native histograms.
+	nativeHistogramsRejected atomic.Bool
 }
 
 // newPRWExporter initializes a new prwExporter instance and sets fields accordingly.
@@ -127,15 +147,49 @@ func (prwe *prwExporter) PushMetrics(ctx context.Context, md pmetric.Metrics) er
 	case <-prwe.closeChan:
 		return errors.New("shutdown has been called")
 	default:
-		tsMap, err := prometheusremotewrite.FromMetrics(md, prwe.exporterSettings)
+		settings := prwe.exporterSettings
+		settings.ExpandExponentialHistograms = !nativeHistogramsFeatureGate.IsEnabled() || prwe.nativeHistogramsRejected.Load()
+		tsMap, err := prometheusremotewrite.FromMetrics(md, settings)
 		if err != nil {
 			err = consumererror.NewPermanent(err)
 		}
 		// Call export even if a conversion error, since there may be points that were successfully converted.
-		return multierr.Combine(err, prwe.handleExport(ctx, tsMap))
+		if settings.ExpandExponentialHistograms {
+			return multierr.Combine(err, prwe.handleExport(ctx, tsMap))
+		}
+		return multierr.Combine(err, prwe.handleExportWithNativeHistograms(ctx, md, tsMap))
 	}
 }
 
+// handleExportWithNativeHistograms exports the native histograms apart from the other time series.
+// If the endpoint rejects them, only the exponential histograms are sent again, as classic histograms.
+func (prwe *prwExporter) handleExportWithNativeHistograms(ctx context.Context, md pmetric.Metrics, tsMap map[string]*prompb.TimeSeries) error {
+	histograms := make(map[string]*prompb.TimeSeries)
+	for key, ts := range tsMap {
+		if len(ts.Histograms) > 0 {
+			histograms[key] = ts
+			delete(tsMap, key)
+		}
+	}
+	err := prwe.handleExport(ctx, tsMap)
+	histogramsErr := prwe.handleExport(ctx, histograms)
+	if !errors.Is(histogramsErr, errNativeHistogramsRejected) {
+		return multierr.Combine(err, histogramsErr)
+	}
+
+	settings := prwe.exporterSettings
+	settings.ExpandExponentialHistograms = true
+	// The conversion errors were already returned by the first conversion
+	expanded, _ := prometheusremotewrite.FromMetrics(md, settings)
+	for key := range expanded {
+		// the other time series were already sent
+		if _, ok := tsMap[key]; ok {
+			delete(expanded, key)
+		}
+	}
+	return multierr.Combine(err, prwe.handleExport(ctx, expanded))
+}
+
 func validateAndSanitizeExternalLabels(cfg *Config) (map[string]string, error) {
 	sanitizedLabels := make(map[string]string)
 	for key, value := range cfg.ExternalLabels {
@@ -202,8 +256,11 @@ func (prwe *prwExporter) export(ctx context.Context, requests []*prompb.WriteReq
 						return
 					}
 					if errExecute := prwe.execute(ctx, request); errExecute != nil {
+						if !errors.Is(errExecute, errNativeHistogramsRejected) {
+							errExecute = consumererror.NewPermanent(errExecute)
+						}
 						mu.Lock()
-						errs = multierr.Append(errs, consumererror.NewPermanent(errExecute))
+						errs = multierr.Append(errs, errExecute)
 						mu.Unlock()
 					}
 				}
@@ -255,9 +312,26 @@ func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequ
 	if resp.StatusCode >= 500 && resp.StatusCode < 600 {
 		return rerr
 	}
+	if resp.StatusCode == http.StatusBadRequest && hasNativeHistograms(writeReq) &&
+		strings.Contains(strings.ToLower(string(body)), "histogram") {
+		if !prwe.nativeHistogramsRejected.Swap(true) {
+			prwe.settings.Logger.Warn("Remote write endpoint does not accept native histograms, exponential histograms will be sent as classic histograms",
+				zap.Error(rerr))
+		}
+		return fmt.Errorf("%w: %v", errNativeHistogramsRejected, rerr)
+	}
 	return consumererror.NewPermanent(rerr)
 }
 
+func hasNativeHistograms(writeReq *prompb.WriteRequest) bool {
+	for _, ts := range writeReq.Timeseries {
+		if len(ts.Histograms) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (prwe *prwExporter) walEnabled() bool { return prwe.wal != nil }
 
 func (prwe *prwExporter) turnOnWALIfEnabled(ctx context.Context) error {
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
@@ -25,6 +26,8 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
@@ -978,3 +981,89 @@ func TestWALOnExporterRoundTrip(t *testing.T) {
 	assert.Equal(t, want, gotFromUpload)
 	assert.Equal(t, gotFromWAL, gotFromUpload)
 }
+
+func TestPushMetricsNativeHistogramsFallback(t *testing.T) {
+	var histograms, samples int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		dest, err := snappy.Decode(nil, body)
+		require.NoError(t, err)
+		writeReq := &prompb.WriteRequest{}
+		require.NoError(t, proto.Unmarshal(dest, writeReq))
+		for _, ts := range writeReq.Timeseries {
+			if len(ts.Histograms) > 0 {
+				histograms += len(ts.Histograms)
+				http.Error(w, "out of order sample: native histograms are disabled", http.StatusBadRequest)
+				return
+			}
+			samples += len(ts.Samples)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = server.URL
+	cfg.TargetInfo.Enabled = false
+	prwe, err := newPRWExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, prwe.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, prwe.Shutdown(context.Background())) }()
+
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ts := uint64(time.Now().UnixNano())
+	getExpHistogramMetric("exponential_hist", pcommon.NewMap(), ts, nil, 2, 0, []uint64{1, 1}).CopyTo(ms.AppendEmpty())
+	getIntGaugeMetric("gauge", pcommon.NewMap(), 1, ts).CopyTo(ms.AppendEmpty())
+
+	// The rejected histograms are sent again as classic histograms, the gauge is sent once
+	require.NoError(t, prwe.PushMetrics(context.Background(), md))
+	assert.Equal(t, 1, histograms)
+	assert.True(t, prwe.nativeHistogramsRejected.Load())
+	// gauge, zero bucket, 2 positive buckets, +Inf bucket and _count, no _sum
+	assert.Equal(t, 6, samples)
+
+	require.NoError(t, prwe.PushMetrics(context.Background(), md))
+	assert.Equal(t, 1, histograms)
+	assert.Equal(t, 12, samples)
+}
+
+func TestPushMetricsNativeHistogramsFeatureGateDisabled(t *testing.T) {
+	require.NoError(t, featuregate.GlobalRegistry().Set(nativeHistogramsFeatureGate.ID(), false))
+	defer func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(nativeHistogramsFeatureGate.ID(), true))
+	}()
+
+	var histograms, samples int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		dest, err := snappy.Decode(nil, body)
+		require.NoError(t, err)
+		writeReq := &prompb.WriteRequest{}
+		require.NoError(t, proto.Unmarshal(dest, writeReq))
+		for _, ts := range writeReq.Timeseries {
+			histograms += len(ts.Histograms)
+			samples += len(ts.Samples)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = server.URL
+	cfg.TargetInfo.Enabled = false
+	prwe, err := newPRWExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, prwe.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, prwe.Shutdown(context.Background())) }()
+
+	md := pmetric.NewMetrics()
+	getExpHistogramMetric("exponential_hist", pcommon.NewMap(), uint64(time.Now().UnixNano()), nil, 2, 0, []uint64{1, 1}).
+		CopyTo(md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty())
+
+	require.NoError(t, prwe.PushMetrics(context.Background(), md))
+	assert.Equal(t, 0, histograms)
+	assert.Equal(t, 5, samples)
+}
@@ -130,6 +130,10 @@ type Config struct {
 	// to be used in a dimension key.
 	NonAlphanumericDimensionChars string `mapstructure:"nonalphanumeric_dimension_chars"`
 
+	// SendOTLPHistograms sends histograms in the OTLP format to the OTLP endpoint of the ingest URL,
+	// "/v2/datapoint/otlp", rather than converting them to datapoints, which preserves their buckets.
+	SendOTLPHistograms bool `mapstructure:"send_otlp_histograms"`
+
 	// MaxConnections is used to set a limit to the maximum idle HTTP connection the exporter can keep open.
 	// Deprecated: use HTTPClientSettings.MaxIdleConns or HTTPClientSettings.MaxIdleConnsPerHost instead.
 	MaxConnections int `mapstructure:"max_connections"`
@@ -17,6 +17,8 @@ import (
 	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
@@ -58,6 +60,7 @@ type sfxDPClient struct {
 	logger                 *zap.Logger
 	accessTokenPassthrough bool
 	converter              *translation.MetricsConverter
+	sendOTLPHistograms     bool
 }
 
 func (s *sfxDPClient) pushMetricsData(
@@ -81,13 +84,58 @@ func (s *sfxDPClient) pushMetricsData(
 	// All metrics in the pmetric.Metrics will have the same access token because of the BatchPerResourceMetrics.
 	metricToken := s.retrieveAccessToken(rms.At(0))
 
+	if !s.sendOTLPHistograms {
+		return s.pushSFxMetricsData(ctx, md, metricToken)
+	}
+
+	// Histograms are sent as OTLP to keep their buckets, the other metrics are converted to datapoints.
+	md, histograms := translation.SplitHistograms(md)
+	s.converter.FilterHistograms(histograms)
+
+	var errs, permanentErrs error
+	failed := pmetric.NewMetrics()
+	for _, part := range []struct {
+		metrics pmetric.Metrics
+		send    func(context.Context, pmetric.Metrics, string) (int, error)
+	}{
+		{md, s.pushSFxMetricsData},
+		{histograms, s.pushOTLPMetricsDataForToken},
+	} {
+		if part.metrics.ResourceMetrics().Len() == 0 {
+			continue
+		}
+		dropped, err := part.send(ctx, part.metrics, metricToken)
+		if err == nil {
+			continue
+		}
+		droppedDataPoints += dropped
+		if consumererror.IsPermanent(err) {
+			s.logger.Error("Exporting failed. The error is not retryable. Dropping data.",
+				zap.Error(err),
+				zap.Int("dropped_items", part.metrics.DataPointCount()))
+			permanentErrs = multierr.Append(permanentErrs, err)
+			continue
+		}
+		errs = multierr.Append(errs, err)
+		part.metrics.ResourceMetrics().MoveAndAppendTo(failed.ResourceMetrics())
+	}
+	if errs == nil {
+		return droppedDataPoints, permanentErrs
+	}
+	// Only the metrics that failed with a retryable error are retried, so that the datapoints
+	// or the histograms already sent aren't sent twice. The permanent errors are left out, as
+	// they would prevent the retry.
+	return droppedDataPoints, consumererror.NewMetrics(errs, failed)
+}
+
+func (s *sfxDPClient) pushSFxMetricsData(ctx context.Context, md pmetric.Metrics, accessToken string) (int, error) {
 	sfxDataPoints := s.converter.MetricsToSignalFxV2(md)
 	if s.logDataPoints {
 		for _, dp := range sfxDataPoints {
 			s.logger.Debug("Dispatching SFx datapoint", zap.Stringer("dp", dp))
 		}
 	}
-	return s.pushMetricsDataForToken(ctx, sfxDataPoints, metricToken)
+	return s.pushMetricsDataForToken(ctx, sfxDataPoints, accessToken)
 }
 
 func (s *sfxDPClient) pushMetricsDataForToken(ctx context.Context, sfxDataPoints []*sfxpb.DataPoint, accessToken string) (int, error) {
@@ -100,9 +148,42 @@ func (s *sfxDPClient) pushMetricsDataForToken(ctx context.Context, sfxDataPoints
 	if !strings.HasSuffix(datapointURL.Path, "v2/datapoint") {
 		datapointURL.Path = path.Join(datapointURL.Path, "v2/datapoint")
 	}
-	req, err := http.NewRequestWithContext(ctx, "POST", datapointURL.String(), body)
+	if err = s.postData(ctx, datapointURL, body, compressed, accessToken); err != nil {
+		return len(sfxDataPoints), err
+	}
+	return 0, nil
+}
+
+func (s *sfxDPClient) pushOTLPMetricsDataForToken(ctx context.Context, md pmetric.Metrics, accessToken string) (int, error) {
+	dataPointCount := md.DataPointCount()
+	if s.logDataPoints {
+		s.logger.Debug("Dispatching OTLP histograms", zap.Int("data_points", dataPointCount))
+	}
+	b, err := pmetricotlp.NewExportRequestFromMetrics(md).MarshalProto()
 	if err != nil {
-		return len(sfxDataPoints), consumererror.NewPermanent(err)
+		return dataPointCount, consumererror.NewPermanent(err)
+	}
+	body, compressed, err := s.getReader(b)
+	if err != nil {
+		return dataPointCount, consumererror.NewPermanent(err)
+	}
+
+	otlpURL := *s.ingestURL
+	if strings.HasSuffix(otlpURL.Path, "v2/datapoint") {
+		otlpURL.Path = path.Join(otlpURL.Path, "otlp")
+	} else if !strings.HasSuffix(otlpURL.Path, "v2/datapoint/otlp") {
+		otlpURL.Path = path.Join(otlpURL.Path, "v2/datapoint/otlp")
+	}
+	if err = s.postData(ctx, otlpURL, body, compressed, accessToken); err != nil {
+		return dataPointCount, err
+	}
+	return 0, nil
+}
+
+func (s *sfxDPClient) postData(ctx context.Context, endpoint url.URL, body io.Reader, compressed bool, accessToken string) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint.String(), body)
+	if err != nil {
+		return consumererror.NewPermanent(err)
 	}
 
 	for k, v := range s.headers {
@@ -122,7 +203,7 @@ func (s *sfxDPClient) pushMetricsDataForToken(ctx context.Context, sfxDataPoints
 	// error for metrics is available.
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return len(sfxDataPoints), err
+		return err
 	}
 
 	defer func() {
@@ -130,11 +211,7 @@ func (s *sfxDPClient) pushMetricsDataForToken(ctx context.Context, sfxDataPoints
 		resp.Body.Close()
 	}()
 
-	err = splunk.HandleHTTPCode(resp)
-	if err != nil {
-		return len(sfxDataPoints), err
-	}
-	return 0, nil
+	return splunk.HandleHTTPCode(resp)
 }
 
 func (s *sfxDPClient) encodeBody(dps []*sfxpb.DataPoint) (bodyReader io.Reader, compressed bool, err error) {
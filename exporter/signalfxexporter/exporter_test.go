@@ -33,6 +33,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 
@@ -233,6 +234,192 @@ func TestConsumeMetrics(t *testing.T) {
 	}
 }
 
+func TestConsumeMetricsOTLPHistograms(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+	g := ms.AppendEmpty()
+	g.SetName("test_gauge")
+	g.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(123)
+	h := ms.AppendEmpty()
+	h.SetName("test_histogram")
+	dp := h.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.SetCount(2)
+	dp.SetSum(42)
+	dp.ExplicitBounds().FromRaw([]float64{10, 100})
+	dp.BucketCounts().FromRaw([]uint64{1, 1, 0})
+
+	tests := []struct {
+		name               string
+		sendOTLPHistograms bool
+		ingestPath         string
+		wantDatapoints     []string
+		wantHistograms     int
+	}{
+		{
+			name:           "disabled",
+			wantDatapoints: []string{"test_gauge", "test_histogram_bucket", "test_histogram_bucket", "test_histogram_bucket", "test_histogram_count", "test_histogram_sum"},
+		},
+		{
+			name:               "enabled",
+			sendOTLPHistograms: true,
+			wantDatapoints:     []string{"test_gauge"},
+			wantHistograms:     1,
+		},
+		{
+			name:               "enabled_with_datapoint_path",
+			sendOTLPHistograms: true,
+			ingestPath:         "/v2/datapoint",
+			wantDatapoints:     []string{"test_gauge"},
+			wantHistograms:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var datapoints []string
+			var histograms []pmetric.Metrics
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "test", r.Header.Get(splunk.SFxAccessTokenHeader))
+				body := r.Body
+				if r.Header.Get("Content-Encoding") == "gzip" {
+					var err error
+					body, err = gzip.NewReader(r.Body)
+					require.NoError(t, err)
+				}
+				b, err := io.ReadAll(body)
+				require.NoError(t, err)
+
+				switch r.URL.Path {
+				case "/v2/datapoint":
+					msg := &sfxpb.DataPointUploadMessage{}
+					require.NoError(t, msg.Unmarshal(b))
+					for _, dp := range msg.Datapoints {
+						datapoints = append(datapoints, dp.Metric)
+					}
+				case "/v2/datapoint/otlp":
+					req := pmetricotlp.NewExportRequest()
+					require.NoError(t, req.UnmarshalProto(b))
+					histograms = append(histograms, req.Metrics())
+				default:
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			serverURL, err := url.Parse(server.URL + tt.ingestPath)
+			require.NoError(t, err)
+
+			cfg := &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{Timeout: 1 * time.Second},
+				AccessToken:        "test",
+			}
+			client, err := cfg.ToClient(componenttest.NewNopHost(), exportertest.NewNopCreateSettings().TelemetrySettings)
+			require.NoError(t, err)
+
+			c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "")
+			require.NoError(t, err)
+			dpClient := &sfxDPClient{
+				sfxClientBase: sfxClientBase{
+					ingestURL: serverURL,
+					headers:   buildHeaders(cfg),
+					client:    client,
+					zippers:   newGzipPool(),
+				},
+				logger:             zap.NewNop(),
+				converter:          c,
+				sendOTLPHistograms: tt.sendOTLPHistograms,
+			}
+
+			numDroppedTimeSeries, err := dpClient.pushMetricsData(context.Background(), md)
+			require.NoError(t, err)
+			assert.Equal(t, 0, numDroppedTimeSeries)
+
+			sort.Strings(datapoints)
+			assert.Equal(t, tt.wantDatapoints, datapoints)
+			require.Len(t, histograms, tt.wantHistograms)
+			for _, hist := range histograms {
+				require.Equal(t, 1, hist.MetricCount())
+				m := hist.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+				assert.Equal(t, "test_histogram", m.Name())
+				assert.Equal(t, []uint64{1, 1, 0}, m.Histogram().DataPoints().At(0).BucketCounts().AsRaw())
+				serviceName, _ := hist.ResourceMetrics().At(0).Resource().Attributes().Get("service.name")
+				assert.Equal(t, "checkout", serviceName.Str())
+			}
+		})
+	}
+}
+
+func TestConsumeMetricsOTLPHistogramsPartialFailure(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	g := ms.AppendEmpty()
+	g.SetName("test_gauge")
+	g.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(123)
+	h := ms.AppendEmpty()
+	h.SetName("test_histogram")
+	dp := h.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.SetCount(1)
+	dp.ExplicitBounds().FromRaw([]float64{10})
+	dp.BucketCounts().FromRaw([]uint64{1, 0})
+
+	requests := map[string]int{}
+	otlpStatus := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		if r.URL.Path == "/v2/datapoint/otlp" {
+			w.WriteHeader(otlpStatus)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{Timeout: 1 * time.Second},
+	}
+	client, err := cfg.ToClient(componenttest.NewNopHost(), exportertest.NewNopCreateSettings().TelemetrySettings)
+	require.NoError(t, err)
+	c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "")
+	require.NoError(t, err)
+	dpClient := &sfxDPClient{
+		sfxClientBase: sfxClientBase{
+			ingestURL: serverURL,
+			headers:   buildHeaders(cfg),
+			client:    client,
+			zippers:   newGzipPool(),
+		},
+		logger:             zap.NewNop(),
+		converter:          c,
+		sendOTLPHistograms: true,
+	}
+
+	// Only the histograms are returned to be retried, the datapoints were sent.
+	_, err = dpClient.pushMetricsData(context.Background(), md)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+	var metricsErr consumererror.Metrics
+	require.ErrorAs(t, err, &metricsErr)
+	retried := metricsErr.Data()
+	require.Equal(t, 1, retried.MetricCount())
+	assert.Equal(t, "test_histogram", retried.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+
+	otlpStatus = http.StatusAccepted
+	_, err = dpClient.pushMetricsData(context.Background(), retried)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"/v2/datapoint": 1, "/v2/datapoint/otlp": 2}, requests)
+
+	// The permanent errors are returned when nothing is to be retried.
+	otlpStatus = http.StatusBadRequest
+	_, err = dpClient.pushMetricsData(context.Background(), md)
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Equal(t, map[string]int{"/v2/datapoint": 2, "/v2/datapoint/otlp": 3}, requests)
+}
+
 func TestConsumeMetricsWithAccessTokenPassthrough(t *testing.T) {
 	fromHeaders := "AccessTokenFromClientHeaders"
 	fromLabels := []string{"AccessTokenFromLabel0", "AccessTokenFromLabel1"}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
+
+import (
+	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+// SplitHistograms returns a copy of the metrics without the histograms, and a copy
+// of the histograms only, so that histograms can be sent in the OTLP format.
+// Resources and scopes left without metrics are not copied.
+func SplitHistograms(md pmetric.Metrics) (others pmetric.Metrics, histograms pmetric.Metrics) {
+	others = pmetric.NewMetrics()
+	histograms = pmetric.NewMetrics()
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		var otherRM, histogramRM pmetric.ResourceMetrics
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			var otherSM, histogramSM pmetric.ScopeMetrics
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				m := sm.Metrics().At(k)
+				if m.Type() == pmetric.MetricTypeHistogram {
+					histogramRM, histogramSM = appendScope(histograms, rm, histogramRM, sm, histogramSM)
+					m.CopyTo(histogramSM.Metrics().AppendEmpty())
+				} else {
+					otherRM, otherSM = appendScope(others, rm, otherRM, sm, otherSM)
+					m.CopyTo(otherSM.Metrics().AppendEmpty())
+				}
+			}
+		}
+	}
+	return others, histograms
+}
+
+// appendScope returns the resource and scope metrics of dest that the metrics
+// of the source resource and scope are copied to, creating them on first use.
+func appendScope(
+	dest pmetric.Metrics,
+	srcRM pmetric.ResourceMetrics, destRM pmetric.ResourceMetrics,
+	srcSM pmetric.ScopeMetrics, destSM pmetric.ScopeMetrics,
+) (pmetric.ResourceMetrics, pmetric.ScopeMetrics) {
+	if destRM == (pmetric.ResourceMetrics{}) {
+		destRM = dest.ResourceMetrics().AppendEmpty()
+		srcRM.Resource().CopyTo(destRM.Resource())
+		destRM.SetSchemaUrl(srcRM.SchemaUrl())
+	}
+	if destSM == (pmetric.ScopeMetrics{}) {
+		destSM = destRM.ScopeMetrics().AppendEmpty()
+		srcSM.Scope().CopyTo(destSM.Scope())
+		destSM.SetSchemaUrl(srcSM.SchemaUrl())
+	}
+	return destRM, destSM
+}
+
+// FilterHistograms removes the data points of the histograms that are excluded
+// by the metric filters of the converter, as done for the SignalFx datapoints.
+// The histograms are not translated.
+func (c *MetricsConverter) FilterHistograms(md pmetric.Metrics) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resourceDims := resourceToDimensions(rm.Resource())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				m := metrics.At(k)
+				if m.Type() != pmetric.MetricTypeHistogram {
+					continue
+				}
+				m.Histogram().DataPoints().RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+					sfxDP := &sfxpb.DataPoint{
+						Metric:     m.Name(),
+						Dimensions: attributesToDimensions(resourceDims, dp.Attributes()),
+					}
+					if c.filterSet.Matches(sfxDP) {
+						c.logger.Debug("Histogram datapoint does not match filter, skipping", zap.String("metric", m.Name()))
+						return true
+					}
+					return false
+				})
+			}
+			metrics.RemoveIf(func(m pmetric.Metric) bool {
+				return m.Type() == pmetric.MetricTypeHistogram && m.Histogram().DataPoints().Len() == 0
+			})
+		}
+	}
+}
+
+func attributesToDimensions(extraDims []*sfxpb.Dimension, attrs pcommon.Map) []*sfxpb.Dimension {
+	dims := make([]*sfxpb.Dimension, 0, len(extraDims)+attrs.Len())
+	dims = append(dims, extraDims...)
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		dims = append(dims, &sfxpb.Dimension{Key: k, Value: v.AsString()})
+		return true
+	})
+	return dims
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
+)
+
+func histogramsTestMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("gauges")
+	g := sm.Metrics().AppendEmpty()
+	g.SetName("queue.size")
+	g.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(3)
+
+	sm = rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("mixed")
+	h := sm.Metrics().AppendEmpty()
+	h.SetName("http.server.duration")
+	dps := h.SetEmptyHistogram().DataPoints()
+	dp := dps.AppendEmpty()
+	dp.Attributes().PutStr("http.route", "/cart")
+	dp.SetCount(2)
+	dp.ExplicitBounds().FromRaw([]float64{10, 100})
+	dp.BucketCounts().FromRaw([]uint64{1, 1, 0})
+	dp = dps.AppendEmpty()
+	dp.Attributes().PutStr("http.route", "/health")
+	dp.SetCount(1)
+	s := sm.Metrics().AppendEmpty()
+	s.SetName("http.server.requests")
+	s.SetEmptySum().DataPoints().AppendEmpty().SetIntValue(3)
+	return md
+}
+
+func TestSplitHistograms(t *testing.T) {
+	md := histogramsTestMetrics()
+	others, histograms := SplitHistograms(md)
+
+	require.Equal(t, 1, others.ResourceMetrics().Len())
+	rm := others.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{"service.name": "checkout"}, rm.Resource().Attributes().AsRaw())
+	require.Equal(t, 2, rm.ScopeMetrics().Len())
+	assert.Equal(t, "gauges", rm.ScopeMetrics().At(0).Scope().Name())
+	assert.Equal(t, "queue.size", rm.ScopeMetrics().At(0).Metrics().At(0).Name())
+	assert.Equal(t, "mixed", rm.ScopeMetrics().At(1).Scope().Name())
+	require.Equal(t, 1, rm.ScopeMetrics().At(1).Metrics().Len())
+	assert.Equal(t, "http.server.requests", rm.ScopeMetrics().At(1).Metrics().At(0).Name())
+
+	require.Equal(t, 1, histograms.ResourceMetrics().Len())
+	rm = histograms.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{"service.name": "checkout"}, rm.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	assert.Equal(t, "mixed", rm.ScopeMetrics().At(0).Scope().Name())
+	require.Equal(t, 1, rm.ScopeMetrics().At(0).Metrics().Len())
+	assert.Equal(t, "http.server.duration", rm.ScopeMetrics().At(0).Metrics().At(0).Name())
+	assert.Equal(t, 2, histograms.DataPointCount())
+
+	// The metrics are left untouched.
+	assert.Equal(t, histogramsTestMetrics(), md)
+}
+
+func TestSplitHistogramsWithoutHistograms(t *testing.T) {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("queue.size")
+	m.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(3)
+
+	others, histograms := SplitHistograms(md)
+	assert.Equal(t, md, others)
+	assert.Equal(t, 0, histograms.ResourceMetrics().Len())
+}
+
+func TestFilterHistograms(t *testing.T) {
+	c, err := NewMetricsConverter(zap.NewNop(), nil, []dpfilters.MetricFilter{
+		{
+			MetricName: "http.server.duration",
+			Dimensions: map[string]interface{}{"http.route": "/health"},
+		},
+		{
+			MetricName: "rpc.server.duration",
+		},
+	}, nil, "")
+	require.NoError(t, err)
+
+	_, histograms := SplitHistograms(histogramsTestMetrics())
+	excluded := histograms.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().AppendEmpty()
+	excluded.SetName("rpc.server.duration")
+	excluded.SetEmptyHistogram().DataPoints().AppendEmpty().SetCount(1)
+
+	c.FilterHistograms(histograms)
+	metrics := histograms.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	assert.Equal(t, "http.server.duration", metrics.At(0).Name())
+	require.Equal(t, 1, metrics.At(0).Histogram().DataPoints().Len())
+	route, _ := metrics.At(0).Histogram().DataPoints().At(0).Attributes().Get("http.route")
+	assert.Equal(t, "/cart", route.Str())
+}
@@ -53,6 +53,8 @@ type client struct {
 	heartbeater       *heartbeater
 	bufferPool        bufferPool
 	exporterName      string
+	// exportRaw is set for the logs client with ExportRaw, which sends raw events
+	exportRaw bool
 }
 
 var jsonStreamPool = sync.Pool{
@@ -73,7 +75,9 @@ func newClient(set exporter.CreateSettings, cfg *Config, maxContentLength uint)
 }
 
 func newLogsClient(set exporter.CreateSettings, cfg *Config) *client {
-	return newClient(set, cfg, cfg.MaxContentLengthLogs)
+	c := newClient(set, cfg, cfg.MaxContentLengthLogs)
+	c.exportRaw = cfg.ExportRaw
+	return c
 }
 
 func newTracesClient(set exporter.CreateSettings, cfg *Config) *client {
@@ -175,10 +179,14 @@ func (c *client) pushLogDataInBatches(ctx context.Context, ld plog.Logs, headers
 
 	for !is.done {
 		buf.Reset()
-		latestIterState, batchPermanentErrors := c.fillLogsBuffer(ld, buf, is)
+		latestIterState, metadata, batchPermanentErrors := c.fillLogsBuffer(ld, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
-			if err := c.postEvents(ctx, buf, headers); err != nil {
+			var query url.Values
+			if c.exportRaw {
+				query = metadata.query()
+			}
+			if err := c.postEvents(ctx, buf, headers, query); err != nil {
 				return consumererror.NewLogs(err, subLogs(ld, is))
 			}
 		}
@@ -189,9 +197,12 @@ func (c *client) pushLogDataInBatches(ctx context.Context, ld plog.Logs, headers
 }
 
 // fillLogsBuffer fills the buffer with Splunk events until the buffer is full or all logs are processed.
-func (c *client) fillLogsBuffer(logs plog.Logs, buf buffer, is iterState) (iterState, []error) {
+// Raw events are sent with their metadata as query parameters, so a raw batch also ends when the
+// metadata of the next log record differs. The metadata of the raw batch is returned.
+func (c *client) fillLogsBuffer(logs plog.Logs, buf buffer, is iterState) (iterState, hecMetadata, []error) {
 	var b []byte
 	var permanentErrors []error
+	var batchMetadata hecMetadata
 	jsonStream := jsonStreamPool.Get().(*jsoniter.Stream)
 	defer jsonStreamPool.Put(jsonStream)
 
@@ -204,7 +215,12 @@ func (c *client) fillLogsBuffer(logs plog.Logs, buf buffer, is iterState) (iterS
 				is.record = 0 // Reset record index for next library.
 				logRecord := sl.LogRecords().At(k)
 
-				if c.config.ExportRaw {
+				var metadata hecMetadata
+				if c.exportRaw {
+					metadata = mapLogRecordToHecMetadata(rl.Resource(), logRecord, c.config)
+					if !buf.Empty() && metadata != batchMetadata {
+						return iterState{i, j, k, false}, batchMetadata, permanentErrors
+					}
 					b = []byte(logRecord.Body().AsString() + "\n")
 				} else {
 					// Parsing log record to Splunk event.
@@ -223,16 +239,17 @@ func (c *client) fillLogsBuffer(logs plog.Logs, buf buffer, is iterState) (iterS
 				// Continue adding events to buffer up to capacity.
 				_, err := buf.Write(b)
 				if err == nil {
+					batchMetadata = metadata
 					continue
 				}
 				if errors.Is(err, errOverCapacity) {
 					if !buf.Empty() {
-						return iterState{i, j, k, false}, permanentErrors
+						return iterState{i, j, k, false}, batchMetadata, permanentErrors
 					}
 					permanentErrors = append(permanentErrors, consumererror.NewPermanent(
 						fmt.Errorf("dropped log event: error: event size %d bytes larger than configured max"+
 							" content length %d bytes", len(b), c.config.MaxContentLengthLogs)))
-					return iterState{i, j, k + 1, false}, permanentErrors
+					return iterState{i, j, k + 1, false}, batchMetadata, permanentErrors
 				}
 				permanentErrors = append(permanentErrors,
 					consumererror.NewPermanent(fmt.Errorf("error writing the event: %w", err)))
@@ -240,7 +257,7 @@ func (c *client) fillLogsBuffer(logs plog.Logs, buf buffer, is iterState) (iterS
 		}
 	}
 
-	return iterState{done: true}, permanentErrors
+	return iterState{done: true}, batchMetadata, permanentErrors
 }
 
 func (c *client) fillMetricsBuffer(metrics pmetric.Metrics, buf buffer, is iterState) (iterState, []error) {
@@ -412,7 +429,7 @@ func (c *client) pushMultiMetricsDataInBatches(ctx context.Context, md pmetric.M
 		latestIterState, batchPermanentErrors := c.fillMetricsBufferMultiMetrics(merged, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
-			if err := c.postEvents(ctx, buf, headers); err != nil {
+			if err := c.postEvents(ctx, buf, headers, nil); err != nil {
 				return consumererror.NewMetrics(err, md)
 			}
 		}
@@ -437,7 +454,7 @@ func (c *client) pushMetricsDataInBatches(ctx context.Context, md pmetric.Metric
 		latestIterState, batchPermanentErrors := c.fillMetricsBuffer(md, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
-			if err := c.postEvents(ctx, buf, headers); err != nil {
+			if err := c.postEvents(ctx, buf, headers, nil); err != nil {
 				return consumererror.NewMetrics(err, subMetrics(md, is))
 			}
 		}
@@ -462,7 +479,7 @@ func (c *client) pushTracesDataInBatches(ctx context.Context, td ptrace.Traces,
 		latestIterState, batchPermanentErrors := c.fillTracesBuffer(td, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
-			if err := c.postEvents(ctx, buf, headers); err != nil {
+			if err := c.postEvents(ctx, buf, headers, nil); err != nil {
 				return consumererror.NewTraces(err, subTraces(td, is))
 			}
 		}
@@ -472,11 +489,11 @@ func (c *client) pushTracesDataInBatches(ctx context.Context, td ptrace.Traces,
 	return multierr.Combine(permanentErrors...)
 }
 
-func (c *client) postEvents(ctx context.Context, buf buffer, headers map[string]string) error {
+func (c *client) postEvents(ctx context.Context, buf buffer, headers map[string]string, query url.Values) error {
 	if err := buf.Close(); err != nil {
 		return err
 	}
-	return c.hecWorker.send(ctx, buf, headers)
+	return c.hecWorker.send(ctx, buf, headers, query)
 }
 
 // subLogs returns a subset of logs starting from the state.
@@ -623,13 +640,13 @@ func (c *client) start(_ context.Context, host component.Host) (err error) {
 	}
 
 	if c.config.HecHealthCheckEnabled {
-		healthCheckURL, _ := c.config.getURL()
+		healthCheckURL, _ := c.config.getURL(c.exportRaw)
 		healthCheckURL.Path = c.config.HealthPath
 		if err := checkHecHealth(httpClient, healthCheckURL); err != nil {
 			return fmt.Errorf("%s: health check failed: %w", c.exporterName, err)
 		}
 	}
-	url, _ := c.config.getURL()
+	url, _ := c.config.getURL(c.exportRaw)
 	c.hecWorker = &defaultHecWorker{url, httpClient, buildHTTPHeaders(c.config, c.buildInfo)}
 	c.heartbeater = newHeartbeater(c.config, c.buildInfo, getPushLogFn(c))
 	if c.config.Heartbeat.Startup {
@@ -2103,3 +2103,78 @@ func validateCompressedContains(t *testing.T, expected []string, got []byte) {
 	}
 
 }
+
+func TestPushLogsRawMetadataRouting(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.ExportRaw = true
+	cfg.DisableCompression = true
+	cfg.SourceType = "default_sourcetype"
+	c := newLogsClient(exportertest.NewNopCreateSettings(), cfg)
+
+	var queries []url.Values
+	var bodies []string
+	httpClient := &http.Client{
+		Transport: testRoundTripper(func(req *http.Request) *http.Response {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			queries = append(queries, req.URL.Query())
+			bodies = append(bodies, string(body))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString("OK")),
+				Header:     make(http.Header),
+			}
+		}),
+	}
+	u := &url.URL{Scheme: "http", Host: "splunk", Path: hecRawPath, RawQuery: "channel=abc"}
+	c.hecWorker = &defaultHecWorker{u, httpClient, buildHTTPHeaders(cfg, component.NewDefaultBuildInfo())}
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(splunk.DefaultIndexLabel, "main")
+	rl.Resource().Attributes().PutStr(conventions.AttributeHostName, "myhost")
+	logRecords := rl.ScopeLogs().AppendEmpty().LogRecords()
+	logRecords.AppendEmpty().Body().SetStr("log-1")
+	logRecords.AppendEmpty().Body().SetStr("log-2")
+	lr := logRecords.AppendEmpty()
+	lr.Body().SetStr("log-3")
+	lr.Attributes().PutStr(splunk.DefaultIndexLabel, "security")
+	lr.Attributes().PutStr(splunk.DefaultSourceTypeLabel, "firewall")
+	logRecords.AppendEmpty().Body().SetStr("log-4")
+
+	require.NoError(t, c.pushLogData(context.Background(), logs))
+
+	// The logs are batched by their metadata, sent as query parameters
+	assert.Equal(t, []string{"log-1\nlog-2\n", "log-3\n", "log-4\n"}, bodies)
+	assert.Equal(t, []url.Values{
+		{"channel": {"abc"}, "host": {"myhost"}, "index": {"main"}, "sourcetype": {"default_sourcetype"}},
+		{"channel": {"abc"}, "host": {"myhost"}, "index": {"security"}, "sourcetype": {"firewall"}},
+		{"channel": {"abc"}, "host": {"myhost"}, "index": {"main"}, "sourcetype": {"default_sourcetype"}},
+	}, queries)
+}
+
+func TestExportRawTargetsRawEndpointForLogsOnly(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = "https://splunk:8088"
+	cfg.Token = "token"
+	cfg.ExportRaw = true
+	set := exportertest.NewNopCreateSettings()
+
+	for _, tt := range []struct {
+		name string
+		c    *client
+		want string
+	}{
+		{name: "logs", c: newLogsClient(set, cfg), want: "https://splunk:8088/services/collector/raw"},
+		{name: "metrics", c: newMetricsClient(set, cfg), want: "https://splunk:8088/services/collector"},
+		{name: "traces", c: newTracesClient(set, cfg), want: "https://splunk:8088/services/collector"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.c.start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, tt.c.stop(context.Background()))
+			}()
+			assert.Equal(t, tt.want, tt.c.hecWorker.(*defaultHecWorker).url.String())
+		})
+	}
+}
@@ -18,8 +18,9 @@ import (
 )
 
 const (
-	// hecPath is the default HEC path on the Splunk instance.
+	// hecPath is the default HEC path on the Splunk instance, hecRawPath the one when exporting raw logs.
 	hecPath                          = "services/collector"
+	hecRawPath                       = "services/collector/raw"
 	defaultContentLengthLogsLimit    = 2 * 1024 * 1024
 	defaultContentLengthMetricsLimit = 2 * 1024 * 1024
 	defaultContentLengthTracesLimit  = 2 * 1024 * 1024
@@ -125,7 +126,9 @@ type Config struct {
 	// HecHealthCheckEnabled can be used to verify Splunk HEC health on exporter's startup
 	HecHealthCheckEnabled bool `mapstructure:"health_check_enabled"`
 
-	// ExportRaw to send only the log's body, targeting a Splunk HEC raw endpoint.
+	// ExportRaw to send only the log's body, targeting a Splunk HEC raw endpoint. The source, sourcetype,
+	// index and host of the logs are sent as query parameters, and the logs are batched by their values.
+	// Metrics and traces are still sent as events to the HEC event endpoint.
 	ExportRaw bool `mapstructure:"export_raw"`
 
 	// UseMultiMetricFormat combines metric events to save space during ingestion.
@@ -138,14 +141,20 @@ type Config struct {
 	Telemetry HecTelemetry `mapstructure:"telemetry"`
 }
 
-func (cfg *Config) getURL() (out *url.URL, err error) {
+// getURL returns the URL of the endpoint. Without a path, it targets the HEC raw endpoint if raw is set,
+// which is the case of the logs exporter with ExportRaw, or else the HEC event endpoint.
+func (cfg *Config) getURL(raw bool) (out *url.URL, err error) {
 
 	out, err = url.Parse(cfg.HTTPClientSettings.Endpoint)
 	if err != nil {
 		return out, err
 	}
 	if out.Path == "" || out.Path == "/" {
-		out.Path = path.Join(out.Path, hecPath)
+		if raw {
+			out.Path = path.Join(out.Path, hecRawPath)
+		} else {
+			out.Path = path.Join(out.Path, hecPath)
+		}
 	}
 
 	return
@@ -159,7 +168,7 @@ func (cfg *Config) Validate() error {
 	if cfg.HTTPClientSettings.Endpoint == "" {
 		return errors.New(`requires a non-empty "endpoint"`)
 	}
-	_, err := cfg.getURL()
+	_, err := cfg.getURL(cfg.ExportRaw)
 	if err != nil {
 		return fmt.Errorf(`invalid "endpoint": %w`, err)
 	}
@@ -218,3 +218,26 @@ func TestConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_getURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoint  string
+		exportRaw bool
+		want      string
+	}{
+		{name: "default path", endpoint: "https://splunk:8088", want: "https://splunk:8088/services/collector"},
+		{name: "default raw path", endpoint: "https://splunk:8088/", exportRaw: true, want: "https://splunk:8088/services/collector/raw"},
+		{name: "configured path", endpoint: "https://splunk:8088/services/collector/raw", want: "https://splunk:8088/services/collector/raw"},
+		{name: "configured raw path", endpoint: "https://splunk:8088/custom", exportRaw: true, want: "https://splunk:8088/custom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.HTTPClientSettings.Endpoint = tt.endpoint
+			got, err := cfg.getURL(tt.exportRaw)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -16,7 +16,7 @@ import (
 )
 
 type hecWorker interface {
-	send(context.Context, buffer, map[string]string) error
+	send(context.Context, buffer, map[string]string, url.Values) error
 }
 
 type defaultHecWorker struct {
@@ -25,8 +25,19 @@ type defaultHecWorker struct {
 	headers map[string]string
 }
 
-func (hec *defaultHecWorker) send(ctx context.Context, buf buffer, headers map[string]string) error {
-	req, err := http.NewRequestWithContext(ctx, "POST", hec.url.String(), buf)
+func (hec *defaultHecWorker) send(ctx context.Context, buf buffer, headers map[string]string, query url.Values) error {
+	u := hec.url
+	if len(query) > 0 {
+		// The query parameters passed by the caller are added to the ones of the configured URL
+		withQuery := *hec.url
+		values := withQuery.Query()
+		for k, v := range query {
+			values[k] = v
+		}
+		withQuery.RawQuery = values.Encode()
+		u = &withQuery
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), buf)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -6,6 +6,7 @@ package splunkhecexporter
 import (
 	"context"
 	"errors"
+	"net/url"
 )
 
 var errHecSendFailed = errors.New("hec send failed")
@@ -14,7 +15,7 @@ type mockHecWorker struct {
 	failSend bool
 }
 
-func (m *mockHecWorker) send(_ context.Context, _ buffer, _ map[string]string) error {
+func (m *mockHecWorker) send(_ context.Context, _ buffer, _ map[string]string, _ url.Values) error {
 	if m.failSend {
 		return errHecSendFailed
 	}
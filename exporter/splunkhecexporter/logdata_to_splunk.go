@@ -6,6 +6,7 @@ package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"encoding/hex"
 	"fmt"
+	"net/url"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
@@ -94,6 +95,59 @@ func mapLogRecordToSplunkEvent(res pcommon.Resource, lr plog.LogRecord, config *
 	}
 }
 
+// hecMetadata is the metadata of a raw event. The raw endpoint receiving the events without
+// their envelope, the metadata is sent as query parameters of the requests.
+type hecMetadata struct {
+	host       string
+	source     string
+	sourcetype string
+	index      string
+}
+
+// mapLogRecordToHecMetadata returns the metadata of the log record, the record attributes
+// taking precedence over the resource attributes as for the events.
+func mapLogRecordToHecMetadata(res pcommon.Resource, lr plog.LogRecord, config *Config) hecMetadata {
+	metadata := hecMetadata{
+		source:     config.Source,
+		sourcetype: config.SourceType,
+		index:      config.Index,
+	}
+	for _, attrs := range []pcommon.Map{res.Attributes(), lr.Attributes()} {
+		if v, ok := attrs.Get(config.HecToOtelAttrs.Host); ok {
+			metadata.host = v.Str()
+		}
+		if v, ok := attrs.Get(config.HecToOtelAttrs.Source); ok {
+			metadata.source = v.Str()
+		}
+		if v, ok := attrs.Get(config.HecToOtelAttrs.SourceType); ok {
+			metadata.sourcetype = v.Str()
+		}
+		if v, ok := attrs.Get(config.HecToOtelAttrs.Index); ok {
+			metadata.index = v.Str()
+		}
+	}
+	return metadata
+}
+
+// query returns the query parameters of the raw endpoint requests, the defaults of the HEC
+// token applying to the missing metadata.
+func (m hecMetadata) query() url.Values {
+	query := url.Values{}
+	if m.host != "" {
+		query.Set("host", m.host)
+	}
+	if m.source != "" {
+		query.Set("source", m.source)
+	}
+	if m.sourcetype != "" {
+		query.Set("sourcetype", m.sourcetype)
+	}
+	if m.index != "" {
+		query.Set("index", m.index)
+	}
+	return query
+}
+
 // nanoTimestampToEpochMilliseconds transforms nanoseconds into <sec>.<ms>. For example, 1433188255.500 indicates 1433188255 seconds and 500 milliseconds after epoch.
 func nanoTimestampToEpochMilliseconds(ts pcommon.Timestamp) float64 {
 	return time.Duration(ts).Round(time.Millisecond).Seconds()
@@ -57,6 +57,14 @@ type Config struct {
 	SourceHost string `mapstructure:"source_host"`
 	// Name of the client
 	Client string `mapstructure:"client"`
+
+	// TranslateAttributes specifies whether the resource attributes should be
+	// translated from the OpenTelemetry to the Sumo Logic conventions
+	TranslateAttributes bool `mapstructure:"translate_attributes"`
+	// AttributeTranslationFiles are JSON files of attribute names to field names
+	// merged, in order, over the built-in translations.
+	// Translating an attribute to an empty name removes its translation.
+	AttributeTranslationFiles []string `mapstructure:"attribute_translation_files"`
 }
 
 // CreateDefaultHTTPClientSettings returns default http client settings
@@ -121,6 +129,8 @@ const (
 	DefaultClient string = "otelcol"
 	// DefaultGraphiteTemplate defines default template for Graphite
 	DefaultGraphiteTemplate string = "%{_metric_}"
+	// DefaultTranslateAttributes defines default TranslateAttributes
+	DefaultTranslateAttributes bool = false
 )
 
 func (cfg *Config) Validate() error {
@@ -151,6 +161,10 @@ func (cfg *Config) Validate() error {
 		return errors.New("endpoint is not set")
 	}
 
+	if len(cfg.AttributeTranslationFiles) > 0 && !cfg.TranslateAttributes {
+		return errors.New("attribute_translation_files requires translate_attributes to be enabled")
+	}
+
 	if err := cfg.QueueSettings.Validate(); err != nil {
 		return fmt.Errorf("queue settings has invalid configuration: %w", err)
 	}
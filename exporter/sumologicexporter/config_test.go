@@ -86,6 +86,20 @@ func TestConfigValidation(t *testing.T) {
 			},
 			expectedErr: "queue settings has invalid configuration: queue size must be positive",
 		},
+		{
+			name: "attribute translation files without translation",
+			cfg: &Config{
+				LogFormat:        "json",
+				MetricFormat:     "carbon2",
+				CompressEncoding: "gzip",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Timeout:  defaultTimeout,
+					Endpoint: "test_endpoint",
+				},
+				AttributeTranslationFiles: []string{"translations.json"},
+			},
+			expectedErr: "attribute_translation_files requires translate_attributes to be enabled",
+		},
 		{
 			name: "valid config",
 			cfg: &Config{
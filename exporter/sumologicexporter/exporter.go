@@ -23,6 +23,7 @@ type sumologicexporter struct {
 	config              *Config
 	client              *http.Client
 	filter              filter
+	translator          *attributeTranslator
 	prometheusFormatter prometheusFormatter
 	graphiteFormatter   graphiteFormatter
 	settings            component.TelemetrySettings
@@ -61,6 +62,13 @@ func initExporter(cfg *Config, settings component.TelemetrySettings) (*sumologic
 		return nil, err
 	}
 
+	var translator *attributeTranslator
+	if cfg.TranslateAttributes {
+		if translator, err = newAttributeTranslator(cfg.AttributeTranslationFiles); err != nil {
+			return nil, err
+		}
+	}
+
 	pf := newPrometheusFormatter()
 
 	gf := newGraphiteFormatter(cfg.GraphiteTemplate)
@@ -69,6 +77,7 @@ func initExporter(cfg *Config, settings component.TelemetrySettings) (*sumologic
 		config:              cfg,
 		sources:             sfs,
 		filter:              f,
+		translator:          translator,
 		prometheusFormatter: pf,
 		graphiteFormatter:   gf,
 		settings:            settings,
@@ -165,6 +174,7 @@ func (se *sumologicexporter) pushLogsData(ctx context.Context, ld plog.Logs) err
 	rls := ld.ResourceLogs()
 	for i := 0; i < rls.Len(); i++ {
 		rl := rls.At(i)
+		attributes := se.translateAttributes(rl.Resource().Attributes())
 
 		ills := rl.ScopeLogs()
 		// iterate over ScopeLogs
@@ -176,7 +186,7 @@ func (se *sumologicexporter) pushLogsData(ctx context.Context, ld plog.Logs) err
 			for k := 0; k < logs.Len(); k++ {
 				log := logs.At(k)
 
-				currentMetadata = sdr.filter.mergeAndFilterIn(rl.Resource().Attributes(), log.Attributes())
+				currentMetadata = sdr.filter.mergeAndFilterIn(attributes, log.Attributes())
 
 				// If metadata differs from currently buffered, flush the buffer
 				if currentMetadata.string() != previousMetadata.string() && previousMetadata.string() != "" {
@@ -259,7 +269,7 @@ func (se *sumologicexporter) pushMetricsData(ctx context.Context, md pmetric.Met
 	for i := 0; i < rms.Len(); i++ {
 		rm := rms.At(i)
 
-		attributes = rm.Resource().Attributes()
+		attributes = se.translateAttributes(rm.Resource().Attributes())
 
 		// iterate over ScopeMetrics
 		ilms := rm.ScopeMetrics()
@@ -326,3 +336,12 @@ func (se *sumologicexporter) pushMetricsData(ctx context.Context, md pmetric.Met
 
 	return nil
 }
+
+// translateAttributes returns the resource attributes translated to the Sumo Logic
+// conventions, or the attributes themselves when they are not translated
+func (se *sumologicexporter) translateAttributes(attributes pcommon.Map) pcommon.Map {
+	if se.translator == nil {
+		return attributes
+	}
+	return se.translator.translate(attributes)
+}
@@ -82,6 +82,34 @@ func TestResourceMerge(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestTranslateResourceAttributes(t *testing.T) {
+	test := prepareSenderTest(t, []func(w http.ResponseWriter, req *http.Request){
+		func(w http.ResponseWriter, req *http.Request) {
+			body := extractBody(t, req)
+			assert.Equal(t, `Example log`, body)
+			assert.Equal(t, "host.name=translated_host, host=original_host, namespace=default, pod=my-pod", req.Header.Get("X-Sumo-Fields"))
+		},
+	})
+	defer func() { test.srv.Close() }()
+
+	f, err := newFilter([]string{`.*`})
+	require.NoError(t, err)
+	test.exp.filter = f
+	test.exp.translator, err = newAttributeTranslator(nil)
+	require.NoError(t, err)
+
+	logs := LogRecordsToLogs(exampleLog())
+	attrs := logs.ResourceLogs().At(0).Resource().Attributes()
+	attrs.PutStr("k8s.pod.name", "my-pod")
+	attrs.PutStr("k8s.namespace.name", "default")
+	attrs.PutStr("host", "original_host")
+	attrs.PutStr("host.name", "translated_host")
+
+	err = test.exp.pushLogsData(context.Background(), logs)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, attrs.Len(), "Must not modify the resource attributes")
+}
+
 func TestAllFailed(t *testing.T) {
 	test := prepareSenderTest(t, []func(w http.ResponseWriter, req *http.Request){
 		func(w http.ResponseWriter, req *http.Request) {
@@ -32,15 +32,16 @@ func createDefaultConfig() component.Config {
 
 	return &Config{
 
-		CompressEncoding:   DefaultCompressEncoding,
-		MaxRequestBodySize: DefaultMaxRequestBodySize,
-		LogFormat:          DefaultLogFormat,
-		MetricFormat:       DefaultMetricFormat,
-		SourceCategory:     DefaultSourceCategory,
-		SourceName:         DefaultSourceName,
-		SourceHost:         DefaultSourceHost,
-		Client:             DefaultClient,
-		GraphiteTemplate:   DefaultGraphiteTemplate,
+		CompressEncoding:    DefaultCompressEncoding,
+		MaxRequestBodySize:  DefaultMaxRequestBodySize,
+		LogFormat:           DefaultLogFormat,
+		MetricFormat:        DefaultMetricFormat,
+		SourceCategory:      DefaultSourceCategory,
+		SourceName:          DefaultSourceName,
+		SourceHost:          DefaultSourceHost,
+		Client:              DefaultClient,
+		GraphiteTemplate:    DefaultGraphiteTemplate,
+		TranslateAttributes: DefaultTranslateAttributes,
 
 		HTTPClientSettings: CreateDefaultHTTPClientSettings(),
 		RetrySettings:      exporterhelper.NewDefaultRetrySettings(),
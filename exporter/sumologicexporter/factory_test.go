@@ -27,15 +27,16 @@ func TestCreateDefaultConfig(t *testing.T) {
 	qs.Enabled = false
 
 	assert.Equal(t, cfg, &Config{
-		CompressEncoding:   "gzip",
-		MaxRequestBodySize: 1_048_576,
-		LogFormat:          "json",
-		MetricFormat:       "prometheus",
-		SourceCategory:     "",
-		SourceName:         "",
-		SourceHost:         "",
-		Client:             "otelcol",
-		GraphiteTemplate:   "%{_metric_}",
+		CompressEncoding:    "gzip",
+		MaxRequestBodySize:  1_048_576,
+		LogFormat:           "json",
+		MetricFormat:        "prometheus",
+		SourceCategory:      "",
+		SourceName:          "",
+		SourceHost:          "",
+		Client:              "otelcol",
+		GraphiteTemplate:    "%{_metric_}",
+		TranslateAttributes: false,
 
 		HTTPClientSettings: confighttp.HTTPClientSettings{
 			Timeout: 5 * time.Second,
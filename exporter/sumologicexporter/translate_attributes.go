@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sumologicexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter"
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// attributeTranslations are the built-in translations of the OpenTelemetry
+// attribute names to the Sumo Logic field names.
+var attributeTranslations = map[string]string{
+	"cloud.account.id":        "AccountId",
+	"cloud.availability_zone": "AvailabilityZone",
+	"cloud.platform":          "aws_service",
+	"cloud.region":            "Region",
+	"host.id":                 "InstanceId",
+	"host.name":               "host",
+	"host.type":               "InstanceType",
+	"k8s.cluster.name":        "Cluster",
+	"k8s.container.name":      "container",
+	"k8s.daemonset.name":      "daemonset",
+	"k8s.deployment.name":     "deployment",
+	"k8s.namespace.name":      "namespace",
+	"k8s.node.name":           "node",
+	"k8s.service.name":        "service",
+	"k8s.pod.hostname":        "host",
+	"k8s.pod.name":            "pod",
+	"k8s.pod.uid":             "pod_id",
+	"k8s.replicaset.name":     "replicaset",
+	"k8s.statefulset.name":    "statefulset",
+	"service.name":            "service",
+	"log.file.path_resolved":  "_sourceName",
+}
+
+// attributeTranslator translates the attribute names to the Sumo Logic field names
+type attributeTranslator struct {
+	translations map[string]string
+}
+
+// newAttributeTranslator returns a translator of the built-in translations merged
+// with the translations of the files, in order. A translation to an empty name in
+// a file removes the translation of the attribute.
+func newAttributeTranslator(files []string) (*attributeTranslator, error) {
+	translations := make(map[string]string, len(attributeTranslations))
+	for otKey, sumoKey := range attributeTranslations {
+		translations[otKey] = sumoKey
+	}
+
+	for _, file := range files {
+		fileTranslations, err := loadAttributeTranslations(file)
+		if err != nil {
+			return nil, err
+		}
+		for otKey, sumoKey := range fileTranslations {
+			if sumoKey == "" {
+				delete(translations, otKey)
+				continue
+			}
+			translations[otKey] = sumoKey
+		}
+	}
+
+	return &attributeTranslator{translations: translations}, nil
+}
+
+// loadAttributeTranslations reads a JSON object of attribute names to field names
+func loadAttributeTranslations(file string) (map[string]string, error) {
+	content, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attribute translations: %w", err)
+	}
+
+	var translations map[string]string
+	if err := json.Unmarshal(content, &translations); err != nil {
+		return nil, fmt.Errorf("failed to parse attribute translations %s: %w", file, err)
+	}
+	return translations, nil
+}
+
+// translate returns a copy of the attributes with translated names.
+// An attribute is not translated when the translated name already exists,
+// to prevent overwriting it.
+func (at *attributeTranslator) translate(attributes pcommon.Map) pcommon.Map {
+	ret := pcommon.NewMap()
+	ret.EnsureCapacity(attributes.Len())
+
+	attributes.Range(func(otKey string, value pcommon.Value) bool {
+		key := otKey
+		if sumoKey, ok := at.translations[otKey]; ok {
+			if _, exists := attributes.Get(sumoKey); !exists {
+				key = sumoKey
+			}
+		}
+		// the first attribute translated to a name is kept
+		if _, ok := ret.Get(key); !ok {
+			value.CopyTo(ret.PutEmpty(key))
+		}
+		return true
+	})
+	return ret
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sumologicexporter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func writeTranslationsFile(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "translations.json")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0600))
+	return file
+}
+
+func TestTranslateAttributes(t *testing.T) {
+	translator, err := newAttributeTranslator(nil)
+	require.NoError(t, err)
+
+	attributes := pcommon.NewMap()
+	attributes.PutStr("host.name", "testing-host")
+	attributes.PutStr("host.id", "my-host-id")
+	attributes.PutStr("host.type", "my-host-type")
+	attributes.PutStr("k8s.cluster.name", "testing-cluster")
+	attributes.PutStr("k8s.deployment.name", "my-deployment-name")
+	attributes.PutStr("k8s.namespace.name", "my-namespace-name")
+	attributes.PutStr("k8s.service.name", "my-service-name")
+	attributes.PutStr("cloud.account.id", "my-account-id")
+	attributes.PutStr("cloud.availability_zone", "my-zone")
+	attributes.PutStr("cloud.region", "my-region")
+	attributes.PutStr("abc", "abc")
+
+	translated := translator.translate(attributes)
+	assert.Equal(t, map[string]any{
+		"host":             "testing-host",
+		"InstanceId":       "my-host-id",
+		"InstanceType":     "my-host-type",
+		"Cluster":          "testing-cluster",
+		"deployment":       "my-deployment-name",
+		"namespace":        "my-namespace-name",
+		"service":          "my-service-name",
+		"AccountId":        "my-account-id",
+		"AvailabilityZone": "my-zone",
+		"Region":           "my-region",
+		"abc":              "abc",
+	}, translated.AsRaw())
+	assert.Equal(t, 11, attributes.Len(), "Must not modify the attributes")
+}
+
+func TestTranslateAttributesDoesNothingWhenAttributeExists(t *testing.T) {
+	translator, err := newAttributeTranslator(nil)
+	require.NoError(t, err)
+
+	attributes := pcommon.NewMap()
+	attributes.PutStr("host.name", "  testing-host  ")
+	attributes.PutStr("host", "my-host")
+
+	translated := translator.translate(attributes)
+	assert.Equal(t, map[string]any{
+		"host.name": "  testing-host  ",
+		"host":      "my-host",
+	}, translated.AsRaw())
+}
+
+func TestTranslateAttributesFirstTranslationKept(t *testing.T) {
+	translator, err := newAttributeTranslator(nil)
+	require.NoError(t, err)
+
+	attributes := pcommon.NewMap()
+	attributes.PutStr("service.name", "first-service")
+	attributes.PutStr("k8s.service.name", "second-service")
+
+	translated := translator.translate(attributes)
+	assert.Equal(t, 1, translated.Len())
+	service, ok := translated.Get("service")
+	require.True(t, ok)
+	assert.Equal(t, "first-service", service.Str())
+}
+
+func TestTranslateAttributesWithFiles(t *testing.T) {
+	overrides := writeTranslationsFile(t, `{
+		"host.name": "hostname",
+		"k8s.pod.name": "",
+		"team.id": "_team"
+	}`)
+	later := writeTranslationsFile(t, `{"team.id": "team"}`)
+
+	translator, err := newAttributeTranslator([]string{overrides, later})
+	require.NoError(t, err)
+
+	attributes := pcommon.NewMap()
+	attributes.PutStr("host.name", "testing-host")
+	attributes.PutStr("k8s.pod.name", "my-pod")
+	attributes.PutStr("k8s.namespace.name", "my-namespace")
+	attributes.PutStr("team.id", "payments")
+
+	translated := translator.translate(attributes)
+	assert.Equal(t, map[string]any{
+		"hostname":     "testing-host",
+		"k8s.pod.name": "my-pod",
+		"namespace":    "my-namespace",
+		"team":         "payments",
+	}, translated.AsRaw())
+}
+
+func TestNewAttributeTranslatorErrors(t *testing.T) {
+	_, err := newAttributeTranslator([]string{filepath.Join(t.TempDir(), "missing.json")})
+	assert.ErrorContains(t, err, "failed to read attribute translations")
+
+	_, err = newAttributeTranslator([]string{writeTranslationsFile(t, `["host.name"]`)})
+	assert.ErrorContains(t, err, "failed to parse attribute translations")
+}
+
+func TestInitExporterInvalidTranslationFile(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TranslateAttributes = true
+	cfg.AttributeTranslationFiles = []string{filepath.Join(t.TempDir(), "missing.json")}
+
+	_, err := initExporter(cfg, componenttest.NewNopTelemetrySettings())
+	assert.Error(t, err)
+}
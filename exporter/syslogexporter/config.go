@@ -5,6 +5,7 @@ package syslogexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"go.opentelemetry.io/collector/config/configtls"
@@ -17,6 +18,9 @@ var (
 	errInvalidEndpoint     = errors.New("invalid endpoint: endpoint is required but it is not configured")
 	errUnsupportedNetwork  = errors.New("unsupported network: network is required, only tcp/udp supported")
 	errUnsupportedProtocol = errors.New("unsupported protocol: Only rfc5424 and rfc3164 supported")
+	errUnsupportedFraming  = errors.New("unsupported framing: Only non_transparent and octet_counting supported")
+	errOctetCountingUDP    = errors.New("unsupported framing: octet_counting requires the tcp network")
+	errStructuredDataRFC   = errors.New("unsupported structured data: structured_data requires the rfc5424 protocol")
 )
 
 // Config defines configuration for Syslog exporter.
@@ -31,6 +35,11 @@ type Config struct {
 	// Protocol of syslog messages
 	// options: rfc5424, rfc3164
 	Protocol string `mapstructure:"protocol"`
+	// Framing of the syslog messages sent over tcp, see RFC6587
+	// options: non_transparent, octet_counting
+	Framing string `mapstructure:"framing"`
+	// StructuredData maps log record attributes to RFC5424 structured data elements
+	StructuredData []StructuredDataConfig `mapstructure:"structured_data"`
 
 	// TLSSetting struct exposes TLS client configuration.
 	TLSSetting configtls.TLSClientSetting `mapstructure:"tls"`
@@ -40,6 +49,43 @@ type Config struct {
 	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 }
 
+// StructuredDataConfig maps log record attributes to the parameters of a RFC5424 structured data element.
+type StructuredDataConfig struct {
+	// ID of the structured data element, e.g. exampleSDID@32473
+	ID string `mapstructure:"id"`
+	// Params maps the names of the element parameters to the log record attributes holding their values
+	Params map[string]string `mapstructure:"params"`
+}
+
+// validate checks that the element ID and parameter names are valid RFC5424 SD-NAMEs.
+func (cfg *StructuredDataConfig) validate() error {
+	if !isSDName(cfg.ID) {
+		return fmt.Errorf("invalid structured data id %q: must be 1 to 32 printable US-ASCII characters except '=', ' ', ']' and '\"'", cfg.ID)
+	}
+	if len(cfg.Params) == 0 {
+		return fmt.Errorf("structured data element %q has no params", cfg.ID)
+	}
+	for name := range cfg.Params {
+		if !isSDName(name) {
+			return fmt.Errorf("invalid param name %q of structured data element %q: must be 1 to 32 printable US-ASCII characters except '=', ' ', ']' and '\"'", name, cfg.ID)
+		}
+	}
+	return nil
+}
+
+func isSDName(name string) bool {
+	if len(name) == 0 || len(name) > 32 {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c < 33 || c > 126 || c == '=' || c == ']' || c == '"' {
+			return false
+		}
+	}
+	return true
+}
+
 // Validate the configuration for errors. This is required by component.Config.
 func (cfg *Config) Validate() error {
 	invalidFields := []error{}
@@ -62,6 +108,25 @@ func (cfg *Config) Validate() error {
 		invalidFields = append(invalidFields, errUnsupportedProtocol)
 	}
 
+	switch cfg.Framing {
+	case framingNonTransparentStr:
+	case framingOctetCountingStr:
+		if strings.ToLower(cfg.Network) == "udp" {
+			invalidFields = append(invalidFields, errOctetCountingUDP)
+		}
+	default:
+		invalidFields = append(invalidFields, errUnsupportedFraming)
+	}
+
+	if len(cfg.StructuredData) > 0 && cfg.Protocol != protocolRFC5424Str {
+		invalidFields = append(invalidFields, errStructuredDataRFC)
+	}
+	for i := range cfg.StructuredData {
+		if err := cfg.StructuredData[i].validate(); err != nil {
+			invalidFields = append(invalidFields, err)
+		}
+	}
+
 	if len(invalidFields) > 0 {
 		return multierr.Combine(invalidFields...)
 	}
@@ -76,4 +141,6 @@ const (
 	DefaultPort = 514
 	// Syslog Protocol
 	DefaultProtocol = "rfc5424"
+	// Syslog Framing
+	DefaultFraming = "non_transparent"
 )
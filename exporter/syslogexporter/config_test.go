@@ -23,6 +23,7 @@ func TestValidate(t *testing.T) {
 				Endpoint: "host.domain.com",
 				Protocol: "rfc542",
 				Network:  "udp",
+				Framing:  "non_transparent",
 			},
 			err: "unsupported port: port is required, must be in the range 1-65535; " +
 				"unsupported protocol: Only rfc5424 and rfc3164 supported",
@@ -34,6 +35,7 @@ func TestValidate(t *testing.T) {
 				Endpoint: "",
 				Protocol: "rfc5424",
 				Network:  "udp",
+				Framing:  "non_transparent",
 			},
 			err: "invalid endpoint: endpoint is required but it is not configured",
 		},
@@ -44,6 +46,7 @@ func TestValidate(t *testing.T) {
 				Endpoint: "host.domain.com",
 				Protocol: "rfc5424",
 				Network:  "ftp",
+				Framing:  "non_transparent",
 			},
 			err: "unsupported network: network is required, only tcp/udp supported",
 		},
@@ -54,9 +57,101 @@ func TestValidate(t *testing.T) {
 				Endpoint: "host.domain.com",
 				Network:  "udp",
 				Protocol: "rfc",
+				Framing:  "non_transparent",
 			},
 			err: "unsupported protocol: Only rfc5424 and rfc3164 supported",
 		},
+		{
+			name: "Unsupported Framing",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc5424",
+				Framing:  "newline",
+			},
+			err: "unsupported framing: Only non_transparent and octet_counting supported",
+		},
+		{
+			name: "Octet counting over udp",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "udp",
+				Protocol: "rfc5424",
+				Framing:  "octet_counting",
+			},
+			err: "unsupported framing: octet_counting requires the tcp network",
+		},
+		{
+			name: "Structured data with rfc3164",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc3164",
+				Framing:  "non_transparent",
+				StructuredData: []StructuredDataConfig{
+					{ID: "otel@32473", Params: map[string]string{"service": "service.name"}},
+				},
+			},
+			err: "unsupported structured data: structured_data requires the rfc5424 protocol",
+		},
+		{
+			name: "Invalid structured data id",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc5424",
+				Framing:  "non_transparent",
+				StructuredData: []StructuredDataConfig{
+					{ID: "otel 32473", Params: map[string]string{"service": "service.name"}},
+				},
+			},
+			err: `invalid structured data id "otel 32473": must be 1 to 32 printable US-ASCII characters except '=', ' ', ']' and '"'`,
+		},
+		{
+			name: "Invalid structured data param",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc5424",
+				Framing:  "non_transparent",
+				StructuredData: []StructuredDataConfig{
+					{ID: "otel@32473", Params: map[string]string{"service=name": "service.name"}},
+				},
+			},
+			err: `invalid param name "service=name" of structured data element "otel@32473": must be 1 to 32 printable US-ASCII characters except '=', ' ', ']' and '"'`,
+		},
+		{
+			name: "Structured data without params",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc5424",
+				Framing:  "octet_counting",
+				StructuredData: []StructuredDataConfig{
+					{ID: "otel@32473"},
+				},
+			},
+			err: `structured data element "otel@32473" has no params`,
+		},
+		{
+			name: "Valid structured data and octet counting",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc5424",
+				Framing:  "octet_counting",
+				StructuredData: []StructuredDataConfig{
+					{ID: "otel@32473", Params: map[string]string{"service": "service.name"}},
+				},
+			},
+		},
 	}
 	for _, testInstance := range tests {
 		t.Run(testInstance.name, func(t *testing.T) {
@@ -6,9 +6,11 @@ package syslogexporter
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -158,6 +160,29 @@ func TestSyslogExportSuccess(t *testing.T) {
 	assert.Equal(t, string(b), expectedForm)
 }
 
+func TestSyslogExportOctetCounting(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framing = "octet_counting"
+	test := prepareExporterTest(t, cfg, false)
+	require.NotNil(t, test.exp)
+	defer test.srv.Close()
+	go func() {
+		buffer := exampleLog(t)
+		logs := logRecordsToLogs(buffer)
+		err := test.exp.pushLogsData(context.Background(), logs)
+		require.NoError(t, err, "could not send message")
+	}()
+	err := test.srv.SetDeadline(time.Now().Add(time.Second * 1))
+	require.NoError(t, err, "cannot set deadline")
+	conn, err := test.srv.AcceptTCP()
+	require.NoError(t, err, "could not accept connection")
+	defer conn.Close()
+	b, err := io.ReadAll(conn)
+	require.NoError(t, err, "could not read all")
+	msg := strings.TrimSuffix(expectedForm, "\n")
+	assert.Equal(t, fmt.Sprintf("%d %s", len(msg), msg), string(b))
+}
+
 func TestSyslogExportFail(t *testing.T) {
 	test := prepareExporterTest(t, createTestConfig(), true)
 	defer test.srv.Close()
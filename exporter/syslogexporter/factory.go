@@ -31,6 +31,7 @@ func createDefaultConfig() component.Config {
 		Port:            DefaultPort,
 		Network:         DefaultNetwork,
 		Protocol:        DefaultProtocol,
+		Framing:         DefaultFraming,
 		RetrySettings:   exporterhelper.NewDefaultRetrySettings(),
 		QueueSettings:   qs,
 		TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
@@ -26,6 +26,7 @@ func TestCreateDefaultConfig(t *testing.T) {
 		Port:     514,
 		Network:  "tcp",
 		Protocol: "rfc5424",
+		Framing:  "non_transparent",
 		QueueSettings: exporterhelper.QueueSettings{
 			Enabled:      false,
 			NumConsumers: 10,
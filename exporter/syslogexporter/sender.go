@@ -7,6 +7,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -21,6 +22,9 @@ const versionRFC5424 = 1
 const protocolRFC5424Str = "rfc5424"
 const protocolRFC3164Str = "rfc3164"
 
+const framingNonTransparentStr = "non_transparent"
+const framingOctetCountingStr = "octet_counting"
+
 const priority = "priority"
 const facility = "facility"
 const version = "version"
@@ -34,22 +38,26 @@ const message = "message"
 const emptyValue = "-"
 
 type sender struct {
-	network   string
-	addr      string
-	protocol  string
-	tlsConfig *tls.Config
-	logger    *zap.Logger
-	mu        sync.Mutex
-	conn      net.Conn
+	network        string
+	addr           string
+	protocol       string
+	framing        string
+	structuredData []StructuredDataConfig
+	tlsConfig      *tls.Config
+	logger         *zap.Logger
+	mu             sync.Mutex
+	conn           net.Conn
 }
 
 func connect(logger *zap.Logger, cfg *Config, tlsConfig *tls.Config) (*sender, error) {
 	s := &sender{
-		logger:    logger,
-		network:   cfg.Network,
-		addr:      fmt.Sprintf("%s:%d", cfg.Endpoint, cfg.Port),
-		protocol:  cfg.Protocol,
-		tlsConfig: tlsConfig,
+		logger:         logger,
+		network:        cfg.Network,
+		addr:           fmt.Sprintf("%s:%d", cfg.Endpoint, cfg.Port),
+		protocol:       cfg.Protocol,
+		framing:        cfg.Framing,
+		structuredData: cfg.StructuredData,
+		tlsConfig:      tlsConfig,
 	}
 
 	s.mu.Lock()
@@ -107,12 +115,21 @@ func (s *sender) Write(msg map[string]any, timestamp time.Time) error {
 }
 
 func (s *sender) write(msg string) error {
+	_, err := fmt.Fprint(s.conn, s.frame(msg))
+	return err
+}
+
+// frame returns the message as sent on the connection, see RFC6587
+func (s *sender) frame(msg string) string {
+	if s.framing == framingOctetCountingStr {
+		// the message length is sent first, the message is then sent as is
+		return fmt.Sprintf("%d %s", len(msg), msg)
+	}
 	// check if logs contains new line character at the end, if not add it
 	if !strings.HasSuffix(msg, "\n") {
 		msg = fmt.Sprintf("%s%s", msg, "\n")
 	}
-	_, err := fmt.Fprint(s.conn, msg)
-	return err
+	return msg
 }
 
 func (s *sender) formatMsg(msg map[string]any, timestamp time.Time) string {
@@ -131,21 +148,76 @@ func (s *sender) addStructuredData(msg map[string]any) {
 		return
 	}
 
-	sd, ok := msg[structuredData].(map[string]map[string]string)
-	if !ok {
-		msg[structuredData] = emptyValue
-	} else {
-		sdElements := []string{}
-		for key, val := range sd {
-			sdElements = append(sdElements, key)
-			for k, v := range val {
-				sdElements = append(sdElements, fmt.Sprintf("%s=\"%s\"", k, v))
+	elements := map[string]map[string]string{}
+	switch sd := msg[structuredData].(type) {
+	case map[string]map[string]string:
+		for id, params := range sd {
+			elements[id] = params
+		}
+	case map[string]any:
+		// the structured data parsed by the syslog parser, read back from the log record attributes
+		for id, params := range sd {
+			if params, ok := params.(map[string]any); ok {
+				elements[id] = map[string]string{}
+				for k, v := range params {
+					elements[id][k] = fmt.Sprint(v)
+				}
+			}
+		}
+	}
+
+	// the configured elements are added to the elements of the log record
+	for _, cfg := range s.structuredData {
+		for name, attribute := range cfg.Params {
+			v, ok := msg[attribute]
+			if !ok {
+				continue
+			}
+			if elements[cfg.ID] == nil {
+				elements[cfg.ID] = map[string]string{}
 			}
+			elements[cfg.ID][name] = fmt.Sprint(v)
 		}
-		msg[structuredData] = sdElements
 	}
+
+	if len(elements) == 0 {
+		msg[structuredData] = emptyValue
+		return
+	}
+	msg[structuredData] = formatStructuredData(elements)
 }
 
+// formatStructuredData formats the structured data elements, sorted by ID and param name so
+// that the messages are stable.
+func formatStructuredData(elements map[string]map[string]string) string {
+	ids := make([]string, 0, len(elements))
+	for id := range elements {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var sb strings.Builder
+	for _, id := range ids {
+		params := elements[id]
+		names := make([]string, 0, len(params))
+		for name := range params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		sb.WriteString("[")
+		sb.WriteString(id)
+		for _, name := range names {
+			fmt.Fprintf(&sb, " %s=\"%s\"", name, sdParamValueEscaper.Replace(params[name]))
+		}
+		sb.WriteString("]")
+	}
+	return sb.String()
+}
+
+// sdParamValueEscaper escapes the characters which must be escaped in the PARAM-VALUEs, see RFC5424 section 6.3.3
+var sdParamValueEscaper = strings.NewReplacer(`"`, `\"`, `\`, `\\`, `]`, `\]`)
+
 func populateDefaults(msg map[string]any, msgProperties []string) {
 
 	for _, msgProperty := range msgProperties {
@@ -85,3 +85,50 @@ func TestFormatRFC5424(t *testing.T) {
 	assert.Equal(t, true, strings.Contains(formattedMsg, "UserID=\"Tester2\""))
 	assert.Equal(t, true, strings.Contains(formattedMsg, "PEN=\"27389\""))
 }
+
+func TestFormatRFC5424StructuredDataMapping(t *testing.T) {
+	s := sender{
+		protocol: protocolRFC5424Str,
+		structuredData: []StructuredDataConfig{
+			{ID: "otel@32473", Params: map[string]string{"service": "service.name", "trace": "trace_id", "missing": "missing"}},
+			{ID: "SecureAuth@27389", Params: map[string]string{"Status": "status"}},
+		},
+	}
+
+	msg := map[string]any{
+		"appname":      "myproc",
+		"hostname":     "192.0.2.1",
+		"message":      "It's time to make the do-nuts.",
+		"msg_id":       "ID47",
+		"priority":     165,
+		"proc_id":      "8710",
+		"version":      1,
+		"service.name": `check"out] \service`,
+		"trace_id":     "5b8efff798038103d269b633813fc60c",
+		"status":       int64(200),
+		"structured_data": map[string]any{
+			"SecureAuth@27389": map[string]any{
+				"UserID": "Tester2",
+			},
+		},
+	}
+
+	expected := `<165>1 2003-08-24T05:14:15-07:00 192.0.2.1 myproc 8710 ID47 ` +
+		`[SecureAuth@27389 Status="200" UserID="Tester2"]` +
+		`[otel@32473 service="check\"out\] \\service" trace="5b8efff798038103d269b633813fc60c"] ` +
+		`It's time to make the do-nuts.`
+	timeObj, err := time.Parse(time.RFC3339, "2003-08-24T05:14:15.000003-07:00")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, s.formatRFC5424(msg, timeObj))
+}
+
+func TestFrame(t *testing.T) {
+	msg := "<165>1 2003-08-24T05:14:15-07:00 192.0.2.1 myproc 8710 - - It's time to make the do-nuts."
+
+	s := sender{framing: framingNonTransparentStr}
+	assert.Equal(t, msg+"\n", s.frame(msg))
+	assert.Equal(t, msg+"\n", s.frame(msg+"\n"))
+
+	s = sender{framing: framingOctetCountingStr}
+	assert.Equal(t, "89 "+msg, s.frame(msg))
+}
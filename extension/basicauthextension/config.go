@@ -5,6 +5,8 @@ package basicauthextension // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/config/configopaque"
 )
@@ -12,6 +14,7 @@ import (
 var (
 	errNoCredentialSource     = errors.New("no credential source provided")
 	errMultipleAuthenticators = errors.New("only one of `htpasswd` or `client_auth` can be specified")
+	errNegativeReloadInterval = errors.New("`htpasswd.reload_interval` must not be negative")
 )
 
 type HtpasswdSettings struct {
@@ -19,6 +22,12 @@ type HtpasswdSettings struct {
 	File string `mapstructure:"file"`
 	// Inline contents of the htpasswd file.
 	Inline string `mapstructure:"inline"`
+	// ReloadInterval is the interval at which the htpasswd file is checked for changes, the
+	// credentials being reloaded when it changed. The file is not reloaded when it is zero.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+	// UserAttributes are the attributes of the authenticated users by username, exposed along
+	// the username and raw attributes of client.Info.Auth.
+	UserAttributes map[string]map[string]string `mapstructure:"user_attributes"`
 }
 
 type ClientAuthSettings struct {
@@ -48,5 +57,18 @@ func (cfg *Config) Validate() error {
 		return errNoCredentialSource
 	}
 
+	if serverCondition {
+		if cfg.Htpasswd.ReloadInterval < 0 {
+			return errNegativeReloadInterval
+		}
+		for username, attributes := range cfg.Htpasswd.UserAttributes {
+			for name := range attributes {
+				if name == usernameAttribute || name == rawAttribute {
+					return fmt.Errorf("the attribute %q of the user %q is reserved", name, username)
+				}
+			}
+		}
+	}
+
 	return nil
 }
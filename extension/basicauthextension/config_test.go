@@ -6,6 +6,7 @@ package basicauthextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -48,6 +49,22 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "both"),
 			expectedErr: true,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "reload"),
+			expected: &Config{
+				Htpasswd: &HtpasswdSettings{
+					File:           "/etc/otelcol/htpasswd",
+					ReloadInterval: 30 * time.Second,
+					UserAttributes: map[string]map[string]string{
+						"username1": {"tenant": "acme"},
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "reservedattribute"),
+			expectedErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -11,15 +11,25 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/tg123/go-htpasswd"
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension/auth"
+	"go.uber.org/zap"
 	creds "google.golang.org/grpc/credentials"
 )
 
+// The attributes of the auth data of every authenticated user.
+const (
+	usernameAttribute = "username"
+	rawAttribute      = "raw"
+)
+
 var (
 	errNoAuth              = errors.New("no basic auth provided")
 	errInvalidCredentials  = errors.New("invalid credentials")
@@ -30,7 +40,16 @@ var (
 type basicAuth struct {
 	htpasswd   *HtpasswdSettings
 	clientAuth *ClientAuthSettings
-	matchFunc  func(username, password string) bool
+	logger     *zap.Logger
+
+	// mu guards the credentials, replaced when the htpasswd file is reloaded
+	mu        sync.RWMutex
+	matchFunc func(username, password string) bool
+	// fileInfo is the htpasswd file the credentials were read from
+	fileInfo os.FileInfo
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
 }
 
 func newClientAuthExtension(cfg *Config) (auth.Client, error) {
@@ -47,23 +66,81 @@ func newClientAuthExtension(cfg *Config) (auth.Client, error) {
 	), nil
 }
 
-func newServerAuthExtension(cfg *Config) (auth.Server, error) {
+func newServerAuthExtension(cfg *Config, logger *zap.Logger) (auth.Server, error) {
 
 	if cfg.Htpasswd == nil || (cfg.Htpasswd.File == "" && cfg.Htpasswd.Inline == "") {
 		return nil, errNoCredentialSource
 	}
 
-	ba := basicAuth{
+	ba := &basicAuth{
 		htpasswd: cfg.Htpasswd,
+		logger:   logger,
 	}
 	return auth.NewServer(
 		auth.WithServerStart(ba.serverStart),
 		auth.WithServerAuthenticate(ba.authenticate),
+		auth.WithServerShutdown(ba.serverShutdown),
 	), nil
 }
 
 func (ba *basicAuth) serverStart(_ context.Context, _ component.Host) error {
+	if err := ba.loadHtpasswd(); err != nil {
+		return err
+	}
+
+	if ba.htpasswd.File != "" && ba.htpasswd.ReloadInterval > 0 {
+		ba.stopCh = make(chan struct{})
+		ba.wg.Add(1)
+		go ba.watchHtpasswdFile()
+	}
+	return nil
+}
+
+func (ba *basicAuth) serverShutdown(context.Context) error {
+	if ba.stopCh != nil {
+		close(ba.stopCh)
+		ba.wg.Wait()
+		ba.stopCh = nil
+	}
+	return nil
+}
+
+// watchHtpasswdFile reloads the credentials when the modification time or the size of the
+// htpasswd file change, the previous credentials being kept when the file can't be read.
+func (ba *basicAuth) watchHtpasswdFile() {
+	defer ba.wg.Done()
+	ticker := time.NewTicker(ba.htpasswd.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ba.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(ba.htpasswd.File)
+		if err != nil {
+			ba.logger.Warn("Failed to check the htpasswd file, keeping the current credentials", zap.String("file", ba.htpasswd.File), zap.Error(err))
+			continue
+		}
+		ba.mu.RLock()
+		previous := ba.fileInfo
+		ba.mu.RUnlock()
+		if previous != nil && info.ModTime().Equal(previous.ModTime()) && info.Size() == previous.Size() {
+			continue
+		}
+		if err = ba.loadHtpasswd(); err != nil {
+			ba.logger.Warn("Failed to reload the htpasswd file, keeping the current credentials", zap.String("file", ba.htpasswd.File), zap.Error(err))
+			continue
+		}
+		ba.logger.Info("Reloaded the htpasswd file", zap.String("file", ba.htpasswd.File))
+	}
+}
+
+// loadHtpasswd reads the credentials of the htpasswd file and inline content.
+func (ba *basicAuth) loadHtpasswd() error {
 	var rs []io.Reader
+	var info os.FileInfo
 
 	if ba.htpasswd.File != "" {
 		f, err := os.Open(ba.htpasswd.File)
@@ -71,6 +148,9 @@ func (ba *basicAuth) serverStart(_ context.Context, _ component.Host) error {
 			return fmt.Errorf("open htpasswd file: %w", err)
 		}
 		defer f.Close()
+		if info, err = f.Stat(); err != nil {
+			return fmt.Errorf("stat htpasswd file: %w", err)
+		}
 
 		rs = append(rs, f)
 		rs = append(rs, strings.NewReader("\n"))
@@ -86,7 +166,10 @@ func (ba *basicAuth) serverStart(_ context.Context, _ component.Host) error {
 		return fmt.Errorf("read htpasswd content: %w", err)
 	}
 
+	ba.mu.Lock()
+	defer ba.mu.Unlock()
 	ba.matchFunc = htp.Match
+	ba.fileInfo = info
 
 	return nil
 }
@@ -102,9 +185,13 @@ func (ba *basicAuth) authenticate(ctx context.Context, headers map[string][]stri
 		return ctx, err
 	}
 
-	if !ba.matchFunc(authData.username, authData.password) {
+	ba.mu.RLock()
+	matchFunc := ba.matchFunc
+	ba.mu.RUnlock()
+	if !matchFunc(authData.username, authData.password) {
 		return ctx, errInvalidCredentials
 	}
+	authData.attributes = ba.htpasswd.UserAttributes[authData.username]
 
 	cl := client.FromContext(ctx)
 	cl.Auth = authData
@@ -171,21 +258,31 @@ type authData struct {
 	username string
 	password string
 	raw      string
+	// attributes are the configured attributes of the user
+	attributes map[string]string
 }
 
 func (a *authData) GetAttribute(name string) interface{} {
 	switch name {
-	case "username":
+	case usernameAttribute:
 		return a.username
-	case "raw":
+	case rawAttribute:
 		return a.raw
 	default:
+		if v, ok := a.attributes[name]; ok {
+			return v
+		}
 		return nil
 	}
 }
 
-func (*authData) GetAttributeNames() []string {
-	return []string{"username", "raw"}
+func (a *authData) GetAttributeNames() []string {
+	names := []string{usernameAttribute, rawAttribute}
+	for name := range a.attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names[2:])
+	return names
 }
 
 // perRPCAuth is a gRPC credentials.PerRPCCredentials implementation that returns an 'authorization' header.
@@ -7,9 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"go.opentelemetry.io/collector/component"
@@ -23,10 +25,16 @@ var _ credentials.PerRPCCredentials = (*PerRPCAuth)(nil)
 // PerRPCAuth is a gRPC credentials.PerRPCCredentials implementation that returns an 'authorization' header.
 type PerRPCAuth struct {
 	metadata map[string]string
+	// bearerTokenFunc returns the current token when set, so the refreshed tokens are used
+	// by the connections created before the refresh.
+	bearerTokenFunc func() string
 }
 
 // GetRequestMetadata returns the request metadata to be used with the RPC.
 func (c *PerRPCAuth) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	if c.bearerTokenFunc != nil {
+		return map[string]string{"authorization": c.bearerTokenFunc()}, nil
+	}
 	return c.metadata, nil
 }
 
@@ -48,27 +56,33 @@ type BearerTokenAuth struct {
 
 	shutdownCH chan struct{}
 
-	filename string
-	logger   *zap.Logger
+	filename        string
+	refreshInterval time.Duration
+	refreshJitter   time.Duration
+	logger          *zap.Logger
 }
 
 var _ auth.Client = (*BearerTokenAuth)(nil)
 
 func newBearerTokenAuth(cfg *Config, logger *zap.Logger) *BearerTokenAuth {
+	tokenString := string(cfg.BearerToken)
 	if cfg.Filename != "" && cfg.BearerToken != "" {
 		logger.Warn("a filename is specified. Configured token is ignored!")
+		tokenString = ""
 	}
 	return &BearerTokenAuth{
-		scheme:      cfg.Scheme,
-		tokenString: string(cfg.BearerToken),
-		filename:    cfg.Filename,
-		logger:      logger,
+		scheme:          cfg.Scheme,
+		tokenString:     tokenString,
+		filename:        cfg.Filename,
+		refreshInterval: cfg.RefreshInterval,
+		refreshJitter:   cfg.RefreshJitter,
+		logger:          logger,
 	}
 }
 
 // Start of BearerTokenAuth does nothing and returns nil if no filename
 // is specified. Otherwise a routine is started to monitor the file containing
-// the token to be transferred.
+// the token to be transferred, and to read it again every refresh interval.
 func (b *BearerTokenAuth) Start(ctx context.Context, _ component.Host) error {
 	if b.filename == "" {
 		return nil
@@ -95,6 +109,18 @@ func (b *BearerTokenAuth) Start(ctx context.Context, _ component.Host) error {
 
 func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Watcher) {
 	defer watcher.Close()
+
+	// the periodic reads catch the rotations missed by the watcher, such as the ones
+	// happening while the file is being replaced
+	var refreshTimer *time.Timer
+	var refreshCH <-chan time.Time
+	if b.refreshInterval > 0 {
+		refreshTimer = time.NewTimer(b.nextRefresh())
+		defer refreshTimer.Stop()
+		refreshCH = refreshTimer.C
+	}
+	watching := true
+
 	for {
 		select {
 		case _, ok := <-b.shutdownCH:
@@ -102,6 +128,15 @@ func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Wa
 			return
 		case <-ctx.Done():
 			return
+		case <-refreshCH:
+			// add the watcher again when the new file was not there after a removal
+			if !watching {
+				if err := watcher.Add(b.filename); err == nil {
+					watching = true
+				}
+			}
+			b.refreshToken()
+			refreshTimer.Reset(b.nextRefresh())
 		case event, ok := <-watcher.Events:
 			if !ok {
 				continue
@@ -117,6 +152,9 @@ func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Wa
 				// add a new watcher pointing to the new symlink/file
 				if err := watcher.Add(b.filename); err != nil {
 					b.logger.Error(err.Error())
+					watching = false
+				} else {
+					watching = true
 				}
 				b.refreshToken()
 			}
@@ -128,16 +166,34 @@ func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Wa
 	}
 }
 
+// nextRefresh returns the delay until the next periodic read of the file, the refresh
+// interval and a random jitter so that the collectors sharing a token do not read it together.
+func (b *BearerTokenAuth) nextRefresh() time.Duration {
+	if b.refreshJitter <= 0 {
+		return b.refreshInterval
+	}
+	return b.refreshInterval + time.Duration(rand.Int63n(int64(b.refreshJitter))) // #nosec G404
+}
+
 func (b *BearerTokenAuth) refreshToken() {
-	b.logger.Info("refresh token", zap.String("filename", b.filename))
 	token, err := os.ReadFile(b.filename)
 	if err != nil {
 		b.logger.Error(err.Error())
 		return
 	}
+	// the file is empty while it is being rewritten, the current token is kept until
+	// the new one is written
+	if len(token) == 0 {
+		b.logger.Warn("token file is empty, keeping the current token", zap.String("filename", b.filename))
+		return
+	}
 	b.muTokenString.Lock()
+	defer b.muTokenString.Unlock()
+	if b.tokenString == string(token) {
+		return
+	}
+	b.logger.Info("refresh token", zap.String("filename", b.filename))
 	b.tokenString = string(token)
-	b.muTokenString.Unlock()
 }
 
 // Shutdown of BearerTokenAuth does nothing and returns nil
@@ -158,7 +214,7 @@ func (b *BearerTokenAuth) Shutdown(_ context.Context) error {
 // PerRPCCredentials returns PerRPCAuth an implementation of credentials.PerRPCCredentials that
 func (b *BearerTokenAuth) PerRPCCredentials() (credentials.PerRPCCredentials, error) {
 	return &PerRPCAuth{
-		metadata: map[string]string{"authorization": b.bearerToken()},
+		bearerTokenFunc: b.bearerToken,
 	}, nil
 }
 
@@ -184,7 +240,9 @@ func (b *BearerTokenAuth) Authenticate(ctx context.Context, headers map[string][
 		return ctx, errors.New("authentication didn't succeed")
 	}
 	token := auth[0]
+	b.muTokenString.RLock()
 	expect := b.tokenString
+	b.muTokenString.RUnlock()
 	if len(b.scheme) != 0 {
 		expect = fmt.Sprintf("%s %s", b.scheme, expect)
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -201,6 +202,54 @@ func TestBearerTokenFileContentUpdate(t *testing.T) {
 	assert.Equal(t, authHeaderValue, fmt.Sprintf("%s %s", scheme, string(token)))
 }
 
+func TestBearerTokenPeriodicRefresh(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(filename, []byte("first"), 0600))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Filename = filename
+	cfg.RefreshInterval = 10 * time.Millisecond
+	cfg.RefreshJitter = 10 * time.Millisecond
+
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	assert.NoError(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
+
+	// the credentials created before the refresh use the new token
+	credential, err := bauth.PerRPCCredentials()
+	assert.NoError(t, err)
+	md, err := credential.GetRequestMetadata(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"authorization": "Bearer first"}, md)
+
+	// replace the file as the kubelet does with the projected tokens
+	tmp := filepath.Join(filepath.Dir(filename), "token.tmp")
+	assert.NoError(t, os.WriteFile(tmp, []byte("second"), 0600))
+	assert.NoError(t, os.Rename(tmp, filename))
+	assert.Eventually(t, func() bool {
+		md, err = credential.GetRequestMetadata(context.Background())
+		return err == nil && md["authorization"] == "Bearer second"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// an empty file does not replace the token
+	assert.NoError(t, os.WriteFile(filename, nil, 0600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "Bearer second", bauth.bearerToken())
+
+	assert.NoError(t, bauth.Shutdown(context.Background()))
+}
+
+func TestBearerTokenNextRefresh(t *testing.T) {
+	bauth := &BearerTokenAuth{refreshInterval: time.Minute}
+	assert.Equal(t, time.Minute, bauth.nextRefresh())
+
+	bauth.refreshJitter = 10 * time.Second
+	for i := 0; i < 10; i++ {
+		next := bauth.nextRefresh()
+		assert.GreaterOrEqual(t, next, time.Minute)
+		assert.Less(t, next, time.Minute+10*time.Second)
+	}
+}
+
 func TestBearerServerAuthenticateWithScheme(t *testing.T) {
 	const token = "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." // #nosec
 	cfg := createDefaultConfig().(*Config)
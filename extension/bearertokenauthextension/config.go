@@ -5,6 +5,7 @@ package bearertokenauthextension // import "github.com/open-telemetry/openteleme
 
 import (
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -21,15 +22,32 @@ type Config struct {
 
 	// Filename points to a file that contains the bearer token to use for every RPC.
 	Filename string `mapstructure:"filename,omitempty"`
+
+	// RefreshInterval specifies the interval at which the file is read again, in addition to the
+	// reads on the file change notifications. The periodic reads are disabled when 0.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval,omitempty"`
+
+	// RefreshJitter specifies the maximum random delay added to each refresh interval.
+	RefreshJitter time.Duration `mapstructure:"refresh_jitter,omitempty"`
 }
 
 var _ component.Config = (*Config)(nil)
-var errNoTokenProvided = errors.New("no bearer token provided")
+var (
+	errNoTokenProvided        = errors.New("no bearer token provided")
+	errNegativeRefresh        = errors.New("refresh_interval and refresh_jitter must not be negative")
+	errRefreshWithoutFilename = errors.New("refresh_interval and refresh_jitter require a filename")
+)
 
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
 	if cfg.BearerToken == "" && cfg.Filename == "" {
 		return errNoTokenProvided
 	}
+	if cfg.RefreshInterval < 0 || cfg.RefreshJitter < 0 {
+		return errNegativeRefresh
+	}
+	if cfg.Filename == "" && (cfg.RefreshInterval != 0 || cfg.RefreshJitter != 0) {
+		return errRefreshWithoutFilename
+	}
 	return nil
 }
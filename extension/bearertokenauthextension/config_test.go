@@ -6,6 +6,7 @@ package bearertokenauthextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,23 @@ func TestLoadConfig(t *testing.T) {
 				BearerToken: "my-token",
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "withrefresh"),
+			expected: &Config{
+				Scheme:          defaultScheme,
+				Filename:        "file-containing.token",
+				RefreshInterval: time.Minute,
+				RefreshJitter:   10 * time.Second,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "negativerefresh"),
+			expectedErr: true,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "refreshwithoutfilename"),
+			expectedErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
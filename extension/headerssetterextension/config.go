@@ -5,13 +5,15 @@ package headerssetterextension // import "github.com/open-telemetry/opentelemetr
 
 import (
 	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
 )
 
 var (
 	errMissingHeader        = fmt.Errorf("missing header name")
 	errMissingHeadersConfig = fmt.Errorf("missing headers configuration")
-	errMissingSource        = fmt.Errorf("missing header source, must be 'from_context' or 'value'")
-	errConflictingSources   = fmt.Errorf("invalid header source, must either 'from_context' or 'value'")
+	errMissingSource        = fmt.Errorf("missing header source, must be 'from_context', 'from_auth', 'from_resource_attribute', 'template' or 'value'")
+	errConflictingSources   = fmt.Errorf("invalid header source, must either 'from_context', 'from_auth', 'from_resource_attribute', 'template' or 'value'")
 )
 
 type Config struct {
@@ -23,6 +25,16 @@ type HeaderConfig struct {
 	Key         *string     `mapstructure:"key"`
 	Value       *string     `mapstructure:"value"`
 	FromContext *string     `mapstructure:"from_context"`
+	// FromAuth is the attribute of the auth data of the request, set by the
+	// authenticator of the receiver, holding the header value.
+	FromAuth *string `mapstructure:"from_auth"`
+	// FromResourceAttribute is the resource attribute of the exported OTLP/HTTP
+	// request holding the header value.
+	FromResourceAttribute *string `mapstructure:"from_resource_attribute"`
+	// Template is the header value built from `{metadata.<key>}`, `{auth.<attribute>}` and
+	// `{resource.<attribute>}` placeholders, replaced by the request metadata, the auth data
+	// attributes and the resource attributes of the exported OTLP/HTTP request.
+	Template *string `mapstructure:"template"`
 }
 
 // ActionValue is the enum to capture the four types of actions to perform on a header
@@ -54,12 +66,23 @@ func (cfg *Config) Validate() error {
 		}
 
 		if header.Action != DELETE {
-			if header.FromContext == nil && header.Value == nil {
+			sources := 0
+			for _, s := range []*string{header.Value, header.FromContext, header.FromAuth, header.FromResourceAttribute, header.Template} {
+				if s != nil {
+					sources++
+				}
+			}
+			if sources == 0 {
 				return errMissingSource
 			}
-			if header.FromContext != nil && header.Value != nil {
+			if sources > 1 {
 				return errConflictingSources
 			}
+			if header.Template != nil {
+				if _, err := source.NewTemplateSource(*header.Template); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -53,6 +53,21 @@ func TestLoadConfig(t *testing.T) {
 						Key:    stringp("User-ID"),
 						Action: DELETE,
 					},
+					{
+						Key:      stringp("X-Tenant"),
+						Action:   UPSERT,
+						FromAuth: stringp("tenant"),
+					},
+					{
+						Key:      stringp("X-Route"),
+						Action:   UPSERT,
+						Template: stringp("{auth.tenant}-{metadata.region}"),
+					},
+					{
+						Key:                   stringp("X-Tenant-ID"),
+						Action:                UPSERT,
+						FromResourceAttribute: stringp("tenant.id"),
+					},
 				},
 			},
 		},
@@ -138,6 +153,52 @@ func TestValidateConfig(t *testing.T) {
 			},
 			errConflictingSources,
 		},
+		{
+			"header value from auth and template",
+			[]HeaderConfig{
+				{
+					Key:      stringp("name"),
+					Action:   INSERT,
+					FromAuth: stringp("tenant"),
+					Template: stringp("{auth.tenant}"),
+				},
+			},
+			errConflictingSources,
+		},
+		{
+			"header value from resource attribute and context",
+			[]HeaderConfig{
+				{
+					Key:                   stringp("name"),
+					Action:                INSERT,
+					FromContext:           stringp("tenant"),
+					FromResourceAttribute: stringp("tenant.id"),
+				},
+			},
+			errConflictingSources,
+		},
+		{
+			"header value from resource attribute",
+			[]HeaderConfig{
+				{
+					Key:                   stringp("name"),
+					Action:                INSERT,
+					FromResourceAttribute: stringp("tenant.id"),
+				},
+			},
+			nil,
+		},
+		{
+			"header value from template",
+			[]HeaderConfig{
+				{
+					Key:      stringp("name"),
+					Action:   INSERT,
+					Template: stringp("tenant-{auth.tenant}"),
+				},
+			},
+			nil,
+		},
 		{
 			"header value source is missing",
 			[]HeaderConfig{
@@ -192,3 +253,14 @@ func TestValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateInvalidTemplate(t *testing.T) {
+	cfg := Config{HeadersConfig: []HeaderConfig{
+		{
+			Key:      stringp("name"),
+			Action:   INSERT,
+			Template: stringp("{scope.name}"),
+		},
+	}}
+	assert.EqualError(t, cfg.Validate(), `unknown placeholder "scope.name" in template "{scope.name}", expected metadata.<key>, auth.<attribute> or resource.<attribute>`)
+}
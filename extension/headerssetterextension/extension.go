@@ -4,12 +4,15 @@
 package headerssetterextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension"
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"go.opentelemetry.io/collector/extension/auth"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/credentials"
 
@@ -28,6 +31,7 @@ func newHeadersSetterExtension(cfg *Config, logger *zap.Logger) (auth.Client, er
 	}
 
 	headers := make([]Header, 0, len(cfg.HeadersConfig))
+	usesResources := false
 	for _, header := range cfg.HeadersConfig {
 		var s source.Source
 		if header.Value != nil {
@@ -38,6 +42,22 @@ func newHeadersSetterExtension(cfg *Config, logger *zap.Logger) (auth.Client, er
 			s = &source.ContextSource{
 				Key: *header.FromContext,
 			}
+		} else if header.FromAuth != nil {
+			s = &source.AuthSource{
+				Key: *header.FromAuth,
+			}
+		} else if header.FromResourceAttribute != nil {
+			s = &source.ResourceAttributeSource{
+				Key: *header.FromResourceAttribute,
+			}
+			usesResources = true
+		} else if header.Template != nil {
+			ts, err := source.NewTemplateSource(*header.Template)
+			if err != nil {
+				return nil, err
+			}
+			s = ts
+			usesResources = usesResources || ts.UsesResources()
 		}
 
 		var a action.Action
@@ -62,8 +82,9 @@ func newHeadersSetterExtension(cfg *Config, logger *zap.Logger) (auth.Client, er
 		auth.WithClientRoundTripper(
 			func(base http.RoundTripper) (http.RoundTripper, error) {
 				return &headersRoundTripper{
-					base:    base,
-					headers: headers,
+					base:          base,
+					headers:       headers,
+					usesResources: usesResources,
 				}, nil
 			}),
 		auth.WithClientPerRPCCredentials(func() (credentials.PerRPCCredentials, error) {
@@ -108,6 +129,9 @@ func (h *headersPerRPC) RequireTransportSecurity() bool {
 type headersRoundTripper struct {
 	base    http.RoundTripper
 	headers []Header
+	// usesResources is set when a header value is looked up from the resource
+	// attributes, the body of the requests is then decoded.
+	usesResources bool
 }
 
 // RoundTrip copies the original request and sets headers of the new requests
@@ -117,8 +141,16 @@ func (h *headersRoundTripper) RoundTrip(req *http.Request) (*http.Response, erro
 	if req2.Header == nil {
 		req2.Header = make(http.Header)
 	}
+	ctx := req.Context()
+	if h.usesResources && req2.Body != nil {
+		resources, err := readResources(req2)
+		if err != nil {
+			return nil, err
+		}
+		ctx = source.ContextWithResources(ctx, resources)
+	}
 	for _, header := range h.headers {
-		value, err := header.source.Get(req.Context())
+		value, err := header.source.Get(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to determine the source: %w", err)
 		}
@@ -126,3 +158,18 @@ func (h *headersRoundTripper) RoundTrip(req *http.Request) (*http.Response, erro
 	}
 	return h.base.RoundTrip(req2)
 }
+
+// readResources decodes the resources of the OTLP/HTTP request, and restores its body
+// so that it is sent as is.
+func readResources(req *http.Request) ([]pcommon.Resource, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request body: %w", err)
+	}
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return source.ResourcesFromOTLPRequest(req.URL.Path, req.Header.Get("Content-Type"), body)
+}
@@ -4,12 +4,17 @@
 package headerssetterextension
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 )
 
 type mockRoundTripper struct{}
@@ -224,3 +229,124 @@ var (
 func stringp(str string) *string {
 	return &str
 }
+
+type mockAuthData map[string]any
+
+func (m mockAuthData) GetAttribute(name string) any {
+	return m[name]
+}
+
+func (m mockAuthData) GetAttributeNames() []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestAuthAndTemplateHeaders(t *testing.T) {
+	cfg := &Config{
+		HeadersConfig: []HeaderConfig{
+			{
+				Key:      stringp("X-Scope-OrgID"),
+				Action:   UPSERT,
+				FromAuth: stringp("tenant"),
+			},
+			{
+				Key:      stringp("X-Route"),
+				Action:   UPSERT,
+				Template: stringp("{auth.tenant}.{metadata.region}"),
+			},
+		},
+	}
+	expectedHeaders := map[string]string{
+		"X-Scope-OrgID": "acme",
+		"X-Route":       "acme.eu",
+	}
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"region": {"eu"}}),
+		Auth:     mockAuthData{"tenant": "acme"},
+	})
+
+	ext, err := newHeadersSetterExtension(cfg, nil)
+	require.NoError(t, err)
+
+	roundTripper, err := ext.RoundTripper(mrt)
+	require.NoError(t, err)
+	req, err := http.NewRequestWithContext(ctx, "GET", "", nil)
+	require.NoError(t, err)
+	resp, err := roundTripper.RoundTrip(req)
+	require.NoError(t, err)
+	for key, value := range expectedHeaders {
+		assert.Equal(t, value, resp.Header.Get(key))
+	}
+
+	perRPC, err := ext.PerRPCCredentials()
+	require.NoError(t, err)
+	metadata, err := perRPC.GetRequestMetadata(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expectedHeaders, metadata)
+}
+
+// bodyRoundTripper records the body of the requests
+type bodyRoundTripper struct {
+	mockRoundTripper
+	body []byte
+}
+
+func (b *bodyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	b.body = body
+	return b.mockRoundTripper.RoundTrip(req)
+}
+
+func TestResourceAttributeHeaders(t *testing.T) {
+	cfg := &Config{
+		HeadersConfig: []HeaderConfig{
+			{
+				Key:                   stringp("X-Scope-OrgID"),
+				Action:                UPSERT,
+				FromResourceAttribute: stringp("tenant.id"),
+			},
+			{
+				Key:      stringp("X-Route"),
+				Action:   UPSERT,
+				Template: stringp("{resource.tenant.id}.{metadata.region}"),
+			},
+		},
+	}
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"region": {"eu"}}),
+	})
+
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "acme")
+	body, err := plogotlp.NewExportRequestFromLogs(logs).MarshalProto()
+	require.NoError(t, err)
+
+	ext, err := newHeadersSetterExtension(cfg, nil)
+	require.NoError(t, err)
+
+	brt := &bodyRoundTripper{}
+	roundTripper, err := ext.RoundTripper(brt)
+	require.NoError(t, err)
+	req, err := http.NewRequestWithContext(ctx, "POST", "http://localhost:4318/v1/logs", bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	resp, err := roundTripper.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, "acme", resp.Header.Get("X-Scope-OrgID"))
+	assert.Equal(t, "acme.eu", resp.Header.Get("X-Route"))
+	// the body is sent as is
+	assert.Equal(t, body, brt.body)
+
+	// the gRPC requests do not expose their resources
+	perRPC, err := ext.PerRPCCredentials()
+	require.NoError(t, err)
+	metadata, err := perRPC.GetRequestMetadata(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"X-Scope-OrgID": "", "X-Route": ".eu"}, metadata)
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/client"
+)
+
+var _ Source = (*AuthSource)(nil)
+
+// AuthSource returns the value of an attribute of the auth data set by
+// the authenticator of the receiver of the request.
+type AuthSource struct {
+	Key string
+}
+
+func (as *AuthSource) Get(ctx context.Context) (string, error) {
+	cl := client.FromContext(ctx)
+	if cl.Auth == nil {
+		return "", nil
+	}
+
+	switch v := cl.Auth.GetAttribute(as.Key).(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []string:
+		if len(v) == 0 {
+			return "", nil
+		}
+		if len(v) > 1 {
+			return "", fmt.Errorf("%d values found for the auth attribute %q, can't determine which one to use", len(v), as.Key)
+		}
+		return v[0], nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/client"
+)
+
+type authData map[string]any
+
+func (a authData) GetAttribute(name string) any {
+	return a[name]
+}
+
+func (a authData) GetAttributeNames() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	return names
+}
+
+func contextWithAuth(attributes map[string]any) context.Context {
+	cl := client.FromContext(context.Background())
+	cl.Auth = authData(attributes)
+	return client.NewContext(context.Background(), cl)
+}
+
+func TestAuthSource(t *testing.T) {
+	ctx := contextWithAuth(map[string]any{
+		"tenant":   "acme",
+		"groups":   []string{"admins"},
+		"tenants":  []string{"acme", "globex"},
+		"priority": 3,
+	})
+
+	tests := []struct {
+		key      string
+		expected string
+		err      bool
+	}{
+		{key: "tenant", expected: "acme"},
+		{key: "groups", expected: "admins"},
+		{key: "priority", expected: "3"},
+		{key: "missing", expected: ""},
+		{key: "tenants", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			value, err := (&AuthSource{Key: tt.key}).Get(ctx)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestAuthSourceNoAuth(t *testing.T) {
+	value, err := (&AuthSource{Key: "tenant"}).Get(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+var _ Source = (*ResourceAttributeSource)(nil)
+
+type resourcesContextKey struct{}
+
+// ContextWithResources returns a context holding the resources of the exported request,
+// read by the ResourceAttributeSource.
+func ContextWithResources(ctx context.Context, resources []pcommon.Resource) context.Context {
+	return context.WithValue(ctx, resourcesContextKey{}, resources)
+}
+
+// ResourceAttributeSource returns the value of an attribute of the resources of the exported
+// request. The resources not having the attribute are ignored, and the resources of the request
+// must all have the same value.
+type ResourceAttributeSource struct {
+	Key string
+}
+
+func (rs *ResourceAttributeSource) Get(ctx context.Context) (string, error) {
+	resources, _ := ctx.Value(resourcesContextKey{}).([]pcommon.Resource)
+
+	var value string
+	found := false
+	for _, resource := range resources {
+		v, ok := resource.Attributes().Get(rs.Key)
+		if !ok {
+			continue
+		}
+		if found && v.AsString() != value {
+			return "", fmt.Errorf("different values found for the resource attribute %q, can't determine which one to use", rs.Key)
+		}
+		value = v.AsString()
+		found = true
+	}
+	return value, nil
+}
+
+// ResourcesFromOTLPRequest returns the resources of the body of an OTLP/HTTP request, whose signal
+// is given by the path. The requests which are not OTLP/HTTP requests have no resources.
+func ResourcesFromOTLPRequest(path string, contentType string, body []byte) ([]pcommon.Resource, error) {
+	unmarshal := func(u interface {
+		UnmarshalProto([]byte) error
+		UnmarshalJSON([]byte) error
+	}) error {
+		if strings.HasPrefix(contentType, "application/json") {
+			return u.UnmarshalJSON(body)
+		}
+		return u.UnmarshalProto(body)
+	}
+
+	var resources []pcommon.Resource
+	switch {
+	case strings.HasSuffix(path, "/v1/traces"):
+		req := ptraceotlp.NewExportRequest()
+		if err := unmarshal(&req); err != nil {
+			return nil, fmt.Errorf("failed to decode the traces of the request: %w", err)
+		}
+		rss := req.Traces().ResourceSpans()
+		for i := 0; i < rss.Len(); i++ {
+			resources = append(resources, rss.At(i).Resource())
+		}
+	case strings.HasSuffix(path, "/v1/metrics"):
+		req := pmetricotlp.NewExportRequest()
+		if err := unmarshal(&req); err != nil {
+			return nil, fmt.Errorf("failed to decode the metrics of the request: %w", err)
+		}
+		rms := req.Metrics().ResourceMetrics()
+		for i := 0; i < rms.Len(); i++ {
+			resources = append(resources, rms.At(i).Resource())
+		}
+	case strings.HasSuffix(path, "/v1/logs"):
+		req := plogotlp.NewExportRequest()
+		if err := unmarshal(&req); err != nil {
+			return nil, fmt.Errorf("failed to decode the logs of the request: %w", err)
+		}
+		rls := req.Logs().ResourceLogs()
+		for i := 0; i < rls.Len(); i++ {
+			resources = append(resources, rls.At(i).Resource())
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+func newResource(attributes map[string]any) pcommon.Resource {
+	resource := pcommon.NewResource()
+	_ = resource.Attributes().FromRaw(attributes)
+	return resource
+}
+
+func TestResourceAttributeSource(t *testing.T) {
+	ts := &ResourceAttributeSource{Key: "tenant.id"}
+
+	// no resources in the context
+	value, err := ts.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+
+	ctx := ContextWithResources(context.Background(), []pcommon.Resource{
+		newResource(map[string]any{"tenant.id": "acme", "service.name": "cart"}),
+		newResource(map[string]any{"service.name": "checkout"}),
+		newResource(map[string]any{"tenant.id": "acme"}),
+	})
+	value, err = ts.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", value)
+
+	ctx = ContextWithResources(context.Background(), []pcommon.Resource{
+		newResource(map[string]any{"tenant.id": 42}),
+	})
+	value, err = ts.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "42", value)
+
+	ctx = ContextWithResources(context.Background(), []pcommon.Resource{
+		newResource(map[string]any{"tenant.id": "acme"}),
+		newResource(map[string]any{"tenant.id": "globex"}),
+	})
+	_, err = ts.Get(ctx)
+	assert.Error(t, err)
+}
+
+func TestResourcesFromOTLPRequest(t *testing.T) {
+	traces := ptrace.NewTraces()
+	traces.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "acme")
+	tracesProto, err := ptraceotlp.NewExportRequestFromTraces(traces).MarshalProto()
+	require.NoError(t, err)
+
+	metrics := pmetric.NewMetrics()
+	metrics.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "acme")
+	metrics.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "globex")
+	metricsJSON, err := pmetricotlp.NewExportRequestFromMetrics(metrics).MarshalJSON()
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "acme")
+	logsProto, err := plogotlp.NewExportRequestFromLogs(logs).MarshalProto()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		body        []byte
+		expected    []string
+		err         bool
+	}{
+		{name: "traces", path: "/v1/traces", contentType: "application/x-protobuf", body: tracesProto, expected: []string{"acme"}},
+		{name: "metrics json", path: "/otlp/v1/metrics", contentType: "application/json", body: metricsJSON, expected: []string{"acme", "globex"}},
+		{name: "logs", path: "/v1/logs", contentType: "application/x-protobuf", body: logsProto, expected: []string{"acme"}},
+		{name: "not otlp", path: "/loki/api/v1/push", contentType: "application/x-protobuf", body: []byte("push")},
+		{name: "invalid body", path: "/v1/traces", contentType: "application/json", body: []byte("{"), err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources, err := ResourcesFromOTLPRequest(tt.path, tt.contentType, tt.body)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resources, len(tt.expected))
+			for i, tenant := range tt.expected {
+				value, _ := resources[i].Attributes().Get("tenant.id")
+				assert.Equal(t, tenant, value.Str())
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	metadataPlaceholderPrefix = "metadata."
+	authPlaceholderPrefix     = "auth."
+	resourcePlaceholderPrefix = "resource."
+)
+
+var _ Source = (*TemplateSource)(nil)
+
+// TemplateSource returns a value built from a template with `{metadata.<key>}`,
+// `{auth.<attribute>}` and `{resource.<attribute>}` placeholders, replaced by the
+// request metadata, the auth data attributes and the resource attributes of the
+// request. The missing values are replaced by an empty string.
+type TemplateSource struct {
+	// segments are either a literal part of the template, or the source of a placeholder.
+	segments []templateSegment
+}
+
+type templateSegment struct {
+	literal string
+	source  Source
+}
+
+// NewTemplateSource parses the template.
+func NewTemplateSource(template string) (*TemplateSource, error) {
+	ts := &TemplateSource{}
+	rest := template
+	for rest != "" {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			ts.segments = append(ts.segments, templateSegment{literal: rest})
+			break
+		}
+		if start > 0 {
+			ts.segments = append(ts.segments, templateSegment{literal: rest[:start]})
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed placeholder in template %q", template)
+		}
+		placeholder := rest[start+1 : start+end]
+		rest = rest[start+end+1:]
+
+		switch {
+		case strings.HasPrefix(placeholder, metadataPlaceholderPrefix) && len(placeholder) > len(metadataPlaceholderPrefix):
+			ts.segments = append(ts.segments, templateSegment{source: &ContextSource{Key: strings.TrimPrefix(placeholder, metadataPlaceholderPrefix)}})
+		case strings.HasPrefix(placeholder, authPlaceholderPrefix) && len(placeholder) > len(authPlaceholderPrefix):
+			ts.segments = append(ts.segments, templateSegment{source: &AuthSource{Key: strings.TrimPrefix(placeholder, authPlaceholderPrefix)}})
+		case strings.HasPrefix(placeholder, resourcePlaceholderPrefix) && len(placeholder) > len(resourcePlaceholderPrefix):
+			ts.segments = append(ts.segments, templateSegment{source: &ResourceAttributeSource{Key: strings.TrimPrefix(placeholder, resourcePlaceholderPrefix)}})
+		default:
+			return nil, fmt.Errorf("unknown placeholder %q in template %q, expected metadata.<key>, auth.<attribute> or resource.<attribute>", placeholder, template)
+		}
+	}
+	return ts, nil
+}
+
+// UsesResources returns whether the template has resource attribute placeholders.
+func (ts *TemplateSource) UsesResources() bool {
+	for _, segment := range ts.segments {
+		if _, ok := segment.source.(*ResourceAttributeSource); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (ts *TemplateSource) Get(ctx context.Context) (string, error) {
+	var sb strings.Builder
+	for _, segment := range ts.segments {
+		if segment.source == nil {
+			sb.WriteString(segment.literal)
+			continue
+		}
+		value, err := segment.source.Get(ctx)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(value)
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestTemplateSource(t *testing.T) {
+	cl := client.FromContext(context.Background())
+	cl.Metadata = client.NewMetadata(map[string][]string{"X-Region": {"eu"}, "X-Many": {"a", "b"}})
+	cl.Auth = authData{"tenant": "acme"}
+	ctx := client.NewContext(context.Background(), cl)
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("tenant.id", "globex")
+	ctx = ContextWithResources(ctx, []pcommon.Resource{resource})
+
+	tests := []struct {
+		template string
+		expected string
+		err      bool
+	}{
+		{template: "static", expected: "static"},
+		{template: "{auth.tenant}", expected: "acme"},
+		{template: "tenant-{auth.tenant}-{metadata.X-Region}", expected: "tenant-acme-eu"},
+		{template: "tenant-{auth.missing}", expected: "tenant-"},
+		{template: "{resource.tenant.id}/{metadata.X-Region}", expected: "globex/eu"},
+		{template: "{metadata.X-Many}", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			ts, err := NewTemplateSource(tt.template)
+			require.NoError(t, err)
+			value, err := ts.Get(ctx)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestTemplateSourceUsesResources(t *testing.T) {
+	ts, err := NewTemplateSource("{auth.tenant}-{metadata.region}")
+	require.NoError(t, err)
+	assert.False(t, ts.UsesResources())
+
+	ts, err = NewTemplateSource("{auth.tenant}-{resource.region}")
+	require.NoError(t, err)
+	assert.True(t, ts.UsesResources())
+}
+
+func TestNewTemplateSourceInvalid(t *testing.T) {
+	for _, template := range []string{"{auth.tenant", "{tenant}", "{metadata.}", "{}"} {
+		_, err := NewTemplateSource(template)
+		assert.Error(t, err, template)
+	}
+}
@@ -5,6 +5,7 @@ package healthcheckextension // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -44,6 +45,9 @@ var (
 	errNoEndpointProvided                      = errors.New("bad config: endpoint must be specified")
 	errInvalidExporterFailureThresholdProvided = errors.New("bad config: exporter_failure_threshold expects a positive number")
 	errInvalidPath                             = errors.New("bad config: path must start with /")
+	errInvalidExporterFailureRatioProvided     = errors.New("bad config: exporter_failure_ratio expects a number between 0 and 1")
+	errPipelinesWithoutFailureRatio            = errors.New("bad config: pipelines require an exporter_failure_ratio")
+	errInvalidPipeline                         = errors.New("bad config: pipelines must be traces, metrics or logs pipelines with exporters")
 )
 
 // Validate checks if the extension configuration is valid
@@ -61,6 +65,22 @@ func (cfg *Config) Validate() error {
 	if !strings.HasPrefix(cfg.Path, "/") {
 		return errInvalidPath
 	}
+	if cfg.CheckCollectorPipeline.ExporterFailureRatio < 0 || cfg.CheckCollectorPipeline.ExporterFailureRatio > 1 {
+		return errInvalidExporterFailureRatioProvided
+	}
+	if len(cfg.CheckCollectorPipeline.Pipelines) > 0 && cfg.CheckCollectorPipeline.ExporterFailureRatio == 0 {
+		return errPipelinesWithoutFailureRatio
+	}
+	for id, exporters := range cfg.CheckCollectorPipeline.Pipelines {
+		switch id.Type() {
+		case component.DataTypeTraces, component.DataTypeMetrics, component.DataTypeLogs:
+		default:
+			return fmt.Errorf("%w: %s", errInvalidPipeline, id)
+		}
+		if len(exporters) == 0 {
+			return fmt.Errorf("%w: %s", errInvalidPipeline, id)
+		}
+	}
 	return nil
 }
 
@@ -71,4 +91,10 @@ type checkCollectorPipelineSettings struct {
 	Interval string `mapstructure:"interval"`
 	// ExporterFailureThreshold is the threshold of exporter failure numbers during the Interval
 	ExporterFailureThreshold int `mapstructure:"exporter_failure_threshold"`
+	// ExporterFailureRatio is the threshold of the ratio of the items an exporter failed to send
+	// during the Interval, above which the exporter is unhealthy. It is not checked when it is zero.
+	ExporterFailureRatio float64 `mapstructure:"exporter_failure_ratio"`
+	// Pipelines are the exporters of the pipelines whose health can be checked individually,
+	// by pipeline ID. A pipeline is unhealthy when one of its exporters is.
+	Pipelines map[component.ID][]component.ID `mapstructure:"pipelines"`
 }
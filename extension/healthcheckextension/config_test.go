@@ -59,6 +59,37 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "invalidpath"),
 			expectedErr: errInvalidPath,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "pipelines"),
+			expected: &Config{
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: "localhost:13",
+				},
+				CheckCollectorPipeline: checkCollectorPipelineSettings{
+					Enabled:                  true,
+					Interval:                 "5m",
+					ExporterFailureThreshold: 5,
+					ExporterFailureRatio:     0.2,
+					Pipelines: map[component.ID][]component.ID{
+						component.NewID("traces"):                {component.NewID("otlp")},
+						component.NewIDWithName("logs", "audit"): {component.NewID("otlp"), component.NewIDWithName("file", "audit")},
+					},
+				},
+				Path: "/",
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalidratio"),
+			expectedErr: errInvalidExporterFailureRatioProvided,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "pipelineswithoutratio"),
+			expectedErr: errPipelinesWithoutFailureRatio,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalidpipeline"),
+			expectedErr: errInvalidPipeline,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
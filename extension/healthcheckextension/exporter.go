@@ -8,20 +8,74 @@ import (
 	"time"
 
 	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component"
 )
 
 const (
 	exporterFailureView = "exporter/send_failed_requests"
+	// exporterTagKey is the tag of the exporter views holding the ID of the exporter
+	exporterTagKey = "exporter"
 )
 
+// sentItemsViews are the views of the cumulative number of items sent by the exporters, by data type
+var sentItemsViews = map[string]component.DataType{
+	"exporter/sent_spans":         component.DataTypeTraces,
+	"exporter/sent_metric_points": component.DataTypeMetrics,
+	"exporter/sent_log_records":   component.DataTypeLogs,
+}
+
+// failedItemsViews are the views of the cumulative number of items the exporters failed to send, by data type
+var failedItemsViews = map[string]component.DataType{
+	"exporter/send_failed_spans":         component.DataTypeTraces,
+	"exporter/send_failed_metric_points": component.DataTypeMetrics,
+	"exporter/send_failed_log_records":   component.DataTypeLogs,
+}
+
+// exporterSignal identifies the items of a data type sent by an exporter
+type exporterSignal struct {
+	exporter string
+	dataType component.DataType
+}
+
+// itemsSample is the cumulative number of items sent and failed to be sent at a time
+type itemsSample struct {
+	time   time.Time
+	sent   float64
+	failed float64
+}
+
+// exporterItems are the samples of the items sent by an exporter during the interval
+type exporterItems struct {
+	// baseline is the last sample expired, the items of the interval being counted from it
+	baseline itemsSample
+	samples  []itemsSample
+}
+
+// failureRatio returns the ratio of the items failed to be sent during the interval, over
+// the items sent or failed to be sent, and false when no item was sent.
+func (i *exporterItems) failureRatio() (float64, bool) {
+	if len(i.samples) == 0 {
+		return 0, false
+	}
+	last := i.samples[len(i.samples)-1]
+	sent, failed := last.sent-i.baseline.sent, last.failed-i.baseline.failed
+	if sent+failed <= 0 {
+		return 0, false
+	}
+	return failed / (sent + failed), true
+}
+
 // healthCheckExporter is a struct implement the exporter interface in open census that could export metrics
 type healthCheckExporter struct {
 	mu                   sync.Mutex
 	exporterFailureQueue []*view.Data
+	items                map[exporterSignal]*exporterItems
 }
 
 func newHealthCheckExporter() *healthCheckExporter {
-	return &healthCheckExporter{}
+	return &healthCheckExporter{
+		items: map[exporterSignal]*exporterItems{},
+	}
 }
 
 // ExportView function could export the failure view to the queue
@@ -31,9 +85,69 @@ func (e *healthCheckExporter) ExportView(vd *view.Data) {
 
 	if vd.View.Name == exporterFailureView {
 		e.exporterFailureQueue = append(e.exporterFailureQueue, vd)
+		return
+	}
+	if dataType, ok := sentItemsViews[vd.View.Name]; ok {
+		e.recordItems(vd, dataType, false)
+	} else if dataType, ok := failedItemsViews[vd.View.Name]; ok {
+		e.recordItems(vd, dataType, true)
 	}
 }
 
+// recordItems samples the cumulative number of items of each exporter of the view data.
+func (e *healthCheckExporter) recordItems(vd *view.Data, dataType component.DataType, failed bool) {
+	for _, row := range vd.Rows {
+		sum, ok := row.Data.(*view.SumData)
+		if !ok {
+			continue
+		}
+		var exporter string
+		for _, t := range row.Tags {
+			if t.Key.Name() == exporterTagKey {
+				exporter = t.Value
+			}
+		}
+		if exporter == "" {
+			continue
+		}
+
+		key := exporterSignal{exporter: exporter, dataType: dataType}
+		items, ok := e.items[key]
+		if !ok {
+			items = &exporterItems{}
+			e.items[key] = items
+		}
+		sample := items.baseline
+		if len(items.samples) > 0 {
+			sample = items.samples[len(items.samples)-1]
+		}
+		sample.time = vd.End
+		if failed {
+			sample.failed = sum.Value
+		} else {
+			sample.sent = sum.Value
+		}
+		items.samples = append(items.samples, sample)
+	}
+}
+
+// checkExporterHealth returns whether the exporter failed to send at most the ratio of its items
+// of the data type during the interval, all the data types being checked when it is empty.
+func (e *healthCheckExporter) checkExporterHealth(exporter string, dataType component.DataType, ratio float64) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for key, items := range e.items {
+		if (exporter != "" && key.exporter != exporter) || (dataType != "" && key.dataType != dataType) {
+			continue
+		}
+		if r, ok := items.failureRatio(); ok && r > ratio {
+			return false
+		}
+	}
+	return true
+}
+
 func (e *healthCheckExporter) checkHealthStatus(exporterFailureThreshold int) bool {
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -55,4 +169,11 @@ func (e *healthCheckExporter) rotate(interval time.Duration) {
 		}
 		e.exporterFailureQueue = e.exporterFailureQueue[1:]
 	}
+
+	for _, items := range e.items {
+		for len(items.samples) > 0 && !items.samples[0].time.Add(interval).After(currentTime) {
+			items.baseline = items.samples[0]
+			items.samples = items.samples[1:]
+		}
+	}
 }
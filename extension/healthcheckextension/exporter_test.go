@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component"
 )
 
 func TestHealthCheckExporter_ExportView(t *testing.T) {
@@ -48,3 +49,34 @@ func TestHealthCheckExporter_rotate(t *testing.T) {
 	exporter.rotate(5 * time.Minute)
 	assert.Equal(t, 1, len(exporter.exporterFailureQueue))
 }
+
+func TestHealthCheckExporter_checkExporterHealth(t *testing.T) {
+	exporter := newHealthCheckExporter()
+	assert.True(t, exporter.checkExporterHealth("otlp", component.DataTypeTraces, 0.1))
+
+	exporter.ExportView(itemsViewData("exporter/sent_spans", "otlp", 90))
+	exporter.ExportView(itemsViewData("exporter/send_failed_spans", "otlp", 10))
+	exporter.ExportView(itemsViewData("exporter/send_failed_metric_points", "otlp", 100))
+	assert.True(t, exporter.checkExporterHealth("otlp", component.DataTypeTraces, 0.1))
+	assert.False(t, exporter.checkExporterHealth("otlp", component.DataTypeTraces, 0.05))
+	assert.False(t, exporter.checkExporterHealth("otlp", component.DataTypeMetrics, 0.5))
+	assert.False(t, exporter.checkExporterHealth("", "", 0.5))
+	assert.True(t, exporter.checkExporterHealth("otlp/2", component.DataTypeMetrics, 0.5))
+}
+
+func TestHealthCheckExporter_rotateItems(t *testing.T) {
+	exporter := newHealthCheckExporter()
+	vd := itemsViewData("exporter/send_failed_spans", "otlp", 10)
+	vd.End = time.Now().Add(-10 * time.Minute)
+	exporter.ExportView(vd)
+	exporter.ExportView(itemsViewData("exporter/sent_spans", "otlp", 10))
+	assert.False(t, exporter.checkExporterHealth("otlp", component.DataTypeTraces, 0.1))
+
+	// the failures expired, the items of the interval are counted from them
+	exporter.rotate(5 * time.Minute)
+	assert.True(t, exporter.checkExporterHealth("otlp", component.DataTypeTraces, 0.1))
+
+	exporter.ExportView(itemsViewData("exporter/send_failed_spans", "otlp", 20))
+	assert.False(t, exporter.checkExporterHealth("otlp", component.DataTypeTraces, 0.4))
+	assert.True(t, exporter.checkExporterHealth("otlp", component.DataTypeTraces, 0.5))
+}
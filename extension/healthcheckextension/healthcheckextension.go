@@ -116,8 +116,18 @@ func (hc *healthCheckExtension) baseHandler() http.Handler {
 
 // new handler function used for check collector pipeline
 func (hc *healthCheckExtension) checkCollectorPipelineHandler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-		if hc.check() && hc.state.Get() == healthcheck.Ready {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var healthy bool
+		if name := r.URL.Query().Get("pipeline"); name != "" {
+			var ok bool
+			if healthy, ok = hc.checkPipeline(name); !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		} else {
+			healthy = hc.check()
+		}
+		if healthy && hc.state.Get() == healthcheck.Ready {
 			w.WriteHeader(http.StatusOK)
 			if hc.config.ResponseBody != nil {
 				_, _ = w.Write([]byte(hc.config.ResponseBody.Healthy))
@@ -132,7 +142,32 @@ func (hc *healthCheckExtension) checkCollectorPipelineHandler() http.Handler {
 }
 
 func (hc *healthCheckExtension) check() bool {
-	return hc.exporter.checkHealthStatus(hc.config.CheckCollectorPipeline.ExporterFailureThreshold)
+	if !hc.exporter.checkHealthStatus(hc.config.CheckCollectorPipeline.ExporterFailureThreshold) {
+		return false
+	}
+	if ratio := hc.config.CheckCollectorPipeline.ExporterFailureRatio; ratio > 0 {
+		return hc.exporter.checkExporterHealth("", "", ratio)
+	}
+	return true
+}
+
+// checkPipeline returns whether the exporters of the pipeline failed to send at most the
+// exporter failure ratio of its items, and false when the pipeline is not configured.
+func (hc *healthCheckExtension) checkPipeline(name string) (healthy bool, ok bool) {
+	var id component.ID
+	if err := id.UnmarshalText([]byte(name)); err != nil {
+		return false, false
+	}
+	exporters, ok := hc.config.CheckCollectorPipeline.Pipelines[id]
+	if !ok {
+		return false, false
+	}
+	for _, exporter := range exporters {
+		if !hc.exporter.checkExporterHealth(exporter.String(), id.Type(), hc.config.CheckCollectorPipeline.ExporterFailureRatio) {
+			return false, true
+		}
+	}
+	return true, true
 }
 
 func (hc *healthCheckExtension) Shutdown(context.Context) error {
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -36,6 +37,7 @@ func ensureServerRunning(url string) func() bool {
 
 type teststep struct {
 	step               func(*healthCheckExtension) error
+	query              string
 	expectedStatusCode int
 	expectedBody       string
 }
@@ -293,6 +295,60 @@ func TestHealthCheckExtensionUsage(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WithPipelinesWithCheckCollectorPipeline",
+			config: Config{
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: testutil.GetAvailableLocalAddress(t),
+				},
+				CheckCollectorPipeline: checkCollectorPipelineSettings{
+					Enabled:                  true,
+					Interval:                 "5m",
+					ExporterFailureThreshold: 5,
+					ExporterFailureRatio:     0.5,
+					Pipelines: map[component.ID][]component.ID{
+						component.NewID("traces"): {component.NewID("otlp")},
+						component.NewID("logs"):   {component.NewIDWithName("otlp", "logs")},
+					},
+				},
+				Path: "/",
+			},
+			teststeps: []teststep{
+				{
+					step: func(hcExt *healthCheckExtension) error {
+						hcExt.exporter.ExportView(itemsViewData("exporter/sent_spans", "otlp", 10))
+						hcExt.exporter.ExportView(itemsViewData("exporter/sent_log_records", "otlp/logs", 10))
+						return hcExt.Ready()
+					},
+					query:              "?pipeline=traces",
+					expectedStatusCode: http.StatusOK,
+				},
+				{
+					step: func(hcExt *healthCheckExtension) error {
+						hcExt.exporter.ExportView(itemsViewData("exporter/send_failed_log_records", "otlp/logs", 20))
+						return nil
+					},
+					query:              "?pipeline=traces",
+					expectedStatusCode: http.StatusOK,
+				},
+				{
+					query:              "?pipeline=logs",
+					expectedStatusCode: http.StatusInternalServerError,
+				},
+				{
+					expectedStatusCode: http.StatusInternalServerError,
+				},
+				{
+					query:              "?pipeline=metrics",
+					expectedStatusCode: http.StatusNotFound,
+				},
+				{
+					step:               func(hcExt *healthCheckExtension) error { return hcExt.NotReady() },
+					query:              "?pipeline=traces",
+					expectedStatusCode: http.StatusInternalServerError,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -315,7 +371,7 @@ func TestHealthCheckExtensionUsage(t *testing.T) {
 					require.NoError(t, ts.step(hcExt))
 				}
 
-				resp, err := client.Get(url)
+				resp, err := client.Get(url + ts.query)
 				require.NoError(t, err)
 
 				if ts.expectedStatusCode != 0 {
@@ -416,6 +472,19 @@ func viewData() *view.Data {
 	return vd
 }
 
+func itemsViewData(name string, exporter string, value float64) *view.Data {
+	currentTime := time.Now()
+	return &view.Data{
+		View:  &view.View{Name: name},
+		Start: currentTime.Add(-1 * time.Minute),
+		End:   currentTime,
+		Rows: []*view.Row{{
+			Tags: []tag.Tag{{Key: tag.MustNewKey(exporterTagKey), Value: exporter}},
+			Data: &view.SumData{Value: value},
+		}},
+	}
+}
+
 // assertNoErrorHost implements a component.Host that asserts that there were no errors.
 type assertNoErrorHost struct {
 	component.Host
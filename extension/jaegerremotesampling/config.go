@@ -5,6 +5,8 @@ package jaegerremotesampling // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -16,6 +18,13 @@ var (
 	errTooManySources     = errors.New("too many sources specified, has to be either 'file' or 'remote'")
 	errNoSources          = errors.New("no sources specified, has to be either 'file' or 'remote'")
 	errAtLeastOneProtocol = errors.New("no protocols selected to serve the strategies, use 'grpc', 'http', or both")
+	errNoOverrideService  = errors.New("no service specified for the strategy override")
+)
+
+// The types of the service strategies, as in the Jaeger strategies files.
+const (
+	strategyTypeProbabilistic = "probabilistic"
+	strategyTypeRateLimiting  = "ratelimiting"
 )
 
 // Config has the configuration for the extension enabling the health check
@@ -26,6 +35,37 @@ type Config struct {
 
 	// Source configures the source for the strategies file. One of `remote` or `file` has to be specified.
 	Source Source `mapstructure:"source"`
+
+	// Overrides are the strategies of services and of their operations taking precedence over the
+	// strategies of the source.
+	Overrides []ServiceStrategyOverride `mapstructure:"overrides"`
+}
+
+// ServiceStrategyOverride overrides the strategy of a service, or of some of its operations.
+type ServiceStrategyOverride struct {
+	// Service is the name of the service.
+	Service string `mapstructure:"service"`
+
+	// Type is the type of the strategy of the service, `probabilistic` or `ratelimiting`, replacing
+	// the strategy of the source. The strategy of the source is kept when it is empty, only the
+	// strategies of the operations being overridden.
+	Type string `mapstructure:"type"`
+
+	// Param is the sampling rate of the probabilistic strategy, or the maximum number of traces per
+	// second of the rate limiting strategy.
+	Param float64 `mapstructure:"param"`
+
+	// OperationStrategies override the probabilistic strategies of operations of the service.
+	OperationStrategies []OperationStrategyOverride `mapstructure:"operation_strategies"`
+}
+
+// OperationStrategyOverride overrides the probabilistic strategy of an operation.
+type OperationStrategyOverride struct {
+	// Operation is the name of the operation.
+	Operation string `mapstructure:"operation"`
+
+	// Param is the sampling rate of the operation.
+	Param float64 `mapstructure:"param"`
 }
 
 type Source struct {
@@ -55,5 +95,48 @@ func (cfg *Config) Validate() error {
 		return errNoSources
 	}
 
+	services := map[string]bool{}
+	for _, override := range cfg.Overrides {
+		if override.Service == "" {
+			return errNoOverrideService
+		}
+		if services[override.Service] {
+			return fmt.Errorf("the strategy of the service %q is overridden more than once", override.Service)
+		}
+		services[override.Service] = true
+		if err := override.validate(); err != nil {
+			return fmt.Errorf("invalid strategy override of the service %q: %w", override.Service, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *ServiceStrategyOverride) validate() error {
+	switch o.Type {
+	case "":
+		if len(o.OperationStrategies) == 0 {
+			return errors.New("either 'type' or 'operation_strategies' has to be specified")
+		}
+	case strategyTypeProbabilistic:
+		if o.Param < 0 || o.Param > 1 {
+			return fmt.Errorf("the sampling rate %v has to be between 0 and 1", o.Param)
+		}
+	case strategyTypeRateLimiting:
+		if o.Param < 0 || o.Param > math.MaxInt16 {
+			return fmt.Errorf("the number of traces per second %v has to be between 0 and %d", o.Param, math.MaxInt16)
+		}
+	default:
+		return fmt.Errorf("unknown strategy type %q, has to be either '%s' or '%s'", o.Type, strategyTypeProbabilistic, strategyTypeRateLimiting)
+	}
+
+	for _, operation := range o.OperationStrategies {
+		if operation.Operation == "" {
+			return errors.New("no operation specified for the operation strategy override")
+		}
+		if operation.Param < 0 || operation.Param > 1 {
+			return fmt.Errorf("the sampling rate %v of the operation %q has to be between 0 and 1", operation.Param, operation.Operation)
+		}
+	}
 	return nil
 }
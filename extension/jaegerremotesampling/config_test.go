@@ -4,6 +4,7 @@
 package jaegerremotesampling
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -55,6 +56,33 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "overrides"),
+			expected: &Config{
+				HTTPServerSettings: &confighttp.HTTPServerSettings{Endpoint: ":5778"},
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{NetAddr: confignet.NetAddr{
+					Endpoint:  ":14250",
+					Transport: "tcp",
+				}},
+				Source: Source{
+					ReloadInterval: 30 * time.Second,
+					File:           "http://jaeger.example.com/sampling_strategies.json",
+				},
+				Overrides: []ServiceStrategyOverride{
+					{
+						Service: "foo",
+						Type:    "probabilistic",
+						Param:   1,
+					},
+					{
+						Service: "bar",
+						OperationStrategies: []OperationStrategyOverride{
+							{Operation: "/health", Param: 0},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -101,11 +129,71 @@ func TestValidate(t *testing.T) {
 			},
 			expected: errTooManySources,
 		},
+		{
+			desc: "override without service",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source:             Source{File: "/tmp/some-file"},
+				Overrides:          []ServiceStrategyOverride{{Type: "probabilistic", Param: 1}},
+			},
+			expected: errNoOverrideService,
+		},
+		{
+			desc: "service overridden twice",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source:             Source{File: "/tmp/some-file"},
+				Overrides: []ServiceStrategyOverride{
+					{Service: "foo", Type: "probabilistic", Param: 1},
+					{Service: "foo", Type: "ratelimiting", Param: 1},
+				},
+			},
+			expected: errors.New(`the strategy of the service "foo" is overridden more than once`),
+		},
+		{
+			desc: "invalid strategy type",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source:             Source{File: "/tmp/some-file"},
+				Overrides:          []ServiceStrategyOverride{{Service: "foo", Type: "adaptive"}},
+			},
+			expected: errors.New(`invalid strategy override of the service "foo": unknown strategy type "adaptive", has to be either 'probabilistic' or 'ratelimiting'`),
+		},
+		{
+			desc: "invalid sampling rate",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source:             Source{File: "/tmp/some-file"},
+				Overrides:          []ServiceStrategyOverride{{Service: "foo", Type: "probabilistic", Param: 2}},
+			},
+			expected: errors.New(`invalid strategy override of the service "foo": the sampling rate 2 has to be between 0 and 1`),
+		},
+		{
+			desc: "invalid operation sampling rate",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source:             Source{File: "/tmp/some-file"},
+				Overrides: []ServiceStrategyOverride{{
+					Service:             "foo",
+					OperationStrategies: []OperationStrategyOverride{{Operation: "op1", Param: -1}},
+				}},
+			},
+			expected: errors.New(`invalid strategy override of the service "foo": the sampling rate -1 of the operation "op1" has to be between 0 and 1`),
+		},
+		{
+			desc: "override without strategy",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source:             Source{File: "/tmp/some-file"},
+				Overrides:          []ServiceStrategyOverride{{Service: "foo"}},
+			},
+			expected: errors.New(`invalid strategy override of the service "foo": either 'type' or 'operation_strategies' has to be specified`),
+		},
 	}
 	for _, tC := range testCases {
 		t.Run(tC.desc, func(t *testing.T) {
 			res := tC.cfg.Validate()
-			assert.Equal(t, tC.expected, res)
+			assert.EqualError(t, res, tC.expected.Error())
 		})
 	}
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
 	"github.com/jaegertracing/jaeger/plugin/sampling/strategystore/static"
+	"github.com/jaegertracing/jaeger/thrift-gen/sampling"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
 	"go.uber.org/zap"
@@ -67,6 +68,10 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 		jrse.samplingStore = internal.NewRemoteStrategyStore(conn, jrse.cfg.Source.Remote)
 	}
 
+	if len(jrse.cfg.Overrides) > 0 {
+		jrse.samplingStore = internal.NewOverrideStrategyStore(jrse.samplingStore, strategyOverrides(jrse.cfg.Overrides))
+	}
+
 	if jrse.cfg.HTTPServerSettings != nil {
 		httpServer, err := internal.NewHTTP(jrse.telemetry, *jrse.cfg.HTTPServerSettings, jrse.samplingStore)
 		if err != nil {
@@ -94,6 +99,39 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 	return nil
 }
 
+// strategyOverrides converts the configured overrides to the strategies served, by service name.
+func strategyOverrides(overrides []ServiceStrategyOverride) map[string]internal.StrategyOverride {
+	converted := make(map[string]internal.StrategyOverride, len(overrides))
+	for _, override := range overrides {
+		var strategy *sampling.SamplingStrategyResponse
+		switch override.Type {
+		case strategyTypeProbabilistic:
+			strategy = &sampling.SamplingStrategyResponse{
+				StrategyType:          sampling.SamplingStrategyType_PROBABILISTIC,
+				ProbabilisticSampling: &sampling.ProbabilisticSamplingStrategy{SamplingRate: override.Param},
+			}
+		case strategyTypeRateLimiting:
+			strategy = &sampling.SamplingStrategyResponse{
+				StrategyType:         sampling.SamplingStrategyType_RATE_LIMITING,
+				RateLimitingSampling: &sampling.RateLimitingSamplingStrategy{MaxTracesPerSecond: int16(override.Param)},
+			}
+		}
+
+		operations := make([]*sampling.OperationSamplingStrategy, 0, len(override.OperationStrategies))
+		for _, operation := range override.OperationStrategies {
+			operations = append(operations, &sampling.OperationSamplingStrategy{
+				Operation:             operation.Operation,
+				ProbabilisticSampling: &sampling.ProbabilisticSamplingStrategy{SamplingRate: operation.Param},
+			})
+		}
+		converted[override.Service] = internal.StrategyOverride{
+			Strategy:   strategy,
+			Operations: operations,
+		}
+	}
+	return converted
+}
+
 func (jrse *jrsExtension) Shutdown(ctx context.Context) error {
 	// we probably don't want to break whenever an error occurs, we want to continue and close the other resources
 	if jrse.httpServer != nil {
@@ -147,3 +147,44 @@ func testConfig() *Config {
 	cfg.GRPCServerSettings.NetAddr.Endpoint = "127.0.0.1:14250"
 	return cfg
 }
+
+func TestStartLocalFileWithOverrides(t *testing.T) {
+	// prepare
+	cfg := testConfig()
+	cfg.Source.File = filepath.Join("testdata", "strategies.json")
+	cfg.Overrides = []ServiceStrategyOverride{
+		{
+			Service:             "foo",
+			OperationStrategies: []OperationStrategyOverride{{Operation: "op2", Param: 1}},
+		},
+		{
+			Service: "bar",
+			Type:    "ratelimiting",
+			Param:   10,
+		},
+	}
+
+	e := newExtension(cfg, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, e)
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, e.Shutdown(context.Background())) })
+
+	// test
+	foo, err := e.samplingStore.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	bar, err := e.samplingStore.GetSamplingStrategy(context.Background(), "bar")
+	require.NoError(t, err)
+	baz, err := e.samplingStore.GetSamplingStrategy(context.Background(), "baz")
+	require.NoError(t, err)
+
+	// verify
+	assert.Equal(t, 0.8, foo.ProbabilisticSampling.SamplingRate)
+	require.Len(t, foo.OperationSampling.PerOperationStrategies, 2)
+	assert.Equal(t, "op1", foo.OperationSampling.PerOperationStrategies[0].Operation)
+	assert.Equal(t, 0.2, foo.OperationSampling.PerOperationStrategies[0].ProbabilisticSampling.SamplingRate)
+	assert.Equal(t, "op2", foo.OperationSampling.PerOperationStrategies[1].Operation)
+	assert.Equal(t, 1.0, foo.OperationSampling.PerOperationStrategies[1].ProbabilisticSampling.SamplingRate)
+	assert.Equal(t, int16(10), bar.RateLimitingSampling.MaxTracesPerSecond)
+	assert.Nil(t, bar.OperationSampling)
+	assert.Equal(t, 0.5, baz.ProbabilisticSampling.SamplingRate)
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
+
+import (
+	"context"
+
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/thrift-gen/sampling"
+)
+
+// defaultSamplingProbability is the sampling probability of the operations without strategy of
+// the services whose strategy isn't probabilistic, as in the Jaeger strategies files.
+const defaultSamplingProbability = 0.001
+
+// StrategyOverride overrides the strategy of a service, or of some of its operations.
+type StrategyOverride struct {
+	// Strategy replaces the strategy of the service when it is not nil, the store not being
+	// queried for the service.
+	Strategy *sampling.SamplingStrategyResponse
+	// Operations are the probabilistic strategies of the operations of the service, replacing
+	// the strategies of the same operations.
+	Operations []*sampling.OperationSamplingStrategy
+}
+
+type overrideStrategyStore struct {
+	delegate  strategystore.StrategyStore
+	overrides map[string]StrategyOverride
+}
+
+// NewOverrideStrategyStore returns a StrategyStore that delegates to the store the strategies of the
+// services, overriding the strategies of the services and operations of the overrides, by service name.
+func NewOverrideStrategyStore(delegate strategystore.StrategyStore, overrides map[string]StrategyOverride) strategystore.StrategyStore {
+	return &overrideStrategyStore{
+		delegate:  delegate,
+		overrides: overrides,
+	}
+}
+
+func (o *overrideStrategyStore) GetSamplingStrategy(ctx context.Context, serviceName string) (*sampling.SamplingStrategyResponse, error) {
+	override, ok := o.overrides[serviceName]
+	if !ok {
+		return o.delegate.GetSamplingStrategy(ctx, serviceName)
+	}
+
+	var resp *sampling.SamplingStrategyResponse
+	if override.Strategy != nil {
+		resp = copyStrategy(override.Strategy)
+	} else {
+		source, err := o.delegate.GetSamplingStrategy(ctx, serviceName)
+		if err != nil {
+			return nil, err
+		}
+		resp = copyStrategy(source)
+	}
+	if len(override.Operations) == 0 {
+		return resp, nil
+	}
+
+	if resp.OperationSampling == nil {
+		resp.OperationSampling = &sampling.PerOperationSamplingStrategies{
+			DefaultSamplingProbability: defaultSamplingProbability,
+		}
+		if resp.StrategyType == sampling.SamplingStrategyType_PROBABILISTIC && resp.ProbabilisticSampling != nil {
+			resp.OperationSampling.DefaultSamplingProbability = resp.ProbabilisticSampling.SamplingRate
+		}
+	}
+	strategies := make([]*sampling.OperationSamplingStrategy, 0, len(resp.OperationSampling.PerOperationStrategies)+len(override.Operations))
+	overridden := make(map[string]bool, len(override.Operations))
+	for _, operation := range override.Operations {
+		overridden[operation.Operation] = true
+	}
+	for _, operation := range resp.OperationSampling.PerOperationStrategies {
+		if !overridden[operation.Operation] {
+			strategies = append(strategies, operation)
+		}
+	}
+	resp.OperationSampling.PerOperationStrategies = append(strategies, override.Operations...)
+	return resp, nil
+}
+
+// copyStrategy returns a copy of the strategy whose per-operation strategies can be modified, the
+// strategies being shared by the responses.
+func copyStrategy(s *sampling.SamplingStrategyResponse) *sampling.SamplingStrategyResponse {
+	c := *s
+	if s.OperationSampling != nil {
+		operationSampling := *s.OperationSampling
+		c.OperationSampling = &operationSampling
+	}
+	return &c
+}
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jaegertracing/jaeger/thrift-gen/sampling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func probabilistic(rate float64) *sampling.ProbabilisticSamplingStrategy {
+	return &sampling.ProbabilisticSamplingStrategy{SamplingRate: rate}
+}
+
+func TestOverrideStrategyStore(t *testing.T) {
+	source := map[string]*sampling.SamplingStrategyResponse{
+		"foo": {
+			StrategyType:          sampling.SamplingStrategyType_PROBABILISTIC,
+			ProbabilisticSampling: probabilistic(0.8),
+			OperationSampling: &sampling.PerOperationSamplingStrategies{
+				DefaultSamplingProbability: 0.8,
+				PerOperationStrategies: []*sampling.OperationSamplingStrategy{
+					{Operation: "op1", ProbabilisticSampling: probabilistic(0.2)},
+					{Operation: "op2", ProbabilisticSampling: probabilistic(0.4)},
+				},
+			},
+		},
+		"bar": {
+			StrategyType:         sampling.SamplingStrategyType_RATE_LIMITING,
+			RateLimitingSampling: &sampling.RateLimitingSamplingStrategy{MaxTracesPerSecond: 5},
+		},
+	}
+	store := NewOverrideStrategyStore(&mockCfgMgr{
+		getSamplingStrategyFunc: func(_ context.Context, serviceName string) (*sampling.SamplingStrategyResponse, error) {
+			if serviceName == "unavailable" {
+				return nil, errors.New("source unavailable")
+			}
+			if s, ok := source[serviceName]; ok {
+				return s, nil
+			}
+			return &sampling.SamplingStrategyResponse{ProbabilisticSampling: probabilistic(0.5)}, nil
+		},
+	}, map[string]StrategyOverride{
+		"foo": {
+			Operations: []*sampling.OperationSamplingStrategy{
+				{Operation: "op2", ProbabilisticSampling: probabilistic(1)},
+				{Operation: "op3", ProbabilisticSampling: probabilistic(0)},
+			},
+		},
+		"bar": {
+			Operations: []*sampling.OperationSamplingStrategy{
+				{Operation: "op1", ProbabilisticSampling: probabilistic(0.1)},
+			},
+		},
+		"unavailable": {
+			Strategy: &sampling.SamplingStrategyResponse{
+				StrategyType:          sampling.SamplingStrategyType_PROBABILISTIC,
+				ProbabilisticSampling: probabilistic(0.3),
+			},
+		},
+	})
+
+	for _, tt := range []struct {
+		service  string
+		expected *sampling.SamplingStrategyResponse
+	}{
+		{
+			service: "foo",
+			expected: &sampling.SamplingStrategyResponse{
+				StrategyType:          sampling.SamplingStrategyType_PROBABILISTIC,
+				ProbabilisticSampling: probabilistic(0.8),
+				OperationSampling: &sampling.PerOperationSamplingStrategies{
+					DefaultSamplingProbability: 0.8,
+					PerOperationStrategies: []*sampling.OperationSamplingStrategy{
+						{Operation: "op1", ProbabilisticSampling: probabilistic(0.2)},
+						{Operation: "op2", ProbabilisticSampling: probabilistic(1)},
+						{Operation: "op3", ProbabilisticSampling: probabilistic(0)},
+					},
+				},
+			},
+		},
+		{
+			service: "bar",
+			expected: &sampling.SamplingStrategyResponse{
+				StrategyType:         sampling.SamplingStrategyType_RATE_LIMITING,
+				RateLimitingSampling: &sampling.RateLimitingSamplingStrategy{MaxTracesPerSecond: 5},
+				OperationSampling: &sampling.PerOperationSamplingStrategies{
+					DefaultSamplingProbability: defaultSamplingProbability,
+					PerOperationStrategies: []*sampling.OperationSamplingStrategy{
+						{Operation: "op1", ProbabilisticSampling: probabilistic(0.1)},
+					},
+				},
+			},
+		},
+		{
+			service: "unavailable",
+			expected: &sampling.SamplingStrategyResponse{
+				StrategyType:          sampling.SamplingStrategyType_PROBABILISTIC,
+				ProbabilisticSampling: probabilistic(0.3),
+			},
+		},
+		{
+			service:  "baz",
+			expected: &sampling.SamplingStrategyResponse{ProbabilisticSampling: probabilistic(0.5)},
+		},
+	} {
+		t.Run(tt.service, func(t *testing.T) {
+			resp, err := store.GetSamplingStrategy(context.Background(), tt.service)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+
+	// the strategies of the source are not modified
+	assert.Len(t, source["foo"].OperationSampling.PerOperationStrategies, 2)
+	assert.Equal(t, 0.4, source["foo"].OperationSampling.PerOperationStrategies[1].ProbabilisticSampling.SamplingRate)
+	assert.Nil(t, source["bar"].OperationSampling)
+}
+
+func TestOverrideStrategyStoreSourceError(t *testing.T) {
+	store := NewOverrideStrategyStore(&mockCfgMgr{
+		getSamplingStrategyFunc: func(context.Context, string) (*sampling.SamplingStrategyResponse, error) {
+			return nil, errors.New("source unavailable")
+		},
+	}, map[string]StrategyOverride{
+		"foo": {
+			Operations: []*sampling.OperationSamplingStrategy{
+				{Operation: "op1", ProbabilisticSampling: probabilistic(0.1)},
+			},
+		},
+	})
+
+	_, err := store.GetSamplingStrategy(context.Background(), "foo")
+	assert.Error(t, err)
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oauth2clientauthextension"
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" // #nosec G505 -- the x5t header is the SHA-1 thumbprint of the certificate
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// clientAssertionType is the type of the JWT client assertions, see
+	// https://datatracker.ietf.org/doc/html/rfc7523#section-2.2
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	defaultClientAssertionExpiry = 5 * time.Minute
+)
+
+// clientAssertionSigner signs the JWT authenticating the client with the `private_key_jwt` method.
+type clientAssertionSigner struct {
+	clientID string
+	audience string
+	expiry   time.Duration
+	method   jwt.SigningMethod
+	key      crypto.Signer
+	headers  map[string]any
+	now      func() time.Time
+}
+
+func newClientAssertionSigner(cfg *Config) (*clientAssertionSigner, error) {
+	key, err := loadSigningKey(cfg.ClientAssertion.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	method, err := signingMethod(key)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := &clientAssertionSigner{
+		clientID: cfg.ClientID,
+		audience: cfg.ClientAssertion.Audience,
+		expiry:   cfg.ClientAssertion.Expiry,
+		method:   method,
+		key:      key,
+		headers:  map[string]any{},
+		now:      time.Now,
+	}
+	if signer.audience == "" {
+		signer.audience = cfg.TokenURL
+	}
+	if signer.expiry == 0 {
+		signer.expiry = defaultClientAssertionExpiry
+	}
+	if cfg.ClientAssertion.KeyID != "" {
+		signer.headers["kid"] = cfg.ClientAssertion.KeyID
+	}
+	if cfg.ClientAssertion.CertFile != "" {
+		thumbprint, err := certificateThumbprint(cfg.ClientAssertion.CertFile)
+		if err != nil {
+			return nil, err
+		}
+		signer.headers["x5t"] = thumbprint
+	}
+	return signer, nil
+}
+
+// sign returns a new client assertion, each token request needing its own since the
+// authorization servers may reject the assertions already used.
+func (s *clientAssertionSigner) sign() (string, error) {
+	now := s.now()
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(s.method, jwt.RegisteredClaims{
+		Issuer:    s.clientID,
+		Subject:   s.clientID,
+		Audience:  jwt.ClaimStrings{s.audience},
+		ID:        hex.EncodeToString(id),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(s.expiry)),
+	})
+	for k, v := range s.headers {
+		token.Header[k] = v
+	}
+	return token.SignedString(s.key)
+}
+
+func loadSigningKey(keyFile string) (crypto.Signer, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the client assertion key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode the client assertion key %q: no PEM data found", keyFile)
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("failed to parse the client assertion key %q: expected a RSA or ECDSA private key", keyFile)
+}
+
+func signingMethod(key crypto.Signer) (jwt.SigningMethod, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return jwt.SigningMethodRS256, nil
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jwt.SigningMethodES256, nil
+		case elliptic.P384():
+			return jwt.SigningMethodES384, nil
+		case elliptic.P521():
+			return jwt.SigningMethodES512, nil
+		}
+	}
+	return nil, errors.New("unsupported client assertion key, expected a RSA or ECDSA P-256, P-384 or P-521 private key")
+}
+
+// certificateThumbprint returns the base64url encoded SHA-1 thumbprint of the certificate.
+func certificateThumbprint(certFile string) (string, error) {
+	data, err := os.ReadFile(certFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the client assertion certificate: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return "", fmt.Errorf("failed to decode the client assertion certificate %q: no PEM data found", certFile)
+	}
+	thumbprint := sha1.Sum(block.Bytes) // #nosec G401
+	return base64.RawURLEncoding.EncodeToString(thumbprint[:]), nil
+}
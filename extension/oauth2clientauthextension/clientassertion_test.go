@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAssertionSigner(t *testing.T) {
+	signer, err := newClientAssertionSigner(&Config{
+		ClientID: "someclientid",
+		TokenURL: "https://example.com/v1/token",
+		ClientAssertion: ClientAssertionConfig{
+			KeyFile:  "testdata/test-key.pem",
+			CertFile: "testdata/test-cert.pem",
+			KeyID:    "somekeyid",
+		},
+	})
+	require.NoError(t, err)
+	now := time.Now().Truncate(time.Second)
+	signer.now = func() time.Time { return now }
+
+	assertion, err := signer.sign()
+	require.NoError(t, err)
+
+	claims := &jwt.RegisteredClaims{}
+	token, err := jwt.ParseWithClaims(assertion, claims, func(token *jwt.Token) (any, error) {
+		return signer.key.Public(), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "RS256", token.Method.Alg())
+	assert.Equal(t, "somekeyid", token.Header["kid"])
+	assert.NotEmpty(t, token.Header["x5t"])
+	assert.Equal(t, "someclientid", claims.Issuer)
+	assert.Equal(t, "someclientid", claims.Subject)
+	assert.Equal(t, jwt.ClaimStrings{"https://example.com/v1/token"}, claims.Audience)
+	assert.Equal(t, now.Add(defaultClientAssertionExpiry), claims.ExpiresAt.Time)
+	assert.NotEmpty(t, claims.ID)
+
+	// each assertion is unique
+	other, err := signer.sign()
+	require.NoError(t, err)
+	assert.NotEqual(t, assertion, other)
+}
+
+func TestClientAssertionSignerECDSA(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600))
+
+	signer, err := newClientAssertionSigner(&Config{
+		ClientID: "someclientid",
+		TokenURL: "https://example.com/v1/token",
+		ClientAssertion: ClientAssertionConfig{
+			KeyFile:  keyFile,
+			Audience: "https://example.com",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, jwt.SigningMethodES256, signer.method)
+	assert.Equal(t, "https://example.com", signer.audience)
+
+	assertion, err := signer.sign()
+	require.NoError(t, err)
+	_, err = jwt.Parse(assertion, func(token *jwt.Token) (any, error) {
+		return &key.PublicKey, nil
+	})
+	require.NoError(t, err)
+}
+
+func TestClientAssertionSignerInvalidKey(t *testing.T) {
+	_, err := newClientAssertionSigner(&Config{
+		ClientAssertion: ClientAssertionConfig{KeyFile: "testdata/doesnotexist.pem"},
+	})
+	assert.ErrorContains(t, err, "failed to read the client assertion key")
+
+	_, err = newClientAssertionSigner(&Config{
+		ClientAssertion: ClientAssertionConfig{KeyFile: "testdata/test-cert.pem"},
+	})
+	assert.ErrorContains(t, err, "expected a RSA or ECDSA private key")
+}
@@ -5,6 +5,7 @@ package oauth2clientauthextension // import "github.com/open-telemetry/opentelem
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 	"time"
 
@@ -17,6 +18,22 @@ var (
 	errNoClientIDProvided     = errors.New("no ClientID provided in the OAuth2 exporter configuration")
 	errNoTokenURLProvided     = errors.New("no TokenURL provided in OAuth Client Credentials configuration")
 	errNoClientSecretProvided = errors.New("no ClientSecret provided in OAuth Client Credentials configuration")
+	errNoKeyFileProvided      = errors.New("no client_assertion::key_file provided for the private_key_jwt client authentication")
+	errNoClientCertProvided   = errors.New("no tls client certificate provided for the tls_client_auth client authentication")
+	errNoURLPrefixProvided    = errors.New("no url_prefix provided in the resources configuration")
+)
+
+// The methods authenticating the client to the token endpoint.
+const (
+	// clientSecretAuthMethod authenticates the client with its secret, see
+	// https://datatracker.ietf.org/doc/html/rfc6749#section-2.3.1
+	clientSecretAuthMethod = "client_secret"
+	// privateKeyJWTAuthMethod authenticates the client with a JWT signed by its private key, see
+	// https://openid.net/specs/openid-connect-core-1_0.html#ClientAuthentication
+	privateKeyJWTAuthMethod = "private_key_jwt"
+	// tlsClientAuthMethod authenticates the client with its TLS client certificate, the issued tokens
+	// being bound to the certificate, see https://datatracker.ietf.org/doc/html/rfc8705
+	tlsClientAuthMethod = "tls_client_auth"
 )
 
 // Config stores the configuration for OAuth2 Client Credentials (2-legged OAuth2 flow) setup.
@@ -48,6 +65,50 @@ type Config struct {
 	// Timeout parameter configures `http.Client.Timeout` for the underneath client to authorization
 	// server while fetching and refreshing tokens.
 	Timeout time.Duration `mapstructure:"timeout,omitempty"`
+
+	// ClientAuthMethod is the method authenticating the client to the token endpoint, one of
+	// `client_secret` (the default), `private_key_jwt` or `tls_client_auth`.
+	ClientAuthMethod string `mapstructure:"client_auth_method,omitempty"`
+
+	// ClientAssertion configures the JWT authenticating the client with the `private_key_jwt` method.
+	ClientAssertion ClientAssertionConfig `mapstructure:"client_assertion,omitempty"`
+
+	// Resources override the scopes and the endpoint params of the tokens of the requests to some
+	// URLs. A token is cached for each set of scopes and endpoint params.
+	Resources []ResourceConfig `mapstructure:"resources,omitempty"`
+}
+
+// ClientAssertionConfig configures the JWT authenticating the client with the `private_key_jwt` method.
+type ClientAssertionConfig struct {
+	// KeyFile is the PEM encoded RSA or ECDSA private key signing the JWT.
+	KeyFile string `mapstructure:"key_file"`
+
+	// CertFile is the optional PEM encoded certificate of the key, its thumbprint is set in the `x5t`
+	// header of the JWT as required by Azure AD.
+	CertFile string `mapstructure:"cert_file"`
+
+	// KeyID is the optional `kid` header of the JWT.
+	KeyID string `mapstructure:"key_id"`
+
+	// Audience is the audience of the JWT, defaults to the token URL.
+	Audience string `mapstructure:"audience"`
+
+	// Expiry is the validity of the JWT, defaults to 5 minutes.
+	Expiry time.Duration `mapstructure:"expiry"`
+}
+
+// ResourceConfig overrides the token requests of the requests to the URLs starting with a prefix.
+type ResourceConfig struct {
+	// URLPrefix is the prefix of the URLs of the requests, such as `https://logs.example.com/`.
+	// For gRPC requests the URL is made of the scheme, the authority and the service of the request.
+	URLPrefix string `mapstructure:"url_prefix"`
+
+	// Scopes replace the scopes of the tokens.
+	Scopes []string `mapstructure:"scopes"`
+
+	// EndpointParams are added to the endpoint params of the token requests, such as the
+	// audience of Google STS or the resource of Azure AD.
+	EndpointParams url.Values `mapstructure:"endpoint_params"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -57,11 +118,30 @@ func (cfg *Config) Validate() error {
 	if cfg.ClientID == "" {
 		return errNoClientIDProvided
 	}
-	if cfg.ClientSecret == "" {
-		return errNoClientSecretProvided
+	switch cfg.ClientAuthMethod {
+	case "", clientSecretAuthMethod:
+		if cfg.ClientSecret == "" {
+			return errNoClientSecretProvided
+		}
+	case privateKeyJWTAuthMethod:
+		if cfg.ClientAssertion.KeyFile == "" {
+			return errNoKeyFileProvided
+		}
+	case tlsClientAuthMethod:
+		if cfg.TLSSetting.CertFile == "" && cfg.TLSSetting.CertPem == "" {
+			return errNoClientCertProvided
+		}
+	default:
+		return fmt.Errorf("unsupported client_auth_method %q, expected one of %s, %s or %s",
+			cfg.ClientAuthMethod, clientSecretAuthMethod, privateKeyJWTAuthMethod, tlsClientAuthMethod)
 	}
 	if cfg.TokenURL == "" {
 		return errNoTokenURLProvided
 	}
+	for _, resource := range cfg.Resources {
+		if resource.URLPrefix == "" {
+			return errNoURLPrefixProvided
+		}
+	}
 	return nil
 }
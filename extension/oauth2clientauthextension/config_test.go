@@ -57,6 +57,56 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "privatekeyjwt"),
+			expected: &Config{
+				ClientID:         "someclientid",
+				ClientAuthMethod: privateKeyJWTAuthMethod,
+				ClientAssertion: ClientAssertionConfig{
+					KeyFile:  "keyfile",
+					CertFile: "certfile",
+					KeyID:    "somekeyid",
+					Expiry:   time.Minute,
+				},
+				TokenURL: "https://login.microsoftonline.com/tenant/oauth2/v2.0/token",
+				Resources: []ResourceConfig{
+					{
+						URLPrefix: "https://logs.example.com/",
+						Scopes:    []string{"api://logs/.default"},
+					},
+					{
+						URLPrefix:      "https://sts.googleapis.com/",
+						EndpointParams: url.Values{"audience": []string{"someaudience"}},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tlsclientauth"),
+			expected: &Config{
+				ClientID:         "someclientid",
+				ClientAuthMethod: tlsClientAuthMethod,
+				TokenURL:         "https://example.com/oauth2/default/v1/token",
+				TLSSetting: configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{
+						CertFile: "certfile",
+						KeyFile:  "keyfile",
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missingkeyfile"),
+			expectedErr: errNoKeyFileProvided,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missingclientcert"),
+			expectedErr: errNoClientCertProvided,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missingurlprefix"),
+			expectedErr: errNoURLPrefixProvided,
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "missingurl"),
 			expectedErr: errNoTokenURLProvided,
@@ -88,3 +138,12 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUnsupportedClientAuthMethod(t *testing.T) {
+	cfg := &Config{
+		ClientID:         "someclientid",
+		ClientAuthMethod: "client_secret_jwt",
+		TokenURL:         "https://example.com/oauth2/default/v1/token",
+	}
+	assert.EqualError(t, cfg.Validate(), `unsupported client_auth_method "client_secret_jwt", expected one of client_secret, private_key_jwt or tls_client_auth`)
+}
@@ -7,6 +7,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -22,6 +25,13 @@ type clientAuthenticator struct {
 	clientCredentials *clientcredentials.Config
 	logger            *zap.Logger
 	client            *http.Client
+	// assertionSigner signs the client assertions of the private_key_jwt client authentication.
+	assertionSigner *clientAssertionSigner
+	resources       []ResourceConfig
+
+	// tokenSources caches a token source for each set of scopes and endpoint params.
+	tokenSourcesMu sync.Mutex
+	tokenSources   map[string]oauth2.TokenSource
 }
 
 type errorWrappingTokenSource struct {
@@ -36,14 +46,8 @@ var _ oauth2.TokenSource = (*errorWrappingTokenSource)(nil)
 var errFailedToGetSecurityToken = fmt.Errorf("failed to get security token from token endpoint")
 
 func newClientAuthenticator(cfg *Config, logger *zap.Logger) (*clientAuthenticator, error) {
-	if cfg.ClientID == "" {
-		return nil, errNoClientIDProvided
-	}
-	if cfg.ClientSecret == "" {
-		return nil, errNoClientSecretProvided
-	}
-	if cfg.TokenURL == "" {
-		return nil, errNoTokenURLProvided
+	if err := cfg.Validate(); err != nil {
+		return nil, err
 	}
 
 	transport := http.DefaultTransport.(*http.Transport).Clone()
@@ -54,19 +58,36 @@ func newClientAuthenticator(cfg *Config, logger *zap.Logger) (*clientAuthenticat
 	}
 	transport.TLSClientConfig = tlsCfg
 
+	clientCredentials := &clientcredentials.Config{
+		ClientID:       cfg.ClientID,
+		ClientSecret:   string(cfg.ClientSecret),
+		TokenURL:       cfg.TokenURL,
+		Scopes:         cfg.Scopes,
+		EndpointParams: cfg.EndpointParams,
+	}
+
+	var assertionSigner *clientAssertionSigner
+	switch cfg.ClientAuthMethod {
+	case privateKeyJWTAuthMethod:
+		if assertionSigner, err = newClientAssertionSigner(cfg); err != nil {
+			return nil, err
+		}
+		clientCredentials.AuthStyle = oauth2.AuthStyleInParams
+	case tlsClientAuthMethod:
+		// the client is authenticated by its certificate, only its ID is sent
+		clientCredentials.AuthStyle = oauth2.AuthStyleInParams
+	}
+
 	return &clientAuthenticator{
-		clientCredentials: &clientcredentials.Config{
-			ClientID:       cfg.ClientID,
-			ClientSecret:   string(cfg.ClientSecret),
-			TokenURL:       cfg.TokenURL,
-			Scopes:         cfg.Scopes,
-			EndpointParams: cfg.EndpointParams,
-		},
-		logger: logger,
+		clientCredentials: clientCredentials,
+		logger:            logger,
 		client: &http.Client{
 			Transport: transport,
 			Timeout:   cfg.Timeout,
 		},
+		assertionSigner: assertionSigner,
+		resources:       cfg.Resources,
+		tokenSources:    map[string]oauth2.TokenSource{},
 	}, nil
 }
 
@@ -80,27 +101,146 @@ func (ewts errorWrappingTokenSource) Token() (*oauth2.Token, error) {
 	return tok, nil
 }
 
+// clientAssertionTokenSource requests the tokens with a new client assertion for each request.
+type clientAssertionTokenSource struct {
+	ctx               context.Context
+	clientCredentials *clientcredentials.Config
+	signer            *clientAssertionSigner
+}
+
+func (cats clientAssertionTokenSource) Token() (*oauth2.Token, error) {
+	assertion, err := cats.signer.sign()
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the client assertion: %w", err)
+	}
+	clientCredentials := *cats.clientCredentials
+	clientCredentials.EndpointParams = url.Values{}
+	for k, v := range cats.clientCredentials.EndpointParams {
+		clientCredentials.EndpointParams[k] = v
+	}
+	clientCredentials.EndpointParams.Set("client_assertion_type", clientAssertionType)
+	clientCredentials.EndpointParams.Set("client_assertion", assertion)
+	return clientCredentials.Token(cats.ctx)
+}
+
+// tokenSource returns the token source of the tokens with the scopes and the endpoint params, which
+// is shared by all the requests needing these tokens.
+func (o *clientAuthenticator) tokenSource(scopes []string, endpointParams url.Values) oauth2.TokenSource {
+	key := strings.Join(scopes, " ") + "?" + endpointParams.Encode()
+
+	o.tokenSourcesMu.Lock()
+	defer o.tokenSourcesMu.Unlock()
+	if ts, ok := o.tokenSources[key]; ok {
+		return ts
+	}
+
+	clientCredentials := *o.clientCredentials
+	clientCredentials.Scopes = scopes
+	clientCredentials.EndpointParams = endpointParams
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
+	var ts oauth2.TokenSource
+	if o.assertionSigner != nil {
+		ts = oauth2.ReuseTokenSource(nil, clientAssertionTokenSource{
+			ctx:               ctx,
+			clientCredentials: &clientCredentials,
+			signer:            o.assertionSigner,
+		})
+	} else {
+		ts = clientCredentials.TokenSource(ctx)
+	}
+	ts = errorWrappingTokenSource{
+		ts:       ts,
+		tokenURL: o.clientCredentials.TokenURL,
+	}
+	o.tokenSources[key] = ts
+	return ts
+}
+
+// defaultTokenSource returns the token source of the requests matching no resource.
+func (o *clientAuthenticator) defaultTokenSource() oauth2.TokenSource {
+	return o.tokenSource(o.clientCredentials.Scopes, o.clientCredentials.EndpointParams)
+}
+
+// resourceTokenSource returns the token source of the requests to the URL, the token source of the
+// longest matching resource prefix or the default one.
+func (o *clientAuthenticator) resourceTokenSource(requestURL string) oauth2.TokenSource {
+	var match *ResourceConfig
+	for i, resource := range o.resources {
+		if strings.HasPrefix(requestURL, resource.URLPrefix) && (match == nil || len(resource.URLPrefix) > len(match.URLPrefix)) {
+			match = &o.resources[i]
+		}
+	}
+	if match == nil {
+		return o.defaultTokenSource()
+	}
+
+	scopes := o.clientCredentials.Scopes
+	if len(match.Scopes) > 0 {
+		scopes = match.Scopes
+	}
+	endpointParams := url.Values{}
+	for k, v := range o.clientCredentials.EndpointParams {
+		endpointParams[k] = v
+	}
+	for k, v := range match.EndpointParams {
+		endpointParams[k] = v
+	}
+	return o.tokenSource(scopes, endpointParams)
+}
+
 // roundTripper returns oauth2.Transport, an http.RoundTripper that performs "client-credential" OAuth flow and
 // also auto refreshes OAuth tokens as needed.
 func (o *clientAuthenticator) roundTripper(base http.RoundTripper) (http.RoundTripper, error) {
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
+	if len(o.resources) > 0 {
+		return &resourcesTransport{authenticator: o, base: base}, nil
+	}
 	return &oauth2.Transport{
-		Source: errorWrappingTokenSource{
-			ts:       o.clientCredentials.TokenSource(ctx),
-			tokenURL: o.clientCredentials.TokenURL,
-		},
-		Base: base,
+		Source: o.defaultTokenSource(),
+		Base:   base,
 	}, nil
 }
 
 // perRPCCredentials returns gRPC PerRPCCredentials that supports "client-credential" OAuth flow. The underneath
 // oauth2.clientcredentials.Config instance will manage tokens performing auto refresh as necessary.
 func (o *clientAuthenticator) perRPCCredentials() (credentials.PerRPCCredentials, error) {
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
+	if len(o.resources) > 0 {
+		return &resourcesPerRPCCredentials{authenticator: o}, nil
+	}
 	return grpcOAuth.TokenSource{
-		TokenSource: errorWrappingTokenSource{
-			ts:       o.clientCredentials.TokenSource(ctx),
-			tokenURL: o.clientCredentials.TokenURL,
-		},
+		TokenSource: o.defaultTokenSource(),
 	}, nil
 }
+
+// resourcesTransport authorizes the requests with the tokens of the resources of their URL.
+type resourcesTransport struct {
+	authenticator *clientAuthenticator
+	base          http.RoundTripper
+}
+
+func (rt *resourcesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := &oauth2.Transport{
+		Source: rt.authenticator.resourceTokenSource(req.URL.String()),
+		Base:   rt.base,
+	}
+	return transport.RoundTrip(req)
+}
+
+// resourcesPerRPCCredentials authorizes the RPCs with the tokens of the resources of their URI.
+type resourcesPerRPCCredentials struct {
+	authenticator *clientAuthenticator
+}
+
+func (rc *resourcesPerRPCCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	requestURL := ""
+	if len(uri) > 0 {
+		requestURL = uri[0]
+	}
+	return grpcOAuth.TokenSource{
+		TokenSource: rc.authenticator.resourceTokenSource(requestURL),
+	}.GetRequestMetadata(ctx, uri...)
+}
+
+func (rc *resourcesPerRPCCredentials) RequireTransportSecurity() bool {
+	return true
+}
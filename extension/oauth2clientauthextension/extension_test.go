@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -285,3 +286,139 @@ func TestFailContactingOAuth(t *testing.T) {
 	assert.ErrorIs(t, err, errFailedToGetSecurityToken)
 	assert.Contains(t, err.Error(), serverURL.String())
 }
+
+// newTokenServer returns a token endpoint issuing tokens made of the scope and audience of the
+// requests, and recording the forms of the requests.
+func newTokenServer(t *testing.T) (*httptest.Server, *[]url.Values) {
+	var forms []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		form := r.PostForm
+		if user, _, ok := r.BasicAuth(); ok {
+			form.Set("basic_auth_user", user)
+		}
+		forms = append(forms, form)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"access_token":"token:` + form.Get("scope") + form.Get("audience") + `","token_type":"Bearer","expires_in":3600}`))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+	return server, &forms
+}
+
+func TestPrivateKeyJWTClientAuthentication(t *testing.T) {
+	server, forms := newTokenServer(t)
+
+	oauth2Authenticator, err := newClientAuthenticator(&Config{
+		ClientID:         "someclientid",
+		ClientAuthMethod: privateKeyJWTAuthMethod,
+		ClientAssertion: ClientAssertionConfig{
+			KeyFile: "testdata/test-key.pem",
+		},
+		TokenURL: server.URL,
+		Scopes:   []string{"api.metrics"},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		token, err := oauth2Authenticator.defaultTokenSource().Token()
+		require.NoError(t, err)
+		assert.Equal(t, "token:api.metrics", token.AccessToken)
+	}
+
+	// the token is cached
+	require.Len(t, *forms, 1)
+	form := (*forms)[0]
+	assert.Equal(t, "client_credentials", form.Get("grant_type"))
+	assert.Equal(t, "someclientid", form.Get("client_id"))
+	assert.Equal(t, clientAssertionType, form.Get("client_assertion_type"))
+	assert.NotEmpty(t, form.Get("client_assertion"))
+	assert.Empty(t, form.Get("client_secret"))
+	assert.Empty(t, form.Get("basic_auth_user"))
+}
+
+func TestTLSClientAuthentication(t *testing.T) {
+	server, forms := newTokenServer(t)
+
+	oauth2Authenticator, err := newClientAuthenticator(&Config{
+		ClientID:         "someclientid",
+		ClientAuthMethod: tlsClientAuthMethod,
+		TokenURL:         server.URL,
+		TLSSetting: configtls.TLSClientSetting{
+			TLSSetting: configtls.TLSSetting{
+				CertFile: "testdata/test-cert.pem",
+				KeyFile:  "testdata/test-key.pem",
+			},
+		},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	_, err = oauth2Authenticator.defaultTokenSource().Token()
+	require.NoError(t, err)
+
+	require.Len(t, *forms, 1)
+	form := (*forms)[0]
+	assert.Equal(t, "someclientid", form.Get("client_id"))
+	assert.Empty(t, form.Get("client_secret"))
+	assert.Empty(t, form.Get("client_assertion"))
+	assert.Empty(t, form.Get("basic_auth_user"))
+}
+
+type recordingRoundTripper struct {
+	authorizations map[string]string
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.authorizations[req.URL.String()] = req.Header.Get("Authorization")
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestResourcesTokens(t *testing.T) {
+	server, forms := newTokenServer(t)
+
+	oauth2Authenticator, err := newClientAuthenticator(&Config{
+		ClientID:     "someclientid",
+		ClientSecret: "someclientsecret",
+		TokenURL:     server.URL,
+		Scopes:       []string{"default"},
+		Resources: []ResourceConfig{
+			{
+				URLPrefix: "https://logs.example.com/",
+				Scopes:    []string{"logs"},
+			},
+			{
+				URLPrefix:      "https://logs.example.com/v2/",
+				EndpointParams: url.Values{"audience": []string{"-v2"}},
+			},
+		},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	base := &recordingRoundTripper{authorizations: map[string]string{}}
+	roundTripper, err := oauth2Authenticator.roundTripper(base)
+	require.NoError(t, err)
+	for _, u := range []string{
+		"https://logs.example.com/v1/logs",
+		"https://logs.example.com/v1/other",
+		"https://logs.example.com/v2/logs",
+		"https://metrics.example.com/v1/metrics",
+	} {
+		req, err := http.NewRequest(http.MethodPost, u, nil)
+		require.NoError(t, err)
+		_, err = roundTripper.RoundTrip(req)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, map[string]string{
+		"https://logs.example.com/v1/logs":       "Bearer token:logs",
+		"https://logs.example.com/v1/other":      "Bearer token:logs",
+		"https://logs.example.com/v2/logs":       "Bearer token:default-v2",
+		"https://metrics.example.com/v1/metrics": "Bearer token:default",
+	}, base.authorizations)
+
+	// the gRPC credentials share the cached tokens
+	token, err := oauth2Authenticator.resourceTokenSource("https://logs.example.com/opentelemetry.proto.collector.logs.v1.LogsService/Export").Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token:logs", token.AccessToken)
+
+	assert.Len(t, *forms, 3)
+}
@@ -3,7 +3,18 @@
 
 package oidcauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oidcauthextension"
 
-import "go.opentelemetry.io/collector/client"
+import (
+	"sort"
+
+	"go.opentelemetry.io/collector/client"
+)
+
+// The attributes of the auth data of every authenticated subject.
+const (
+	subjectAttribute    = "subject"
+	membershipAttribute = "membership"
+	rawAttribute        = "raw"
+)
 
 var _ client.AuthData = (*authData)(nil)
 
@@ -11,21 +22,28 @@ type authData struct {
 	raw        string
 	subject    string
 	membership []string
+	// attributes are the values of the claims mapped to attributes
+	attributes map[string]interface{}
 }
 
 func (a *authData) GetAttribute(name string) interface{} {
 	switch name {
-	case "subject":
+	case subjectAttribute:
 		return a.subject
-	case "membership":
+	case membershipAttribute:
 		return a.membership
-	case "raw":
+	case rawAttribute:
 		return a.raw
 	default:
-		return nil
+		return a.attributes[name]
 	}
 }
 
-func (*authData) GetAttributeNames() []string {
-	return []string{"subject", "membership", "raw"}
+func (a *authData) GetAttributeNames() []string {
+	names := []string{subjectAttribute, membershipAttribute, rawAttribute}
+	for name := range a.attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names[3:])
+	return names
 }
@@ -3,6 +3,8 @@
 
 package oidcauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oidcauthextension"
 
+import "time"
+
 // Config has the configuration for the OIDC Authenticator extension.
 type Config struct {
 
@@ -13,11 +15,26 @@ type Config struct {
 	// Required.
 	IssuerURL string `mapstructure:"issuer_url"`
 
+	// IssuerURLs are the base URLs of additional OIDC providers whose tokens are accepted.
+	// Optional.
+	IssuerURLs []string `mapstructure:"issuer_urls"`
+
 	// Audience of the token, used during the verification.
 	// For example: "https://accounts.google.com" or "https://login.salesforce.com".
 	// Required.
 	Audience string `mapstructure:"audience"`
 
+	// Audiences are additional audiences accepted, the token having to be issued for one of
+	// the Audience and Audiences.
+	// Optional.
+	Audiences []string `mapstructure:"audiences"`
+
+	// JWKSRefreshInterval is the maximum duration the signing keys of the OIDC providers are
+	// cached, whatever the cache headers of their JWKS endpoint. The keys are cached until they
+	// expire or a token is signed by an unknown key when it is zero.
+	// Optional.
+	JWKSRefreshInterval time.Duration `mapstructure:"jwks_refresh_interval"`
+
 	// The local path for the issuer CA's TLS server cert.
 	// Optional.
 	IssuerCAPath string `mapstructure:"issuer_ca_path"`
@@ -29,4 +46,38 @@ type Config struct {
 	// The claim that holds the subject's group membership information.
 	// Optional.
 	GroupsClaim string `mapstructure:"groups_claim"`
+
+	// ClaimAttributes maps the names of attributes to the claims of the token exposed as
+	// these attributes of the auth data, along the subject, membership and raw attributes.
+	// Optional.
+	ClaimAttributes map[string]string `mapstructure:"claim_attributes"`
+}
+
+// issuerURLs returns the URLs of the accepted OIDC providers.
+func (cfg *Config) issuerURLs() []string {
+	return appendUnique(appendUnique(nil, cfg.IssuerURL), cfg.IssuerURLs...)
+}
+
+// audiences returns the accepted audiences of the tokens.
+func (cfg *Config) audiences() []string {
+	return appendUnique(appendUnique(nil, cfg.Audience), cfg.Audiences...)
+}
+
+// appendUnique appends the non-empty values not in the slice yet.
+func appendUnique(slice []string, values ...string) []string {
+	for _, v := range values {
+		if v != "" && !contains(slice, v) {
+			slice = append(slice, v)
+		}
+	}
+	return slice
+}
+
+func contains(slice []string, value string) bool {
+	for _, v := range slice {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
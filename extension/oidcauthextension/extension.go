@@ -7,6 +7,8 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -27,8 +29,8 @@ import (
 type oidcExtension struct {
 	cfg *Config
 
-	provider *oidc.Provider
-	verifier *oidc.IDTokenVerifier
+	// verifiers verify the tokens of the accepted OIDC providers, by issuer URL
+	verifiers map[string]*oidc.IDTokenVerifier
 
 	logger *zap.Logger
 }
@@ -42,15 +44,23 @@ var (
 	errUsernameNotString                 = errors.New("the username returned by the OIDC provider isn't a regular string")
 	errGroupsClaimNotFound               = errors.New("groups claim from the OIDC configuration not found on the token returned by the OIDC provider")
 	errNotAuthenticated                  = errors.New("authentication didn't succeed")
+	errUnknownIssuer                     = errors.New("the token wasn't issued by an OIDC provider from the configuration")
+	errUnexpectedAudience                = errors.New("the token wasn't issued for an audience from the configuration")
+	errReservedClaimAttribute            = errors.New("the subject, membership and raw attributes can't be mapped to claims")
 )
 
 func newExtension(cfg *Config, logger *zap.Logger) (auth.Server, error) {
-	if cfg.Audience == "" {
+	if len(cfg.audiences()) == 0 {
 		return nil, errNoAudienceProvided
 	}
-	if cfg.IssuerURL == "" {
+	if len(cfg.issuerURLs()) == 0 {
 		return nil, errNoIssuerURL
 	}
+	for name := range cfg.ClaimAttributes {
+		if name == subjectAttribute || name == membershipAttribute || name == rawAttribute {
+			return nil, errReservedClaimAttribute
+		}
+	}
 
 	if cfg.Attribute == "" {
 		cfg.Attribute = defaultAttribute
@@ -64,19 +74,66 @@ func newExtension(cfg *Config, logger *zap.Logger) (auth.Server, error) {
 }
 
 func (e *oidcExtension) start(context.Context, component.Host) error {
-	provider, err := getProviderForConfig(e.cfg)
-	if err != nil {
-		return fmt.Errorf("failed to get configuration from the auth server: %w", err)
-	}
-	e.provider = provider
+	// the audiences are checked once the token is verified, the verifiers accepting a single one
+	oidcConfig := &oidc.Config{SkipClientIDCheck: true}
+	e.verifiers = map[string]*oidc.IDTokenVerifier{}
+	for _, issuerURL := range e.cfg.issuerURLs() {
+		provider, err := getProviderForConfig(e.cfg, issuerURL)
+		if err != nil {
+			return fmt.Errorf("failed to get configuration from the auth server %q: %w", issuerURL, err)
+		}
 
-	e.verifier = e.provider.Verifier(&oidc.Config{
-		ClientID: e.cfg.Audience,
-	})
+		if e.cfg.JWKSRefreshInterval <= 0 {
+			e.verifiers[issuerURL] = provider.Verifier(oidcConfig)
+			continue
+		}
+		var claims struct {
+			JWKSURL string `json:"jwks_uri"`
+		}
+		if err = provider.Claims(&claims); err != nil {
+			return fmt.Errorf("failed to get the JWKS URL of the auth server %q: %w", issuerURL, err)
+		}
+		oidcContext, err := getClientContextForConfig(e.cfg)
+		if err != nil {
+			return err
+		}
+		keySet := newRefreshingKeySet(oidcContext, claims.JWKSURL, e.cfg.JWKSRefreshInterval)
+		e.verifiers[issuerURL] = oidc.NewVerifier(issuerURL, keySet, oidcConfig)
+	}
 
 	return nil
 }
 
+// verifierForToken returns the verifier of the OIDC provider issuing the token.
+func (e *oidcExtension) verifierForToken(raw string) (*oidc.IDTokenVerifier, error) {
+	if len(e.verifiers) == 1 {
+		for _, verifier := range e.verifiers {
+			return verifier, nil
+		}
+	}
+
+	// the issuer claim is read before the token is verified, by the verifier of the issuer
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("failed to verify token: malformed jwt")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify token: malformed jwt payload: %w", err)
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("failed to verify token: malformed jwt claims: %w", err)
+	}
+	verifier, ok := e.verifiers[claims.Issuer]
+	if !ok {
+		return nil, errUnknownIssuer
+	}
+	return verifier, nil
+}
+
 // authenticate checks whether the given context contains valid auth data. Successfully authenticated calls will always return a nil error and a context with the auth data.
 func (e *oidcExtension) authenticate(ctx context.Context, headers map[string][]string) (context.Context, error) {
 	metadata := client.NewMetadata(headers)
@@ -92,10 +149,17 @@ func (e *oidcExtension) authenticate(ctx context.Context, headers map[string][]s
 	}
 
 	raw := parts[1]
-	idToken, err := e.verifier.Verify(ctx, raw)
+	verifier, err := e.verifierForToken(raw)
+	if err != nil {
+		return ctx, err
+	}
+	idToken, err := verifier.Verify(ctx, raw)
 	if err != nil {
 		return ctx, fmt.Errorf("failed to verify token: %w", err)
 	}
+	if !containsAny(idToken.Audience, e.cfg.audiences()) {
+		return ctx, errUnexpectedAudience
+	}
 
 	claims := map[string]interface{}{}
 	if err = idToken.Claims(&claims); err != nil {
@@ -122,10 +186,35 @@ func (e *oidcExtension) authenticate(ctx context.Context, headers map[string][]s
 		raw:        raw,
 		subject:    subject,
 		membership: membership,
+		attributes: getAttributesFromClaims(claims, e.cfg.ClaimAttributes),
 	}
 	return client.NewContext(ctx, cl), nil
 }
 
+func containsAny(slice []string, values []string) bool {
+	for _, v := range values {
+		if contains(slice, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAttributesFromClaims returns the values of the claims mapped to attributes, the claims
+// missing from the token being skipped.
+func getAttributesFromClaims(claims map[string]interface{}, claimAttributes map[string]string) map[string]interface{} {
+	if len(claimAttributes) == 0 {
+		return nil
+	}
+	attributes := make(map[string]interface{}, len(claimAttributes))
+	for name, claim := range claimAttributes {
+		if v, ok := claims[claim]; ok {
+			attributes[name] = v
+		}
+	}
+	return attributes
+}
+
 func getSubjectFromClaims(claims map[string]interface{}, usernameClaim string, fallback string) (string, error) {
 	if len(usernameClaim) > 0 {
 		username, found := claims[usernameClaim]
@@ -169,7 +258,16 @@ func getGroupsFromClaims(claims map[string]interface{}, groupsClaim string) ([]s
 	return []string{}, nil
 }
 
-func getProviderForConfig(config *Config) (*oidc.Provider, error) {
+func getProviderForConfig(config *Config, issuerURL string) (*oidc.Provider, error) {
+	oidcContext, err := getClientContextForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return oidc.NewProvider(oidcContext, issuerURL)
+}
+
+// getClientContextForConfig returns a context holding the HTTP client requesting the OIDC providers.
+func getClientContextForConfig(config *Config) (context.Context, error) {
 	t := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -200,8 +298,7 @@ func getProviderForConfig(config *Config) (*oidc.Provider, error) {
 		Timeout:   5 * time.Second,
 		Transport: t,
 	}
-	oidcContext := oidc.ClientContext(context.Background(), client)
-	return oidc.NewProvider(oidcContext, config.IssuerURL)
+	return oidc.ClientContext(context.Background(), client), nil
 }
 
 func getIssuerCACertFromPath(path string) (*x509.Certificate, error) {
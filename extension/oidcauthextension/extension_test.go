@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.uber.org/zap"
 )
@@ -113,7 +114,7 @@ func TestOIDCProviderForConfigWithTLS(t *testing.T) {
 	}
 
 	// test
-	provider, err := getProviderForConfig(config)
+	provider, err := getProviderForConfig(config, config.IssuerURL)
 
 	// verify
 	assert.NoError(t, err)
@@ -186,7 +187,7 @@ func TestOIDCFailedToLoadIssuerCAFromPathInvalidContent(t *testing.T) {
 	}
 
 	// test
-	provider, err := getProviderForConfig(config) // cross test with getIssuerCACertFromPath
+	provider, err := getProviderForConfig(config, config.IssuerURL) // cross test with getIssuerCACertFromPath
 
 	// verify
 	assert.Error(t, err)
@@ -451,3 +452,146 @@ func TestShutdown(t *testing.T) {
 	// verify
 	assert.NoError(t, err)
 }
+
+func TestOIDCMultipleIssuersAndAudiences(t *testing.T) {
+	// prepare
+	servers := make([]*oidcServer, 2)
+	for i := range servers {
+		server, err := newOIDCServer()
+		require.NoError(t, err)
+		server.Start()
+		defer server.Close()
+		servers[i] = server
+	}
+
+	p, err := newExtension(&Config{
+		IssuerURL:  servers[0].URL,
+		IssuerURLs: []string{servers[1].URL},
+		Audience:   "unit-test",
+		Audiences:  []string{"tenant-a", "tenant-b"},
+		ClaimAttributes: map[string]string{
+			"tenant": "tenant_id",
+			"name":   "name",
+			"region": "region",
+		},
+	}, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	authenticate := func(server *oidcServer, claims map[string]interface{}) (context.Context, error) {
+		claims["iss"] = server.URL
+		claims["exp"] = time.Now().Add(time.Minute).Unix()
+		payload, _ := json.Marshal(claims)
+		token, err := server.token(payload)
+		require.NoError(t, err)
+		return p.Authenticate(context.Background(), map[string][]string{"authorization": {fmt.Sprintf("Bearer %s", token)}})
+	}
+
+	// test
+	ctx, err := authenticate(servers[1], map[string]interface{}{
+		"sub":       "jdoe@example.com",
+		"name":      "jdoe",
+		"aud":       []string{"other", "tenant-b"},
+		"tenant_id": "b",
+	})
+
+	// verify
+	require.NoError(t, err)
+	data := client.FromContext(ctx).Auth
+	assert.Equal(t, "jdoe@example.com", data.GetAttribute("subject"))
+	assert.Equal(t, "b", data.GetAttribute("tenant"))
+	assert.Equal(t, "jdoe", data.GetAttribute("name"))
+	assert.Nil(t, data.GetAttribute("region"))
+	assert.Equal(t, []string{"subject", "membership", "raw", "name", "tenant"}, data.GetAttributeNames())
+
+	_, err = authenticate(servers[0], map[string]interface{}{"sub": "jdoe@example.com", "aud": "unit-test"})
+	assert.NoError(t, err)
+
+	_, err = authenticate(servers[0], map[string]interface{}{"sub": "jdoe@example.com", "aud": "other"})
+	assert.Equal(t, errUnexpectedAudience, err)
+
+	// the token is signed by the first server, but claims to be issued by the second one
+	payload, _ := json.Marshal(map[string]interface{}{"sub": "jdoe@example.com", "aud": "unit-test", "iss": servers[1].URL, "exp": time.Now().Add(time.Minute).Unix()})
+	token, err := servers[0].token(payload)
+	require.NoError(t, err)
+	_, err = p.Authenticate(context.Background(), map[string][]string{"authorization": {fmt.Sprintf("Bearer %s", token)}})
+	assert.Error(t, err)
+
+	payload, _ = json.Marshal(map[string]interface{}{"sub": "jdoe@example.com", "aud": "unit-test", "iss": "https://unknown.example.com"})
+	token, err = servers[0].token(payload)
+	require.NoError(t, err)
+	_, err = p.Authenticate(context.Background(), map[string][]string{"authorization": {fmt.Sprintf("Bearer %s", token)}})
+	assert.Equal(t, errUnknownIssuer, err)
+}
+
+func TestOIDCReservedClaimAttribute(t *testing.T) {
+	p, err := newExtension(&Config{
+		IssuerURL:       "http://example.com/",
+		Audience:        "unit-test",
+		ClaimAttributes: map[string]string{"subject": "email"},
+	}, zap.NewNop())
+	assert.Nil(t, p)
+	assert.Equal(t, errReservedClaimAttribute, err)
+}
+
+func TestOIDCJWKSRefreshInterval(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	p, err := newExtension(&Config{
+		IssuerURL:           oidcServer.URL,
+		Audience:            "unit-test",
+		JWKSRefreshInterval: time.Hour,
+	}, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	payload, _ := json.Marshal(map[string]interface{}{
+		"sub": "jdoe@example.com",
+		"iss": oidcServer.URL,
+		"aud": "unit-test",
+		"exp": time.Now().Add(time.Minute).Unix(),
+	})
+	token, err := oidcServer.token(payload)
+	require.NoError(t, err)
+
+	// test
+	for i := 0; i < 3; i++ {
+		_, err = p.Authenticate(context.Background(), map[string][]string{"authorization": {fmt.Sprintf("Bearer %s", token)}})
+		require.NoError(t, err)
+	}
+
+	// verify
+	assert.Equal(t, int64(1), oidcServer.jwksRequests.Load())
+}
+
+func TestRefreshingKeySet(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	now := time.Now()
+	keySet := newRefreshingKeySet(context.Background(), oidcServer.URL+"/.well-known/jwks.json", time.Minute)
+	keySet.now = func() time.Time { return now }
+
+	payload, _ := json.Marshal(map[string]interface{}{"sub": "jdoe@example.com"})
+	token, err := oidcServer.token(payload)
+	require.NoError(t, err)
+
+	// test
+	_, err = keySet.VerifySignature(context.Background(), token)
+	require.NoError(t, err)
+	_, err = keySet.VerifySignature(context.Background(), token)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, err = keySet.VerifySignature(context.Background(), token)
+	require.NoError(t, err)
+
+	// verify
+	assert.Equal(t, int64(2), oidcServer.jwksRequests.Load())
+}
@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oidcauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oidcauthextension"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc"
+)
+
+// refreshingKeySet verifies the signatures of the tokens with the keys of a JWKS endpoint, which
+// are fetched again once they are older than the refresh interval.
+type refreshingKeySet struct {
+	// ctx holds the HTTP client fetching the keys
+	ctx      context.Context
+	jwksURL  string
+	interval time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	keySet  oidc.KeySet
+	created time.Time
+}
+
+var _ oidc.KeySet = (*refreshingKeySet)(nil)
+
+func newRefreshingKeySet(ctx context.Context, jwksURL string, interval time.Duration) *refreshingKeySet {
+	return &refreshingKeySet{
+		ctx:      ctx,
+		jwksURL:  jwksURL,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+func (k *refreshingKeySet) VerifySignature(ctx context.Context, jwt string) ([]byte, error) {
+	k.mu.Lock()
+	if k.keySet == nil || k.now().Sub(k.created) >= k.interval {
+		// the remote key set fetches its keys when it first verifies a signature
+		k.keySet = oidc.NewRemoteKeySet(k.ctx, k.jwksURL)
+		k.created = k.now()
+	}
+	keySet := k.keySet
+	k.mu.Unlock()
+
+	return keySet.VerifySignature(ctx, jwt)
+}
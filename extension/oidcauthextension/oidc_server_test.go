@@ -19,6 +19,7 @@ import (
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"time"
 )
 
@@ -28,10 +29,13 @@ type oidcServer struct {
 	*httptest.Server
 	x509Cert   []byte
 	privateKey *rsa.PrivateKey
+	// jwksRequests is the number of requests of the keys
+	jwksRequests *atomic.Int64
 }
 
 func newOIDCServer() (*oidcServer, error) {
 	jwks := map[string]interface{}{}
+	jwksRequests := &atomic.Int64{}
 
 	mux := http.NewServeMux()
 	server := httptest.NewUnstartedServer(mux)
@@ -48,6 +52,7 @@ func newOIDCServer() (*oidcServer, error) {
 		}
 	})
 	mux.HandleFunc("/.well-known/jwks.json", func(w http.ResponseWriter, req *http.Request) {
+		jwksRequests.Add(1)
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		if err := json.NewEncoder(w).Encode(jwks); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -82,7 +87,7 @@ func newOIDCServer() (*oidcServer, error) {
 		"x5t": base64.RawURLEncoding.EncodeToString(sum[:]),
 	}}
 
-	return &oidcServer{server, x509Cert, privateKey}, nil
+	return &oidcServer{server, x509Cert, privateKey, jwksRequests}, nil
 }
 
 func (s *oidcServer) token(jsonPayload []byte) (string, error) {
@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// capturePath is the path of the endpoint capturing profiles to files
+	capturePath = "/debug/pprof/capture"
+	// cpuProfile is the name of the CPU profile, the other profiles being the pprof.Profile names
+	cpuProfile = "cpu"
+	// captureTimeFormat is the format of the time of the capture in the profile filenames
+	captureTimeFormat     = "20060102T150405.000Z"
+	captureFileExtension  = ".pprof"
+	defaultCaptureSeconds = 30
+	defaultMaxDuration    = 5 * time.Minute
+)
+
+// profileCapturer captures the profiles requested by the HTTP requests to files of a
+// directory, from which the oldest profiles are removed.
+type profileCapturer struct {
+	config CapturesConfig
+	logger *zap.Logger
+	now    func() time.Time
+
+	// mu is held while the old profiles are removed
+	mu sync.Mutex
+}
+
+var _ http.Handler = (*profileCapturer)(nil)
+
+func newProfileCapturer(config CapturesConfig, logger *zap.Logger) *profileCapturer {
+	if config.MaxDuration == 0 {
+		config.MaxDuration = defaultMaxDuration
+	}
+	return &profileCapturer{
+		config: config,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// ServeHTTP captures the profile of the profile query parameter, the CPU profile by default,
+// during the seconds query parameter for the CPU profile, and responds with the file the
+// profile is written to.
+func (c *profileCapturer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "profiles are captured by POST requests", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := r.URL.Query().Get("profile")
+	if name == "" {
+		name = cpuProfile
+	}
+	if name != cpuProfile && pprof.Lookup(name) == nil {
+		http.Error(w, fmt.Sprintf("unknown profile %q", name), http.StatusBadRequest)
+		return
+	}
+	duration := defaultCaptureSeconds * time.Second
+	if s := r.URL.Query().Get("seconds"); s != "" {
+		seconds, err := strconv.Atoi(s)
+		if err != nil || seconds <= 0 {
+			http.Error(w, fmt.Sprintf("invalid seconds %q", s), http.StatusBadRequest)
+			return
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+	if duration > c.config.MaxDuration {
+		duration = c.config.MaxDuration
+	}
+
+	path, status, err := c.capture(r, name, duration)
+	if err != nil {
+		c.logger.Warn("Failed to capture profile", zap.String("profile", name), zap.Error(err))
+		http.Error(w, err.Error(), status)
+		return
+	}
+	c.logger.Info("Captured profile", zap.String("profile", name), zap.String("file", path))
+	c.removeOldProfiles()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"profile": name, "file": path})
+}
+
+// capture writes the profile to a new file of the directory, and returns the HTTP status
+// of the error when it fails.
+func (c *profileCapturer) capture(r *http.Request, name string, duration time.Duration) (string, int, error) {
+	path := filepath.Join(c.config.Directory, name+"-"+c.now().UTC().Format(captureTimeFormat)+captureFileExtension)
+	f, err := os.OpenFile(filepath.Clean(path), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return "", http.StatusInternalServerError, err
+	}
+
+	status, err := writeProfile(r, f, name, duration)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		status, err = http.StatusInternalServerError, closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return "", status, err
+	}
+	return path, http.StatusOK, nil
+}
+
+func writeProfile(r *http.Request, f *os.File, name string, duration time.Duration) (int, error) {
+	if name != cpuProfile {
+		if err := pprof.Lookup(name).WriteTo(f, 0); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		return http.StatusOK, nil
+	}
+
+	// a single CPU profile can be running per process, including the one of save_to_file
+	if err := pprof.StartCPUProfile(f); err != nil {
+		return http.StatusConflict, err
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-r.Context().Done():
+	}
+	pprof.StopCPUProfile()
+	return http.StatusOK, nil
+}
+
+// removeOldProfiles removes the captured profiles older than MaxDays, and the oldest profiles
+// beyond MaxBackups.
+func (c *profileCapturer) removeOldProfiles() {
+	if c.config.MaxDays == 0 && c.config.MaxBackups == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries, err := os.ReadDir(c.config.Directory)
+	if err != nil {
+		c.logger.Warn("Failed to list captured profiles", zap.Error(err))
+		return
+	}
+	type profileFile struct {
+		name string
+		time time.Time
+	}
+	var files []profileFile
+	for _, entry := range entries {
+		if t, ok := captureTime(entry.Name()); ok && !entry.IsDir() {
+			files = append(files, profileFile{name: entry.Name(), time: t})
+		}
+	}
+	// newest first
+	sort.Slice(files, func(i, j int) bool { return files[i].time.After(files[j].time) })
+
+	cutoff := c.now().Add(-time.Duration(c.config.MaxDays) * 24 * time.Hour)
+	for i, file := range files {
+		if (c.config.MaxBackups == 0 || i < c.config.MaxBackups) && (c.config.MaxDays == 0 || file.time.After(cutoff)) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.config.Directory, file.name)); err != nil {
+			c.logger.Warn("Failed to remove captured profile", zap.String("file", file.name), zap.Error(err))
+		}
+	}
+}
+
+// captureTime returns the time of the capture encoded in the filename of a captured profile,
+// and false for the other files.
+func captureTime(filename string) (time.Time, bool) {
+	if !strings.HasSuffix(filename, captureFileExtension) {
+		return time.Time{}, false
+	}
+	base := strings.TrimSuffix(filename, captureFileExtension)
+	i := strings.LastIndex(base, "-")
+	if i < 0 {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(captureTimeFormat, base[i+1:])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofextension
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func capture(t *testing.T, c *profileCapturer, method string, query string) (int, map[string]string) {
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, httptest.NewRequest(method, capturePath+query, nil))
+	var body map[string]string
+	if rec.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	}
+	return rec.Code, body
+}
+
+func TestProfileCapturer(t *testing.T) {
+	c := newProfileCapturer(CapturesConfig{Directory: t.TempDir()}, zap.NewNop())
+
+	code, body := capture(t, c, http.MethodPost, "?profile=heap")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "heap", body["profile"])
+	info, err := os.Stat(body["file"])
+	require.NoError(t, err)
+	assert.Greater(t, info.Size(), int64(0))
+
+	code, body = capture(t, c, http.MethodPost, "?seconds=1")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "cpu", body["profile"])
+	assert.FileExists(t, body["file"])
+
+	code, _ = capture(t, c, http.MethodGet, "?profile=heap")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+	code, _ = capture(t, c, http.MethodPost, "?profile=unknown")
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, _ = capture(t, c, http.MethodPost, "?seconds=-1")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestProfileCapturerCPUProfileRunning(t *testing.T) {
+	dir := t.TempDir()
+	c := newProfileCapturer(CapturesConfig{Directory: dir}, zap.NewNop())
+
+	require.NoError(t, pprof.StartCPUProfile(io.Discard))
+	code, _ := capture(t, c, http.MethodPost, "?seconds=1")
+	pprof.StopCPUProfile()
+	assert.Equal(t, http.StatusConflict, code)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestProfileCapturerRemoveOldProfiles(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+	for _, name := range []string{
+		"heap-20230801T110000.000Z.pprof",
+		"cpu-20230801T100000.000Z.pprof",
+		"cpu-20230729T100000.000Z.pprof",
+		"goroutine-20230731T100000.000Z.pprof",
+		"other.txt",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+
+	c := newProfileCapturer(CapturesConfig{Directory: dir, MaxDays: 2, MaxBackups: 3}, zap.NewNop())
+	c.now = func() time.Time { return now }
+	code, body := capture(t, c, http.MethodPost, "?profile=goroutine")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, filepath.Join(dir, "goroutine-20230801T120000.000Z.pprof"), body["file"])
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{
+		"cpu-20230801T100000.000Z.pprof",
+		"goroutine-20230801T120000.000Z.pprof",
+		"heap-20230801T110000.000Z.pprof",
+		"other.txt",
+	}, names)
+}
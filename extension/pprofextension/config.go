@@ -4,6 +4,9 @@
 package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
 
 import (
+	"errors"
+	"time"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
 )
@@ -29,11 +32,43 @@ type Config struct {
 	// Optional file name to save the CPU profile to. The profiling starts when the
 	// Collector starts and is saved to the file when the Collector is terminated.
 	SaveToFile string `mapstructure:"save_to_file"`
+
+	// Optional settings of the profiles captured on demand, by POST requests to
+	// /debug/pprof/capture, and written to files in a directory.
+	Captures *CapturesConfig `mapstructure:"captures"`
+}
+
+// CapturesConfig has the configuration of the profiles captured on demand.
+type CapturesConfig struct {
+	// Directory is the directory the captured profiles are written to.
+	Directory string `mapstructure:"directory"`
+
+	// MaxDuration is the maximum duration of a CPU profile capture. It defaults
+	// to 5 minutes.
+	MaxDuration time.Duration `mapstructure:"max_duration"`
+
+	// MaxDays is the maximum number of days to retain the captured profiles based
+	// on the timestamp encoded in their filename. The default is not to remove
+	// profiles based on age.
+	MaxDays int `mapstructure:"max_days"`
+
+	// MaxBackups is the maximum number of captured profiles to retain. The default
+	// is to retain all of them.
+	MaxBackups int `mapstructure:"max_backups"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.Captures == nil {
+		return nil
+	}
+	if cfg.Captures.Directory == "" {
+		return errors.New("\"directory\" is required when captures are configured")
+	}
+	if cfg.Captures.MaxDuration < 0 || cfg.Captures.MaxDays < 0 || cfg.Captures.MaxBackups < 0 {
+		return errors.New("\"max_duration\", \"max_days\" and \"max_backups\" of captures must not be negative")
+	}
 	return nil
 }
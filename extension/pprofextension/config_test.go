@@ -6,6 +6,7 @@ package pprofextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,18 @@ func TestLoadConfig(t *testing.T) {
 				MutexProfileFraction: 5,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "captures"),
+			expected: &Config{
+				TCPAddr: confignet.TCPAddr{Endpoint: "localhost:1777"},
+				Captures: &CapturesConfig{
+					Directory:   "/var/lib/otelcol/profiles",
+					MaxDuration: time.Minute,
+					MaxDays:     7,
+					MaxBackups:  20,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -51,3 +64,15 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Captures = &CapturesConfig{}
+	assert.Error(t, component.ValidateConfig(cfg))
+
+	cfg.Captures = &CapturesConfig{Directory: "profiles", MaxBackups: -1}
+	assert.Error(t, component.ValidateConfig(cfg))
+
+	cfg.Captures = &CapturesConfig{Directory: "profiles"}
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
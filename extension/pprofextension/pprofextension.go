@@ -54,6 +54,18 @@ func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
 		return startErr
 	}
 
+	if p.config.Captures != nil {
+		if startErr = os.MkdirAll(p.config.Captures.Directory, 0o750); startErr != nil {
+			_ = ln.Close()
+			return startErr
+		}
+		// the capture endpoint is served along the net/http/pprof endpoints
+		mux := http.NewServeMux()
+		mux.Handle(capturePath, newProfileCapturer(*p.config.Captures, p.logger))
+		mux.Handle("/", http.DefaultServeMux)
+		p.server.Handler = mux
+	}
+
 	runtime.SetBlockProfileRate(p.config.BlockProfileFraction)
 	runtime.SetMutexProfileFraction(p.config.MutexProfileFraction)
 
@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 
@@ -131,3 +132,33 @@ func TestPerformanceProfilerLifecycleWithFile(t *testing.T) {
 	require.NoError(t, pprofExt.Start(context.Background(), componenttest.NewNopHost()))
 	require.NoError(t, pprofExt.Shutdown(context.Background()))
 }
+
+func TestPerformanceProfilerCaptures(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "profiles")
+	config := Config{
+		TCPAddr: confignet.TCPAddr{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		Captures: &CapturesConfig{Directory: dir},
+	}
+
+	pprofExt := newServer(config, zap.NewNop())
+	require.NoError(t, pprofExt.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, pprofExt.Shutdown(context.Background())) })
+
+	client := &http.Client{}
+	resp, err := client.Post("http://"+config.TCPAddr.Endpoint+"/debug/pprof/capture?profile=heap", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// the net/http/pprof endpoints are still served
+	resp, err = client.Get("http://" + config.TCPAddr.Endpoint + "/debug/pprof")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
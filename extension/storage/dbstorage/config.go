@@ -5,12 +5,29 @@ package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-c
 
 import (
 	"errors"
+	"time"
 )
 
 // Config defines configuration for dbstorage extension.
 type Config struct {
 	DriverName string `mapstructure:"driver,omitempty"`
 	DataSource string `mapstructure:"datasource,omitempty"`
+
+	// ConnectionPool limits the connections opened to the database
+	ConnectionPool ConnectionPoolConfig `mapstructure:"connection_pool,omitempty"`
+}
+
+// ConnectionPoolConfig defines the limits of the database connection pool.
+// Zero values keep the database/sql defaults.
+type ConnectionPoolConfig struct {
+	// MaxOpen is the maximum number of open connections to the database
+	MaxOpen int `mapstructure:"max_open,omitempty"`
+	// MaxIdle is the maximum number of connections kept in the idle pool
+	MaxIdle int `mapstructure:"max_idle,omitempty"`
+	// MaxLifetime is the maximum amount of time a connection may be reused
+	MaxLifetime time.Duration `mapstructure:"max_lifetime,omitempty"`
+	// MaxIdleTime is the maximum amount of time a connection may be idle
+	MaxIdleTime time.Duration `mapstructure:"max_idle_time,omitempty"`
 }
 
 func (cfg *Config) Validate() error {
@@ -21,5 +38,13 @@ func (cfg *Config) Validate() error {
 		return errors.New("missing driver name")
 	}
 
+	pool := cfg.ConnectionPool
+	if pool.MaxOpen < 0 || pool.MaxIdle < 0 || pool.MaxLifetime < 0 || pool.MaxIdleTime < 0 {
+		return errors.New("connection pool limits must not be negative")
+	}
+	if pool.MaxOpen > 0 && pool.MaxIdle > pool.MaxOpen {
+		return errors.New("connection_pool::max_idle must not exceed connection_pool::max_open")
+	}
+
 	return nil
 }
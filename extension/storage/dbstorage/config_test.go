@@ -10,6 +10,7 @@ package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-c
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,11 +31,26 @@ func TestConfigValidate(t *testing.T) {
 			Config{DriverName: "foo"},
 			errors.New("missing datasource"),
 		},
+		{
+			"Negative connection pool limit",
+			Config{DriverName: "foo", DataSource: "bar", ConnectionPool: ConnectionPoolConfig{MaxLifetime: -time.Second}},
+			errors.New("connection pool limits must not be negative"),
+		},
+		{
+			"More idle than open connections",
+			Config{DriverName: "foo", DataSource: "bar", ConnectionPool: ConnectionPoolConfig{MaxOpen: 2, MaxIdle: 3}},
+			errors.New("connection_pool::max_idle must not exceed connection_pool::max_open"),
+		},
 		{
 			"valid",
 			Config{DriverName: "foo", DataSource: "bar"},
 			nil,
 		},
+		{
+			"valid with connection pool",
+			Config{DriverName: "foo", DataSource: "bar", ConnectionPool: ConnectionPoolConfig{MaxOpen: 10, MaxIdle: 5, MaxIdleTime: time.Minute}},
+			nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	driverSQLite   = "sqlite3"
+	driverPostgres = "pgx"
+
+	// schemaTable records which migrations have been applied to each storage table
+	schemaTable = "otel_storage_schema"
+)
+
+// dialect holds the driver specific statements used by the storage client.
+// Statements containing %s are formatted with the name of the storage table.
+type dialect struct {
+	getQuery    string
+	setQuery    string
+	deleteQuery string
+
+	createSchemaTable string
+	getVersion        string
+	setVersion        string
+	// lockTable is optional and serializes migrations of a table across
+	// collectors sharing the same database
+	lockTable string
+
+	// migrations are applied in order, each exactly once per storage table
+	migrations []string
+}
+
+var sqliteDialect = dialect{
+	getQuery:    "select value from %s where key=?",
+	setQuery:    "insert into %s(key, value) values(?,?) on conflict(key) do update set value=excluded.value",
+	deleteQuery: "delete from %s where key=?",
+
+	createSchemaTable: "create table if not exists " + schemaTable + " (table_name text primary key, version integer not null)",
+	getVersion:        "select version from " + schemaTable + " where table_name=?",
+	setVersion:        "insert into " + schemaTable + "(table_name, version) values(?,?) on conflict(table_name) do update set version=excluded.version",
+
+	migrations: []string{
+		"create table if not exists %s (key text primary key, value blob)",
+	},
+}
+
+var postgresDialect = dialect{
+	getQuery:    "select value from %s where key=$1",
+	setQuery:    "insert into %s(key, value) values($1,$2) on conflict(key) do update set value=excluded.value",
+	deleteQuery: "delete from %s where key=$1",
+
+	createSchemaTable: "create table if not exists " + schemaTable + " (table_name text primary key, version integer not null)",
+	getVersion:        "select version from " + schemaTable + " where table_name=$1",
+	setVersion:        "insert into " + schemaTable + "(table_name, version) values($1,$2) on conflict(table_name) do update set version=excluded.version",
+	lockTable:         "select pg_advisory_xact_lock(hashtext($1))",
+
+	migrations: []string{
+		"create table if not exists %s (key text primary key, value bytea)",
+	},
+}
+
+// dialectFor returns the dialect matching the driver. Drivers without a
+// dedicated dialect use the sqlite one, which relies on standard SQL with
+// '?' placeholders.
+func dialectFor(driverName string) dialect {
+	if driverName == driverPostgres {
+		return postgresDialect
+	}
+	return sqliteDialect
+}
+
+// migrate brings the storage table up to date with the dialect migrations.
+func (d dialect) migrate(ctx context.Context, db *sql.DB, tableName string) error {
+	if _, err := db.ExecContext(ctx, d.createSchemaTable); err != nil {
+		return fmt.Errorf("failed to create schema table: %w", err)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if d.lockTable != "" {
+		if _, err = tx.ExecContext(ctx, d.lockTable, tableName); err != nil {
+			return fmt.Errorf("failed to lock table %s: %w", tableName, err)
+		}
+	}
+
+	var version int
+	err = tx.QueryRowContext(ctx, d.getVersion, tableName).Scan(&version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to read schema version of table %s: %w", tableName, err)
+	}
+	if version > len(d.migrations) {
+		return fmt.Errorf("table %s has schema version %d, newer than the supported version %d", tableName, version, len(d.migrations))
+	}
+	if version == len(d.migrations) {
+		return nil
+	}
+
+	for i := version; i < len(d.migrations); i++ {
+		if _, err = tx.ExecContext(ctx, fmt.Sprintf(d.migrations[i], tableName)); err != nil {
+			return fmt.Errorf("failed to apply migration %d to table %s: %w", i+1, tableName, err)
+		}
+	}
+	if _, err = tx.ExecContext(ctx, d.setVersion, tableName, len(d.migrations)); err != nil {
+		return fmt.Errorf("failed to update schema version of table %s: %w", tableName, err)
+	}
+	return tx.Commit()
+}
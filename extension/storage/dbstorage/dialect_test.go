@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func TestDialectFor(t *testing.T) {
+	assert.Equal(t, postgresDialect, dialectFor("pgx"))
+	assert.Equal(t, sqliteDialect, dialectFor("sqlite3"))
+	assert.Equal(t, sqliteDialect, dialectFor("other"))
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	d := sqliteDialect
+	d.migrations = append(d.migrations[:len(d.migrations):len(d.migrations)], "create index if not exists %[1]s_value on %[1]s (value)")
+
+	require.NoError(t, d.migrate(ctx, db, "receiver_nop_migrate"))
+	assert.Equal(t, len(d.migrations), schemaVersion(t, db, "receiver_nop_migrate"))
+
+	// Already migrated tables are left untouched
+	require.NoError(t, d.migrate(ctx, db, "receiver_nop_migrate"))
+	assert.Equal(t, len(d.migrations), schemaVersion(t, db, "receiver_nop_migrate"))
+
+	// Tables created by a newer version of the schema are rejected
+	err := sqliteDialect.migrate(ctx, db, "receiver_nop_migrate")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "newer than the supported version")
+}
+
+func TestMigrateFailure(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	d := sqliteDialect
+	d.migrations = append(d.migrations[:len(d.migrations):len(d.migrations)], "not valid sql")
+
+	require.Error(t, d.migrate(ctx, db, "receiver_nop_broken"))
+
+	// A failed migration is rolled back entirely
+	assert.Equal(t, 0, schemaVersion(t, db, "receiver_nop_broken"))
+	var count int
+	require.NoError(t, db.QueryRow("select count(*) from sqlite_master where name='receiver_nop_broken'").Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func TestClientBatchIsTransactional(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	client, err := newClient(ctx, db, sqliteDialect, "receiver_nop_batch")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close(ctx))
+	}()
+
+	require.NoError(t, client.Set(ctx, "key", []byte("before")))
+
+	badOp := storage.DeleteOperation("other")
+	badOp.Type = 100
+	require.Error(t, client.Batch(ctx, storage.SetOperation("key", []byte("after")), badOp))
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("before"), value)
+
+	getOp := storage.GetOperation("key")
+	require.NoError(t, client.Batch(ctx, storage.SetOperation("key", []byte("after")), getOp))
+	assert.Equal(t, []byte("after"), getOp.Value)
+}
+
+func newTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func schemaVersion(t *testing.T, db *sql.DB, tableName string) int {
+	var version int
+	err := db.QueryRow("select version from "+schemaTable+" where table_name=?", tableName).Scan(&version)
+	if err == sql.ErrNoRows {
+		return 0
+	}
+	require.NoError(t, err)
+	return version
+}
@@ -18,6 +18,8 @@ import (
 type databaseStorage struct {
 	driverName     string
 	datasourceName string
+	pool           ConnectionPoolConfig
+	dialect        dialect
 	logger         *zap.Logger
 	db             *sql.DB
 }
@@ -29,6 +31,8 @@ func newDBStorage(logger *zap.Logger, config *Config) (extension.Extension, erro
 	return &databaseStorage{
 		driverName:     config.DriverName,
 		datasourceName: config.DataSource,
+		pool:           config.ConnectionPool,
+		dialect:        dialectFor(config.DriverName),
 		logger:         logger,
 	}, nil
 }
@@ -40,7 +44,21 @@ func (ds *databaseStorage) Start(context.Context, component.Host) error {
 		return err
 	}
 
+	if ds.pool.MaxOpen > 0 {
+		db.SetMaxOpenConns(ds.pool.MaxOpen)
+	}
+	if ds.pool.MaxIdle > 0 {
+		db.SetMaxIdleConns(ds.pool.MaxIdle)
+	}
+	if ds.pool.MaxLifetime > 0 {
+		db.SetConnMaxLifetime(ds.pool.MaxLifetime)
+	}
+	if ds.pool.MaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(ds.pool.MaxIdleTime)
+	}
+
 	if err := db.Ping(); err != nil {
+		_ = db.Close()
 		return err
 	}
 	ds.db = db
@@ -64,7 +82,7 @@ func (ds *databaseStorage) GetClient(ctx context.Context, kind component.Kind, e
 		fullName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
 	}
 	fullName = strings.ReplaceAll(fullName, " ", "")
-	return newClient(ctx, ds.db, fullName)
+	return newClient(ctx, ds.db, ds.dialect, fullName)
 }
 
 func kindString(k component.Kind) string {
@@ -101,11 +101,56 @@ func TestExtensionIntegrity(t *testing.T) {
 	wg.Wait()
 }
 
-func newTestExtension(t *testing.T) storage.Extension {
+func TestExtensionConnectionPool(t *testing.T) {
+	se := newTestExtension(t, func(cfg *Config) {
+		cfg.ConnectionPool = ConnectionPoolConfig{MaxOpen: 3, MaxIdle: 2}
+	})
+	require.NoError(t, se.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, se.Shutdown(context.Background()))
+	}()
+
+	assert.Equal(t, 3, se.(*databaseStorage).db.Stats().MaxOpenConnections)
+}
+
+func TestExtensionClientsShareDatabase(t *testing.T) {
+	ctx := context.Background()
+	dataSource := fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir())
+	withDataSource := func(cfg *Config) {
+		cfg.DataSource = dataSource
+	}
+
+	// Two collectors pointed at the same database see each other's state
+	first := newTestExtension(t, withDataSource)
+	second := newTestExtension(t, withDataSource)
+	for _, se := range []storage.Extension{first, second} {
+		require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	}
+	defer func() {
+		for _, se := range []storage.Extension{first, second} {
+			require.NoError(t, se.Shutdown(ctx))
+		}
+	}()
+
+	firstClient, err := first.GetClient(ctx, component.KindReceiver, newTestEntity("shared"), "")
+	require.NoError(t, err)
+	secondClient, err := second.GetClient(ctx, component.KindReceiver, newTestEntity("shared"), "")
+	require.NoError(t, err)
+
+	require.NoError(t, firstClient.Set(ctx, "checkpoint", []byte("42")))
+	value, err := secondClient.Get(ctx, "checkpoint")
+	require.NoError(t, err)
+	require.Equal(t, []byte("42"), value)
+}
+
+func newTestExtension(t *testing.T, modify ...func(*Config)) storage.Extension {
 	f := NewFactory()
 	cfg := f.CreateDefaultConfig().(*Config)
 	cfg.DriverName = "sqlite3"
 	cfg.DataSource = fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir())
+	for _, m := range modify {
+		m(cfg)
+	}
 
 	extension, err := f.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
 	require.NoError(t, err)
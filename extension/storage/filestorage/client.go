@@ -8,11 +8,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"syscall"
 	"time"
 
 	"go.etcd.io/bbolt"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.uber.org/zap"
 )
@@ -35,6 +38,8 @@ type fileStorageClient struct {
 	openTimeout     time.Duration
 	cancel          context.CancelFunc
 	closed          bool
+	lastCompaction  time.Time
+	metricTags      []tag.Mutator
 }
 
 func bboltOptions(timeout time.Duration) *bbolt.Options {
@@ -62,8 +67,16 @@ func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compa
 		return nil, err
 	}
 
-	client := &fileStorageClient{logger: logger, db: db, compactionCfg: compactionCfg, openTimeout: timeout}
-	if compactionCfg.OnRebound {
+	client := &fileStorageClient{
+		logger:         logger,
+		db:             db,
+		compactionCfg:  compactionCfg,
+		openTimeout:    timeout,
+		lastCompaction: time.Now(),
+		metricTags:     []tag.Mutator{tag.Upsert(tagDatabaseKey, filepath.Base(filePath))},
+	}
+	client.recordDbSize()
+	if compactionCfg.CheckInterval > 0 {
 		client.startCompactionLoop(context.Background())
 	}
 
@@ -230,9 +243,16 @@ func (c *fileStorageClient) Compact(compactionDirectory string, timeout time.Dur
 		return fmt.Errorf("failed to move compacted database, compaction aborted: %w", moveErr)
 	}
 
+	elapsed := time.Since(compactionStart)
+	c.lastCompaction = time.Now()
+	_ = stats.RecordWithTags(context.Background(), c.metricTags,
+		mCompactions.M(1),
+		mCompactionDuration.M(float64(elapsed)/float64(time.Millisecond)))
+	c.recordDbSizeLocked()
+
 	c.logger.Info("finished compaction",
 		zap.String(directoryKey, dbPath),
-		zap.Duration(elapsedKey, time.Since(compactionStart)))
+		zap.Duration(elapsedKey, elapsed))
 
 	return nil
 }
@@ -251,6 +271,7 @@ func (c *fileStorageClient) startCompactionLoop(ctx context.Context) {
 		for {
 			select {
 			case <-compactionTicker.C:
+				c.recordDbSize()
 				if c.shouldCompact() {
 					err := c.Compact(c.compactionCfg.Directory, c.openTimeout, c.compactionCfg.MaxTransactionSize)
 					if err != nil {
@@ -269,6 +290,12 @@ func (c *fileStorageClient) startCompactionLoop(ctx context.Context) {
 
 // shouldCompact checks whether the conditions for online compaction are met
 func (c *fileStorageClient) shouldCompact() bool {
+	if c.compactionCfg.Interval > 0 && c.timeSinceLastCompaction() >= c.compactionCfg.Interval {
+		c.logger.Debug("shouldCompact returns true, compaction interval elapsed",
+			zap.Duration("interval", c.compactionCfg.Interval))
+		return true
+	}
+
 	if !c.compactionCfg.OnRebound {
 		return false
 	}
@@ -295,6 +322,34 @@ func (c *fileStorageClient) shouldCompact() bool {
 	return true
 }
 
+func (c *fileStorageClient) timeSinceLastCompaction() time.Duration {
+	c.compactionMutex.RLock()
+	defer c.compactionMutex.RUnlock()
+	return time.Since(c.lastCompaction)
+}
+
+// recordDbSize records the size of the database
+func (c *fileStorageClient) recordDbSize() {
+	c.compactionMutex.RLock()
+	defer c.compactionMutex.RUnlock()
+	c.recordDbSizeLocked()
+}
+
+// recordDbSizeLocked records the size of the database, it must be called while holding compactionMutex
+func (c *fileStorageClient) recordDbSizeLocked() {
+	if c.closed {
+		return
+	}
+
+	totalSize, dataSize, err := c.getDbSize()
+	if err != nil {
+		c.logger.Debug("failed to get db size", zap.Error(err))
+		return
+	}
+
+	_ = stats.RecordWithTags(context.Background(), c.metricTags, mDbTotalSize.M(totalSize), mDbDataSize.M(dataSize))
+}
+
 func (c *fileStorageClient) getDbSize() (totalSizeResult int64, dataSizeResult int64, errResult error) {
 	var totalSize int64
 
@@ -334,6 +334,41 @@ func TestClientReboundCompaction(t *testing.T) {
 	}
 }
 
+func TestClientIntervalCompaction(t *testing.T) {
+	tempDir := t.TempDir()
+	dbFile := filepath.Join(tempDir, "my_db")
+
+	client, err := newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{
+		Directory:     tempDir,
+		Interval:      100 * time.Millisecond,
+		CheckInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(context.TODO()))
+	})
+
+	client.compactionMutex.RLock()
+	created := client.lastCompaction
+	client.compactionMutex.RUnlock()
+
+	require.Eventually(t,
+		func() bool {
+			client.compactionMutex.RLock()
+			defer client.compactionMutex.RUnlock()
+			return client.lastCompaction.After(created)
+		},
+		5*time.Second, 10*time.Millisecond, "compaction should happen once the interval elapsed",
+	)
+
+	// data must survive the compaction
+	ctx := context.Background()
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
+
 func TestClientConcurrentCompaction(t *testing.T) {
 	logCore, logObserver := observer.New(zap.DebugLevel)
 	logger := zap.New(logCore)
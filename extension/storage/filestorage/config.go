@@ -30,6 +30,9 @@ type CompactionConfig struct {
 	// so compaction should be relatively fast and at the same time there is relatively large volume of space
 	// that might be reclaimed.
 	OnRebound bool `mapstructure:"on_rebound,omitempty"`
+	// Interval specifies that compaction is attempted online periodically, regardless of the rebound conditions.
+	// This is useful for workloads with constant churn, where the rebound conditions are never met.
+	Interval time.Duration `mapstructure:"interval,omitempty"`
 	// Directory specifies where the temporary files for compaction will be stored
 	Directory string `mapstructure:"directory,omitempty"`
 	// ReboundNeededThresholdMiB specifies the minimum total allocated size (both used and empty)
@@ -76,5 +79,13 @@ func (cfg *Config) Validate() error {
 		return errors.New("compaction check interval must be positive when rebound compaction is set")
 	}
 
+	if cfg.Compaction.Interval < 0 {
+		return errors.New("compaction interval cannot be negative")
+	}
+
+	if cfg.Compaction.Interval > 0 && cfg.Compaction.CheckInterval <= 0 {
+		return errors.New("compaction check interval must be positive when interval compaction is set")
+	}
+
 	return nil
 }
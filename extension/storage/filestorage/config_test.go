@@ -41,6 +41,7 @@ func TestLoadConfig(t *testing.T) {
 					Directory:                  ".",
 					OnStart:                    true,
 					OnRebound:                  true,
+					Interval:                   24 * time.Hour,
 					MaxTransactionSize:         2048,
 					ReboundTriggerThresholdMiB: 16,
 					ReboundNeededThresholdMiB:  128,
@@ -93,3 +94,24 @@ func TestHandleProvidingFilePathAsDirWithAnError(t *testing.T) {
 	require.Error(t, err)
 	require.EqualError(t, err, file.Name()+" is not a directory")
 }
+
+func TestNegativeCompactionIntervalWithAnError(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = "."
+	cfg.Compaction.Interval = -time.Second
+
+	err := component.ValidateConfig(cfg)
+	require.EqualError(t, err, "compaction interval cannot be negative")
+}
+
+func TestCompactionIntervalRequiresCheckIntervalWithAnError(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = "."
+	cfg.Compaction.Interval = time.Hour
+	cfg.Compaction.CheckInterval = 0
+
+	err := component.ValidateConfig(cfg)
+	require.EqualError(t, err, "compaction check interval must be positive when interval compaction is set")
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
 
@@ -26,6 +27,7 @@ const (
 
 // NewFactory creates a factory for HostObserver extension.
 func NewFactory() extension.Factory {
+	_ = view.Register(MetricViews()...)
 	return extension.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
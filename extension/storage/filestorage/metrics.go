@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	tagDatabaseKey, _ = tag.NewKey("database")
+
+	mDbTotalSize        = stats.Int64("filestorage_db_total_size", "Total allocated size of the database file, including free pages", stats.UnitBytes)
+	mDbDataSize         = stats.Int64("filestorage_db_data_size", "Size of the database file used by data", stats.UnitBytes)
+	mCompactions        = stats.Int64("filestorage_compactions", "Number of compactions of the database file", stats.UnitDimensionless)
+	mCompactionDuration = stats.Float64("filestorage_compaction_duration", "Duration of compactions of the database file", stats.UnitMilliseconds)
+)
+
+// MetricViews returns the metric views of the file storage extension.
+func MetricViews() []*view.View {
+	tagKeys := []tag.Key{tagDatabaseKey}
+
+	return []*view.View{
+		{
+			Name:        mDbTotalSize.Name(),
+			Measure:     mDbTotalSize,
+			Description: mDbTotalSize.Description(),
+			TagKeys:     tagKeys,
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        mDbDataSize.Name(),
+			Measure:     mDbDataSize,
+			Description: mDbDataSize.Description(),
+			TagKeys:     tagKeys,
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        mCompactions.Name(),
+			Measure:     mCompactions,
+			Description: mCompactions.Description(),
+			TagKeys:     tagKeys,
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        mCompactionDuration.Name(),
+			Measure:     mCompactionDuration,
+			Description: mCompactionDuration.Description(),
+			TagKeys:     tagKeys,
+			Aggregation: view.Distribution(10, 50, 100, 500, 1000, 5000, 10000, 60000),
+		},
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+type redisStorageClient struct {
+	client     *redis.Client
+	prefix     string
+	expiration time.Duration
+}
+
+var _ storage.Client = (*redisStorageClient)(nil)
+
+func newClient(client *redis.Client, prefix string, expiration time.Duration) *redisStorageClient {
+	return &redisStorageClient{
+		client:     client,
+		prefix:     prefix,
+		expiration: expiration,
+	}
+}
+
+// Get will retrieve data from storage that corresponds to the specified key
+func (c *redisStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	if err := c.Batch(ctx, op); err != nil {
+		return nil, err
+	}
+	return op.Value, nil
+}
+
+// Set will store data. The data can be retrieved using the same key
+func (c *redisStorageClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+// Delete will delete data associated with the specified key
+func (c *redisStorageClient) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+// Batch executes the specified operations in order within a single transaction.
+// Get operation results are updated in place
+func (c *redisStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	if len(ops) == 0 {
+		return nil
+	}
+
+	cmds, err := c.client.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) error {
+		for _, op := range ops {
+			switch op.Type {
+			case storage.Get:
+				pipe.Get(c.prefix + op.Key)
+			case storage.Set:
+				pipe.Set(c.prefix+op.Key, op.Value, c.expiration)
+			case storage.Delete:
+				pipe.Del(c.prefix + op.Key)
+			default:
+				return errors.New("wrong operation type")
+			}
+		}
+		return nil
+	})
+	// A missing key is reported as redis.Nil, which is not an error for the storage API
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	for i, cmd := range cmds {
+		if err := cmd.Err(); err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+		if ops[i].Type == storage.Get {
+			ops[i].Value = nil
+			if val, err := cmd.(*redis.StringCmd).Bytes(); err == nil {
+				ops[i].Value = val
+			}
+		}
+	}
+	return nil
+}
+
+// Close is a no-op; the underlying connection is owned by the extension
+func (c *redisStorageClient) Close(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func TestClientOperations(t *testing.T) {
+	ctx := context.Background()
+	se, _ := newTestExtension(t, nil)
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("ops"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(ctx))
+	})
+
+	testKey := "testKey"
+	testValue := []byte("testValue")
+
+	// Make sure nothing is there
+	value, err := client.Get(ctx, testKey)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// Set it
+	require.NoError(t, client.Set(ctx, testKey, testValue))
+
+	// Get it back out, make sure it's right
+	value, err = client.Get(ctx, testKey)
+	require.NoError(t, err)
+	require.Equal(t, testValue, value)
+
+	// Delete it
+	require.NoError(t, client.Delete(ctx, testKey))
+
+	// Make sure it's gone
+	value, err = client.Get(ctx, testKey)
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestClientBatchOperations(t *testing.T) {
+	ctx := context.Background()
+	se, _ := newTestExtension(t, nil)
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("batch"), "")
+	require.NoError(t, err)
+
+	testSetEntries := []storage.Operation{
+		storage.SetOperation("testKey1", []byte("testValue1")),
+		storage.SetOperation("testKey2", []byte("testValue2")),
+	}
+
+	testGetEntries := []storage.Operation{
+		storage.GetOperation("testKey1"),
+		storage.GetOperation("testKey2"),
+	}
+
+	// Make sure nothing is there
+	require.NoError(t, client.Batch(ctx, testGetEntries...))
+	require.Nil(t, testGetEntries[0].Value)
+	require.Nil(t, testGetEntries[1].Value)
+
+	// Set it
+	require.NoError(t, client.Batch(ctx, testSetEntries...))
+
+	// Get it back out, make sure it's right
+	require.NoError(t, client.Batch(ctx, testGetEntries...))
+	for i := range testGetEntries {
+		require.Equal(t, testSetEntries[i].Key, testGetEntries[i].Key)
+		require.Equal(t, testSetEntries[i].Value, testGetEntries[i].Value)
+	}
+
+	// Operations are applied in order within the batch
+	ops := []storage.Operation{
+		storage.DeleteOperation("testKey1"),
+		storage.GetOperation("testKey1"),
+		storage.SetOperation("testKey2", []byte("testValue3")),
+		storage.GetOperation("testKey2"),
+	}
+	require.NoError(t, client.Batch(ctx, ops...))
+	require.Nil(t, ops[1].Value)
+	require.Equal(t, []byte("testValue3"), ops[3].Value)
+
+	// Empty batches are a no-op
+	require.NoError(t, client.Batch(ctx))
+
+	// Unknown operation types are rejected
+	badOp := storage.GetOperation("testKey1")
+	badOp.Type = 100
+	require.Error(t, client.Batch(ctx, badOp))
+}
@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+// Config defines configuration for the redis storage extension.
+type Config struct {
+	// Endpoint is the address of the Redis server, in the form host:port.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Username is used to authenticate against a server with ACLs enabled.
+	Username string `mapstructure:"username"`
+
+	// Password must match the requirepass configuration or the ACL user password.
+	Password configopaque.String `mapstructure:"password"`
+
+	// DB selects the Redis logical database.
+	DB int `mapstructure:"db"`
+
+	// Prefix is prepended to every key written by the extension, allowing
+	// several collectors to share a single Redis instance.
+	Prefix string `mapstructure:"prefix"`
+
+	// Expiration is the time to live applied to every key on write.
+	// A value of 0 means keys never expire.
+	Expiration time.Duration `mapstructure:"expiration"`
+
+	TLS configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("missing endpoint")
+	}
+	if cfg.DB < 0 {
+		return errors.New("db must not be negative")
+	}
+	if cfg.Expiration < 0 {
+		return errors.New("expiration must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-redis/redis/v7"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+)
+
+type redisStorage struct {
+	cfg    *Config
+	logger *zap.Logger
+	client *redis.Client
+}
+
+// Ensure this storage extension implements the appropriate interface
+var _ storage.Extension = (*redisStorage)(nil)
+
+func newRedisStorage(logger *zap.Logger, config *Config) (extension.Extension, error) {
+	return &redisStorage{
+		cfg:    config,
+		logger: logger,
+	}, nil
+}
+
+// Start connects to the Redis server
+func (rs *redisStorage) Start(context.Context, component.Host) error {
+	opts := &redis.Options{
+		Addr:     rs.cfg.Endpoint,
+		Username: rs.cfg.Username,
+		Password: string(rs.cfg.Password),
+		DB:       rs.cfg.DB,
+	}
+
+	tlsConfig, err := rs.cfg.TLS.LoadTLSConfig()
+	if err != nil {
+		return err
+	}
+	opts.TLSConfig = tlsConfig
+
+	client := redis.NewClient(opts)
+	if err := client.Ping().Err(); err != nil {
+		_ = client.Close()
+		return fmt.Errorf("failed to connect to redis at %q: %w", rs.cfg.Endpoint, err)
+	}
+	rs.client = client
+	return nil
+}
+
+// Shutdown closes the connection to the Redis server
+func (rs *redisStorage) Shutdown(context.Context) error {
+	if rs.client == nil {
+		return nil
+	}
+	return rs.client.Close()
+}
+
+// GetClient returns a storage client for an individual component
+func (rs *redisStorage) GetClient(_ context.Context, kind component.Kind, ent component.ID, name string) (storage.Client, error) {
+	var fullName string
+	if name == "" {
+		fullName = fmt.Sprintf("%s_%s_%s", kindString(kind), ent.Type(), ent.Name())
+	} else {
+		fullName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
+	}
+	fullName = strings.ReplaceAll(fullName, " ", "")
+	return newClient(rs.client, rs.cfg.Prefix+fullName+":", rs.cfg.Expiration), nil
+}
+
+func kindString(k component.Kind) string {
+	switch k {
+	case component.KindReceiver:
+		return "receiver"
+	case component.KindProcessor:
+		return "processor"
+	case component.KindExporter:
+		return "exporter"
+	case component.KindExtension:
+		return "extension"
+	case component.KindConnector:
+		return "connector"
+	default:
+		return "other" // not expected
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+)
+
+func TestExtensionIntegrity(t *testing.T) {
+	ctx := context.Background()
+	se, _ := newTestExtension(t, nil)
+
+	type mockComponent struct {
+		kind component.Kind
+		name component.ID
+	}
+
+	components := []mockComponent{
+		{kind: component.KindReceiver, name: newTestEntity("receiver_one")},
+		{kind: component.KindReceiver, name: newTestEntity("receiver_two")},
+		{kind: component.KindProcessor, name: newTestEntity("processor_one")},
+		{kind: component.KindProcessor, name: newTestEntity("processor_two")},
+		{kind: component.KindExporter, name: newTestEntity("exporter_one")},
+		{kind: component.KindExporter, name: newTestEntity("exporter_two")},
+		{kind: component.KindExtension, name: newTestEntity("extension_one")},
+		{kind: component.KindExtension, name: newTestEntity("extension_two")},
+	}
+
+	// Make a client for each component
+	clients := make(map[component.ID]storage.Client)
+	for _, c := range components {
+		client, err := se.GetClient(ctx, c.kind, c.name, "")
+		require.NoError(t, err)
+		clients[c.name] = client
+	}
+
+	thrashClient := func(wg *sync.WaitGroup, n component.ID, c storage.Client) {
+		// keys and values
+		keys := []string{"a", "b", "c", "d", "e"}
+		myBytes := []byte(n.Name())
+
+		// Set my values
+		for i := 0; i < len(keys); i++ {
+			err := c.Set(ctx, keys[i], myBytes)
+			require.NoError(t, err)
+		}
+
+		// Repeatedly thrash client
+		for j := 0; j < 20; j++ {
+
+			// Make sure my values are still mine
+			for i := 0; i < len(keys); i++ {
+				v, err := c.Get(ctx, keys[i])
+				require.NoError(t, err)
+				require.Equal(t, myBytes, v)
+			}
+
+			// Delete my values
+			for i := 0; i < len(keys); i++ {
+				err := c.Delete(ctx, keys[i])
+				require.NoError(t, err)
+			}
+
+			// Reset my values
+			for i := 0; i < len(keys); i++ {
+				err := c.Set(ctx, keys[i], myBytes)
+				require.NoError(t, err)
+			}
+		}
+		c.Close(ctx)
+		wg.Done()
+	}
+
+	// Use clients concurrently
+	var wg sync.WaitGroup
+	for name, client := range clients {
+		wg.Add(1)
+		go thrashClient(&wg, name, client)
+	}
+	wg.Wait()
+}
+
+func TestExtensionKeyPrefix(t *testing.T) {
+	ctx := context.Background()
+	se, server := newTestExtension(t, func(cfg *Config) {
+		cfg.Prefix = "otelcol:"
+	})
+
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("my_receiver"), "my name")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+
+	got, err := server.Get("otelcol:receiver_nop_my_receiver_myname:key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", got)
+}
+
+func TestExtensionExpiration(t *testing.T) {
+	ctx := context.Background()
+	se, server := newTestExtension(t, func(cfg *Config) {
+		cfg.Expiration = time.Minute
+	})
+
+	client, err := se.GetClient(ctx, component.KindExporter, newTestEntity("my_exporter"), "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+
+	key := "exporter_nop_my_exporter:key"
+	assert.Equal(t, time.Minute, server.TTL(key))
+
+	server.FastForward(time.Minute)
+	v, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestExtensionAuth(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireUserAuth("otel", "secret")
+
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Endpoint = server.Addr()
+
+	ext, err := f.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.Error(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+
+	cfg.Username = "otel"
+	cfg.Password = "secret"
+	ext, err = f.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
+
+func TestExtensionInvalidTLS(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.TLS.Insecure = false
+	cfg.TLS.CAFile = "/nonexistent/ca.pem"
+
+	ext, err := f.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.Error(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+}
+
+func TestExtensionShutdownWithoutStart(t *testing.T) {
+	f := NewFactory()
+	ext, err := f.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), f.CreateDefaultConfig())
+	require.NoError(t, err)
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
+
+func newTestExtension(t *testing.T, modify func(*Config)) (storage.Extension, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Endpoint = server.Addr()
+	if modify != nil {
+		modify(cfg)
+	}
+
+	extension, err := f.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	se, ok := extension.(storage.Extension)
+	require.True(t, ok)
+
+	require.NoError(t, se.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, se.Shutdown(context.Background()))
+	})
+
+	return se, server
+}
+
+func newTestEntity(name string) component.ID {
+	return component.NewIDWithName("nop", name)
+}
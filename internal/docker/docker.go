@@ -107,7 +107,7 @@ func (dc *Client) LoadContainerList(ctx context.Context) error {
 	for _, c := range containerList {
 		wg.Add(1)
 		go func(container dtypes.Container) {
-			if !dc.shouldBeExcluded(container.Image) {
+			if !dc.ShouldBeExcluded(container.Image) {
 				dc.InspectAndPersistContainer(ctx, container.ID)
 			} else {
 				dc.logger.Debug(
@@ -289,7 +289,7 @@ func (dc *Client) inspectedContainerIsOfInterest(ctx context.Context, cid string
 			zap.String("id", cid),
 			zap.Error(err),
 		)
-	} else if !dc.shouldBeExcluded(container.Config.Image) {
+	} else if !dc.ShouldBeExcluded(container.Config.Image) {
 		return &container, true
 	}
 	return nil, false
@@ -323,7 +323,8 @@ func (dc *Client) RemoveContainer(cid string) {
 	dc.logger.Debug("Removed container from stores.", zap.String("id", cid))
 }
 
-func (dc *Client) shouldBeExcluded(image string) bool {
+// ShouldBeExcluded returns true if the image matches one of the excluded images of the config.
+func (dc *Client) ShouldBeExcluded(image string) bool {
 	return dc.excludedImageMatcher != nil && dc.excludedImageMatcher.matches(image)
 }
 
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type IsInCIDRArguments[K any] struct {
+	Target  ottl.StringLikeGetter[K] `ottlarg:"0"`
+	Subnets []string                 `ottlarg:"1"`
+}
+
+func NewIsInCIDRFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("IsInCIDR", &IsInCIDRArguments[K]{}, createIsInCIDRFunction[K])
+}
+
+func createIsInCIDRFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*IsInCIDRArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("IsInCIDRFactory args must be of type *IsInCIDRArguments[K]")
+	}
+
+	return isInCIDR(args.Target, args.Subnets)
+}
+
+func isInCIDR[K any](target ottl.StringLikeGetter[K], subnets []string) (ottl.ExprFunc[K], error) {
+	if len(subnets) == 0 {
+		return nil, fmt.Errorf("at least one subnet must be supplied to IsInCIDR")
+	}
+	prefixes := make([]netip.Prefix, 0, len(subnets))
+	for _, subnet := range subnets {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("the subnet supplied to IsInCIDR is not a valid CIDR: %w", err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return false, nil
+		}
+		addr, err := netip.ParseAddr(*val)
+		if err != nil {
+			return false, nil
+		}
+		// IPv4-mapped IPv6 addresses are matched against IPv4 subnets
+		addr = addr.Unmap()
+		for _, prefix := range prefixes {
+			if prefix.Contains(addr) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}, nil
+}
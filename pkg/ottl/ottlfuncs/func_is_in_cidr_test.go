@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_isInCIDR(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		subnets  []string
+		expected bool
+	}{
+		{
+			name:     "ipv4 in subnet",
+			target:   "10.1.2.3",
+			subnets:  []string{"10.0.0.0/8"},
+			expected: true,
+		},
+		{
+			name:     "ipv4 not in subnet",
+			target:   "192.168.1.1",
+			subnets:  []string{"10.0.0.0/8"},
+			expected: false,
+		},
+		{
+			name:     "ipv4 in one of the subnets",
+			target:   "192.168.1.1",
+			subnets:  []string{"10.0.0.0/8", "192.168.0.0/16"},
+			expected: true,
+		},
+		{
+			name:     "subnet with host bits",
+			target:   "172.16.5.4",
+			subnets:  []string{"172.16.1.1/16"},
+			expected: true,
+		},
+		{
+			name:     "ipv6 in subnet",
+			target:   "2001:db8::1",
+			subnets:  []string{"2001:db8::/32"},
+			expected: true,
+		},
+		{
+			name:     "ipv4-mapped ipv6 in ipv4 subnet",
+			target:   "::ffff:10.0.0.1",
+			subnets:  []string{"10.0.0.0/8"},
+			expected: true,
+		},
+		{
+			name:     "not an ip",
+			target:   "example.com",
+			subnets:  []string{"10.0.0.0/8"},
+			expected: false,
+		},
+		{
+			name:     "nil target",
+			target:   nil,
+			subnets:  []string{"10.0.0.0/8"},
+			expected: false,
+		},
+		{
+			name: "pcommon value",
+			target: func() pcommon.Value {
+				return pcommon.NewValueStr("10.0.0.1")
+			}(),
+			subnets:  []string{"10.0.0.0/8"},
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardStringLikeGetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := isInCIDR[interface{}](target, tt.subnets)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_isInCIDR_validation(t *testing.T) {
+	target := &ottl.StandardStringLikeGetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return "10.0.0.1", nil
+		},
+	}
+	_, err := isInCIDR[interface{}](target, []string{"10.0.0.0"})
+	require.Error(t, err)
+
+	_, err = isInCIDR[interface{}](target, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	urlOriginal  = "url.original"
+	urlScheme    = "url.scheme"
+	urlDomain    = "url.domain"
+	urlPort      = "url.port"
+	urlPath      = "url.path"
+	urlExtension = "url.extension"
+	urlQuery     = "url.query"
+	urlFragment  = "url.fragment"
+	urlUsername  = "url.username"
+	urlPassword  = "url.password"
+)
+
+type URLArguments[K any] struct {
+	Target ottl.StringGetter[K] `ottlarg:"0"`
+}
+
+func NewURLFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("URL", &URLArguments[K]{}, createURLFunction[K])
+}
+
+func createURLFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*URLArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("URLFactory args must be of type *URLArguments[K]")
+	}
+
+	return parseURL(args.Target), nil
+}
+
+// parseURL returns a `pcommon.Map` with the components of the target URL. Only the components
+// present in the URL are set.
+func parseURL[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if targetVal == "" {
+			return nil, fmt.Errorf("url cannot be empty")
+		}
+		u, err := url.Parse(targetVal)
+		if err != nil {
+			return nil, err
+		}
+
+		result := pcommon.NewMap()
+		result.PutStr(urlOriginal, targetVal)
+		putNonEmptyStr(result, urlScheme, u.Scheme)
+		putNonEmptyStr(result, urlDomain, u.Hostname())
+		if portStr := u.Port(); portStr != "" {
+			port, err := strconv.ParseInt(portStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid url port %q: %w", portStr, err)
+			}
+			result.PutInt(urlPort, port)
+		}
+		putNonEmptyStr(result, urlPath, u.Path)
+		if ext := path.Ext(u.Path); len(ext) > 1 {
+			result.PutStr(urlExtension, ext[1:])
+		}
+		putNonEmptyStr(result, urlQuery, u.RawQuery)
+		putNonEmptyStr(result, urlFragment, u.Fragment)
+		if u.User != nil {
+			putNonEmptyStr(result, urlUsername, u.User.Username())
+			if password, ok := u.User.Password(); ok {
+				putNonEmptyStr(result, urlPassword, password)
+			}
+		}
+		return result, nil
+	}
+}
+
+func putNonEmptyStr(m pcommon.Map, key, value string) {
+	if value != "" {
+		m.PutStr(key, value)
+	}
+}
@@ -39,6 +39,7 @@ func converters[K any]() []ottl.Factory[K] {
 		NewDurationFactory[K](),
 		NewFnvFactory[K](),
 		NewIntFactory[K](),
+		NewIsInCIDRFactory[K](),
 		NewIsMapFactory[K](),
 		NewIsMatchFactory[K](),
 		NewIsStringFactory[K](),
@@ -52,6 +53,7 @@ func converters[K any]() []ottl.Factory[K] {
 		NewSubstringFactory[K](),
 		NewTimeFactory[K](),
 		NewTraceIDFactory[K](),
+		NewURLFactory[K](),
 		NewUUIDFactory[K](),
 	}
 }
@@ -55,9 +55,9 @@ func (bc benchCase) run(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		f := NewFactory(BenchReceiverType{}, component.StabilityLevelUndefined)
 		cfg := f.CreateDefaultConfig().(*BenchConfig)
-		cfg.BaseConfig.numWorkers = bc.workerCount
-		cfg.BaseConfig.maxBatchSize = bc.maxBatchSize
-		cfg.BaseConfig.flushInterval = bc.flushInterval
+		cfg.BaseConfig.ConverterWorkers = bc.workerCount
+		cfg.BaseConfig.MaxBatchSize = bc.maxBatchSize
+		cfg.BaseConfig.FlushInterval = bc.flushInterval
 		cfg.BenchOpConfig.NumEntries = numEntries
 		cfg.BenchOpConfig.NumHosts = numHosts
 		sink := new(consumertest.LogsSink)
@@ -4,6 +4,7 @@
 package adapter // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
 
 import (
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -18,8 +19,32 @@ type BaseConfig struct {
 	StorageID      *component.ID        `mapstructure:"storage"`
 	RetryOnFailure consumerretry.Config `mapstructure:"retry_on_failure"`
 
-	// currently not configurable by users, but available for benchmarking
-	numWorkers    int
-	maxBatchSize  uint
-	flushInterval time.Duration
+	// StorageNamespace, if set, replaces the receiver's name in the ID under which its
+	// state is stored, so that the state is kept when the receiver is renamed.
+	StorageNamespace string `mapstructure:"storage_namespace"`
+	// MigrateStorageFrom lists the IDs under which the state of the receiver was
+	// previously stored. Their state is moved to the receiver's storage on start.
+	MigrateStorageFrom []component.ID `mapstructure:"migrate_storage_from"`
+
+	// ConverterWorkers is the number of workers converting entries to pdata.
+	// Defaults to a quarter of the available CPUs, and at least one.
+	ConverterWorkers int `mapstructure:"converter_workers"`
+	// MaxBatchSize is the number of entries after which a batch is sent to the converter.
+	MaxBatchSize uint `mapstructure:"max_batch_size"`
+	// FlushInterval is the longest time entries are held before being sent to the converter.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+}
+
+// Validate checks that the converter and batching settings are valid. Zero values select the defaults.
+func (c BaseConfig) Validate() error {
+	if c.ConverterWorkers < 0 {
+		return errors.New("'converter_workers' must not be negative")
+	}
+	if c.FlushInterval < 0 {
+		return errors.New("'flush_interval' must not be negative")
+	}
+	if c.StorageID == nil && (c.StorageNamespace != "" || len(c.MigrateStorageFrom) > 0) {
+		return errors.New("'storage' must be specified to use 'storage_namespace' or 'migrate_storage_from'")
+	}
+	return nil
 }
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adapter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+)
+
+func TestBaseConfigValidate(t *testing.T) {
+	cases := []struct {
+		name        string
+		cfg         BaseConfig
+		expectedErr string
+	}{
+		{
+			name: "Defaults",
+			cfg:  BaseConfig{},
+		},
+		{
+			name: "Tuned",
+			cfg: BaseConfig{
+				ConverterWorkers: 4,
+				MaxBatchSize:     1000,
+				FlushInterval:    time.Second,
+			},
+		},
+		{
+			name:        "NegativeConverterWorkers",
+			cfg:         BaseConfig{ConverterWorkers: -1},
+			expectedErr: "'converter_workers' must not be negative",
+		},
+		{
+			name:        "NegativeFlushInterval",
+			cfg:         BaseConfig{FlushInterval: -time.Second},
+			expectedErr: "'flush_interval' must not be negative",
+		},
+		{
+			name: "StorageNamespace",
+			cfg: BaseConfig{
+				StorageID:        &component.ID{},
+				StorageNamespace: "logs",
+			},
+		},
+		{
+			name:        "StorageNamespaceWithoutStorage",
+			cfg:         BaseConfig{StorageNamespace: "logs"},
+			expectedErr: "'storage' must be specified to use 'storage_namespace' or 'migrate_storage_from'",
+		},
+		{
+			name:        "MigrateStorageFromWithoutStorage",
+			cfg:         BaseConfig{MigrateStorageFrom: []component.ID{component.NewID("filelog")}},
+			expectedErr: "'storage' must be specified to use 'storage_namespace' or 'migrate_storage_from'",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -136,6 +136,7 @@ func (c *Converter) OutChannel() <-chan plog.Logs {
 func (c *Converter) workerLoop() {
 	defer c.wg.Done()
 
+	bc := newBatchConverter()
 	for {
 
 		select {
@@ -147,23 +148,7 @@ func (c *Converter) workerLoop() {
 				return
 			}
 
-			resourceHashToIdx := make(map[uint64]int)
-
-			pLogs := plog.NewLogs()
-			var sl plog.ScopeLogs
-			for _, e := range entries {
-				resourceID := HashResource(e.Resource)
-				resourceIdx, ok := resourceHashToIdx[resourceID]
-				if !ok {
-					resourceHashToIdx[resourceID] = pLogs.ResourceLogs().Len()
-					rl := pLogs.ResourceLogs().AppendEmpty()
-					upsertToMap(e.Resource, rl.Resource().Attributes())
-					sl = rl.ScopeLogs().AppendEmpty()
-				} else {
-					sl = pLogs.ResourceLogs().At(resourceIdx).ScopeLogs().At(0)
-				}
-				convertInto(e, sl.LogRecords().AppendEmpty())
-			}
+			pLogs := bc.convert(entries)
 
 			// Send plogs directly to flushChan
 			select {
@@ -222,6 +207,112 @@ func (c *Converter) Batch(e []*entry.Entry) error {
 	}
 }
 
+// batchConverter converts batches of entries into plog.Logs. It holds scratch
+// space which is reused between batches, so it must not be shared between workers.
+type batchConverter struct {
+	// resourceIdx maps a resource hash to its index in the current batch.
+	resourceIdx map[uint64]int
+	// resources holds the first entry of each resource, from which the resource is taken.
+	resources []*entry.Entry
+	// resourceLogs holds the ResourceLogs of each resource.
+	resourceLogs []plog.ResourceLogs
+	// scopeIdx maps a resource index and an instrumentation scope to its group in the current batch.
+	scopeIdx map[scopeKey]int
+	// scopes holds the resource index and instrumentation scope of each group.
+	scopes []scopeKey
+	// groups holds the group of each entry in the current batch.
+	groups []int
+	// counts holds the number of entries in each group.
+	counts []int
+	// records holds the log records of each group.
+	records []plog.LogRecordSlice
+}
+
+// scopeKey identifies the ScopeLogs of an instrumentation scope in a resource.
+type scopeKey struct {
+	resource int
+	name     string
+	version  string
+}
+
+func newBatchConverter() *batchConverter {
+	return &batchConverter{
+		resourceIdx: make(map[uint64]int),
+		scopeIdx:    make(map[scopeKey]int),
+	}
+}
+
+// convert translates entries into plog.Logs, with one ResourceLogs per distinct resource and
+// one ScopeLogs per distinct instrumentation scope of the resource. Entries are grouped first
+// so that every slice is allocated with its final size.
+func (bc *batchConverter) convert(entries []*entry.Entry) plog.Logs {
+	for k := range bc.resourceIdx {
+		delete(bc.resourceIdx, k)
+	}
+	for k := range bc.scopeIdx {
+		delete(bc.scopeIdx, k)
+	}
+	bc.resources = bc.resources[:0]
+	bc.scopes = bc.scopes[:0]
+	bc.groups = bc.groups[:0]
+	bc.counts = bc.counts[:0]
+
+	for _, e := range entries {
+		resourceID := HashResource(e.Resource)
+		resource, ok := bc.resourceIdx[resourceID]
+		if !ok {
+			resource = len(bc.resources)
+			bc.resourceIdx[resourceID] = resource
+			bc.resources = append(bc.resources, e)
+		}
+		key := scopeKey{resource: resource, name: e.ScopeName, version: e.ScopeVersion}
+		idx, ok := bc.scopeIdx[key]
+		if !ok {
+			idx = len(bc.counts)
+			bc.scopeIdx[key] = idx
+			bc.scopes = append(bc.scopes, key)
+			bc.counts = append(bc.counts, 0)
+		}
+		bc.groups = append(bc.groups, idx)
+		bc.counts[idx]++
+	}
+
+	pLogs := plog.NewLogs()
+	resourceLogs := pLogs.ResourceLogs()
+	resourceLogs.EnsureCapacity(len(bc.resources))
+	bc.resourceLogs = bc.resourceLogs[:0]
+	for _, e := range bc.resources {
+		rl := resourceLogs.AppendEmpty()
+		upsertToMap(e.Resource, rl.Resource().Attributes())
+		bc.resourceLogs = append(bc.resourceLogs, rl)
+	}
+
+	bc.records = bc.records[:0]
+	for idx, count := range bc.counts {
+		key := bc.scopes[idx]
+		sl := bc.resourceLogs[key.resource].ScopeLogs().AppendEmpty()
+		sl.Scope().SetName(key.name)
+		sl.Scope().SetVersion(key.version)
+		lrs := sl.LogRecords()
+		lrs.EnsureCapacity(count)
+		bc.records = append(bc.records, lrs)
+	}
+
+	for i, e := range entries {
+		convertInto(e, bc.records[bc.groups[i]].AppendEmpty())
+	}
+
+	// Drop references to the converted data so it can be collected once sent
+	for i := range bc.records {
+		bc.records[i] = plog.LogRecordSlice{}
+	}
+	for i := range bc.resources {
+		bc.resources[i] = nil
+		bc.resourceLogs[i] = plog.ResourceLogs{}
+	}
+	return pLogs
+}
+
 // convert converts one entry.Entry into plog.LogRecord allocating it.
 func convert(ent *entry.Entry) plog.LogRecord {
 	dest := plog.NewLogRecord()
@@ -395,14 +486,15 @@ var pairSep = []byte{0xfe}
 const emptyResourceID uint64 = 17241709254077376921
 
 type hashWriter struct {
-	h        *xxhash.Digest
-	keySlice []string
+	h *xxhash.Digest
+	// keySlices holds the sorted keys of the map being hashed at each nesting depth.
+	keySlices [][]string
+	buf       [8]byte
 }
 
 func newHashWriter() *hashWriter {
 	return &hashWriter{
-		h:        xxhash.New(),
-		keySlice: make([]string, 0),
+		h: xxhash.New(),
 	}
 }
 
@@ -419,36 +511,81 @@ func HashResource(resource map[string]interface{}) uint64 {
 	hw := hashWriterPool.Get().(*hashWriter)
 	defer hashWriterPool.Put(hw)
 	hw.h.Reset()
-	hw.keySlice = hw.keySlice[:0]
 
-	for k := range resource {
-		hw.keySlice = append(hw.keySlice, k)
-	}
+	hw.writeMap(resource, 0)
+	return hw.h.Sum64()
+}
 
-	if len(hw.keySlice) > 1 {
-		// In order for this to be deterministic, we need to sort the map. Using range, like above,
-		// has no guarantee about order.
-		sort.Strings(hw.keySlice)
+// writeMap hashes m in key order. In order for this to be deterministic, the keys
+// need to be sorted, since ranging over a map has no guarantee about order.
+func (hw *hashWriter) writeMap(m map[string]interface{}, depth int) {
+	if depth == len(hw.keySlices) {
+		hw.keySlices = append(hw.keySlices, make([]string, 0, len(m)))
+	}
+	keys := hw.keySlices[depth][:0]
+	for k := range m {
+		keys = append(keys, k)
 	}
+	if len(keys) > 1 {
+		sort.Strings(keys)
+	}
+	// Keep the grown slice for the next map at this depth
+	hw.keySlices[depth] = keys
 
-	for _, k := range hw.keySlice {
-		hw.h.Write([]byte(k)) //nolint:errcheck
-		hw.h.Write(pairSep)   //nolint:errcheck
+	for _, k := range keys {
+		hw.h.WriteString(k) //nolint:errcheck
+		hw.h.Write(pairSep) //nolint:errcheck
+		hw.writeValue(m[k], depth)
+		hw.h.Write(pairSep) //nolint:errcheck
+	}
+}
 
-		switch t := resource[k].(type) {
-		case string:
-			hw.h.Write([]byte(t)) //nolint:errcheck
-		case []byte:
-			hw.h.Write(t) //nolint:errcheck
-		case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
-			binary.Write(hw.h, binary.BigEndian, t) // nolint - nothing to do about it
-		default:
-			b, _ := json.Marshal(t)
-			hw.h.Write(b) //nolint:errcheck
+func (hw *hashWriter) writeValue(value interface{}, depth int) {
+	switch t := value.(type) {
+	case string:
+		hw.h.WriteString(t) //nolint:errcheck
+	case []byte:
+		hw.h.Write(t) //nolint:errcheck
+	case bool:
+		if t {
+			hw.writeUint(1, 1)
+		} else {
+			hw.writeUint(0, 1)
 		}
-
-		hw.h.Write(pairSep) //nolint:errcheck
+	case int:
+		hw.writeUint(uint64(t), 8)
+	case int8:
+		hw.writeUint(uint64(t), 1)
+	case int16:
+		hw.writeUint(uint64(t), 2)
+	case int32:
+		hw.writeUint(uint64(t), 4)
+	case int64:
+		hw.writeUint(uint64(t), 8)
+	case uint:
+		hw.writeUint(uint64(t), 8)
+	case uint8:
+		hw.writeUint(uint64(t), 1)
+	case uint16:
+		hw.writeUint(uint64(t), 2)
+	case uint32:
+		hw.writeUint(uint64(t), 4)
+	case uint64:
+		hw.writeUint(t, 8)
+	case float32:
+		hw.writeUint(uint64(math.Float32bits(t)), 4)
+	case float64:
+		hw.writeUint(math.Float64bits(t), 8)
+	case map[string]interface{}:
+		hw.writeMap(t, depth+1)
+	default:
+		b, _ := json.Marshal(t)
+		hw.h.Write(b) //nolint:errcheck
 	}
+}
 
-	return hw.h.Sum64()
+// writeUint writes the size least significant bytes of v in big endian order.
+func (hw *hashWriter) writeUint(v uint64, size int) {
+	binary.BigEndian.PutUint64(hw.buf[:], v)
+	hw.h.Write(hw.buf[8-size:]) //nolint:errcheck
 }
@@ -305,6 +305,50 @@ func TestHashResource(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "int",
+			baseline: map[string]interface{}{
+				"pid": 1,
+			},
+			same: []map[string]interface{}{
+				{
+					"pid": 1,
+				},
+			},
+			diff: []map[string]interface{}{
+				{
+					"pid": 2,
+				},
+				{
+					"pid": uint(2),
+				},
+			},
+		},
+		{
+			name: "nested_maps",
+			baseline: map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": map[string]interface{}{"c": "d", "e": "f"},
+					"g": "h",
+				},
+			},
+			same: []map[string]interface{}{
+				{
+					"a": map[string]interface{}{
+						"g": "h",
+						"b": map[string]interface{}{"e": "f", "c": "d"},
+					},
+				},
+			},
+			diff: []map[string]interface{}{
+				{
+					"a": map[string]interface{}{
+						"b": map[string]interface{}{"c": "d", "e": "g"},
+						"g": "h",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -320,6 +364,106 @@ func TestHashResource(t *testing.T) {
 	}
 }
 
+func TestBatchConverterGroupsByResource(t *testing.T) {
+	bc := newBatchConverter()
+
+	for batch := 0; batch < 2; batch++ {
+		entries := make([]*entry.Entry, 0, 9)
+		for i := 0; i < 9; i++ {
+			e := entry.New()
+			e.Resource = map[string]interface{}{"host": fmt.Sprintf("host-%d", (i+batch)%3)}
+			e.Body = strconv.Itoa(i)
+			entries = append(entries, e)
+		}
+
+		pLogs := bc.convert(entries)
+		require.Equal(t, 3, pLogs.ResourceLogs().Len())
+		require.Equal(t, 9, pLogs.LogRecordCount())
+
+		for i := 0; i < pLogs.ResourceLogs().Len(); i++ {
+			rl := pLogs.ResourceLogs().At(i)
+			host, ok := rl.Resource().Attributes().Get("host")
+			require.True(t, ok)
+			require.Equal(t, fmt.Sprintf("host-%d", (i+batch)%3), host.Str())
+
+			// Records keep the order in which the entries were batched
+			lrs := rl.ScopeLogs().At(0).LogRecords()
+			require.Equal(t, 3, lrs.Len())
+			for j := 0; j < lrs.Len(); j++ {
+				require.Equal(t, strconv.Itoa(i+3*j), lrs.At(j).Body().Str())
+			}
+		}
+	}
+}
+
+func TestBatchConverterGroupsByScope(t *testing.T) {
+	bc := newBatchConverter()
+
+	newEntry := func(host, scopeName, scopeVersion, body string) *entry.Entry {
+		e := entry.New()
+		e.Resource = map[string]interface{}{"host": host}
+		e.ScopeName = scopeName
+		e.ScopeVersion = scopeVersion
+		e.Body = body
+		return e
+	}
+	pLogs := bc.convert([]*entry.Entry{
+		newEntry("host-1", "com.example.Foo", "", "0"),
+		newEntry("host-1", "com.example.Bar", "", "1"),
+		newEntry("host-2", "com.example.Foo", "", "2"),
+		newEntry("host-1", "com.example.Foo", "", "3"),
+		newEntry("host-1", "com.example.Foo", "1.0.0", "4"),
+		newEntry("host-2", "", "", "5"),
+	})
+	require.Equal(t, 2, pLogs.ResourceLogs().Len())
+	require.Equal(t, 6, pLogs.LogRecordCount())
+
+	type scopeLogs struct {
+		name    string
+		version string
+		bodies  []string
+	}
+	expected := [][]scopeLogs{
+		{
+			{name: "com.example.Foo", bodies: []string{"0", "3"}},
+			{name: "com.example.Bar", bodies: []string{"1"}},
+			{name: "com.example.Foo", version: "1.0.0", bodies: []string{"4"}},
+		},
+		{
+			{name: "com.example.Foo", bodies: []string{"2"}},
+			{name: "", bodies: []string{"5"}},
+		},
+	}
+	for i, expectedScopes := range expected {
+		sls := pLogs.ResourceLogs().At(i).ScopeLogs()
+		require.Equal(t, len(expectedScopes), sls.Len())
+		for j, expectedScope := range expectedScopes {
+			sl := sls.At(j)
+			assert.Equal(t, expectedScope.name, sl.Scope().Name())
+			assert.Equal(t, expectedScope.version, sl.Scope().Version())
+			var bodies []string
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				bodies = append(bodies, sl.LogRecords().At(k).Body().Str())
+			}
+			assert.Equal(t, expectedScope.bodies, bodies)
+		}
+	}
+}
+
+func BenchmarkBatchConvert(b *testing.B) {
+	for _, hosts := range []int{1, 4} {
+		entries := complexEntriesForNDifferentHosts(100, hosts)
+		b.Run(fmt.Sprintf("hosts=%d", hosts), func(b *testing.B) {
+			bc := newBatchConverter()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				bc.convert(entries)
+			}
+		})
+	}
+}
+
 func TestAllConvertedEntriesAreSentAndReceived(t *testing.T) {
 	t.Parallel()
 
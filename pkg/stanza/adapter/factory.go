@@ -46,11 +46,11 @@ func createLogsReceiver(logReceiverType LogReceiverType) rcvr.CreateLogsFunc {
 		operators := append([]operator.Config{inputCfg}, baseCfg.Operators...)
 
 		emitterOpts := []emitterOption{}
-		if baseCfg.maxBatchSize > 0 {
-			emitterOpts = append(emitterOpts, withMaxBatchSize(baseCfg.maxBatchSize))
+		if baseCfg.MaxBatchSize > 0 {
+			emitterOpts = append(emitterOpts, withMaxBatchSize(baseCfg.MaxBatchSize))
 		}
-		if baseCfg.flushInterval > 0 {
-			emitterOpts = append(emitterOpts, withFlushInterval(baseCfg.flushInterval))
+		if baseCfg.FlushInterval > 0 {
+			emitterOpts = append(emitterOpts, withFlushInterval(baseCfg.FlushInterval))
 		}
 		emitter := NewLogEmitter(params.Logger.Sugar(), emitterOpts...)
 		pipe, err := pipeline.Config{
@@ -62,8 +62,8 @@ func createLogsReceiver(logReceiverType LogReceiverType) rcvr.CreateLogsFunc {
 		}
 
 		converterOpts := []converterOption{}
-		if baseCfg.numWorkers > 0 {
-			converterOpts = append(converterOpts, withWorkerCount(baseCfg.numWorkers))
+		if baseCfg.ConverterWorkers > 0 {
+			converterOpts = append(converterOpts, withWorkerCount(baseCfg.ConverterWorkers))
 		}
 		converter := NewConverter(params.Logger, converterOpts...)
 		obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
@@ -82,6 +82,9 @@ func createLogsReceiver(logReceiverType LogReceiverType) rcvr.CreateLogsFunc {
 			converter: converter,
 			obsrecv:   obsrecv,
 			storageID: baseCfg.StorageID,
+
+			storageNamespace:   baseCfg.StorageNamespace,
+			migrateStorageFrom: baseCfg.MigrateStorageFrom,
 		}, nil
 	}
 }
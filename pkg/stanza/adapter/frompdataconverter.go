@@ -155,6 +155,7 @@ func convertFromLogs(workerItem fromConverterWorkerItem) []*entry.Entry {
 		entry := entry.Entry{}
 
 		entry.ScopeName = workerItem.Scope.Scope().Name()
+		entry.ScopeVersion = workerItem.Scope.Scope().Version()
 		entry.Resource = workerItem.Resource.Attributes().AsRaw()
 		convertFrom(record, &entry)
 		result = append(result, &entry)
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adapter // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+
+import (
+	"expvar"
+	"sort"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/pipeline"
+)
+
+// pipelinesVar is the name of the expvar describing the running pipelines, exposed by
+// the debug endpoints serving the expvars, such as /debug/vars of the pprof extension.
+const pipelinesVar = "stanza_pipelines"
+
+// PipelineStatus describes the pipeline of a running stanza-based receiver.
+type PipelineStatus struct {
+	Receiver  string           `json:"receiver"`
+	Operators []OperatorStatus `json:"operators"`
+}
+
+// OperatorStatus describes an operator of a running pipeline.
+type OperatorStatus struct {
+	ID      string   `json:"id"`
+	Type    string   `json:"type"`
+	Outputs []string `json:"outputs,omitempty"`
+	// Stats are set for the operators counting the entries they handle.
+	Stats *operator.Stats `json:"stats,omitempty"`
+	// Files are set for the operators reading files.
+	Files []operator.TrackedFile `json:"files,omitempty"`
+}
+
+// runningPipelines are the pipelines of the started receivers, by receiver ID.
+var runningPipelines = struct {
+	mu    sync.Mutex
+	pipes map[component.ID]pipeline.Pipeline
+}{pipes: map[component.ID]pipeline.Pipeline{}}
+
+func init() {
+	expvar.Publish(pipelinesVar, expvar.Func(func() any {
+		return Pipelines()
+	}))
+}
+
+func registerPipeline(id component.ID, pipe pipeline.Pipeline) {
+	runningPipelines.mu.Lock()
+	defer runningPipelines.mu.Unlock()
+	runningPipelines.pipes[id] = pipe
+}
+
+func unregisterPipeline(id component.ID) {
+	runningPipelines.mu.Lock()
+	defer runningPipelines.mu.Unlock()
+	delete(runningPipelines.pipes, id)
+}
+
+// Pipelines describes the pipelines of the running stanza-based receivers, sorted by
+// receiver ID, for troubleshooting.
+func Pipelines() []PipelineStatus {
+	runningPipelines.mu.Lock()
+	defer runningPipelines.mu.Unlock()
+
+	statuses := make([]PipelineStatus, 0, len(runningPipelines.pipes))
+	for id, pipe := range runningPipelines.pipes {
+		status := PipelineStatus{Receiver: id.String()}
+		for _, op := range pipe.Operators() {
+			status.Operators = append(status.Operators, operatorStatus(op))
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Receiver < statuses[j].Receiver
+	})
+	return statuses
+}
+
+func operatorStatus(op operator.Operator) OperatorStatus {
+	status := OperatorStatus{
+		ID:   op.ID(),
+		Type: op.Type(),
+	}
+	for _, output := range op.Outputs() {
+		status.Outputs = append(status.Outputs, output.ID())
+	}
+	if reporter, ok := op.(operator.StatsReporter); ok {
+		stats := reporter.Stats()
+		status.Stats = &stats
+	}
+	if tracker, ok := op.(operator.FileTracker); ok {
+		status.Files = tracker.TrackedFiles()
+	}
+	return status
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adapter
+
+import (
+	"context"
+	encodingjson "encoding/json"
+	"expvar"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/file"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/json"
+)
+
+func TestPipelines(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "test.log")
+	require.NoError(t, os.WriteFile(filePath, []byte("{\"a\":1}\nnot json\n"), 0600))
+
+	fileCfg := file.NewConfig()
+	fileCfg.Include = []string{filePath}
+	fileCfg.StartAt = "beginning"
+	fileCfg.PollInterval = 10 * time.Millisecond
+	parserCfg := json.NewConfig()
+	parserCfg.OnError = helper.SendOnError
+
+	factory := NewFactory(TestReceiverType{}, component.StabilityLevelDevelopment)
+	cfg := factory.CreateDefaultConfig().(*TestConfig)
+	cfg.Input = operator.NewConfig(fileCfg)
+	cfg.Operators = []operator.Config{operator.NewConfig(parserCfg)}
+	sink := &consumertest.LogsSink{}
+	set := receivertest.NewNopCreateSettings()
+	set.ID = component.NewIDWithName(testType, "introspected")
+	rcvr, err := factory.CreateLogsReceiver(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 10*time.Second, 10*time.Millisecond)
+
+	status := findPipeline(t, Pipelines(), set.ID.String())
+	require.Len(t, status.Operators, 3)
+
+	input := status.Operators[0]
+	assert.Equal(t, "file_input", input.Type)
+	assert.Equal(t, []string{"json_parser"}, input.Outputs)
+	require.NotNil(t, input.Stats)
+	assert.Equal(t, operator.Stats{Written: 2}, *input.Stats)
+	require.Eventually(t, func() bool {
+		files := findPipeline(t, Pipelines(), set.ID.String()).Operators[0].Files
+		return len(files) == 1 && files[0].Offset == 17
+	}, 10*time.Second, 10*time.Millisecond)
+	files := findPipeline(t, Pipelines(), set.ID.String()).Operators[0].Files
+	assert.Equal(t, []operator.TrackedFile{{Path: filePath, Offset: 17, Size: 17}}, files)
+
+	parser := status.Operators[1]
+	assert.Equal(t, "json_parser", parser.Type)
+	require.NotNil(t, parser.Stats)
+	assert.Equal(t, operator.Stats{Written: 2, Errors: 1}, *parser.Stats)
+	assert.Empty(t, parser.Files)
+
+	// the pipelines are published as an expvar
+	var published []PipelineStatus
+	require.NoError(t, encodingjson.Unmarshal([]byte(expvar.Get(pipelinesVar).String()), &published))
+	assert.Equal(t, "json_parser", findPipeline(t, published, set.ID.String()).Operators[1].ID)
+	assert.Equal(t, filePath, findPipeline(t, published, set.ID.String()).Operators[0].Files[0].Path)
+
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+	for _, status := range Pipelines() {
+		assert.NotEqual(t, set.ID.String(), status.Receiver)
+	}
+}
+
+func findPipeline(t *testing.T, statuses []PipelineStatus, receiver string) PipelineStatus {
+	for _, status := range statuses {
+		if status.Receiver == receiver {
+			return status
+		}
+	}
+	require.Failf(t, "missing pipeline", "no pipeline for receiver %s", receiver)
+	return PipelineStatus{}
+}
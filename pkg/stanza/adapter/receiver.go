@@ -31,8 +31,10 @@ type receiver struct {
 	logger    *zap.Logger
 	obsrecv   *obsreport.Receiver
 
-	storageID     *component.ID
-	storageClient storage.Client
+	storageID          *component.ID
+	storageClient      storage.Client
+	storageNamespace   string
+	migrateStorageFrom []component.ID
 }
 
 // Ensure this receiver adheres to required interface
@@ -53,6 +55,7 @@ func (r *receiver) Start(ctx context.Context, host component.Host) error {
 	}
 
 	r.converter.Start()
+	registerPipeline(r.id, r.pipe)
 
 	// Below we're starting 2 loops:
 	// * one which reads all the logs produced by the emitter and then forwards
@@ -137,6 +140,7 @@ func (r *receiver) Shutdown(ctx context.Context) error {
 	}
 
 	r.logger.Info("Stopping stanza receiver")
+	unregisterPipeline(r.id)
 	pipelineErr := r.pipe.Stop()
 	r.converter.Stop()
 	r.cancel()
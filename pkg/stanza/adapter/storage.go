@@ -9,6 +9,10 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 )
 
 func GetStorageClient(ctx context.Context, host component.Host, storageID *component.ID, componentID component.ID) (storage.Client, error) {
@@ -30,11 +34,93 @@ func GetStorageClient(ctx context.Context, host component.Host, storageID *compo
 
 }
 
+// MigrateStorage moves the values of keys from one storage client to another, and returns
+// the number of keys moved. Keys which already hold a value in the destination are left
+// untouched in both clients.
+func MigrateStorage(ctx context.Context, from, to storage.Client, keys []string) (int, error) {
+	var moved int
+	for _, key := range keys {
+		existing, err := to.Get(ctx, key)
+		if err != nil {
+			return moved, err
+		}
+		if existing != nil {
+			continue
+		}
+
+		value, err := from.Get(ctx, key)
+		if err != nil {
+			return moved, err
+		}
+		if value == nil {
+			continue
+		}
+		if err = to.Set(ctx, key, value); err != nil {
+			return moved, err
+		}
+		if err = from.Delete(ctx, key); err != nil {
+			return moved, err
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// storageClientID returns the ID under which the receiver's state is stored.
+func (r *receiver) storageClientID() component.ID {
+	if r.storageNamespace == "" {
+		return r.id
+	}
+	return component.NewIDWithName(r.id.Type(), r.storageNamespace)
+}
+
 func (r *receiver) setStorageClient(ctx context.Context, host component.Host) error {
-	client, err := GetStorageClient(ctx, host, r.storageID, r.id)
+	client, err := GetStorageClient(ctx, host, r.storageID, r.storageClientID())
 	if err != nil {
 		return err
 	}
 	r.storageClient = client
+	return r.migrateStorage(ctx, host)
+}
+
+// migrateStorage moves the state stored under each of the previous IDs of the receiver to its storage client.
+func (r *receiver) migrateStorage(ctx context.Context, host component.Host) error {
+	if len(r.migrateStorageFrom) == 0 {
+		return nil
+	}
+
+	keys := r.persistedKeys()
+	for _, id := range r.migrateStorageFrom {
+		if id == r.storageClientID() {
+			continue
+		}
+		client, err := GetStorageClient(ctx, host, r.storageID, id)
+		if err != nil {
+			return err
+		}
+		moved, err := MigrateStorage(ctx, client, r.storageClient, keys)
+		if err = multierr.Append(err, client.Close(ctx)); err != nil {
+			return fmt.Errorf("migrate from '%s': %w", id, err)
+		}
+		if moved > 0 {
+			r.logger.Info("Migrated persisted state", zap.Stringer("from", id), zap.Int("keys", moved))
+		}
+	}
 	return nil
 }
+
+// persistedKeys lists the keys persisted by the operators of the pipeline,
+// as scoped by the pipeline.
+func (r *receiver) persistedKeys() []string {
+	var keys []string
+	for _, op := range r.pipe.Operators() {
+		lister, ok := op.(operator.PersistedKeysLister)
+		if !ok {
+			continue
+		}
+		for _, key := range lister.PersistedKeys() {
+			keys = append(keys, fmt.Sprintf("%s.%s", op.ID(), key))
+		}
+	}
+	return keys
+}
@@ -5,6 +5,7 @@ package adapter
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,8 @@ import (
 	rcvr "go.opentelemetry.io/collector/receiver"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/file"
 )
 
 func TestStorage(t *testing.T) {
@@ -104,6 +107,107 @@ func TestFailOnNonStorageExtension(t *testing.T) {
 	require.Equal(t, "storage client: non-storage extension 'non_storage/non' found", err.Error())
 }
 
+func TestStorageNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	storageExt := storagetest.NewFileBackedStorageExtension("test", t.TempDir())
+	host := storagetest.NewStorageHost().
+		WithExtension(storageExt.ID, storageExt)
+
+	r := createReceiver(t, storageExt.ID)
+	r.id = component.NewIDWithName(testType, "before")
+	r.storageNamespace = "logs"
+	require.NoError(t, r.Start(ctx, host))
+	require.NoError(t, r.storageClient.Set(ctx, "key", []byte("value")))
+	require.NoError(t, r.Shutdown(ctx))
+
+	// A renamed receiver with the same namespace finds its state
+	r = createReceiver(t, storageExt.ID)
+	r.id = component.NewIDWithName(testType, "after")
+	r.storageNamespace = "logs"
+	require.NoError(t, r.Start(ctx, host))
+	val, err := r.storageClient.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), val)
+	require.NoError(t, r.Shutdown(ctx))
+}
+
+func TestMigrateStorageFrom(t *testing.T) {
+	ctx := context.Background()
+
+	storageExt := storagetest.NewFileBackedStorageExtension("test", t.TempDir())
+	host := storagetest.NewStorageHost().
+		WithExtension(storageExt.ID, storageExt)
+
+	oldID := component.NewIDWithName(testType, "old")
+	oldClient, err := storageExt.GetClient(ctx, component.KindReceiver, oldID, "")
+	require.NoError(t, err)
+	require.NoError(t, oldClient.Set(ctx, "file_input.knownFiles", []byte("0\n")))
+	require.NoError(t, oldClient.Set(ctx, "unrelated", []byte("kept")))
+	require.NoError(t, oldClient.Close(ctx))
+
+	inputCfg := file.NewConfig()
+	inputCfg.Include = []string{filepath.Join(t.TempDir(), "*.log")}
+	cfg := TestReceiverType{}.CreateDefaultConfig().(*TestConfig)
+	cfg.Input = operator.NewConfig(inputCfg)
+	cfg.StorageID = &storageExt.ID
+	cfg.MigrateStorageFrom = []component.ID{oldID}
+
+	params := rcvr.CreateSettings{
+		ID:                component.NewIDWithName(testType, "new"),
+		TelemetrySettings: componenttest.NewNopTelemetrySettings(),
+	}
+	factory := NewFactory(TestReceiverType{}, component.StabilityLevelDevelopment)
+	r, err := factory.CreateLogsReceiver(ctx, params, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, r.Start(ctx, host))
+
+	val, err := r.(*receiver).storageClient.Get(ctx, "file_input.knownFiles")
+	require.NoError(t, err)
+	require.Equal(t, []byte("0\n"), val)
+	require.NoError(t, r.Shutdown(ctx))
+
+	// Only the keys persisted by the pipeline are moved
+	oldClient, err = storageExt.GetClient(ctx, component.KindReceiver, oldID, "")
+	require.NoError(t, err)
+	val, err = oldClient.Get(ctx, "file_input.knownFiles")
+	require.NoError(t, err)
+	require.Nil(t, val)
+	val, err = oldClient.Get(ctx, "unrelated")
+	require.NoError(t, err)
+	require.Equal(t, []byte("kept"), val)
+	require.NoError(t, oldClient.Close(ctx))
+}
+
+func TestMigrateStorage(t *testing.T) {
+	ctx := context.Background()
+	from := storagetest.NewInMemoryClient(component.KindReceiver, component.NewIDWithName(testType, "from"), "")
+	to := storagetest.NewInMemoryClient(component.KindReceiver, component.NewIDWithName(testType, "to"), "")
+
+	require.NoError(t, from.Set(ctx, "moved", []byte("a")))
+	require.NoError(t, from.Set(ctx, "conflict", []byte("old")))
+	require.NoError(t, to.Set(ctx, "conflict", []byte("new")))
+
+	moved, err := MigrateStorage(ctx, from, to, []string{"moved", "conflict", "missing"})
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+
+	val, err := to.Get(ctx, "moved")
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), val)
+	val, err = from.Get(ctx, "moved")
+	require.NoError(t, err)
+	require.Nil(t, val)
+
+	// Existing state in the destination wins
+	val, err = to.Get(ctx, "conflict")
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), val)
+	val, err = from.Get(ctx, "conflict")
+	require.NoError(t, err)
+	require.Equal(t, []byte("old"), val)
+}
+
 func createReceiver(t *testing.T, storageID component.ID) *receiver {
 	params := rcvr.CreateSettings{
 		TelemetrySettings: componenttest.NewNopTelemetrySettings(),
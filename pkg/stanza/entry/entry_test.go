@@ -136,6 +136,7 @@ func TestCopy(t *testing.T) {
 	entry.SpanID = []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
 	entry.TraceFlags = []byte{0x01}
 	entry.ScopeName = "my.logger"
+	entry.ScopeVersion = "1.0.0"
 	cp := entry.Copy()
 
 	entry.Severity = Severity(1)
@@ -148,6 +149,7 @@ func TestCopy(t *testing.T) {
 	entry.SpanID[0] = 0xff
 	entry.TraceFlags[0] = 0xff
 	entry.ScopeName = "foo"
+	entry.ScopeVersion = "2.0.0"
 
 	require.Equal(t, now, cp.ObservedTimestamp)
 	require.Equal(t, time.Time{}, cp.Timestamp)
@@ -160,6 +162,7 @@ func TestCopy(t *testing.T) {
 	require.Equal(t, []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}, cp.SpanID)
 	require.Equal(t, []byte{0x01}, cp.TraceFlags)
 	require.Equal(t, "my.logger", cp.ScopeName)
+	require.Equal(t, "1.0.0", cp.ScopeVersion)
 }
 
 func TestCopyNil(t *testing.T) {
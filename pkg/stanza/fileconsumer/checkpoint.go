@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+)
+
+// PersistedKeys lists the keys under which a Manager persists its state.
+func PersistedKeys() []string {
+	return []string{knownFilesKey, completedFilesKey}
+}
+
+// Checkpoint is the persisted position of a Manager in one file.
+type Checkpoint struct {
+	// Fingerprint holds the first bytes of the file, which identify it.
+	Fingerprint    []byte
+	Offset         int64
+	FileAttributes map[string]any
+
+	// raw is the checkpoint as it was persisted, so that it is saved back unchanged.
+	raw json.RawMessage
+}
+
+// LoadCheckpoints returns the checkpoints a Manager persisted with the given persister.
+func LoadCheckpoints(ctx context.Context, persister operator.Persister) ([]Checkpoint, error) {
+	encoded, err := persister.Get(ctx, knownFilesKey)
+	if err != nil {
+		return nil, err
+	}
+	if encoded == nil {
+		return nil, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(encoded))
+	var count int
+	if err = dec.Decode(&count); err != nil {
+		return nil, fmt.Errorf("decoding file count: %w", err)
+	}
+
+	checkpoints := make([]Checkpoint, 0, count)
+	for i := 0; i < count; i++ {
+		var raw json.RawMessage
+		if err = dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("decoding checkpoint: %w", err)
+		}
+		var decoded struct {
+			Fingerprint struct {
+				FirstBytes []byte
+			}
+			Offset         int64
+			FileAttributes map[string]any
+		}
+		if err = json.Unmarshal(raw, &decoded); err != nil {
+			return nil, fmt.Errorf("decoding checkpoint: %w", err)
+		}
+		checkpoints = append(checkpoints, Checkpoint{
+			Fingerprint:    decoded.Fingerprint.FirstBytes,
+			Offset:         decoded.Offset,
+			FileAttributes: decoded.FileAttributes,
+			raw:            raw,
+		})
+	}
+	return checkpoints, nil
+}
+
+// PruneCheckpoints removes the persisted checkpoints for which keep returns false,
+// and returns the number of checkpoints removed. It must not be used on the state
+// of a running Manager, which would overwrite it on its next poll.
+func PruneCheckpoints(ctx context.Context, persister operator.Persister, keep func(Checkpoint) bool) (int, error) {
+	checkpoints, err := LoadCheckpoints(ctx, persister)
+	if err != nil {
+		return 0, err
+	}
+
+	kept := make([]Checkpoint, 0, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		if keep(checkpoint) {
+			kept = append(kept, checkpoint)
+		}
+	}
+	removed := len(checkpoints) - len(kept)
+	if removed == 0 {
+		return 0, nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	if err = enc.Encode(len(kept)); err != nil {
+		return 0, err
+	}
+	for _, checkpoint := range kept {
+		if err = enc.Encode(checkpoint.raw); err != nil {
+			return 0, err
+		}
+	}
+	if err = persister.Set(ctx, knownFilesKey, buf.Bytes()); err != nil {
+		return 0, err
+	}
+	return removed, nil
+}
+
+// TrackedFiles returns the files known to the running Manager and the offsets they are
+// read from. The files whose reader was restored from a checkpoint and not matched since
+// have no path.
+func (m *Manager) TrackedFiles() []operator.TrackedFile {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	files := make([]operator.TrackedFile, 0, len(m.knownFiles))
+	for _, r := range m.knownFiles {
+		file := operator.TrackedFile{Offset: r.Offset, Size: -1}
+		if r.file != nil {
+			file.Path = r.file.Name()
+			if info, err := os.Stat(file.Path); err == nil {
+				file.Size = info.Size()
+			}
+		}
+		files = append(files, file)
+	}
+	return files
+}
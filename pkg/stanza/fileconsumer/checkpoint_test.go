@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stanzaoperator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestLoadCheckpointsEmpty(t *testing.T) {
+	checkpoints, err := LoadCheckpoints(context.Background(), testutil.NewMockPersister("test"))
+	require.NoError(t, err)
+	require.Empty(t, checkpoints)
+}
+
+func TestPruneCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	persister := testutil.NewMockPersister("test")
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+
+	keep := openTemp(t, tempDir)
+	writeString(t, keep, "keep1\n")
+	prune := openTemp(t, tempDir)
+	writeString(t, prune, "prune1\n")
+
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = persister
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("keep1"), []byte("prune1")})
+	operator.poll(context.Background())
+	require.NoError(t, operator.Stop())
+
+	checkpoints, err := LoadCheckpoints(context.Background(), persister)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 2)
+	offsets := map[any]int64{
+		filepath.Base(keep.Name()):  int64(len("keep1\n")),
+		filepath.Base(prune.Name()): int64(len("prune1\n")),
+	}
+	for _, checkpoint := range checkpoints {
+		require.Equal(t, offsets[checkpoint.FileAttributes[logFileName]], checkpoint.Offset)
+	}
+
+	removed, err := PruneCheckpoints(context.Background(), persister, func(c Checkpoint) bool {
+		return c.FileAttributes[logFileName] != filepath.Base(prune.Name())
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	checkpoints, err = LoadCheckpoints(context.Background(), persister)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 1)
+	require.Equal(t, []byte("keep1\n"), checkpoints[0].Fingerprint)
+
+	// The pruned file is read again from the beginning, the kept one is resumed
+	writeString(t, keep, "keep2\n")
+	operator, emitCalls = buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(persister))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+	waitForTokens(t, emitCalls, [][]byte{[]byte("keep2"), []byte("prune1")})
+}
+
+func TestTrackedFiles(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+
+	file := openTemp(t, tempDir)
+	writeString(t, file, "line1\n")
+
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	require.Empty(t, operator.TrackedFiles())
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("line1"))
+	require.Equal(t, []stanzaoperator.TrackedFile{{Path: file.Name(), Offset: 6, Size: 6}}, operator.TrackedFiles())
+
+	// the size is the one of the file when it is inspected
+	writeString(t, file, "line2\n")
+	require.Equal(t, []stanzaoperator.TrackedFile{{Path: file.Name(), Offset: 6, Size: 12}}, operator.TrackedFiles())
+	require.NoError(t, operator.Stop())
+}
+
+func TestTrackedFilesWhileStopping(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+
+	file := openTemp(t, tempDir)
+	writeString(t, file, "line1\n")
+
+	operator, emitCalls := buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(testutil.NewMockPersister("test")))
+	waitForToken(t, emitCalls, []byte("line1"))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			operator.TrackedFiles()
+		}
+	}()
+	require.NoError(t, operator.Stop())
+	<-done
+	require.Empty(t, operator.TrackedFiles())
+}
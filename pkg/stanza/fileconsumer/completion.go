@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+)
+
+const completedFilesKey = "completedFiles"
+
+// CompletionConfig defines when a file is considered complete. Once a complete
+// file has been read to the end, it is no longer polled and its reader is released.
+type CompletionConfig struct {
+	// IdleTimeout marks a file as complete once it has not been written to for this long
+	IdleTimeout time.Duration `mapstructure:"idle_timeout,omitempty"`
+	// SentinelSuffix marks a file as complete once a file with the same path
+	// followed by this suffix exists, e.g. `app.log.done` for `app.log`
+	SentinelSuffix string `mapstructure:"sentinel_suffix,omitempty"`
+}
+
+func (c CompletionConfig) validate() error {
+	if c.IdleTimeout < 0 {
+		return fmt.Errorf("`completion.idle_timeout` must not be negative")
+	}
+	if c.IdleTimeout == 0 && c.SentinelSuffix == "" {
+		return fmt.Errorf("`completion` requires `idle_timeout` or `sentinel_suffix`")
+	}
+	return nil
+}
+
+// completedFile records the state of a file when it was found complete.
+// A file which changes afterwards is polled again, resuming from the
+// recorded reader state.
+type completedFile struct {
+	Size            int64
+	ModTime         time.Time
+	Fingerprint     *fingerprint.Fingerprint
+	Offset          int64
+	FileAttributes  map[string]any
+	HeaderFinalized bool
+}
+
+type completionTracker struct {
+	idleTimeout    time.Duration
+	sentinelSuffix string
+	files          map[string]completedFile
+}
+
+func newCompletionTracker(cfg *CompletionConfig) *completionTracker {
+	if cfg == nil {
+		return nil
+	}
+	return &completionTracker{
+		idleTimeout:    cfg.IdleTimeout,
+		sentinelSuffix: cfg.SentinelSuffix,
+		files:          make(map[string]completedFile),
+	}
+}
+
+// filter removes sentinel files and unchanged completed files from the matched paths.
+// Completed files which changed or no longer match are returned so that their state
+// is tracked again, by fingerprint, in case they were rotated or renamed.
+func (c *completionTracker) filter(paths []string) ([]string, []completedFile) {
+	var changed []completedFile
+	matched := make(map[string]struct{}, len(paths))
+	filtered := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if c.sentinelSuffix != "" && strings.HasSuffix(path, c.sentinelSuffix) {
+			continue
+		}
+		matched[path] = struct{}{}
+
+		if state, ok := c.files[path]; ok {
+			info, err := os.Stat(path)
+			if err == nil && info.Size() == state.Size && info.ModTime().Equal(state.ModTime) {
+				continue
+			}
+			// The file was modified or replaced since it was completed
+			delete(c.files, path)
+			changed = append(changed, state)
+		}
+		filtered = append(filtered, path)
+	}
+
+	for path, state := range c.files {
+		if _, ok := matched[path]; !ok {
+			delete(c.files, path)
+			changed = append(changed, state)
+		}
+	}
+	return filtered, changed
+}
+
+// complete records the reader's file as complete if it was read to the end
+// and meets one of the completion criteria
+func (c *completionTracker) complete(r *reader) bool {
+	if !r.eof {
+		return false
+	}
+
+	info, err := r.file.Stat()
+	if err != nil {
+		return false
+	}
+
+	if (c.idleTimeout > 0 && time.Since(info.ModTime()) >= c.idleTimeout) || c.hasSentinel(r.file.Name()) {
+		c.files[r.file.Name()] = completedFile{
+			Size:            info.Size(),
+			ModTime:         info.ModTime(),
+			Fingerprint:     r.Fingerprint,
+			Offset:          r.Offset,
+			FileAttributes:  r.FileAttributes,
+			HeaderFinalized: r.HeaderFinalized,
+		}
+		return true
+	}
+	return false
+}
+
+func (c *completionTracker) hasSentinel(path string) bool {
+	if c.sentinelSuffix == "" {
+		return false
+	}
+	_, err := os.Stat(path + c.sentinelSuffix)
+	return err == nil
+}
+
+// releaseCompleted closes the readers of completed files and returns the remaining readers
+func (m *Manager) releaseCompleted(readers []*reader) []*reader {
+	active := make([]*reader, 0, len(readers))
+	for _, r := range readers {
+		if m.completion.complete(r) {
+			m.Debugw("File is complete, no longer polling it", "path", r.file.Name())
+			r.Close()
+			continue
+		}
+		active = append(active, r)
+	}
+	return active
+}
+
+// filterCompleted skips unchanged completed files. Completed files which changed or
+// moved are tracked again so that they are read from where they were left.
+func (m *Manager) filterCompleted(paths []string) []string {
+	paths, changed := m.completion.filter(paths)
+	for _, state := range changed {
+		r, err := m.readerFactory.unsafeReader()
+		if err != nil {
+			m.Errorw("Failed to restore completed file", zap.Error(err))
+			continue
+		}
+		r.Fingerprint = state.Fingerprint
+		r.Offset = state.Offset
+		r.FileAttributes = state.FileAttributes
+		r.HeaderFinalized = state.HeaderFinalized
+		m.knownFiles = append(m.knownFiles, r)
+	}
+	return paths
+}
+
+func (m *Manager) syncCompletedFiles(ctx context.Context) {
+	encoded, err := json.Marshal(m.completion.files)
+	if err != nil {
+		m.Errorw("Failed to encode completed files", zap.Error(err))
+		return
+	}
+	if err := m.persister.Set(ctx, completedFilesKey, encoded); err != nil {
+		m.Errorw("Failed to sync completed files to database", zap.Error(err))
+	}
+}
+
+func (m *Manager) loadCompletedFiles(ctx context.Context) error {
+	encoded, err := m.persister.Get(ctx, completedFilesKey)
+	if err != nil {
+		return err
+	}
+	if encoded == nil {
+		return nil
+	}
+	return json.Unmarshal(encoded, &m.completion.files)
+}
@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestCompletionIdleTimeout(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Completion = &CompletionConfig{IdleTimeout: time.Hour}
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	active := openTemp(t, tempDir)
+	writeString(t, active, "active1\n")
+	idle := openTemp(t, tempDir)
+	writeString(t, idle, "idle1\n")
+	past := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(idle.Name(), past, past))
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("active1"), []byte("idle1")})
+
+	// The idle file is no longer tracked
+	require.Len(t, operator.knownFiles, 1)
+	require.Contains(t, operator.completion.files, idle.Name())
+
+	// Unchanged completed files are skipped, active files keep being read
+	writeString(t, active, "active2\n")
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("active2"))
+	expectNoTokens(t, emitCalls)
+
+	// A completed file which is written to again is picked up where it was left
+	writeString(t, idle, "idle2\n")
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("idle2"))
+	require.NotContains(t, operator.completion.files, idle.Name())
+}
+
+func TestCompletionSentinel(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Completion = &CompletionConfig{SentinelSuffix: ".done"}
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog1"))
+	require.Empty(t, operator.completion.files)
+
+	// The writer signals completion. The sentinel itself is not read.
+	writeString(t, temp, "testlog2\n")
+	sentinel := openFile(t, temp.Name()+".done")
+	writeString(t, sentinel, "sentinel\n")
+
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog2"))
+	expectNoTokens(t, emitCalls)
+	require.Contains(t, operator.completion.files, temp.Name())
+	require.Empty(t, operator.knownFiles)
+
+	// Removed files are forgotten
+	require.NoError(t, temp.Close())
+	require.NoError(t, os.Remove(temp.Name()))
+	operator.poll(context.Background())
+	require.Empty(t, operator.completion.files)
+}
+
+func TestCompletionRenamed(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Completion = &CompletionConfig{IdleTimeout: time.Hour}
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+	past := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(temp.Name(), past, past))
+
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog1"))
+	require.Contains(t, operator.completion.files, temp.Name())
+
+	// A completed file which is rotated is recognized by its fingerprint
+	rotated := temp.Name() + ".1"
+	require.NoError(t, os.Rename(temp.Name(), rotated))
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+	require.NotContains(t, operator.completion.files, temp.Name())
+	require.Contains(t, operator.completion.files, rotated)
+
+	// and is picked up where it was left if it is written to again
+	writeString(t, temp, "testlog2\n")
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog2"))
+	expectNoTokens(t, emitCalls)
+}
+
+func TestCompletionPersisted(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	persister := testutil.NewMockPersister("test")
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Completion = &CompletionConfig{SentinelSuffix: ".done"}
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+	openFile(t, temp.Name()+".done")
+
+	operator, emitCalls := buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(persister))
+	waitForToken(t, emitCalls, []byte("testlog1"))
+	require.Eventually(t, func() bool {
+		encoded, err := persister.Get(context.Background(), completedFilesKey)
+		return err == nil && encoded != nil
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, operator.Stop())
+
+	// A restarted operator does not read the completed file again
+	operator, emitCalls = buildTestManager(t, cfg)
+	require.NoError(t, operator.Start(persister))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+	expectNoTokens(t, emitCalls)
+}
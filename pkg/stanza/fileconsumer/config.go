@@ -58,20 +58,24 @@ func NewConfig() *Config {
 
 // Config is the configuration of a file input operator
 type Config struct {
-	matcher.Criteria        `mapstructure:",squash"`
-	IncludeFileName         bool                  `mapstructure:"include_file_name,omitempty"`
-	IncludeFilePath         bool                  `mapstructure:"include_file_path,omitempty"`
-	IncludeFileNameResolved bool                  `mapstructure:"include_file_name_resolved,omitempty"`
-	IncludeFilePathResolved bool                  `mapstructure:"include_file_path_resolved,omitempty"`
-	PollInterval            time.Duration         `mapstructure:"poll_interval,omitempty"`
-	StartAt                 string                `mapstructure:"start_at,omitempty"`
-	FingerprintSize         helper.ByteSize       `mapstructure:"fingerprint_size,omitempty"`
-	MaxLogSize              helper.ByteSize       `mapstructure:"max_log_size,omitempty"`
-	MaxConcurrentFiles      int                   `mapstructure:"max_concurrent_files,omitempty"`
-	MaxBatches              int                   `mapstructure:"max_batches,omitempty"`
-	DeleteAfterRead         bool                  `mapstructure:"delete_after_read,omitempty"`
-	Splitter                helper.SplitterConfig `mapstructure:",squash,omitempty"`
-	Header                  *HeaderConfig         `mapstructure:"header,omitempty"`
+	matcher.Criteria         `mapstructure:",squash"`
+	IncludeFileName          bool                  `mapstructure:"include_file_name,omitempty"`
+	IncludeFilePath          bool                  `mapstructure:"include_file_path,omitempty"`
+	IncludeFileNameResolved  bool                  `mapstructure:"include_file_name_resolved,omitempty"`
+	IncludeFilePathResolved  bool                  `mapstructure:"include_file_path_resolved,omitempty"`
+	FileAttributesAsResource bool                  `mapstructure:"file_attributes_as_resource,omitempty"`
+	PollInterval             time.Duration         `mapstructure:"poll_interval,omitempty"`
+	StartAt                  string                `mapstructure:"start_at,omitempty"`
+	FingerprintSize          helper.ByteSize       `mapstructure:"fingerprint_size,omitempty"`
+	MaxLogSize               helper.ByteSize       `mapstructure:"max_log_size,omitempty"`
+	MaxConcurrentFiles       int                   `mapstructure:"max_concurrent_files,omitempty"`
+	MaxBatches               int                   `mapstructure:"max_batches,omitempty"`
+	DeleteAfterRead          bool                  `mapstructure:"delete_after_read,omitempty"`
+	Exclusive                bool                  `mapstructure:"exclusive,omitempty"`
+	Splitter                 helper.SplitterConfig `mapstructure:",squash,omitempty"`
+	Header                   *HeaderConfig         `mapstructure:"header,omitempty"`
+	Completion               *CompletionConfig     `mapstructure:"completion,omitempty"`
+	Quarantine               *QuarantineConfig     `mapstructure:"quarantine,omitempty"`
 }
 
 type HeaderConfig struct {
@@ -145,7 +149,7 @@ func (c Config) buildManager(logger *zap.SugaredLogger, emit emit.Callback, fact
 		return nil, err
 	}
 
-	return &Manager{
+	m := &Manager{
 		SugaredLogger: logger.With("component", "fileconsumer"),
 		cancel:        func() {},
 		readerFactory: readerFactory{
@@ -158,6 +162,7 @@ func (c Config) buildManager(logger *zap.SugaredLogger, emit emit.Callback, fact
 				includeFilePath:         c.IncludeFilePath,
 				includeFileNameResolved: c.IncludeFileNameResolved,
 				includeFilePathResolved: c.IncludeFilePathResolved,
+				fileAttributesResource:  c.FileAttributesAsResource,
 			},
 			fromBeginning:   startAtBeginning,
 			splitterFactory: factory,
@@ -170,9 +175,16 @@ func (c Config) buildManager(logger *zap.SugaredLogger, emit emit.Callback, fact
 		maxBatchFiles:   c.MaxConcurrentFiles / 2,
 		maxBatches:      c.MaxBatches,
 		deleteAfterRead: c.DeleteAfterRead,
+		completion:      newCompletionTracker(c.Completion),
+		quarantine:      newQuarantineTracker(c.Quarantine),
 		knownFiles:      make([]*reader, 0, 10),
 		seenPaths:       make(map[string]struct{}, 100),
-	}, nil
+	}
+	if c.Exclusive {
+		m.claims = fileClaims
+		m.foreignPaths = make(map[string]struct{})
+	}
+	return m, nil
 }
 
 func (c Config) validate() error {
@@ -212,6 +224,18 @@ func (c Config) validate() error {
 		return errors.New("`max_batches` must not be negative")
 	}
 
+	if c.Completion != nil {
+		if err := c.Completion.validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Quarantine != nil {
+		if err := c.Quarantine.validate(); err != nil {
+			return err
+		}
+	}
+
 	enc, err := c.Splitter.EncodingConfig.Build()
 	if err != nil {
 		return err
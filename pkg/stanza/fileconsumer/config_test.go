@@ -387,6 +387,44 @@ func TestUnmarshal(t *testing.T) {
 					return newMockOperatorConfig(cfg)
 				}(),
 			},
+			{
+				Name: "directory_cache_ttl",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.DirectoryCacheTTL = 150 * time.Millisecond
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "completion",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.Completion = &CompletionConfig{
+						IdleTimeout:    10 * time.Minute,
+						SentinelSuffix: ".done",
+					}
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "exclusive",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.Exclusive = true
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "quarantine",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.Quarantine = &QuarantineConfig{
+						MaxFailures:   5,
+						RetryInterval: 30 * time.Minute,
+					}
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
 			{
 				Name: "header_config",
 				Expect: func() *mockOperatorConfig {
@@ -560,6 +598,68 @@ func TestBuild(t *testing.T) {
 				require.Equal(t, 6, m.maxBatches)
 			},
 		},
+		{
+			"CompletionWithoutCriteria",
+			func(f *Config) {
+				f.Completion = &CompletionConfig{}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"CompletionNegativeIdleTimeout",
+			func(f *Config) {
+				f.Completion = &CompletionConfig{IdleTimeout: -time.Second}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ValidCompletion",
+			func(f *Config) {
+				f.Completion = &CompletionConfig{SentinelSuffix: ".done"}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.completion)
+				require.Equal(t, ".done", m.completion.sentinelSuffix)
+			},
+		},
+		{
+			"QuarantineWithoutMaxFailures",
+			func(f *Config) {
+				f.Quarantine = &QuarantineConfig{RetryInterval: time.Minute}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"QuarantineWithoutRetryInterval",
+			func(f *Config) {
+				f.Quarantine = &QuarantineConfig{MaxFailures: 3}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ValidQuarantine",
+			func(f *Config) {
+				f.Quarantine = &QuarantineConfig{MaxFailures: 3, RetryInterval: time.Minute}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.quarantine)
+				require.Equal(t, 3, m.quarantine.maxFailures)
+			},
+		},
+		{
+			"NegativeDirectoryCacheTTL",
+			func(f *Config) {
+				f.DirectoryCacheTTL = -time.Second
+			},
+			require.Error,
+			nil,
+		},
 		{
 			"HeaderConfigNoFlag",
 			func(f *Config) {
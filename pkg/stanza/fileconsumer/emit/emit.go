@@ -7,4 +7,6 @@ import (
 	"context"
 )
 
-type Callback func(ctx context.Context, token []byte, attrs map[string]any) error
+// Callback is called for each token read from a file. attrs are attributes of the
+// individual record, while resource describes the file the token was read from.
+type Callback func(ctx context.Context, token []byte, attrs map[string]any, resource map[string]any) error
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"sync"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+)
+
+// fileClaims is shared by the exclusive file consumers of the process, so that the files
+// matched by several of them are only read by the first one.
+var fileClaims = newClaimRegistry()
+
+// claimRegistry records the fingerprints of the files read by each exclusive file consumer.
+type claimRegistry struct {
+	mu     sync.Mutex
+	claims map[*Manager][]*fingerprint.Fingerprint
+}
+
+func newClaimRegistry() *claimRegistry {
+	return &claimRegistry{
+		claims: make(map[*Manager][]*fingerprint.Fingerprint),
+	}
+}
+
+// claim records the file of the fingerprint as read by the owner, unless another
+// file consumer already reads it. The fingerprints are compared by prefix since
+// the consumers may have read the growing file at different sizes.
+func (r *claimRegistry) claim(owner *Manager, fp *fingerprint.Fingerprint) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for m, fps := range r.claims {
+		if m == owner {
+			continue
+		}
+		for _, claimed := range fps {
+			if fp.StartsWith(claimed) || claimed.StartsWith(fp) {
+				return false
+			}
+		}
+	}
+	r.claims[owner] = append(r.claims[owner], fp)
+	return true
+}
+
+// update replaces the files claimed by the owner with the files it still tracks
+func (r *claimRegistry) update(owner *Manager, readers []*reader) {
+	fps := make([]*fingerprint.Fingerprint, 0, len(readers))
+	for _, reader := range readers {
+		fps = append(fps, reader.Fingerprint)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.claims[owner] = fps
+}
+
+// release forgets the files claimed by the owner
+func (r *claimRegistry) release(owner *Manager) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.claims, owner)
+}
+
+// claimFile returns whether the file is to be read by this file consumer, and warns
+// once about the files already read by another one.
+func (m *Manager) claimFile(path string, fp *fingerprint.Fingerprint) bool {
+	if m.claims.claim(m, fp) {
+		delete(m.foreignPaths, path)
+		return true
+	}
+	if _, ok := m.foreignPaths[path]; !ok {
+		m.Warnw("File is already read by another file consumer with overlapping include patterns, skipping it", "path", path)
+		m.foreignPaths[path] = struct{}{}
+	}
+	return false
+}
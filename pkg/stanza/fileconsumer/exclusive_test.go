@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func buildExclusiveTestManager(t *testing.T, tempDir string, claims *claimRegistry) (*Manager, chan *emitParams) {
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Exclusive = true
+	operator, emitCalls := buildTestManager(t, cfg)
+	require.Equal(t, fileClaims, operator.claims)
+	operator.claims = claims
+	operator.persister = testutil.NewMockPersister("test")
+	return operator, emitCalls
+}
+
+func TestExclusiveOverlappingManagers(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	claims := newClaimRegistry()
+	first, firstCalls := buildExclusiveTestManager(t, tempDir, claims)
+	second, secondCalls := buildExclusiveTestManager(t, tempDir, claims)
+	defer func() {
+		require.NoError(t, second.Stop())
+	}()
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	// The file is only read by the first file consumer reading it
+	first.poll(context.Background())
+	waitForToken(t, firstCalls, []byte("testlog1"))
+	second.poll(context.Background())
+	expectNoTokens(t, secondCalls)
+	require.Contains(t, second.foreignPaths, temp.Name())
+
+	// The claimed fingerprint matches the file as it grows
+	writeString(t, temp, "testlog2\n")
+	second.poll(context.Background())
+	expectNoTokens(t, secondCalls)
+	first.poll(context.Background())
+	waitForToken(t, firstCalls, []byte("testlog2"))
+
+	// The file is read by the other file consumer once the first one stopped
+	require.NoError(t, first.Stop())
+	second.poll(context.Background())
+	waitForTokens(t, secondCalls, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+	require.NotContains(t, second.foreignPaths, temp.Name())
+}
+
+func TestExclusiveDistinctFiles(t *testing.T) {
+	t.Parallel()
+
+	claims := newClaimRegistry()
+	firstDir, secondDir := t.TempDir(), t.TempDir()
+	first, firstCalls := buildExclusiveTestManager(t, firstDir, claims)
+	second, secondCalls := buildExclusiveTestManager(t, secondDir, claims)
+	defer func() {
+		require.NoError(t, first.Stop())
+		require.NoError(t, second.Stop())
+	}()
+
+	writeString(t, openTemp(t, firstDir), "first\n")
+	writeString(t, openTemp(t, secondDir), "second\n")
+
+	first.poll(context.Background())
+	second.poll(context.Background())
+	waitForToken(t, firstCalls, []byte("first"))
+	waitForToken(t, secondCalls, []byte("second"))
+}
@@ -40,7 +40,14 @@ type Manager struct {
 	maxBatches      int
 	maxBatchFiles   int
 	deleteAfterRead bool
-
+	completion      *completionTracker
+	quarantine      *quarantineTracker
+	// claims is set for exclusive file consumers, foreignPaths are the files read by another one
+	claims       *claimRegistry
+	foreignPaths map[string]struct{}
+
+	// mu guards the known files, which are used by the polls and TrackedFiles
+	mu         sync.Mutex
 	knownFiles []*reader
 	seenPaths  map[string]struct{}
 
@@ -56,6 +63,11 @@ func (m *Manager) Start(persister operator.Persister) error {
 	if err := m.loadLastPollFiles(ctx); err != nil {
 		return fmt.Errorf("read known files from database: %w", err)
 	}
+	if m.completion != nil {
+		if err := m.loadCompletedFiles(ctx); err != nil {
+			return fmt.Errorf("read completed files from database: %w", err)
+		}
+	}
 
 	if _, err := m.fileMatcher.MatchFiles(); err != nil {
 		m.Warnw("finding files", "error", err.Error())
@@ -71,11 +83,16 @@ func (m *Manager) Start(persister operator.Persister) error {
 func (m *Manager) Stop() error {
 	m.cancel()
 	m.wg.Wait()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.roller.cleanup()
 	for _, reader := range m.knownFiles {
 		reader.Close()
 	}
 	m.knownFiles = nil
+	if m.claims != nil {
+		m.claims.release(m)
+	}
 	m.cancel = nil
 	return nil
 }
@@ -103,6 +120,9 @@ func (m *Manager) startPoller(ctx context.Context) {
 
 // poll checks all the watched paths for new entries
 func (m *Manager) poll(ctx context.Context) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	// Increment the generation on all known readers
 	// This is done here because the next generation is about to start
 	for i := 0; i < len(m.knownFiles); i++ {
@@ -117,6 +137,12 @@ func (m *Manager) poll(ctx context.Context) {
 	if err != nil {
 		m.Errorf("error finding files: %s", err)
 	}
+	if m.completion != nil {
+		matches = m.filterCompleted(matches)
+	}
+	if m.quarantine != nil {
+		matches = m.filterQuarantined(matches)
+	}
 
 	for len(matches) > m.maxBatchFiles {
 		m.consume(ctx, matches[:m.maxBatchFiles])
@@ -166,6 +192,16 @@ func (m *Manager) consume(ctx context.Context, paths []string) {
 	}
 	wg.Wait()
 
+	// Release the readers of files which will not be written to anymore
+	if m.completion != nil {
+		readers = m.releaseCompleted(readers)
+	}
+
+	// Release the readers of files which keep failing to be read
+	if m.quarantine != nil {
+		readers = m.releaseQuarantined(ctx, readers)
+	}
+
 	// Save off any files that were not fully read
 	if m.deleteAfterRead {
 		unfinished := make([]*reader, 0, len(readers))
@@ -187,7 +223,13 @@ func (m *Manager) consume(ctx context.Context, paths []string) {
 
 	m.roller.roll(ctx, readers)
 	m.saveCurrent(readers)
+	if m.claims != nil {
+		m.claims.update(m, m.knownFiles)
+	}
 	m.syncLastPollFiles(ctx)
+	if m.completion != nil {
+		m.syncCompletedFiles(ctx)
+	}
 	m.clearCurrentFingerprints()
 }
 
@@ -251,6 +293,14 @@ func (m *Manager) makeReader(path string) *reader {
 		return nil
 	}
 
+	// Exclude the files already read by another exclusive file consumer
+	if m.claims != nil && !m.claimFile(path, fp) {
+		if err := file.Close(); err != nil {
+			m.Errorf("problem closing file", "file", file.Name())
+		}
+		return nil
+	}
+
 	m.currentFps = append(m.currentFps, fp)
 	reader, err := m.newReader(file, fp)
 	if err != nil {
@@ -287,7 +337,17 @@ func (m *Manager) saveCurrent(readers []*reader) {
 func (m *Manager) newReader(file *os.File, fp *fingerprint.Fingerprint) (*reader, error) {
 	// Check if the new path has the same fingerprint as an old path
 	if oldReader, ok := m.findFingerprintMatch(fp); ok {
-		return m.readerFactory.copy(oldReader, file)
+		r, err := m.readerFactory.copy(oldReader, file)
+		if err != nil {
+			return nil, err
+		}
+		// Keep the secondary checksum once the file is large enough to have one,
+		// so that later files sharing the same first bytes can be told apart
+		if r.Fingerprint.SecondaryChecksum == nil && fp.SecondaryChecksum != nil {
+			checksum := *fp.SecondaryChecksum
+			r.Fingerprint.SecondaryChecksum = &checksum
+		}
+		return r, nil
 	}
 
 	// If we don't match any previously known files, create a new reader from scratch
@@ -312,25 +372,34 @@ const knownFilesKey = "knownFiles"
 
 // syncLastPollFiles syncs the most recent set of files to the database
 func (m *Manager) syncLastPollFiles(ctx context.Context) {
+	encoded, err := encodeReaders(m.knownFiles)
+	if err != nil {
+		m.Errorw("Failed to encode known files", zap.Error(err))
+		return
+	}
+
+	if err := m.persister.Set(ctx, knownFilesKey, encoded); err != nil {
+		m.Errorw("Failed to sync to database", zap.Error(err))
+	}
+}
+
+// encodeReaders encodes the number of readers followed by each reader
+func encodeReaders(readers []*reader) ([]byte, error) {
 	var buf bytes.Buffer
 	enc := json.NewEncoder(&buf)
 
 	// Encode the number of known files
-	if err := enc.Encode(len(m.knownFiles)); err != nil {
-		m.Errorw("Failed to encode known files", zap.Error(err))
-		return
+	if err := enc.Encode(len(readers)); err != nil {
+		return nil, err
 	}
 
 	// Encode each known file
-	for _, fileReader := range m.knownFiles {
+	for _, fileReader := range readers {
 		if err := enc.Encode(fileReader); err != nil {
-			m.Errorw("Failed to encode known files", zap.Error(err))
+			return nil, err
 		}
 	}
-
-	if err := m.persister.Set(ctx, knownFilesKey, buf.Bytes()); err != nil {
-		m.Errorw("Failed to sync to database", zap.Error(err))
-	}
+	return buf.Bytes(), nil
 }
 
 // syncLastPollFiles loads the most recent set of files to the database
@@ -345,30 +414,40 @@ func (m *Manager) loadLastPollFiles(ctx context.Context) error {
 		return nil
 	}
 
+	readers, err := m.decodeReaders(encoded)
+	if err != nil {
+		return err
+	}
+
+	if len(readers) > 0 {
+		m.Infow("Resuming from previously known offset(s). 'start_at' setting is not applicable.")
+		m.readerFactory.fromBeginning = true
+	}
+	m.knownFiles = readers
+	return nil
+}
+
+// decodeReaders decodes the readers encoded by encodeReaders
+func (m *Manager) decodeReaders(encoded []byte) ([]*reader, error) {
 	dec := json.NewDecoder(bytes.NewReader(encoded))
 
 	// Decode the number of entries
 	var knownFileCount int
 	if err := dec.Decode(&knownFileCount); err != nil {
-		return fmt.Errorf("decoding file count: %w", err)
-	}
-
-	if knownFileCount > 0 {
-		m.Infow("Resuming from previously known offset(s). 'start_at' setting is not applicable.")
-		m.readerFactory.fromBeginning = true
+		return nil, fmt.Errorf("decoding file count: %w", err)
 	}
 
 	// Decode each of the known files
-	m.knownFiles = make([]*reader, 0, knownFileCount)
+	readers := make([]*reader, 0, knownFileCount)
 	for i := 0; i < knownFileCount; i++ {
 		// Only the offset, fingerprint, and splitter
 		// will be used before this reader is discarded
 		unsafeReader, err := m.readerFactory.unsafeReader()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err = dec.Decode(unsafeReader); err != nil {
-			return err
+			return nil, err
 		}
 
 		// Migrate readers that used FileAttributes.HeaderAttributes
@@ -385,8 +464,8 @@ func (m *Manager) loadLastPollFiles(ctx context.Context) error {
 			}
 		}
 
-		m.knownFiles = append(m.knownFiles, unsafeReader)
+		readers = append(readers, unsafeReader)
 	}
 
-	return nil
+	return readers, nil
 }
@@ -73,6 +73,37 @@ func TestAddFileFields(t *testing.T) {
 	require.Equal(t, temp.Name(), emitCall.attrs[logFilePath])
 	require.Nil(t, emitCall.attrs[logFileNameResolved])
 	require.Nil(t, emitCall.attrs[logFilePathResolved])
+	require.Empty(t, emitCall.resource)
+}
+
+// AddFileFieldsAsResource tests that the `log.file.*` fields are emitted as resource attributes
+// instead of record attributes when FileAttributesAsResource is set to true
+func TestAddFileFieldsAsResource(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.IncludeFileName = true
+	cfg.IncludeFilePath = true
+	cfg.FileAttributesAsResource = true
+	operator, emitCalls := buildTestManager(t, cfg)
+
+	// Create a file, then start
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog\n")
+
+	require.NoError(t, operator.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	emitCall := waitForEmit(t, emitCalls)
+	require.Equal(t, filepath.Base(temp.Name()), emitCall.resource[logFileName])
+	require.Equal(t, temp.Name(), emitCall.resource[logFilePath])
+	require.Nil(t, emitCall.resource[logFileNameResolved])
+	require.Nil(t, emitCall.resource[logFilePathResolved])
+	require.Empty(t, emitCall.attrs)
 }
 
 // AddFileResolvedFields tests that the `log.file.name_resolved` and `log.file.path_resolved` fields are included
@@ -1664,3 +1695,67 @@ func TestStalePartialFingerprintDiscarded(t *testing.T) {
 	waitForTokens(t, emitCalls, [][]byte{[]byte(content), []byte(newContent1), []byte(newContent)})
 	operator.wg.Wait()
 }
+
+// FingerprintCollisionSamePoll tests that distinct files which begin with the
+// same bytes as their fingerprint are not discarded as duplicates of each other
+func TestFingerprintCollisionSamePoll(t *testing.T) {
+	t.Parallel()
+
+	header := "# generated from template v1\n"
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.FingerprintSize = helper.ByteSize(len(header))
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp1 := openTemp(t, tempDir)
+	writeString(t, temp1, header+"first file, first line with enough content\n")
+	temp2 := openTemp(t, tempDir)
+	writeString(t, temp2, header+"second file, first line with enough content\n")
+
+	operator.poll(context.Background())
+
+	waitForTokens(t, emitCalls, [][]byte{
+		[]byte("# generated from template v1"),
+		[]byte("first file, first line with enough content"),
+		[]byte("# generated from template v1"),
+		[]byte("second file, first line with enough content"),
+	})
+}
+
+// FingerprintCollisionAcrossPolls tests that a new file which begins with the
+// same bytes as a previously read file is read from the beginning
+func TestFingerprintCollisionAcrossPolls(t *testing.T) {
+	t.Parallel()
+
+	header := "# generated from template v1\n"
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.FingerprintSize = helper.ByteSize(len(header))
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp1 := openTemp(t, tempDir)
+	writeString(t, temp1, header+"first file, first line with enough content\n")
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{
+		[]byte("# generated from template v1"),
+		[]byte("first file, first line with enough content"),
+	})
+
+	temp2 := openTemp(t, tempDir)
+	writeString(t, temp2, header+"second file, first line with enough content\n")
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{
+		[]byte("# generated from template v1"),
+		[]byte("second file, first line with enough content"),
+	})
+}
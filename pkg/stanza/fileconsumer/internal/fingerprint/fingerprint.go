@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"os"
 )
@@ -19,6 +20,12 @@ const MinSize = 16 // bytes
 // A file's fingerprint is the first N bytes of the file
 type Fingerprint struct {
 	FirstBytes []byte
+
+	// SecondaryChecksum is a checksum of the N bytes which follow FirstBytes.
+	// It is only computed once a file holds at least 2N bytes, and is used to
+	// tell apart distinct files whose first N bytes are identical, such as files
+	// which begin with the same templated header.
+	SecondaryChecksum *uint32 `json:",omitempty"`
 }
 
 // New creates a new fingerprint from an open file
@@ -34,6 +41,20 @@ func New(file *os.File, size int) (*Fingerprint, error) {
 		FirstBytes: buf[:n],
 	}
 
+	if n < size {
+		return fp, nil
+	}
+
+	secondary := make([]byte, size)
+	n, err = file.ReadAt(secondary, int64(size))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("reading secondary fingerprint bytes: %w", err)
+	}
+	if n == size {
+		checksum := crc32.ChecksumIEEE(secondary)
+		fp.SecondaryChecksum = &checksum
+	}
+
 	return fp, nil
 }
 
@@ -41,15 +62,28 @@ func New(file *os.File, size int) (*Fingerprint, error) {
 func (f Fingerprint) Copy() *Fingerprint {
 	buf := make([]byte, len(f.FirstBytes), cap(f.FirstBytes))
 	n := copy(buf, f.FirstBytes)
-	return &Fingerprint{
+	fp := &Fingerprint{
 		FirstBytes: buf[:n],
 	}
+	if f.SecondaryChecksum != nil {
+		checksum := *f.SecondaryChecksum
+		fp.SecondaryChecksum = &checksum
+	}
+	return fp
+}
+
+// SecondaryMatches returns false only if both fingerprints have a secondary
+// checksum and the checksums differ. A missing checksum is not evidence that
+// the files are different.
+func (f Fingerprint) SecondaryMatches(other *Fingerprint) bool {
+	if f.SecondaryChecksum == nil || other.SecondaryChecksum == nil {
+		return true
+	}
+	return *f.SecondaryChecksum == *other.SecondaryChecksum
 }
 
-// Equal returns true if the fingerprints have the same FirstBytes,
-// false otherwise. This does not compare other aspects of the fingerprints
-// because the primary purpose of a fingerprint is to convey a unique
-// identity, and only the FirstBytes field contributes to this goal.
+// Equal returns true if the fingerprints have the same FirstBytes and
+// their secondary checksums do not conflict, false otherwise.
 func (f Fingerprint) Equal(other *Fingerprint) bool {
 	l0 := len(other.FirstBytes)
 	l1 := len(f.FirstBytes)
@@ -61,7 +95,7 @@ func (f Fingerprint) Equal(other *Fingerprint) bool {
 			return false
 		}
 	}
-	return true
+	return f.SecondaryMatches(other)
 }
 
 // StartsWith returns true if the fingerprints are the same
@@ -79,5 +113,5 @@ func (f Fingerprint) StartsWith(old *Fingerprint) bool {
 	if l0 > l1 {
 		return false
 	}
-	return bytes.Equal(old.FirstBytes[:l0], f.FirstBytes[:l0])
+	return bytes.Equal(old.FirstBytes[:l0], f.FirstBytes[:l0]) && f.SecondaryMatches(old)
 }
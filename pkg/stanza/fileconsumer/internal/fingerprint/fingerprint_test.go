@@ -277,3 +277,87 @@ func tokenWithLength(length int) []byte {
 	}
 	return b
 }
+
+func TestNewSecondaryChecksum(t *testing.T) {
+	cases := []struct {
+		name            string
+		fingerprintSize int
+		fileSize        int
+		expectChecksum  bool
+	}{
+		{
+			name:            "fileSmallerThanFingerprint",
+			fingerprintSize: MinSize,
+			fileSize:        MinSize / 2,
+			expectChecksum:  false,
+		},
+		{
+			name:            "fileSmallerThanSecondaryWindow",
+			fingerprintSize: MinSize,
+			fileSize:        2*MinSize - 1,
+			expectChecksum:  false,
+		},
+		{
+			name:            "fileExactlySecondaryWindow",
+			fingerprintSize: MinSize,
+			fileSize:        2 * MinSize,
+			expectChecksum:  true,
+		},
+		{
+			name:            "fileLargerThanSecondaryWindow",
+			fingerprintSize: MinSize,
+			fileSize:        DefaultSize,
+			expectChecksum:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			temp, err := os.CreateTemp(t.TempDir(), "")
+			require.NoError(t, err)
+			defer temp.Close()
+
+			_, err = temp.Write(tokenWithLength(tc.fileSize))
+			require.NoError(t, err)
+
+			fp, err := New(temp, tc.fingerprintSize)
+			require.NoError(t, err)
+			if tc.expectChecksum {
+				require.NotNil(t, fp.SecondaryChecksum)
+			} else {
+				require.Nil(t, fp.SecondaryChecksum)
+			}
+		})
+	}
+}
+
+func TestSecondaryChecksumCollision(t *testing.T) {
+	checksum := func(v uint32) *uint32 { return &v }
+
+	header := []byte("identical header")
+	noChecksum := &Fingerprint{FirstBytes: header}
+	one := &Fingerprint{FirstBytes: header, SecondaryChecksum: checksum(1)}
+	oneAgain := &Fingerprint{FirstBytes: header, SecondaryChecksum: checksum(1)}
+	two := &Fingerprint{FirstBytes: header, SecondaryChecksum: checksum(2)}
+
+	// A missing checksum cannot tell files apart
+	require.True(t, one.Equal(noChecksum))
+	require.True(t, noChecksum.Equal(one))
+	require.True(t, one.StartsWith(noChecksum))
+	require.True(t, noChecksum.StartsWith(one))
+
+	require.True(t, one.Equal(oneAgain))
+	require.True(t, one.StartsWith(oneAgain))
+
+	// Identical first bytes with different checksums are different files
+	require.False(t, one.Equal(two))
+	require.False(t, two.Equal(one))
+	require.False(t, one.StartsWith(two))
+	require.False(t, two.StartsWith(one))
+
+	// Copies keep their own checksum
+	cp := one.Copy()
+	require.Equal(t, uint32(1), *cp.SecondaryChecksum)
+	*cp.SecondaryChecksum = 3
+	require.Equal(t, uint32(1), *one.SecondaryChecksum)
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package finder // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher/internal/finder"
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// sharedDirCache is used by every finder in the process, so that receivers
+// watching overlapping directory trees list each directory once per poll.
+var sharedDirCache = newDirCache()
+
+type cachedDir struct {
+	entries []fs.DirEntry
+	err     error
+	listed  time.Time
+	// ttl is the longest maxAge with which the directory was read, which is how
+	// long the listing is kept
+	ttl time.Duration
+}
+
+// dirCache caches directory listings by path.
+type dirCache struct {
+	mu     sync.Mutex
+	dirs   map[string]cachedDir
+	pruned time.Time
+}
+
+func newDirCache() *dirCache {
+	return &dirCache{dirs: make(map[string]cachedDir)}
+}
+
+// readDir returns the entries of dir, listing it again only if the cached
+// listing is older than maxAge.
+func (c *dirCache) readDir(dir string, maxAge time.Duration) ([]fs.DirEntry, error) {
+	// Relative paths are resolved so that finders in different working
+	// directories do not share listings.
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	cached, ok := c.dirs[dir]
+	if ok && maxAge > cached.ttl {
+		cached.ttl = maxAge
+		c.dirs[dir] = cached
+	}
+	c.mu.Unlock()
+	if ok && time.Since(cached.listed) < maxAge {
+		return cached.entries, cached.err
+	}
+
+	entries, err := os.ReadDir(dir)
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ttl := maxAge
+	if cached, ok := c.dirs[dir]; ok && cached.ttl > ttl {
+		ttl = cached.ttl
+	}
+	c.dirs[dir] = cachedDir{entries: entries, err: err, listed: now, ttl: ttl}
+	// Forget directories which no glob has listed within their ttl
+	if now.Sub(c.pruned) >= maxAge {
+		for d, cd := range c.dirs {
+			if now.Sub(cd.listed) >= cd.ttl {
+				delete(c.dirs, d)
+			}
+		}
+		c.pruned = now
+	}
+	return entries, err
+}
+
+// cachedFS is an fs.FS rooted at base which lists directories through a dirCache.
+type cachedFS struct {
+	fs.FS
+	base   string
+	cache  *dirCache
+	maxAge time.Duration
+}
+
+var _ fs.ReadDirFS = cachedFS{}
+
+func (c cachedFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+	return c.cache.readDir(filepath.Join(c.base, filepath.FromSlash(name)), c.maxAge)
+}
+
+func (c cachedFS) Stat(name string) (fs.FileInfo, error) {
+	return fs.Stat(c.FS, name)
+}
+
+// cachedGlob behaves like doublestar.FilepathGlob, except that directories are
+// listed through the cache.
+func cachedGlob(cache *dirCache, maxAge time.Duration) globFunc {
+	return func(pattern string, opts ...doublestar.GlobOption) ([]string, error) {
+		slashed := filepath.ToSlash(filepath.Clean(pattern))
+		base, f := doublestar.SplitPattern(slashed)
+		if f == "" || f == "." || f == ".." {
+			return doublestar.FilepathGlob(pattern, opts...)
+		}
+
+		fsys := cachedFS{FS: os.DirFS(base), base: filepath.FromSlash(base), cache: cache, maxAge: maxAge}
+		matches, err := doublestar.Glob(fsys, f, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range matches {
+			matches[i] = filepath.FromSlash(path.Join(base, matches[i]))
+		}
+		return matches, nil
+	}
+}
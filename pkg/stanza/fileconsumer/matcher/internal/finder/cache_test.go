@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package finder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedGlobReusesListing(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.log"), []byte("a"), 0600))
+
+	cache := newDirCache()
+	glob := cachedGlob(cache, time.Hour)
+	pattern := filepath.Join(dir, "*.log")
+
+	matches, err := glob(pattern, doublestar.WithFilesOnly())
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a.log")}, matches)
+
+	// A second glob over the same directory is served from the cache
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.log"), []byte("b"), 0600))
+	matches, err = cachedGlob(cache, time.Hour)(filepath.Join(dir, "*"), doublestar.WithFilesOnly())
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a.log")}, matches)
+
+	// An expired listing is read again
+	matches, err = cachedGlob(cache, time.Nanosecond)(pattern, doublestar.WithFilesOnly())
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a.log"), filepath.Join(dir, "b.log")}, matches)
+}
+
+func TestDirCachePrune(t *testing.T) {
+	cache := newDirCache()
+	a, b, c := t.TempDir(), t.TempDir(), t.TempDir()
+
+	_, err := cache.readDir(a, time.Millisecond)
+	require.NoError(t, err)
+	_, err = cache.readDir(b, time.Hour)
+	require.NoError(t, err)
+	_, err = cache.readDir(c, time.Millisecond)
+	require.NoError(t, err)
+	require.Len(t, cache.dirs, 3)
+
+	// Directories are pruned by the longest maxAge they were read with, not
+	// the one of the caller, so the listing of b is still fresh
+	time.Sleep(10 * time.Millisecond)
+	_, err = cache.readDir(a, time.Millisecond)
+	require.NoError(t, err)
+	require.Len(t, cache.dirs, 2)
+	require.Contains(t, cache.dirs, a)
+	require.Contains(t, cache.dirs, b)
+
+	// A directory read with a longer maxAge keeps it
+	_, err = cache.readDir(a, time.Hour)
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	_, err = cache.readDir(c, time.Millisecond)
+	require.NoError(t, err)
+	require.Contains(t, cache.dirs, a)
+}
+
+func TestDirCacheMissingDir(t *testing.T) {
+	cache := newDirCache()
+	_, err := cache.readDir(filepath.Join(t.TempDir(), "missing"), time.Hour)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
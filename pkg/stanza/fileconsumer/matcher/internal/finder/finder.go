@@ -5,6 +5,7 @@ package finder // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/bmatcuk/doublestar/v4"
 )
@@ -19,11 +20,23 @@ func Validate(globs []string) error {
 	return nil
 }
 
+type globFunc func(pattern string, opts ...doublestar.GlobOption) ([]string, error)
+
 // FindFiles gets a list of paths given an array of glob patterns to include and exclude
 func FindFiles(includes []string, excludes []string) []string {
+	return findFiles(includes, excludes, doublestar.FilepathGlob)
+}
+
+// FindFilesCached is like FindFiles, but directory listings are shared with every other
+// cached search in the process and reused for up to maxAge.
+func FindFilesCached(includes []string, excludes []string, maxAge time.Duration) []string {
+	return findFiles(includes, excludes, cachedGlob(sharedDirCache, maxAge))
+}
+
+func findFiles(includes []string, excludes []string, glob globFunc) []string {
 	all := make([]string, 0, len(includes))
 	for _, include := range includes {
-		matches, _ := doublestar.FilepathGlob(include, doublestar.WithFilesOnly()) // compile error checked in build
+		matches, _ := glob(include, doublestar.WithFilesOnly()) // compile error checked in build
 	INCLUDE:
 		for _, match := range matches {
 			for _, exclude := range excludes {
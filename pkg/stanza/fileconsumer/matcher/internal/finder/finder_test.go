@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -185,6 +186,7 @@ func TestFindFiles(t *testing.T) {
 				require.NoError(t, file.Close())
 			}
 			assert.Equal(t, tc.expected, FindFiles(tc.include, tc.exclude))
+			assert.Equal(t, tc.expected, FindFilesCached(tc.include, tc.exclude, time.Minute))
 		})
 	}
 }
@@ -6,6 +6,7 @@ package matcher // import "github.com/open-telemetry/opentelemetry-collector-con
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"go.uber.org/multierr"
 
@@ -23,6 +24,10 @@ type Criteria struct {
 	Include          []string         `mapstructure:"include,omitempty"`
 	Exclude          []string         `mapstructure:"exclude,omitempty"`
 	OrderingCriteria OrderingCriteria `mapstructure:"ordering_criteria,omitempty"`
+
+	// DirectoryCacheTTL enables the process-wide directory listing cache. Listings
+	// made by any matcher are reused by others for up to this duration.
+	DirectoryCacheTTL time.Duration `mapstructure:"directory_cache_ttl,omitempty"`
 }
 
 type OrderingCriteria struct {
@@ -51,11 +56,15 @@ func New(c Criteria) (*Matcher, error) {
 	if err := finder.Validate(c.Exclude); err != nil {
 		return nil, fmt.Errorf("exclude: %w", err)
 	}
+	if c.DirectoryCacheTTL < 0 {
+		return nil, fmt.Errorf("'directory_cache_ttl' must not be negative")
+	}
 
 	if len(c.OrderingCriteria.SortBy) == 0 {
 		return &Matcher{
-			include: c.Include,
-			exclude: c.Exclude,
+			include:  c.Include,
+			exclude:  c.Exclude,
+			cacheTTL: c.DirectoryCacheTTL,
 		}, nil
 	}
 
@@ -97,6 +106,7 @@ func New(c Criteria) (*Matcher, error) {
 	return &Matcher{
 		include:    c.Include,
 		exclude:    c.Exclude,
+		cacheTTL:   c.DirectoryCacheTTL,
 		regex:      regex,
 		filterOpts: filterOpts,
 	}, nil
@@ -105,13 +115,19 @@ func New(c Criteria) (*Matcher, error) {
 type Matcher struct {
 	include    []string
 	exclude    []string
+	cacheTTL   time.Duration
 	regex      *regexp.Regexp
 	filterOpts []filter.Option
 }
 
 // MatchFiles gets a list of paths given an array of glob patterns to include and exclude
 func (m Matcher) MatchFiles() ([]string, error) {
-	files := finder.FindFiles(m.include, m.exclude)
+	var files []string
+	if m.cacheTTL > 0 {
+		files = finder.FindFilesCached(m.include, m.exclude, m.cacheTTL)
+	} else {
+		files = finder.FindFiles(m.include, m.exclude)
+	}
 	if len(files) == 0 {
 		return files, fmt.Errorf("no files match the configured criteria")
 	}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -58,6 +59,21 @@ func TestNew(t *testing.T) {
 				Exclude: []string{"a.log", "b.log"},
 			},
 		},
+		{
+			name: "DirectoryCacheTTL",
+			criteria: Criteria{
+				Include:           []string{"*.log"},
+				DirectoryCacheTTL: time.Second,
+			},
+		},
+		{
+			name: "DirectoryCacheTTLNegative",
+			criteria: Criteria{
+				Include:           []string{"*.log"},
+				DirectoryCacheTTL: -time.Second,
+			},
+			expectedErr: "'directory_cache_ttl' must not be negative",
+		},
 		{
 			name: "ExcludeInvalidGlob",
 			criteria: Criteria{
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var filesQuarantined = stats.Int64(
+	"fileconsumer_quarantined_files",
+	"Number of files quarantined because they repeatedly failed to be read",
+	stats.UnitDimensionless)
+
+// MetricViews returns the metric views related to the file consumer.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        filesQuarantined.Name(),
+			Measure:     filesQuarantined,
+			Description: filesQuarantined.Description(),
+			Aggregation: view.Sum(),
+		},
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+)
+
+// QuarantineConfig defines when a file which repeatedly fails to be read is quarantined.
+// A quarantined file is not polled until the retry interval elapsed, so that a corrupted
+// file does not log errors at every poll.
+type QuarantineConfig struct {
+	// MaxFailures quarantines a file once this many consecutive polls failed to scan or decode it
+	MaxFailures int `mapstructure:"max_failures,omitempty"`
+	// RetryInterval is how long a quarantined file is not polled before it is read again
+	RetryInterval time.Duration `mapstructure:"retry_interval,omitempty"`
+}
+
+func (c QuarantineConfig) validate() error {
+	if c.MaxFailures <= 0 {
+		return fmt.Errorf("`quarantine.max_failures` must be positive")
+	}
+	if c.RetryInterval <= 0 {
+		return fmt.Errorf("`quarantine.retry_interval` must be positive")
+	}
+	return nil
+}
+
+// quarantinedFile records the state of a file when it was quarantined, so that it is
+// resumed from there once the retry interval elapsed.
+type quarantinedFile struct {
+	until           time.Time
+	failures        int
+	fingerprint     *fingerprint.Fingerprint
+	offset          int64
+	fileAttributes  map[string]any
+	headerFinalized bool
+}
+
+type quarantineTracker struct {
+	maxFailures   int
+	retryInterval time.Duration
+	files         map[string]quarantinedFile
+	now           func() time.Time
+}
+
+func newQuarantineTracker(cfg *QuarantineConfig) *quarantineTracker {
+	if cfg == nil {
+		return nil
+	}
+	return &quarantineTracker{
+		maxFailures:   cfg.MaxFailures,
+		retryInterval: cfg.RetryInterval,
+		files:         make(map[string]quarantinedFile),
+		now:           time.Now,
+	}
+}
+
+// filter removes the quarantined files from the matched paths. The files whose retry
+// interval elapsed are returned so that they can be read again, and the quarantined
+// files which no longer match are forgotten.
+func (q *quarantineTracker) filter(paths []string) ([]string, []quarantinedFile) {
+	var retried []quarantinedFile
+	matched := make(map[string]struct{}, len(paths))
+	filtered := make([]string, 0, len(paths))
+	now := q.now()
+	for _, path := range paths {
+		matched[path] = struct{}{}
+		if state, ok := q.files[path]; ok {
+			if now.Before(state.until) {
+				continue
+			}
+			delete(q.files, path)
+			retried = append(retried, state)
+		}
+		filtered = append(filtered, path)
+	}
+
+	for path := range q.files {
+		if _, ok := matched[path]; !ok {
+			delete(q.files, path)
+		}
+	}
+	return filtered, retried
+}
+
+// quarantine records the reader's file as quarantined if it failed too many
+// consecutive polls
+func (q *quarantineTracker) quarantine(r *reader) bool {
+	if !r.failed {
+		r.failures = 0
+		return false
+	}
+	r.failures++
+	if r.failures < q.maxFailures {
+		return false
+	}
+	q.files[r.file.Name()] = quarantinedFile{
+		until:           q.now().Add(q.retryInterval),
+		failures:        r.failures,
+		fingerprint:     r.Fingerprint,
+		offset:          r.Offset,
+		fileAttributes:  r.FileAttributes,
+		headerFinalized: r.HeaderFinalized,
+	}
+	return true
+}
+
+// releaseQuarantined closes the readers of quarantined files and returns the remaining readers
+func (m *Manager) releaseQuarantined(ctx context.Context, readers []*reader) []*reader {
+	active := make([]*reader, 0, len(readers))
+	for _, r := range readers {
+		if m.quarantine.quarantine(r) {
+			m.Warnw("File repeatedly failed to be read, quarantining it",
+				"path", r.file.Name(),
+				"failures", r.failures,
+				"retry_interval", m.quarantine.retryInterval)
+			stats.Record(ctx, filesQuarantined.M(1))
+			r.Close()
+			continue
+		}
+		active = append(active, r)
+	}
+	return active
+}
+
+// filterQuarantined skips the quarantined files. The files whose retry interval elapsed
+// are tracked again so that they are read from where they were left, and are quarantined
+// again on their next failure.
+func (m *Manager) filterQuarantined(paths []string) []string {
+	paths, retried := m.quarantine.filter(paths)
+	for _, state := range retried {
+		r, err := m.readerFactory.unsafeReader()
+		if err != nil {
+			m.Errorw("Failed to restore quarantined file", zap.Error(err))
+			continue
+		}
+		r.Fingerprint = state.fingerprint
+		r.Offset = state.offset
+		r.FileAttributes = state.fileAttributes
+		r.HeaderFinalized = state.headerFinalized
+		r.failures = state.failures - 1
+		m.knownFiles = append(m.knownFiles, r)
+	}
+	return paths
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+// failingSplitterFactory builds split funcs failing while fail is set, as a corrupted file would
+type failingSplitterFactory struct {
+	fail *atomic.Bool
+}
+
+func (f failingSplitterFactory) Build(int) (bufio.SplitFunc, error) {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if f.fail.Load() {
+			return 0, nil, errors.New("corrupted data")
+		}
+		return bufio.ScanLines(data, atEOF)
+	}, nil
+}
+
+func buildQuarantineTestManager(t *testing.T, tempDir string, fail *atomic.Bool) (*Manager, chan *emitParams) {
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Quarantine = &QuarantineConfig{MaxFailures: 2, RetryInterval: time.Hour}
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	operator.readerFactory.splitterFactory = failingSplitterFactory{fail: fail}
+	t.Cleanup(func() {
+		require.NoError(t, operator.Stop())
+	})
+	return operator, emitCalls
+}
+
+func TestQuarantineRepeatedFailures(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	fail := &atomic.Bool{}
+	fail.Store(true)
+	operator, emitCalls := buildQuarantineTestManager(t, tempDir, fail)
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	// The file is quarantined once it failed max_failures consecutive polls
+	operator.poll(context.Background())
+	require.Empty(t, operator.quarantine.files)
+	operator.poll(context.Background())
+	require.Contains(t, operator.quarantine.files, temp.Name())
+	require.Equal(t, 2, operator.quarantine.files[temp.Name()].failures)
+
+	// Quarantined files are not polled until the retry interval elapsed
+	fail.Store(false)
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+
+	// The file is read again after the retry interval, where it was left
+	operator.quarantine.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog1"))
+	require.Empty(t, operator.quarantine.files)
+
+	writeString(t, temp, "testlog2\n")
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog2"))
+}
+
+func TestQuarantineMetric(t *testing.T) {
+	views := MetricViews()
+	view.Unregister(views...)
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	tempDir := t.TempDir()
+	fail := &atomic.Bool{}
+	fail.Store(true)
+	operator, _ := buildQuarantineTestManager(t, tempDir, fail)
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	operator.poll(context.Background())
+	rows, err := view.RetrieveData(filesQuarantined.Name())
+	require.NoError(t, err)
+	require.Empty(t, rows)
+
+	operator.poll(context.Background())
+	rows, err = view.RetrieveData(filesQuarantined.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, float64(1), rows[0].Data.(*view.SumData).Value)
+
+	// A retried file failing again is counted again
+	operator.quarantine.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	operator.poll(context.Background())
+	rows, err = view.RetrieveData(filesQuarantined.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, float64(2), rows[0].Data.(*view.SumData).Value)
+}
+
+func TestQuarantineRetriedFileFailingAgain(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	fail := &atomic.Bool{}
+	fail.Store(true)
+	operator, _ := buildQuarantineTestManager(t, tempDir, fail)
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	operator.poll(context.Background())
+	operator.poll(context.Background())
+	require.Contains(t, operator.quarantine.files, temp.Name())
+
+	// A retried file failing again is quarantined right away
+	operator.quarantine.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	operator.poll(context.Background())
+	require.Contains(t, operator.quarantine.files, temp.Name())
+}
+
+func TestQuarantineFailuresReset(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	fail := &atomic.Bool{}
+	fail.Store(true)
+	operator, emitCalls := buildQuarantineTestManager(t, tempDir, fail)
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	operator.poll(context.Background())
+	require.Equal(t, 1, operator.knownFiles[len(operator.knownFiles)-1].failures)
+
+	// A successful read resets the consecutive failures
+	fail.Store(false)
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog1"))
+	require.Equal(t, 0, operator.knownFiles[len(operator.knownFiles)-1].failures)
+
+	fail.Store(true)
+	writeString(t, temp, "testlog2\n")
+	operator.poll(context.Background())
+	require.Empty(t, operator.quarantine.files)
+}
+
+func TestQuarantineForgetsRemovedFiles(t *testing.T) {
+	t.Parallel()
+
+	tracker := newQuarantineTracker(&QuarantineConfig{MaxFailures: 1, RetryInterval: time.Hour})
+	tracker.files["a.log"] = quarantinedFile{until: time.Now().Add(time.Hour)}
+	tracker.files["b.log"] = quarantinedFile{until: time.Now().Add(time.Hour)}
+
+	filtered, retried := tracker.filter([]string{"a.log", "c.log"})
+	require.Equal(t, []string{"c.log"}, filtered)
+	require.Empty(t, retried)
+	require.Contains(t, tracker.files, "a.log")
+	require.NotContains(t, tracker.files, "b.log")
+}
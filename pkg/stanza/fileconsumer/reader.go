@@ -27,8 +27,12 @@ type readerConfig struct {
 	includeFilePath         bool
 	includeFileNameResolved bool
 	includeFilePathResolved bool
+	fileAttributesResource  bool
 }
 
+// processFunc handles a token read from the file, along with the file attributes
+type processFunc func(ctx context.Context, token []byte, attrs map[string]any) error
+
 // reader manages a single file
 type reader struct {
 	*zap.SugaredLogger `json:"-"` // json tag excludes embedded fields from storage
@@ -36,14 +40,19 @@ type reader struct {
 	lineSplitFunc bufio.SplitFunc
 	splitFunc     bufio.SplitFunc
 	encoding      helper.Encoding
-	processFunc   emit.Callback
+	processFunc   processFunc
 
 	Fingerprint    *fingerprint.Fingerprint
 	Offset         int64
 	generation     int
 	file           *os.File
 	FileAttributes map[string]any
+	FileResource   map[string]any `json:"-"` // recomputed from the path whenever the reader is built
 	eof            bool
+	// failed records whether the last read failed to scan or decode the file, and failures
+	// counts the consecutive polls which failed
+	failed   bool
+	failures int
 
 	HeaderFinalized bool
 	headerReader    *header.Reader
@@ -67,6 +76,7 @@ func (r *reader) ReadToEnd(ctx context.Context) {
 	}
 
 	s := scanner.New(r, r.maxLogSize, scanner.DefaultBufferSize, r.Offset, r.splitFunc)
+	r.failed = false
 
 	// Iterate over the tokenized file, emitting entries as we go
 	for {
@@ -82,6 +92,7 @@ func (r *reader) ReadToEnd(ctx context.Context) {
 			if err := s.Error(); err != nil {
 				// If Scan returned an error then we are not guaranteed to be at the end of the file
 				r.eof = false
+				r.failed = true
 				r.Errorw("Failed during scan", zap.Error(err))
 			}
 			break
@@ -89,6 +100,7 @@ func (r *reader) ReadToEnd(ctx context.Context) {
 
 		token, err := r.encoding.Decode(s.Bytes())
 		if err != nil {
+			r.failed = true
 			r.Errorw("decode: %w", zap.Error(err))
 		} else if err := r.processFunc(ctx, token, r.FileAttributes); err != nil {
 			if errors.Is(err, header.ErrEndOfHeader) {
@@ -99,7 +111,7 @@ func (r *reader) ReadToEnd(ctx context.Context) {
 				// Do not use the updated offset from the old scanner, as the most recent token
 				// could be split differently with the new splitter.
 				r.splitFunc = r.lineSplitFunc
-				r.processFunc = r.emit
+				r.processFunc = r.emitToken
 				if _, err = r.file.Seek(r.Offset, 0); err != nil {
 					r.Errorw("Failed to seek post-header", zap.Error(err))
 					return
@@ -114,6 +126,11 @@ func (r *reader) ReadToEnd(ctx context.Context) {
 	}
 }
 
+// emitToken passes a token to the emit callback, along with the file resource
+func (r *reader) emitToken(ctx context.Context, token []byte, attrs map[string]any) error {
+	return r.emit(ctx, token, attrs, r.FileResource)
+}
+
 func (r *reader) finalizeHeader() {
 	if err := r.headerReader.Stop(); err != nil {
 		r.Errorw("Failed to stop header pipeline during finalization", zap.Error(err))
@@ -35,7 +35,7 @@ func (f *readerFactory) newReader(file *os.File, fp *fingerprint.Fingerprint) (*
 
 // copy creates a deep copy of a reader
 func (f *readerFactory) copy(old *reader, newFile *os.File) (*reader, error) {
-	return f.newReaderBuilder().
+	r, err := f.newReaderBuilder().
 		withFile(newFile).
 		withFingerprint(old.Fingerprint.Copy()).
 		withOffset(old.Offset).
@@ -43,6 +43,11 @@ func (f *readerFactory) copy(old *reader, newFile *os.File) (*reader, error) {
 		withFileAttributes(util.MapCopy(old.FileAttributes)).
 		withHeaderFinalized(old.HeaderFinalized).
 		build()
+	if err != nil {
+		return nil, err
+	}
+	r.failures = old.failures
+	return r, nil
 }
 
 func (f *readerFactory) unsafeReader() (*reader, error) {
@@ -103,6 +108,7 @@ func (b *readerBuilder) build() (r *reader, err error) {
 		Offset:          b.offset,
 		HeaderFinalized: b.headerFinalized,
 		FileAttributes:  b.fileAttributes,
+		FileResource:    map[string]any{},
 	}
 
 	if b.splitFunc != nil {
@@ -121,7 +127,7 @@ func (b *readerBuilder) build() (r *reader, err error) {
 
 	if b.headerConfig == nil || b.headerFinalized {
 		r.splitFunc = r.lineSplitFunc
-		r.processFunc = b.readerConfig.emit
+		r.processFunc = r.emitToken
 	} else {
 		r.splitFunc = b.headerConfig.SplitFunc
 		r.headerReader, err = header.NewReader(b.SugaredLogger, *b.headerConfig)
@@ -156,25 +162,34 @@ func (b *readerBuilder) build() (r *reader, err error) {
 		b.Errorf("resolve abs: %w", err)
 	}
 
+	// Path derived attributes are set either on each record or on the resource
+	pathAttrs := r.FileAttributes
+	if b.readerConfig.fileAttributesResource {
+		pathAttrs = r.FileResource
+		for _, k := range []string{logFileName, logFilePath, logFileNameResolved, logFilePathResolved} {
+			delete(r.FileAttributes, k)
+		}
+	}
+
 	if b.readerConfig.includeFileName {
-		r.FileAttributes[logFileName] = filepath.Base(b.file.Name())
-	} else if r.FileAttributes[logFileName] != nil {
-		delete(r.FileAttributes, logFileName)
+		pathAttrs[logFileName] = filepath.Base(b.file.Name())
+	} else if pathAttrs[logFileName] != nil {
+		delete(pathAttrs, logFileName)
 	}
 	if b.readerConfig.includeFilePath {
-		r.FileAttributes[logFilePath] = b.file.Name()
-	} else if r.FileAttributes[logFilePath] != nil {
-		delete(r.FileAttributes, logFilePath)
+		pathAttrs[logFilePath] = b.file.Name()
+	} else if pathAttrs[logFilePath] != nil {
+		delete(pathAttrs, logFilePath)
 	}
 	if b.readerConfig.includeFileNameResolved {
-		r.FileAttributes[logFileNameResolved] = filepath.Base(abs)
-	} else if r.FileAttributes[logFileNameResolved] != nil {
-		delete(r.FileAttributes, logFileNameResolved)
+		pathAttrs[logFileNameResolved] = filepath.Base(abs)
+	} else if pathAttrs[logFileNameResolved] != nil {
+		delete(pathAttrs, logFileNameResolved)
 	}
 	if b.readerConfig.includeFilePathResolved {
-		r.FileAttributes[logFilePathResolved] = abs
-	} else if r.FileAttributes[logFilePathResolved] != nil {
-		delete(r.FileAttributes, logFilePathResolved)
+		pathAttrs[logFilePathResolved] = abs
+	} else if pathAttrs[logFilePathResolved] != nil {
+		delete(pathAttrs, logFilePathResolved)
 	}
 
 	if !b.fromBeginning {
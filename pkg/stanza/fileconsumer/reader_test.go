@@ -188,7 +188,7 @@ func TestHeaderFingerprintIncluded(t *testing.T) {
 	require.Equal(t, []byte("#header-line\naaa\n"), r.Fingerprint.FirstBytes)
 }
 
-func testReaderFactory(t *testing.T) (*readerFactory, chan *emitParams) {
+func testReaderFactory(t testing.TB) (*readerFactory, chan *emitParams) {
 	emitChan := make(chan *emitParams, 100)
 	splitterConfig := helper.NewSplitterConfig()
 	return &readerFactory{
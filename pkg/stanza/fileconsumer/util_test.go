@@ -24,15 +24,15 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
 )
 
-func nopEmitFunc(_ context.Context, _ []byte, _ map[string]any) error {
+func nopEmitFunc(_ context.Context, _ []byte, _ map[string]any, _ map[string]any) error {
 	return nil
 }
 
 func testEmitFunc(emitChan chan *emitParams) emit.Callback {
-	return func(_ context.Context, token []byte, attrs map[string]any) error {
+	return func(_ context.Context, token []byte, attrs map[string]any, resource map[string]any) error {
 		copied := make([]byte, len(token))
 		copy(copied, token)
-		emitChan <- &emitParams{attrs, copied}
+		emitChan <- &emitParams{attrs, resource, copied}
 		return nil
 	}
 }
@@ -61,15 +61,16 @@ func (c *Config) withHeader(headerMatchPattern, extractRegex string) *Config {
 }
 
 func emitOnChan(received chan []byte) emit.Callback {
-	return func(_ context.Context, token []byte, _ map[string]any) error {
+	return func(_ context.Context, token []byte, _ map[string]any, _ map[string]any) error {
 		received <- token
 		return nil
 	}
 }
 
 type emitParams struct {
-	attrs map[string]any
-	token []byte
+	attrs    map[string]any
+	resource map[string]any
+	token    []byte
 }
 
 type testManagerConfig struct {
@@ -65,6 +65,7 @@ func (c BasicConfig) Build(logger *zap.SugaredLogger) (BasicOperator, error) {
 		OperatorID:    c.ID(),
 		OperatorType:  c.Type(),
 		SugaredLogger: logger.With("operator_id", c.ID(), "operator_type", c.Type()),
+		stats:         &operatorStats{},
 	}
 
 	return operator, nil
@@ -75,6 +76,7 @@ type BasicOperator struct {
 	OperatorID   string
 	OperatorType string
 	*zap.SugaredLogger
+	stats *operatorStats
 }
 
 // ID will return the operator id.
@@ -90,6 +92,8 @@ func (p *BasicOperator) Type() string {
 	return p.OperatorType
 }
 
+var _ operator.StatsReporter = (*BasicOperator)(nil)
+
 // Logger returns the operator's scoped logger.
 func (p *BasicOperator) Logger() *zap.SugaredLogger {
 	return p.SugaredLogger
@@ -113,3 +113,13 @@ func TestBasicOperatorStop(t *testing.T) {
 	err := operator.Stop()
 	require.NoError(t, err)
 }
+
+func TestBasicOperatorStatsWithoutBuild(t *testing.T) {
+	operator := BasicOperator{
+		OperatorID:   "test-id",
+		OperatorType: "test-type",
+	}
+	operator.countWritten()
+	operator.countError()
+	require.Zero(t, operator.Stats())
+}
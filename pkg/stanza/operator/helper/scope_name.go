@@ -8,9 +8,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/errors"
 )
 
-// ScopeNameParser is a helper that parses severity onto an entry.
+// ScopeNameParser is a helper that parses the instrumentation scope onto an entry.
 type ScopeNameParser struct {
-	ParseFrom entry.Field `mapstructure:"parse_from,omitempty"`
+	ParseFrom   entry.Field  `mapstructure:"parse_from,omitempty"`
+	VersionFrom *entry.Field `mapstructure:"version_from,omitempty"`
 }
 
 // NewScopeNameParser creates a new scope parser with default values
@@ -18,7 +19,7 @@ func NewScopeNameParser() ScopeNameParser {
 	return ScopeNameParser{}
 }
 
-// Parse will parse severity from a field and attach it to the entry
+// Parse will parse the scope name, and the scope version when configured, from fields and attach them to the entry
 func (p *ScopeNameParser) Parse(ent *entry.Entry) error {
 	value, ok := ent.Get(p.ParseFrom)
 	if !ok {
@@ -42,6 +43,21 @@ func (p *ScopeNameParser) Parse(ent *entry.Entry) error {
 		)
 	}
 
+	if p.VersionFrom != nil {
+		// the version is optional, the entries without it keep an empty version
+		if version, ok := ent.Get(*p.VersionFrom); ok {
+			versionStr, ok := version.(string)
+			if !ok {
+				return errors.NewError(
+					"version_from field does not contain a string",
+					"ensure that all entries forwarded to this parser contain a string in the version_from field",
+					"version_from", p.VersionFrom.String(),
+				)
+			}
+			ent.ScopeVersion = versionStr
+		}
+	}
+
 	ent.ScopeName = strVal
 	return nil
 }
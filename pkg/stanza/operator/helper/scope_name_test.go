@@ -18,6 +18,7 @@ const testScopeName = "my.logger"
 
 func TestScopeNameParser(t *testing.T) {
 	now := time.Now()
+	versionField := entry.NewBodyField("version")
 	testCases := []struct {
 		name      string
 		parser    *ScopeNameParser
@@ -82,6 +83,67 @@ func TestScopeNameParser(t *testing.T) {
 				return e
 			}(),
 		},
+		{
+			name: "with_version",
+			parser: &ScopeNameParser{
+				ParseFrom:   entry.NewBodyField("logger"),
+				VersionFrom: &versionField,
+			},
+			input: func() *entry.Entry {
+				e := entry.New()
+				e.Body = map[string]interface{}{"logger": testScopeName, "version": "1.2.3"}
+				e.ObservedTimestamp = now
+				return e
+			}(),
+			expected: func() *entry.Entry {
+				e := entry.New()
+				e.Body = map[string]interface{}{"logger": testScopeName, "version": "1.2.3"}
+				e.ScopeName = testScopeName
+				e.ScopeVersion = "1.2.3"
+				e.ObservedTimestamp = now
+				return e
+			}(),
+		},
+		{
+			name: "missing_version",
+			parser: &ScopeNameParser{
+				ParseFrom:   entry.NewBodyField("logger"),
+				VersionFrom: &versionField,
+			},
+			input: func() *entry.Entry {
+				e := entry.New()
+				e.Body = map[string]interface{}{"logger": testScopeName}
+				e.ObservedTimestamp = now
+				return e
+			}(),
+			expected: func() *entry.Entry {
+				e := entry.New()
+				e.Body = map[string]interface{}{"logger": testScopeName}
+				e.ScopeName = testScopeName
+				e.ObservedTimestamp = now
+				return e
+			}(),
+		},
+		{
+			name: "nonstring_version",
+			parser: &ScopeNameParser{
+				ParseFrom:   entry.NewBodyField("logger"),
+				VersionFrom: &versionField,
+			},
+			input: func() *entry.Entry {
+				e := entry.New()
+				e.Body = map[string]interface{}{"logger": testScopeName, "version": 2}
+				e.ObservedTimestamp = now
+				return e
+			}(),
+			expectErr: true,
+			expected: func() *entry.Entry {
+				e := entry.New()
+				e.Body = map[string]interface{}{"logger": testScopeName, "version": 2}
+				e.ObservedTimestamp = now
+				return e
+			}(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -111,6 +173,17 @@ func TestUnmarshalScopeNameConfig(t *testing.T) {
 					return c
 				}(),
 			},
+			{
+				Name: "version_from",
+				Expect: func() *helpersConfig {
+					c := newHelpersConfig()
+					c.Scope = NewScopeNameParser()
+					c.Scope.ParseFrom = entry.NewBodyField("from")
+					versionFrom := entry.NewAttributeField("version")
+					c.Scope.VersionFrom = &versionFrom
+					return c
+				}(),
+			},
 		},
 	}.Run(t)
 }
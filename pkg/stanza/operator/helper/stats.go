@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helper // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+
+import (
+	"sync/atomic"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+)
+
+// operatorStats counts the entries handled by an operator. It is shared by the copies
+// of the BasicOperator built for the operator.
+type operatorStats struct {
+	written atomic.Int64
+	errors  atomic.Int64
+}
+
+// Stats returns the counts of the entries handled by the operator.
+func (p *BasicOperator) Stats() operator.Stats {
+	if p.stats == nil {
+		return operator.Stats{}
+	}
+	return operator.Stats{
+		Written: p.stats.written.Load(),
+		Errors:  p.stats.errors.Load(),
+	}
+}
+
+func (p *BasicOperator) countWritten() {
+	if p.stats != nil {
+		p.stats.written.Add(1)
+	}
+}
+
+func (p *BasicOperator) countError() {
+	if p.stats != nil {
+		p.stats.errors.Add(1)
+	}
+}
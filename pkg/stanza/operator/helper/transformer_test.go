@@ -221,3 +221,31 @@ func TestTransformerIf(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestTransformerStats(t *testing.T) {
+	output := &testutil.Operator{}
+	output.On("ID").Return("test-output")
+	output.On("CanProcess").Return(true)
+	output.On("Process", mock.Anything, mock.Anything).Return(nil)
+	cfg := NewTransformerConfig("test-id", "test-type")
+	cfg.OutputIDs = []string{"test-output"}
+	cfg.OnError = SendOnError
+	transformer, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	require.NoError(t, transformer.SetOutputs([]operator.Operator{output}))
+	require.Equal(t, operator.Stats{}, transformer.Stats())
+
+	ctx := context.Background()
+	require.NoError(t, transformer.ProcessWith(ctx, entry.New(), func(e *entry.Entry) error {
+		return nil
+	}))
+	require.Error(t, transformer.ProcessWith(ctx, entry.New(), func(e *entry.Entry) error {
+		return fmt.Errorf("Failure")
+	}))
+	require.Equal(t, operator.Stats{Written: 2, Errors: 1}, transformer.Stats())
+
+	// the copies of the operator share its stats
+	copied := transformer
+	copied.Write(ctx, entry.New())
+	require.Equal(t, operator.Stats{Written: 3, Errors: 1}, transformer.Stats())
+}
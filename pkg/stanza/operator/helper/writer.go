@@ -48,6 +48,7 @@ type WriterOperator struct {
 
 // Write will write an entry to the outputs of the operator.
 func (w *WriterOperator) Write(ctx context.Context, e *entry.Entry) {
+	w.countWritten()
 	for i, operator := range w.OutputOperators {
 		if i == len(w.OutputOperators)-1 {
 			_ = operator.Process(ctx, e)
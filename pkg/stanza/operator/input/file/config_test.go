@@ -309,6 +309,17 @@ func TestUnmarshal(t *testing.T) {
 				ExpectErr: true,
 				Expect:    nil,
 			},
+			{
+				Name:      "file_attributes_as_resource",
+				ExpectErr: false,
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Include = append(cfg.Include, "one.log")
+					cfg.IncludeFilePath = true
+					cfg.FileAttributesAsResource = true
+					return cfg
+				}(),
+			},
 			{
 				Name:      "multiline_line_start_string",
 				ExpectErr: false,
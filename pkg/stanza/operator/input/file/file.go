@@ -29,12 +29,22 @@ func (f *Input) Start(persister operator.Persister) error {
 	return f.fileConsumer.Start(persister)
 }
 
+// PersistedKeys lists the keys under which the file consumer persists its state
+func (f *Input) PersistedKeys() []string {
+	return fileconsumer.PersistedKeys()
+}
+
+// TrackedFiles lists the files read by the file consumer and their offsets
+func (f *Input) TrackedFiles() []operator.TrackedFile {
+	return f.fileConsumer.TrackedFiles()
+}
+
 // Stop will stop the file monitoring process
 func (f *Input) Stop() error {
 	return f.fileConsumer.Stop()
 }
 
-func (f *Input) emit(ctx context.Context, token []byte, attrs map[string]any) error {
+func (f *Input) emit(ctx context.Context, token []byte, attrs map[string]any, resource map[string]any) error {
 	if len(token) == 0 {
 		return nil
 	}
@@ -49,6 +59,11 @@ func (f *Input) emit(ctx context.Context, token []byte, attrs map[string]any) er
 			f.Errorf("set attribute: %w", err)
 		}
 	}
+	for k, v := range resource {
+		if err := ent.Set(entry.NewResourceField(k), v); err != nil {
+			f.Errorf("set resource: %w", err)
+		}
+	}
 	f.Write(ctx, ent)
 	return nil
 }
@@ -65,6 +65,31 @@ func TestAddFileResolvedFields(t *testing.T) {
 	require.Equal(t, resolved, e.Attributes["log.file.path_resolved"])
 }
 
+// AddFileFieldsAsResource tests that the `log.file.*` fields are set on the entry's resource
+// when FileAttributesAsResource is set to true
+func TestAddFileFieldsAsResource(t *testing.T) {
+	t.Parallel()
+	operator, logReceived, tempDir := newTestFileOperator(t, func(cfg *Config) {
+		cfg.IncludeFileName = true
+		cfg.IncludeFilePath = true
+		cfg.FileAttributesAsResource = true
+	})
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog\n")
+
+	require.NoError(t, operator.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	e := waitForOne(t, logReceived)
+	require.Equal(t, filepath.Base(temp.Name()), e.Resource["log.file.name"])
+	require.Equal(t, temp.Name(), e.Resource["log.file.path"])
+	require.NotContains(t, e.Attributes, "log.file.name")
+	require.NotContains(t, e.Attributes, "log.file.path")
+}
+
 // ReadExistingLogs tests that, when starting from beginning, we
 // read all the lines that are already there
 func TestReadExistingLogs(t *testing.T) {
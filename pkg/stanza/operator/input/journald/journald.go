@@ -60,6 +60,7 @@ type Config struct {
 	Priority  string        `mapstructure:"priority,omitempty"`
 	Matches   []MatchConfig `mapstructure:"matches,omitempty"`
 	Grep      string        `mapstructure:"grep,omitempty"`
+	Namespace string        `mapstructure:"namespace,omitempty"`
 }
 
 type MatchConfig map[string]string
@@ -79,10 +80,13 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	return &Input{
 		InputOperator: inputOperator,
 		newCmd: func(ctx context.Context, cursor []byte) cmd {
+			// Copy the args so that restarting the operator does not accumulate cursors
+			cmdArgs := make([]string, len(args), len(args)+2)
+			copy(cmdArgs, args)
 			if cursor != nil {
-				args = append(args, "--after-cursor", string(cursor))
+				cmdArgs = append(cmdArgs, "--after-cursor", string(cursor))
 			}
-			return exec.CommandContext(ctx, "journalctl", args...) // #nosec - ...
+			return exec.CommandContext(ctx, "journalctl", cmdArgs...) // #nosec - ...
 			// journalctl is an executable that is required for this operator to function
 		},
 		json: jsoniter.ConfigFastest,
@@ -119,6 +123,10 @@ func (c Config) buildArgs() ([]string, error) {
 		args = append(args, "--grep", c.Grep)
 	}
 
+	if len(c.Namespace) > 0 {
+		args = append(args, "--namespace", c.Namespace)
+	}
+
 	switch {
 	case c.Directory != nil:
 		args = append(args, "--directory", *c.Directory)
@@ -190,6 +190,13 @@ func TestBuildConfig(t *testing.T) {
 			},
 			Expected: []string{"--utc", "--output=json", "--follow", "--priority", "info", "--grep", "test_grep"},
 		},
+		{
+			Name: "namespace",
+			Config: func(cfg *Config) {
+				cfg.Namespace = "test_namespace"
+			},
+			Expected: []string{"--utc", "--output=json", "--follow", "--priority", "info", "--namespace", "test_namespace"},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -209,6 +216,49 @@ func TestBuildConfig(t *testing.T) {
 	}
 }
 
+func TestInputJournaldCursor(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.OutputIDs = []string{"output"}
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.NewMockOperator("output")
+	received := make(chan *entry.Entry)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		received <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	err = op.SetOutputs([]operator.Operator{mockOutput})
+	require.NoError(t, err)
+
+	var startCursor []byte
+	op.(*Input).newCmd = func(ctx context.Context, cursor []byte) cmd {
+		startCursor = cursor
+		return &fakeJournaldCmd{}
+	}
+
+	persister := testutil.NewMockPersister("test")
+	require.NoError(t, persister.Set(context.Background(), lastReadCursorKey, []byte("previous_cursor")))
+
+	err = op.Start(persister)
+	assert.EqualError(t, err, "journalctl command exited")
+	defer func() {
+		require.NoError(t, op.Stop())
+	}()
+	require.Equal(t, []byte("previous_cursor"), startCursor)
+
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry to be read")
+	}
+
+	cursor, err := persister.Get(context.Background(), lastReadCursorKey)
+	require.NoError(t, err)
+	require.Equal(t, "s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30;b=c4fa36de06824d21835c05ff80c54468;m=9f9d630205;t=5a369604ee333;x=16c2d4fd4fdb7c36", string(cursor))
+}
+
 func TestInputJournaldError(t *testing.T) {
 	cfg := NewConfigWithID("my_journald_input")
 	cfg.OutputIDs = []string{"output"}
@@ -6,6 +6,7 @@ package tcp
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"go.opentelemetry.io/collector/config/configtls"
 
@@ -46,6 +47,17 @@ func TestUnmarshal(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name:      "connection_limits",
+				ExpectErr: false,
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.ListenAddress = "10.0.0.1:9000"
+					cfg.MaxConnections = 10
+					cfg.IdleTimeout = 30 * time.Second
+					return cfg
+				}(),
+			},
 		},
 	}.Run(t)
 }
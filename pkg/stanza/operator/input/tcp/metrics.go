@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tcp // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/tcp"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	connectionsActive = stats.Int64(
+		"tcp_input_active_connections",
+		"Number of connections currently open to the tcp input",
+		stats.UnitDimensionless)
+	connectionsOpened = stats.Int64(
+		"tcp_input_opened_connections",
+		"Number of connections opened to the tcp input",
+		stats.UnitDimensionless)
+	connectionsClosed = stats.Int64(
+		"tcp_input_closed_connections",
+		"Number of connections to the tcp input that were closed",
+		stats.UnitDimensionless)
+	connectionsRejected = stats.Int64(
+		"tcp_input_rejected_connections",
+		"Number of connections rejected by the tcp input because max_connections was reached",
+		stats.UnitDimensionless)
+)
+
+// MetricViews returns the metric views related to tcp input connections.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        connectionsActive.Name(),
+			Measure:     connectionsActive,
+			Description: connectionsActive.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        connectionsOpened.Name(),
+			Measure:     connectionsOpened,
+			Description: connectionsOpened.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        connectionsClosed.Name(),
+			Measure:     connectionsClosed,
+			Description: connectionsClosed.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        connectionsRejected.Name(),
+			Measure:     connectionsRejected,
+			Description: connectionsRejected.Description(),
+			Aggregation: view.Sum(),
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -17,6 +18,7 @@ import (
 	"time"
 
 	"github.com/jpillora/backoff"
+	"go.opencensus.io/stats"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.uber.org/zap"
 
@@ -67,6 +69,8 @@ type Config struct {
 type BaseConfig struct {
 	MaxLogSize                  helper.ByteSize             `mapstructure:"max_log_size,omitempty"`
 	ListenAddress               string                      `mapstructure:"listen_address,omitempty"`
+	MaxConnections              int                         `mapstructure:"max_connections,omitempty"`
+	IdleTimeout                 time.Duration               `mapstructure:"idle_timeout,omitempty"`
 	TLS                         *configtls.TLSServerSetting `mapstructure:"tls,omitempty"`
 	AddAttributes               bool                        `mapstructure:"add_attributes,omitempty"`
 	OneLogPerPacket             bool                        `mapstructure:"one_log_per_packet,omitempty"`
@@ -107,6 +111,14 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, fmt.Errorf("missing required parameter 'listen_address'")
 	}
 
+	if c.MaxConnections < 0 {
+		return nil, fmt.Errorf("invalid value for parameter 'max_connections', must be equal to or greater than 0")
+	}
+
+	if c.IdleTimeout < 0 {
+		return nil, fmt.Errorf("invalid value for parameter 'idle_timeout', must be equal to or greater than 0")
+	}
+
 	// validate the input address
 	if _, err = net.ResolveTCPAddr("tcp", c.ListenAddress); err != nil {
 		return nil, fmt.Errorf("failed to resolve listen_address: %w", err)
@@ -138,6 +150,7 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		MaxLogSize:      int(c.MaxLogSize),
 		addAttributes:   c.AddAttributes,
 		OneLogPerPacket: c.OneLogPerPacket,
+		idleTimeout:     c.IdleTimeout,
 		encoding:        encoding,
 		splitFunc:       splitFunc,
 		backoff: backoff.Backoff{
@@ -146,6 +159,10 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		resolver: resolver,
 	}
 
+	if c.MaxConnections > 0 {
+		tcpInput.connSlots = make(chan struct{}, c.MaxConnections)
+	}
+
 	if c.TLS != nil {
 		tcpInput.tls, err = c.TLS.LoadTLSConfig()
 		if err != nil {
@@ -163,6 +180,11 @@ type Input struct {
 	MaxLogSize      int
 	addAttributes   bool
 	OneLogPerPacket bool
+	idleTimeout     time.Duration
+
+	// connSlots limits the number of concurrently handled connections.
+	// It is nil when connections are not limited.
+	connSlots chan struct{}
 
 	listener net.Listener
 	cancel   context.CancelFunc
@@ -230,7 +252,17 @@ func (t *Input) goListen(ctx context.Context) {
 			}
 			t.backoff.Reset()
 
+			if !t.acquireConnSlot() {
+				t.Warnf("Rejecting connection from %s: max_connections limit of %d reached", conn.RemoteAddr().String(), cap(t.connSlots))
+				stats.Record(ctx, connectionsRejected.M(1))
+				if err = conn.Close(); err != nil {
+					t.Errorf("Failed to close connection: %s", err)
+				}
+				continue
+			}
+
 			t.Debugf("Received connection: %s", conn.RemoteAddr().String())
+			stats.Record(ctx, connectionsOpened.M(1), connectionsActive.M(1))
 			subctx, cancel := context.WithCancel(ctx)
 			t.goHandleClose(subctx, conn)
 			t.goHandleMessages(subctx, conn, cancel)
@@ -249,9 +281,33 @@ func (t *Input) goHandleClose(ctx context.Context, conn net.Conn) {
 		if err := conn.Close(); err != nil {
 			t.Errorf("Failed to close connection: %s", err)
 		}
+		t.releaseConnSlot()
+		stats.Record(context.Background(), connectionsClosed.M(1), connectionsActive.M(-1))
 	}()
 }
 
+// acquireConnSlot reserves a slot for a new connection, returning false
+// if the max_connections limit has been reached.
+func (t *Input) acquireConnSlot() bool {
+	if t.connSlots == nil {
+		return true
+	}
+	select {
+	case t.connSlots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// releaseConnSlot frees a slot reserved by acquireConnSlot.
+func (t *Input) releaseConnSlot() {
+	if t.connSlots == nil {
+		return
+	}
+	<-t.connSlots
+}
+
 // goHandleMessages will handles messages from a tcp connection.
 func (t *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel context.CancelFunc) {
 	t.wg.Add(1)
@@ -260,35 +316,93 @@ func (t *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 		defer t.wg.Done()
 		defer cancel()
 
+		var attrs map[string]string
+		if t.addAttributes {
+			attrs = t.connectionAttributes(conn)
+		}
+
+		var reader io.Reader = conn
+		if t.idleTimeout > 0 {
+			reader = &idleTimeoutReader{conn: conn, timeout: t.idleTimeout}
+		}
+
 		if t.OneLogPerPacket {
 			var buf bytes.Buffer
-			_, err := io.Copy(&buf, conn)
+			_, err := io.Copy(&buf, reader)
 			if err != nil {
-				t.Errorw("IO copy net connection buffer error", zap.Error(err))
+				t.logReadError("IO copy net connection buffer error", conn, err)
 			}
 			log := truncateMaxLog(buf.Bytes(), t.MaxLogSize)
-			t.handleMessage(ctx, conn, log)
+			t.handleMessage(ctx, attrs, log)
 			return
 		}
 
 		buf := make([]byte, 0, t.MaxLogSize)
 
-		scanner := bufio.NewScanner(conn)
+		scanner := bufio.NewScanner(reader)
 		scanner.Buffer(buf, t.MaxLogSize)
 
 		scanner.Split(t.splitFunc)
 
 		for scanner.Scan() {
-			t.handleMessage(ctx, conn, scanner.Bytes())
+			t.handleMessage(ctx, attrs, scanner.Bytes())
 		}
 
 		if err := scanner.Err(); err != nil {
-			t.Errorw("Scanner error", zap.Error(err))
+			t.logReadError("Scanner error", conn, err)
 		}
 	}()
 }
 
-func (t *Input) handleMessage(ctx context.Context, conn net.Conn, log []byte) {
+// logReadError logs an error encountered while reading from a connection.
+// Connections closed for being idle are expected and only logged at debug level.
+func (t *Input) logReadError(msg string, conn net.Conn, err error) {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		t.Debugf("Closing idle connection: %s", conn.RemoteAddr().String())
+		return
+	}
+	t.Errorw(msg, zap.Error(err))
+}
+
+// connectionAttributes returns the attributes describing a connection,
+// which are added to every entry read from it.
+func (t *Input) connectionAttributes(conn net.Conn) map[string]string {
+	attrs := map[string]string{
+		"net.transport": "IP.TCP",
+	}
+
+	if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		ip := addr.IP.String()
+		attrs["net.peer.ip"] = ip
+		attrs["net.peer.port"] = strconv.FormatInt(int64(addr.Port), 10)
+		attrs["net.peer.name"] = t.resolver.GetHostFromIP(ip)
+	}
+
+	if addr, ok := conn.LocalAddr().(*net.TCPAddr); ok {
+		ip := addr.IP.String()
+		attrs["net.host.ip"] = ip
+		attrs["net.host.port"] = strconv.FormatInt(int64(addr.Port), 10)
+		attrs["net.host.name"] = t.resolver.GetHostFromIP(ip)
+	}
+
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		if t.idleTimeout > 0 {
+			_ = tlsConn.SetDeadline(time.Now().Add(t.idleTimeout))
+		}
+		if err := tlsConn.Handshake(); err != nil {
+			t.Debugw("TLS handshake failed", zap.Error(err))
+			return attrs
+		}
+		if certs := tlsConn.ConnectionState().PeerCertificates; len(certs) > 0 {
+			attrs["tls.client.subject"] = certs[0].Subject.String()
+		}
+	}
+
+	return attrs
+}
+
+func (t *Input) handleMessage(ctx context.Context, attrs map[string]string, log []byte) {
 	decoded, err := t.encoding.Decode(log)
 	if err != nil {
 		t.Errorw("Failed to decode data", zap.Error(err))
@@ -301,26 +415,28 @@ func (t *Input) handleMessage(ctx context.Context, conn net.Conn, log []byte) {
 		return
 	}
 
-	if t.addAttributes {
-		entry.AddAttribute("net.transport", "IP.TCP")
-		if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
-			ip := addr.IP.String()
-			entry.AddAttribute("net.peer.ip", ip)
-			entry.AddAttribute("net.peer.port", strconv.FormatInt(int64(addr.Port), 10))
-			entry.AddAttribute("net.peer.name", t.resolver.GetHostFromIP(ip))
-		}
-
-		if addr, ok := conn.LocalAddr().(*net.TCPAddr); ok {
-			ip := addr.IP.String()
-			entry.AddAttribute("net.host.ip", addr.IP.String())
-			entry.AddAttribute("net.host.port", strconv.FormatInt(int64(addr.Port), 10))
-			entry.AddAttribute("net.host.name", t.resolver.GetHostFromIP(ip))
-		}
+	for k, v := range attrs {
+		entry.AddAttribute(k, v)
 	}
 
 	t.Write(ctx, entry)
 }
 
+// idleTimeoutReader extends the read deadline of a connection before every
+// read, so that a connection is closed once no data has been received for
+// the configured timeout.
+type idleTimeoutReader struct {
+	conn    net.Conn
+	timeout time.Duration
+}
+
+func (r *idleTimeoutReader) Read(p []byte) (int, error) {
+	if err := r.conn.SetReadDeadline(time.Now().Add(r.timeout)); err != nil {
+		return 0, err
+	}
+	return r.conn.Read(p)
+}
+
 func truncateMaxLog(data []byte, maxLogSize int) (token []byte) {
 	if len(data) >= maxLogSize {
 		return data[:maxLogSize]
@@ -5,6 +5,7 @@ package tcp
 
 import (
 	"crypto/tls"
+	"io"
 	"math/rand"
 	"net"
 	"os"
@@ -319,6 +320,26 @@ func TestBuild(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"max-connections-negative",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress:  "10.0.0.1:9000",
+					MaxConnections: -1,
+				},
+			},
+			true,
+		},
+		{
+			"idle-timeout-negative",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress: "10.0.0.1:9000",
+					IdleTimeout:   -time.Second,
+				},
+			},
+			true,
+		},
 		{
 			"tls-enabled-with-no-such-file-error",
 			Config{
@@ -343,6 +364,8 @@ func TestBuild(t *testing.T) {
 			cfg.ListenAddress = tc.inputBody.ListenAddress
 			cfg.MaxLogSize = tc.inputBody.MaxLogSize
 			cfg.TLS = tc.inputBody.TLS
+			cfg.MaxConnections = tc.inputBody.MaxConnections
+			cfg.IdleTimeout = tc.inputBody.IdleTimeout
 			_, err := cfg.Build(testutil.Logger(t))
 			if tc.expectErr {
 				require.Error(t, err)
@@ -368,6 +391,77 @@ func TestTLSTCPInput(t *testing.T) {
 	t.Run("CarriageReturn", tlsInputTest([]byte("message\r\n"), []string{"message"}))
 }
 
+func TestMaxConnections(t *testing.T) {
+	cfg := NewConfigWithID("test_id")
+	cfg.ListenAddress = ":0"
+	cfg.MaxConnections = 1
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	tcpInput := op.(*Input)
+	tcpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+	entryChan := make(chan *entry.Entry, 1)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entryChan <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	require.NoError(t, tcpInput.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+	}()
+
+	first, err := net.Dial("tcp", tcpInput.listener.Addr().String())
+	require.NoError(t, err)
+	defer first.Close()
+
+	_, err = first.Write([]byte("first\n"))
+	require.NoError(t, err)
+	select {
+	case e := <-entryChan:
+		require.Equal(t, "first", e.Body)
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for message to be written")
+	}
+
+	second, err := net.Dial("tcp", tcpInput.listener.Addr().String())
+	require.NoError(t, err)
+	defer second.Close()
+
+	// The rejected connection is closed by the server without reading from it.
+	require.NoError(t, second.SetReadDeadline(time.Now().Add(time.Second)))
+	_, err = second.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestIdleTimeout(t *testing.T) {
+	cfg := NewConfigWithID("test_id")
+	cfg.ListenAddress = ":0"
+	cfg.IdleTimeout = 100 * time.Millisecond
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	tcpInput := op.(*Input)
+	tcpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+	require.NoError(t, tcpInput.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+	}()
+
+	conn, err := net.Dial("tcp", tcpInput.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, err = conn.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+}
+
 func TestFailToBind(t *testing.T) {
 	ip := "localhost"
 	port := 0
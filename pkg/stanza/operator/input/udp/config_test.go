@@ -35,6 +35,16 @@ func TestUnmarshal(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name:      "async",
+				ExpectErr: false,
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.ListenAddress = "10.0.0.1:9000"
+					cfg.Async = &AsyncConfig{Readers: 4}
+					return cfg
+				}(),
+			},
 		},
 	}.Run(t)
 }
@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package udp // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/udp"
+
+import (
+	"errors"
+	"net"
+)
+
+const reusePortSupported = false
+
+func listenReusePort(string) (net.PacketConn, error) {
+	return nil, errors.New("SO_REUSEPORT is not supported on windows")
+}
@@ -64,6 +64,14 @@ type BaseConfig struct {
 	Multiline                   helper.MultilineConfig `mapstructure:"multiline,omitempty"`
 	PreserveLeadingWhitespaces  bool                   `mapstructure:"preserve_leading_whitespaces,omitempty"`
 	PreserveTrailingWhitespaces bool                   `mapstructure:"preserve_trailing_whitespaces,omitempty"`
+	Async                       *AsyncConfig           `mapstructure:"async,omitempty"`
+}
+
+// AsyncConfig is the configuration of the async mode of a udp input operator.
+// In async mode, several sockets are bound to the same address using
+// SO_REUSEPORT, each with its own read loop and buffer.
+type AsyncConfig struct {
+	Readers int `mapstructure:"readers,omitempty"`
 }
 
 // Build will build a udp input operator.
@@ -93,6 +101,17 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, err
 	}
 
+	readers := 1
+	if c.Async != nil {
+		if c.Async.Readers < 1 {
+			return nil, fmt.Errorf("invalid value for parameter 'async.readers', must be equal to or greater than 1")
+		}
+		if c.Async.Readers > 1 && !reusePortSupported {
+			return nil, fmt.Errorf("'async.readers' greater than 1 is not supported on this platform")
+		}
+		readers = c.Async.Readers
+	}
+
 	var resolver *helper.IPResolver
 	if c.AddAttributes {
 		resolver = helper.NewIPResolver()
@@ -101,7 +120,7 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	udpInput := &Input{
 		InputOperator:   inputOperator,
 		address:         address,
-		buffer:          make([]byte, MaxUDPSize),
+		readers:         readers,
 		addAttributes:   c.AddAttributes,
 		encoding:        encoding,
 		splitFunc:       splitFunc,
@@ -113,15 +132,15 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 
 // Input is an operator that listens to a socket for log entries.
 type Input struct {
-	buffer []byte
 	helper.InputOperator
 	address         *net.UDPAddr
+	readers         int
 	addAttributes   bool
 	OneLogPerPacket bool
 
-	connection net.PacketConn
-	cancel     context.CancelFunc
-	wg         sync.WaitGroup
+	connections []net.PacketConn
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
 
 	encoding  helper.Encoding
 	splitFunc bufio.SplitFunc
@@ -133,26 +152,61 @@ func (u *Input) Start(_ operator.Persister) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	u.cancel = cancel
 
-	conn, err := net.ListenUDP("udp", u.address)
-	if err != nil {
+	if err := u.openConnections(); err != nil {
+		u.closeConnections()
 		return fmt.Errorf("failed to open connection: %w", err)
 	}
-	u.connection = conn
 
-	u.goHandleMessages(ctx)
+	for _, conn := range u.connections {
+		u.goHandleMessages(ctx, conn)
+	}
 	return nil
 }
 
+// openConnections opens one connection per reader. When there is more
+// than one reader, all connections share the address of the first one.
+func (u *Input) openConnections() error {
+	if u.readers == 1 {
+		conn, err := net.ListenUDP("udp", u.address)
+		if err != nil {
+			return err
+		}
+		u.connections = []net.PacketConn{conn}
+		return nil
+	}
+
+	address := u.address.String()
+	for i := 0; i < u.readers; i++ {
+		conn, err := listenReusePort(address)
+		if err != nil {
+			return err
+		}
+		u.connections = append(u.connections, conn)
+		// Resolve the actual address in case an ephemeral port was requested
+		address = conn.LocalAddr().String()
+	}
+	return nil
+}
+
+func (u *Input) closeConnections() {
+	for _, conn := range u.connections {
+		if err := conn.Close(); err != nil {
+			u.Errorf("failed to close UDP connection: %s", err)
+		}
+	}
+}
+
 // goHandleMessages will handle messages from a udp connection.
-func (u *Input) goHandleMessages(ctx context.Context) {
+func (u *Input) goHandleMessages(ctx context.Context, conn net.PacketConn) {
 	u.wg.Add(1)
 
 	go func() {
 		defer u.wg.Done()
 
+		readBuf := make([]byte, MaxUDPSize)
 		buf := make([]byte, 0, MaxUDPSize)
 		for {
-			message, remoteAddr, err := u.readMessage()
+			message, remoteAddr, err := readMessage(conn, readBuf)
 			if err != nil {
 				select {
 				case <-ctx.Done():
@@ -165,7 +219,7 @@ func (u *Input) goHandleMessages(ctx context.Context) {
 
 			if u.OneLogPerPacket {
 				log := truncateMaxLog(message)
-				u.handleMessage(ctx, remoteAddr, log)
+				u.handleMessage(ctx, conn.LocalAddr(), remoteAddr, log)
 				continue
 			}
 
@@ -175,7 +229,7 @@ func (u *Input) goHandleMessages(ctx context.Context) {
 			scanner.Split(u.splitFunc)
 
 			for scanner.Scan() {
-				u.handleMessage(ctx, remoteAddr, scanner.Bytes())
+				u.handleMessage(ctx, conn.LocalAddr(), remoteAddr, scanner.Bytes())
 			}
 			if err := scanner.Err(); err != nil {
 				u.Errorw("Scanner error", zap.Error(err))
@@ -196,7 +250,7 @@ func truncateMaxLog(data []byte) (token []byte) {
 	return data
 }
 
-func (u *Input) handleMessage(ctx context.Context, remoteAddr net.Addr, log []byte) {
+func (u *Input) handleMessage(ctx context.Context, localAddr net.Addr, remoteAddr net.Addr, log []byte) {
 	decoded, err := u.encoding.Decode(log)
 	if err != nil {
 		u.Errorw("Failed to decode data", zap.Error(err))
@@ -211,7 +265,7 @@ func (u *Input) handleMessage(ctx context.Context, remoteAddr net.Addr, log []by
 
 	if u.addAttributes {
 		entry.AddAttribute("net.transport", "IP.UDP")
-		if addr, ok := u.connection.LocalAddr().(*net.UDPAddr); ok {
+		if addr, ok := localAddr.(*net.UDPAddr); ok {
 			ip := addr.IP.String()
 			entry.AddAttribute("net.host.ip", addr.IP.String())
 			entry.AddAttribute("net.host.port", strconv.FormatInt(int64(addr.Port), 10))
@@ -229,18 +283,18 @@ func (u *Input) handleMessage(ctx context.Context, remoteAddr net.Addr, log []by
 	u.Write(ctx, entry)
 }
 
-// readMessage will read log messages from the connection.
-func (u *Input) readMessage() ([]byte, net.Addr, error) {
-	n, addr, err := u.connection.ReadFrom(u.buffer)
+// readMessage will read log messages from the connection into buffer.
+func readMessage(conn net.PacketConn, buffer []byte) ([]byte, net.Addr, error) {
+	n, addr, err := conn.ReadFrom(buffer)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Remove trailing characters and NULs
-	for ; (n > 0) && (u.buffer[n-1] < 32); n-- { // nolint
+	for ; (n > 0) && (buffer[n-1] < 32); n-- { // nolint
 	}
 
-	return u.buffer[:n], addr, nil
+	return buffer[:n], addr, nil
 }
 
 // Stop will stop listening for udp messages.
@@ -249,11 +303,8 @@ func (u *Input) Stop() error {
 		return nil
 	}
 	u.cancel()
-	if u.connection != nil {
-		if err := u.connection.Close(); err != nil {
-			u.Errorf("failed to close UDP connection: %s", err)
-		}
-	}
+	u.closeConnections()
+	u.connections = nil
 	u.wg.Wait()
 	if u.resolver != nil {
 		u.resolver.Stop()
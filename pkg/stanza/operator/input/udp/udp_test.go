@@ -43,7 +43,7 @@ func udpInputTest(input []byte, expected []string) func(t *testing.T) {
 			require.NoError(t, udpInput.Stop(), "expected to stop udp input operator without error")
 		}()
 
-		conn, err := net.Dial("udp", udpInput.connection.LocalAddr().String())
+		conn, err := net.Dial("udp", udpInput.connections[0].LocalAddr().String())
 		require.NoError(t, err)
 		defer conn.Close()
 
@@ -94,7 +94,7 @@ func udpInputAttributesTest(input []byte, expected []string) func(t *testing.T)
 			require.NoError(t, udpInput.Stop(), "expected to stop udp input operator without error")
 		}()
 
-		conn, err := net.Dial("udp", udpInput.connection.LocalAddr().String())
+		conn, err := net.Dial("udp", udpInput.connections[0].LocalAddr().String())
 		require.NoError(t, err)
 		defer conn.Close()
 
@@ -107,8 +107,8 @@ func udpInputAttributesTest(input []byte, expected []string) func(t *testing.T)
 				expectedAttributes := map[string]interface{}{
 					"net.transport": "IP.UDP",
 				}
-				// LocalAddr for udpInput.connection is a server address
-				if addr, ok := udpInput.connection.LocalAddr().(*net.UDPAddr); ok {
+				// LocalAddr for udpInput.connections is a server address
+				if addr, ok := udpInput.connections[0].LocalAddr().(*net.UDPAddr); ok {
 					ip := addr.IP.String()
 					expectedAttributes["net.host.ip"] = addr.IP.String()
 					expectedAttributes["net.host.port"] = strconv.FormatInt(int64(addr.Port), 10)
@@ -151,6 +151,69 @@ func TestInputAttributes(t *testing.T) {
 	t.Run("NewlineInMessage", udpInputAttributesTest([]byte("message1\nmessage2\n"), []string{"message1\nmessage2"}))
 }
 
+func TestAsyncInput(t *testing.T) {
+	if !reusePortSupported {
+		t.Skip("SO_REUSEPORT is not supported on this platform")
+	}
+
+	cfg := NewConfigWithID("test_input")
+	cfg.ListenAddress = "127.0.0.1:0"
+	cfg.Async = &AsyncConfig{Readers: 4}
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	udpInput, ok := op.(*Input)
+	require.True(t, ok)
+
+	udpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+	const messages = 20
+	entryChan := make(chan *entry.Entry, messages)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entryChan <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	require.NoError(t, udpInput.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, udpInput.Stop(), "expected to stop udp input operator without error")
+	}()
+
+	require.Len(t, udpInput.connections, 4)
+	address := udpInput.connections[0].LocalAddr().String()
+	for _, conn := range udpInput.connections {
+		require.Equal(t, address, conn.LocalAddr().String())
+	}
+
+	// Use a separate socket per message so the kernel can distribute them across readers
+	for i := 0; i < messages; i++ {
+		conn, err := net.Dial("udp", address)
+		require.NoError(t, err)
+		_, err = conn.Write([]byte("message"))
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+	}
+
+	for i := 0; i < messages; i++ {
+		select {
+		case e := <-entryChan:
+			require.Equal(t, "message", e.Body)
+		case <-time.After(time.Second):
+			require.FailNow(t, "Timed out waiting for message to be written")
+		}
+	}
+}
+
+func TestBuildAsyncInvalidReaders(t *testing.T) {
+	cfg := NewConfigWithID("test_input")
+	cfg.ListenAddress = ":0"
+	cfg.Async = &AsyncConfig{Readers: 0}
+
+	_, err := cfg.Build(testutil.Logger(t))
+	require.Error(t, err)
+}
+
 func TestFailToBind(t *testing.T) {
 	ip := "localhost"
 	port := 0
@@ -216,7 +279,7 @@ func BenchmarkUDPInput(b *testing.B) {
 
 	done := make(chan struct{})
 	go func() {
-		conn, err := net.Dial("udp", udpInput.connection.LocalAddr().String())
+		conn, err := net.Dial("udp", udpInput.connections[0].LocalAddr().String())
 		require.NoError(b, err)
 		defer func() {
 			require.NoError(b, udpInput.Stop())
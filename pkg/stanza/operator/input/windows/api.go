@@ -24,6 +24,7 @@ var (
 	updateBookmarkProc        SyscallProc = api.NewProc("EvtUpdateBookmark")
 	openPublisherMetadataProc SyscallProc = api.NewProc("EvtOpenPublisherMetadata")
 	formatMessageProc         SyscallProc = api.NewProc("EvtFormatMessage")
+	openSessionProc           SyscallProc = api.NewProc("EvtOpenSession")
 )
 
 // SyscallProc is a syscall procedure.
@@ -53,6 +54,16 @@ const (
 	ErrorInvalidOperation syscall.Errno = 4317
 )
 
+const (
+	// EvtRPCLogin is the login class used to open a session to a remote computer.
+	EvtRPCLogin uint32 = 1
+)
+
+const (
+	// EvtRPCLoginAuthDefault is a flag that uses the default authentication method (negotiate) when opening a remote session.
+	EvtRPCLoginAuthDefault uint32 = 0
+)
+
 const (
 	// EvtFormatMessageXML is flag that formats a message as an XML string that contains all event details and message strings.
 	EvtFormatMessageXML uint32 = 9
@@ -147,3 +158,22 @@ func evtFormatMessage(publisherMetadata uintptr, event uintptr, messageID uint32
 
 	return bufferUsed, nil
 }
+
+// evtRPCLogin is the login information used to open a session to a remote computer (https://docs.microsoft.com/en-us/windows/win32/api/winevt/ns-winevt-evt_rpc_login)
+type evtRPCLogin struct {
+	Server   *uint16
+	User     *uint16
+	Domain   *uint16
+	Password *uint16
+	Flags    uint32
+}
+
+// evtOpenSession is the direct syscall implementation of EvtOpenSession (https://docs.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtopensession)
+func evtOpenSession(loginClass uint32, login *evtRPCLogin, timeout uint32, flags uint32) (uintptr, error) {
+	handle, _, err := openSessionProc.Call(uintptr(loginClass), uintptr(unsafe.Pointer(login)), uintptr(timeout), uintptr(flags))
+	if err != ErrorSuccess {
+		return 0, err
+	}
+
+	return handle, nil
+}
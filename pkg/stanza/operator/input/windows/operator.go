@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -41,13 +42,24 @@ func NewConfigWithID(operatorID string) *Config {
 
 // Config is the configuration of a windows event log operator.
 type Config struct {
-	helper.InputConfig `mapstructure:",squash"`
-	Channel            string        `mapstructure:"channel"`
-	MaxReads           int           `mapstructure:"max_reads,omitempty"`
-	StartAt            string        `mapstructure:"start_at,omitempty"`
-	PollInterval       time.Duration `mapstructure:"poll_interval,omitempty"`
-	Raw                bool          `mapstructure:"raw,omitempty"`
-	ExcludeProviders   []string      `mapstructure:"exclude_providers,omitempty"`
+	helper.InputConfig    `mapstructure:",squash"`
+	Channel               string        `mapstructure:"channel"`
+	Query                 string        `mapstructure:"query,omitempty"`
+	MaxReads              int           `mapstructure:"max_reads,omitempty"`
+	StartAt               string        `mapstructure:"start_at,omitempty"`
+	PollInterval          time.Duration `mapstructure:"poll_interval,omitempty"`
+	Raw                   bool          `mapstructure:"raw,omitempty"`
+	ExcludeProviders      []string      `mapstructure:"exclude_providers,omitempty"`
+	Remote                RemoteConfig  `mapstructure:"remote,omitempty"`
+	SuppressRenderingInfo bool          `mapstructure:"suppress_rendering_info,omitempty"`
+}
+
+// RemoteConfig is the configuration for reading the channel of a remote computer.
+type RemoteConfig struct {
+	Server   string              `mapstructure:"server"`
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	Domain   string              `mapstructure:"domain,omitempty"`
 }
 
 // Build will build a windows event log operator.
@@ -57,8 +69,8 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, err
 	}
 
-	if c.Channel == "" {
-		return nil, fmt.Errorf("missing required `channel` field")
+	if c.Channel == "" && c.Query == "" {
+		return nil, fmt.Errorf("missing required `channel` or `query` field")
 	}
 
 	if c.MaxReads < 1 {
@@ -69,33 +81,46 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, fmt.Errorf("the `start_at` field must be set to `beginning` or `end`")
 	}
 
+	if (c.Remote.Username != "" || c.Remote.Password != "" || c.Remote.Domain != "") && c.Remote.Server == "" {
+		return nil, fmt.Errorf("the `remote.server` field must be set when remote credentials are configured")
+	}
+
 	return &Input{
-		InputOperator:    inputOperator,
-		buffer:           NewBuffer(),
-		channel:          c.Channel,
-		maxReads:         c.MaxReads,
-		startAt:          c.StartAt,
-		pollInterval:     c.PollInterval,
-		raw:              c.Raw,
-		excludeProviders: c.ExcludeProviders,
+		InputOperator:     inputOperator,
+		buffer:            NewBuffer(),
+		channel:           c.Channel,
+		query:             c.Query,
+		maxReads:          c.MaxReads,
+		startAt:           c.StartAt,
+		pollInterval:      c.PollInterval,
+		raw:               c.Raw,
+		excludeProviders:  c.ExcludeProviders,
+		remote:            c.Remote,
+		suppressRendering: c.SuppressRenderingInfo,
+		publisherCache:    newPublisherCache(),
 	}, nil
 }
 
 // Input is an operator that creates entries using the windows event log api.
 type Input struct {
 	helper.InputOperator
-	bookmark         Bookmark
-	subscription     Subscription
-	buffer           Buffer
-	channel          string
-	maxReads         int
-	startAt          string
-	raw              bool
-	excludeProviders []string
-	pollInterval     time.Duration
-	persister        operator.Persister
-	cancel           context.CancelFunc
-	wg               sync.WaitGroup
+	bookmark          Bookmark
+	subscription      Subscription
+	buffer            Buffer
+	channel           string
+	query             string
+	maxReads          int
+	startAt           string
+	raw               bool
+	excludeProviders  []string
+	remote            RemoteConfig
+	session           Session
+	suppressRendering bool
+	publisherCache    publisherCache
+	pollInterval      time.Duration
+	persister         operator.Persister
+	cancel            context.CancelFunc
+	wg                sync.WaitGroup
 }
 
 // Start will start reading events from a subscription.
@@ -105,11 +130,18 @@ func (e *Input) Start(persister operator.Persister) error {
 
 	e.persister = persister
 
+	e.session = NewLocalSession()
+	if e.remote.Server != "" {
+		if err := e.session.Open(e.remote); err != nil {
+			return fmt.Errorf("failed to open remote session: %w", err)
+		}
+	}
+
 	e.bookmark = NewBookmark()
 	offsetXML, err := e.getBookmarkOffset(ctx)
 	if err != nil {
 		e.Errorf("Failed to open bookmark, continuing without previous bookmark: %s", err)
-		e.persister.Delete(ctx, e.channel)
+		e.persister.Delete(ctx, e.bookmarkKey())
 	}
 
 	if offsetXML != "" {
@@ -119,7 +151,7 @@ func (e *Input) Start(persister operator.Persister) error {
 	}
 
 	e.subscription = NewSubscription()
-	if err := e.subscription.Open(e.channel, e.startAt, e.bookmark); err != nil {
+	if err := e.subscription.Open(e.session, e.channel, e.query, e.startAt, e.bookmark); err != nil {
 		return fmt.Errorf("failed to open subscription: %w", err)
 	}
 
@@ -141,6 +173,14 @@ func (e *Input) Stop() error {
 		return fmt.Errorf("failed to close bookmark: %w", err)
 	}
 
+	if err := e.publisherCache.evictAll(); err != nil {
+		return fmt.Errorf("failed to close publishers: %w", err)
+	}
+
+	if err := e.session.Close(); err != nil {
+		return fmt.Errorf("failed to close remote session: %w", err)
+	}
+
 	return nil
 }
 
@@ -231,13 +271,17 @@ func (e *Input) processEvent(ctx context.Context, event Event) {
 		}
 	}
 
-	publisher := NewPublisher()
-	if err := publisher.Open(simpleEvent.Provider.Name); err != nil {
+	if e.suppressRendering {
+		e.sendEvent(ctx, simpleEvent)
+		return
+	}
+
+	publisher, err := e.publisherCache.get(e.session, simpleEvent.Provider.Name)
+	if err != nil {
 		e.Errorf("Failed to open publisher: %s: writing log entry to pipeline without metadata", err)
 		e.sendEvent(ctx, simpleEvent)
 		return
 	}
-	defer publisher.Close()
 
 	formattedEvent, err := event.RenderFormatted(e.buffer, publisher)
 	if err != nil {
@@ -278,10 +322,19 @@ func (e *Input) sendEventRaw(ctx context.Context, eventRaw EventRaw) {
 
 // getBookmarkXML will get the bookmark xml from the offsets database.
 func (e *Input) getBookmarkOffset(ctx context.Context) (string, error) {
-	bytes, err := e.persister.Get(ctx, e.channel)
+	bytes, err := e.persister.Get(ctx, e.bookmarkKey())
 	return string(bytes), err
 }
 
+// bookmarkKey is the key of the bookmark in the offsets database.
+// Subscriptions using a structured query without a channel are keyed by their query.
+func (e *Input) bookmarkKey() string {
+	if e.channel != "" {
+		return e.channel
+	}
+	return e.query
+}
+
 // updateBookmark will update the bookmark xml and save it in the offsets database.
 func (e *Input) updateBookmarkOffset(ctx context.Context, event Event) {
 	if err := e.bookmark.Update(event); err != nil {
@@ -295,7 +348,7 @@ func (e *Input) updateBookmarkOffset(ctx context.Context, event Event) {
 		return
 	}
 
-	if err := e.persister.Set(ctx, e.channel, []byte(bookmarkXML)); err != nil {
+	if err := e.persister.Set(ctx, e.bookmarkKey(), []byte(bookmarkXML)); err != nil {
 		e.Errorf("failed to set offsets: %s", err)
 		return
 	}
@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestBuildWithQuery(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Query = `<QueryList><Query Id="0"><Select Path="Application">*[System[Level=2]]</Select></Query></QueryList>`
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	input := op.(*Input)
+	require.Equal(t, cfg.Query, input.query)
+	require.Equal(t, "", input.channel)
+	require.Equal(t, cfg.Query, input.bookmarkKey())
+}
+
+func TestBuildWithoutChannelOrQuery(t *testing.T) {
+	cfg := NewConfigWithID("test")
+
+	_, err := cfg.Build(testutil.Logger(t))
+	require.ErrorContains(t, err, "missing required `channel` or `query` field")
+}
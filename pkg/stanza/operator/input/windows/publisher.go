@@ -16,8 +16,9 @@ type Publisher struct {
 	handle uintptr
 }
 
-// Open will open the publisher handle using the supplied provider.
-func (p *Publisher) Open(provider string) error {
+// Open will open the publisher handle using the supplied provider. The session
+// selects the computer to read metadata from; an empty session refers to the local computer.
+func (p *Publisher) Open(session Session, provider string) error {
 	if p.handle != 0 {
 		return fmt.Errorf("publisher handle is already open")
 	}
@@ -27,7 +28,7 @@ func (p *Publisher) Open(provider string) error {
 		return fmt.Errorf("failed to convert provider to utf16: %w", err)
 	}
 
-	handle, err := evtOpenPublisherMetadata(0, utf16, nil, 0, 0)
+	handle, err := evtOpenPublisherMetadata(session.handle, utf16, nil, 0, 0)
 	if err != nil {
 		return fmt.Errorf("failed to open publisher handle: %w", err)
 	}
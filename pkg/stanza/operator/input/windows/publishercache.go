@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+// +build windows
+
+package windows // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/windows"
+
+import (
+	"go.uber.org/multierr"
+)
+
+// publisherCache lazily opens publishers the first time their provider is
+// seen, and keeps them open until evictAll is called.
+type publisherCache struct {
+	cache map[string]cachedPublisher
+}
+
+type cachedPublisher struct {
+	publisher Publisher
+	err       error
+}
+
+func newPublisherCache() publisherCache {
+	return publisherCache{
+		cache: make(map[string]cachedPublisher),
+	}
+}
+
+// get returns the publisher of the provider, opening it if it was not seen before.
+// A failure to open a publisher is remembered, so that it is not retried for every event.
+func (c *publisherCache) get(session Session, provider string) (Publisher, error) {
+	if cached, ok := c.cache[provider]; ok {
+		return cached.publisher, cached.err
+	}
+
+	publisher := NewPublisher()
+	err := publisher.Open(session, provider)
+	c.cache[provider] = cachedPublisher{publisher: publisher, err: err}
+	return publisher, err
+}
+
+// evictAll closes all cached publishers.
+func (c *publisherCache) evictAll() error {
+	var errs error
+	for provider, cached := range c.cache {
+		if cached.err == nil {
+			errs = multierr.Append(errs, cached.publisher.Close())
+		}
+		delete(c.cache, provider)
+	}
+	return errs
+}
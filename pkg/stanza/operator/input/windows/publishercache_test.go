@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublisherCacheOpensOnce(t *testing.T) {
+	calls := 0
+	openPublisherMetadataProc = MockProc{
+		call: func(a ...uintptr) (uintptr, uintptr, error) {
+			calls++
+			return 5, 0, ErrorSuccess
+		},
+	}
+
+	cache := newPublisherCache()
+	publisher, err := cache.get(NewLocalSession(), "provider")
+	require.NoError(t, err)
+	require.Equal(t, uintptr(5), publisher.handle)
+
+	publisher, err = cache.get(NewLocalSession(), "provider")
+	require.NoError(t, err)
+	require.Equal(t, uintptr(5), publisher.handle)
+	require.Equal(t, 1, calls)
+}
+
+func TestPublisherCacheRemembersFailure(t *testing.T) {
+	calls := 0
+	openPublisherMetadataProc = MockProc{
+		call: func(a ...uintptr) (uintptr, uintptr, error) {
+			calls++
+			return 0, 0, ErrorNotSupported
+		},
+	}
+
+	cache := newPublisherCache()
+	_, err := cache.get(NewLocalSession(), "provider")
+	require.Error(t, err)
+	_, err = cache.get(NewLocalSession(), "provider")
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestPublisherCacheEvictAll(t *testing.T) {
+	openPublisherMetadataProc = SimpleMockProc(5, 0, ErrorSuccess)
+	closeProc = SimpleMockProc(1, 0, ErrorSuccess)
+
+	cache := newPublisherCache()
+	_, err := cache.get(NewLocalSession(), "provider")
+	require.NoError(t, err)
+
+	require.NoError(t, cache.evictAll())
+	require.Empty(t, cache.cache)
+}
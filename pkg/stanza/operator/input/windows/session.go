@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+// +build windows
+
+package windows // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/windows"
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// Session is a session to the event log service of a remote computer.
+type Session struct {
+	handle uintptr
+}
+
+// Open will open a session to the remote computer described by the supplied config.
+func (s *Session) Open(remote RemoteConfig) error {
+	if s.handle != 0 {
+		return fmt.Errorf("session handle is already open")
+	}
+
+	login := &evtRPCLogin{Flags: EvtRPCLoginAuthDefault}
+	var err error
+	if login.Server, err = utf16PtrOrNil(remote.Server); err != nil {
+		return fmt.Errorf("failed to convert server to utf16: %w", err)
+	}
+	if login.User, err = utf16PtrOrNil(remote.Username); err != nil {
+		return fmt.Errorf("failed to convert username to utf16: %w", err)
+	}
+	if login.Domain, err = utf16PtrOrNil(remote.Domain); err != nil {
+		return fmt.Errorf("failed to convert domain to utf16: %w", err)
+	}
+	if login.Password, err = utf16PtrOrNil(string(remote.Password)); err != nil {
+		return fmt.Errorf("failed to convert password to utf16: %w", err)
+	}
+
+	handle, err := evtOpenSession(EvtRPCLogin, login, 0, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open session to %s: %w", remote.Server, err)
+	}
+
+	s.handle = handle
+	return nil
+}
+
+// Close will close the session handle.
+func (s *Session) Close() error {
+	if s.handle == 0 {
+		return nil
+	}
+
+	if err := evtClose(s.handle); err != nil {
+		return fmt.Errorf("failed to close session handle: %w", err)
+	}
+
+	s.handle = 0
+	return nil
+}
+
+// NewLocalSession will create a session with an empty handle, which refers to the local computer.
+func NewLocalSession() Session {
+	return Session{
+		handle: 0,
+	}
+}
+
+// utf16PtrOrNil converts a string to utf16, returning nil for empty strings
+// so that the event log api falls back to its default value.
+func utf16PtrOrNil(s string) (*uint16, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return syscall.UTF16PtrFromString(s)
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionOpenPreexisting(t *testing.T) {
+	session := Session{handle: 5}
+	err := session.Open(RemoteConfig{Server: "remote-host"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "session handle is already open")
+}
+
+func TestSessionOpenInvalidUTF8(t *testing.T) {
+	session := NewLocalSession()
+	err := session.Open(RemoteConfig{Server: "\u0000"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to convert server to utf16")
+}
+
+func TestSessionOpenSyscallFailure(t *testing.T) {
+	session := NewLocalSession()
+	openSessionProc = SimpleMockProc(0, 0, ErrorNotSupported)
+	err := session.Open(RemoteConfig{Server: "remote-host"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to open session to remote-host")
+}
+
+func TestSessionOpenSuccess(t *testing.T) {
+	session := NewLocalSession()
+	openSessionProc = SimpleMockProc(5, 0, ErrorSuccess)
+	err := session.Open(RemoteConfig{Server: "remote-host", Username: "user", Password: "password", Domain: "domain"})
+	require.NoError(t, err)
+	require.Equal(t, uintptr(5), session.handle)
+}
+
+func TestSessionCloseWhenAlreadyClosed(t *testing.T) {
+	session := NewLocalSession()
+	err := session.Close()
+	require.NoError(t, err)
+}
+
+func TestSessionCloseSuccess(t *testing.T) {
+	session := Session{handle: 5}
+	closeProc = SimpleMockProc(1, 0, ErrorSuccess)
+	err := session.Close()
+	require.NoError(t, err)
+	require.Equal(t, uintptr(0), session.handle)
+}
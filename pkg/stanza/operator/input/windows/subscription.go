@@ -9,7 +9,6 @@ package windows // import "github.com/open-telemetry/opentelemetry-collector-con
 import (
 	"errors"
 	"fmt"
-	"syscall"
 
 	"golang.org/x/sys/windows"
 )
@@ -19,8 +18,11 @@ type Subscription struct {
 	handle uintptr
 }
 
-// Open will open the subscription handle.
-func (s *Subscription) Open(channel string, startAt string, bookmark Bookmark) error {
+// Open will open the subscription handle. The session selects the computer
+// to subscribe to; an empty session refers to the local computer.
+// The query is either an XPath expression that filters the events of the channel,
+// or a structured XML query, in which case the channel may be empty.
+func (s *Subscription) Open(session Session, channel string, query string, startAt string, bookmark Bookmark) error {
 	if s.handle != 0 {
 		return fmt.Errorf("subscription handle is already open")
 	}
@@ -31,14 +33,22 @@ func (s *Subscription) Open(channel string, startAt string, bookmark Bookmark) e
 	}
 	defer windows.CloseHandle(signalEvent)
 
-	channelPtr, err := syscall.UTF16PtrFromString(channel)
+	channelPtr, err := utf16PtrOrNil(channel)
 	if err != nil {
 		return fmt.Errorf("failed to convert channel to utf16: %w", err)
 	}
 
+	queryPtr, err := utf16PtrOrNil(query)
+	if err != nil {
+		return fmt.Errorf("failed to convert query to utf16: %w", err)
+	}
+
 	flags := s.createFlags(startAt, bookmark)
-	subscriptionHandle, err := evtSubscribe(0, signalEvent, channelPtr, nil, bookmark.handle, 0, 0, flags)
+	subscriptionHandle, err := evtSubscribe(session.handle, signalEvent, channelPtr, queryPtr, bookmark.handle, 0, 0, flags)
 	if err != nil {
+		if channel == "" {
+			return fmt.Errorf("failed to subscribe to query: %w", err)
+		}
 		return fmt.Errorf("failed to subscribe to %s channel: %w", channel, err)
 	}
 
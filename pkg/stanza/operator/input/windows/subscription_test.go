@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionOpenPreexisting(t *testing.T) {
+	subscription := Subscription{handle: 5}
+	err := subscription.Open(NewLocalSession(), "application", "", "end", NewBookmark())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "subscription handle is already open")
+}
+
+func TestSubscriptionOpenInvalidQuery(t *testing.T) {
+	subscription := NewSubscription()
+	err := subscription.Open(NewLocalSession(), "application", "\u0000", "end", NewBookmark())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to convert query to utf16")
+}
+
+func TestSubscriptionOpenQuerySuccess(t *testing.T) {
+	subscription := NewSubscription()
+	subscribeProc = SimpleMockProc(5, 0, ErrorSuccess)
+	err := subscription.Open(NewLocalSession(), "", "<QueryList><Query><Select Path=\"Application\">*[System[(EventID=1000)]]</Select></Query></QueryList>", "end", NewBookmark())
+	require.NoError(t, err)
+	require.Equal(t, uintptr(5), subscription.handle)
+}
+
+func TestSubscriptionOpenQueryFailure(t *testing.T) {
+	subscription := NewSubscription()
+	subscribeProc = SimpleMockProc(0, 0, ErrorNotSupported)
+	err := subscription.Open(NewLocalSession(), "", "<QueryList></QueryList>", "end", NewBookmark())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to subscribe to query")
+}
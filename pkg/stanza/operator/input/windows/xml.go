@@ -6,6 +6,7 @@ package windows // import "github.com/open-telemetry/opentelemetry-collector-con
 import (
 	"encoding/xml"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
@@ -29,6 +30,7 @@ type EventXML struct {
 	RenderedKeywords []string         `xml:"RenderingInfo>Keywords>Keyword"`
 	Keywords         []string         `xml:"System>Keywords"`
 	EventData        []EventDataEntry `xml:"EventData>Data"`
+	UserData         *UserData        `xml:"UserData"`
 }
 
 // parseTimestamp will parse the timestamp of the event.
@@ -121,6 +123,9 @@ func (e *EventXML) parseBody() map[string]interface{} {
 	if len(details) > 0 {
 		body["details"] = details
 	}
+	if e.UserData != nil {
+		body["user_data"] = parseUserData(e.UserData.Elements)
+	}
 	return body
 }
 
@@ -150,6 +155,34 @@ func parseEventData(entries []EventDataEntry) map[string]interface{} {
 	return outputMap
 }
 
+// parse user data elements into a map[string]interface
+// where the key is the element name, and value is either the element text or,
+// for elements with children, a map of the children.
+// Elements that appear more than once are collected into a slice.
+// see: https://learn.microsoft.com/en-us/windows/win32/wes/eventschema-userdatatype-complextype
+func parseUserData(elements []XMLElement) map[string]interface{} {
+	outputMap := make(map[string]interface{}, len(elements))
+
+	for _, element := range elements {
+		var value interface{} = strings.TrimSpace(element.Value)
+		if len(element.Children) > 0 {
+			value = parseUserData(element.Children)
+		}
+
+		name := element.XMLName.Local
+		switch existing := outputMap[name].(type) {
+		case nil:
+			outputMap[name] = value
+		case []interface{}:
+			outputMap[name] = append(existing, value)
+		default:
+			outputMap[name] = []interface{}{existing, value}
+		}
+	}
+
+	return outputMap
+}
+
 // unmarshalEventXML will unmarshal EventXML from xml bytes.
 func unmarshalEventXML(bytes []byte) (EventXML, error) {
 	var eventXML EventXML
@@ -181,3 +214,15 @@ type EventDataEntry struct {
 	Name  string `xml:"Name,attr"`
 	Value string `xml:",chardata"`
 }
+
+// UserData is the provider defined data of the event.
+type UserData struct {
+	Elements []XMLElement `xml:",any"`
+}
+
+// XMLElement is an arbitrary xml element.
+type XMLElement struct {
+	XMLName  xml.Name
+	Value    string       `xml:",chardata"`
+	Children []XMLElement `xml:",any"`
+}
@@ -288,3 +288,29 @@ func TestUnmarshal(t *testing.T) {
 
 	require.Equal(t, xml, event)
 }
+
+func TestParseUserData(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "xmlUserDataSample.xml"))
+	require.NoError(t, err)
+
+	event, err := unmarshalEventXML(data)
+	require.NoError(t, err)
+	require.NotNil(t, event.UserData)
+
+	expected := map[string]interface{}{
+		"LogFileCleared": map[string]interface{}{
+			"SubjectUserSid":  "S-1-5-21-1",
+			"SubjectUserName": "user",
+			"PrivilegeList": map[string]interface{}{
+				"Privilege": []interface{}{"SeSecurityPrivilege", "SeBackupPrivilege"},
+			},
+		},
+	}
+	require.Equal(t, expected, event.parseBody()["user_data"])
+}
+
+func TestParseNoUserData(t *testing.T) {
+	xml := EventXML{}
+	_, ok := xml.parseBody()["user_data"]
+	require.False(t, ok)
+}
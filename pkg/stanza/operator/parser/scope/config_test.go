@@ -35,6 +35,16 @@ func TestConfig(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name: "version_from",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.ParseFrom = entry.NewBodyField("logger")
+					versionFrom := entry.NewBodyField("version")
+					cfg.VersionFrom = &versionFrom
+					return cfg
+				}(),
+			},
 			{
 				Name:      "no_nested",
 				ExpectErr: true,
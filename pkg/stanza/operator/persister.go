@@ -36,3 +36,9 @@ func (p scopedPersister) Set(ctx context.Context, key string, value []byte) erro
 func (p scopedPersister) Delete(ctx context.Context, key string) error {
 	return p.Persister.Delete(ctx, fmt.Sprintf("%s.%s", p.scope, key))
 }
+
+// PersistedKeysLister is implemented by operators which can list the keys they persist,
+// so that their state can be moved between persisters.
+type PersistedKeysLister interface {
+	PersistedKeys() []string
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package operator // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+
+// Stats are the counts of the entries handled by an operator since it was built.
+type Stats struct {
+	// Written counts the entries written to the outputs of the operator.
+	Written int64 `json:"written"`
+	// Errors counts the entries the operator failed to process.
+	Errors int64 `json:"errors"`
+}
+
+// StatsReporter is implemented by operators which count the entries they handle,
+// so that a running pipeline can be inspected.
+type StatsReporter interface {
+	Stats() Stats
+}
+
+// TrackedFile is a file read by an operator, and the offset it is read from.
+type TrackedFile struct {
+	Path   string `json:"path"`
+	Offset int64  `json:"offset"`
+	// Size is the size of the file when it was inspected, or -1 when it is unknown.
+	Size int64 `json:"size"`
+}
+
+// FileTracker is implemented by operators which read files, so that the progress
+// of a running pipeline can be inspected.
+type FileTracker interface {
+	TrackedFiles() []TrackedFile
+}
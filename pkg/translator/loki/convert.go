@@ -91,9 +91,13 @@ func getDefaultLabels(resAttrs pcommon.Map, defaultLabelsEnabled map[string]bool
 }
 
 func convertAttributesToLabels(attributes pcommon.Map, attrsToSelect pcommon.Value) model.LabelSet {
-	out := model.LabelSet{}
+	return selectAttributes(attributes, parseAttributeNames(attrsToSelect))
+}
 
-	attrs := parseAttributeNames(attrsToSelect)
+// selectAttributes returns the values of the attributes with the given names as labels,
+// looking up nested attributes when an attribute isn't found under the given name.
+func selectAttributes(attributes pcommon.Map, attrs []string) model.LabelSet {
+	out := model.LabelSet{}
 	for _, attr := range attrs {
 		attr = strings.TrimSpace(attr)
 
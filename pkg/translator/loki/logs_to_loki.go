@@ -4,7 +4,9 @@
 package loki // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/grafana/loki/pkg/push"
 	"github.com/prometheus/common/model"
@@ -17,6 +19,26 @@ import (
 type PushRequest struct {
 	*push.PushRequest
 	Report *PushReport
+
+	// streamLabels contains the labels of each stream of the PushRequest.
+	streamLabels []model.LabelSet
+	// structuredMetadata contains the structured metadata of each entry of each stream of the
+	// PushRequest, it is nil when no attribute is mapped to structured metadata.
+	structuredMetadata [][]model.LabelSet
+}
+
+// AttributesMapping lists the resource and log attributes that become Loki labels or Loki
+// structured metadata, in addition to the labels listed by the hints. The attributes are
+// removed from the log line, like the ones promoted to labels by the hints.
+type AttributesMapping struct {
+	ResourceLabels              []string
+	AttributeLabels             []string
+	ResourceStructuredMetadata  []string
+	AttributeStructuredMetadata []string
+}
+
+func (m AttributesMapping) hasStructuredMetadata() bool {
+	return len(m.ResourceStructuredMetadata) > 0 || len(m.AttributeStructuredMetadata) > 0
 }
 
 // PushReport contains the summary for the outcome of a LogsToLoki operation
@@ -48,6 +70,14 @@ const (
 // to make this decision, as it includes all of the errors that were encountered,
 // as well as the number of items dropped and submitted.
 func LogsToLokiRequests(ld plog.Logs, defaultLabelsEnabled map[string]bool) map[string]PushRequest {
+	return LogsToLokiRequestsWithMapping(ld, defaultLabelsEnabled, AttributesMapping{})
+}
+
+// LogsToLokiRequestsWithMapping is like LogsToLokiRequests, and additionally promotes the
+// attributes of the given mapping to labels or structured metadata. The protobuf encoding
+// of the PushRequests doesn't contain the structured metadata: the PushRequests with
+// structured metadata must be encoded with EncodeJSON.
+func LogsToLokiRequestsWithMapping(ld plog.Logs, defaultLabelsEnabled map[string]bool, mapping AttributesMapping) map[string]PushRequest {
 	groups := map[string]pushRequestGroup{}
 
 	rls := ld.ResourceLogs()
@@ -65,12 +95,12 @@ func LogsToLokiRequests(ld plog.Logs, defaultLabelsEnabled map[string]bool) map[
 				if !ok {
 					group = pushRequestGroup{
 						report:  &PushReport{},
-						streams: make(map[string]*push.Stream),
+						streams: make(map[string]*stream),
 					}
 					groups[tenant] = group
 				}
 
-				entry, err := LogToLokiEntry(log, resource, scope, defaultLabelsEnabled)
+				entry, err := logToLokiEntry(log, resource, scope, defaultLabelsEnabled, mapping)
 				if err != nil {
 					// Couldn't convert so dropping log.
 					group.report.Errors = append(group.report.Errors, fmt.Errorf("failed to convert, dropping log: %w", err))
@@ -80,24 +110,21 @@ func LogsToLokiRequests(ld plog.Logs, defaultLabelsEnabled map[string]bool) map[
 
 				group.report.NumSubmitted++
 
-				processed := model.LabelSet{}
-				for label := range entry.Labels {
-					// Loki doesn't support dots in label names
-					// labelName is normalized label name to follow Prometheus label names standard
-					labelName := prometheustranslator.NormalizeLabel(string(label))
-					processed[model.LabelName(labelName)] = entry.Labels[label]
-				}
+				processed := normalizeLabels(entry.Labels)
 
 				// create the stream name based on the labels
 				labels := processed.String()
-				if stream, ok := group.streams[labels]; ok {
-					stream.Entries = append(stream.Entries, *entry.Entry)
-					continue
+				s, ok := group.streams[labels]
+				if !ok {
+					s = &stream{
+						Stream: push.Stream{Labels: labels},
+						labels: processed,
+					}
+					group.streams[labels] = s
 				}
-
-				group.streams[labels] = &push.Stream{
-					Labels:  labels,
-					Entries: []push.Entry{*entry.Entry},
+				s.Entries = append(s.Entries, *entry.Entry)
+				if mapping.hasStructuredMetadata() {
+					s.structuredMetadata = append(s.structuredMetadata, normalizeLabels(entry.StructuredMetadata))
 				}
 			}
 		}
@@ -109,27 +136,82 @@ func LogsToLokiRequests(ld plog.Logs, defaultLabelsEnabled map[string]bool) map[
 			Streams: make([]push.Stream, len(g.streams)),
 		}
 
+		request := PushRequest{
+			PushRequest:  pr,
+			Report:       g.report,
+			streamLabels: make([]model.LabelSet, len(g.streams)),
+		}
+		if mapping.hasStructuredMetadata() {
+			request.structuredMetadata = make([][]model.LabelSet, len(g.streams))
+		}
+
 		i := 0
-		for _, stream := range g.streams {
-			pr.Streams[i] = *stream
+		for _, s := range g.streams {
+			pr.Streams[i] = s.Stream
+			request.streamLabels[i] = s.labels
+			if request.structuredMetadata != nil {
+				request.structuredMetadata[i] = s.structuredMetadata
+			}
 			i++
 		}
-		requests[tenant] = PushRequest{
-			PushRequest: pr,
-			Report:      g.report,
-		}
+		requests[tenant] = request
 	}
 	return requests
 }
 
+// HasStructuredMetadata returns true if the entries of the PushRequest have structured metadata,
+// in which case the PushRequest must be encoded with EncodeJSON.
+func (r PushRequest) HasStructuredMetadata() bool {
+	return r.structuredMetadata != nil
+}
+
+type jsonPushRequest struct {
+	Streams []jsonStream `json:"streams"`
+}
+
+type jsonStream struct {
+	Stream model.LabelSet `json:"stream"`
+	// Values contains the timestamp, the line and the structured metadata of each entry.
+	Values [][]any `json:"values"`
+}
+
+// EncodeJSON encodes the PushRequest with the JSON encoding of the Loki push API, which
+// supports the structured metadata of the entries. It only supports the PushRequests
+// created by LogsToLokiRequests and LogsToLokiRequestsWithMapping.
+func (r PushRequest) EncodeJSON() ([]byte, error) {
+	req := jsonPushRequest{
+		Streams: make([]jsonStream, len(r.Streams)),
+	}
+	for i, s := range r.Streams {
+		js := jsonStream{
+			Stream: r.streamLabels[i],
+			Values: make([][]any, len(s.Entries)),
+		}
+		for j, entry := range s.Entries {
+			value := []any{strconv.FormatInt(entry.Timestamp.UnixNano(), 10), entry.Line}
+			if r.structuredMetadata != nil && len(r.structuredMetadata[i][j]) > 0 {
+				value = append(value, r.structuredMetadata[i][j])
+			}
+			js.Values[j] = value
+		}
+		req.Streams[i] = js
+	}
+	return json.Marshal(req)
+}
+
 // PushEntry is Loki log entry enriched with labels
 type PushEntry struct {
-	Entry  *push.Entry
-	Labels model.LabelSet
+	Entry              *push.Entry
+	Labels             model.LabelSet
+	StructuredMetadata model.LabelSet
 }
 
 // LogToLokiEntry converts LogRecord into Loki log entry enriched with labels and tenant
 func LogToLokiEntry(lr plog.LogRecord, rl pcommon.Resource, scope pcommon.InstrumentationScope, defaultLabelsEnabled map[string]bool) (*PushEntry, error) {
+	return logToLokiEntry(lr, rl, scope, defaultLabelsEnabled, AttributesMapping{})
+}
+
+func logToLokiEntry(lr plog.LogRecord, rl pcommon.Resource, scope pcommon.InstrumentationScope, defaultLabelsEnabled map[string]bool, mapping AttributesMapping) (*PushEntry, error) {
 	// we may remove attributes, so change only our version
 	log := plog.NewLogRecord()
 	lr.CopyTo(log)
@@ -146,9 +228,19 @@ func LogToLokiEntry(lr plog.LogRecord, rl pcommon.Resource, scope pcommon.Instru
 	format := getFormatFromFormatHint(log.Attributes(), resource.Attributes())
 
 	mergedLabels := convertAttributesAndMerge(log.Attributes(), resource.Attributes(), defaultLabelsEnabled)
-	// remove the attributes that were promoted to labels
-	removeAttributes(log.Attributes(), mergedLabels)
-	removeAttributes(resource.Attributes(), mergedLabels)
+	mergedLabels = mergedLabels.Merge(selectAttributes(resource.Attributes(), mapping.ResourceLabels))
+	mergedLabels = mergedLabels.Merge(selectAttributes(log.Attributes(), mapping.AttributeLabels))
+
+	var structuredMetadata model.LabelSet
+	if mapping.hasStructuredMetadata() {
+		structuredMetadata = selectAttributes(resource.Attributes(), mapping.ResourceStructuredMetadata)
+		structuredMetadata = structuredMetadata.Merge(selectAttributes(log.Attributes(), mapping.AttributeStructuredMetadata))
+	}
+
+	// remove the attributes that were promoted to labels or structured metadata
+	removed := mergedLabels.Merge(structuredMetadata)
+	removeAttributes(log.Attributes(), removed)
+	removeAttributes(resource.Attributes(), removed)
 
 	entry, err := convertLogToLokiEntry(log, resource, format, scope)
 	if err != nil {
@@ -156,11 +248,26 @@ func LogToLokiEntry(lr plog.LogRecord, rl pcommon.Resource, scope pcommon.Instru
 	}
 
 	return &PushEntry{
-		Entry:  entry,
-		Labels: mergedLabels,
+		Entry:              entry,
+		Labels:             mergedLabels,
+		StructuredMetadata: structuredMetadata,
 	}, nil
 }
 
+// normalizeLabels returns the labels with names following the Prometheus label names
+// standard, as Loki doesn't support dots in label names.
+func normalizeLabels(labels model.LabelSet) model.LabelSet {
+	if labels == nil {
+		return nil
+	}
+	processed := model.LabelSet{}
+	for label, value := range labels {
+		labelName := prometheustranslator.NormalizeLabel(string(label))
+		processed[model.LabelName(labelName)] = value
+	}
+	return processed
+}
+
 func getFormatFromFormatHint(logAttr pcommon.Map, resourceAttr pcommon.Map) string {
 	format := formatJSON
 	formatVal, found := resourceAttr.Get(hintFormat)
@@ -197,10 +304,16 @@ func GetTenantFromTenantHint(logAttr pcommon.Map, resourceAttr pcommon.Map) stri
 }
 
 type pushRequestGroup struct {
-	streams map[string]*push.Stream
+	streams map[string]*stream
 	report  *PushReport
 }
 
+type stream struct {
+	push.Stream
+	labels             model.LabelSet
+	structuredMetadata []model.LabelSet
+}
+
 func addLogLevelAttributeAndHint(log plog.LogRecord) {
 	if log.SeverityNumber() == plog.SeverityNumberUnspecified {
 		return
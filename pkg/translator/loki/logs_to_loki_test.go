@@ -721,3 +721,51 @@ func TestGetTenantFromTenantHint(t *testing.T) {
 		})
 	}
 }
+
+func TestLogsToLokiRequestsWithMapping(t *testing.T) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.Resource().Attributes().PutStr("k8s.pod.name", "checkout-5d4f")
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1677592916000000000))
+	lr.Body().SetStr("order placed")
+	lr.Attributes().PutStr("http.method", "POST")
+	lr.Attributes().PutStr("order.id", "42")
+	lr.Attributes().PutInt("http.status", 200)
+
+	requests := LogsToLokiRequestsWithMapping(logs, map[string]bool{"exporter": false, "job": false}, AttributesMapping{
+		ResourceLabels:              []string{"service.name"},
+		AttributeLabels:             []string{"http.method"},
+		ResourceStructuredMetadata:  []string{"k8s.pod.name"},
+		AttributeStructuredMetadata: []string{"order.id"},
+	})
+	require.Len(t, requests, 1)
+	request := requests[""]
+	require.True(t, request.HasStructuredMetadata())
+	require.Len(t, request.Streams, 1)
+	assert.Equal(t, `{http_method="POST", service_name="checkout"}`, request.Streams[0].Labels)
+	require.Len(t, request.Streams[0].Entries, 1)
+	assert.Equal(t, `{"body":"order placed","attributes":{"http.status":200}}`, request.Streams[0].Entries[0].Line)
+
+	buf, err := request.EncodeJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"streams":[{
+		"stream":{"http_method":"POST","service_name":"checkout"},
+		"values":[["1677592916000000000","{\"body\":\"order placed\",\"attributes\":{\"http.status\":200}}",{"k8s_pod_name":"checkout-5d4f","order_id":"42"}]]
+	}]}`, string(buf))
+}
+
+func TestLogsToLokiRequestsEncodeJSONWithoutStructuredMetadata(t *testing.T) {
+	logs := plog.NewLogs()
+	lr := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1677592916000000000))
+	lr.Body().SetStr("hello")
+
+	request := LogsToLokiRequests(logs, nil)[""]
+	assert.False(t, request.HasStructuredMetadata())
+
+	buf, err := request.EncodeJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"streams":[{"stream":{"exporter":"OTLP"},"values":[["1677592916000000000","{\"body\":\"hello\"}"]]}]}`, string(buf))
+}
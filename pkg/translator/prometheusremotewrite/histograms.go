@@ -194,3 +194,44 @@ func convertBucketsLayout(buckets pmetric.ExponentialHistogramDataPointBuckets,
 
 	return spans, deltas
 }
+
+// exponentialToExplicitHistogram translates OTel Exponential Histogram data point
+// to an explicit bucket histogram data point, for endpoints that do not accept
+// Prometheus Native Histograms. Bucket upper bounds are the exponential bucket
+// boundaries, with an additional bucket at 0 holding the zero count.
+func exponentialToExplicitHistogram(p pmetric.ExponentialHistogramDataPoint) pmetric.HistogramDataPoint {
+	hp := pmetric.NewHistogramDataPoint()
+	p.Attributes().CopyTo(hp.Attributes())
+	p.Exemplars().CopyTo(hp.Exemplars())
+	hp.SetStartTimestamp(p.StartTimestamp())
+	hp.SetTimestamp(p.Timestamp())
+	hp.SetFlags(p.Flags())
+	hp.SetCount(p.Count())
+	if p.HasSum() {
+		hp.SetSum(p.Sum())
+	}
+
+	// bucket index i covers (base^i, base^(i+1)], where base = 2^(2^-scale).
+	factor := math.Exp2(-float64(p.Scale()))
+	bounds := hp.ExplicitBounds()
+	counts := hp.BucketCounts()
+
+	negative := p.Negative()
+	for i := negative.BucketCounts().Len() - 1; i >= 0; i-- {
+		bounds.Append(-math.Exp2(float64(negative.Offset()+int32(i)) * factor))
+		counts.Append(negative.BucketCounts().At(i))
+	}
+
+	bounds.Append(0)
+	counts.Append(p.ZeroCount())
+
+	positive := p.Positive()
+	for i := 0; i < positive.BucketCounts().Len(); i++ {
+		bounds.Append(math.Exp2(float64(positive.Offset()+int32(i)+1) * factor))
+		counts.Append(positive.BucketCounts().At(i))
+	}
+
+	// +Inf bucket
+	counts.Append(0)
+	return hp
+}
@@ -755,3 +755,45 @@ func TestAddSingleExponentialHistogramDataPoint(t *testing.T) {
 		})
 	}
 }
+
+func TestExponentialToExplicitHistogram(t *testing.T) {
+	pt := pmetric.NewExponentialHistogramDataPoint()
+	pt.SetStartTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(100)))
+	pt.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(500)))
+	pt.SetCount(7)
+	pt.SetSum(10.1)
+	pt.SetScale(0)
+	pt.SetZeroCount(1)
+	pt.Attributes().PutStr("attribute_test", "test_value")
+	pt.Exemplars().AppendEmpty().SetDoubleValue(1)
+
+	pt.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+	pt.Positive().SetOffset(1)
+
+	pt.Negative().BucketCounts().FromRaw([]uint64{2, 1})
+	pt.Negative().SetOffset(1)
+
+	got := exponentialToExplicitHistogram(pt)
+
+	assert.Equal(t, []float64{-4, -2, 0, 4, 8}, got.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{1, 2, 1, 1, 2, 0}, got.BucketCounts().AsRaw())
+	assert.Equal(t, uint64(7), got.Count())
+	assert.True(t, got.HasSum())
+	assert.Equal(t, 10.1, got.Sum())
+	assert.Equal(t, pt.StartTimestamp(), got.StartTimestamp())
+	assert.Equal(t, pt.Timestamp(), got.Timestamp())
+	assert.Equal(t, map[string]interface{}{"attribute_test": "test_value"}, got.Attributes().AsRaw())
+	assert.Equal(t, 1, got.Exemplars().Len())
+}
+
+func TestExponentialToExplicitHistogramScale(t *testing.T) {
+	pt := pmetric.NewExponentialHistogramDataPoint()
+	pt.SetScale(-1)
+	pt.Positive().BucketCounts().FromRaw([]uint64{1, 1})
+
+	got := exponentialToExplicitHistogram(pt)
+
+	assert.False(t, got.HasSum())
+	assert.Equal(t, []float64{0, 4, 16}, got.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{0, 1, 1, 0}, got.BucketCounts().AsRaw())
+}
@@ -21,6 +21,9 @@ type Settings struct {
 	DisableTargetInfo   bool
 	ExportCreatedMetric bool
 	AddMetricSuffixes   bool
+	// ExpandExponentialHistograms converts exponential histograms to classic
+	// explicit bucket histograms instead of Prometheus native histograms.
+	ExpandExponentialHistograms bool
 }
 
 // FromMetrics converts pmetric.Metrics to prometheus remote write format.
@@ -80,6 +83,12 @@ func FromMetrics(md pmetric.Metrics, settings Settings) (tsMap map[string]*promp
 					if dataPoints.Len() == 0 {
 						errs = multierr.Append(errs, fmt.Errorf("empty data points. %s is dropped", metric.Name()))
 					}
+					if settings.ExpandExponentialHistograms {
+						for x := 0; x < dataPoints.Len(); x++ {
+							addSingleHistogramDataPoint(exponentialToExplicitHistogram(dataPoints.At(x)), resource, metric, settings, tsMap)
+						}
+						break
+					}
 					name := prometheustranslator.BuildCompliantName(metric, settings.Namespace, settings.AddMetricSuffixes)
 					for x := 0; x < dataPoints.Len(); x++ {
 						errs = multierr.Append(
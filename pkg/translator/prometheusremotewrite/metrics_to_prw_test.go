@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -53,6 +54,36 @@ func BenchmarkFromMetrics(b *testing.B) {
 	}
 }
 
+func TestFromMetricsExpandExponentialHistograms(t *testing.T) {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("exponential_hist")
+	m.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	pt := m.ExponentialHistogram().DataPoints().AppendEmpty()
+	pt.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	pt.SetCount(3)
+	pt.SetSum(6)
+	pt.SetZeroCount(1)
+	pt.Positive().BucketCounts().FromRaw([]uint64{1, 1})
+
+	native, err := FromMetrics(md, Settings{DisableTargetInfo: true})
+	require.NoError(t, err)
+	require.Len(t, native, 1)
+	for _, ts := range native {
+		assert.Len(t, ts.Histograms, 1)
+		assert.Empty(t, ts.Samples)
+	}
+
+	classic, err := FromMetrics(md, Settings{DisableTargetInfo: true, ExpandExponentialHistograms: true})
+	require.NoError(t, err)
+	// 4 buckets (0, 2, 4, +Inf), _sum and _count
+	require.Len(t, classic, 6)
+	for _, ts := range classic {
+		assert.Empty(t, ts.Histograms)
+		assert.Len(t, ts.Samples, 1)
+	}
+}
+
 func createExportRequest(resourceAttributeCount int, histogramCount int, nonHistogramCount int, labelsPerMetric int, exemplarsPerSeries int) pmetricotlp.ExportRequest {
 	request := pmetricotlp.NewExportRequest()
 
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attributesprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributesprocessor"
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+)
+
+// attrProcessor processes the attributes of the spans, log records and metric data points.
+type attrProcessor interface {
+	Process(ctx context.Context, logger *zap.Logger, attrs pcommon.Map)
+}
+
+var _ attrProcessor = (*attraction.AttrProc)(nil)
+
+// fileAttrProc applies the actions of the configuration followed by the actions of the actions
+// file, which are reloaded when the file is modified.
+type fileAttrProc struct {
+	logger   *zap.Logger
+	actions  []attraction.ActionKeyValue
+	file     ActionsFile
+	attrProc atomic.Pointer[attraction.AttrProc]
+	modTime  time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ attrProcessor = (*fileAttrProc)(nil)
+
+// newAttrProcessor returns the processor of the actions of the configuration, and of the
+// actions file when it is set.
+func newAttrProcessor(logger *zap.Logger, cfg *Config) (attrProcessor, error) {
+	if cfg.ActionsFile == nil {
+		return attraction.NewAttrProc(&cfg.Settings)
+	}
+	p := &fileAttrProc{
+		logger:  logger,
+		actions: cfg.Actions,
+		file:    *cfg.ActionsFile,
+	}
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// processorOptions returns the options of the processor, starting and stopping the reloads of
+// the actions file.
+func processorOptions(attrProc attrProcessor) []processorhelper.Option {
+	options := []processorhelper.Option{processorhelper.WithCapabilities(processorCapabilities)}
+	if p, ok := attrProc.(*fileAttrProc); ok && p.file.ReloadInterval > 0 {
+		options = append(options, processorhelper.WithStart(p.start), processorhelper.WithShutdown(p.shutdown))
+	}
+	return options
+}
+
+func (p *fileAttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcommon.Map) {
+	p.attrProc.Load().Process(ctx, logger, attrs)
+}
+
+func (p *fileAttrProc) start(_ context.Context, _ component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.file.ReloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := p.reload(); err != nil {
+					p.logger.Warn("Failed to reload the actions file, keeping the previous actions", zap.String("path", p.file.Path), zap.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (p *fileAttrProc) shutdown(context.Context) error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.wg.Wait()
+	return nil
+}
+
+// reload loads the actions of the actions file when it was modified since it was last loaded.
+func (p *fileAttrProc) reload() error {
+	info, err := os.Stat(p.file.Path)
+	if err != nil {
+		return err
+	}
+	if p.attrProc.Load() != nil && info.ModTime().Equal(p.modTime) {
+		return nil
+	}
+
+	fileActions, err := loadActionsFile(p.file.Path)
+	if err != nil {
+		return err
+	}
+	actions := make([]attraction.ActionKeyValue, 0, len(p.actions)+len(fileActions))
+	actions = append(actions, p.actions...)
+	actions = append(actions, fileActions...)
+	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: actions})
+	if err != nil {
+		return fmt.Errorf("invalid actions file %q: %w", p.file.Path, err)
+	}
+
+	p.attrProc.Store(attrProc)
+	p.modTime = info.ModTime()
+	p.logger.Debug("Loaded the actions file", zap.String("path", p.file.Path), zap.Int("actions", len(fileActions)))
+	return nil
+}
+
+// loadActionsFile returns the actions of a CSV file, whose header holds the action fields, or
+// of a YAML file holding a list of actions like the processor configuration.
+func loadActionsFile(path string) ([]attraction.ActionKeyValue, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var rawActions []any
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		rawActions, err = parseCSVActions(data)
+	} else {
+		var raw struct {
+			Actions []any `yaml:"actions"`
+		}
+		err = yaml.Unmarshal(data, &raw)
+		rawActions = raw.Actions
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the actions file %q: %w", path, err)
+	}
+
+	var settings attraction.Settings
+	if err = confmap.NewFromStringMap(map[string]any{"actions": rawActions}).Unmarshal(&settings, confmap.WithErrorUnused()); err != nil {
+		return nil, fmt.Errorf("invalid actions file %q: %w", path, err)
+	}
+	return settings.Actions, nil
+}
+
+// parseCSVActions returns the actions of the rows of the CSV data, the empty fields being unset.
+func parseCSVActions(data []byte) ([]any, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	actions := make([]any, 0, len(records)-1)
+	for _, record := range records[1:] {
+		action := map[string]any{}
+		for i, field := range record {
+			if field != "" {
+				action[strings.TrimSpace(header[i])] = field
+			}
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attributesprocessor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+)
+
+func TestLoadActionsFile(t *testing.T) {
+	expected := []attraction.ActionKeyValue{
+		{Key: "environment", Value: "production", Action: attraction.UPSERT},
+		{Key: "department", FromAttribute: "team", Action: attraction.INSERT},
+		{Key: "team", Action: attraction.DELETE},
+	}
+	for _, path := range []string{"actions.yaml", "actions.csv"} {
+		t.Run(path, func(t *testing.T) {
+			actions, err := loadActionsFile(filepath.Join("testdata", path))
+			require.NoError(t, err)
+			assert.Equal(t, expected, actions)
+		})
+	}
+}
+
+func TestLoadActionsFileInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		content string
+	}{
+		{
+			name:    "unknown column",
+			path:    "actions.csv",
+			content: "key,action,unknown\nenvironment,delete,value\n",
+		},
+		{
+			name:    "missing field",
+			path:    "actions.csv",
+			content: "key,action\nenvironment\n",
+		},
+		{
+			name:    "invalid yaml",
+			path:    "actions.yaml",
+			content: "actions: {",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.path)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0600))
+			_, err := loadActionsFile(path)
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := loadActionsFile(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.Error(t, err)
+}
+
+func TestFileAttrProcReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "actions.csv")
+	require.NoError(t, os.WriteFile(path, []byte("key,action,value\nenvironment,upsert,staging\n"), 0600))
+
+	cfg := &Config{
+		Settings: attraction.Settings{
+			Actions: []attraction.ActionKeyValue{{Key: "region", Value: "eu", Action: attraction.INSERT}},
+		},
+		ActionsFile: &ActionsFile{Path: path, ReloadInterval: 10 * time.Millisecond},
+	}
+	attrProc, err := newAttrProcessor(zap.NewNop(), cfg)
+	require.NoError(t, err)
+	assert.Len(t, processorOptions(attrProc), 3)
+
+	process := func() map[string]any {
+		attrs := pcommon.NewMap()
+		attrProc.Process(context.Background(), zap.NewNop(), attrs)
+		return attrs.AsRaw()
+	}
+	assert.Equal(t, map[string]any{"region": "eu", "environment": "staging"}, process())
+
+	// the invalid actions are not loaded, the previous actions being kept
+	p := attrProc.(*fileAttrProc)
+	require.NoError(t, os.WriteFile(path, []byte("key,action\nenvironment,unknown\n"), 0600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	assert.Error(t, p.reload())
+	assert.Equal(t, map[string]any{"region": "eu", "environment": "staging"}, process())
+
+	require.NoError(t, p.start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, p.shutdown(context.Background()))
+	}()
+
+	require.NoError(t, os.WriteFile(path, []byte("key,action,value\nenvironment,upsert,production\n"), 0600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	assert.Eventually(t, func() bool {
+		return process()["environment"] == "production"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestFileAttrProcWithoutReload(t *testing.T) {
+	cfg := &Config{
+		ActionsFile: &ActionsFile{Path: filepath.Join("testdata", "actions.yaml")},
+	}
+	attrProc, err := newAttrProcessor(zap.NewNop(), cfg)
+	require.NoError(t, err)
+	assert.Len(t, processorOptions(attrProc), 1)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("team", "payments")
+	attrProc.Process(context.Background(), zap.NewNop(), attrs)
+	assert.Equal(t, map[string]any{"environment": "production", "department": "payments"}, attrs.AsRaw())
+}
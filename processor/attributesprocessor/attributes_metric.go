@@ -9,21 +9,20 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 )
 
 type metricAttributesProcessor struct {
 	logger   *zap.Logger
-	attrProc *attraction.AttrProc
+	attrProc attrProcessor
 	skipExpr expr.BoolExpr[ottlmetric.TransformContext]
 }
 
 // newMetricAttributesProcessor returns a processor that modifies attributes of a
 // metric record. To construct the attributes processors, the use of the factory
 // methods are required in order to validate the inputs.
-func newMetricAttributesProcessor(logger *zap.Logger, attrProc *attraction.AttrProc, skipExpr expr.BoolExpr[ottlmetric.TransformContext]) *metricAttributesProcessor {
+func newMetricAttributesProcessor(logger *zap.Logger, attrProc attrProcessor, skipExpr expr.BoolExpr[ottlmetric.TransformContext]) *metricAttributesProcessor {
 	return &metricAttributesProcessor{
 		logger:   logger,
 		attrProc: attrProc,
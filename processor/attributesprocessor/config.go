@@ -5,6 +5,7 @@ package attributesprocessor // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 
@@ -26,14 +27,37 @@ type Config struct {
 	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT}.
 	// This is a required field.
 	attraction.Settings `mapstructure:",squash"`
+
+	// ActionsFile specifies a file holding more actions, applied after the actions of the configuration.
+	ActionsFile *ActionsFile `mapstructure:"actions_file"`
+}
+
+// ActionsFile specifies a YAML or CSV file holding actions, such as large attribute normalization tables.
+type ActionsFile struct {
+	// Path is the path of the file. The files with the .csv extension hold a header of the action
+	// fields and an action per row, the other files hold the list of actions in YAML.
+	// This is a required field.
+	Path string `mapstructure:"path"`
+
+	// ReloadInterval is the interval at which the file is reloaded when it was modified, the file
+	// only being loaded at startup when 0.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
-	if len(cfg.Actions) == 0 {
+	if len(cfg.Actions) == 0 && cfg.ActionsFile == nil {
 		return errors.New("missing required field \"actions\"")
 	}
+	if cfg.ActionsFile != nil {
+		if cfg.ActionsFile.Path == "" {
+			return errors.New("missing required field \"actions_file::path\"")
+		}
+		if cfg.ActionsFile.ReloadInterval < 0 {
+			return errors.New("\"actions_file::reload_interval\" must not be negative")
+		}
+	}
 	return nil
 }
@@ -6,6 +6,7 @@ package attributesprocessor
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -197,6 +198,20 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "actions_file"),
+			expected: &Config{
+				Settings: attraction.Settings{
+					Actions: []attraction.ActionKeyValue{
+						{Key: "password", Action: attraction.DELETE},
+					},
+				},
+				ActionsFile: &ActionsFile{
+					Path:           "./testdata/actions.csv",
+					ReloadInterval: time.Minute,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
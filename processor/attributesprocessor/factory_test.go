@@ -6,6 +6,7 @@ package attributesprocessor
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,6 +37,17 @@ func TestValidateConfig(t *testing.T) {
 	assert.Error(t, component.ValidateConfig(cfg))
 }
 
+func TestValidateConfigActionsFile(t *testing.T) {
+	cfg := &Config{ActionsFile: &ActionsFile{Path: "actions.csv"}}
+	assert.NoError(t, component.ValidateConfig(cfg))
+
+	cfg.ActionsFile.ReloadInterval = -time.Second
+	assert.EqualError(t, component.ValidateConfig(cfg), "\"actions_file::reload_interval\" must not be negative")
+
+	cfg.ActionsFile.Path = ""
+	assert.EqualError(t, component.ValidateConfig(cfg), "missing required field \"actions_file::path\"")
+}
+
 func TestFactoryCreateTracesProcessor_InvalidActions(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig()
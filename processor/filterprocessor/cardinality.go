@@ -0,0 +1,265 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const (
+	cardinalityActionDrop      = "drop"
+	cardinalityActionAggregate = "aggregate"
+
+	// overflowAttributeKey is the attribute set on the data points that aggregate the series
+	// beyond the cardinality limit.
+	overflowAttributeKey = "otel.metric.overflow"
+)
+
+// seriesKey identifies a series of a metric.
+type seriesKey struct {
+	resource   [16]byte
+	attributes [16]byte
+}
+
+// cardinalityLimiter tracks the series of each metric during a window and drops, or
+// aggregates, the data points of the new series once a metric reached the limit.
+type cardinalityLimiter struct {
+	limit     int
+	window    time.Duration
+	aggregate bool
+	logger    *zap.Logger
+	now       func() time.Time
+
+	mu          sync.Mutex
+	windowStart time.Time
+	series      map[string]map[seriesKey]struct{}
+	// limited contains the metrics that reached the limit during the current window.
+	limited map[string]struct{}
+}
+
+func newCardinalityLimiter(cfg *CardinalityLimitConfig, logger *zap.Logger) *cardinalityLimiter {
+	return &cardinalityLimiter{
+		limit:     cfg.Limit,
+		window:    cfg.Window,
+		aggregate: cfg.Action == cardinalityActionAggregate,
+		logger:    logger,
+		now:       time.Now,
+		series:    make(map[string]map[seriesKey]struct{}),
+		limited:   make(map[string]struct{}),
+	}
+}
+
+// processMetrics removes, or aggregates, the data points of the series beyond the limit.
+func (cl *cardinalityLimiter) processMetrics(md pmetric.Metrics) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	if now := cl.now(); now.Sub(cl.windowStart) >= cl.window {
+		cl.windowStart = now
+		cl.series = make(map[string]map[seriesKey]struct{})
+		cl.limited = make(map[string]struct{})
+	}
+
+	md.ResourceMetrics().RemoveIf(func(rmetrics pmetric.ResourceMetrics) bool {
+		resourceHash := pdatautil.MapHash(rmetrics.Resource().Attributes())
+		rmetrics.ScopeMetrics().RemoveIf(func(smetrics pmetric.ScopeMetrics) bool {
+			smetrics.Metrics().RemoveIf(func(metric pmetric.Metric) bool {
+				switch metric.Type() {
+				case pmetric.MetricTypeSum:
+					cl.limitNumberDataPoints(metric.Name(), resourceHash, metric.Sum().DataPoints(), cl.aggregate)
+					return metric.Sum().DataPoints().Len() == 0
+				case pmetric.MetricTypeGauge:
+					// gauges can't be aggregated, the data points beyond the limit are always dropped
+					cl.limitNumberDataPoints(metric.Name(), resourceHash, metric.Gauge().DataPoints(), false)
+					return metric.Gauge().DataPoints().Len() == 0
+				case pmetric.MetricTypeHistogram:
+					cl.limitHistogramDataPoints(metric.Name(), resourceHash, metric.Histogram().DataPoints())
+					return metric.Histogram().DataPoints().Len() == 0
+				case pmetric.MetricTypeExponentialHistogram:
+					metric.ExponentialHistogram().DataPoints().RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+						return !cl.admit(metric.Name(), seriesKey{resourceHash, pdatautil.MapHash(dp.Attributes())}, false)
+					})
+					return metric.ExponentialHistogram().DataPoints().Len() == 0
+				case pmetric.MetricTypeSummary:
+					metric.Summary().DataPoints().RemoveIf(func(dp pmetric.SummaryDataPoint) bool {
+						return !cl.admit(metric.Name(), seriesKey{resourceHash, pdatautil.MapHash(dp.Attributes())}, false)
+					})
+					return metric.Summary().DataPoints().Len() == 0
+				default:
+					return false
+				}
+			})
+			return smetrics.Metrics().Len() == 0
+		})
+		return rmetrics.ScopeMetrics().Len() == 0
+	})
+}
+
+// admit returns true if the series is already known or if the metric has not reached the
+// limit yet, in which case the series is added to the known series of the metric. aggregate
+// tells whether the data points of the series beyond the limit are aggregated or dropped.
+func (cl *cardinalityLimiter) admit(name string, key seriesKey, aggregate bool) bool {
+	series, ok := cl.series[name]
+	if !ok {
+		series = make(map[seriesKey]struct{})
+		cl.series[name] = series
+	}
+	if _, ok := series[key]; ok {
+		return true
+	}
+	if len(series) < cl.limit {
+		series[key] = struct{}{}
+		return true
+	}
+	if _, ok := cl.limited[name]; !ok {
+		cl.limited[name] = struct{}{}
+		msg := "Metric reached the cardinality limit, the data points of new series are dropped until the end of the window"
+		if aggregate {
+			msg = "Metric reached the cardinality limit, the data points of new series are aggregated into a single overflow series until the end of the window"
+		}
+		cl.logger.Warn(msg,
+			zap.String("metric", name),
+			zap.Int("limit", cl.limit),
+			zap.Duration("window", cl.window))
+	}
+	return false
+}
+
+func (cl *cardinalityLimiter) limitNumberDataPoints(name string, resourceHash [16]byte, dps pmetric.NumberDataPointSlice, aggregate bool) {
+	var overflow pmetric.NumberDataPoint
+	hasOverflow := false
+	dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+		if cl.admit(name, seriesKey{resourceHash, pdatautil.MapHash(dp.Attributes())}, aggregate) {
+			return false
+		}
+		if !aggregate {
+			return true
+		}
+		if !hasOverflow {
+			overflow = pmetric.NewNumberDataPoint()
+			dp.CopyTo(overflow)
+			overflow.Attributes().Clear()
+			overflow.Attributes().PutBool(overflowAttributeKey, true)
+			overflow.Exemplars().RemoveIf(func(pmetric.Exemplar) bool { return true })
+			hasOverflow = true
+			return true
+		}
+		mergeNumberDataPoint(overflow, dp)
+		return true
+	})
+	if hasOverflow {
+		overflow.MoveTo(dps.AppendEmpty())
+	}
+}
+
+func (cl *cardinalityLimiter) limitHistogramDataPoints(name string, resourceHash [16]byte, dps pmetric.HistogramDataPointSlice) {
+	var overflow pmetric.HistogramDataPoint
+	hasOverflow := false
+	dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+		if cl.admit(name, seriesKey{resourceHash, pdatautil.MapHash(dp.Attributes())}, cl.aggregate) {
+			return false
+		}
+		if !cl.aggregate {
+			return true
+		}
+		if !hasOverflow {
+			overflow = pmetric.NewHistogramDataPoint()
+			dp.CopyTo(overflow)
+			overflow.Attributes().Clear()
+			overflow.Attributes().PutBool(overflowAttributeKey, true)
+			overflow.Exemplars().RemoveIf(func(pmetric.Exemplar) bool { return true })
+			hasOverflow = true
+			return true
+		}
+		// the data points with different bucket boundaries can't be merged and are dropped
+		if equalBounds(overflow.ExplicitBounds(), dp.ExplicitBounds()) {
+			mergeHistogramDataPoint(overflow, dp)
+		}
+		return true
+	})
+	if hasOverflow {
+		overflow.MoveTo(dps.AppendEmpty())
+	}
+}
+
+func equalBounds(a, b pcommon.Float64Slice) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if a.At(i) != b.At(i) {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeNumberDataPoint(dst, src pmetric.NumberDataPoint) {
+	if dst.ValueType() == pmetric.NumberDataPointValueTypeInt && src.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		dst.SetIntValue(dst.IntValue() + src.IntValue())
+	} else {
+		dst.SetDoubleValue(numberValue(dst) + numberValue(src))
+	}
+	mergeTimestamps(dst, src)
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
+
+func mergeHistogramDataPoint(dst, src pmetric.HistogramDataPoint) {
+	dst.SetCount(dst.Count() + src.Count())
+	if dst.HasSum() && src.HasSum() {
+		dst.SetSum(dst.Sum() + src.Sum())
+	} else {
+		dst.RemoveSum()
+	}
+	if dst.HasMin() && src.HasMin() {
+		if src.Min() < dst.Min() {
+			dst.SetMin(src.Min())
+		}
+	} else {
+		dst.RemoveMin()
+	}
+	if dst.HasMax() && src.HasMax() {
+		if src.Max() > dst.Max() {
+			dst.SetMax(src.Max())
+		}
+	} else {
+		dst.RemoveMax()
+	}
+	if dst.BucketCounts().Len() == src.BucketCounts().Len() {
+		for i := 0; i < dst.BucketCounts().Len(); i++ {
+			dst.BucketCounts().SetAt(i, dst.BucketCounts().At(i)+src.BucketCounts().At(i))
+		}
+	}
+	mergeTimestamps(dst, src)
+}
+
+type timestampedDataPoint interface {
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+}
+
+// mergeTimestamps sets the earliest start timestamp and the latest timestamp of both data points on dst.
+func mergeTimestamps[DP timestampedDataPoint](dst, src DP) {
+	if src.StartTimestamp() < dst.StartTimestamp() {
+		dst.SetStartTimestamp(src.StartTimestamp())
+	}
+	if src.Timestamp() > dst.Timestamp() {
+		dst.SetTimestamp(src.Timestamp())
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newSumMetrics(host string, name string, series ...string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("host.name", host)
+	sum := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	sum.SetName(name)
+	sum.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for i, s := range series {
+		dp := sum.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("user.id", s)
+		dp.SetStartTimestamp(pcommon.Timestamp(100 - i))
+		dp.SetTimestamp(pcommon.Timestamp(200 + i))
+		dp.SetIntValue(int64(i + 1))
+	}
+	return md
+}
+
+func dataPointUserIDs(dps pmetric.NumberDataPointSlice) []string {
+	var ids []string
+	for i := 0; i < dps.Len(); i++ {
+		if v, ok := dps.At(i).Attributes().Get("user.id"); ok {
+			ids = append(ids, v.Str())
+		}
+	}
+	return ids
+}
+
+func TestCardinalityLimiterDrop(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cl := newCardinalityLimiter(&CardinalityLimitConfig{Limit: 2, Window: time.Minute}, zap.NewNop())
+	cl.now = func() time.Time { return now }
+
+	md := newSumMetrics("host-a", "requests", "a", "b", "c", "d")
+	cl.processMetrics(md)
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	assert.Equal(t, []string{"a", "b"}, dataPointUserIDs(dps))
+
+	// known series are still accepted, new ones are dropped
+	md = newSumMetrics("host-a", "requests", "e", "b", "a")
+	cl.processMetrics(md)
+	dps = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	assert.Equal(t, []string{"b", "a"}, dataPointUserIDs(dps))
+
+	// the same attributes on another resource are another series
+	md = newSumMetrics("host-b", "requests", "a")
+	cl.processMetrics(md)
+	assert.Equal(t, 0, md.ResourceMetrics().Len(), "empty resources must be removed")
+
+	// the limit applies per metric
+	md = newSumMetrics("host-a", "errors", "c", "d")
+	cl.processMetrics(md)
+	dps = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	assert.Equal(t, []string{"c", "d"}, dataPointUserIDs(dps))
+
+	// the series are forgotten at the end of the window
+	now = now.Add(time.Minute)
+	md = newSumMetrics("host-a", "requests", "e", "f", "a")
+	cl.processMetrics(md)
+	dps = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	assert.Equal(t, []string{"e", "f"}, dataPointUserIDs(dps))
+}
+
+func TestCardinalityLimiterAggregateSum(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	cl := newCardinalityLimiter(&CardinalityLimitConfig{Limit: 1, Window: time.Minute, Action: "aggregate"}, zap.New(core))
+
+	md := newSumMetrics("host-a", "requests", "a", "b", "c", "d")
+	cl.processMetrics(md)
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+
+	assert.Equal(t, []string{"a"}, dataPointUserIDs(dps))
+	assert.Equal(t, int64(1), dps.At(0).IntValue())
+
+	overflow := dps.At(1)
+	assert.Equal(t, map[string]any{overflowAttributeKey: true}, overflow.Attributes().AsRaw())
+	assert.Equal(t, int64(2+3+4), overflow.IntValue())
+	assert.Equal(t, pcommon.Timestamp(97), overflow.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(203), overflow.Timestamp())
+
+	require.Equal(t, 1, logs.Len())
+	assert.Contains(t, logs.All()[0].Message, "aggregated")
+}
+
+func TestCardinalityLimiterAggregateGauge(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	cl := newCardinalityLimiter(&CardinalityLimitConfig{Limit: 1, Window: time.Minute, Action: "aggregate"}, zap.New(core))
+
+	md := pmetric.NewMetrics()
+	gauge := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	gauge.SetName("memory")
+	gauge.SetEmptyGauge()
+	for _, s := range []string{"a", "b"} {
+		dp := gauge.Gauge().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("user.id", s)
+	}
+	cl.processMetrics(md)
+
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	assert.Equal(t, []string{"a"}, dataPointUserIDs(dps))
+	assert.Equal(t, 1, dps.Len(), "gauges must not be aggregated")
+
+	require.Equal(t, 1, logs.Len())
+	assert.Contains(t, logs.All()[0].Message, "dropped")
+}
+
+func TestCardinalityLimiterAggregateHistogram(t *testing.T) {
+	cl := newCardinalityLimiter(&CardinalityLimitConfig{Limit: 1, Window: time.Minute, Action: "aggregate"}, zap.NewNop())
+
+	md := pmetric.NewMetrics()
+	histogram := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	histogram.SetName("latency")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for i, bounds := range [][]float64{{1, 10}, {1, 10}, {1, 10}, {5}} {
+		dp := histogram.Histogram().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("user.id", fmt.Sprint(i))
+		dp.ExplicitBounds().FromRaw(bounds)
+		counts := make([]uint64, len(bounds)+1)
+		for j := range counts {
+			counts[j] = uint64(i + 1)
+		}
+		dp.BucketCounts().FromRaw(counts)
+		dp.SetCount(uint64((i + 1) * len(counts)))
+		dp.SetSum(float64(i + 1))
+		dp.SetMin(float64(i))
+		dp.SetMax(float64(10 + i))
+	}
+	cl.processMetrics(md)
+
+	dps := histogram.Histogram().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	overflow := dps.At(1)
+	assert.Equal(t, map[string]any{overflowAttributeKey: true}, overflow.Attributes().AsRaw())
+	// the last data point has different bounds and is dropped
+	assert.Equal(t, []float64{1, 10}, overflow.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{5, 5, 5}, overflow.BucketCounts().AsRaw())
+	assert.Equal(t, uint64(15), overflow.Count())
+	assert.Equal(t, float64(5), overflow.Sum())
+	assert.Equal(t, float64(1), overflow.Min())
+	assert.Equal(t, float64(12), overflow.Max())
+}
+
+func TestFilterMetricProcessorCardinalityLimit(t *testing.T) {
+	cfg := &Config{
+		Metrics: MetricFilters{
+			DataPointConditions: []string{`attributes["user.id"] == "a"`},
+			CardinalityLimit: &CardinalityLimitConfig{
+				Limit:  2,
+				Window: time.Hour,
+			},
+		},
+	}
+	fmp, err := newFilterMetricProcessor(componenttest.NewNopTelemetrySettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := fmp.processMetrics(context.Background(), newSumMetrics("host-a", "requests", "a", "b", "c", "d"))
+	require.NoError(t, err)
+
+	// the data points dropped by the conditions don't count towards the limit
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	assert.Equal(t, []string{"b", "c"}, dataPointUserIDs(dps))
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -62,6 +63,45 @@ type MetricFilters struct {
 	// If any condition resolves to true, the datapoint will be dropped.
 	// Supports `and`, `or`, and `()`
 	DataPointConditions []string `mapstructure:"datapoint"`
+
+	// CardinalityLimit limits the number of series of each metric, a series being identified by the
+	// resource attributes and the data point attributes. It applies after the other filters.
+	CardinalityLimit *CardinalityLimitConfig `mapstructure:"cardinality_limit"`
+}
+
+// CardinalityLimitConfig configures the number of series a metric can have during a window. Once
+// a metric reached the limit, the data points of its new series are dropped, or aggregated, until
+// the end of the window.
+type CardinalityLimitConfig struct {
+	// Limit is the maximum number of series of each metric during a window.
+	Limit int `mapstructure:"limit"`
+
+	// Window is the duration after which the tracked series are forgotten.
+	Window time.Duration `mapstructure:"window"`
+
+	// Action determines what happens to the data points of the series beyond the limit.
+	// Valid values are `drop` and `aggregate`.
+	// `drop` means the data points are dropped.
+	// `aggregate` means the data points of sums and histograms are merged into a single data point
+	// with the `otel.metric.overflow` attribute for each metric, the others being dropped.
+	// The default value is `drop`.
+	Action string `mapstructure:"action"`
+}
+
+// validate checks that the CardinalityLimitConfig is valid
+func (clc CardinalityLimitConfig) validate() error {
+	if clc.Limit <= 0 {
+		return fmt.Errorf("cardinality_limit: limit must be greater than 0, got %d", clc.Limit)
+	}
+	if clc.Window <= 0 {
+		return fmt.Errorf("cardinality_limit: window must be greater than 0, got %v", clc.Window)
+	}
+	switch clc.Action {
+	case "", cardinalityActionDrop, cardinalityActionAggregate:
+	default:
+		return fmt.Errorf("cardinality_limit: action must be either %q or %q, got %q", cardinalityActionDrop, cardinalityActionAggregate, clc.Action)
+	}
+	return nil
 }
 
 // TraceFilters filters by OTTL conditions
@@ -303,6 +343,10 @@ func (cfg *Config) Validate() error {
 		errors = multierr.Append(errors, err)
 	}
 
+	if cfg.Metrics.CardinalityLimit != nil {
+		errors = multierr.Append(errors, cfg.Metrics.CardinalityLimit.validate())
+	}
+
 	if cfg.Logs.LogConditions != nil && cfg.Logs.Include != nil {
 		errors = multierr.Append(errors, cfg.Logs.Include.validate())
 	}
@@ -6,6 +6,7 @@ package filterprocessor
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -931,3 +932,73 @@ func TestLoadingConfigOTTL(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadingConfigCardinalityLimit(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config_cardinality_limit.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id           component.ID
+		expected     *Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, "cardinality_limit"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				Metrics: MetricFilters{
+					CardinalityLimit: &CardinalityLimitConfig{
+						Limit:  1000,
+						Window: time.Hour,
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "cardinality_limit_aggregate"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				Metrics: MetricFilters{
+					MetricConditions: []string{
+						`name == "drop"`,
+					},
+					CardinalityLimit: &CardinalityLimitConfig{
+						Limit:  100,
+						Window: 10 * time.Minute,
+						Action: "aggregate",
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "cardinality_limit_no_limit"),
+			errorMessage: "cardinality_limit: limit must be greater than 0, got 0",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "cardinality_limit_no_window"),
+			errorMessage: "cardinality_limit: window must be greater than 0, got 0s",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "cardinality_limit_invalid_action"),
+			errorMessage: `cardinality_limit: action must be either "drop" or "aggregate", got "sample"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expected == nil {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+			} else {
+				assert.NoError(t, component.ValidateConfig(cfg))
+				assert.Equal(t, tt.expected, cfg)
+			}
+		})
+	}
+}
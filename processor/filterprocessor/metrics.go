@@ -28,6 +28,7 @@ type filterMetricProcessor struct {
 	skipResourceExpr  expr.BoolExpr[ottlresource.TransformContext]
 	skipMetricExpr    expr.BoolExpr[ottlmetric.TransformContext]
 	skipDataPointExpr expr.BoolExpr[ottldatapoint.TransformContext]
+	cardinalityLimit  *cardinalityLimiter
 	logger            *zap.Logger
 }
 
@@ -36,6 +37,9 @@ func newFilterMetricProcessor(set component.TelemetrySettings, cfg *Config) (*fi
 	fsp := &filterMetricProcessor{
 		logger: set.Logger,
 	}
+	if cfg.Metrics.CardinalityLimit != nil {
+		fsp.cardinalityLimit = newCardinalityLimiter(cfg.Metrics.CardinalityLimit, set.Logger)
+	}
 	if cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil {
 		if cfg.Metrics.MetricConditions != nil {
 			fsp.skipMetricExpr, err = filterottl.NewBoolExprForMetric(cfg.Metrics.MetricConditions, filterottl.StandardMetricFuncs(), cfg.ErrorMode, set)
@@ -103,7 +107,7 @@ func newFilterMetricProcessor(set component.TelemetrySettings, cfg *Config) (*fi
 
 // processMetrics filters the given metrics based off the filterMetricProcessor's filters.
 func (fmp *filterMetricProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
-	if fmp.skipResourceExpr == nil && fmp.skipMetricExpr == nil && fmp.skipDataPointExpr == nil {
+	if fmp.skipResourceExpr == nil && fmp.skipMetricExpr == nil && fmp.skipDataPointExpr == nil && fmp.cardinalityLimit == nil {
 		return md, nil
 	}
 
@@ -160,6 +164,10 @@ func (fmp *filterMetricProcessor) processMetrics(ctx context.Context, md pmetric
 		return rmetrics.ScopeMetrics().Len() == 0
 	})
 
+	if fmp.cardinalityLimit != nil {
+		fmp.cardinalityLimit.processMetrics(md)
+	}
+
 	if errors != nil {
 		fmp.logger.Error("failed processing metrics", zap.Error(errors))
 		return md, errors
@@ -15,61 +15,70 @@ import (
 type tracesGroup struct {
 	traces         ptrace.Traces
 	resourceHashes [][16]byte
+	// origins are the indexes of the input resources each resource was created from
+	origins []int
 }
 
 func newTracesGroup() *tracesGroup {
 	return &tracesGroup{traces: ptrace.NewTraces()}
 }
 
-// findOrCreateResource searches for a Resource with matching attributes and returns it. If nothing is found, it is being created
-func (tg *tracesGroup) findOrCreateResourceSpans(originResource pcommon.Resource, requiredAttributes pcommon.Map) ptrace.ResourceSpans {
+// findOrCreateResourceSpans searches for a Resource with matching attributes and returns it. If nothing is found, it is being created.
+// It also returns whether the Resource was created from another input Resource, i.e. whether the record is compacted with records of another input Resource.
+func (tg *tracesGroup) findOrCreateResourceSpans(origin int, originResource pcommon.Resource, requiredAttributes pcommon.Map) (ptrace.ResourceSpans, bool) {
 	referenceResource := buildReferenceResource(originResource, requiredAttributes)
 	referenceResourceHash := pdatautil.MapHash(referenceResource.Attributes())
 
 	rss := tg.traces.ResourceSpans()
 	for i := 0; i < rss.Len(); i++ {
 		if tg.resourceHashes[i] == referenceResourceHash {
-			return rss.At(i)
+			return rss.At(i), tg.origins[i] != origin
 		}
 	}
 
 	rs := tg.traces.ResourceSpans().AppendEmpty()
 	referenceResource.MoveTo(rs.Resource())
 	tg.resourceHashes = append(tg.resourceHashes, referenceResourceHash)
-	return rs
+	tg.origins = append(tg.origins, origin)
+	return rs, false
 }
 
 type metricsGroup struct {
 	metrics        pmetric.Metrics
 	resourceHashes [][16]byte
+	// origins are the indexes of the input resources each resource was created from
+	origins []int
 }
 
 func newMetricsGroup() *metricsGroup {
 	return &metricsGroup{metrics: pmetric.NewMetrics()}
 }
 
-// findOrCreateResourceMetrics searches for a Resource with matching attributes and returns it. If nothing is found, it is being created
-func (mg *metricsGroup) findOrCreateResourceMetrics(originResource pcommon.Resource, requiredAttributes pcommon.Map) pmetric.ResourceMetrics {
+// findOrCreateResourceMetrics searches for a Resource with matching attributes and returns it. If nothing is found, it is being created.
+// It also returns whether the Resource was created from another input Resource, i.e. whether the record is compacted with records of another input Resource.
+func (mg *metricsGroup) findOrCreateResourceMetrics(origin int, originResource pcommon.Resource, requiredAttributes pcommon.Map) (pmetric.ResourceMetrics, bool) {
 	referenceResource := buildReferenceResource(originResource, requiredAttributes)
 	referenceResourceHash := pdatautil.MapHash(referenceResource.Attributes())
 
 	rms := mg.metrics.ResourceMetrics()
 	for i := 0; i < rms.Len(); i++ {
 		if mg.resourceHashes[i] == referenceResourceHash {
-			return rms.At(i)
+			return rms.At(i), mg.origins[i] != origin
 		}
 	}
 
 	rm := mg.metrics.ResourceMetrics().AppendEmpty()
 	referenceResource.MoveTo(rm.Resource())
 	mg.resourceHashes = append(mg.resourceHashes, referenceResourceHash)
-	return rm
-
+	mg.origins = append(mg.origins, origin)
+	return rm, false
 }
 
 type logsGroup struct {
 	logs           plog.Logs
 	resourceHashes [][16]byte
+	// origins are the indexes of the input resources each resource was created from
+	origins []int
 }
 
 // newLogsGroup returns new logsGroup with predefined capacity
@@ -77,22 +86,24 @@ func newLogsGroup() *logsGroup {
 	return &logsGroup{logs: plog.NewLogs()}
 }
 
-// findOrCreateResourceLogs searches for a Resource with matching attributes and returns it. If nothing is found, it is being created
-func (lg *logsGroup) findOrCreateResourceLogs(originResource pcommon.Resource, requiredAttributes pcommon.Map) plog.ResourceLogs {
+// findOrCreateResourceLogs searches for a Resource with matching attributes and returns it. If nothing is found, it is being created.
+// It also returns whether the Resource was created from another input Resource, i.e. whether the record is compacted with records of another input Resource.
+func (lg *logsGroup) findOrCreateResourceLogs(origin int, originResource pcommon.Resource, requiredAttributes pcommon.Map) (plog.ResourceLogs, bool) {
 	referenceResource := buildReferenceResource(originResource, requiredAttributes)
 	referenceResourceHash := pdatautil.MapHash(referenceResource.Attributes())
 
 	rls := lg.logs.ResourceLogs()
 	for i := 0; i < rls.Len(); i++ {
 		if lg.resourceHashes[i] == referenceResourceHash {
-			return rls.At(i)
+			return rls.At(i), lg.origins[i] != origin
 		}
 	}
 
 	rl := lg.logs.ResourceLogs().AppendEmpty()
 	referenceResource.MoveTo(rl.Resource())
 	lg.resourceHashes = append(lg.resourceHashes, referenceResourceHash)
-	return rl
+	lg.origins = append(lg.origins, origin)
+	return rl, false
 }
 
 func instrumentationLibrariesEqual(il1, il2 pcommon.InstrumentationScope) bool {
@@ -108,12 +108,39 @@ func TestResourceAttributeScenarios(t *testing.T) {
 				tt.fillExpectedResourceFun(tt.baseResource, expectedResource)
 			}
 
-			rl := lg.findOrCreateResourceLogs(tt.baseResource, recordAttributeMap)
+			rl, _ := lg.findOrCreateResourceLogs(0, tt.baseResource, recordAttributeMap)
 			assert.Equal(t, expectedResource.Attributes().AsRaw(), rl.Resource().Attributes().AsRaw())
 		})
 	}
 }
 
+func TestCompactedResource(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("host.name", "host-A")
+
+	lg := newLogsGroup()
+	_, compacted := lg.findOrCreateResourceLogs(0, res, attrs)
+	assert.False(t, compacted)
+	_, compacted = lg.findOrCreateResourceLogs(0, res, attrs)
+	assert.False(t, compacted)
+	_, compacted = lg.findOrCreateResourceLogs(1, res, attrs)
+	assert.True(t, compacted)
+	_, compacted = lg.findOrCreateResourceLogs(1, res, pcommon.NewMap())
+	assert.False(t, compacted)
+
+	tg := newTracesGroup()
+	_, compacted = tg.findOrCreateResourceSpans(0, res, attrs)
+	assert.False(t, compacted)
+	_, compacted = tg.findOrCreateResourceSpans(1, res, attrs)
+	assert.True(t, compacted)
+
+	mg := newMetricsGroup()
+	_, compacted = mg.findOrCreateResourceMetrics(0, res, attrs)
+	assert.False(t, compacted)
+	_, compacted = mg.findOrCreateResourceMetrics(1, res, attrs)
+	assert.True(t, compacted)
+}
+
 func TestInstrumentationLibraryMatching(t *testing.T) {
 	rl := plog.NewResourceLogs()
 	rs := ptrace.NewResourceSpans()
@@ -150,6 +177,6 @@ func BenchmarkAttrGrouping(b *testing.B) {
 	lg := newLogsGroup()
 	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
-		lg.findOrCreateResourceLogs(res, groups[rand.Intn(count)])
+		lg.findOrCreateResourceLogs(0, res, groups[rand.Intn(count)])
 	}
 }
@@ -3,10 +3,35 @@
 
 package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
 
+import (
+	"errors"
+	"fmt"
+)
+
+var errKeysAndHierarchy = errors.New("keys and hierarchy cannot be both set")
+
 // Config is the configuration for the processor.
 type Config struct {
 
 	// GroupByKeys describes the attribute names that are going to be used for grouping.
 	// Empty value is allowed, since processor in such case can compact data
 	GroupByKeys []string `mapstructure:"keys"`
+
+	// Hierarchy describes the levels of attribute names used for grouping, from the outermost
+	// to the innermost, e.g. the cluster, the namespace and the pod. The attributes of a level
+	// are only used for grouping when the record has at least one attribute of each previous level.
+	Hierarchy [][]string `mapstructure:"hierarchy"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if len(cfg.GroupByKeys) > 0 && len(cfg.Hierarchy) > 0 {
+		return errKeysAndHierarchy
+	}
+	for i, level := range cfg.Hierarchy {
+		if len(level) == 0 {
+			return fmt.Errorf("level %d of the hierarchy has no keys", i)
+		}
+	}
+	return nil
 }
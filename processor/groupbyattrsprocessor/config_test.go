@@ -34,6 +34,17 @@ func TestLoadingConfig(t *testing.T) {
 				GroupByKeys: []string{},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "hierarchy"),
+			expected: &Config{
+				GroupByKeys: []string{},
+				Hierarchy: [][]string{
+					{"k8s.cluster.name"},
+					{"k8s.namespace.name"},
+					{"k8s.pod.name", "k8s.pod.uid"},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -53,3 +64,41 @@ func TestLoadingConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *Config
+		err  string
+	}{
+		{
+			name: "keys",
+			cfg:  &Config{GroupByKeys: []string{"key1"}},
+		},
+		{
+			name: "hierarchy",
+			cfg:  &Config{Hierarchy: [][]string{{"key1"}, {"key2", "key3"}}},
+		},
+		{
+			name: "keys and hierarchy",
+			cfg:  &Config{GroupByKeys: []string{"key1"}, Hierarchy: [][]string{{"key2"}}},
+			err:  errKeysAndHierarchy.Error(),
+		},
+		{
+			name: "empty level",
+			cfg:  &Config{Hierarchy: [][]string{{"key1"}, {}}},
+			err:  "level 1 of the hierarchy has no keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -46,9 +46,36 @@ func createDefaultConfig() component.Config {
 }
 
 func createGroupByAttrsProcessor(logger *zap.Logger, attributes []string) *groupByAttrsProcessor {
-	var nonEmptyAttributes []string
+	nonEmptyAttributes := uniqueAttributes(logger, attributes, make(map[string]struct{}))
+	return &groupByAttrsProcessor{logger: logger, groupByKeys: nonEmptyAttributes}
+}
+
+// createHierarchicalGroupByAttrsProcessor creates a processor grouping the records by the levels
+// of the hierarchy, a key being only used by its first level.
+func createHierarchicalGroupByAttrsProcessor(logger *zap.Logger, hierarchy [][]string) *groupByAttrsProcessor {
+	var levels [][]string
 	presentAttributes := make(map[string]struct{})
 
+	for _, level := range hierarchy {
+		if nonEmptyAttributes := uniqueAttributes(logger, level, presentAttributes); len(nonEmptyAttributes) > 0 {
+			levels = append(levels, nonEmptyAttributes)
+		}
+	}
+
+	return &groupByAttrsProcessor{logger: logger, hierarchy: levels}
+}
+
+func newGroupByAttrsProcessor(logger *zap.Logger, cfg *Config) *groupByAttrsProcessor {
+	if len(cfg.Hierarchy) > 0 {
+		return createHierarchicalGroupByAttrsProcessor(logger, cfg.Hierarchy)
+	}
+	return createGroupByAttrsProcessor(logger, cfg.GroupByKeys)
+}
+
+// uniqueAttributes returns the non empty attributes not already present
+func uniqueAttributes(logger *zap.Logger, attributes []string, presentAttributes map[string]struct{}) []string {
+	var nonEmptyAttributes []string
+
 	for _, str := range attributes {
 		if str != "" {
 			_, isPresent := presentAttributes[str]
@@ -61,7 +88,7 @@ func createGroupByAttrsProcessor(logger *zap.Logger, attributes []string) *group
 		}
 	}
 
-	return &groupByAttrsProcessor{logger: logger, groupByKeys: nonEmptyAttributes}
+	return nonEmptyAttributes
 }
 
 // createTracesProcessor creates a trace processor based on this config.
@@ -72,7 +99,7 @@ func createTracesProcessor(
 	nextConsumer consumer.Traces) (processor.Traces, error) {
 
 	oCfg := cfg.(*Config)
-	gap := createGroupByAttrsProcessor(set.Logger, oCfg.GroupByKeys)
+	gap := newGroupByAttrsProcessor(set.Logger, oCfg)
 
 	return processorhelper.NewTracesProcessor(
 		ctx,
@@ -91,7 +118,7 @@ func createLogsProcessor(
 	nextConsumer consumer.Logs) (processor.Logs, error) {
 
 	oCfg := cfg.(*Config)
-	gap := createGroupByAttrsProcessor(set.Logger, oCfg.GroupByKeys)
+	gap := newGroupByAttrsProcessor(set.Logger, oCfg)
 
 	return processorhelper.NewLogsProcessor(
 		ctx,
@@ -110,7 +137,7 @@ func createMetricsProcessor(
 	nextConsumer consumer.Metrics) (processor.Metrics, error) {
 
 	oCfg := cfg.(*Config)
-	gap := createGroupByAttrsProcessor(set.Logger, oCfg.GroupByKeys)
+	gap := newGroupByAttrsProcessor(set.Logger, oCfg)
 
 	return processorhelper.NewMetricsProcessor(
 		ctx,
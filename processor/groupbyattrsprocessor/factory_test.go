@@ -50,3 +50,10 @@ func TestDuplicateKeys(t *testing.T) {
 	assert.NotNil(t, gbap)
 	assert.EqualValues(t, []string{"foo"}, gbap.groupByKeys)
 }
+
+func TestDuplicateHierarchyKeys(t *testing.T) {
+	gbap := createHierarchicalGroupByAttrsProcessor(zap.NewNop(), [][]string{{"foo", ""}, {"foo", "bar"}, {""}})
+	assert.NotNil(t, gbap)
+	assert.EqualValues(t, [][]string{{"foo"}, {"bar"}}, gbap.hierarchy)
+	assert.Empty(t, gbap.groupByKeys)
+}
@@ -15,14 +15,17 @@ var (
 	mNumGroupedSpans    = stats.Int64("num_grouped_spans", "Number of spans that had attributes grouped", stats.UnitDimensionless)
 	mNumNonGroupedSpans = stats.Int64("num_non_grouped_spans", "Number of spans that did not have attributes grouped", stats.UnitDimensionless)
 	mDistSpanGroups     = stats.Int64("span_groups", "Distribution of groups extracted for spans", stats.UnitDimensionless)
+	mNumCompactedSpans  = stats.Int64("num_compacted_spans", "Number of spans that were moved under the resource of spans from another resource", stats.UnitDimensionless)
 
 	mNumGroupedLogs    = stats.Int64("num_grouped_logs", "Number of logs that had attributes grouped", stats.UnitDimensionless)
 	mNumNonGroupedLogs = stats.Int64("num_non_grouped_logs", "Number of logs that did not have attributes grouped", stats.UnitDimensionless)
 	mDistLogGroups     = stats.Int64("log_groups", "Distribution of groups extracted for logs", stats.UnitDimensionless)
+	mNumCompactedLogs  = stats.Int64("num_compacted_logs", "Number of logs that were moved under the resource of logs from another resource", stats.UnitDimensionless)
 
 	mNumGroupedMetrics    = stats.Int64("num_grouped_metrics", "Number of metrics that had attributes grouped", stats.UnitDimensionless)
 	mNumNonGroupedMetrics = stats.Int64("num_non_grouped_metrics", "Number of metrics that did not have attributes grouped", stats.UnitDimensionless)
 	mDistMetricGroups     = stats.Int64("metric_groups", "Distribution of groups extracted for metrics", stats.UnitDimensionless)
+	mNumCompactedMetrics  = stats.Int64("num_compacted_metrics", "Number of metrics that were moved under the resource of metrics from another resource", stats.UnitDimensionless)
 )
 
 // MetricViews return the metrics views according to given telemetry level.
@@ -86,5 +89,24 @@ func MetricViews() []*view.View {
 			Description: mDistMetricGroups.Description(),
 			Aggregation: distributionGroups,
 		},
+
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mNumCompactedSpans.Name()),
+			Measure:     mNumCompactedSpans,
+			Description: mNumCompactedSpans.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mNumCompactedLogs.Name()),
+			Measure:     mNumCompactedLogs,
+			Description: mNumCompactedLogs.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mNumCompactedMetrics.Name()),
+			Measure:     mNumCompactedMetrics,
+			Description: mNumCompactedMetrics.Description(),
+			Aggregation: view.Sum(),
+		},
 	}
 }
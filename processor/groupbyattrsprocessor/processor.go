@@ -17,6 +17,8 @@ import (
 type groupByAttrsProcessor struct {
 	logger      *zap.Logger
 	groupByKeys []string
+	// hierarchy are the levels of keys used for grouping instead of groupByKeys, when set
+	hierarchy [][]string
 }
 
 // ProcessTraces process traces and groups traces by attribute.
@@ -45,7 +47,10 @@ func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td ptrace.T
 
 				// Lets combine the base resource attributes + the extracted (grouped) attributes
 				// and keep them in the grouping entry
-				groupedResourceSpans := tg.findOrCreateResourceSpans(rs.Resource(), requiredAttributes)
+				groupedResourceSpans, compacted := tg.findOrCreateResourceSpans(i, rs.Resource(), requiredAttributes)
+				if compacted {
+					stats.Record(ctx, mNumCompactedSpans.M(1))
+				}
 				sp := matchingScopeSpans(groupedResourceSpans, ils.Scope()).Spans().AppendEmpty()
 				span.CopyTo(sp)
 			}
@@ -83,7 +88,10 @@ func (gap *groupByAttrsProcessor) processLogs(ctx context.Context, ld plog.Logs)
 
 				// Lets combine the base resource attributes + the extracted (grouped) attributes
 				// and keep them in the grouping entry
-				groupedResourceLogs := lg.findOrCreateResourceLogs(ls.Resource(), requiredAttributes)
+				groupedResourceLogs, compacted := lg.findOrCreateResourceLogs(i, ls.Resource(), requiredAttributes)
+				if compacted {
+					stats.Record(ctx, mNumCompactedLogs.M(1))
+				}
 				lr := matchingScopeLogs(groupedResourceLogs, sl.Scope()).LogRecords().AppendEmpty()
 				log.CopyTo(lr)
 			}
@@ -115,35 +123,35 @@ func (gap *groupByAttrsProcessor) processMetrics(ctx context.Context, md pmetric
 				case pmetric.MetricTypeGauge:
 					for pointIndex := 0; pointIndex < metric.Gauge().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Gauge().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, i, rm, ilm, metric, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Gauge().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeSum:
 					for pointIndex := 0; pointIndex < metric.Sum().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Sum().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, i, rm, ilm, metric, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Sum().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeSummary:
 					for pointIndex := 0; pointIndex < metric.Summary().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Summary().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, i, rm, ilm, metric, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Summary().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeHistogram:
 					for pointIndex := 0; pointIndex < metric.Histogram().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Histogram().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, i, rm, ilm, metric, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Histogram().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeExponentialHistogram:
 					for pointIndex := 0; pointIndex < metric.ExponentialHistogram().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.ExponentialHistogram().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, i, rm, ilm, metric, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.ExponentialHistogram().DataPoints().AppendEmpty())
 					}
 
@@ -167,6 +175,7 @@ func deleteAttributes(attrsForRemoval, targetAttrs pcommon.Map) {
 
 // extractGroupingAttributes extracts the keys and values of the specified Attributes
 // that match with the attributes keys that is used for grouping
+// With a hierarchy, the keys of a level are only extracted when at least one key of each previous level matched
 // Returns:
 //   - whether any attribute matched (true) or none (false)
 //   - the extracted AttributeMap of matching keys and their corresponding values
@@ -175,6 +184,24 @@ func (gap *groupByAttrsProcessor) extractGroupingAttributes(attrMap pcommon.Map)
 	groupingAttributes := pcommon.NewMap()
 	foundMatch := false
 
+	if len(gap.hierarchy) > 0 {
+		for _, level := range gap.hierarchy {
+			levelMatch := false
+			for _, attrKey := range level {
+				attrVal, found := attrMap.Get(attrKey)
+				if found {
+					attrVal.CopyTo(groupingAttributes.PutEmpty(attrKey))
+					levelMatch = true
+				}
+			}
+			if !levelMatch {
+				break
+			}
+			foundMatch = true
+		}
+		return foundMatch, groupingAttributes
+	}
+
 	for _, attrKey := range gap.groupByKeys {
 		attrVal, found := attrMap.Get(attrKey)
 		if found {
@@ -233,6 +260,7 @@ func getMetricInInstrumentationLibrary(ilm pmetric.ScopeMetrics, searchedMetric
 func (gap *groupByAttrsProcessor) getGroupedMetricsFromAttributes(
 	ctx context.Context,
 	mg *metricsGroup,
+	origin int,
 	originResourceMetrics pmetric.ResourceMetrics,
 	ilm pmetric.ScopeMetrics,
 	metric pmetric.Metric,
@@ -250,7 +278,10 @@ func (gap *groupByAttrsProcessor) getGroupedMetricsFromAttributes(
 	}
 
 	// Get the ResourceMetrics matching with these attributes
-	groupedResourceMetrics := mg.findOrCreateResourceMetrics(originResourceMetrics.Resource(), requiredAttributes)
+	groupedResourceMetrics, compacted := mg.findOrCreateResourceMetrics(origin, originResourceMetrics.Resource(), requiredAttributes)
+	if compacted {
+		stats.Record(ctx, mNumCompactedMetrics.M(1))
+	}
 
 	// Get the corresponding instrumentation library
 	groupedInstrumentationLibrary := matchingScopeMetrics(groupedResourceMetrics, ilm.Scope())
@@ -881,3 +881,56 @@ func BenchmarkCompacting(bb *testing.B) {
 		})
 	}
 }
+
+func TestHierarchicalGrouping(t *testing.T) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("source", "gateway")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for _, attrs := range []map[string]any{
+		{"cluster": "c1", "namespace": "n1", "pod": "p1", "id": 1},
+		{"cluster": "c1", "namespace": "n1", "pod": "p1", "id": 2},
+		{"cluster": "c1", "namespace": "n1", "pod": "p2", "id": 3},
+		{"cluster": "c1", "pod": "p3", "id": 4},
+		{"namespace": "n2", "pod": "p4", "id": 5},
+	} {
+		assert.NoError(t, records.AppendEmpty().Attributes().FromRaw(attrs))
+	}
+
+	gap := createHierarchicalGroupByAttrsProcessor(zap.NewNop(), [][]string{{"cluster"}, {"namespace"}, {"pod"}})
+	processedLogs, err := gap.processLogs(context.Background(), logs)
+	assert.NoError(t, err)
+
+	expected := []struct {
+		resource map[string]any
+		records  []map[string]any
+	}{
+		{
+			resource: map[string]any{"source": "gateway", "cluster": "c1", "namespace": "n1", "pod": "p1"},
+			records:  []map[string]any{{"id": int64(1)}, {"id": int64(2)}},
+		},
+		{
+			resource: map[string]any{"source": "gateway", "cluster": "c1", "namespace": "n1", "pod": "p2"},
+			records:  []map[string]any{{"id": int64(3)}},
+		},
+		{
+			resource: map[string]any{"source": "gateway", "cluster": "c1"},
+			records:  []map[string]any{{"pod": "p3", "id": int64(4)}},
+		},
+		{
+			resource: map[string]any{"source": "gateway"},
+			records:  []map[string]any{{"namespace": "n2", "pod": "p4", "id": int64(5)}},
+		},
+	}
+
+	rls := processedLogs.ResourceLogs()
+	assert.Equal(t, len(expected), rls.Len())
+	for i, e := range expected {
+		assert.Equal(t, e.resource, rls.At(i).Resource().Attributes().AsRaw())
+		lrs := rls.At(i).ScopeLogs().At(0).LogRecords()
+		assert.Equal(t, len(e.records), lrs.Len())
+		for j, attrs := range e.records {
+			assert.Equal(t, attrs, lrs.At(j).Attributes().AsRaw())
+		}
+	}
+}
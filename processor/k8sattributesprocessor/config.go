@@ -93,7 +93,7 @@ type ExtractConfig struct {
 	Labels []FieldExtractConfig `mapstructure:"labels"`
 }
 
-// FieldExtractConfig allows specifying an extraction rule to extract a resource attribute from pod (or namespace)
+// FieldExtractConfig allows specifying an extraction rule to extract a resource attribute from pod (or namespace, or node)
 // annotations (or labels).
 type FieldExtractConfig struct {
 	// TagName represents the name of the resource attribute that will be added to logs, metrics or spans.
@@ -147,7 +147,7 @@ type FieldExtractConfig struct {
 	Regex string `mapstructure:"regex"`
 
 	// From represents the source of the labels/annotations.
-	// Allowed values are "pod" and "namespace". The default is pod.
+	// Allowed values are "pod", "namespace" and "node". The default is pod.
 	From string `mapstructure:"from"`
 }
 
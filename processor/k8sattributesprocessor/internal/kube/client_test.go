@@ -115,6 +115,31 @@ func namespaceAddAndUpdateTest(t *testing.T, c *WatchClient, handler func(obj in
 	assert.Equal(t, "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", got.NamespaceUID)
 }
 
+func nodeAddAndUpdateTest(t *testing.T, c *WatchClient, handler func(obj interface{})) {
+	assert.Equal(t, 0, len(c.Nodes))
+
+	node := &api_v1.Node{}
+	handler(node)
+	assert.Equal(t, 0, len(c.Nodes))
+
+	node = &api_v1.Node{}
+	node.Name = "nodeA"
+	handler(node)
+	assert.Equal(t, 1, len(c.Nodes))
+	got := c.Nodes["nodeA"]
+	assert.Equal(t, "nodeA", got.Name)
+	assert.Equal(t, "", got.NodeUID)
+
+	node = &api_v1.Node{}
+	node.Name = "nodeB"
+	node.UID = "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"
+	handler(node)
+	assert.Equal(t, 2, len(c.Nodes))
+	got = c.Nodes["nodeB"]
+	assert.Equal(t, "nodeB", got.Name)
+	assert.Equal(t, "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", got.NodeUID)
+}
+
 func TestDefaultClientset(t *testing.T) {
 	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, nil, nil, nil)
 	assert.Error(t, err)
@@ -192,6 +217,11 @@ func TestNamespaceAdd(t *testing.T) {
 	namespaceAddAndUpdateTest(t, c, c.handleNamespaceAdd)
 }
 
+func TestNodeAdd(t *testing.T) {
+	c, _ := newTestClient(t)
+	nodeAddAndUpdateTest(t, c, c.handleNodeAdd)
+}
+
 func TestReplicaSetHandler(t *testing.T) {
 	c, _ := newTestClient(t)
 	assert.Equal(t, len(c.ReplicaSets), 0)
@@ -383,6 +413,14 @@ func TestNamespaceUpdate(t *testing.T) {
 	})
 }
 
+func TestNodeUpdate(t *testing.T) {
+	c, _ := newTestClient(t)
+	nodeAddAndUpdateTest(t, c, func(obj interface{}) {
+		// first argument (old node) is not used right now
+		c.handleNodeUpdate(&api_v1.Node{}, obj)
+	})
+}
+
 func TestPodDelete(t *testing.T) {
 	c, _ := newTestClient(t)
 	podAddAndUpdateTest(t, c, c.handlePodAdd)
@@ -466,6 +504,29 @@ func TestNamespaceDelete(t *testing.T) {
 	assert.Equal(t, "namespaceA", got.Name)
 }
 
+func TestNodeDelete(t *testing.T) {
+	c, _ := newTestClient(t)
+	nodeAddAndUpdateTest(t, c, c.handleNodeAdd)
+	assert.Equal(t, 2, len(c.Nodes))
+	assert.Equal(t, "nodeA", c.Nodes["nodeA"].Name)
+
+	// delete non-existent node
+	node := &api_v1.Node{}
+	node.Name = "nodeC"
+	c.handleNodeDelete(node)
+	assert.Equal(t, 2, len(c.Nodes))
+
+	node = &api_v1.Node{}
+	node.Name = "nodeA"
+	c.handleNodeDelete(node)
+	assert.Equal(t, 1, len(c.Nodes))
+	_, ok := c.GetNode("nodeA")
+	assert.False(t, ok)
+	got, ok := c.GetNode("nodeB")
+	require.True(t, ok)
+	assert.Equal(t, "nodeB", got.Name)
+}
+
 func TestDeleteQueue(t *testing.T) {
 	c, _ := newTestClient(t)
 	podAddAndUpdateTest(t, c, c.handlePodAdd)
@@ -1243,6 +1304,120 @@ func TestNamespaceExtractionRules(t *testing.T) {
 	}
 }
 
+func TestNodeExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+
+	node := &api_v1.Node{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:              "k8s-node-example",
+			UID:               "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			CreationTimestamp: meta_v1.Now(),
+			Labels: map[string]string{
+				"label1":                           "lv1",
+				"topology.kubernetes.io/zone":      "us-east-1a",
+				"node.kubernetes.io/instance-type": "m5.large",
+			},
+			Annotations: map[string]string{
+				"annotation1": "av1",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		rules      ExtractionRules
+		attributes map[string]string
+	}{{
+		name:       "no-rules",
+		rules:      ExtractionRules{},
+		attributes: map[string]string{},
+	}, {
+		name: "pod-rules",
+		rules: ExtractionRules{
+			Labels: []FieldExtractionRule{{
+				Name: "l1",
+				Key:  "label1",
+				From: MetadataFromPod,
+			},
+			},
+		},
+		attributes: map[string]string{},
+	}, {
+		name: "labels",
+		rules: ExtractionRules{
+			Annotations: []FieldExtractionRule{{
+				Name: "a1",
+				Key:  "annotation1",
+				From: MetadataFromNode,
+			},
+			},
+			Labels: []FieldExtractionRule{{
+				Name: "l1",
+				Key:  "label1",
+				From: MetadataFromNode,
+			},
+			},
+		},
+		attributes: map[string]string{
+			"l1": "lv1",
+			"a1": "av1",
+		},
+	},
+		{
+			name: "all-labels",
+			rules: ExtractionRules{
+				Labels: []FieldExtractionRule{{
+					KeyRegex: regexp.MustCompile("^(?:la.*)$"),
+					From:     MetadataFromNode,
+				},
+				},
+			},
+			attributes: map[string]string{
+				"k8s.node.labels.label1": "lv1",
+			},
+		},
+		{
+			name: "all-annotations",
+			rules: ExtractionRules{
+				Annotations: []FieldExtractionRule{{
+					KeyRegex: regexp.MustCompile("^(?:an.*)$"),
+					From:     MetadataFromNode,
+				},
+				},
+			},
+			attributes: map[string]string{
+				"k8s.node.annotations.annotation1": "av1",
+			},
+		},
+		{
+			name: "captured-groups",
+			rules: ExtractionRules{
+				Labels: []FieldExtractionRule{{
+					Name:                 "k8s.node.$1",
+					KeyRegex:             regexp.MustCompile(`^(?:topology\.kubernetes\.io|node\.kubernetes\.io)/(.*)$`),
+					HasKeyRegexReference: true,
+					From:                 MetadataFromNode,
+				},
+				},
+			},
+			attributes: map[string]string{
+				"k8s.node.zone":          "us-east-1a",
+				"k8s.node.instance-type": "m5.large",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.Rules = tc.rules
+			c.handleNodeAdd(node)
+			n, ok := c.GetNode(node.Name)
+			require.True(t, ok)
+
+			assert.Equal(t, tc.attributes, n.Attributes)
+		})
+	}
+}
+
 func TestFilters(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -1741,6 +1916,59 @@ func TestExtractNamespaceLabelsAnnotations(t *testing.T) {
 	}
 }
 
+func TestExtractNodeLabelsAnnotations(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+	testCases := []struct {
+		name              string
+		shouldExtractNode bool
+		rules             ExtractionRules
+	}{{
+		name:              "empty-rules",
+		shouldExtractNode: false,
+		rules:             ExtractionRules{},
+	}, {
+		name:              "namespace-rules",
+		shouldExtractNode: false,
+		rules: ExtractionRules{
+			Labels: []FieldExtractionRule{{
+				Name: "l1",
+				Key:  "label1",
+				From: MetadataFromNamespace,
+			},
+			},
+		},
+	}, {
+		name:              "node-rules-only-annotations",
+		shouldExtractNode: true,
+		rules: ExtractionRules{
+			Annotations: []FieldExtractionRule{{
+				Name: "a1",
+				Key:  "annotation1",
+				From: MetadataFromNode,
+			},
+			},
+		},
+	}, {
+		name:              "node-rules-only-labels",
+		shouldExtractNode: true,
+		rules: ExtractionRules{
+			Labels: []FieldExtractionRule{{
+				Name: "l1",
+				Key:  "label1",
+				From: MetadataFromNode,
+			},
+			},
+		},
+	},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.Rules = tc.rules
+			assert.Equal(t, tc.shouldExtractNode, c.extractNodeLabelsAnnotations())
+		})
+	}
+}
+
 func newTestClientWithRulesAndFilters(t *testing.T, f Filters) (*WatchClient, *observer.ObservedLogs) {
 	observedLogger, logs := observer.New(zapcore.WarnLevel)
 	logger := zap.New(observedLogger)
@@ -100,6 +100,39 @@ func namespaceInformerWatchFunc(client kubernetes.Interface) cache.WatchFunc {
 	}
 }
 
+func newNodeSharedInformer(
+	client kubernetes.Interface,
+	nodeName string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc:  nodeInformerListFunc(client, nodeName),
+			WatchFunc: nodeInformerWatchFunc(client, nodeName),
+		},
+		&api_v1.Node{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+func nodeInformerListFunc(client kubernetes.Interface, nodeName string) cache.ListFunc {
+	return func(opts metav1.ListOptions) (runtime.Object, error) {
+		if nodeName != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector(nodeNameField, nodeName).String()
+		}
+		return client.CoreV1().Nodes().List(context.Background(), opts)
+	}
+}
+
+func nodeInformerWatchFunc(client kubernetes.Interface, nodeName string) cache.WatchFunc {
+	return func(opts metav1.ListOptions) (watch.Interface, error) {
+		if nodeName != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector(nodeNameField, nodeName).String()
+		}
+		return client.CoreV1().Nodes().Watch(context.Background(), opts)
+	}
+}
+
 func newReplicaSetSharedInformer(
 	client kubernetes.Interface,
 	namespace string,
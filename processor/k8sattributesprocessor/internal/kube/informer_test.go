@@ -107,6 +107,33 @@ func Test_namespaceInformerWatchFunc(t *testing.T) {
 	assert.NotNil(t, obj)
 }
 
+func Test_newSharedNodeInformer(t *testing.T) {
+	client, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	require.NoError(t, err)
+	informer := newNodeSharedInformer(client, "")
+	assert.NotNil(t, informer)
+}
+
+func Test_nodeInformerListFunc(t *testing.T) {
+	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	assert.NoError(t, err)
+	listFunc := nodeInformerListFunc(c, "node-1")
+	opts := metav1.ListOptions{}
+	obj, err := listFunc(opts)
+	assert.NoError(t, err)
+	assert.NotNil(t, obj)
+}
+
+func Test_nodeInformerWatchFunc(t *testing.T) {
+	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	assert.NoError(t, err)
+	watchFunc := nodeInformerWatchFunc(c, "node-1")
+	opts := metav1.ListOptions{}
+	obj, err := watchFunc(opts)
+	assert.NoError(t, err)
+	assert.NotNil(t, obj)
+}
+
 func Test_fakeInformer(t *testing.T) {
 	// nothing real to test here. just to make coverage happy
 	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
@@ -18,6 +18,7 @@ import (
 
 const (
 	podNodeField            = "spec.nodeName"
+	nodeNameField           = "metadata.name"
 	ignoreAnnotation string = "opentelemetry.io/k8s-processor/ignore"
 	tagNodeName             = "k8s.node.name"
 	tagStartTime            = "k8s.pod.start_time"
@@ -25,7 +26,9 @@ const (
 	// MetadataFromPod is used to specify to extract metadata/labels/annotations from pod
 	MetadataFromPod = "pod"
 	// MetadataFromNamespace is used to specify to extract metadata/labels/annotations from namespace
-	MetadataFromNamespace  = "namespace"
+	MetadataFromNamespace = "namespace"
+	// MetadataFromNode is used to specify to extract metadata/labels/annotations from node
+	MetadataFromNode       = "node"
 	PodIdentifierMaxLength = 4
 
 	ResourceSource   = "resource_attribute"
@@ -87,6 +90,7 @@ var (
 type Client interface {
 	GetPod(PodIdentifier) (*Pod, bool)
 	GetNamespace(string) (*Namespace, bool)
+	GetNode(string) (*Node, bool)
 	Start()
 	Stop()
 }
@@ -107,6 +111,7 @@ type Pod struct {
 	StartTime   *metav1.Time
 	Ignore      bool
 	Namespace   string
+	NodeName    string
 	HostNetwork bool
 
 	// Containers specifies all containers in this pod.
@@ -147,6 +152,14 @@ type Namespace struct {
 	DeletedAt    time.Time
 }
 
+// Node represents a kubernetes node.
+type Node struct {
+	Name       string
+	NodeUID    string
+	Attributes map[string]string
+	StartTime  metav1.Time
+}
+
 type deleteRequest struct {
 	// id is identifier (IP address or Pod UID) of pod to remove from pods map
 	id PodIdentifier
@@ -231,6 +244,21 @@ func (rules *ExtractionRules) IncludesOwnerMetadata() bool {
 	return false
 }
 
+// includesNodeMetadata determines whether the ExtractionRules include labels or annotations of Nodes
+func (rules *ExtractionRules) includesNodeMetadata() bool {
+	for _, r := range rules.Labels {
+		if r.From == MetadataFromNode {
+			return true
+		}
+	}
+	for _, r := range rules.Annotations {
+		if r.From == MetadataFromNode {
+			return true
+		}
+	}
+	return false
+}
+
 // FieldExtractionRule is used to specify which fields to extract from pod fields
 // and inject into spans as attributes.
 type FieldExtractionRule struct {
@@ -245,9 +273,10 @@ type FieldExtractionRule struct {
 	// Full value is extracted when no regexp is provided.
 	Regex *regexp.Regexp
 	// From determines the kubernetes object the field should be retrieved from.
-	// Currently only two values are supported,
+	// Currently only three values are supported,
 	//  - pod
 	//  - namespace
+	//  - node
 	From string
 }
 
@@ -264,6 +293,12 @@ func (r *FieldExtractionRule) extractFromNamespaceMetadata(metadata map[string]s
 	}
 }
 
+func (r *FieldExtractionRule) extractFromNodeMetadata(metadata map[string]string, tags map[string]string, formatter string) {
+	if r.From == MetadataFromNode {
+		r.extractFromMetadata(metadata, tags, formatter)
+	}
+}
+
 func (r *FieldExtractionRule) extractFromMetadata(metadata map[string]string, tags map[string]string, formatter string) {
 	if r.KeyRegex != nil {
 		for k, v := range metadata {
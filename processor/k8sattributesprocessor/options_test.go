@@ -171,6 +171,42 @@ func TestWithExtractAnnotations(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"basic-node",
+			[]FieldExtractConfig{
+				{
+					Key:  "key1",
+					From: kube.MetadataFromNode,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name: "k8s.node.annotations.key1",
+					Key:  "key1",
+					From: kube.MetadataFromNode,
+				},
+			},
+			"",
+		},
+		{
+			"basic-node-keyregex",
+			[]FieldExtractConfig{
+				{
+					TagName:  "k8s.node.$1",
+					KeyRegex: "topology.kubernetes.io/(.*)",
+					From:     kube.MetadataFromNode,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name:                 "k8s.node.$1",
+					KeyRegex:             regexp.MustCompile("^(?:topology.kubernetes.io/(.*))$"),
+					HasKeyRegexReference: true,
+					From:                 kube.MetadataFromNode,
+				},
+			},
+			"",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -288,6 +324,42 @@ func TestWithExtractLabels(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"basic-node",
+			[]FieldExtractConfig{
+				{
+					Key:  "key1",
+					From: kube.MetadataFromNode,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name: "k8s.node.labels.key1",
+					Key:  "key1",
+					From: kube.MetadataFromNode,
+				},
+			},
+			"",
+		},
+		{
+			"basic-node-keyregex",
+			[]FieldExtractConfig{
+				{
+					TagName:  "k8s.node.$1",
+					KeyRegex: "topology.kubernetes.io/(.*)",
+					From:     kube.MetadataFromNode,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name:                 "k8s.node.$1",
+					KeyRegex:             regexp.MustCompile("^(?:topology.kubernetes.io/(.*))$"),
+					HasKeyRegexReference: true,
+					From:                 kube.MetadataFromNode,
+				},
+			},
+			"",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -119,8 +119,10 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 		return
 	}
 
+	var nodeName string
 	if podIdentifierValue.IsNotEmpty() {
 		if pod, ok := kp.kc.GetPod(podIdentifierValue); ok {
+			nodeName = pod.NodeName
 			kp.logger.Debug("getting the pod", zap.Any("pod", pod))
 
 			for key, val := range pod.Attributes {
@@ -141,6 +143,18 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 			}
 		}
 	}
+
+	if name := stringAttributeFromMap(resource.Attributes(), conventions.AttributeK8SNodeName); name != "" {
+		nodeName = name
+	}
+	if nodeName != "" {
+		attrsToAdd := kp.getAttributesForPodsNode(nodeName)
+		for key, val := range attrsToAdd {
+			if _, found := resource.Attributes().Get(key); !found {
+				resource.Attributes().PutStr(key, val)
+			}
+		}
+	}
 }
 
 // addContainerAttributes looks if pod has any container identifiers and adds additional container attributes
@@ -215,6 +229,14 @@ func (kp *kubernetesprocessor) getAttributesForPodsNamespace(namespace string) m
 	return ns.Attributes
 }
 
+func (kp *kubernetesprocessor) getAttributesForPodsNode(nodeName string) map[string]string {
+	node, ok := kp.kc.GetNode(nodeName)
+	if !ok {
+		return nil
+	}
+	return node.Attributes
+}
+
 // intFromAttribute extracts int value from an attribute stored as string or int
 func intFromAttribute(val pcommon.Value) (int, error) {
 	switch val.Type() {
@@ -738,6 +738,60 @@ func TestProcessorAddLabels(t *testing.T) {
 	}
 }
 
+func TestProcessorAddNodeAttributes(t *testing.T) {
+	m := newMultiTest(
+		t,
+		NewFactory().CreateDefaultConfig(),
+		nil,
+	)
+
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		kp.podAssociations = []kube.Association{
+			{
+				Sources: []kube.AssociationSource{
+					{
+						From: "connection",
+					},
+				},
+			},
+		}
+		pi := kube.PodIdentifier{
+			kube.PodIdentifierAttributeFromConnection("1.1.1.1"),
+		}
+		kp.kc.(*fakeClient).Pods[pi] = &kube.Pod{
+			NodeName:   "node-1",
+			Attributes: map[string]string{"k8s.pod.name": "test-2323"},
+		}
+		kp.kc.(*fakeClient).Nodes = map[string]*kube.Node{
+			"node-1": {
+				Name:       "node-1",
+				Attributes: map[string]string{"k8s.node.zone": "us-east-1a"},
+			},
+		}
+	})
+
+	ctx := client.NewContext(context.Background(), client.Info{
+		Addr: &net.IPAddr{
+			IP: net.ParseIP("1.1.1.1"),
+		},
+	})
+	m.testConsume(
+		ctx,
+		generateTraces(),
+		generateMetrics(),
+		generateLogs(),
+		func(err error) {
+			assert.NoError(t, err)
+		})
+
+	m.assertBatchesLen(1)
+	m.assertResourceObjectLen(0)
+	m.assertResource(0, func(res pcommon.Resource) {
+		assertResourceHasStringAttribute(t, res, "k8s.pod.name", "test-2323")
+		assertResourceHasStringAttribute(t, res, "k8s.node.zone", "us-east-1a")
+	})
+}
+
 func TestProcessorAddContainerAttributes(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -36,6 +36,9 @@ const (
 
 	// submatchCaseFieldName is the mapstructure field name for submatchCase field
 	submatchCaseFieldName = "submatch_case"
+
+	// valueActionsFieldName is the mapstructure field name for ValueActions field
+	valueActionsFieldName = "value_actions"
 )
 
 // Config defines configuration for Resource processor.
@@ -123,6 +126,11 @@ type Operation struct {
 	// ValueActions is a list of renaming actions for label values.
 	ValueActions []ValueAction `mapstructure:"value_actions"`
 
+	// MatchType determines how the Value of the ValueActions is matched: <strict|regexp>.
+	// With regexp, the first matching ValueAction applies and its NewValue can reference the
+	// submatches of the label value, e.g. $1 or ${name}.
+	MatchType matchType `mapstructure:"match_type"`
+
 	// Scale is a scalar to multiply the values with.
 	Scale float64 `mapstructure:"experimental_scale"`
 
@@ -132,7 +140,7 @@ type Operation struct {
 
 // ValueAction renames label values.
 type ValueAction struct {
-	// Value specifies the current label value.
+	// Value specifies the current label value, or the regexp matching it.
 	Value string `mapstructure:"value"`
 
 	// NewValue specifies the label value to rename to.
@@ -122,6 +122,24 @@ func TestLoadConfig(t *testing.T) {
 							},
 						},
 					},
+					{
+						MetricIncludeFilter: FilterConfig{
+							Include:   "^k8s_(.*)$",
+							MatchType: "regexp",
+						},
+						Action:  "update",
+						NewName: "k8s.$1",
+						Operations: []Operation{
+							{
+								Action:    "update_label",
+								Label:     "pod",
+								MatchType: "regexp",
+								ValueActions: []ValueAction{
+									{Value: "^(.+)-[a-z0-9]{5,10}-[a-z0-9]{5}$", NewValue: "$1"},
+								},
+							},
+						},
+					},
 					{
 						MetricIncludeFilter: FilterConfig{
 							Include:   "^regexp (?P<my_label>.*)$",
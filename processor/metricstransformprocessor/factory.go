@@ -117,6 +117,18 @@ func validateConfiguration(config *Config) error {
 			if op.AggregationType != "" && !op.AggregationType.isValid() {
 				return fmt.Errorf("operation %v: %q must be in %q", i+1, aggregationTypeFieldName, aggregationTypes)
 			}
+
+			if op.MatchType != "" && !op.MatchType.isValid() {
+				return fmt.Errorf("operation %v: %q must be in %q", i+1, matchTypeFieldName, matchTypes)
+			}
+
+			if op.MatchType == regexpMatchType {
+				for _, valueAction := range op.ValueActions {
+					if _, err := regexp.Compile(valueAction.Value); err != nil {
+						return fmt.Errorf("operation %v: %q, %w", i+1, valueActionsFieldName, err)
+					}
+				}
+			}
 		}
 	}
 	return nil
@@ -151,7 +163,9 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 			mtpOp := internalOperation{
 				configOperation: op,
 			}
-			if len(op.ValueActions) > 0 {
+			if len(op.ValueActions) > 0 && op.MatchType == regexpMatchType {
+				mtpOp.valueActionsRegexps = createLabelValueRegexps(op.ValueActions, version)
+			} else if len(op.ValueActions) > 0 {
 				mtpOp.valueActionsMapping = createLabelValueMapping(op.ValueActions, version)
 			}
 			if op.Action == aggregateLabels {
@@ -195,6 +209,18 @@ func createLabelValueMapping(valueActions []ValueAction, version string) map[str
 	return mapping
 }
 
+// createLabelValueRegexps creates the labelValue rename regexps based on the valueActions
+func createLabelValueRegexps(valueActions []ValueAction, version string) []valueActionRegexp {
+	regexps := make([]valueActionRegexp, len(valueActions))
+	for i, valueAction := range valueActions {
+		regexps[i] = valueActionRegexp{
+			value:    regexp.MustCompile(valueAction.Value),
+			newValue: strings.ReplaceAll(valueAction.NewValue, "{{version}}", version),
+		}
+	}
+	return regexps
+}
+
 // sliceToSet converts slice of strings to set of strings
 // Returns the set of strings
 func sliceToSet(slice []string) map[string]bool {
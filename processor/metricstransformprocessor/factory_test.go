@@ -98,6 +98,16 @@ func TestCreateProcessors(t *testing.T) {
 			succeed:      false,
 			errorMessage: fmt.Sprintf("operation %v: %q must be in %q", 1, aggregationTypeFieldName, aggregationTypes),
 		},
+		{
+			configName:   "config_invalid_operation_matchtype.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: %q must be in %q", 1, matchTypeFieldName, matchTypes),
+		},
+		{
+			configName:   "config_invalid_value_regexp.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: %q, error parsing regexp: missing closing ]: `[\\da`", 1, valueActionsFieldName),
+		},
 		{
 			configName:   "config_invalid_submatchcase.yaml",
 			succeed:      false,
@@ -31,10 +31,17 @@ type internalTransform struct {
 type internalOperation struct {
 	configOperation     Operation
 	valueActionsMapping map[string]string
+	valueActionsRegexps []valueActionRegexp
 	labelSetMap         map[string]bool
 	aggregatedValuesSet map[string]bool
 }
 
+// valueActionRegexp renames the label values matching the regexp to the expanded new value.
+type valueActionRegexp struct {
+	value    *regexp.Regexp
+	newValue string
+}
+
 type internalFilter interface {
 	getSubexpNames() []string
 	matchMetric(pmetric.Metric) bool
@@ -183,6 +183,42 @@ var (
 					addIntDatapoint(1, 2, 3, "new/label1-value1").build(),
 			},
 		},
+		{
+			name: "metric_name_and_label_value_update_with_regexp",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterRegexp{include: regexp.MustCompile("^k8s_(.*)$")},
+					Action:              Update,
+					NewName:             "k8s.$1",
+					Operations: []internalOperation{
+						{
+							configOperation: Operation{
+								Action:    updateLabel,
+								Label:     "pod",
+								NewLabel:  "deployment",
+								MatchType: regexpMatchType,
+							},
+							valueActionsRegexps: []valueActionRegexp{
+								{value: regexp.MustCompile(`^(?P<name>.+)-[a-z0-9]{5,10}-[a-z0-9]{5}$`), newValue: "${name}"},
+								{value: regexp.MustCompile(`^(.+)-[a-z0-9]{5}$`), newValue: "$1-daemon"},
+							},
+						},
+					},
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "k8s_pod_cpu", "pod").
+					addIntDatapoint(1, 2, 3, "frontend-7d4b9c8f6d-x2k9p").
+					addIntDatapoint(1, 2, 3, "agent-m4q7z").
+					addIntDatapoint(1, 2, 3, "standalone").build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "k8s.pod_cpu", "deployment").
+					addIntDatapoint(1, 2, 3, "frontend").
+					addIntDatapoint(1, 2, 3, "agent-daemon").
+					addIntDatapoint(1, 2, 3, "standalone").build(),
+			},
+		},
 		{
 			name: "metric_label_update_with_regexp_filter",
 			transforms: []internalTransform{
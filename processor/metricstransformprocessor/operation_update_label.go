@@ -30,7 +30,20 @@ func updateLabelOp(metric pmetric.Metric, mtpOp internalOperation, f internalFil
 
 		if newValue, ok := mtpOp.valueActionsMapping[attrVal.Str()]; ok {
 			attrs.PutStr(attrKey, newValue)
+		} else if newValue, ok := expandValueActionRegexps(mtpOp.valueActionsRegexps, attrVal.Str()); ok {
+			attrs.PutStr(attrKey, newValue)
 		}
 		return true
 	})
 }
+
+// expandValueActionRegexps returns the new value of the first regexp matching the label value,
+// expanded with its submatches.
+func expandValueActionRegexps(valueActions []valueActionRegexp, value string) (string, bool) {
+	for _, valueAction := range valueActions {
+		if submatches := valueAction.value.FindStringSubmatchIndex(value); submatches != nil {
+			return string(valueAction.value.ExpandString([]byte{}, valueAction.newValue, value, submatches)), true
+		}
+	}
+	return "", false
+}
@@ -23,9 +23,29 @@ var validAttributeSource = map[AttributeSource]bool{
 	recordAttributeSource:  true,
 }
 
+type SamplerMode string
+
+const (
+	hashSeedSamplerMode   = SamplerMode("hash_seed")
+	consistentSamplerMode = SamplerMode("consistent")
+
+	defaultSamplerMode = hashSeedSamplerMode
+)
+
+var validSamplerMode = map[SamplerMode]bool{
+	hashSeedSamplerMode:   true,
+	consistentSamplerMode: true,
+}
+
 // Config has the configuration guiding the sampler processor.
 type Config struct {
 
+	// SamplerMode selects the sampling algorithm, `hash_seed` hashes the trace IDs, or the log record attributes,
+	// with the hash seed while `consistent` follows the W3C trace context consistent probability sampling, using the
+	// randomness of the trace IDs and recording the sampling threshold in the tracestate of the spans and in the
+	// `sampling.threshold` attribute of the log records. Default is `hash_seed`.
+	SamplerMode SamplerMode `mapstructure:"mode"`
+
 	// SamplingPercentage is the percentage rate at which traces or logs are going to be sampled. Defaults to zero, i.e.: no sample.
 	// Values greater or equal 100 are treated as "sample all traces/logs".
 	SamplingPercentage float32 `mapstructure:"sampling_percentage"`
@@ -58,5 +78,11 @@ func (cfg *Config) Validate() error {
 	if cfg.AttributeSource != "" && !validAttributeSource[cfg.AttributeSource] {
 		return fmt.Errorf("invalid attribute source: %v. Expected: %v or %v", cfg.AttributeSource, traceIDAttributeSource, recordAttributeSource)
 	}
+	if cfg.SamplerMode != "" && !validSamplerMode[cfg.SamplerMode] {
+		return fmt.Errorf("invalid sampler mode: %v. Expected: %v or %v", cfg.SamplerMode, hashSeedSamplerMode, consistentSamplerMode)
+	}
+	if cfg.SamplerMode == consistentSamplerMode && cfg.HashSeed != 0 {
+		return fmt.Errorf("hash_seed is not used by the %v sampler mode", consistentSamplerMode)
+	}
 	return nil
 }
@@ -28,6 +28,7 @@ func TestLoadConfig(t *testing.T) {
 				SamplingPercentage: 15.3,
 				HashSeed:           22,
 				AttributeSource:    "traceID",
+				SamplerMode:        "hash_seed",
 			},
 		},
 		{
@@ -38,6 +39,15 @@ func TestLoadConfig(t *testing.T) {
 				AttributeSource:    "record",
 				FromAttribute:      "foo",
 				SamplingPriority:   "bar",
+				SamplerMode:        "hash_seed",
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "consistent"),
+			expected: &Config{
+				SamplingPercentage: 25,
+				AttributeSource:    "traceID",
+				SamplerMode:        "consistent",
 			},
 		},
 	}
@@ -72,3 +82,13 @@ func TestLoadInvalidConfig(t *testing.T) {
 	_, err = otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "invalid.yaml"), factories)
 	require.ErrorContains(t, err, "negative sampling rate: -15.30")
 }
+
+func TestValidateSamplerMode(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SamplerMode = "proportional"
+	assert.EqualError(t, cfg.Validate(), "invalid sampler mode: proportional. Expected: hash_seed or consistent")
+
+	cfg.SamplerMode = consistentSamplerMode
+	cfg.HashSeed = 22
+	assert.EqualError(t, cfg.Validate(), "hash_seed is not used by the consistent sampler mode")
+}
@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package probabilisticsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor"
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// The consistent mode implements the W3C trace context consistent probability sampling: the
+// items are sampled when their 56 bits randomness value is greater or equal to the rejection
+// threshold derived from the sampling probability, and the threshold is recorded with the
+// sampled items so the sampling decisions of the different tiers and signals agree.
+const (
+	// maxThreshold is the exclusive upper bound of the 56 bits randomness values and thresholds.
+	maxThreshold = uint64(1) << 56
+	// maxThresholdHexDigits is the number of hexadecimal digits of the encoded randomness values and thresholds.
+	maxThresholdHexDigits = 14
+
+	// otTraceStateKey is the key of the OpenTelemetry values of the W3C tracestate.
+	otTraceStateKey = "ot"
+	// thresholdKey and randomnessKey are the keys of the sampling threshold and of the
+	// explicit randomness value in the OpenTelemetry tracestate value.
+	thresholdKey  = "th"
+	randomnessKey = "rv"
+
+	// The log record attributes carrying the sampling threshold and randomness value of the
+	// log records, as log records have no tracestate.
+	thresholdAttribute  = "sampling.threshold"
+	randomnessAttribute = "sampling.randomness"
+)
+
+// probabilityToThreshold returns the rejection threshold of the sampling percentage, 0 to sample
+// everything and maxThreshold to sample nothing.
+func probabilityToThreshold(samplingPercentage float64) uint64 {
+	if samplingPercentage >= 100 {
+		return 0
+	}
+	if samplingPercentage <= 0 {
+		return maxThreshold
+	}
+	return maxThreshold - uint64(math.Round(samplingPercentage/100*float64(maxThreshold)))
+}
+
+// encodeThreshold encodes the threshold in hexadecimal without the trailing zeros, "0" being
+// the threshold sampling everything.
+func encodeThreshold(threshold uint64) string {
+	encoded := strings.TrimRight(fmt.Sprintf("%0*x", maxThresholdHexDigits, threshold), "0")
+	if encoded == "" {
+		return "0"
+	}
+	return encoded
+}
+
+// parseThreshold parses a threshold encoded with up to 14 hexadecimal digits, the omitted
+// trailing digits being zeros.
+func parseThreshold(encoded string) (uint64, error) {
+	if encoded == "" || len(encoded) > maxThresholdHexDigits {
+		return 0, fmt.Errorf("invalid sampling threshold %q", encoded)
+	}
+	threshold, err := strconv.ParseUint(encoded, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sampling threshold %q: %w", encoded, err)
+	}
+	return threshold << (4 * (maxThresholdHexDigits - len(encoded))), nil
+}
+
+// parseRandomness parses a randomness value encoded with exactly 14 hexadecimal digits.
+func parseRandomness(encoded string) (uint64, error) {
+	if len(encoded) != maxThresholdHexDigits {
+		return 0, fmt.Errorf("invalid sampling randomness %q", encoded)
+	}
+	randomness, err := strconv.ParseUint(encoded, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sampling randomness %q: %w", encoded, err)
+	}
+	return randomness, nil
+}
+
+// traceIDRandomness returns the randomness value of the trace ID, its 7 least significant bytes.
+func traceIDRandomness(traceID pcommon.TraceID) uint64 {
+	var randomness uint64
+	for _, b := range traceID[9:] {
+		randomness = randomness<<8 | uint64(b)
+	}
+	return randomness
+}
+
+// hashRandomness returns a randomness value from the hash of the bytes, for the items with no
+// trace ID nor explicit randomness value.
+func hashRandomness(b []byte, seed uint32) uint64 {
+	return uint64(computeHash(b, seed)) << 24
+}
+
+// consistentDecision returns whether the item of the randomness value is sampled, and the threshold
+// to record on it. The threshold of an item already sampled by a former tier with a lower probability
+// is kept, so the adjusted count of the item stays consistent.
+func consistentDecision(threshold, randomness uint64, previous string) (bool, uint64) {
+	if previous != "" {
+		if previousThreshold, err := parseThreshold(previous); err == nil && previousThreshold > threshold {
+			threshold = previousThreshold
+		}
+	}
+	return threshold < maxThreshold && randomness >= threshold, threshold
+}
+
+// otTraceStateValue returns the value of the key in the OpenTelemetry value of the W3C tracestate.
+func otTraceStateValue(traceState string, key string) string {
+	for _, member := range strings.Split(traceState, ",") {
+		vendor, value, ok := strings.Cut(strings.TrimSpace(member), "=")
+		if !ok || vendor != otTraceStateKey {
+			continue
+		}
+		for _, field := range strings.Split(value, ";") {
+			if k, v, ok := strings.Cut(field, ":"); ok && k == key {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+// withOTTraceStateValue returns the W3C tracestate with the key set in its OpenTelemetry value, which
+// is moved first as it is modified.
+func withOTTraceStateValue(traceState string, key string, value string) string {
+	fields := []string{key + ":" + value}
+	var members []string
+	for _, member := range strings.Split(traceState, ",") {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			continue
+		}
+		vendor, otValue, ok := strings.Cut(member, "=")
+		if !ok || vendor != otTraceStateKey {
+			members = append(members, member)
+			continue
+		}
+		for _, field := range strings.Split(otValue, ";") {
+			if k, _, _ := strings.Cut(field, ":"); field != "" && k != key {
+				fields = append(fields, field)
+			}
+		}
+	}
+	return strings.Join(append([]string{otTraceStateKey + "=" + strings.Join(fields, ";")}, members...), ",")
+}
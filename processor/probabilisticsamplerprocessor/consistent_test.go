@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package probabilisticsamplerprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+func TestThresholdEncoding(t *testing.T) {
+	tests := []struct {
+		percentage float64
+		encoded    string
+	}{
+		{percentage: 100, encoded: "0"},
+		{percentage: 50, encoded: "8"},
+		{percentage: 25, encoded: "c"},
+		{percentage: 10, encoded: "e6666666666666"},
+		{percentage: 1, encoded: "fd70a3d70a3d71"},
+	}
+	for _, tt := range tests {
+		threshold := probabilityToThreshold(tt.percentage)
+		assert.Equal(t, tt.encoded, encodeThreshold(threshold))
+		parsed, err := parseThreshold(tt.encoded)
+		require.NoError(t, err)
+		assert.Equal(t, threshold, parsed)
+	}
+	assert.Equal(t, maxThreshold, probabilityToThreshold(0))
+
+	for _, invalid := range []string{"", "g", "123456789abcdef"} {
+		_, err := parseThreshold(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestParseRandomness(t *testing.T) {
+	randomness, err := parseRandomness("ffffffffffffff")
+	require.NoError(t, err)
+	assert.Equal(t, maxThreshold-1, randomness)
+
+	for _, invalid := range []string{"", "fff", "zzzzzzzzzzzzzz"} {
+		_, err := parseRandomness(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestTraceIDRandomness(t *testing.T) {
+	traceID := pcommon.TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}
+	assert.Equal(t, uint64(0x01020304050607), traceIDRandomness(traceID))
+}
+
+func TestConsistentDecision(t *testing.T) {
+	half := probabilityToThreshold(50)
+	quarter := probabilityToThreshold(25)
+
+	sampled, threshold := consistentDecision(half, half, "")
+	assert.True(t, sampled)
+	assert.Equal(t, half, threshold)
+
+	sampled, _ = consistentDecision(half, half-1, "")
+	assert.False(t, sampled)
+
+	// the items sampled with a lower probability keep their threshold
+	sampled, threshold = consistentDecision(half, quarter, "c")
+	assert.True(t, sampled)
+	assert.Equal(t, quarter, threshold)
+
+	sampled, threshold = consistentDecision(quarter, quarter, "8")
+	assert.True(t, sampled)
+	assert.Equal(t, quarter, threshold)
+
+	sampled, _ = consistentDecision(maxThreshold, maxThreshold-1, "")
+	assert.False(t, sampled)
+}
+
+func TestOTTraceState(t *testing.T) {
+	assert.Equal(t, "", otTraceStateValue("", thresholdKey))
+	assert.Equal(t, "c", otTraceStateValue("vendor=value,ot=rv:0123456789abcd;th:c", thresholdKey))
+	assert.Equal(t, "0123456789abcd", otTraceStateValue("vendor=value,ot=rv:0123456789abcd;th:c", randomnessKey))
+
+	assert.Equal(t, "ot=th:8", withOTTraceStateValue("", thresholdKey, "8"))
+	assert.Equal(t, "ot=th:8;rv:0123456789abcd,vendor=value",
+		withOTTraceStateValue("vendor=value,ot=rv:0123456789abcd;th:c", thresholdKey, "8"))
+}
+
+// newConsistentTraceID returns a trace ID with the randomness value.
+func newConsistentTraceID(randomness uint64) pcommon.TraceID {
+	var traceID pcommon.TraceID
+	for i := 15; i >= 9; i-- {
+		traceID[i] = byte(randomness)
+		randomness >>= 8
+	}
+	return traceID
+}
+
+func TestConsistentSamplingTracesAndLogs(t *testing.T) {
+	cfg := &Config{
+		SamplerMode:        consistentSamplerMode,
+		SamplingPercentage: 25,
+		AttributeSource:    traceIDAttributeSource,
+	}
+	tracesSink := new(consumertest.TracesSink)
+	tracesProcessor, err := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, tracesSink)
+	require.NoError(t, err)
+	logsSink := new(consumertest.LogsSink)
+	logsProcessor, err := newLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), logsSink, cfg)
+	require.NoError(t, err)
+
+	traces := ptrace.NewTraces()
+	spans := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	logs := plog.NewLogs()
+	records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := uint64(0); i < 16; i++ {
+		traceID := newConsistentTraceID(i << 52)
+		span := spans.AppendEmpty()
+		span.SetTraceID(traceID)
+		span.TraceState().FromRaw("vendor=value")
+		records.AppendEmpty().SetTraceID(traceID)
+	}
+	require.NoError(t, tracesProcessor.ConsumeTraces(context.Background(), traces))
+	require.NoError(t, logsProcessor.ConsumeLogs(context.Background(), logs))
+
+	sampledSpans := tracesSink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	sampledRecords := logsSink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 4, sampledSpans.Len())
+	require.Equal(t, 4, sampledRecords.Len())
+	for i := 0; i < sampledSpans.Len(); i++ {
+		span := sampledSpans.At(i)
+		record := sampledRecords.At(i)
+		assert.Equal(t, newConsistentTraceID(uint64(12+i)<<52), span.TraceID())
+		assert.Equal(t, span.TraceID(), record.TraceID())
+		assert.Equal(t, "ot=th:c,vendor=value", span.TraceState().AsRaw())
+		assert.Equal(t, map[string]any{thresholdAttribute: "c"}, record.Attributes().AsRaw())
+	}
+}
+
+func TestConsistentSamplingLogsRandomnessAttribute(t *testing.T) {
+	cfg := &Config{
+		SamplerMode:        consistentSamplerMode,
+		SamplingPercentage: 50,
+		AttributeSource:    traceIDAttributeSource,
+	}
+	sink := new(consumertest.LogsSink)
+	processor, err := newLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), sink, cfg)
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Attributes().PutStr(randomnessAttribute, "90000000000000")
+	records.AppendEmpty().Attributes().PutStr(randomnessAttribute, "10000000000000")
+	// already sampled at 25% by a former tier
+	notSampled := records.AppendEmpty()
+	notSampled.Attributes().PutStr(randomnessAttribute, "90000000000000")
+	notSampled.Attributes().PutStr(thresholdAttribute, "c")
+	sampled := records.AppendEmpty()
+	sampled.Attributes().PutStr(randomnessAttribute, "d0000000000000")
+	sampled.Attributes().PutStr(thresholdAttribute, "c")
+	require.NoError(t, processor.ConsumeLogs(context.Background(), logs))
+
+	sampledRecords := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, sampledRecords.Len())
+	assert.Equal(t, map[string]any{randomnessAttribute: "90000000000000", thresholdAttribute: "8"}, sampledRecords.At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{randomnessAttribute: "d0000000000000", thresholdAttribute: "c"}, sampledRecords.At(1).Attributes().AsRaw())
+}
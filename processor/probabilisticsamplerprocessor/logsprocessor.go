@@ -23,6 +23,8 @@ type logSamplerProcessor struct {
 	traceIDEnabled     bool
 	samplingSource     string
 	samplingPriority   string
+	consistent         bool
+	threshold          uint64
 	logger             *zap.Logger
 }
 
@@ -36,6 +38,8 @@ func newLogsProcessor(ctx context.Context, set processor.CreateSettings, nextCon
 		traceIDEnabled:     cfg.AttributeSource == traceIDAttributeSource,
 		samplingPriority:   cfg.SamplingPriority,
 		samplingSource:     cfg.FromAttribute,
+		consistent:         cfg.SamplerMode == consistentSamplerMode,
+		threshold:          probabilityToThreshold(float64(cfg.SamplingPercentage)),
 		logger:             set.Logger,
 	}
 
@@ -68,18 +72,26 @@ func (lsp *logSamplerProcessor) processLogs(ctx context.Context, ld plog.Logs) (
 					}
 				}
 				priority := lsp.scaledSamplingRate
+				threshold := lsp.threshold
 				if lsp.samplingPriority != "" {
 					if localPriority, ok := l.Attributes().Get(lsp.samplingPriority); ok {
 						switch localPriority.Type() {
 						case pcommon.ValueTypeDouble:
 							priority = uint32(localPriority.Double() * percentageScaleFactor)
+							threshold = probabilityToThreshold(localPriority.Double())
 						case pcommon.ValueTypeInt:
 							priority = uint32(float64(localPriority.Int()) * percentageScaleFactor)
+							threshold = probabilityToThreshold(float64(localPriority.Int()))
 						}
 					}
 				}
 
-				sampled := computeHash(lidBytes, lsp.hashSeed)&bitMaskHashBuckets < priority
+				var sampled bool
+				if lsp.consistent {
+					sampled, tagPolicyValue = lsp.consistentSample(l, threshold, lidBytes, tagPolicyValue)
+				} else {
+					sampled = computeHash(lidBytes, lsp.hashSeed)&bitMaskHashBuckets < priority
+				}
 				var err error = stats.RecordWithTags(
 					ctx,
 					[]tag.Mutator{tag.Upsert(tagPolicyKey, tagPolicyValue), tag.Upsert(tagSampledKey, strconv.FormatBool(sampled))},
@@ -102,3 +114,33 @@ func (lsp *logSamplerProcessor) processLogs(ctx context.Context, ld plog.Logs) (
 	}
 	return ld, nil
 }
+
+// consistentSample returns whether the log record is sampled according to the randomness of its
+// trace ID, falling back to the explicit randomness value of its `sampling.randomness` attribute and
+// to the hash of the sampling source, and records the sampling threshold in the `sampling.threshold`
+// attribute of the sampled log records.
+func (lsp *logSamplerProcessor) consistentSample(l plog.LogRecord, threshold uint64, lidBytes []byte, tagPolicyValue string) (bool, string) {
+	randomness, err := parseRandomness(stringAttribute(l, randomnessAttribute))
+	switch {
+	case lsp.traceIDEnabled && !l.TraceID().IsEmpty():
+		randomness = traceIDRandomness(l.TraceID())
+		tagPolicyValue = "trace_id_randomness"
+	case err == nil:
+		tagPolicyValue = randomnessAttribute
+	default:
+		randomness = hashRandomness(lidBytes, 0)
+	}
+
+	sampled, threshold := consistentDecision(threshold, randomness, stringAttribute(l, thresholdAttribute))
+	if sampled {
+		l.Attributes().PutStr(thresholdAttribute, encodeThreshold(threshold))
+	}
+	return sampled, tagPolicyValue
+}
+
+func stringAttribute(l plog.LogRecord, key string) string {
+	if value, ok := l.Attributes().Get(key); ok && value.Type() == pcommon.ValueTypeStr {
+		return value.Str()
+	}
+	return ""
+}
@@ -44,6 +44,8 @@ const (
 type traceSamplerProcessor struct {
 	scaledSamplingRate uint32
 	hashSeed           uint32
+	consistent         bool
+	threshold          uint64
 	logger             *zap.Logger
 }
 
@@ -54,6 +56,8 @@ func newTracesProcessor(ctx context.Context, set processor.CreateSettings, cfg *
 		// Adjust sampling percentage on private so recalculations are avoided.
 		scaledSamplingRate: uint32(cfg.SamplingPercentage * percentageScaleFactor),
 		hashSeed:           cfg.HashSeed,
+		consistent:         cfg.SamplerMode == consistentSamplerMode,
+		threshold:          probabilityToThreshold(float64(cfg.SamplingPercentage)),
 		logger:             set.Logger,
 	}
 
@@ -89,6 +93,16 @@ func (tsp *traceSamplerProcessor) processTraces(ctx context.Context, td ptrace.T
 					statCountTracesSampled.M(int64(1)),
 				)
 
+				if tsp.consistent {
+					sampled := sp == mustSampleSpan || tsp.consistentSample(s)
+					_ = stats.RecordWithTags(
+						ctx,
+						[]tag.Mutator{tag.Upsert(tagPolicyKey, "trace_id_randomness"), tag.Upsert(tagSampledKey, strconv.FormatBool(sampled))},
+						statCountTracesSampled.M(int64(1)),
+					)
+					return !sampled
+				}
+
 				// If one assumes random trace ids hashing may seems avoidable, however, traces can be coming from sources
 				// with various different criteria to generate trace id and perhaps were already sampled without hashing.
 				// Hashing here prevents bias due to such systems.
@@ -115,6 +129,24 @@ func (tsp *traceSamplerProcessor) processTraces(ctx context.Context, td ptrace.T
 	return td, nil
 }
 
+// consistentSample returns whether the span is sampled according to the randomness of its trace
+// ID, or to the explicit randomness value of its tracestate, and records the sampling threshold
+// in the tracestate of the sampled spans.
+func (tsp *traceSamplerProcessor) consistentSample(s ptrace.Span) bool {
+	traceState := s.TraceState().AsRaw()
+	randomness := traceIDRandomness(s.TraceID())
+	if rv := otTraceStateValue(traceState, randomnessKey); rv != "" {
+		if value, err := parseRandomness(rv); err == nil {
+			randomness = value
+		}
+	}
+	sampled, threshold := consistentDecision(tsp.threshold, randomness, otTraceStateValue(traceState, thresholdKey))
+	if sampled {
+		s.TraceState().FromRaw(withOTTraceStateValue(traceState, thresholdKey, encodeThreshold(threshold)))
+	}
+	return sampled
+}
+
 // parseSpanSamplingPriority checks if the span has the "sampling.priority" tag to
 // decide if the span should be sampled or not. The usage of the tag follows the
 // OpenTracing semantic tags:
@@ -3,6 +3,9 @@
 
 package redactionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
 
+// Config is the configuration of the redaction processor. The span attributes of
+// the field comments stand for the attributes of the spans, the log records and
+// the metric data points alike.
 type Config struct {
 
 	// AllowAllKeys is a flag to allow all span attribute keys. Setting this
@@ -29,5 +32,6 @@ type Config struct {
 	// attributes. In some contexts a list of redacted attributes leaks
 	// information, while it is valuable when integrating and testing a new
 	// configuration. Possible values are `debug`, `info`, and `silent`.
+	// The summary is not added to metric data points.
 	Summary string `mapstructure:"summary"`
 }
@@ -7,6 +7,8 @@ import (
 )
 
 const (
-	Type            = "redaction"
-	TracesStability = component.StabilityLevelBeta
+	Type             = "redaction"
+	LogsStability    = component.StabilityLevelAlpha
+	MetricsStability = component.StabilityLevelAlpha
+	TracesStability  = component.StabilityLevelBeta
 )
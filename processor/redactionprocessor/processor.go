@@ -11,11 +11,17 @@ import (
 	"strings"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
 
-const attrValuesSeparator = ","
+const (
+	attrValuesSeparator = ","
+	// bodyKey is the key listing the masked log record bodies in the diagnostic attributes
+	bodyKey = "body"
+)
 
 type redaction struct {
 	// Attribute keys allowed in a span
@@ -79,13 +85,137 @@ func (s *redaction) processResourceSpan(ctx context.Context, rs ptrace.ResourceS
 	}
 }
 
-// processAttrs redacts the attributes of a resource span or a span
+// processLogs implements ProcessLogsFunc. It processes the incoming data
+// and returns the data to be sent to the next component
+func (s *redaction) processLogs(ctx context.Context, logs plog.Logs) (plog.Logs, error) {
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		rl := logs.ResourceLogs().At(i)
+		s.processResourceLog(ctx, rl)
+	}
+	return logs, nil
+}
+
+// processResourceLog processes the RL and all of its log records
+func (s *redaction) processResourceLog(ctx context.Context, rl plog.ResourceLogs) {
+	// Attributes can be part of a resource log
+	s.processAttrs(ctx, rl.Resource().Attributes())
+
+	for j := 0; j < rl.ScopeLogs().Len(); j++ {
+		sl := rl.ScopeLogs().At(j)
+		for k := 0; k < sl.LogRecords().Len(); k++ {
+			lr := sl.LogRecords().At(k)
+
+			// Attributes can also be part of log records
+			s.processAttrs(ctx, lr.Attributes())
+
+			// The blocked values are masked in the body, which has no keys to redact
+			if s.maskValue(lr.Body()) {
+				s.addMetaAttrs([]string{bodyKey}, lr.Attributes(), maskedValues, maskedValueCount)
+			}
+		}
+	}
+}
+
+// processMetrics implements ProcessMetricsFunc. It processes the incoming data
+// and returns the data to be sent to the next component
+func (s *redaction) processMetrics(ctx context.Context, metrics pmetric.Metrics) (pmetric.Metrics, error) {
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		rm := metrics.ResourceMetrics().At(i)
+		s.processResourceMetric(ctx, rm)
+	}
+	return metrics, nil
+}
+
+// processResourceMetric processes the RM and the data points of all of its metrics
+func (s *redaction) processResourceMetric(ctx context.Context, rm pmetric.ResourceMetrics) {
+	// Attributes can be part of a resource metric
+	s.processAttrs(ctx, rm.Resource().Attributes())
+
+	for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+		sm := rm.ScopeMetrics().At(j)
+		for k := 0; k < sm.Metrics().Len(); k++ {
+			// Attributes can also be part of data points
+			s.processDataPoints(ctx, sm.Metrics().At(k))
+		}
+	}
+}
+
+// processDataPoints redacts the attributes of the data points of a metric. The summary
+// attributes are not added to the data points, as they would change the time series.
+func (s *redaction) processDataPoints(_ context.Context, metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+// maskValue masks the blocked values in the string, or in the strings nested in the map or
+// slice, and returns whether any value was masked
+func (s *redaction) maskValue(value pcommon.Value) bool {
+	masked := false
+	switch value.Type() {
+	case pcommon.ValueTypeStr:
+		strVal := value.Str()
+		for _, compiledRE := range s.blockRegexList {
+			if compiledRE.MatchString(strVal) {
+				masked = true
+				strVal = compiledRE.ReplaceAllString(strVal, "****")
+			}
+		}
+		if masked {
+			value.SetStr(strVal)
+		}
+	case pcommon.ValueTypeMap:
+		value.Map().Range(func(_ string, v pcommon.Value) bool {
+			masked = s.maskValue(v) || masked
+			return true
+		})
+	case pcommon.ValueTypeSlice:
+		for i := 0; i < value.Slice().Len(); i++ {
+			masked = s.maskValue(value.Slice().At(i)) || masked
+		}
+	}
+	return masked
+}
+
+// processAttrs redacts the attributes of a resource, a span or a log record, and adds
+// the summary of the redaction to them
 func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 	// TODO: Use the context for recording metrics
-	var toDelete []string
-	var toBlock []string
-	var ignoring []string
+	toDelete, toBlock, ignoring := s.redactAttrs(attributes)
+
+	// Add diagnostic information to the span
+	s.addMetaAttrs(toDelete, attributes, redactedKeys, redactedKeyCount)
+	s.addMetaAttrs(toBlock, attributes, maskedValues, maskedValueCount)
+	s.addMetaAttrs(ignoring, attributes, "", ignoredKeyCount)
+}
 
+// redactAttrs redacts the attributes, and returns the keys of the redacted, masked and ignored ones
+func (s *redaction) redactAttrs(attributes pcommon.Map) (toDelete []string, toBlock []string, ignoring []string) {
 	// Identify attributes to redact and mask in the following sequence
 	// 1. Make a list of attribute keys to redact
 	// 2. Mask any blocked values for the other attributes
@@ -129,10 +259,7 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 	for _, k := range toDelete {
 		attributes.Remove(k)
 	}
-	// Add diagnostic information to the span
-	s.addMetaAttrs(toDelete, attributes, redactedKeys, redactedKeyCount)
-	s.addMetaAttrs(toBlock, attributes, maskedValues, maskedValueCount)
-	s.addMetaAttrs(ignoring, attributes, "", ignoredKeyCount)
+	return toDelete, toBlock, ignoring
 }
 
 // addMetaAttrs adds diagnostic information about redacted or masked attribute keys
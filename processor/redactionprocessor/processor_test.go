@@ -12,6 +12,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap/zaptest"
 )
@@ -368,6 +370,115 @@ func TestProcessAttrsAppliedTwice(t *testing.T) {
 }
 
 // runTest transforms the test input data and passes it through the processor
+// TestRedactLogs validates that the processor redacts the attributes of the log
+// records and masks the blocked values of their bodies
+func TestRedactLogs(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"id", "name"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Summary:       "debug",
+	}
+	ctx := context.Background()
+	processor, err := newRedaction(ctx, config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("credit_card", "4111111111111111")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+
+	lr := records.AppendEmpty()
+	lr.Body().SetStr("payment with card 4111111111111111 declined")
+	lr.Attributes().PutInt("id", 5)
+	lr.Attributes().PutStr("name", "placeholder 4111111111111111")
+	lr.Attributes().PutStr("credit_card", "4111111111111111")
+
+	lr = records.AppendEmpty()
+	body := lr.Body().SetEmptyMap()
+	body.PutStr("message", "card 4111111111111111")
+	body.PutEmptySlice("cards").AppendEmpty().SetStr("4111111111111111")
+	body.PutStr("status", "declined")
+
+	lr = records.AppendEmpty()
+	lr.Body().SetStr("nothing to mask")
+
+	outLogs, err := processor.processLogs(ctx, logs)
+	require.NoError(t, err)
+
+	rl = outLogs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{
+		redactedKeys:     "credit_card",
+		redactedKeyCount: int64(1),
+	}, rl.Resource().Attributes().AsRaw())
+
+	records = rl.ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, "payment with card **** declined", records.At(0).Body().Str())
+	assert.Equal(t, map[string]any{
+		"id":             int64(5),
+		"name":           "placeholder ****",
+		redactedKeys:     "credit_card",
+		redactedKeyCount: int64(1),
+		maskedValues:     "body,name",
+		maskedValueCount: int64(2),
+	}, records.At(0).Attributes().AsRaw())
+
+	assert.Equal(t, map[string]any{
+		"message": "card ****",
+		"cards":   []any{"****"},
+		"status":  "declined",
+	}, records.At(1).Body().Map().AsRaw())
+	assert.Equal(t, map[string]any{
+		maskedValues:     "body",
+		maskedValueCount: int64(1),
+	}, records.At(1).Attributes().AsRaw())
+
+	assert.Equal(t, "nothing to mask", records.At(2).Body().Str())
+	assert.Equal(t, 0, records.At(2).Attributes().Len())
+}
+
+// TestRedactMetrics validates that the processor redacts the attributes of the
+// data points of all the metric types
+func TestRedactMetrics(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"id", "name"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Summary:       "info",
+	}
+	ctx := context.Background()
+	processor, err := newRedaction(ctx, config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("credit_card", "4111111111111111")
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+	var attrs []pcommon.Map
+	attrs = append(attrs, ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().Attributes())
+	attrs = append(attrs, ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().Attributes())
+	attrs = append(attrs, ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty().Attributes())
+	attrs = append(attrs, ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty().Attributes())
+	attrs = append(attrs, ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty().Attributes())
+	for _, attr := range attrs {
+		attr.PutInt("id", 5)
+		attr.PutStr("name", "placeholder 4111111111111111")
+		attr.PutStr("credit_card", "4111111111111111")
+	}
+
+	_, err = processor.processMetrics(ctx, metrics)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		redactedKeyCount: int64(1),
+	}, rm.Resource().Attributes().AsRaw())
+	// The data points are redacted without summary, which would change their time series
+	for _, attr := range attrs {
+		assert.Equal(t, map[string]any{
+			"id":   int64(5),
+			"name": "placeholder ****",
+		}, attr.AsRaw())
+	}
+}
+
 func runTest(
 	t *testing.T,
 	allowed map[string]pcommon.Value,
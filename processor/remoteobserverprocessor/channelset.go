@@ -3,19 +3,28 @@
 
 package remoteobserverprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/remoteobserverprocessor"
 
-import "sync"
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
 
 // channelSet is a collection of byte channels where adding, removing, and writing to
-// the channels is synchronized.
+// the channels is synchronized. The bytes written to each channel are rate limited
+// independently.
 type channelSet struct {
-	i       int
-	mu      sync.RWMutex
-	chanmap map[int]chan []byte
+	i        int
+	limit    rate.Limit
+	mu       sync.RWMutex
+	chanmap  map[int]chan []byte
+	limiters map[int]*rate.Limiter
 }
 
-func newChannelSet() *channelSet {
+func newChannelSet(limit rate.Limit) *channelSet {
 	return &channelSet{
-		chanmap: map[int]chan []byte{},
+		limit:    limit,
+		chanmap:  map[int]chan []byte{},
+		limiters: map[int]*rate.Limiter{},
 	}
 }
 
@@ -25,17 +34,20 @@ func (c *channelSet) add(ch chan []byte) int {
 	c.mu.Lock()
 	idx := c.i
 	c.chanmap[idx] = ch
+	c.limiters[idx] = rate.NewLimiter(c.limit, 1)
 	c.i++
 	c.mu.Unlock()
 	return idx
 }
 
 // writeBytes writes the passed in bytes to all of the channels in the
-// channelSet.
+// channelSet, skipping the channels exceeding their rate limit.
 func (c *channelSet) writeBytes(bytes []byte) {
 	c.mu.RLock()
-	for _, ch := range c.chanmap {
-		ch <- bytes
+	for idx, ch := range c.chanmap {
+		if c.limiters[idx].Allow() {
+			ch <- bytes
+		}
 	}
 	c.mu.RUnlock()
 }
@@ -46,5 +58,6 @@ func (c *channelSet) closeAndRemove(key int) {
 	c.mu.Lock()
 	close(c.chanmap[key])
 	delete(c.chanmap, key)
+	delete(c.limiters, key)
 	c.mu.Unlock()
 }
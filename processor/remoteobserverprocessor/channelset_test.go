@@ -8,10 +8,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 )
 
 func TestChannelset(t *testing.T) {
-	cs := newChannelSet()
+	cs := newChannelSet(rate.Inf)
 	ch := make(chan []byte)
 	key := cs.add(ch)
 	go func() {
@@ -22,3 +24,29 @@ func TestChannelset(t *testing.T) {
 	}, time.Second, time.Millisecond*10)
 	cs.closeAndRemove(key)
 }
+
+func TestChannelsetRateLimit(t *testing.T) {
+	cs := newChannelSet(rate.Every(time.Hour))
+	ch1 := make(chan []byte)
+	key1 := cs.add(ch1)
+	go func() {
+		cs.writeBytes([]byte("hello"))
+	}()
+	require.Equal(t, []byte("hello"), <-ch1)
+
+	// the messages exceeding the limit of the first channel are dropped, while the
+	// second channel has its own limit
+	ch2 := make(chan []byte)
+	key2 := cs.add(ch2)
+	go func() {
+		cs.writeBytes([]byte("world"))
+	}()
+	require.Equal(t, []byte("world"), <-ch2)
+	select {
+	case b := <-ch1:
+		t.Fatalf("unexpected message %q exceeding the rate limit", b)
+	case <-time.After(50 * time.Millisecond):
+	}
+	cs.closeAndRemove(key1)
+	cs.closeAndRemove(key2)
+}
@@ -6,7 +6,12 @@ package remoteobserverprocessor // import "github.com/open-telemetry/opentelemet
 import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
 	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
 const defaultEndpoint = ":12001"
@@ -15,8 +20,48 @@ type Config struct {
 	confighttp.HTTPServerSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 
 	// Limit is a float that indicates the maximum number of messages repeated
-	// through the websocket by this processor in messages per second. Defaults to 1.
+	// through the websocket to each client by this processor in messages per second.
+	// The messages exceeding the limit of a client are dropped. A limit of zero or
+	// less disables the rate limiting. Defaults to 1.
 	Limit rate.Limit `mapstructure:"limit"`
+
+	// Traces are the OTTL conditions selecting the spans repeated through the websocket.
+	Traces TraceFilters `mapstructure:"traces"`
+
+	// Logs are the OTTL conditions selecting the log records repeated through the websocket.
+	Logs LogFilters `mapstructure:"logs"`
+}
+
+// TraceFilters selects the spans by OTTL conditions.
+type TraceFilters struct {
+	// SpanConditions is a list of OTTL conditions for an ottlspan context.
+	// Only the spans for which any condition resolves to true are repeated,
+	// all the spans being repeated when there is no condition.
+	SpanConditions []string `mapstructure:"span"`
+}
+
+// LogFilters selects the log records by OTTL conditions.
+type LogFilters struct {
+	// LogConditions is a list of OTTL conditions for an ottllog context.
+	// Only the log records for which any condition resolves to true are repeated,
+	// all the log records being repeated when there is no condition.
+	LogConditions []string `mapstructure:"log_record"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks that the OTTL conditions are valid.
+func (cfg *Config) Validate() error {
+	var errors error
+	if cfg.Traces.SpanConditions != nil {
+		_, err := filterottl.NewBoolExprForSpan(cfg.Traces.SpanConditions, filterottl.StandardSpanFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
+		errors = multierr.Append(errors, err)
+	}
+	if cfg.Logs.LogConditions != nil {
+		_, err := filterottl.NewBoolExprForLog(cfg.Logs.LogConditions, filterottl.StandardLogFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
+		errors = multierr.Append(errors, err)
+	}
+	return errors
 }
 
 func createDefaultConfig() component.Config {
@@ -13,4 +13,45 @@ func TestCreateDefaultConfig(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	assert.Equal(t, ":12001", cfg.Endpoint)
 	assert.EqualValues(t, 1, cfg.Limit)
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *Config
+		wantErr bool
+	}{
+		{
+			name: "valid conditions",
+			cfg: &Config{
+				Traces: TraceFilters{SpanConditions: []string{`attributes["http.status_code"] >= 500`}},
+				Logs:   LogFilters{LogConditions: []string{`severity_number >= SEVERITY_NUMBER_ERROR`}},
+			},
+		},
+		{
+			name: "invalid span condition",
+			cfg: &Config{
+				Traces: TraceFilters{SpanConditions: []string{`attributes["http.status_code"] >=`}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid log record condition",
+			cfg: &Config{
+				Logs: LogFilters{LogConditions: []string{`unknown == "foo"`}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
 }
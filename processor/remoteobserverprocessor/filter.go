@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remoteobserverprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/remoteobserverprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+// filterSpans returns a copy of the traces holding only the spans matching the
+// condition, with their resource and scope. The spans whose condition fails to be
+// evaluated are not matching.
+func filterSpans(ctx context.Context, td ptrace.Traces, condition expr.BoolExpr[ottlspan.TransformContext], logger *zap.Logger) ptrace.Traces {
+	filtered := ptrace.NewTraces()
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		var filteredRS ptrace.ResourceSpans
+		hasRS := false
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			var filteredSS ptrace.ScopeSpans
+			hasSS := false
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				match, err := condition.Eval(ctx, ottlspan.NewTransformContext(span, ss.Scope(), rs.Resource()))
+				if err != nil {
+					logger.Debug("Failed to evaluate the span condition", zap.Error(err))
+					continue
+				}
+				if !match {
+					continue
+				}
+				if !hasRS {
+					filteredRS = filtered.ResourceSpans().AppendEmpty()
+					rs.Resource().CopyTo(filteredRS.Resource())
+					filteredRS.SetSchemaUrl(rs.SchemaUrl())
+					hasRS = true
+				}
+				if !hasSS {
+					filteredSS = filteredRS.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(filteredSS.Scope())
+					filteredSS.SetSchemaUrl(ss.SchemaUrl())
+					hasSS = true
+				}
+				span.CopyTo(filteredSS.Spans().AppendEmpty())
+			}
+		}
+	}
+	return filtered
+}
+
+// filterLogs returns a copy of the logs holding only the log records matching the
+// condition, with their resource and scope. The log records whose condition fails to
+// be evaluated are not matching.
+func filterLogs(ctx context.Context, ld plog.Logs, condition expr.BoolExpr[ottllog.TransformContext], logger *zap.Logger) plog.Logs {
+	filtered := plog.NewLogs()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		var filteredRL plog.ResourceLogs
+		hasRL := false
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			var filteredSL plog.ScopeLogs
+			hasSL := false
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				match, err := condition.Eval(ctx, ottllog.NewTransformContext(lr, sl.Scope(), rl.Resource()))
+				if err != nil {
+					logger.Debug("Failed to evaluate the log record condition", zap.Error(err))
+					continue
+				}
+				if !match {
+					continue
+				}
+				if !hasRL {
+					filteredRL = filtered.ResourceLogs().AppendEmpty()
+					rl.Resource().CopyTo(filteredRL.Resource())
+					filteredRL.SetSchemaUrl(rl.SchemaUrl())
+					hasRL = true
+				}
+				if !hasSL {
+					filteredSL = filteredRL.ScopeLogs().AppendEmpty()
+					sl.Scope().CopyTo(filteredSL.Scope())
+					filteredSL.SetSchemaUrl(sl.SchemaUrl())
+					hasSL = true
+				}
+				lr.CopyTo(filteredSL.LogRecords().AppendEmpty())
+			}
+		}
+	}
+	return filtered
+}
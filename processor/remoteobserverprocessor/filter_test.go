@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remoteobserverprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TestFilterSpans(t *testing.T) {
+	condition, err := filterottl.NewBoolExprForSpan([]string{`name == "bar"`}, filterottl.StandardSpanFuncs(), ottl.IgnoreError, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("scope")
+	ss.Spans().AppendEmpty().SetName("foo")
+	ss.Spans().AppendEmpty().SetName("bar")
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("baz")
+
+	filtered := filterSpans(context.Background(), td, condition, zap.NewNop())
+	require.Equal(t, 1, filtered.SpanCount())
+	require.Equal(t, 1, filtered.ResourceSpans().Len())
+	frs := filtered.ResourceSpans().At(0)
+	assert.Equal(t, map[string]any{"service.name": "checkout"}, frs.Resource().Attributes().AsRaw())
+	assert.Equal(t, "scope", frs.ScopeSpans().At(0).Scope().Name())
+	assert.Equal(t, "bar", frs.ScopeSpans().At(0).Spans().At(0).Name())
+	// the traces are not modified
+	assert.Equal(t, 3, td.SpanCount())
+}
+
+func TestFilterLogs(t *testing.T) {
+	condition, err := filterottl.NewBoolExprForLog([]string{`severity_number >= SEVERITY_NUMBER_ERROR`}, filterottl.StandardLogFuncs(), ottl.IgnoreError, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	sl := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	info := sl.LogRecords().AppendEmpty()
+	info.SetSeverityNumber(plog.SeverityNumberInfo)
+	info.Body().SetStr("info")
+	errorRecord := sl.LogRecords().AppendEmpty()
+	errorRecord.SetSeverityNumber(plog.SeverityNumberError)
+	errorRecord.Body().SetStr("error")
+
+	filtered := filterLogs(context.Background(), ld, condition, zap.NewNop())
+	require.Equal(t, 1, filtered.LogRecordCount())
+	assert.Equal(t, "error", filtered.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+	assert.Equal(t, 2, ld.LogRecordCount())
+
+	assert.Equal(t, 0, filterLogs(context.Background(), plog.NewLogs(), condition, zap.NewNop()).LogRecordCount())
+}
@@ -19,6 +19,13 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
 	"golang.org/x/net/websocket"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 )
 
 type wsprocessor struct {
@@ -27,6 +34,8 @@ type wsprocessor struct {
 	server            *http.Server
 	shutdownWG        sync.WaitGroup
 	cs                *channelSet
+	spanCondition     expr.BoolExpr[ottlspan.TransformContext]
+	logCondition      expr.BoolExpr[ottllog.TransformContext]
 }
 
 var logMarshaler = &plog.JSONMarshaler{}
@@ -34,15 +43,31 @@ var metricMarshaler = &pmetric.JSONMarshaler{}
 var traceMarshaler = &ptrace.JSONMarshaler{}
 
 func newProcessor(settings processor.CreateSettings, config *Config) *wsprocessor {
+	limit := config.Limit
+	if limit <= 0 {
+		limit = rate.Inf
+	}
 	return &wsprocessor{
 		config:            config,
 		telemetrySettings: settings.TelemetrySettings,
-		cs:                newChannelSet(),
+		cs:                newChannelSet(limit),
 	}
 }
 
 func (w *wsprocessor) Start(_ context.Context, host component.Host) error {
 	var err error
+	if w.config.Traces.SpanConditions != nil {
+		w.spanCondition, err = filterottl.NewBoolExprForSpan(w.config.Traces.SpanConditions, filterottl.StandardSpanFuncs(), ottl.IgnoreError, w.telemetrySettings)
+		if err != nil {
+			return err
+		}
+	}
+	if w.config.Logs.LogConditions != nil {
+		w.logCondition, err = filterottl.NewBoolExprForLog(w.config.Logs.LogConditions, filterottl.StandardLogFuncs(), ottl.IgnoreError, w.telemetrySettings)
+		if err != nil {
+			return err
+		}
+	}
 	var ln net.Listener
 	ln, err = w.config.HTTPServerSettings.ToListener()
 	if err != nil {
@@ -98,8 +123,15 @@ func (w *wsprocessor) ConsumeMetrics(_ context.Context, md pmetric.Metrics) (pme
 	return md, nil
 }
 
-func (w *wsprocessor) ConsumeLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
-	b, err := logMarshaler.MarshalLogs(ld)
+func (w *wsprocessor) ConsumeLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	observed := ld
+	if w.logCondition != nil {
+		observed = filterLogs(ctx, ld, w.logCondition, w.telemetrySettings.Logger)
+		if observed.LogRecordCount() == 0 {
+			return ld, nil
+		}
+	}
+	b, err := logMarshaler.MarshalLogs(observed)
 	if err != nil {
 		w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
 	} else {
@@ -108,8 +140,15 @@ func (w *wsprocessor) ConsumeLogs(_ context.Context, ld plog.Logs) (plog.Logs, e
 	return ld, nil
 }
 
-func (w *wsprocessor) ConsumeTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
-	b, err := traceMarshaler.MarshalTraces(td)
+func (w *wsprocessor) ConsumeTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	observed := td
+	if w.spanCondition != nil {
+		observed = filterSpans(ctx, td, w.spanCondition, w.telemetrySettings.Logger)
+		if observed.SpanCount() == 0 {
+			return td, nil
+		}
+	}
+	b, err := traceMarshaler.MarshalTraces(observed)
 	if err != nil {
 		w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
 	} else {
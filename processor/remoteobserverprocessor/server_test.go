@@ -126,3 +126,45 @@ func TestSocketConnectionTraces(t *testing.T) {
 	err = rawConn.Close()
 	require.NoError(t, err)
 }
+
+func TestSocketConnectionTracesFiltered(t *testing.T) {
+	cfg := &Config{
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: "localhost:12004",
+		},
+		Traces: TraceFilters{
+			SpanConditions: []string{`name == "bar"`},
+		},
+	}
+	tracesSink := &consumertest.TracesSink{}
+	processor, err := NewFactory().CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg,
+		tracesSink)
+	require.NoError(t, err)
+	err = processor.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	rawConn, err := net.Dial("tcp", "localhost:12004")
+	require.NoError(t, err)
+	wsConfig, err := websocket.NewConfig("http://localhost:12004", "http://localhost:12004")
+	require.NoError(t, err)
+	wsConn, err := websocket.NewClient(wsConfig, rawConn)
+	require.NoError(t, err)
+	trace := ptrace.NewTraces()
+	spans := trace.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("foo")
+	spans.AppendEmpty().SetName("bar")
+	buf := make([]byte, 1024)
+	require.Eventuallyf(t, func() bool {
+		err = processor.ConsumeTraces(context.Background(), trace)
+		require.NoError(t, err)
+		n, _ := wsConn.Read(buf)
+		return n == 143
+	}, 1*time.Second, 100*time.Millisecond, "received message")
+	require.Equal(t, `{"resourceSpans":[{"resource":{},"scopeSpans":[{"scope":{},"spans":[{"traceId":"","spanId":"","parentSpanId":"","name":"bar","status":{}}]}]}]}`, string(buf[0:143]))
+	// the filtered spans are still passed to the next consumer
+	require.Equal(t, 2, tracesSink.AllTraces()[0].SpanCount())
+
+	err = processor.Shutdown(context.Background())
+	require.NoError(t, err)
+	err = rawConn.Close()
+	require.NoError(t, err)
+}
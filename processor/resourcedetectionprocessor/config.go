@@ -4,6 +4,9 @@
 package resourcedetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor"
 
 import (
+	"errors"
+	"time"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
@@ -16,6 +19,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/azure/aks"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/consul"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/docker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/file"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/gcp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
@@ -40,6 +44,18 @@ type Config struct {
 	// If a supplied attribute is not a valid attribute of a supplied detector it will be ignored.
 	// Deprecated: Please use detector's resource_attributes config instead
 	Attributes []string `mapstructure:"attributes"`
+	// RefreshInterval is the interval at which the resource is detected again, so that the
+	// changes, such as the ones of the file read by the file detector, are picked up.
+	// The resource is only detected at startup when not set.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.RefreshInterval < 0 {
+		return errors.New("refresh_interval must not be negative")
+	}
+	return nil
 }
 
 // DetectorConfig contains user-specified configurations unique to all individual detectors
@@ -71,6 +87,9 @@ type DetectorConfig struct {
 	// DockerConfig contains user-specified configurations for the docker detector
 	DockerConfig docker.Config `mapstructure:"docker"`
 
+	// FileConfig contains user-specified configurations for the file detector
+	FileConfig file.Config `mapstructure:"file"`
+
 	// GcpConfig contains user-specified configurations for the gcp detector
 	GcpConfig gcp.Config `mapstructure:"gcp"`
 
@@ -95,6 +114,7 @@ func detectorCreateDefaultConfig() DetectorConfig {
 		AksConfig:              aks.CreateDefaultConfig(),
 		ConsulConfig:           consul.CreateDefaultConfig(),
 		DockerConfig:           docker.CreateDefaultConfig(),
+		FileConfig:             file.CreateDefaultConfig(),
 		GcpConfig:              gcp.CreateDefaultConfig(),
 		HerokuConfig:           heroku.CreateDefaultConfig(),
 		SystemConfig:           system.CreateDefaultConfig(),
@@ -122,6 +142,8 @@ func (d *DetectorConfig) GetConfigFromType(detectorType internal.DetectorType) i
 		return d.ConsulConfig
 	case docker.TypeStr:
 		return d.DockerConfig
+	case file.TypeStr:
+		return d.FileConfig
 	case gcp.TypeStr:
 		return d.GcpConfig
 	case heroku.TypeStr:
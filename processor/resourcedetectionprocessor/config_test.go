@@ -18,6 +18,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/ec2"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/lambda"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/file"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
@@ -51,6 +52,11 @@ func TestLoadConfig(t *testing.T) {
 		ResourceAttributes: system.CreateDefaultConfig().ResourceAttributes,
 	}
 
+	fileConfig := detectorCreateDefaultConfig()
+	fileConfig.FileConfig = file.Config{
+		Path: "./internal/file/testdata/resource.yaml",
+	}
+
 	resourceAttributesConfig := detectorCreateDefaultConfig()
 	ec2ResourceAttributesConfig := ec2.CreateDefaultConfig()
 	ec2ResourceAttributesConfig.ResourceAttributes.HostName.Enabled = false
@@ -130,10 +136,24 @@ func TestLoadConfig(t *testing.T) {
 				DetectorConfig:     resourceAttributesConfig,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "file"),
+			expected: &Config{
+				Detectors:          []string{"env", "file"},
+				HTTPClientSettings: cfg,
+				Override:           false,
+				DetectorConfig:     fileConfig,
+				RefreshInterval:    5 * time.Minute,
+			},
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "invalid"),
 			errorMessage: "hostname_sources contains invalid value: \"invalid_source\"",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_refresh_interval"),
+			errorMessage: "refresh_interval must not be negative",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcedetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+// DetectorType is the type of a detector, used to enable it in the `detectors` option.
+type DetectorType = internal.DetectorType
+
+// Detector detects resource information. The resources of the detectors are merged in the
+// order of the `detectors` option, the attributes of the first ones taking precedence.
+type Detector = internal.Detector
+
+// DetectorFactory creates a Detector. Custom detectors registered with NewFactoryWithDetectors
+// are created with a nil config, as they have no configuration in the processor, so their
+// factories can be written as func(processor.CreateSettings, any) (Detector, error).
+type DetectorFactory = internal.DetectorFactory
@@ -5,6 +5,7 @@ package resourcedetectionprocessor // import "github.com/open-telemetry/opentele
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +27,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/consul"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/docker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/env"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/file"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/gcp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/metadata"
@@ -46,7 +48,16 @@ type factory struct {
 
 // NewFactory creates a new factory for ResourceDetection processor.
 func NewFactory() processor.Factory {
-	resourceProviderFactory := internal.NewProviderFactory(map[internal.DetectorType]internal.DetectorFactory{
+	return NewFactoryWithDetectors(nil)
+}
+
+// NewFactoryWithDetectors creates a new factory for ResourceDetection processor that supports the
+// given custom detectors in addition to the built-in ones. This allows distributions to detect
+// resource information from sources that none of the built-in detectors supports; the custom
+// detectors are enabled with their type in the `detectors` option, like the built-in ones.
+// It panics if the type of a custom detector is the type of a built-in detector.
+func NewFactoryWithDetectors(customDetectors map[DetectorType]DetectorFactory) processor.Factory {
+	detectors := map[internal.DetectorType]internal.DetectorFactory{
 		aks.TypeStr:              aks.NewDetector,
 		azure.TypeStr:            azure.NewDetector,
 		consul.TypeStr:           consul.NewDetector,
@@ -57,11 +68,19 @@ func NewFactory() processor.Factory {
 		elasticbeanstalk.TypeStr: elasticbeanstalk.NewDetector,
 		lambda.TypeStr:           lambda.NewDetector,
 		env.TypeStr:              env.NewDetector,
+		file.TypeStr:             file.NewDetector,
 		gcp.TypeStr:              gcp.NewDetector,
 		heroku.TypeStr:           heroku.NewDetector,
 		system.TypeStr:           system.NewDetector,
 		openshift.TypeStr:        openshift.NewDetector,
-	})
+	}
+	for detectorType, detectorFactory := range customDetectors {
+		if _, ok := detectors[detectorType]; ok {
+			panic(fmt.Sprintf("custom detector %q conflicts with a built-in detector", detectorType))
+		}
+		detectors[detectorType] = detectorFactory
+	}
+	resourceProviderFactory := internal.NewProviderFactory(detectors)
 
 	f := &factory{
 		resourceProviderFactory: resourceProviderFactory,
@@ -117,7 +136,8 @@ func (f *factory) createTracesProcessor(
 		nextConsumer,
 		rdp.processTraces,
 		processorhelper.WithCapabilities(consumerCapabilities),
-		processorhelper.WithStart(rdp.Start))
+		processorhelper.WithStart(rdp.Start),
+		processorhelper.WithShutdown(rdp.Shutdown))
 }
 
 func (f *factory) createMetricsProcessor(
@@ -138,7 +158,8 @@ func (f *factory) createMetricsProcessor(
 		nextConsumer,
 		rdp.processMetrics,
 		processorhelper.WithCapabilities(consumerCapabilities),
-		processorhelper.WithStart(rdp.Start))
+		processorhelper.WithStart(rdp.Start),
+		processorhelper.WithShutdown(rdp.Shutdown))
 }
 
 func (f *factory) createLogsProcessor(
@@ -159,7 +180,8 @@ func (f *factory) createLogsProcessor(
 		nextConsumer,
 		rdp.processLogs,
 		processorhelper.WithCapabilities(consumerCapabilities),
-		processorhelper.WithStart(rdp.Start))
+		processorhelper.WithStart(rdp.Start),
+		processorhelper.WithShutdown(rdp.Shutdown))
 }
 
 func (f *factory) getResourceDetectionProcessor(
@@ -178,6 +200,7 @@ func (f *factory) getResourceDetectionProcessor(
 	return &resourceDetectionProcessor{
 		provider:           provider,
 		override:           oCfg.Override,
+		refreshInterval:    oCfg.RefreshInterval,
 		httpClientSettings: oCfg.HTTPClientSettings,
 		telemetrySettings:  params.TelemetrySettings,
 	}, nil
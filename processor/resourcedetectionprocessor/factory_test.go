@@ -14,9 +14,26 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/env"
 )
 
+type customDetector struct{}
+
+func (customDetector) Detect(context.Context) (pcommon.Resource, string, error) {
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("custom.attribute", "value")
+	return res, "", nil
+}
+
+func newCustomDetector(processor.CreateSettings, any) (Detector, error) {
+	return customDetector{}, nil
+}
+
 func TestCreateDefaultConfig(t *testing.T) {
 	cfg := createDefaultConfig()
 	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
@@ -84,3 +101,33 @@ func TestInvalidConfig(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, lp)
 }
+
+func TestCustomDetector(t *testing.T) {
+	factory := NewFactoryWithDetectors(map[DetectorType]DetectorFactory{
+		"custom": newCustomDetector,
+	})
+	cfg := factory.CreateDefaultConfig()
+	cfg.(*Config).Detectors = []string{"custom"}
+
+	sink := new(consumertest.TracesSink)
+	tp, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, tp.Shutdown(context.Background())) }()
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty()
+	require.NoError(t, tp.ConsumeTraces(context.Background(), td))
+
+	require.Len(t, sink.AllTraces(), 1)
+	attrs := sink.AllTraces()[0].ResourceSpans().At(0).Resource().Attributes()
+	assert.Equal(t, map[string]any{"custom.attribute": "value"}, attrs.AsRaw())
+}
+
+func TestCustomDetectorConflict(t *testing.T) {
+	assert.Panics(t, func() {
+		NewFactoryWithDetectors(map[DetectorType]DetectorFactory{
+			env.TypeStr: newCustomDetector,
+		})
+	})
+}
@@ -22,6 +22,7 @@ require (
 	go.opentelemetry.io/collector/semconv v0.82.0
 	go.uber.org/multierr v1.11.0
 	go.uber.org/zap v1.25.0
+	gopkg.in/yaml.v3 v3.0.1
 	k8s.io/apimachinery v0.27.4
 	k8s.io/client-go v0.27.4
 )
@@ -109,7 +110,6 @@ require (
 	google.golang.org/protobuf v1.31.0 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 	k8s.io/api v0.27.4 // indirect
 	k8s.io/klog/v2 v2.90.1 // indirect
 	k8s.io/kube-openapi v0.0.0-20230501164219-8b0f38b5fd1f // indirect
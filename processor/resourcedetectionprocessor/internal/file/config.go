@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package file // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/file"
+
+// Config defines user-specified configurations unique to the file detector
+type Config struct {
+	// Path is the path of the file containing the resource attributes.
+	Path string `mapstructure:"path"`
+
+	// Format is the format of the file, either `yaml` or `env`.
+	// `yaml` files contain a map of the resource attributes, JSON files being valid YAML files.
+	// `env` files contain a `key=value` pair per line.
+	// The default format is `env` for files with the `.env` extension, and `yaml` otherwise.
+	Format string `mapstructure:"format"`
+}
+
+func CreateDefaultConfig() Config {
+	return Config{}
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package file provides a detector that loads resource information from a
+// local file, for hosts where none of the other detectors apply.
+package file // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/file"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+const (
+	// TypeStr is type of detector.
+	TypeStr = "file"
+
+	formatYAML = "yaml"
+	formatEnv  = "env"
+)
+
+var _ internal.Detector = (*Detector)(nil)
+
+type Detector struct {
+	path   string
+	format string
+}
+
+// NewDetector creates a detector reading the resource attributes from the configured file.
+// The file is read on each detection, so the changes are picked up when the resource is refreshed.
+func NewDetector(_ processor.CreateSettings, dcfg internal.DetectorConfig) (internal.Detector, error) {
+	cfg := dcfg.(Config)
+	if cfg.Path == "" {
+		return nil, errors.New("path must be specified")
+	}
+
+	format := cfg.Format
+	if format == "" {
+		format = formatYAML
+		if filepath.Ext(cfg.Path) == ".env" {
+			format = formatEnv
+		}
+	}
+	if format != formatYAML && format != formatEnv {
+		return nil, fmt.Errorf("invalid format %q, must be either %q or %q", cfg.Format, formatYAML, formatEnv)
+	}
+
+	return &Detector{path: cfg.Path, format: format}, nil
+}
+
+func (d *Detector) Detect(context.Context) (resource pcommon.Resource, schemaURL string, err error) {
+	res := pcommon.NewResource()
+
+	content, err := os.ReadFile(d.path)
+	if err != nil {
+		return res, "", fmt.Errorf("failed to read resource file: %w", err)
+	}
+
+	var attributes map[string]any
+	if d.format == formatEnv {
+		attributes, err = parseEnv(content)
+	} else {
+		attributes, err = parseYAML(content)
+	}
+	if err != nil {
+		return res, "", fmt.Errorf("failed to parse resource file %q: %w", d.path, err)
+	}
+
+	if err = res.Attributes().FromRaw(attributes); err != nil {
+		res.Attributes().Clear()
+		return res, "", err
+	}
+	return res, "", nil
+}
+
+func parseYAML(content []byte) (map[string]any, error) {
+	attributes := map[string]any{}
+	if err := yaml.Unmarshal(content, &attributes); err != nil {
+		return nil, err
+	}
+	return attributes, nil
+}
+
+// parseEnv parses the `key=value` lines of the content. Blank lines and lines starting with
+// `#` are ignored, and the values can be quoted.
+func parseEnv(content []byte) (map[string]any, error) {
+	attributes := map[string]any{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected key=value", lineNumber)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			if value[0] == '"' {
+				unquoted, err := strconv.Unquote(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+				}
+				value = unquoted
+			} else {
+				value = value[1 : len(value)-1]
+			}
+		}
+		attributes[key] = value
+	}
+	return attributes, scanner.Err()
+}
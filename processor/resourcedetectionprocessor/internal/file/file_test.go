@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+func TestNewDetector(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            Config
+		expectedFormat string
+		expectedErr    string
+	}{
+		{
+			name:           "yaml by default",
+			cfg:            Config{Path: "resource.json"},
+			expectedFormat: formatYAML,
+		},
+		{
+			name:           "env extension",
+			cfg:            Config{Path: "/etc/resource.env"},
+			expectedFormat: formatEnv,
+		},
+		{
+			name:           "explicit format",
+			cfg:            Config{Path: "/etc/resource", Format: "env"},
+			expectedFormat: formatEnv,
+		},
+		{
+			name:        "no path",
+			cfg:         Config{},
+			expectedErr: "path must be specified",
+		},
+		{
+			name:        "invalid format",
+			cfg:         Config{Path: "resource.toml", Format: "toml"},
+			expectedErr: `invalid format "toml", must be either "yaml" or "env"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDetector(processortest.NewNopCreateSettings(), tt.cfg)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFormat, d.(*Detector).format)
+		})
+	}
+}
+
+func TestDetectYAML(t *testing.T) {
+	detector := &Detector{path: filepath.Join("testdata", "resource.yaml"), format: formatYAML}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "", schemaURL)
+	assert.Equal(t, map[string]any{
+		"host.name":              "rack-12-node-3",
+		"deployment.environment": "production",
+		"datacenter": map[string]any{
+			"region": "eu-west",
+			"rack":   int64(12),
+		},
+		"host.cpu.count": int64(32),
+		"maintenance":    false,
+	}, res.Attributes().AsRaw())
+}
+
+func TestDetectEnv(t *testing.T) {
+	detector := &Detector{path: filepath.Join("testdata", "resource.env"), format: formatEnv}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "", schemaURL)
+	assert.Equal(t, map[string]any{
+		"host.name":              "rack-12-node-3",
+		"deployment.environment": "production",
+		"service.owner":          `team "infra"`,
+		"team.channel":           "#infra",
+	}, res.Attributes().AsRaw())
+}
+
+func TestDetectInvalidEnv(t *testing.T) {
+	detector := &Detector{path: filepath.Join("testdata", "invalid.env"), format: formatEnv}
+	res, _, err := detector.Detect(context.Background())
+	assert.ErrorContains(t, err, "line 2: expected key=value")
+	assert.True(t, internal.IsEmptyResource(res))
+}
+
+func TestDetectInvalidYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resource.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("- not\n- a map\n"), 0600))
+
+	detector := &Detector{path: path, format: formatYAML}
+	res, _, err := detector.Detect(context.Background())
+	assert.Error(t, err)
+	assert.True(t, internal.IsEmptyResource(res))
+}
+
+func TestDetectMissingFile(t *testing.T) {
+	detector := &Detector{path: filepath.Join("testdata", "missing.yaml"), format: formatYAML}
+	res, _, err := detector.Detect(context.Background())
+	assert.ErrorContains(t, err, "failed to read resource file")
+	assert.True(t, internal.IsEmptyResource(res))
+}
+
+func TestDetectReadsFileOnEachDetection(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resource.env")
+	require.NoError(t, os.WriteFile(path, []byte("host.name=first\n"), 0600))
+
+	detector := &Detector{path: path, format: formatEnv}
+	res, _, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"host.name": "first"}, res.Attributes().AsRaw())
+
+	require.NoError(t, os.WriteFile(path, []byte("host.name=second\n"), 0600))
+	res, _, err = detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"host.name": "second"}, res.Attributes().AsRaw())
+}
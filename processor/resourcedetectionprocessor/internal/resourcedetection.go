@@ -23,7 +23,7 @@ type Detector interface {
 	Detect(ctx context.Context) (resource pcommon.Resource, schemaURL string, err error)
 }
 
-type DetectorConfig interface{}
+type DetectorConfig = any
 
 type ResourceDetectorConfig interface {
 	GetConfigFromType(DetectorType) DetectorConfig
@@ -85,9 +85,16 @@ type ResourceProvider struct {
 	logger           *zap.Logger
 	timeout          time.Duration
 	detectors        []Detector
+	attributesToKeep map[string]struct{}
+
+	mu               sync.RWMutex
 	detectedResource *resourceResult
 	once             sync.Once
-	attributesToKeep map[string]struct{}
+
+	// refreshMu guards stopCh, which is only set while the resource is refreshed.
+	refreshMu sync.Mutex
+	stopCh    chan struct{}
+	refreshWG sync.WaitGroup
 }
 
 type resourceResult struct {
@@ -110,17 +117,80 @@ func (p *ResourceProvider) Get(ctx context.Context, client *http.Client) (resour
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, client.Timeout)
 		defer cancel()
-		p.detectResource(ctx)
+		result, _ := p.detectResource(ctx)
+		p.mu.Lock()
+		p.detectedResource = result
+		p.mu.Unlock()
 	})
 
+	return p.Current()
+}
+
+// Current returns the last detected resource. Get must have been called first.
+func (p *ResourceProvider) Current() (resource pcommon.Resource, schemaURL string, err error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	return p.detectedResource.resource, p.detectedResource.schemaURL, p.detectedResource.err
 }
 
-func (p *ResourceProvider) detectResource(ctx context.Context) {
-	p.detectedResource = &resourceResult{}
+// StartRefreshing detects the resource again at each interval, until StopRefreshing is called.
+// It does nothing if the resource is already refreshed, as the provider may be shared by several processors.
+func (p *ResourceProvider) StartRefreshing(interval time.Duration, client *http.Client) {
+	p.refreshMu.Lock()
+	defer p.refreshMu.Unlock()
+	if p.stopCh != nil {
+		return
+	}
+	stopCh := make(chan struct{})
+	p.stopCh = stopCh
+
+	p.refreshWG.Add(1)
+	go func() {
+		defer p.refreshWG.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.refresh(client)
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// StopRefreshing stops refreshing the resource.
+func (p *ResourceProvider) StopRefreshing() {
+	p.refreshMu.Lock()
+	if p.stopCh != nil {
+		close(p.stopCh)
+		p.stopCh = nil
+	}
+	p.refreshMu.Unlock()
+	p.refreshWG.Wait()
+}
+
+func (p *ResourceProvider) refresh(client *http.Client) {
+	ctx, cancel := context.WithTimeout(ContextWithClient(context.Background(), client), client.Timeout)
+	defer cancel()
+	result, ok := p.detectResource(ctx)
+	if !ok {
+		// keep the previous resource rather than losing the attributes of the failed detectors
+		p.logger.Warn("failed to refresh resource information, keeping the previously detected resource")
+		return
+	}
+	p.mu.Lock()
+	p.detectedResource = result
+	p.mu.Unlock()
+}
 
+// detectResource runs the detectors and merges the resources they detected. It returns false
+// if any of the detectors failed.
+func (p *ResourceProvider) detectResource(ctx context.Context) (*resourceResult, bool) {
 	res := pcommon.NewResource()
 	mergedSchemaURL := ""
+	ok := true
 
 	p.logger.Info("began detecting resource information")
 
@@ -128,6 +198,7 @@ func (p *ResourceProvider) detectResource(ctx context.Context) {
 		r, schemaURL, err := detector.Detect(ctx)
 		if err != nil {
 			p.logger.Warn("failed to detect resource", zap.Error(err))
+			ok = false
 		} else {
 			mergedSchemaURL = MergeSchemaURL(mergedSchemaURL, schemaURL)
 			MergeResource(res, r, false)
@@ -141,8 +212,7 @@ func (p *ResourceProvider) detectResource(ctx context.Context) {
 		p.logger.Info("dropped resource information", zap.Strings("resource keys", droppedAttributes))
 	}
 
-	p.detectedResource.resource = res
-	p.detectedResource.schemaURL = mergedSchemaURL
+	return &resourceResult{resource: res, schemaURL: mergedSchemaURL}, ok
 }
 
 func MergeSchemaURL(currentSchemaURL string, newSchemaURL string) string {
@@ -317,3 +317,80 @@ func TestFilterAttributes_NoAttributes(t *testing.T) {
 
 	assert.Equal(t, len(droppedAttributes), 0)
 }
+
+// sequenceDetector returns the given attributes at each detection, and then keeps returning the
+// last ones. A nil map results in an error.
+type sequenceDetector struct {
+	mu        sync.Mutex
+	sequence  []map[string]any
+	detection int
+}
+
+func (d *sequenceDetector) Detect(context.Context) (pcommon.Resource, string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	attrs := d.sequence[d.detection]
+	if d.detection < len(d.sequence)-1 {
+		d.detection++
+	}
+	res := pcommon.NewResource()
+	if attrs == nil {
+		return res, "", errors.New("detection failed")
+	}
+	return res, "", res.Attributes().FromRaw(attrs)
+}
+
+func TestDetectResource_Refresh(t *testing.T) {
+	detector := &sequenceDetector{sequence: []map[string]any{
+		{"a": "1"},
+		nil,
+		{"a": "2"},
+	}}
+	p := NewResourceProvider(zap.NewNop(), time.Second, nil, detector)
+
+	res, _, err := p.Get(context.Background(), http.DefaultClient)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "1"}, res.Attributes().AsRaw())
+
+	// the failed detection keeps the previous resource
+	p.refresh(http.DefaultClient)
+	res, _, err = p.Current()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "1"}, res.Attributes().AsRaw())
+
+	p.StartRefreshing(time.Millisecond, http.DefaultClient)
+	// starting again is a no-op
+	p.StartRefreshing(time.Millisecond, http.DefaultClient)
+	assert.Eventually(t, func() bool {
+		res, _, _ := p.Current()
+		return assert.ObjectsAreEqual(map[string]any{"a": "2"}, res.Attributes().AsRaw())
+	}, 5*time.Second, time.Millisecond)
+	p.StopRefreshing()
+	p.StopRefreshing()
+
+	// Get doesn't detect the resource again
+	res, _, err = p.Get(context.Background(), http.DefaultClient)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "2"}, res.Attributes().AsRaw())
+}
+
+func TestDetectResource_RestartRefreshing(t *testing.T) {
+	detector := &sequenceDetector{sequence: []map[string]any{{"a": "1"}}}
+	p := NewResourceProvider(zap.NewNop(), time.Second, nil, detector)
+	_, _, err := p.Get(context.Background(), http.DefaultClient)
+	require.NoError(t, err)
+
+	p.StartRefreshing(time.Millisecond, http.DefaultClient)
+	p.StopRefreshing()
+
+	detector.mu.Lock()
+	detector.sequence = append(detector.sequence, map[string]any{"a": "2"})
+	detector.mu.Unlock()
+
+	p.StartRefreshing(time.Millisecond, http.DefaultClient)
+	defer p.StopRefreshing()
+	assert.Eventually(t, func() bool {
+		res, _, _ := p.Current()
+		return assert.ObjectsAreEqual(map[string]any{"a": "2"}, res.Attributes().AsRaw())
+	}, 5*time.Second, time.Millisecond)
+}
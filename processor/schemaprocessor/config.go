@@ -15,6 +15,7 @@ import (
 var (
 	errRequiresTargets  = errors.New("requires schema targets")
 	errDuplicateTargets = errors.New("duplicate targets detected")
+	errEmptySchemaFile  = errors.New("empty schema file path")
 )
 
 // Config defines the user provided values for the Schema Processor
@@ -31,6 +32,14 @@ type Config struct {
 	// translated to, allowing older and newer formats
 	// to conform to the target schema identifier.
 	Targets []string `mapstructure:"targets"`
+
+	// SchemaFiles are paths of local schema files that are
+	// loaded as the collector starts, and used instead of
+	// fetching the schema URLs of the same schema family.
+	// This allows for custom schema families that aren't
+	// published, or for environments without network access.
+	// (Optional field)
+	SchemaFiles []string `mapstructure:"schema_files"`
 }
 
 func (c *Config) Validate() error {
@@ -47,6 +56,12 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("no schema targets defined: %w", errRequiresTargets)
 	}
 
+	for _, file := range c.SchemaFiles {
+		if file == "" {
+			return errEmptySchemaFile
+		}
+	}
+
 	families := make(map[string]struct{})
 	for _, target := range c.Targets {
 		family, _, err := translation.GetFamilyAndVersion(target)
@@ -39,6 +39,9 @@ func TestLoadConfig(t *testing.T) {
 			"https://opentelemetry.io/schemas/1.4.2",
 			"https://example.com/otel/schemas/1.2.0",
 		},
+		SchemaFiles: []string{
+			"/etc/otelcol/schemas/example-1.2.0.yaml",
+		},
 	}, cfg)
 }
 
@@ -86,3 +89,13 @@ func TestConfigurationValidation(t *testing.T) {
 		assert.ErrorIs(t, component.ValidateConfig(cfg), tc.expectError, tc.scenario)
 	}
 }
+
+func TestConfigurationValidationSchemaFiles(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{
+		Targets:     []string{"https://example.com/otel/schemas/1.2.0"},
+		SchemaFiles: []string{""},
+	}
+	assert.ErrorIs(t, component.ValidateConfig(cfg), errEmptySchemaFile)
+}
@@ -47,6 +47,11 @@ func (ca *ConditionalAttributeSet) Rollback(attrs pcommon.Map, values ...string)
 	return errs
 }
 
+// Matches returns whether the change set applies to the signals of the values.
+func (ca *ConditionalAttributeSet) Matches(values ...string) bool {
+	return ca.check(values...)
+}
+
 func (ca *ConditionalAttributeSet) check(values ...string) bool {
 	if len(*ca.on) == 0 {
 		return true
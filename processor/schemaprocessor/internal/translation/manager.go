@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// retryInterval is the minimum time between the attempts
+// to retrieve a schema file that could not be retrieved.
+const retryInterval = time.Minute
+
+var errIncompatibleSchemaFile = errors.New("schema file does not describe the schema url")
+
+type target struct {
+	schemaURL string
+	version   *Version
+}
+
+// entry is a cached schema file, ready is closed once
+// the retrieval has completed.
+type entry struct {
+	ready       chan struct{}
+	translation *Translation
+	err         error
+	retrievedAt time.Time
+}
+
+// Manager retrieves and caches the schema files needed
+// to translate signals to the target of their schema family.
+type Manager struct {
+	log      *zap.Logger
+	provider Provider
+	targets  map[string]target
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// NewManager returns a Manager translating signals to the targets,
+// retrieving the schema files not already known using the provider.
+func NewManager(targets []string, provider Provider, log *zap.Logger) (*Manager, error) {
+	m := &Manager{
+		log:      log,
+		provider: provider,
+		targets:  make(map[string]target, len(targets)),
+		now:      time.Now,
+		entries:  make(map[string]*entry),
+	}
+	for _, schemaURL := range targets {
+		family, version, err := GetFamilyAndVersion(schemaURL)
+		if err != nil {
+			return nil, err
+		}
+		m.targets[family] = target{schemaURL: schemaURL, version: version}
+	}
+	return m, nil
+}
+
+// AddTranslation caches a schema file that was loaded outside of the provider,
+// such as a local file. It is used for any signal of its schema family
+// whose version it supports.
+func (m *Manager) AddTranslation(schemaURL string, t *Translation) {
+	e := &entry{ready: make(chan struct{}), translation: t, retrievedAt: m.now()}
+	close(e.ready)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[schemaURL] = e
+}
+
+// Prefetch retrieves the schema file of the schema URL so that signals
+// using it are not blocked on the retrieval.
+func (m *Manager) Prefetch(ctx context.Context, schemaURL string) error {
+	_, err := m.retrieve(ctx, schemaURL)
+	return err
+}
+
+// RequestTranslator returns the translator of signals using the schema URL
+// to the target of their schema family, and the target schema URL.
+// A nil translator is returned when the family has no target, or the signals
+// already use the target version.
+func (m *Manager) RequestTranslator(ctx context.Context, schemaURL string) (*Translator, string, error) {
+	family, version, err := GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		return nil, "", err
+	}
+	target, ok := m.targets[family]
+	if !ok || version.Equal(target.version) {
+		return nil, "", nil
+	}
+
+	// The schema file of the newer version describes the changes
+	// of both the upgrade and the downgrade.
+	needed := target.version
+	if version.GreaterThan(needed) {
+		needed = version
+	}
+	t := m.lookup(family, needed)
+	if t == nil {
+		if t, err = m.retrieve(ctx, family+"/"+needed.String()); err != nil {
+			return nil, "", err
+		}
+		if !t.Supports(needed) {
+			return nil, "", fmt.Errorf("version %s: %w", needed, errIncompatibleSchemaFile)
+		}
+	}
+	return t.Translator(version, target.version), target.schemaURL, nil
+}
+
+// lookup returns the cached schema file of the family with the
+// oldest version that supports the version, or nil if there is none.
+func (m *Manager) lookup(family string, version *Version) *Translation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var found *Translation
+	for _, e := range m.entries {
+		select {
+		case <-e.ready:
+		default:
+			continue
+		}
+		t := e.translation
+		if t == nil || t.Family() != family || !t.Supports(version) {
+			continue
+		}
+		if found == nil || t.Version().LessThan(found.Version()) {
+			found = t
+		}
+	}
+	return found
+}
+
+// retrieve returns the cached schema file of the schema URL, retrieving it
+// when it is not cached or when the last retrieval failed long enough ago.
+// Concurrent callers wait on the same retrieval.
+func (m *Manager) retrieve(ctx context.Context, schemaURL string) (*Translation, error) {
+	m.mu.Lock()
+	e, ok := m.entries[schemaURL]
+	if ok {
+		select {
+		case <-e.ready:
+			if e.err != nil && m.now().Sub(e.retrievedAt) >= retryInterval {
+				ok = false
+			}
+		default:
+		}
+	}
+	if ok {
+		m.mu.Unlock()
+		select {
+		case <-e.ready:
+			return e.translation, e.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	e = &entry{ready: make(chan struct{})}
+	m.entries[schemaURL] = e
+	m.mu.Unlock()
+
+	e.translation, e.err = m.fetch(ctx, schemaURL)
+	e.retrievedAt = m.now()
+	close(e.ready)
+	if e.err != nil {
+		m.log.Warn("Failed to retrieve schema file", zap.String("schema-url", schemaURL), zap.Error(e.err))
+	}
+	return e.translation, e.err
+}
+
+func (m *Manager) fetch(ctx context.Context, schemaURL string) (*Translation, error) {
+	family, _, err := GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		return nil, err
+	}
+	content, err := m.provider.Retrieve(ctx, schemaURL)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	t, err := NewTranslation(content)
+	if err != nil {
+		return nil, err
+	}
+	if t.Family() != family {
+		return nil, fmt.Errorf("schema file of family %q: %w", t.Family(), errIncompatibleSchemaFile)
+	}
+	return t, nil
+}
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/fixture"
+)
+
+// newTestProvider returns a provider serving the test schema file
+// for any schema URL, and the count of the retrieved schema files.
+func newTestProvider(t *testing.T, status int) (Provider, *int32) {
+	content, err := os.ReadFile(filepath.Join("testdata", "schema.yaml"))
+	require.NoError(t, err, "Must be able to read the schema file")
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(status)
+		_, _ = w.Write(content)
+	}))
+	t.Cleanup(srv.Close)
+
+	return &rewriteProvider{target: srv.URL, provider: NewHTTPProvider(srv.Client())}, &requests
+}
+
+// rewriteProvider sends the requests of the example.com schema family to the test server.
+type rewriteProvider struct {
+	target   string
+	provider Provider
+}
+
+func (rp *rewriteProvider) Retrieve(ctx context.Context, schemaURL string) (io.ReadCloser, error) {
+	return rp.provider.Retrieve(ctx, rp.target+schemaURL[len("https://example.com"):])
+}
+
+func TestManagerRequestTranslator(t *testing.T) {
+	t.Parallel()
+
+	provider, requests := newTestProvider(t, http.StatusOK)
+	m, err := NewManager([]string{"https://example.com/otel/schemas/1.1.0"}, provider, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	tr, targetURL, err := m.RequestTranslator(context.Background(), "https://example.com/otel/schemas/1.1.0")
+	assert.NoError(t, err)
+	assert.Nil(t, tr, "Must not translate signals using the target version")
+	assert.Empty(t, targetURL)
+
+	tr, _, err = m.RequestTranslator(context.Background(), "https://opentelemetry.io/schemas/1.9.0")
+	assert.NoError(t, err)
+	assert.Nil(t, tr, "Must not translate signals of a family without target")
+	assert.EqualValues(t, 0, atomic.LoadInt32(requests))
+
+	tr, targetURL, err = m.RequestTranslator(context.Background(), "https://example.com/otel/schemas/1.2.0")
+	require.NoError(t, err)
+	require.NotNil(t, tr)
+	assert.Equal(t, "https://example.com/otel/schemas/1.1.0", targetURL)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("deployment.environment", "prod")
+	require.NoError(t, tr.ApplyResource(attrs))
+	assert.Equal(t, map[string]any{"deployment": "prod"}, attrs.AsRaw(), "Must downgrade to the target")
+
+	tr, _, err = m.RequestTranslator(context.Background(), "https://example.com/otel/schemas/1.0.0")
+	require.NoError(t, err)
+	require.NotNil(t, tr)
+
+	attrs = pcommon.NewMap()
+	attrs.PutStr("env", "prod")
+	require.NoError(t, tr.ApplyResource(attrs))
+	assert.Equal(t, map[string]any{"deployment": "prod"}, attrs.AsRaw(), "Must upgrade to the target")
+
+	assert.EqualValues(t, 1, atomic.LoadInt32(requests), "Must reuse the cached schema file")
+}
+
+func TestManagerAddTranslation(t *testing.T) {
+	t.Parallel()
+
+	provider, requests := newTestProvider(t, http.StatusOK)
+	m, err := NewManager([]string{"https://example.com/otel/schemas/1.0.0"}, provider, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	m.AddTranslation("https://example.com/otel/schemas/1.2.0", newTestTranslation(t))
+
+	tr, _, err := m.RequestTranslator(context.Background(), "https://example.com/otel/schemas/1.1.0")
+	require.NoError(t, err)
+	assert.NotNil(t, tr, "Must use the newer schema file of the family")
+	assert.EqualValues(t, 0, atomic.LoadInt32(requests), "Must not retrieve the schema file")
+
+	_, _, err = m.RequestTranslator(context.Background(), "https://example.com/otel/schemas/1.3.0")
+	assert.ErrorIs(t, err, errIncompatibleSchemaFile, "Must not support versions newer than the schema file")
+}
+
+func TestManagerRetryInterval(t *testing.T) {
+	t.Parallel()
+
+	provider, requests := newTestProvider(t, http.StatusNotFound)
+	m, err := NewManager([]string{"https://example.com/otel/schemas/1.2.0"}, provider, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		_, _, err = m.RequestTranslator(context.Background(), "https://example.com/otel/schemas/1.0.0")
+		assert.Error(t, err)
+	}
+	assert.EqualValues(t, 1, atomic.LoadInt32(requests), "Must not retry before the retry interval")
+
+	now = now.Add(retryInterval)
+	assert.Error(t, m.Prefetch(context.Background(), "https://example.com/otel/schemas/1.2.0"))
+	assert.EqualValues(t, 2, atomic.LoadInt32(requests), "Must retry after the retry interval")
+}
+
+func TestManagerConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	provider, requests := newTestProvider(t, http.StatusOK)
+	m, err := NewManager([]string{"https://example.com/otel/schemas/1.2.0"}, provider, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	fixture.ParallelRaceCompute(t, 10, func() error {
+		_, _, err := m.RequestTranslator(context.Background(), "https://example.com/otel/schemas/1.0.0")
+		return err
+	})
+	assert.EqualValues(t, 1, atomic.LoadInt32(requests), "Must retrieve the schema file once")
+}
@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxSchemaFileSize limits the size of the retrieved schema files.
+const maxSchemaFileSize = 10 << 20
+
+// Provider retrieves the content of the schema file of a schema URL.
+type Provider interface {
+	Retrieve(ctx context.Context, schemaURL string) (io.ReadCloser, error)
+}
+
+type httpProvider struct {
+	client *http.Client
+}
+
+var _ Provider = (*httpProvider)(nil)
+
+// NewHTTPProvider returns a Provider that downloads the schema files
+// from the schema URLs using the client.
+func NewHTTPProvider(client *http.Client) Provider {
+	return &httpProvider{client: client}
+}
+
+func (hp *httpProvider) Retrieve(ctx context.Context, schemaURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, schemaURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := hp.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unable to retrieve %q: %s", schemaURL, resp.Status)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(resp.Body, maxSchemaFileSize), resp.Body}, nil
+}
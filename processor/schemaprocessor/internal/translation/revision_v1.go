@@ -19,6 +19,7 @@ type RevisionV1 struct {
 	eventNames       *migrate.SignalNameChangeSlice
 	eventAttrsOnSpan *migrate.ConditionalAttributeSetSlice
 	eventAttrsOnName *migrate.ConditionalAttributeSetSlice
+	logs             *migrate.AttributeChangeSetSlice
 	metricsAttrs     *migrate.ConditionalAttributeSetSlice
 	metricNames      *migrate.SignalNameChangeSlice
 }
@@ -37,6 +38,7 @@ func NewRevision(ver *Version, def ast.VersionDef) *RevisionV1 {
 		eventNames:       newSpanEventSignalSlice(def.SpanEvents),
 		eventAttrsOnSpan: newSpanEventConditionalSpans(def.SpanEvents),
 		eventAttrsOnName: newSpanEventConditionalNames(def.SpanEvents),
+		logs:             newLogsAttributeChangeSetSlice(def.Logs),
 		metricsAttrs:     newMetricConditionalSlice(def.Metrics),
 		metricNames:      newMetricNameSignalSlice(def.Metrics),
 	}
@@ -95,6 +97,16 @@ func newSpanEventConditionalNames(events ast.SpanEvents) *migrate.ConditionalAtt
 	return migrate.NewConditionalAttributeSetSlice(values...)
 }
 
+func newLogsAttributeChangeSetSlice(logs ast.Logs) *migrate.AttributeChangeSetSlice {
+	values := make([]*migrate.AttributeChangeSet, 0, 10)
+	for _, ch := range logs.Changes {
+		if renamed := ch.RenameAttributes; renamed != nil {
+			values = append(values, migrate.NewAttributeChangeSet(renamed.AttributeMap))
+		}
+	}
+	return migrate.NewAttributeChangeSetSlice(values...)
+}
+
 func newMetricConditionalSlice(metrics ast.Metrics) *migrate.ConditionalAttributeSetSlice {
 	values := make([]*migrate.ConditionalAttributeSet, 0, 10)
 	for _, ch := range metrics.Changes {
@@ -33,6 +33,7 @@ func TestNewRevision(t *testing.T) {
 				eventNames:       migrate.NewSignalNameChangeSlice(),
 				eventAttrsOnSpan: migrate.NewConditionalAttributeSetSlice(),
 				eventAttrsOnName: migrate.NewConditionalAttributeSetSlice(),
+				logs:             migrate.NewAttributeChangeSetSlice(),
 				metricsAttrs:     migrate.NewConditionalAttributeSetSlice(),
 				metricNames:      migrate.NewSignalNameChangeSlice(),
 			},
@@ -187,6 +188,11 @@ func TestNewRevision(t *testing.T) {
 						"service errored",
 					),
 				),
+				logs: migrate.NewAttributeChangeSetSlice(
+					migrate.NewAttributeChangeSet(map[string]string{
+						"ERROR": "error",
+					}),
+				),
 				metricsAttrs: migrate.NewConditionalAttributeSetSlice(
 					migrate.NewConditionalAttributeSet(
 						map[string]string{
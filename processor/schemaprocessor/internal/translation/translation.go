@@ -0,0 +1,236 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	schema "go.opentelemetry.io/otel/schema/v1.0"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
+)
+
+// Translation holds the revisions of a schema file, and is able to
+// translate signals between any of the versions it describes.
+type Translation struct {
+	family    string
+	version   *Version
+	revisions []*RevisionV1
+}
+
+// NewTranslation parses the content of a schema file.
+func NewTranslation(content io.Reader) (*Translation, error) {
+	def, err := schema.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	family, version, err := GetFamilyAndVersion(def.SchemaURL)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Translation{
+		family:    family,
+		version:   version,
+		revisions: make([]*RevisionV1, 0, len(def.Versions)),
+	}
+	for v, changes := range def.Versions {
+		ver, err := NewVersion(string(v))
+		if err != nil {
+			return nil, err
+		}
+		if ver.GreaterThan(version) {
+			return nil, fmt.Errorf("version %s is newer than the schema url %q: %w", ver, def.SchemaURL, ErrInvalidVersion)
+		}
+		t.revisions = append(t.revisions, NewRevision(ver, changes))
+	}
+	sort.Slice(t.revisions, func(i, j int) bool {
+		return t.revisions[i].ver.LessThan(t.revisions[j].ver)
+	})
+	return t, nil
+}
+
+// Family returns the schema family of the schema file.
+func (t *Translation) Family() string {
+	return t.family
+}
+
+// Version returns the version of the schema file, which is the
+// newest version it describes.
+func (t *Translation) Version() *Version {
+	return t.version
+}
+
+// Supports reports if signals using the version can be translated
+// by the schema file.
+func (t *Translation) Supports(v *Version) bool {
+	return !v.GreaterThan(t.version)
+}
+
+// Translator returns the translator of the signals from one version to another,
+// the translation being an upgrade when `from` is older than `to` and
+// a downgrade otherwise.
+func (t *Translation) Translator(from, to *Version) *Translator {
+	tr := &Translator{selector: migrate.StateSelectorApply}
+	if from.GreaterThan(to) {
+		tr.selector = migrate.StateSelectorRollback
+		for i := len(t.revisions) - 1; i >= 0; i-- {
+			if rev := t.revisions[i]; rev.ver.GreaterThan(to) && !rev.ver.GreaterThan(from) {
+				tr.revisions = append(tr.revisions, rev)
+			}
+		}
+		return tr
+	}
+	for _, rev := range t.revisions {
+		if rev.ver.GreaterThan(from) && !rev.ver.GreaterThan(to) {
+			tr.revisions = append(tr.revisions, rev)
+		}
+	}
+	return tr
+}
+
+// Translator applies the revisions between two versions of a schema family,
+// in the order required by the direction of the translation.
+type Translator struct {
+	selector  migrate.StateSelector
+	revisions []*RevisionV1
+}
+
+// ApplyResource translates the attributes of a resource.
+func (t *Translator) ApplyResource(attrs pcommon.Map) (errs error) {
+	for _, rev := range t.revisions {
+		errs = multierr.Append(errs, rev.applyResource(t.selector, attrs))
+	}
+	return errs
+}
+
+// ApplySpan translates the attributes of the span and the names
+// and attributes of its events.
+func (t *Translator) ApplySpan(span ptrace.Span) (errs error) {
+	for _, rev := range t.revisions {
+		errs = multierr.Append(errs, rev.applySpan(t.selector, span))
+	}
+	return errs
+}
+
+// ApplyLogRecord translates the attributes of the log record.
+func (t *Translator) ApplyLogRecord(record plog.LogRecord) (errs error) {
+	for _, rev := range t.revisions {
+		errs = multierr.Append(errs, rev.applyLogRecord(t.selector, record))
+	}
+	return errs
+}
+
+// ApplyMetric translates the name of the metric and the attributes
+// of its data points.
+func (t *Translator) ApplyMetric(metric pmetric.Metric) (errs error) {
+	for _, rev := range t.revisions {
+		errs = multierr.Append(errs, rev.applyMetric(t.selector, metric))
+	}
+	return errs
+}
+
+// The changes of the `all` section are applied before the changes specific to the signal,
+// and are rolled back after them, so that a rollback undoes exactly what was applied.
+
+func (r *RevisionV1) applyResource(ss migrate.StateSelector, attrs pcommon.Map) error {
+	if ss == migrate.StateSelectorRollback {
+		return multierr.Append(r.resource.Rollback(attrs), r.all.Rollback(attrs))
+	}
+	return multierr.Append(r.all.Apply(attrs), r.resource.Apply(attrs))
+}
+
+func (r *RevisionV1) applySpan(ss migrate.StateSelector, span ptrace.Span) (errs error) {
+	if ss == migrate.StateSelectorRollback {
+		errs = multierr.Append(errs, r.spans.Rollback(span.Attributes(), span.Name()))
+		errs = multierr.Append(errs, r.all.Rollback(span.Attributes()))
+	} else {
+		errs = multierr.Append(errs, r.all.Apply(span.Attributes()))
+		errs = multierr.Append(errs, r.spans.Apply(span.Attributes(), span.Name()))
+	}
+	for i := 0; i < span.Events().Len(); i++ {
+		errs = multierr.Append(errs, r.applySpanEvent(ss, span.Name(), span.Events().At(i)))
+	}
+	return errs
+}
+
+func (r *RevisionV1) applySpanEvent(ss migrate.StateSelector, spanName string, event ptrace.SpanEvent) (errs error) {
+	if ss == migrate.StateSelectorRollback {
+		r.eventNames.Rollback(event)
+		for i := len(*r.eventAttrsOnName) - 1; i >= 0; i-- {
+			if (*r.eventAttrsOnSpan)[i].Matches(spanName) {
+				errs = multierr.Append(errs, (*r.eventAttrsOnName)[i].Rollback(event.Attributes(), event.Name()))
+			}
+		}
+		return multierr.Append(errs, r.all.Rollback(event.Attributes()))
+	}
+	errs = r.all.Apply(event.Attributes())
+	// The span and event conditions of a change are split between the two
+	// slices at the same index, and must both match for the change to apply.
+	for i := 0; i < len(*r.eventAttrsOnName); i++ {
+		if (*r.eventAttrsOnSpan)[i].Matches(spanName) {
+			errs = multierr.Append(errs, (*r.eventAttrsOnName)[i].Apply(event.Attributes(), event.Name()))
+		}
+	}
+	r.eventNames.Apply(event)
+	return errs
+}
+
+func (r *RevisionV1) applyLogRecord(ss migrate.StateSelector, record plog.LogRecord) error {
+	if ss == migrate.StateSelectorRollback {
+		return multierr.Append(r.logs.Rollback(record.Attributes()), r.all.Rollback(record.Attributes()))
+	}
+	return multierr.Append(r.all.Apply(record.Attributes()), r.logs.Apply(record.Attributes()))
+}
+
+// applyMetric changes the attributes of the data points using the metric name of
+// the older version, which is the name before it is applied and after it is rolled back.
+func (r *RevisionV1) applyMetric(ss migrate.StateSelector, metric pmetric.Metric) error {
+	if ss == migrate.StateSelectorRollback {
+		r.metricNames.Rollback(metric)
+		return rangeDataPointAttributes(metric, func(attrs pcommon.Map) error {
+			return multierr.Append(r.metricsAttrs.Rollback(attrs, metric.Name()), r.all.Rollback(attrs))
+		})
+	}
+	err := rangeDataPointAttributes(metric, func(attrs pcommon.Map) error {
+		return multierr.Append(r.all.Apply(attrs), r.metricsAttrs.Apply(attrs, metric.Name()))
+	})
+	r.metricNames.Apply(metric)
+	return err
+}
+
+func rangeDataPointAttributes(metric pmetric.Metric, fn func(attrs pcommon.Map) error) (errs error) {
+	//exhaustive:enforce
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Gauge().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Sum().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Histogram().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.ExponentialHistogram().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Summary().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+	return errs
+}
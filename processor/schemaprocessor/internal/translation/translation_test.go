@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestTranslation(t *testing.T) *Translation {
+	f, err := os.Open(filepath.Join("testdata", "schema.yaml"))
+	require.NoError(t, err, "Must be able to open the schema file")
+	t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+	tr, err := NewTranslation(f)
+	require.NoError(t, err, "Must be able to parse the schema file")
+	return tr
+}
+
+func TestNewTranslation(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslation(t)
+	assert.Equal(t, "https://example.com/otel/schemas", tr.Family())
+	assert.Equal(t, &Version{1, 2, 0}, tr.Version())
+	assert.True(t, tr.Supports(&Version{1, 0, 0}))
+	assert.True(t, tr.Supports(&Version{1, 2, 0}))
+	assert.False(t, tr.Supports(&Version{1, 3, 0}))
+
+	require.Len(t, tr.revisions, 3)
+	assert.Equal(t, &Version{1, 0, 0}, tr.revisions[0].ver, "Must sort the revisions")
+	assert.Equal(t, &Version{1, 2, 0}, tr.revisions[2].ver, "Must sort the revisions")
+}
+
+func TestNewTranslationErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "invalid file",
+			content: "versions: [",
+		},
+		{
+			name: "invalid schema url",
+			content: `file_format: 1.0.0
+schema_url: example.com/otel/schemas/1.0.0
+versions:
+  1.0.0:
+`,
+		},
+		{
+			name: "version newer than the schema url",
+			content: `file_format: 1.0.0
+schema_url: https://example.com/otel/schemas/1.0.0
+versions:
+  1.1.0:
+`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewTranslation(strings.NewReader(tc.content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTranslatorResource(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslation(t)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("service_name", "shop")
+	attrs.PutStr("env", "prod")
+
+	require.NoError(t, tr.Translator(&Version{1, 0, 0}, &Version{1, 2, 0}).ApplyResource(attrs))
+	assert.Equal(t, map[string]any{
+		"service.name":           "shop",
+		"deployment.environment": "prod",
+	}, attrs.AsRaw(), "Must upgrade across each version")
+
+	require.NoError(t, tr.Translator(&Version{1, 2, 0}, &Version{1, 1, 0}).ApplyResource(attrs))
+	assert.Equal(t, map[string]any{
+		"service_name": "shop",
+		"deployment":   "prod",
+	}, attrs.AsRaw(), "Must downgrade to the target version only")
+
+	require.NoError(t, tr.Translator(&Version{1, 1, 0}, &Version{1, 0, 0}).ApplyResource(attrs))
+	assert.Equal(t, map[string]any{
+		"service_name": "shop",
+		"env":          "prod",
+	}, attrs.AsRaw())
+}
+
+func TestTranslatorSpan(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslation(t)
+
+	spans := ptrace.NewSpanSlice()
+	checkout := spans.AppendEmpty()
+	checkout.SetName("checkout")
+	checkout.Attributes().PutStr("cart", "1234")
+	event := checkout.Events().AppendEmpty()
+	event.SetName("failure")
+	event.Attributes().PutStr("reason", "declined")
+
+	browse := spans.AppendEmpty()
+	browse.SetName("browse")
+	browse.Attributes().PutStr("cart", "1234")
+	event = browse.Events().AppendEmpty()
+	event.SetName("failure")
+	event.Attributes().PutStr("reason", "timeout")
+
+	original := ptrace.NewSpanSlice()
+	spans.CopyTo(original)
+
+	upgrade := tr.Translator(&Version{1, 1, 0}, &Version{1, 2, 0})
+	for i := 0; i < spans.Len(); i++ {
+		require.NoError(t, upgrade.ApplySpan(spans.At(i)))
+	}
+
+	assert.Equal(t, map[string]any{"cart.id": "1234"}, checkout.Attributes().AsRaw())
+	assert.Equal(t, "exception", checkout.Events().At(0).Name())
+	assert.Equal(t, map[string]any{"exception.message": "declined"}, checkout.Events().At(0).Attributes().AsRaw())
+
+	assert.Equal(t, map[string]any{"cart": "1234"}, browse.Attributes().AsRaw(), "Must only apply to the listed spans")
+	assert.Equal(t, "exception", browse.Events().At(0).Name())
+	assert.Equal(t, map[string]any{"reason": "timeout"}, browse.Events().At(0).Attributes().AsRaw(), "Must only apply to the events of the listed spans")
+
+	downgrade := tr.Translator(&Version{1, 2, 0}, &Version{1, 1, 0})
+	for i := 0; i < spans.Len(); i++ {
+		require.NoError(t, downgrade.ApplySpan(spans.At(i)))
+	}
+	assert.Equal(t, original, spans, "Must revert the upgrade")
+}
+
+func TestTranslatorLogRecord(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslation(t)
+
+	record := plog.NewLogRecord()
+	record.Attributes().PutStr("level", "info")
+	record.Attributes().PutStr("env", "dev")
+
+	require.NoError(t, tr.Translator(&Version{1, 0, 0}, &Version{1, 2, 0}).ApplyLogRecord(record))
+	assert.Equal(t, map[string]any{
+		"severity":               "info",
+		"deployment.environment": "dev",
+	}, record.Attributes().AsRaw())
+
+	require.NoError(t, tr.Translator(&Version{1, 2, 0}, &Version{1, 0, 0}).ApplyLogRecord(record))
+	assert.Equal(t, map[string]any{
+		"level": "info",
+		"env":   "dev",
+	}, record.Attributes().AsRaw())
+}
+
+func TestTranslatorMetric(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTranslation(t)
+
+	metrics := pmetric.NewMetricSlice()
+	count := metrics.AppendEmpty()
+	count.SetName("checkout.count")
+	dp := count.SetEmptySum().DataPoints().AppendEmpty()
+	dp.Attributes().PutStr("currency", "EUR")
+	dp.Attributes().PutStr("deployment", "prod")
+
+	latency := metrics.AppendEmpty()
+	latency.SetName("checkout.latency")
+	hdp := latency.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.Attributes().PutStr("currency", "EUR")
+
+	original := pmetric.NewMetricSlice()
+	metrics.CopyTo(original)
+
+	upgrade := tr.Translator(&Version{1, 1, 0}, &Version{1, 2, 0})
+	for i := 0; i < metrics.Len(); i++ {
+		require.NoError(t, upgrade.ApplyMetric(metrics.At(i)))
+	}
+	assert.Equal(t, "checkout.total", count.Name())
+	assert.Equal(t, map[string]any{
+		"checkout.currency":      "EUR",
+		"deployment.environment": "prod",
+	}, dp.Attributes().AsRaw())
+	assert.Equal(t, "checkout.latency", latency.Name())
+	assert.Equal(t, map[string]any{"currency": "EUR"}, hdp.Attributes().AsRaw(), "Must only apply to the listed metrics")
+
+	downgrade := tr.Translator(&Version{1, 2, 0}, &Version{1, 1, 0})
+	for i := 0; i < metrics.Len(); i++ {
+		require.NoError(t, downgrade.ApplyMetric(metrics.At(i)))
+	}
+	assert.Equal(t, original, metrics, "Must revert the upgrade")
+}
@@ -6,18 +6,29 @@ package schemaprocessor // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 )
 
 type transformer struct {
+	config  *Config
 	targets []string
 	log     *zap.Logger
+	set     component.TelemetrySettings
+
+	// manager is set once the processor has started,
+	// signals are passed through until then.
+	manager *translation.Manager
 }
 
 func newTransformer(
@@ -30,28 +41,173 @@ func newTransformer(
 		return nil, errors.New("invalid configuration provided")
 	}
 	return &transformer{
+		config:  cfg,
 		log:     set.Logger,
+		set:     set.TelemetrySettings,
 		targets: cfg.Targets,
 	}, nil
 }
 
-func (t transformer) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+func (t *transformer) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	if t.manager == nil {
+		return ld, nil
+	}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		resourceURL := rl.SchemaUrl()
+		t.translateResource(ctx, rl.Resource().Attributes(), rl)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			tr, targetURL := t.translator(ctx, scopeSchemaURL(sl.SchemaUrl(), resourceURL))
+			if tr == nil {
+				continue
+			}
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				t.handleError(tr.ApplyLogRecord(sl.LogRecords().At(k)))
+			}
+			if sl.SchemaUrl() != "" {
+				sl.SetSchemaUrl(targetURL)
+			}
+		}
+	}
 	return ld, nil
 }
 
-func (t transformer) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (t *transformer) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	if t.manager == nil {
+		return md, nil
+	}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resourceURL := rm.SchemaUrl()
+		t.translateResource(ctx, rm.Resource().Attributes(), rm)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			tr, targetURL := t.translator(ctx, scopeSchemaURL(sm.SchemaUrl(), resourceURL))
+			if tr == nil {
+				continue
+			}
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				t.handleError(tr.ApplyMetric(sm.Metrics().At(k)))
+			}
+			if sm.SchemaUrl() != "" {
+				sm.SetSchemaUrl(targetURL)
+			}
+		}
+	}
 	return md, nil
 }
 
-func (t transformer) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+func (t *transformer) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	if t.manager == nil {
+		return td, nil
+	}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		resourceURL := rs.SchemaUrl()
+		t.translateResource(ctx, rs.Resource().Attributes(), rs)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			tr, targetURL := t.translator(ctx, scopeSchemaURL(ss.SchemaUrl(), resourceURL))
+			if tr == nil {
+				continue
+			}
+			for k := 0; k < ss.Spans().Len(); k++ {
+				t.handleError(tr.ApplySpan(ss.Spans().At(k)))
+			}
+			if ss.SchemaUrl() != "" {
+				ss.SetSchemaUrl(targetURL)
+			}
+		}
+	}
 	return td, nil
 }
 
-// start will load the remote file definition if it isn't already cached
-// and resolve the schema translation file
-func (t *transformer) start(_ context.Context, _ component.Host) error {
-	for _, target := range t.targets {
-		t.log.Info("Fetching remote schema url", zap.String("schema-url", target))
+// schemaURLSetter is the subset of the resource signals
+// needed to update their schema URL.
+type schemaURLSetter interface {
+	SchemaUrl() string
+	SetSchemaUrl(url string)
+}
+
+// translateResource translates the attributes of the resource using
+// the schema URL of the resource signals, and updates it to the target.
+func (t *transformer) translateResource(ctx context.Context, attrs pcommon.Map, rs schemaURLSetter) {
+	tr, targetURL := t.translator(ctx, rs.SchemaUrl())
+	if tr == nil {
+		return
+	}
+	t.handleError(tr.ApplyResource(attrs))
+	rs.SetSchemaUrl(targetURL)
+}
+
+// translator returns the translator of the signals of the schema URL,
+// or nil when they are not translated.
+func (t *transformer) translator(ctx context.Context, schemaURL string) (*translation.Translator, string) {
+	if schemaURL == "" {
+		return nil, ""
+	}
+	tr, targetURL, err := t.manager.RequestTranslator(ctx, schemaURL)
+	if err != nil {
+		t.log.Debug("Unable to translate signals", zap.String("schema-url", schemaURL), zap.Error(err))
+		return nil, ""
 	}
+	return tr, targetURL
+}
+
+func (t *transformer) handleError(err error) {
+	if err != nil {
+		t.log.Debug("Conflicts while translating signals", zap.Error(err))
+	}
+}
+
+// scopeSchemaURL returns the schema URL that applies to the signals of a scope,
+// which is the one of the resource when the scope doesn't define one.
+func scopeSchemaURL(scopeURL, resourceURL string) string {
+	if scopeURL != "" {
+		return scopeURL
+	}
+	return resourceURL
+}
+
+// start loads the local schema files, and fetches the schema files
+// to prefetch unless they are already cached
+func (t *transformer) start(ctx context.Context, host component.Host) error {
+	client, err := t.config.HTTPClientSettings.ToClient(host, t.set)
+	if err != nil {
+		return err
+	}
+	manager, err := translation.NewManager(t.targets, translation.NewHTTPProvider(client), t.log)
+	if err != nil {
+		return err
+	}
+	for _, file := range t.config.SchemaFiles {
+		schemaURL, tr, err := loadSchemaFile(file)
+		if err != nil {
+			return err
+		}
+		t.log.Info("Loaded local schema file", zap.String("file", file), zap.String("schema-url", schemaURL))
+		manager.AddTranslation(schemaURL, tr)
+	}
+	for _, schemaURL := range t.config.Prefetch {
+		t.log.Info("Fetching remote schema url", zap.String("schema-url", schemaURL))
+		// A failed prefetch is retried once signals use the schema URL.
+		_ = manager.Prefetch(ctx, schemaURL)
+	}
+	t.manager = manager
 	return nil
 }
+
+func loadSchemaFile(file string) (string, *translation.Translation, error) {
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	tr, err := translation.NewTranslation(f)
+	if err != nil {
+		return "", nil, err
+	}
+	return tr.Family() + "/" + tr.Version().String(), tr, nil
+}
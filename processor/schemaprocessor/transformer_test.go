@@ -6,11 +6,13 @@ package schemaprocessor
 import (
 	"context"
 	_ "embed"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -86,3 +88,95 @@ func TestTransformerProcessing(t *testing.T) {
 		assert.Equal(t, in, out, "Must return the same data (subject to change)")
 	})
 }
+
+func TestTransformerTranslation(t *testing.T) {
+	t.Parallel()
+
+	cfg := newDefaultConfiguration().(*Config)
+	cfg.Targets = []string{"https://example.com/otel/schemas/1.0.0"}
+	cfg.SchemaFiles = []string{filepath.Join("testdata", "schema.yaml")}
+	trans, err := newTransformer(context.Background(), cfg, processor.CreateSettings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger: zaptest.NewLogger(t),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, trans.start(context.Background(), componenttest.NewNopHost()))
+
+	t.Run("traces", func(t *testing.T) {
+		in := ptrace.NewTraces()
+		rs := in.ResourceSpans().AppendEmpty()
+		rs.SetSchemaUrl("https://example.com/otel/schemas/1.2.0")
+		rs.Resource().Attributes().PutStr("service.name", "shop")
+		ss := rs.ScopeSpans().AppendEmpty()
+		s := ss.Spans().AppendEmpty()
+		s.SetName("checkout")
+		s.Attributes().PutStr("cart.id", "1234")
+		s.Attributes().PutStr("deployment.environment", "prod")
+		other := rs.ScopeSpans().AppendEmpty()
+		other.SetSchemaUrl("https://opentelemetry.io/schemas/1.9.0")
+		other.Spans().AppendEmpty().Attributes().PutStr("cart.id", "1234")
+
+		out, err := trans.processTraces(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing traces")
+
+		rs = out.ResourceSpans().At(0)
+		assert.Equal(t, "https://example.com/otel/schemas/1.0.0", rs.SchemaUrl())
+		assert.Equal(t, map[string]any{"service_name": "shop"}, rs.Resource().Attributes().AsRaw())
+		assert.Empty(t, rs.ScopeSpans().At(0).SchemaUrl(), "Must keep inheriting the resource schema url")
+		assert.Equal(t, map[string]any{
+			"cart": "1234",
+			"env":  "prod",
+		}, rs.ScopeSpans().At(0).Spans().At(0).Attributes().AsRaw())
+		assert.Equal(t, "https://opentelemetry.io/schemas/1.9.0", rs.ScopeSpans().At(1).SchemaUrl())
+		assert.Equal(t, map[string]any{"cart.id": "1234"}, rs.ScopeSpans().At(1).Spans().At(0).Attributes().AsRaw(),
+			"Must not translate the signals of other families")
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		in := pmetric.NewMetrics()
+		rm := in.ResourceMetrics().AppendEmpty()
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.SetSchemaUrl("https://example.com/otel/schemas/1.2.0")
+		m := sm.Metrics().AppendEmpty()
+		m.SetName("checkout.total")
+		m.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("checkout.currency", "EUR")
+
+		out, err := trans.processMetrics(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing metrics")
+
+		sm = out.ResourceMetrics().At(0).ScopeMetrics().At(0)
+		assert.Equal(t, "https://example.com/otel/schemas/1.0.0", sm.SchemaUrl())
+		assert.Equal(t, "checkout.count", sm.Metrics().At(0).Name())
+		assert.Equal(t, map[string]any{"currency": "EUR"}, sm.Metrics().At(0).Gauge().DataPoints().At(0).Attributes().AsRaw())
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		in := plog.NewLogs()
+		rl := in.ResourceLogs().AppendEmpty()
+		rl.SetSchemaUrl("https://example.com/otel/schemas/1.2.0")
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr("severity", "info")
+
+		out, err := trans.processLogs(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing logs")
+
+		rl = out.ResourceLogs().At(0)
+		assert.Equal(t, "https://example.com/otel/schemas/1.0.0", rl.SchemaUrl())
+		assert.Equal(t, map[string]any{"level": "info"}, rl.ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw())
+	})
+}
+
+func TestTransformerStartInvalidSchemaFile(t *testing.T) {
+	t.Parallel()
+
+	cfg := newDefaultConfiguration().(*Config)
+	cfg.Targets = []string{"https://example.com/otel/schemas/1.0.0"}
+	cfg.SchemaFiles = []string{filepath.Join("testdata", "missing.yaml")}
+	trans, err := newTransformer(context.Background(), cfg, processor.CreateSettings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger: zaptest.NewLogger(t),
+		},
+	})
+	require.NoError(t, err)
+	assert.Error(t, trans.start(context.Background(), componenttest.NewNopHost()))
+}
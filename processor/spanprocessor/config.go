@@ -25,6 +25,12 @@ type Config struct {
 
 	// SetStatus specifies status which should be set for this span.
 	SetStatus *Status `mapstructure:"status"`
+
+	// FromEvents specifies the attributes of the span events to promote to span attributes.
+	FromEvents []EventAttributes `mapstructure:"from_events"`
+
+	// FromLinks specifies the attributes of the span links to promote to span attributes.
+	FromLinks []LinkAttributes `mapstructure:"from_links"`
 }
 
 // Name specifies the attributes to use to re-name a span.
@@ -70,6 +76,34 @@ type ToAttributes struct {
 	BreakAfterMatch bool `mapstructure:"break_after_match"`
 }
 
+// EventAttributes specifies the attributes of the span events to promote to span attributes.
+// The attributes already present in the span are not overwritten, the attributes of the first
+// matching event being promoted when several events have them.
+type EventAttributes struct {
+	// EventName is a regex pattern the name of the events must match. The attributes of all
+	// the events are promoted when it is empty.
+	EventName string `mapstructure:"event_name"`
+
+	// Attributes is a list of regex patterns, the event attributes whose key matches one of
+	// them being promoted. This field is required and cannot be empty.
+	Attributes []string `mapstructure:"attributes"`
+
+	// Prefix is prepended to the keys of the promoted attributes.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// LinkAttributes specifies the attributes of the span links to promote to span attributes.
+// The attributes already present in the span are not overwritten, the attributes of the first
+// link being promoted when several links have them.
+type LinkAttributes struct {
+	// Attributes is a list of regex patterns, the link attributes whose key matches one of
+	// them being promoted. This field is required and cannot be empty.
+	Attributes []string `mapstructure:"attributes"`
+
+	// Prefix is prepended to the keys of the promoted attributes.
+	Prefix string `mapstructure:"prefix"`
+}
+
 type Status struct {
 	// Code is one of three values "Ok" or "Error" or "Unset". Please check:
 	// https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/trace/api.md#set-status
@@ -97,6 +97,23 @@ func TestLoadingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName("span", "from_events_and_links"),
+			expected: &Config{
+				FromEvents: []EventAttributes{
+					{
+						EventName:  "^exception$",
+						Attributes: []string{`^exception\.(type|message)$`},
+					},
+				},
+				FromLinks: []LinkAttributes{
+					{
+						Attributes: []string{`^messaging\.`},
+						Prefix:     "link.",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -30,7 +30,8 @@ var processorCapabilities = consumer.Capabilities{MutatesData: true}
 //
 //	Move this to the error package that allows for span name and field to be specified.
 var (
-	errMissingRequiredField       = errors.New("error creating \"span\" processor: either \"from_attributes\" or \"to_attributes\" must be specified in \"name:\" or \"setStatus\" must be specified")
+	errMissingRequiredField       = errors.New("error creating \"span\" processor: either \"from_attributes\" or \"to_attributes\" must be specified in \"name:\" or \"setStatus\", \"from_events\" or \"from_links\" must be specified")
+	errMissingPromotedAttributes  = errors.New("error creating \"span\" processor: \"attributes\" must be specified in \"from_events\" and \"from_links\"")
 	errIncorrectStatusCode        = errors.New("error creating \"span\" processor: \"status\" must have specified \"code\" as \"Ok\" or \"Error\" or \"Unset\"")
 	errIncorrectStatusDescription = errors.New("error creating \"span\" processor: \"description\" can be specified only for \"code\" \"Error\"")
 )
@@ -59,9 +60,19 @@ func createTracesProcessor(
 	oCfg := cfg.(*Config)
 	if len(oCfg.Rename.FromAttributes) == 0 &&
 		(oCfg.Rename.ToAttributes == nil || len(oCfg.Rename.ToAttributes.Rules) == 0) &&
-		oCfg.SetStatus == nil {
+		oCfg.SetStatus == nil && len(oCfg.FromEvents) == 0 && len(oCfg.FromLinks) == 0 {
 		return nil, errMissingRequiredField
 	}
+	for _, events := range oCfg.FromEvents {
+		if len(events.Attributes) == 0 {
+			return nil, errMissingPromotedAttributes
+		}
+	}
+	for _, links := range oCfg.FromLinks {
+		if len(links.Attributes) == 0 {
+			return nil, errMissingPromotedAttributes
+		}
+	}
 
 	if oCfg.SetStatus != nil {
 		if oCfg.SetStatus.Code != statusCodeUnset && oCfg.SetStatus.Code != statusCodeError && oCfg.SetStatus.Code != statusCodeOk {
@@ -51,9 +51,11 @@ func TestFactory_CreateTracesProcessor_InvalidConfig(t *testing.T) {
 	factory := NewFactory()
 
 	testcases := []struct {
-		name string
-		cfg  Name
-		err  error
+		name       string
+		cfg        Name
+		fromEvents []EventAttributes
+		fromLinks  []LinkAttributes
+		err        error
 	}{
 		{
 			name: "missing_config",
@@ -69,12 +71,34 @@ func TestFactory_CreateTracesProcessor_InvalidConfig(t *testing.T) {
 			},
 			err: fmt.Errorf("invalid regexp pattern \\"),
 		},
+		{
+			name:       "missing_event_attributes",
+			fromEvents: []EventAttributes{{EventName: "exception"}},
+			err:        errMissingPromotedAttributes,
+		},
+		{
+			name:      "missing_link_attributes",
+			fromLinks: []LinkAttributes{{Prefix: "link."}},
+			err:       errMissingPromotedAttributes,
+		},
+		{
+			name:       "invalid_event_name_regexp",
+			fromEvents: []EventAttributes{{EventName: "(", Attributes: []string{"exception.type"}}},
+			err:        fmt.Errorf("invalid regexp pattern ("),
+		},
+		{
+			name:      "invalid_link_attributes_regexp",
+			fromLinks: []LinkAttributes{{Attributes: []string{"["}}},
+			err:       fmt.Errorf("invalid regexp pattern ["),
+		},
 	}
 
 	for _, test := range testcases {
 		t.Run(test.name, func(t *testing.T) {
 			cfg := factory.CreateDefaultConfig().(*Config)
 			cfg.Rename = test.cfg
+			cfg.FromEvents = test.fromEvents
+			cfg.FromLinks = test.fromLinks
 
 			tp, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
 			require.Nil(t, tp)
@@ -21,9 +21,22 @@ import (
 type spanProcessor struct {
 	config           Config
 	toAttributeRules []toAttributeRule
+	fromEventRules   []promoteAttributesRule
+	fromLinkRules    []promoteAttributesRule
 	skipExpr         expr.BoolExpr[ottlspan.TransformContext]
 }
 
+// promoteAttributesRule is the compiled equivalent of config.FromEvents and config.FromLinks fields.
+type promoteAttributesRule struct {
+	// Compiled regexp of the event names, nil matching all the events and links.
+	name *regexp.Regexp
+
+	// Compiled regexps of the attribute keys.
+	keys []*regexp.Regexp
+
+	prefix string
+}
+
 // toAttributeRule is the compiled equivalent of config.ToAttributes field.
 type toAttributeRule struct {
 	// Compiled regexp.
@@ -63,9 +76,43 @@ func newSpanProcessor(config Config) (*spanProcessor, error) {
 		}
 	}
 
+	for _, events := range config.FromEvents {
+		rule, err := newPromoteAttributesRule(events.EventName, events.Attributes, events.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		sp.fromEventRules = append(sp.fromEventRules, rule)
+	}
+	for _, links := range config.FromLinks {
+		rule, err := newPromoteAttributesRule("", links.Attributes, links.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		sp.fromLinkRules = append(sp.fromLinkRules, rule)
+	}
+
 	return sp, nil
 }
 
+func newPromoteAttributesRule(name string, keys []string, prefix string) (promoteAttributesRule, error) {
+	rule := promoteAttributesRule{prefix: prefix}
+	if name != "" {
+		re, err := regexp.Compile(name)
+		if err != nil {
+			return rule, fmt.Errorf("invalid regexp pattern %s", name)
+		}
+		rule.name = re
+	}
+	for _, pattern := range keys {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return rule, fmt.Errorf("invalid regexp pattern %s", pattern)
+		}
+		rule.keys = append(rule.keys, re)
+	}
+	return rule, nil
+}
+
 func (sp *spanProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	rss := td.ResourceSpans()
 	for i := 0; i < rss.Len(); i++ {
@@ -87,6 +134,8 @@ func (sp *spanProcessor) processTraces(ctx context.Context, td ptrace.Traces) (p
 						continue
 					}
 				}
+				sp.processFromEvents(span)
+				sp.processFromLinks(span)
 				sp.processFromAttributes(span)
 				sp.processToAttributes(span)
 				sp.processUpdateStatus(span)
@@ -96,6 +145,46 @@ func (sp *spanProcessor) processTraces(ctx context.Context, td ptrace.Traces) (p
 	return td, nil
 }
 
+func (sp *spanProcessor) processFromEvents(span ptrace.Span) {
+	events := span.Events()
+	for _, rule := range sp.fromEventRules {
+		for i := 0; i < events.Len(); i++ {
+			event := events.At(i)
+			if rule.name != nil && !rule.name.MatchString(event.Name()) {
+				continue
+			}
+			rule.promote(event.Attributes(), span.Attributes())
+		}
+	}
+}
+
+func (sp *spanProcessor) processFromLinks(span ptrace.Span) {
+	links := span.Links()
+	for _, rule := range sp.fromLinkRules {
+		for i := 0; i < links.Len(); i++ {
+			rule.promote(links.At(i).Attributes(), span.Attributes())
+		}
+	}
+}
+
+// promote copies the attributes matching the rule to the span attributes, unless the span
+// already has them.
+func (rule promoteAttributesRule) promote(from pcommon.Map, to pcommon.Map) {
+	from.Range(func(k string, v pcommon.Value) bool {
+		for _, re := range rule.keys {
+			if !re.MatchString(k) {
+				continue
+			}
+			key := rule.prefix + k
+			if _, found := to.Get(key); !found {
+				v.CopyTo(to.PutEmpty(key))
+			}
+			break
+		}
+		return true
+	})
+}
+
 func (sp *spanProcessor) processFromAttributes(span ptrace.Span) {
 	if len(sp.config.Rename.FromAttributes) == 0 {
 		// There is FromAttributes rule.
@@ -655,3 +655,57 @@ func TestSpanProcessor_setStatusCodeConditionally(t *testing.T) {
 		})
 	}
 }
+
+func TestSpanProcessor_FromEventsAndLinks(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.FromEvents = []EventAttributes{
+		{
+			EventName:  "^exception$",
+			Attributes: []string{`^exception\.(type|message)$`},
+		},
+	}
+	oCfg.FromLinks = []LinkAttributes{
+		{
+			Attributes: []string{"^messaging\\."},
+			Prefix:     "link.",
+		},
+	}
+	tp, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), oCfg, consumertest.NewNop())
+	require.Nil(t, err)
+	require.NotNil(t, tp)
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("GET /orders")
+	span.Attributes().PutStr("exception.message", "already set")
+
+	event := span.Events().AppendEmpty()
+	event.SetName("log")
+	event.Attributes().PutStr("exception.type", "ignored")
+	event = span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.Attributes().PutStr("exception.type", "java.io.IOException")
+	event.Attributes().PutStr("exception.message", "connection reset")
+	event.Attributes().PutStr("exception.stacktrace", "...")
+	event = span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.Attributes().PutStr("exception.type", "java.lang.IllegalStateException")
+
+	link := span.Links().AppendEmpty()
+	link.Attributes().PutStr("messaging.system", "kafka")
+	link.Attributes().PutInt("messaging.batch.message_count", 3)
+	link.Attributes().PutStr("other", "ignored")
+
+	assert.NoError(t, tp.ConsumeTraces(context.Background(), td))
+
+	assert.Equal(t, map[string]interface{}{
+		"exception.message":                  "already set",
+		"exception.type":                     "java.io.IOException",
+		"link.messaging.system":              "kafka",
+		"link.messaging.batch.message_count": int64(3),
+	}, span.Attributes().AsRaw())
+	assert.Equal(t, 3, span.Events().Len())
+	assert.Equal(t, 1, span.Links().Len())
+}
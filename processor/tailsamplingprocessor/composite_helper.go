@@ -49,6 +49,8 @@ func getCompositeSubPolicyEvaluator(settings component.TelemetrySettings, cfg *C
 	switch cfg.Type {
 	case And:
 		return getNewAndPolicy(settings, &cfg.AndCfg)
+	case PerKeyRateLimiting:
+		return getNewPerKeyRateLimitingPolicy(settings, &cfg.PerKeyRateLimitingCfg)
 	default:
 		return getSharedPolicyEvaluator(settings, &cfg.sharedPolicyCfg)
 	}
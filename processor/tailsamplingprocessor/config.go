@@ -4,6 +4,8 @@
 package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
@@ -43,6 +45,9 @@ const (
 	// OTTLCondition sample traces which match user provided OpenTelemetry Transformation Language
 	// conditions.
 	OTTLCondition PolicyType = "ottl_condition"
+	// PerKeyRateLimiting allows traces until the limits of the value of a given attribute,
+	// e.g. a tenant, are satisfied.
+	PerKeyRateLimiting PolicyType = "per_key_rate_limiting"
 )
 
 // sharedPolicyCfg holds the common configuration to all policies that are used in derivative policy configurations
@@ -80,6 +85,8 @@ type CompositeSubPolicyCfg struct {
 
 	// Configs for and policy evaluator.
 	AndCfg AndCfg `mapstructure:"and"`
+	// Configs for per key rate limiting policy evaluator.
+	PerKeyRateLimitingCfg PerKeyRateLimitingCfg `mapstructure:"per_key_rate_limiting"`
 }
 
 // AndSubPolicyCfg holds the common configuration to all policies under and policy.
@@ -98,6 +105,29 @@ type AndCfg struct {
 	SubPolicyCfg []AndSubPolicyCfg `mapstructure:"and_sub_policy"`
 }
 
+// PerKeyRateLimitingSubPolicyCfg holds the common configuration to all policies under per key rate limiting policy.
+type PerKeyRateLimitingSubPolicyCfg struct {
+	sharedPolicyCfg `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Configs for and policy evaluator.
+	AndCfg AndCfg `mapstructure:"and"`
+}
+
+// PerKeyRateLimitingCfg holds the configurable settings to create a per key rate limiting
+// sampling policy evaluator.
+type PerKeyRateLimitingCfg struct {
+	// Key is the resource or span attribute whose values get separate rate limits, e.g. a tenant.
+	Key string `mapstructure:"key"`
+	// SpansPerSecond sets the rate at which spans can be sampled for each value of Key.
+	SpansPerSecond int64 `mapstructure:"spans_per_second"`
+	// BurstCapacity sets the maximum number of spans that can be sampled at once for each value
+	// of Key. Defaults to SpansPerSecond.
+	BurstCapacity int64 `mapstructure:"burst_capacity"`
+	// SubPolicyCfg selects the traces subject to the rate limits: when set, only the traces sampled
+	// by at least one of the sub-policies are considered.
+	SubPolicyCfg []PerKeyRateLimitingSubPolicyCfg `mapstructure:"per_key_sub_policy"`
+}
+
 // CompositeCfg holds the configurable settings to create a composite
 // sampling policy evaluator.
 type CompositeCfg struct {
@@ -121,6 +151,8 @@ type PolicyCfg struct {
 	CompositeCfg CompositeCfg `mapstructure:"composite"`
 	// Configs for defining and policy
 	AndCfg AndCfg `mapstructure:"and"`
+	// Configs for defining per key rate limiting policy
+	PerKeyRateLimitingCfg PerKeyRateLimitingCfg `mapstructure:"per_key_rate_limiting"`
 }
 
 // LatencyCfg holds the configurable settings to create a latency filter sampling policy
@@ -231,3 +263,34 @@ type Config struct {
 	// for a given trace when requested.
 	PolicyCfgs []PolicyCfg `mapstructure:"policies"`
 }
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	for i := range cfg.PolicyCfgs {
+		policy := &cfg.PolicyCfgs[i]
+		switch policy.Type {
+		case PerKeyRateLimiting:
+			if err := policy.PerKeyRateLimitingCfg.validate(); err != nil {
+				return fmt.Errorf("policy %q: %w", policy.Name, err)
+			}
+		case Composite:
+			for j := range policy.CompositeCfg.SubPolicyCfg {
+				subPolicy := &policy.CompositeCfg.SubPolicyCfg[j]
+				if subPolicy.Type != PerKeyRateLimiting {
+					continue
+				}
+				if err := subPolicy.PerKeyRateLimitingCfg.validate(); err != nil {
+					return fmt.Errorf("policy %q: composite sub-policy %q: %w", policy.Name, subPolicy.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (cfg *PerKeyRateLimitingCfg) validate() error {
+	if cfg.SpansPerSecond <= 0 {
+		return errors.New("per_key_rate_limiting requires spans_per_second > 0")
+	}
+	return nil
+}
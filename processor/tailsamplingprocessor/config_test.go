@@ -183,6 +183,90 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name: "per-key-rate-limiting-policy-1",
+						Type: PerKeyRateLimiting,
+					},
+					PerKeyRateLimitingCfg: PerKeyRateLimitingCfg{
+						Key:            "tenant.id",
+						SpansPerSecond: 100,
+						BurstCapacity:  200,
+						SubPolicyCfg: []PerKeyRateLimitingSubPolicyCfg{
+							{
+								sharedPolicyCfg: sharedPolicyCfg{
+									Name:          "test-per-key-rate-limiting-policy-1",
+									Type:          StatusCode,
+									StatusCodeCfg: StatusCodeCfg{StatusCodes: []string{"ERROR"}},
+								},
+							},
+							{
+								sharedPolicyCfg: sharedPolicyCfg{
+									Name:             "test-per-key-rate-limiting-policy-2",
+									Type:             Probabilistic,
+									ProbabilisticCfg: ProbabilisticCfg{SamplingPercentage: 10},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestValidatePerKeyRateLimiting(t *testing.T) {
+	t.Parallel()
+
+	perKeyRateLimiting := func(spansPerSecond int64) PerKeyRateLimitingCfg {
+		return PerKeyRateLimitingCfg{Key: "tenant", SpansPerSecond: spansPerSecond}
+	}
+	tests := []struct {
+		name    string
+		policy  PolicyCfg
+		wantErr string
+	}{
+		{
+			name: "valid",
+			policy: PolicyCfg{
+				sharedPolicyCfg:       sharedPolicyCfg{Name: "per-tenant", Type: PerKeyRateLimiting},
+				PerKeyRateLimitingCfg: perKeyRateLimiting(100),
+			},
+		},
+		{
+			name: "zero spans_per_second",
+			policy: PolicyCfg{
+				sharedPolicyCfg:       sharedPolicyCfg{Name: "per-tenant", Type: PerKeyRateLimiting},
+				PerKeyRateLimitingCfg: perKeyRateLimiting(0),
+			},
+			wantErr: `policy "per-tenant": per_key_rate_limiting requires spans_per_second > 0`,
+		},
+		{
+			name: "negative spans_per_second in composite sub-policy",
+			policy: PolicyCfg{
+				sharedPolicyCfg: sharedPolicyCfg{Name: "composite", Type: Composite},
+				CompositeCfg: CompositeCfg{
+					SubPolicyCfg: []CompositeSubPolicyCfg{
+						{
+							sharedPolicyCfg:       sharedPolicyCfg{Name: "per-tenant", Type: PerKeyRateLimiting},
+							PerKeyRateLimitingCfg: perKeyRateLimiting(-1),
+						},
+					},
+				},
 			},
+			wantErr: `policy "composite": composite sub-policy "per-tenant": per_key_rate_limiting requires spans_per_second > 0`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := &Config{PolicyCfgs: []PolicyCfg{tt.policy}}
+			err := component.ValidateConfig(cfg)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
 		})
+	}
 }
@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+// bucketsSweepInterval is how often the token buckets that have been refilled
+// to their capacity are removed, so idle keys don't use memory.
+const bucketsSweepInterval = time.Minute
+
+// tokenBucket holds the spans that can still be sampled for a key.
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+type perKeyRateLimiting struct {
+	key            string
+	spansPerSecond float64
+	burstCapacity  float64
+	subpolicies    []PolicyEvaluator
+	buckets        map[string]*tokenBucket
+	lastSweep      time.Time
+	now            func() time.Time
+	logger         *zap.Logger
+}
+
+var _ PolicyEvaluator = (*perKeyRateLimiting)(nil)
+
+// NewPerKeyRateLimiting creates a policy evaluator that samples traces until the token bucket
+// of the value of the given attribute key is empty. Each value gets its own bucket, refilled at
+// spansPerSecond up to burstCapacity spans. When subpolicies are given, only the traces sampled by
+// at least one of them are considered, and the others are not sampled.
+func NewPerKeyRateLimiting(settings component.TelemetrySettings, key string, spansPerSecond, burstCapacity int64, subpolicies []PolicyEvaluator) PolicyEvaluator {
+	if burstCapacity <= 0 {
+		burstCapacity = spansPerSecond
+	}
+	return &perKeyRateLimiting{
+		key:            key,
+		spansPerSecond: float64(spansPerSecond),
+		burstCapacity:  float64(burstCapacity),
+		subpolicies:    subpolicies,
+		buckets:        make(map[string]*tokenBucket),
+		now:            time.Now,
+		logger:         settings.Logger,
+	}
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (r *perKeyRateLimiting) Evaluate(ctx context.Context, traceID pcommon.TraceID, trace *TraceData) (Decision, error) {
+	r.logger.Debug("Evaluating spans in per-key rate-limiting filter")
+
+	if len(r.subpolicies) > 0 {
+		matched, err := r.anySubPolicySampled(ctx, traceID, trace)
+		if err != nil {
+			return Unspecified, err
+		}
+		if !matched {
+			return NotSampled, nil
+		}
+	}
+
+	trace.Lock()
+	key := traceKeyValue(trace.ReceivedBatches, r.key)
+	trace.Unlock()
+
+	now := r.now()
+	r.sweep(now)
+
+	bucket, ok := r.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: r.burstCapacity, lastRefill: now}
+		r.buckets[key] = bucket
+	}
+	r.refill(bucket, now)
+
+	spanCount := float64(trace.SpanCount.Load())
+	if spanCount > bucket.tokens {
+		return NotSampled, nil
+	}
+	bucket.tokens -= spanCount
+	return Sampled, nil
+}
+
+func (r *perKeyRateLimiting) anySubPolicySampled(ctx context.Context, traceID pcommon.TraceID, trace *TraceData) (bool, error) {
+	for _, sub := range r.subpolicies {
+		decision, err := sub.Evaluate(ctx, traceID, trace)
+		if err != nil {
+			return false, err
+		}
+		if decision == Sampled || decision == InvertSampled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *perKeyRateLimiting) refill(bucket *tokenBucket, now time.Time) {
+	elapsed := now.Sub(bucket.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	bucket.tokens = math.Min(r.burstCapacity, bucket.tokens+elapsed*r.spansPerSecond)
+	bucket.lastRefill = now
+}
+
+// sweep removes the buckets that are full, since they are equivalent to a new bucket.
+func (r *perKeyRateLimiting) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < bucketsSweepInterval {
+		return
+	}
+	r.lastSweep = now
+	for key, bucket := range r.buckets {
+		r.refill(bucket, now)
+		if bucket.tokens >= r.burstCapacity {
+			delete(r.buckets, key)
+		}
+	}
+}
+
+// traceKeyValue returns the value of the given attribute key, looking first at the resource
+// attributes and then at the span attributes. Traces without the attribute share the empty key.
+func traceKeyValue(td ptrace.Traces, key string) string {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		if v, ok := td.ResourceSpans().At(i).Resource().Attributes().Get(key); ok {
+			return v.AsString()
+		}
+	}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		ilss := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if v, ok := spans.At(k).Attributes().Get(key); ok {
+					return v.AsString()
+				}
+			}
+		}
+	}
+	return ""
+}
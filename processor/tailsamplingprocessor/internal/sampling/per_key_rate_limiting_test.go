@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampling
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func newTenantTrace(resourceTenant, spanTenant string, spanCount int64) *TraceData {
+	nodeAttrs := map[string]interface{}{}
+	if resourceTenant != "" {
+		nodeAttrs["tenant"] = resourceTenant
+	}
+	trace := newTraceStringAttrs(nodeAttrs, "tenant", spanTenant)
+	trace.SpanCount = &atomic.Int64{}
+	trace.SpanCount.Store(spanCount)
+	return trace
+}
+
+func TestPerKeyRateLimiting(t *testing.T) {
+	now := time.Unix(1000, 0)
+	evaluator := NewPerKeyRateLimiting(componenttest.NewNopTelemetrySettings(), "tenant", 10, 20, nil)
+	evaluator.(*perKeyRateLimiting).now = func() time.Time { return now }
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+
+	evaluate := func(trace *TraceData) Decision {
+		decision, err := evaluator.Evaluate(context.Background(), traceID, trace)
+		require.NoError(t, err)
+		return decision
+	}
+
+	// The burst capacity is available at once for each key.
+	assert.Equal(t, Sampled, evaluate(newTenantTrace("acme", "", 15)))
+	assert.Equal(t, NotSampled, evaluate(newTenantTrace("acme", "", 10)))
+	assert.Equal(t, Sampled, evaluate(newTenantTrace("acme", "", 5)))
+	assert.Equal(t, Sampled, evaluate(newTenantTrace("globex", "", 20)))
+
+	// Span attributes are used when the resource doesn't have the key.
+	assert.Equal(t, NotSampled, evaluate(newTenantTrace("", "globex", 1)))
+	// Traces without the key share a bucket.
+	assert.Equal(t, Sampled, evaluate(newTenantTrace("", "", 20)))
+
+	// Buckets are refilled at spans_per_second.
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, NotSampled, evaluate(newTenantTrace("acme", "", 6)))
+	assert.Equal(t, Sampled, evaluate(newTenantTrace("acme", "", 5)))
+}
+
+func TestPerKeyRateLimitingDefaultBurstCapacity(t *testing.T) {
+	evaluator := NewPerKeyRateLimiting(componenttest.NewNopTelemetrySettings(), "tenant", 10, 0, nil)
+	assert.Equal(t, float64(10), evaluator.(*perKeyRateLimiting).burstCapacity)
+}
+
+func TestPerKeyRateLimitingSubPolicies(t *testing.T) {
+	subpolicy := NewStringAttributeFilter(componenttest.NewNopTelemetrySettings(), "tenant", []string{"acme"}, false, 0, false)
+	evaluator := NewPerKeyRateLimiting(componenttest.NewNopTelemetrySettings(), "tenant", 10, 10, []PolicyEvaluator{subpolicy})
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+
+	decision, err := evaluator.Evaluate(context.Background(), traceID, newTenantTrace("", "acme", 5))
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+
+	decision, err = evaluator.Evaluate(context.Background(), traceID, newTenantTrace("", "globex", 5))
+	require.NoError(t, err)
+	assert.Equal(t, NotSampled, decision)
+}
+
+func TestPerKeyRateLimitingSweep(t *testing.T) {
+	now := time.Unix(1000, 0)
+	evaluator := NewPerKeyRateLimiting(componenttest.NewNopTelemetrySettings(), "tenant", 10, 10, nil)
+	r := evaluator.(*perKeyRateLimiting)
+	r.now = func() time.Time { return now }
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+
+	_, err := evaluator.Evaluate(context.Background(), traceID, newTenantTrace("acme", "", 5))
+	require.NoError(t, err)
+	assert.Len(t, r.buckets, 1)
+
+	now = now.Add(bucketsSweepInterval)
+	_, err = evaluator.Evaluate(context.Background(), traceID, newTenantTrace("globex", "", 5))
+	require.NoError(t, err)
+	assert.Len(t, r.buckets, 1)
+	assert.Contains(t, r.buckets, "globex")
+}
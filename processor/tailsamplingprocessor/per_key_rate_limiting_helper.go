@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+func getNewPerKeyRateLimitingPolicy(settings component.TelemetrySettings, config *PerKeyRateLimitingCfg) (sampling.PolicyEvaluator, error) {
+	var subPolicyEvaluators []sampling.PolicyEvaluator
+	for i := range config.SubPolicyCfg {
+		policyCfg := &config.SubPolicyCfg[i]
+		policy, err := getPerKeyRateLimitingSubPolicyEvaluator(settings, policyCfg)
+		if err != nil {
+			return nil, err
+		}
+		subPolicyEvaluators = append(subPolicyEvaluators, policy)
+	}
+	return sampling.NewPerKeyRateLimiting(settings, config.Key, config.SpansPerSecond, config.BurstCapacity, subPolicyEvaluators), nil
+}
+
+// Return instance of per key rate limiting sub-policy
+func getPerKeyRateLimitingSubPolicyEvaluator(settings component.TelemetrySettings, cfg *PerKeyRateLimitingSubPolicyCfg) (sampling.PolicyEvaluator, error) {
+	switch cfg.Type {
+	case And:
+		return getNewAndPolicy(settings, &cfg.AndCfg)
+	default:
+		return getSharedPolicyEvaluator(settings, &cfg.sharedPolicyCfg)
+	}
+}
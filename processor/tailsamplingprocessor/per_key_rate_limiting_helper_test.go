@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+func TestPerKeyRateLimitingHelper(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		evaluator, err := getNewPerKeyRateLimitingPolicy(componenttest.NewNopTelemetrySettings(), &PerKeyRateLimitingCfg{
+			Key:            "tenant",
+			SpansPerSecond: 10,
+			SubPolicyCfg: []PerKeyRateLimitingSubPolicyCfg{
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name:          "test-per-key-rate-limiting-policy-1",
+						Type:          StatusCode,
+						StatusCodeCfg: StatusCodeCfg{StatusCodes: []string{"ERROR"}},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		newTrace := func(tenant string, status ptrace.StatusCode, spanCount int64) *sampling.TraceData {
+			traces := ptrace.NewTraces()
+			rs := traces.ResourceSpans().AppendEmpty()
+			rs.Resource().Attributes().PutStr("tenant", tenant)
+			rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().Status().SetCode(status)
+			trace := &sampling.TraceData{ReceivedBatches: traces, SpanCount: &atomic.Int64{}}
+			trace.SpanCount.Store(spanCount)
+			return trace
+		}
+
+		decision, err := evaluator.Evaluate(context.Background(), pcommon.TraceID{}, newTrace("acme", ptrace.StatusCodeOk, 1))
+		require.NoError(t, err)
+		assert.Equal(t, sampling.NotSampled, decision)
+
+		decision, err = evaluator.Evaluate(context.Background(), pcommon.TraceID{}, newTrace("acme", ptrace.StatusCodeError, 10))
+		require.NoError(t, err)
+		assert.Equal(t, sampling.Sampled, decision)
+
+		decision, err = evaluator.Evaluate(context.Background(), pcommon.TraceID{}, newTrace("acme", ptrace.StatusCodeError, 10))
+		require.NoError(t, err)
+		assert.Equal(t, sampling.NotSampled, decision)
+
+		decision, err = evaluator.Evaluate(context.Background(), pcommon.TraceID{}, newTrace("globex", ptrace.StatusCodeError, 10))
+		require.NoError(t, err)
+		assert.Equal(t, sampling.Sampled, decision)
+	})
+
+	t.Run("unsupported sampling policy type", func(t *testing.T) {
+		_, err := getNewPerKeyRateLimitingPolicy(componenttest.NewNopTelemetrySettings(), &PerKeyRateLimitingCfg{
+			Key:            "tenant",
+			SpansPerSecond: 10,
+			SubPolicyCfg: []PerKeyRateLimitingSubPolicyCfg{
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name: "test-per-key-rate-limiting-policy-2",
+						Type: PerKeyRateLimiting, // nested per key rate limiting is not allowed
+					},
+				},
+			},
+		})
+		require.EqualError(t, err, "unknown sampling policy type per_key_rate_limiting")
+	})
+}
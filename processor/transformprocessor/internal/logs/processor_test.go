@@ -317,6 +317,26 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("json_test", "pass")
 			},
 		},
+		{
+			statement: `merge_maps(attributes, URL(attributes["http.url"]), "insert") where body == "operationA"`,
+			want: func(td plog.Logs) {
+				attrs := td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+				attrs.PutStr("url.original", "http://localhost/health")
+				attrs.PutStr("url.scheme", "http")
+				attrs.PutStr("url.domain", "localhost")
+				attrs.PutStr("url.path", "/health")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where body == "operationA" and IsInCIDR("10.1.2.3", ["192.168.0.0/16", "10.0.0.0/8"]) == true`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where IsInCIDR(attributes["http.path"], ["10.0.0.0/8"]) == true`,
+			want:      func(td plog.Logs) {},
+		},
 		{
 			statement: `limit(attributes, 0, []) where body == "operationA"`,
 			want: func(td plog.Logs) {
@@ -56,8 +56,12 @@ type MetricsConfig struct {
 	SparkDriverLiveListenerBusProcessingTimeAverage    MetricConfig `mapstructure:"spark.driver.live_listener_bus.processing_time.average"`
 	SparkDriverLiveListenerBusQueueSize                MetricConfig `mapstructure:"spark.driver.live_listener_bus.queue_size"`
 	SparkExecutorDiskUsage                             MetricConfig `mapstructure:"spark.executor.disk.usage"`
+	SparkExecutorGcDuration                            MetricConfig `mapstructure:"spark.executor.gc.duration"`
+	SparkExecutorGcOperations                          MetricConfig `mapstructure:"spark.executor.gc.operations"`
 	SparkExecutorGcTime                                MetricConfig `mapstructure:"spark.executor.gc_time"`
 	SparkExecutorInputSize                             MetricConfig `mapstructure:"spark.executor.input_size"`
+	SparkExecutorMemoryExecution                       MetricConfig `mapstructure:"spark.executor.memory.execution"`
+	SparkExecutorMemoryJvm                             MetricConfig `mapstructure:"spark.executor.memory.jvm"`
 	SparkExecutorMemoryUsage                           MetricConfig `mapstructure:"spark.executor.memory.usage"`
 	SparkExecutorShuffleIoSize                         MetricConfig `mapstructure:"spark.executor.shuffle.io.size"`
 	SparkExecutorStorageMemoryUsage                    MetricConfig `mapstructure:"spark.executor.storage_memory.usage"`
@@ -88,6 +92,11 @@ type MetricsConfig struct {
 	SparkStageTaskActive                               MetricConfig `mapstructure:"spark.stage.task.active"`
 	SparkStageTaskResult                               MetricConfig `mapstructure:"spark.stage.task.result"`
 	SparkStageTaskResultSize                           MetricConfig `mapstructure:"spark.stage.task.result_size"`
+	SparkStreamingQueryBatchDuration                   MetricConfig `mapstructure:"spark.streaming.query.batch.duration"`
+	SparkStreamingQueryInputRate                       MetricConfig `mapstructure:"spark.streaming.query.input_rate"`
+	SparkStreamingQueryProcessingRate                  MetricConfig `mapstructure:"spark.streaming.query.processing_rate"`
+	SparkStreamingQueryStateMemory                     MetricConfig `mapstructure:"spark.streaming.query.state.memory"`
+	SparkStreamingQueryStateRows                       MetricConfig `mapstructure:"spark.streaming.query.state.rows"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -185,12 +194,24 @@ func DefaultMetricsConfig() MetricsConfig {
 		SparkExecutorDiskUsage: MetricConfig{
 			Enabled: true,
 		},
+		SparkExecutorGcDuration: MetricConfig{
+			Enabled: true,
+		},
+		SparkExecutorGcOperations: MetricConfig{
+			Enabled: true,
+		},
 		SparkExecutorGcTime: MetricConfig{
 			Enabled: true,
 		},
 		SparkExecutorInputSize: MetricConfig{
 			Enabled: true,
 		},
+		SparkExecutorMemoryExecution: MetricConfig{
+			Enabled: true,
+		},
+		SparkExecutorMemoryJvm: MetricConfig{
+			Enabled: true,
+		},
 		SparkExecutorMemoryUsage: MetricConfig{
 			Enabled: true,
 		},
@@ -281,6 +302,21 @@ func DefaultMetricsConfig() MetricsConfig {
 		SparkStageTaskResultSize: MetricConfig{
 			Enabled: true,
 		},
+		SparkStreamingQueryBatchDuration: MetricConfig{
+			Enabled: true,
+		},
+		SparkStreamingQueryInputRate: MetricConfig{
+			Enabled: true,
+		},
+		SparkStreamingQueryProcessingRate: MetricConfig{
+			Enabled: true,
+		},
+		SparkStreamingQueryStateMemory: MetricConfig{
+			Enabled: true,
+		},
+		SparkStreamingQueryStateRows: MetricConfig{
+			Enabled: true,
+		},
 	}
 }
 
@@ -291,12 +327,13 @@ type ResourceAttributeConfig struct {
 
 // ResourceAttributesConfig provides config for apachespark resource attributes.
 type ResourceAttributesConfig struct {
-	SparkApplicationID   ResourceAttributeConfig `mapstructure:"spark.application.id"`
-	SparkApplicationName ResourceAttributeConfig `mapstructure:"spark.application.name"`
-	SparkExecutorID      ResourceAttributeConfig `mapstructure:"spark.executor.id"`
-	SparkJobID           ResourceAttributeConfig `mapstructure:"spark.job.id"`
-	SparkStageAttemptID  ResourceAttributeConfig `mapstructure:"spark.stage.attempt.id"`
-	SparkStageID         ResourceAttributeConfig `mapstructure:"spark.stage.id"`
+	SparkApplicationID      ResourceAttributeConfig `mapstructure:"spark.application.id"`
+	SparkApplicationName    ResourceAttributeConfig `mapstructure:"spark.application.name"`
+	SparkExecutorID         ResourceAttributeConfig `mapstructure:"spark.executor.id"`
+	SparkJobID              ResourceAttributeConfig `mapstructure:"spark.job.id"`
+	SparkStageAttemptID     ResourceAttributeConfig `mapstructure:"spark.stage.attempt.id"`
+	SparkStageID            ResourceAttributeConfig `mapstructure:"spark.stage.id"`
+	SparkStreamingQueryName ResourceAttributeConfig `mapstructure:"spark.streaming.query.name"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
@@ -319,6 +356,9 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		SparkStageID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		SparkStreamingQueryName: ResourceAttributeConfig{
+			Enabled: true,
+		},
 	}
 }
 
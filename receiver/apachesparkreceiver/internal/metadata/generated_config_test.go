@@ -57,8 +57,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SparkDriverLiveListenerBusProcessingTimeAverage:    MetricConfig{Enabled: true},
 					SparkDriverLiveListenerBusQueueSize:                MetricConfig{Enabled: true},
 					SparkExecutorDiskUsage:                             MetricConfig{Enabled: true},
+					SparkExecutorGcDuration:                            MetricConfig{Enabled: true},
+					SparkExecutorGcOperations:                          MetricConfig{Enabled: true},
 					SparkExecutorGcTime:                                MetricConfig{Enabled: true},
 					SparkExecutorInputSize:                             MetricConfig{Enabled: true},
+					SparkExecutorMemoryExecution:                       MetricConfig{Enabled: true},
+					SparkExecutorMemoryJvm:                             MetricConfig{Enabled: true},
 					SparkExecutorMemoryUsage:                           MetricConfig{Enabled: true},
 					SparkExecutorShuffleIoSize:                         MetricConfig{Enabled: true},
 					SparkExecutorStorageMemoryUsage:                    MetricConfig{Enabled: true},
@@ -89,14 +93,20 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SparkStageTaskActive:                               MetricConfig{Enabled: true},
 					SparkStageTaskResult:                               MetricConfig{Enabled: true},
 					SparkStageTaskResultSize:                           MetricConfig{Enabled: true},
+					SparkStreamingQueryBatchDuration:                   MetricConfig{Enabled: true},
+					SparkStreamingQueryInputRate:                       MetricConfig{Enabled: true},
+					SparkStreamingQueryProcessingRate:                  MetricConfig{Enabled: true},
+					SparkStreamingQueryStateMemory:                     MetricConfig{Enabled: true},
+					SparkStreamingQueryStateRows:                       MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					SparkApplicationID:   ResourceAttributeConfig{Enabled: true},
-					SparkApplicationName: ResourceAttributeConfig{Enabled: true},
-					SparkExecutorID:      ResourceAttributeConfig{Enabled: true},
-					SparkJobID:           ResourceAttributeConfig{Enabled: true},
-					SparkStageAttemptID:  ResourceAttributeConfig{Enabled: true},
-					SparkStageID:         ResourceAttributeConfig{Enabled: true},
+					SparkApplicationID:      ResourceAttributeConfig{Enabled: true},
+					SparkApplicationName:    ResourceAttributeConfig{Enabled: true},
+					SparkExecutorID:         ResourceAttributeConfig{Enabled: true},
+					SparkJobID:              ResourceAttributeConfig{Enabled: true},
+					SparkStageAttemptID:     ResourceAttributeConfig{Enabled: true},
+					SparkStageID:            ResourceAttributeConfig{Enabled: true},
+					SparkStreamingQueryName: ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -135,8 +145,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SparkDriverLiveListenerBusProcessingTimeAverage:    MetricConfig{Enabled: false},
 					SparkDriverLiveListenerBusQueueSize:                MetricConfig{Enabled: false},
 					SparkExecutorDiskUsage:                             MetricConfig{Enabled: false},
+					SparkExecutorGcDuration:                            MetricConfig{Enabled: false},
+					SparkExecutorGcOperations:                          MetricConfig{Enabled: false},
 					SparkExecutorGcTime:                                MetricConfig{Enabled: false},
 					SparkExecutorInputSize:                             MetricConfig{Enabled: false},
+					SparkExecutorMemoryExecution:                       MetricConfig{Enabled: false},
+					SparkExecutorMemoryJvm:                             MetricConfig{Enabled: false},
 					SparkExecutorMemoryUsage:                           MetricConfig{Enabled: false},
 					SparkExecutorShuffleIoSize:                         MetricConfig{Enabled: false},
 					SparkExecutorStorageMemoryUsage:                    MetricConfig{Enabled: false},
@@ -167,14 +181,20 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SparkStageTaskActive:                               MetricConfig{Enabled: false},
 					SparkStageTaskResult:                               MetricConfig{Enabled: false},
 					SparkStageTaskResultSize:                           MetricConfig{Enabled: false},
+					SparkStreamingQueryBatchDuration:                   MetricConfig{Enabled: false},
+					SparkStreamingQueryInputRate:                       MetricConfig{Enabled: false},
+					SparkStreamingQueryProcessingRate:                  MetricConfig{Enabled: false},
+					SparkStreamingQueryStateMemory:                     MetricConfig{Enabled: false},
+					SparkStreamingQueryStateRows:                       MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					SparkApplicationID:   ResourceAttributeConfig{Enabled: false},
-					SparkApplicationName: ResourceAttributeConfig{Enabled: false},
-					SparkExecutorID:      ResourceAttributeConfig{Enabled: false},
-					SparkJobID:           ResourceAttributeConfig{Enabled: false},
-					SparkStageAttemptID:  ResourceAttributeConfig{Enabled: false},
-					SparkStageID:         ResourceAttributeConfig{Enabled: false},
+					SparkApplicationID:      ResourceAttributeConfig{Enabled: false},
+					SparkApplicationName:    ResourceAttributeConfig{Enabled: false},
+					SparkExecutorID:         ResourceAttributeConfig{Enabled: false},
+					SparkJobID:              ResourceAttributeConfig{Enabled: false},
+					SparkStageAttemptID:     ResourceAttributeConfig{Enabled: false},
+					SparkStageID:            ResourceAttributeConfig{Enabled: false},
+					SparkStreamingQueryName: ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -211,23 +231,25 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
-				SparkApplicationID:   ResourceAttributeConfig{Enabled: true},
-				SparkApplicationName: ResourceAttributeConfig{Enabled: true},
-				SparkExecutorID:      ResourceAttributeConfig{Enabled: true},
-				SparkJobID:           ResourceAttributeConfig{Enabled: true},
-				SparkStageAttemptID:  ResourceAttributeConfig{Enabled: true},
-				SparkStageID:         ResourceAttributeConfig{Enabled: true},
+				SparkApplicationID:      ResourceAttributeConfig{Enabled: true},
+				SparkApplicationName:    ResourceAttributeConfig{Enabled: true},
+				SparkExecutorID:         ResourceAttributeConfig{Enabled: true},
+				SparkJobID:              ResourceAttributeConfig{Enabled: true},
+				SparkStageAttemptID:     ResourceAttributeConfig{Enabled: true},
+				SparkStageID:            ResourceAttributeConfig{Enabled: true},
+				SparkStreamingQueryName: ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
-				SparkApplicationID:   ResourceAttributeConfig{Enabled: false},
-				SparkApplicationName: ResourceAttributeConfig{Enabled: false},
-				SparkExecutorID:      ResourceAttributeConfig{Enabled: false},
-				SparkJobID:           ResourceAttributeConfig{Enabled: false},
-				SparkStageAttemptID:  ResourceAttributeConfig{Enabled: false},
-				SparkStageID:         ResourceAttributeConfig{Enabled: false},
+				SparkApplicationID:      ResourceAttributeConfig{Enabled: false},
+				SparkApplicationName:    ResourceAttributeConfig{Enabled: false},
+				SparkExecutorID:         ResourceAttributeConfig{Enabled: false},
+				SparkJobID:              ResourceAttributeConfig{Enabled: false},
+				SparkStageAttemptID:     ResourceAttributeConfig{Enabled: false},
+				SparkStageID:            ResourceAttributeConfig{Enabled: false},
+				SparkStreamingQueryName: ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
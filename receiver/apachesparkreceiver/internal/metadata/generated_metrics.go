@@ -1867,6 +1867,112 @@ func newMetricSparkExecutorDiskUsage(cfg MetricConfig) metricSparkExecutorDiskUs
 	return m
 }
 
+type metricSparkExecutorGcDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.executor.gc.duration metric with initial data.
+func (m *metricSparkExecutorGcDuration) init() {
+	m.data.SetName("spark.executor.gc.duration")
+	m.data.SetDescription("Total elapsed time during garbage collection operations of each type performed by the executor.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSparkExecutorGcDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, gcTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("gc_type", gcTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkExecutorGcDuration) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkExecutorGcDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkExecutorGcDuration(cfg MetricConfig) metricSparkExecutorGcDuration {
+	m := metricSparkExecutorGcDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSparkExecutorGcOperations struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.executor.gc.operations metric with initial data.
+func (m *metricSparkExecutorGcOperations) init() {
+	m.data.SetName("spark.executor.gc.operations")
+	m.data.SetDescription("Number of garbage collection operations performed by the executor.")
+	m.data.SetUnit("{ gc_operation }")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSparkExecutorGcOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, gcTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("gc_type", gcTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkExecutorGcOperations) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkExecutorGcOperations) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkExecutorGcOperations(cfg MetricConfig) metricSparkExecutorGcOperations {
+	m := metricSparkExecutorGcOperations{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSparkExecutorGcTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1969,6 +2075,112 @@ func newMetricSparkExecutorInputSize(cfg MetricConfig) metricSparkExecutorInputS
 	return m
 }
 
+type metricSparkExecutorMemoryExecution struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.executor.memory.execution metric with initial data.
+func (m *metricSparkExecutorMemoryExecution) init() {
+	m.data.SetName("spark.executor.memory.execution")
+	m.data.SetDescription("Peak amount of execution memory used by the executor.")
+	m.data.SetUnit("bytes")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSparkExecutorMemoryExecution) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, locationAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("location", locationAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkExecutorMemoryExecution) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkExecutorMemoryExecution) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkExecutorMemoryExecution(cfg MetricConfig) metricSparkExecutorMemoryExecution {
+	m := metricSparkExecutorMemoryExecution{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSparkExecutorMemoryJvm struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.executor.memory.jvm metric with initial data.
+func (m *metricSparkExecutorMemoryJvm) init() {
+	m.data.SetName("spark.executor.memory.jvm")
+	m.data.SetDescription("Peak amount of memory used by the executor's JVM.")
+	m.data.SetUnit("bytes")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSparkExecutorMemoryJvm) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, locationAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("location", locationAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkExecutorMemoryJvm) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkExecutorMemoryJvm) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkExecutorMemoryJvm(cfg MetricConfig) metricSparkExecutorMemoryJvm {
+	m := metricSparkExecutorMemoryJvm{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSparkExecutorMemoryUsage struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -3527,6 +3739,255 @@ func newMetricSparkStageTaskResultSize(cfg MetricConfig) metricSparkStageTaskRes
 	return m
 }
 
+type metricSparkStreamingQueryBatchDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.streaming.query.batch.duration metric with initial data.
+func (m *metricSparkStreamingQueryBatchDuration) init() {
+	m.data.SetName("spark.streaming.query.batch.duration")
+	m.data.SetDescription("Duration of the last micro-batch of the structured streaming query.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSparkStreamingQueryBatchDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkStreamingQueryBatchDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkStreamingQueryBatchDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkStreamingQueryBatchDuration(cfg MetricConfig) metricSparkStreamingQueryBatchDuration {
+	m := metricSparkStreamingQueryBatchDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSparkStreamingQueryInputRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.streaming.query.input_rate metric with initial data.
+func (m *metricSparkStreamingQueryInputRate) init() {
+	m.data.SetName("spark.streaming.query.input_rate")
+	m.data.SetDescription("Rate at which data is arriving for the structured streaming query.")
+	m.data.SetUnit("{ record }/s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSparkStreamingQueryInputRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkStreamingQueryInputRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkStreamingQueryInputRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkStreamingQueryInputRate(cfg MetricConfig) metricSparkStreamingQueryInputRate {
+	m := metricSparkStreamingQueryInputRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSparkStreamingQueryProcessingRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.streaming.query.processing_rate metric with initial data.
+func (m *metricSparkStreamingQueryProcessingRate) init() {
+	m.data.SetName("spark.streaming.query.processing_rate")
+	m.data.SetDescription("Rate at which data is processed by the structured streaming query.")
+	m.data.SetUnit("{ record }/s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSparkStreamingQueryProcessingRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkStreamingQueryProcessingRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkStreamingQueryProcessingRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkStreamingQueryProcessingRate(cfg MetricConfig) metricSparkStreamingQueryProcessingRate {
+	m := metricSparkStreamingQueryProcessingRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSparkStreamingQueryStateMemory struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.streaming.query.state.memory metric with initial data.
+func (m *metricSparkStreamingQueryStateMemory) init() {
+	m.data.SetName("spark.streaming.query.state.memory")
+	m.data.SetDescription("Memory used by the state store of the structured streaming query.")
+	m.data.SetUnit("bytes")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSparkStreamingQueryStateMemory) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkStreamingQueryStateMemory) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkStreamingQueryStateMemory) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkStreamingQueryStateMemory(cfg MetricConfig) metricSparkStreamingQueryStateMemory {
+	m := metricSparkStreamingQueryStateMemory{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSparkStreamingQueryStateRows struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.streaming.query.state.rows metric with initial data.
+func (m *metricSparkStreamingQueryStateRows) init() {
+	m.data.SetName("spark.streaming.query.state.rows")
+	m.data.SetDescription("Number of rows in the state store of the structured streaming query.")
+	m.data.SetUnit("{ row }")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSparkStreamingQueryStateRows) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkStreamingQueryStateRows) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkStreamingQueryStateRows) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkStreamingQueryStateRows(cfg MetricConfig) metricSparkStreamingQueryStateRows {
+	m := metricSparkStreamingQueryStateRows{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -3566,8 +4027,12 @@ type MetricsBuilder struct {
 	metricSparkDriverLiveListenerBusProcessingTimeAverage    metricSparkDriverLiveListenerBusProcessingTimeAverage
 	metricSparkDriverLiveListenerBusQueueSize                metricSparkDriverLiveListenerBusQueueSize
 	metricSparkExecutorDiskUsage                             metricSparkExecutorDiskUsage
+	metricSparkExecutorGcDuration                            metricSparkExecutorGcDuration
+	metricSparkExecutorGcOperations                          metricSparkExecutorGcOperations
 	metricSparkExecutorGcTime                                metricSparkExecutorGcTime
 	metricSparkExecutorInputSize                             metricSparkExecutorInputSize
+	metricSparkExecutorMemoryExecution                       metricSparkExecutorMemoryExecution
+	metricSparkExecutorMemoryJvm                             metricSparkExecutorMemoryJvm
 	metricSparkExecutorMemoryUsage                           metricSparkExecutorMemoryUsage
 	metricSparkExecutorShuffleIoSize                         metricSparkExecutorShuffleIoSize
 	metricSparkExecutorStorageMemoryUsage                    metricSparkExecutorStorageMemoryUsage
@@ -3598,6 +4063,11 @@ type MetricsBuilder struct {
 	metricSparkStageTaskActive                               metricSparkStageTaskActive
 	metricSparkStageTaskResult                               metricSparkStageTaskResult
 	metricSparkStageTaskResultSize                           metricSparkStageTaskResultSize
+	metricSparkStreamingQueryBatchDuration                   metricSparkStreamingQueryBatchDuration
+	metricSparkStreamingQueryInputRate                       metricSparkStreamingQueryInputRate
+	metricSparkStreamingQueryProcessingRate                  metricSparkStreamingQueryProcessingRate
+	metricSparkStreamingQueryStateMemory                     metricSparkStreamingQueryStateMemory
+	metricSparkStreamingQueryStateRows                       metricSparkStreamingQueryStateRows
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -3647,8 +4117,12 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricSparkDriverLiveListenerBusProcessingTimeAverage:    newMetricSparkDriverLiveListenerBusProcessingTimeAverage(mbc.Metrics.SparkDriverLiveListenerBusProcessingTimeAverage),
 		metricSparkDriverLiveListenerBusQueueSize:                newMetricSparkDriverLiveListenerBusQueueSize(mbc.Metrics.SparkDriverLiveListenerBusQueueSize),
 		metricSparkExecutorDiskUsage:                             newMetricSparkExecutorDiskUsage(mbc.Metrics.SparkExecutorDiskUsage),
+		metricSparkExecutorGcDuration:                            newMetricSparkExecutorGcDuration(mbc.Metrics.SparkExecutorGcDuration),
+		metricSparkExecutorGcOperations:                          newMetricSparkExecutorGcOperations(mbc.Metrics.SparkExecutorGcOperations),
 		metricSparkExecutorGcTime:                                newMetricSparkExecutorGcTime(mbc.Metrics.SparkExecutorGcTime),
 		metricSparkExecutorInputSize:                             newMetricSparkExecutorInputSize(mbc.Metrics.SparkExecutorInputSize),
+		metricSparkExecutorMemoryExecution:                       newMetricSparkExecutorMemoryExecution(mbc.Metrics.SparkExecutorMemoryExecution),
+		metricSparkExecutorMemoryJvm:                             newMetricSparkExecutorMemoryJvm(mbc.Metrics.SparkExecutorMemoryJvm),
 		metricSparkExecutorMemoryUsage:                           newMetricSparkExecutorMemoryUsage(mbc.Metrics.SparkExecutorMemoryUsage),
 		metricSparkExecutorShuffleIoSize:                         newMetricSparkExecutorShuffleIoSize(mbc.Metrics.SparkExecutorShuffleIoSize),
 		metricSparkExecutorStorageMemoryUsage:                    newMetricSparkExecutorStorageMemoryUsage(mbc.Metrics.SparkExecutorStorageMemoryUsage),
@@ -3679,6 +4153,11 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricSparkStageTaskActive:                               newMetricSparkStageTaskActive(mbc.Metrics.SparkStageTaskActive),
 		metricSparkStageTaskResult:                               newMetricSparkStageTaskResult(mbc.Metrics.SparkStageTaskResult),
 		metricSparkStageTaskResultSize:                           newMetricSparkStageTaskResultSize(mbc.Metrics.SparkStageTaskResultSize),
+		metricSparkStreamingQueryBatchDuration:                   newMetricSparkStreamingQueryBatchDuration(mbc.Metrics.SparkStreamingQueryBatchDuration),
+		metricSparkStreamingQueryInputRate:                       newMetricSparkStreamingQueryInputRate(mbc.Metrics.SparkStreamingQueryInputRate),
+		metricSparkStreamingQueryProcessingRate:                  newMetricSparkStreamingQueryProcessingRate(mbc.Metrics.SparkStreamingQueryProcessingRate),
+		metricSparkStreamingQueryStateMemory:                     newMetricSparkStreamingQueryStateMemory(mbc.Metrics.SparkStreamingQueryStateMemory),
+		metricSparkStreamingQueryStateRows:                       newMetricSparkStreamingQueryStateRows(mbc.Metrics.SparkStreamingQueryStateRows),
 	}
 	for _, op := range options {
 		op(mb)
@@ -3771,8 +4250,12 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSparkDriverLiveListenerBusProcessingTimeAverage.emit(ils.Metrics())
 	mb.metricSparkDriverLiveListenerBusQueueSize.emit(ils.Metrics())
 	mb.metricSparkExecutorDiskUsage.emit(ils.Metrics())
+	mb.metricSparkExecutorGcDuration.emit(ils.Metrics())
+	mb.metricSparkExecutorGcOperations.emit(ils.Metrics())
 	mb.metricSparkExecutorGcTime.emit(ils.Metrics())
 	mb.metricSparkExecutorInputSize.emit(ils.Metrics())
+	mb.metricSparkExecutorMemoryExecution.emit(ils.Metrics())
+	mb.metricSparkExecutorMemoryJvm.emit(ils.Metrics())
 	mb.metricSparkExecutorMemoryUsage.emit(ils.Metrics())
 	mb.metricSparkExecutorShuffleIoSize.emit(ils.Metrics())
 	mb.metricSparkExecutorStorageMemoryUsage.emit(ils.Metrics())
@@ -3803,6 +4286,11 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSparkStageTaskActive.emit(ils.Metrics())
 	mb.metricSparkStageTaskResult.emit(ils.Metrics())
 	mb.metricSparkStageTaskResultSize.emit(ils.Metrics())
+	mb.metricSparkStreamingQueryBatchDuration.emit(ils.Metrics())
+	mb.metricSparkStreamingQueryInputRate.emit(ils.Metrics())
+	mb.metricSparkStreamingQueryProcessingRate.emit(ils.Metrics())
+	mb.metricSparkStreamingQueryStateMemory.emit(ils.Metrics())
+	mb.metricSparkStreamingQueryStateRows.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -3978,6 +4466,16 @@ func (mb *MetricsBuilder) RecordSparkExecutorDiskUsageDataPoint(ts pcommon.Times
 	mb.metricSparkExecutorDiskUsage.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSparkExecutorGcDurationDataPoint adds a data point to spark.executor.gc.duration metric.
+func (mb *MetricsBuilder) RecordSparkExecutorGcDurationDataPoint(ts pcommon.Timestamp, val int64, gcTypeAttributeValue AttributeGcType) {
+	mb.metricSparkExecutorGcDuration.recordDataPoint(mb.startTime, ts, val, gcTypeAttributeValue.String())
+}
+
+// RecordSparkExecutorGcOperationsDataPoint adds a data point to spark.executor.gc.operations metric.
+func (mb *MetricsBuilder) RecordSparkExecutorGcOperationsDataPoint(ts pcommon.Timestamp, val int64, gcTypeAttributeValue AttributeGcType) {
+	mb.metricSparkExecutorGcOperations.recordDataPoint(mb.startTime, ts, val, gcTypeAttributeValue.String())
+}
+
 // RecordSparkExecutorGcTimeDataPoint adds a data point to spark.executor.gc_time metric.
 func (mb *MetricsBuilder) RecordSparkExecutorGcTimeDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricSparkExecutorGcTime.recordDataPoint(mb.startTime, ts, val)
@@ -3988,6 +4486,16 @@ func (mb *MetricsBuilder) RecordSparkExecutorInputSizeDataPoint(ts pcommon.Times
 	mb.metricSparkExecutorInputSize.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSparkExecutorMemoryExecutionDataPoint adds a data point to spark.executor.memory.execution metric.
+func (mb *MetricsBuilder) RecordSparkExecutorMemoryExecutionDataPoint(ts pcommon.Timestamp, val int64, locationAttributeValue AttributeLocation) {
+	mb.metricSparkExecutorMemoryExecution.recordDataPoint(mb.startTime, ts, val, locationAttributeValue.String())
+}
+
+// RecordSparkExecutorMemoryJvmDataPoint adds a data point to spark.executor.memory.jvm metric.
+func (mb *MetricsBuilder) RecordSparkExecutorMemoryJvmDataPoint(ts pcommon.Timestamp, val int64, locationAttributeValue AttributeLocation) {
+	mb.metricSparkExecutorMemoryJvm.recordDataPoint(mb.startTime, ts, val, locationAttributeValue.String())
+}
+
 // RecordSparkExecutorMemoryUsageDataPoint adds a data point to spark.executor.memory.usage metric.
 func (mb *MetricsBuilder) RecordSparkExecutorMemoryUsageDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricSparkExecutorMemoryUsage.recordDataPoint(mb.startTime, ts, val)
@@ -4138,6 +4646,31 @@ func (mb *MetricsBuilder) RecordSparkStageTaskResultSizeDataPoint(ts pcommon.Tim
 	mb.metricSparkStageTaskResultSize.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSparkStreamingQueryBatchDurationDataPoint adds a data point to spark.streaming.query.batch.duration metric.
+func (mb *MetricsBuilder) RecordSparkStreamingQueryBatchDurationDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSparkStreamingQueryBatchDuration.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSparkStreamingQueryInputRateDataPoint adds a data point to spark.streaming.query.input_rate metric.
+func (mb *MetricsBuilder) RecordSparkStreamingQueryInputRateDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSparkStreamingQueryInputRate.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSparkStreamingQueryProcessingRateDataPoint adds a data point to spark.streaming.query.processing_rate metric.
+func (mb *MetricsBuilder) RecordSparkStreamingQueryProcessingRateDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSparkStreamingQueryProcessingRate.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSparkStreamingQueryStateMemoryDataPoint adds a data point to spark.streaming.query.state.memory metric.
+func (mb *MetricsBuilder) RecordSparkStreamingQueryStateMemoryDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSparkStreamingQueryStateMemory.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSparkStreamingQueryStateRowsDataPoint adds a data point to spark.streaming.query.state.rows metric.
+func (mb *MetricsBuilder) RecordSparkStreamingQueryStateRowsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSparkStreamingQueryStateRows.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
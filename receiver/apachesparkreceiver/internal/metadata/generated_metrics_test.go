@@ -178,6 +178,14 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSparkExecutorDiskUsageDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSparkExecutorGcDurationDataPoint(ts, 1, AttributeGcTypeMajor)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSparkExecutorGcOperationsDataPoint(ts, 1, AttributeGcTypeMajor)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSparkExecutorGcTimeDataPoint(ts, 1)
@@ -186,6 +194,14 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSparkExecutorInputSizeDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSparkExecutorMemoryExecutionDataPoint(ts, 1, AttributeLocationOnHeap)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSparkExecutorMemoryJvmDataPoint(ts, 1, AttributeLocationOnHeap)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSparkExecutorMemoryUsageDataPoint(ts, 1)
@@ -306,6 +322,26 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSparkStageTaskResultSizeDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSparkStreamingQueryBatchDurationDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSparkStreamingQueryInputRateDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSparkStreamingQueryProcessingRateDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSparkStreamingQueryStateMemoryDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSparkStreamingQueryStateRowsDataPoint(ts, 1)
+
 			rb := mb.NewResourceBuilder()
 			rb.SetSparkApplicationID("spark.application.id-val")
 			rb.SetSparkApplicationName("spark.application.name-val")
@@ -313,6 +349,7 @@ func TestMetricsBuilder(t *testing.T) {
 			rb.SetSparkJobID(12)
 			rb.SetSparkStageAttemptID(22)
 			rb.SetSparkStageID(14)
+			rb.SetSparkStreamingQueryName("spark.streaming.query.name-val")
 			res := rb.Emit()
 			metrics := mb.Emit(WithResource(res))
 
@@ -786,6 +823,40 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "spark.executor.gc.duration":
+					assert.False(t, validatedMetrics["spark.executor.gc.duration"], "Found a duplicate in the metrics slice: spark.executor.gc.duration")
+					validatedMetrics["spark.executor.gc.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total elapsed time during garbage collection operations of each type performed by the executor.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("gc_type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "major", attrVal.Str())
+				case "spark.executor.gc.operations":
+					assert.False(t, validatedMetrics["spark.executor.gc.operations"], "Found a duplicate in the metrics slice: spark.executor.gc.operations")
+					validatedMetrics["spark.executor.gc.operations"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of garbage collection operations performed by the executor.", ms.At(i).Description())
+					assert.Equal(t, "{ gc_operation }", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("gc_type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "major", attrVal.Str())
 				case "spark.executor.gc_time":
 					assert.False(t, validatedMetrics["spark.executor.gc_time"], "Found a duplicate in the metrics slice: spark.executor.gc_time")
 					validatedMetrics["spark.executor.gc_time"] = true
@@ -814,6 +885,40 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "spark.executor.memory.execution":
+					assert.False(t, validatedMetrics["spark.executor.memory.execution"], "Found a duplicate in the metrics slice: spark.executor.memory.execution")
+					validatedMetrics["spark.executor.memory.execution"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Peak amount of execution memory used by the executor.", ms.At(i).Description())
+					assert.Equal(t, "bytes", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("location")
+					assert.True(t, ok)
+					assert.EqualValues(t, "on_heap", attrVal.Str())
+				case "spark.executor.memory.jvm":
+					assert.False(t, validatedMetrics["spark.executor.memory.jvm"], "Found a duplicate in the metrics slice: spark.executor.memory.jvm")
+					validatedMetrics["spark.executor.memory.jvm"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Peak amount of memory used by the executor's JVM.", ms.At(i).Description())
+					assert.Equal(t, "bytes", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("location")
+					assert.True(t, ok)
+					assert.EqualValues(t, "on_heap", attrVal.Str())
 				case "spark.executor.memory.usage":
 					assert.False(t, validatedMetrics["spark.executor.memory.usage"], "Found a duplicate in the metrics slice: spark.executor.memory.usage")
 					validatedMetrics["spark.executor.memory.usage"] = true
@@ -1282,6 +1387,70 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "spark.streaming.query.batch.duration":
+					assert.False(t, validatedMetrics["spark.streaming.query.batch.duration"], "Found a duplicate in the metrics slice: spark.streaming.query.batch.duration")
+					validatedMetrics["spark.streaming.query.batch.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Duration of the last micro-batch of the structured streaming query.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "spark.streaming.query.input_rate":
+					assert.False(t, validatedMetrics["spark.streaming.query.input_rate"], "Found a duplicate in the metrics slice: spark.streaming.query.input_rate")
+					validatedMetrics["spark.streaming.query.input_rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Rate at which data is arriving for the structured streaming query.", ms.At(i).Description())
+					assert.Equal(t, "{ record }/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "spark.streaming.query.processing_rate":
+					assert.False(t, validatedMetrics["spark.streaming.query.processing_rate"], "Found a duplicate in the metrics slice: spark.streaming.query.processing_rate")
+					validatedMetrics["spark.streaming.query.processing_rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Rate at which data is processed by the structured streaming query.", ms.At(i).Description())
+					assert.Equal(t, "{ record }/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "spark.streaming.query.state.memory":
+					assert.False(t, validatedMetrics["spark.streaming.query.state.memory"], "Found a duplicate in the metrics slice: spark.streaming.query.state.memory")
+					validatedMetrics["spark.streaming.query.state.memory"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Memory used by the state store of the structured streaming query.", ms.At(i).Description())
+					assert.Equal(t, "bytes", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "spark.streaming.query.state.rows":
+					assert.False(t, validatedMetrics["spark.streaming.query.state.rows"], "Found a duplicate in the metrics slice: spark.streaming.query.state.rows")
+					validatedMetrics["spark.streaming.query.state.rows"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of rows in the state store of the structured streaming query.", ms.At(i).Description())
+					assert.Equal(t, "{ row }", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				}
 			}
 		})
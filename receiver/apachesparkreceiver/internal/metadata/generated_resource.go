@@ -63,6 +63,13 @@ func (rb *ResourceBuilder) SetSparkStageID(val int64) {
 	}
 }
 
+// SetSparkStreamingQueryName sets provided value as "spark.streaming.query.name" attribute.
+func (rb *ResourceBuilder) SetSparkStreamingQueryName(val string) {
+	if rb.config.SparkStreamingQueryName.Enabled {
+		rb.res.Attributes().PutStr("spark.streaming.query.name", val)
+	}
+}
+
 // Emit returns the built resource and resets the internal builder state.
 func (rb *ResourceBuilder) Emit() pcommon.Resource {
 	r := rb.res
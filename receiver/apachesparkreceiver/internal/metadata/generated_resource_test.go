@@ -19,15 +19,16 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetSparkJobID(12)
 			rb.SetSparkStageAttemptID(22)
 			rb.SetSparkStageID(14)
+			rb.SetSparkStreamingQueryName("spark.streaming.query.name-val")
 
 			res := rb.Emit()
 			assert.Equal(t, 0, rb.Emit().Attributes().Len()) // Second call should return 0
 
 			switch test {
 			case "default":
-				assert.Equal(t, 5, res.Attributes().Len())
-			case "all_set":
 				assert.Equal(t, 6, res.Attributes().Len())
+			case "all_set":
+				assert.Equal(t, 7, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -65,6 +66,11 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, 14, val.Int())
 			}
+			val, ok = res.Attributes().Get("spark.streaming.query.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "spark.streaming.query.name-val", val.Str())
+			}
 		})
 	}
 }
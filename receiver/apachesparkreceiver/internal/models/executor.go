@@ -21,4 +21,20 @@ type Executor struct {
 	UsedOffHeapStorageMemory  int64  `json:"usedOffHeapStorageMemory"`
 	TotalOnHeapStorageMemory  int64  `json:"totalOnHeapStorageMemory"`
 	TotalOffHeapStorageMemory int64  `json:"totalOffHeapStorageMemory"`
+	// PeakMemoryMetrics is not returned for the executors which have not sent
+	// any heartbeat yet.
+	PeakMemoryMetrics *ExecutorPeakMemoryMetrics `json:"peakMemoryMetrics"`
+}
+
+// ExecutorPeakMemoryMetrics represents the peak values of the executor metrics
+// returned by the api/v1/applications/[app-id]/executors endpoint
+type ExecutorPeakMemoryMetrics struct {
+	JVMHeapMemory          int64 `json:"JVMHeapMemory"`
+	JVMOffHeapMemory       int64 `json:"JVMOffHeapMemory"`
+	OnHeapExecutionMemory  int64 `json:"OnHeapExecutionMemory"`
+	OffHeapExecutionMemory int64 `json:"OffHeapExecutionMemory"`
+	MinorGCCount           int64 `json:"MinorGCCount"`
+	MinorGCTime            int64 `json:"MinorGCTime"`
+	MajorGCCount           int64 `json:"MajorGCCount"`
+	MajorGCTime            int64 `json:"MajorGCTime"`
 }
@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -93,6 +95,7 @@ func (s *sparkScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 	} else {
 		for _, app := range allowedApps {
 			s.recordCluster(clusterStats, now, app.ApplicationID, app.Name)
+			s.recordStreamingQueries(clusterStats, now, app.ApplicationID, app.Name)
 		}
 	}
 
@@ -251,6 +254,58 @@ func (s *sparkScraper) recordCluster(clusterStats *models.ClusterProperties, now
 	s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
+// recordStreamingQueries records the progress of the structured streaming queries, reported
+// in the gauges of the 'metrics' endpoint when spark.sql.streaming.metricsEnabled is set.
+func (s *sparkScraper) recordStreamingQueries(clusterStats *models.ClusterProperties, now pcommon.Timestamp, appID string, appName string) {
+	prefix := fmt.Sprintf("%s.driver.spark.streaming.", appID)
+	queries := make(map[string]map[string]float64)
+	for key, stat := range clusterStats.Gauges {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(key, prefix)
+		i := strings.LastIndex(name, ".")
+		if i < 0 {
+			continue
+		}
+		query, metric := name[:i], name[i+1:]
+		if queries[query] == nil {
+			queries[query] = make(map[string]float64)
+		}
+		queries[query][metric] = stat.Value
+	}
+
+	names := make([]string, 0, len(queries))
+	for name := range queries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		stats := queries[name]
+		if value, ok := stats["inputRate-total"]; ok {
+			s.mb.RecordSparkStreamingQueryInputRateDataPoint(now, value)
+		}
+		if value, ok := stats["processingRate-total"]; ok {
+			s.mb.RecordSparkStreamingQueryProcessingRateDataPoint(now, value)
+		}
+		if value, ok := stats["latency"]; ok {
+			s.mb.RecordSparkStreamingQueryBatchDurationDataPoint(now, int64(value))
+		}
+		if value, ok := stats["states-rowsTotal"]; ok {
+			s.mb.RecordSparkStreamingQueryStateRowsDataPoint(now, int64(value))
+		}
+		if value, ok := stats["states-usedBytes"]; ok {
+			s.mb.RecordSparkStreamingQueryStateMemoryDataPoint(now, int64(value))
+		}
+
+		rb := s.mb.NewResourceBuilder()
+		rb.SetSparkApplicationID(appID)
+		rb.SetSparkApplicationName(appName)
+		rb.SetSparkStreamingQueryName(name)
+		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+}
+
 func (s *sparkScraper) recordStages(stageStats []models.Stage, now pcommon.Timestamp, appID string, appName string) {
 	for _, stage := range stageStats {
 		switch stage.Status {
@@ -321,6 +376,16 @@ func (s *sparkScraper) recordExecutors(executorStats []models.Executor, now pcom
 		used = executor.UsedOffHeapStorageMemory
 		s.mb.RecordSparkExecutorStorageMemoryUsageDataPoint(now, used, metadata.AttributeLocationOffHeap, metadata.AttributeStateUsed)
 		s.mb.RecordSparkExecutorStorageMemoryUsageDataPoint(now, executor.TotalOffHeapStorageMemory-used, metadata.AttributeLocationOffHeap, metadata.AttributeStateFree)
+		if peak := executor.PeakMemoryMetrics; peak != nil {
+			s.mb.RecordSparkExecutorMemoryJvmDataPoint(now, peak.JVMHeapMemory, metadata.AttributeLocationOnHeap)
+			s.mb.RecordSparkExecutorMemoryJvmDataPoint(now, peak.JVMOffHeapMemory, metadata.AttributeLocationOffHeap)
+			s.mb.RecordSparkExecutorMemoryExecutionDataPoint(now, peak.OnHeapExecutionMemory, metadata.AttributeLocationOnHeap)
+			s.mb.RecordSparkExecutorMemoryExecutionDataPoint(now, peak.OffHeapExecutionMemory, metadata.AttributeLocationOffHeap)
+			s.mb.RecordSparkExecutorGcOperationsDataPoint(now, peak.MinorGCCount, metadata.AttributeGcTypeMinor)
+			s.mb.RecordSparkExecutorGcOperationsDataPoint(now, peak.MajorGCCount, metadata.AttributeGcTypeMajor)
+			s.mb.RecordSparkExecutorGcDurationDataPoint(now, peak.MinorGCTime, metadata.AttributeGcTypeMinor)
+			s.mb.RecordSparkExecutorGcDurationDataPoint(now, peak.MajorGCTime, metadata.AttributeGcTypeMajor)
+		}
 
 		rb := s.mb.NewResourceBuilder()
 		rb.SetSparkApplicationID(appID)
@@ -5,6 +5,7 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"bytes"
+	"encoding/json"
 	"strconv"
 
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
@@ -58,7 +59,7 @@ type azureLogRecord struct {
 	ResultType        *string      `json:"resultType"`
 	ResultSignature   *string      `json:"resultSignature"`
 	ResultDescription *string      `json:"resultDescription"`
-	DurationMs        *string      `json:"durationMs"`
+	DurationMs        *json.Number `json:"durationMs"`
 	CallerIPAddress   *string      `json:"callerIpAddress"`
 	CorrelationID     *string      `json:"correlationId"`
 	Identity          *interface{} `json:"identity"`
@@ -80,7 +81,8 @@ func newAzureResourceLogsUnmarshaler(buildInfo component.BuildInfo, logger *zap.
 // an OpenTelemetry plog.Logs object. The data in the Azure
 // log record appears as fields and attributes in the
 // OpenTelemetry representation; the bodies of the
-// OpenTelemetry log records are empty.
+// OpenTelemetry log records are empty. The log records
+// are grouped by the Azure resource they originate from.
 func (r azureResourceLogsUnmarshaler) UnmarshalLogs(event *eventhub.Event) (plog.Logs, error) {
 
 	l := plog.NewLogs()
@@ -92,21 +94,19 @@ func (r azureResourceLogsUnmarshaler) UnmarshalLogs(event *eventhub.Event) (plog
 		return l, err
 	}
 
-	resourceLogs := l.ResourceLogs().AppendEmpty()
-	scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
-	scopeLogs.Scope().SetName(receiverScopeName)
-	scopeLogs.Scope().SetVersion(r.buildInfo.Version)
-	logRecords := scopeLogs.LogRecords()
-
-	resourceID := ""
+	resourceLogRecords := map[string]plog.LogRecordSlice{}
 	for _, azureLog := range azureLogs.Records {
-		resourceID = azureLog.ResourceID
 		nanos, err := asTimestamp(azureLog.Time)
 		if err != nil {
 			r.logger.Warn("Invalid Timestamp", zap.String("time", azureLog.Time))
 			continue
 		}
 
+		logRecords, ok := resourceLogRecords[azureLog.ResourceID]
+		if !ok {
+			logRecords = r.appendResourceLogs(l, azureLog.ResourceID)
+			resourceLogRecords[azureLog.ResourceID] = logRecords
+		}
 		lr := logRecords.AppendEmpty()
 
 		lr.SetTimestamp(nanos)
@@ -120,18 +120,25 @@ func (r azureResourceLogsUnmarshaler) UnmarshalLogs(event *eventhub.Event) (plog
 		if err := lr.Attributes().FromRaw(extractRawAttributes(azureLog)); err != nil {
 			return l, err
 		}
-
-		// The Azure resource ID will be pulled into a common resource attribute.
-		// This implementation assumes that a single log message from Azure will
-		// contain ONLY logs from a single resource.
-		if resourceID != "" {
-			resourceLogs.Resource().Attributes().PutStr(azureResourceID, resourceID)
-		}
 	}
 
 	return l, nil
 }
 
+// appendResourceLogs appends the resource logs of the log records of
+// the Azure resource, whose ID is pulled into a resource attribute,
+// and returns its log records.
+func (r azureResourceLogsUnmarshaler) appendResourceLogs(l plog.Logs, resourceID string) plog.LogRecordSlice {
+	resourceLogs := l.ResourceLogs().AppendEmpty()
+	if resourceID != "" {
+		resourceLogs.Resource().Attributes().PutStr(azureResourceID, resourceID)
+	}
+	scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName(receiverScopeName)
+	scopeLogs.Scope().SetVersion(r.buildInfo.Version)
+	return scopeLogs.LogRecords()
+}
+
 // asTimestamp will parse an ISO8601 string into an OpenTelemetry
 // nanosecond timestamp. If the string cannot be parsed, it will
 // return zero and the error.
@@ -148,6 +155,8 @@ func asTimestamp(s string) (pcommon.Timestamp, error) {
 // valid, then the 'Unspecified' value is returned.
 func asSeverity(s string) plog.SeverityNumber {
 	switch s {
+	case "Verbose":
+		return plog.SeverityNumberDebug
 	case "Informational":
 		return plog.SeverityNumberInfo
 	case "Warning":
@@ -171,8 +180,9 @@ func extractRawAttributes(log azureLogRecord) map[string]interface{} {
 
 	attrs[azureCategory] = log.Category
 	setIf(attrs, azureCorrelationID, log.CorrelationID)
+	// the duration is either a JSON number or a string, depending on the category
 	if log.DurationMs != nil {
-		duration, err := strconv.ParseInt(*log.DurationMs, 10, 64)
+		duration, err := strconv.ParseInt(string(*log.DurationMs), 10, 64)
 		if err == nil {
 			attrs[azureDuration] = duration
 		}
@@ -4,6 +4,7 @@
 package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -80,6 +81,7 @@ func TestAsTimestamp(t *testing.T) {
 
 func TestAsSeverity(t *testing.T) {
 	tests := map[string]plog.SeverityNumber{
+		"Verbose":       plog.SeverityNumberDebug,
 		"Informational": plog.SeverityNumberInfo,
 		"Warning":       plog.SeverityNumberWarn,
 		"Error":         plog.SeverityNumberError,
@@ -116,8 +118,8 @@ func TestSetIf(t *testing.T) {
 }
 
 func TestExtractRawAttributes(t *testing.T) {
-	badDuration := "invalid"
-	goodDuration := "1234"
+	badDuration := json.Number("invalid")
+	goodDuration := json.Number("1234")
 
 	tenantID := "tenant.id"
 	operationVersion := "operation.version"
@@ -252,6 +254,22 @@ func TestUnmarshalLogs(t *testing.T) {
 	lr = scopeLogs.LogRecords().AppendEmpty()
 	maximumLogRecord.CopyTo(lr)
 
+	// the log records are grouped by resource
+	expectedMultipleResources := plog.NewLogs()
+	for _, resourceID := range []string{"/RESOURCE_ID", "/OTHER_RESOURCE_ID"} {
+		resourceLogs = expectedMultipleResources.ResourceLogs().AppendEmpty()
+		resourceLogs.Resource().Attributes().PutStr(azureResourceID, resourceID)
+		scopeLogs = resourceLogs.ScopeLogs().AppendEmpty()
+		scopeLogs.Scope().SetName("otelcol/azureeventhubreceiver")
+		scopeLogs.Scope().SetVersion(testBuildInfo.Version)
+	}
+	logRecords = expectedMultipleResources.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	minimumLogRecord.CopyTo(logRecords.AppendEmpty())
+	minimumLogRecord.CopyTo(logRecords.AppendEmpty())
+	lr = expectedMultipleResources.ResourceLogs().At(1).ScopeLogs().At(0).LogRecords().AppendEmpty()
+	minimumLogRecord.CopyTo(lr)
+	lr.Attributes().PutInt(azureDuration, 1234)
+
 	tests := []struct {
 		file     string
 		expected plog.Logs
@@ -268,6 +286,10 @@ func TestUnmarshalLogs(t *testing.T) {
 			file:     "log-maximum.json",
 			expected: expectedMaximum,
 		},
+		{
+			file:     "log-multiple-resources.json",
+			expected: expectedMultipleResources,
+		},
 	}
 
 	sut := newAzureResourceLogsUnmarshaler(testBuildInfo, nil)
@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// partitionBalancer receives a fair share of the partitions of the Event Hub, the receivers
+// sharing the checkpoint store claiming the partitions without owner, and stealing the
+// partitions of the receivers owning more than their share. It also persists the
+// checkpoints of the owned partitions in the checkpoint store.
+type partitionBalancer struct {
+	store          partitionStore
+	ownerID        string
+	partitionIDs   []string
+	updateInterval time.Duration
+	logger         *zap.Logger
+	// receive starts receiving the events of the partition from its checkpoint
+	receive func(ctx context.Context, partitionID string) (listerHandleWrapper, error)
+
+	// mu guards the owned partitions while they are balanced
+	mu    sync.Mutex
+	owned map[string]listerHandleWrapper
+
+	// checkpointsMu guards the checkpoints of the received events not written yet,
+	// updated by the receivers of the partitions
+	checkpointsMu sync.Mutex
+	checkpoints   map[string]persist.Checkpoint
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// partitionBalancer is the checkpoint persister of the Event Hub
+var _ persist.CheckpointPersister = (*partitionBalancer)(nil)
+
+func newPartitionBalancer(store partitionStore, ownerID string, updateInterval time.Duration, logger *zap.Logger) *partitionBalancer {
+	return &partitionBalancer{
+		store:          store,
+		ownerID:        ownerID,
+		updateInterval: updateInterval,
+		logger:         logger,
+		owned:          map[string]listerHandleWrapper{},
+		checkpoints:    map[string]persist.Checkpoint{},
+	}
+}
+
+// start balances the partitions until stop is called.
+func (b *partitionBalancer) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ticker := time.NewTicker(b.updateInterval)
+		defer ticker.Stop()
+		for {
+			b.balance(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// stop stops receiving the owned partitions, whose checkpoints are written and leases
+// released for the other receivers to claim them.
+func (b *partitionBalancer) stop(ctx context.Context) error {
+	if b.cancel != nil {
+		b.cancel()
+	}
+	b.wg.Wait()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var errs error
+	for partitionID := range b.owned {
+		errs = multierr.Append(errs, b.stopReceiving(ctx, partitionID, true))
+	}
+	return errs
+}
+
+// balance renews the leases of the owned partitions, writes their checkpoints, and claims
+// the partitions the receiver needs to own its share of the partitions.
+func (b *partitionBalancer) balance(ctx context.Context) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for partitionID, handle := range b.owned {
+		select {
+		case <-handle.Done():
+			// the partition is released to be claimed again
+			b.logger.Warn("Stopped receiving Event Hub partition", zap.String("partition", partitionID), zap.Error(handle.Err()))
+			_ = b.stopReceiving(ctx, partitionID, true)
+			continue
+		default:
+		}
+		if err := b.store.renew(ctx, partitionID); err != nil {
+			b.logger.Info("Lost the ownership of Event Hub partition", zap.String("partition", partitionID), zap.Error(err))
+			_ = b.stopReceiving(ctx, partitionID, false)
+		}
+	}
+	if err := b.writeCheckpoints(ctx); err != nil {
+		b.logger.Warn("Failed to write Event Hub checkpoints", zap.Error(err))
+	}
+
+	ownership, err := b.store.ownership(ctx)
+	if err != nil {
+		b.logger.Warn("Failed to read the ownership of the Event Hub partitions", zap.Error(err))
+		return
+	}
+
+	// the owners include the receiver, even when it owns no partition yet
+	counts := map[string]int{b.ownerID: len(b.owned)}
+	ownedBy := map[string][]string{}
+	for _, partitionID := range b.partitionIDs {
+		owner := ownership[partitionID]
+		if owner == "" || owner == b.ownerID {
+			continue
+		}
+		counts[owner]++
+		ownedBy[owner] = append(ownedBy[owner], partitionID)
+	}
+	minShare := len(b.partitionIDs) / len(counts)
+	maxShare := minShare
+	if len(b.partitionIDs)%len(counts) != 0 {
+		maxShare++
+	}
+
+	// the partitions are claimed in random order so the receivers starting together
+	// don't compete for the same partitions
+	for _, i := range rand.Perm(len(b.partitionIDs)) {
+		if len(b.owned) >= maxShare {
+			break
+		}
+		partitionID := b.partitionIDs[i]
+		if _, ok := b.owned[partitionID]; ok || ownership[partitionID] != "" {
+			continue
+		}
+		b.claim(ctx, partitionID, false)
+	}
+
+	if len(b.owned) >= minShare {
+		return
+	}
+	// a single partition is stolen at a time, from the receiver owning the most partitions
+	victim, most := "", minShare
+	for owner, count := range counts {
+		if owner != b.ownerID && count > most {
+			victim, most = owner, count
+		}
+	}
+	if victim != "" {
+		partitions := ownedBy[victim]
+		b.claim(ctx, partitions[rand.Intn(len(partitions))], true)
+	}
+}
+
+// claim takes the ownership of the partition and starts receiving its events.
+func (b *partitionBalancer) claim(ctx context.Context, partitionID string, steal bool) {
+	if err := b.store.claim(ctx, partitionID, steal); err != nil {
+		// the partition was claimed by another receiver
+		b.logger.Debug("Failed to claim Event Hub partition", zap.String("partition", partitionID), zap.Error(err))
+		return
+	}
+	handle, err := b.receive(ctx, partitionID)
+	if err != nil {
+		b.logger.Error("Failed to receive Event Hub partition", zap.String("partition", partitionID), zap.Error(err))
+		if err = b.store.release(ctx, partitionID); err != nil {
+			b.logger.Debug("Failed to release Event Hub partition", zap.String("partition", partitionID), zap.Error(err))
+		}
+		return
+	}
+	b.logger.Info("Claimed Event Hub partition", zap.String("partition", partitionID), zap.Bool("stolen", steal))
+	b.owned[partitionID] = handle
+}
+
+// stopReceiving stops receiving the events of the partition. Its checkpoint is written and
+// its lease released when release is set, the lease being lost otherwise.
+func (b *partitionBalancer) stopReceiving(ctx context.Context, partitionID string, release bool) error {
+	handle := b.owned[partitionID]
+	delete(b.owned, partitionID)
+	err := handle.Close(ctx)
+
+	b.checkpointsMu.Lock()
+	checkpoint, ok := b.checkpoints[partitionID]
+	delete(b.checkpoints, partitionID)
+	b.checkpointsMu.Unlock()
+
+	if !release {
+		return err
+	}
+	if ok {
+		err = multierr.Append(err, b.store.writeCheckpoint(ctx, partitionID, checkpoint))
+	}
+	return multierr.Append(err, b.store.release(ctx, partitionID))
+}
+
+// writeCheckpoints writes the checkpoints of the events received since the last update.
+func (b *partitionBalancer) writeCheckpoints(ctx context.Context) error {
+	b.checkpointsMu.Lock()
+	checkpoints := b.checkpoints
+	b.checkpoints = map[string]persist.Checkpoint{}
+	b.checkpointsMu.Unlock()
+
+	var errs error
+	for partitionID, checkpoint := range checkpoints {
+		if _, ok := b.owned[partitionID]; !ok {
+			continue
+		}
+		errs = multierr.Append(errs, b.store.writeCheckpoint(ctx, partitionID, checkpoint))
+	}
+	return errs
+}
+
+// Write records the checkpoint of the last event received from the partition, written to
+// the checkpoint store at the next update.
+func (b *partitionBalancer) Write(_, _, _, partitionID string, checkpoint persist.Checkpoint) error {
+	b.checkpointsMu.Lock()
+	defer b.checkpointsMu.Unlock()
+	b.checkpoints[partitionID] = checkpoint
+	return nil
+}
+
+// Read returns the checkpoint the partition is received from, its last event received or
+// the end of the stream for the partitions without checkpoint.
+func (b *partitionBalancer) Read(_, _, _, partitionID string) (persist.Checkpoint, error) {
+	b.checkpointsMu.Lock()
+	checkpoint, ok := b.checkpoints[partitionID]
+	b.checkpointsMu.Unlock()
+	if ok {
+		return checkpoint, nil
+	}
+
+	checkpoint, ok, err := b.store.readCheckpoint(context.Background(), partitionID)
+	if err != nil {
+		return persist.Checkpoint{}, err
+	}
+	if !ok {
+		return persist.NewCheckpointFromEndOfStream(), nil
+	}
+	return checkpoint, nil
+}
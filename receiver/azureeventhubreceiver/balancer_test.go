@@ -0,0 +1,223 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeLeases are the leases and checkpoints of the partitions shared by the receivers.
+type fakeLeases struct {
+	mu          sync.Mutex
+	owners      map[string]string
+	checkpoints map[string]persist.Checkpoint
+}
+
+func newFakeLeases() *fakeLeases {
+	return &fakeLeases{
+		owners:      map[string]string{},
+		checkpoints: map[string]persist.Checkpoint{},
+	}
+}
+
+// fakePartitionStore is the partition store of a receiver.
+type fakePartitionStore struct {
+	leases  *fakeLeases
+	ownerID string
+}
+
+var errLeaseMismatch = errors.New("lease mismatch")
+
+func (s *fakePartitionStore) ownership(_ context.Context) (map[string]string, error) {
+	s.leases.mu.Lock()
+	defer s.leases.mu.Unlock()
+	owners := map[string]string{}
+	for partitionID, owner := range s.leases.owners {
+		owners[partitionID] = owner
+	}
+	return owners, nil
+}
+
+func (s *fakePartitionStore) claim(_ context.Context, partitionID string, steal bool) error {
+	s.leases.mu.Lock()
+	defer s.leases.mu.Unlock()
+	if owner, ok := s.leases.owners[partitionID]; ok && !steal && owner != s.ownerID {
+		return errLeaseMismatch
+	}
+	s.leases.owners[partitionID] = s.ownerID
+	return nil
+}
+
+func (s *fakePartitionStore) renew(_ context.Context, partitionID string) error {
+	s.leases.mu.Lock()
+	defer s.leases.mu.Unlock()
+	if s.leases.owners[partitionID] != s.ownerID {
+		return errLeaseMismatch
+	}
+	return nil
+}
+
+func (s *fakePartitionStore) release(_ context.Context, partitionID string) error {
+	s.leases.mu.Lock()
+	defer s.leases.mu.Unlock()
+	if s.leases.owners[partitionID] != s.ownerID {
+		return errLeaseMismatch
+	}
+	delete(s.leases.owners, partitionID)
+	return nil
+}
+
+func (s *fakePartitionStore) readCheckpoint(_ context.Context, partitionID string) (persist.Checkpoint, bool, error) {
+	s.leases.mu.Lock()
+	defer s.leases.mu.Unlock()
+	checkpoint, ok := s.leases.checkpoints[partitionID]
+	return checkpoint, ok, nil
+}
+
+func (s *fakePartitionStore) writeCheckpoint(_ context.Context, partitionID string, checkpoint persist.Checkpoint) error {
+	s.leases.mu.Lock()
+	defer s.leases.mu.Unlock()
+	if s.leases.owners[partitionID] != s.ownerID {
+		return errLeaseMismatch
+	}
+	s.leases.checkpoints[partitionID] = checkpoint
+	return nil
+}
+
+type fakeListenerHandle struct {
+	done   chan struct{}
+	closed bool
+}
+
+func (h *fakeListenerHandle) Done() <-chan struct{} {
+	return h.done
+}
+
+func (h *fakeListenerHandle) Err() error {
+	return nil
+}
+
+func (h *fakeListenerHandle) Close(_ context.Context) error {
+	h.closed = true
+	return nil
+}
+
+func newTestBalancer(leases *fakeLeases, ownerID string, partitionIDs []string) *partitionBalancer {
+	b := newPartitionBalancer(&fakePartitionStore{leases: leases, ownerID: ownerID}, ownerID, time.Second, zap.NewNop())
+	b.partitionIDs = partitionIDs
+	b.receive = func(_ context.Context, _ string) (listerHandleWrapper, error) {
+		return &fakeListenerHandle{done: make(chan struct{})}, nil
+	}
+	return b
+}
+
+func TestPartitionBalancerClaimsFreePartitions(t *testing.T) {
+	leases := newFakeLeases()
+	b := newTestBalancer(leases, "a", []string{"0", "1", "2", "3"})
+
+	b.balance(context.Background())
+	assert.Len(t, b.owned, 4)
+	assert.Equal(t, map[string]string{"0": "a", "1": "a", "2": "a", "3": "a"}, leases.owners)
+}
+
+func TestPartitionBalancerSharesPartitions(t *testing.T) {
+	leases := newFakeLeases()
+	partitionIDs := []string{"0", "1", "2", "3", "4"}
+	a := newTestBalancer(leases, "a", partitionIDs)
+	b := newTestBalancer(leases, "b", partitionIDs)
+
+	a.balance(context.Background())
+	assert.Len(t, a.owned, 5)
+
+	// the partitions are stolen one at a time until they are balanced
+	for i := 0; i < 3; i++ {
+		b.balance(context.Background())
+		a.balance(context.Background())
+	}
+	assert.Len(t, a.owned, 3)
+	assert.Len(t, b.owned, 2)
+	for partitionID := range b.owned {
+		assert.Equal(t, "b", leases.owners[partitionID])
+		assert.NotContains(t, a.owned, partitionID)
+	}
+
+	// the partitions of a stopped receiver are claimed by the others
+	require.NoError(t, a.stop(context.Background()))
+	b.balance(context.Background())
+	assert.Len(t, b.owned, 5)
+}
+
+func TestPartitionBalancerReleasesStoppedPartitions(t *testing.T) {
+	leases := newFakeLeases()
+	b := newTestBalancer(leases, "a", []string{"0"})
+
+	b.balance(context.Background())
+	handle := b.owned["0"].(*fakeListenerHandle)
+	close(handle.done)
+
+	// the partition stopped receiving is released and claimed again
+	b.balance(context.Background())
+	assert.True(t, handle.closed)
+	require.Contains(t, b.owned, "0")
+	assert.NotSame(t, handle, b.owned["0"])
+}
+
+func TestPartitionBalancerCheckpoints(t *testing.T) {
+	leases := newFakeLeases()
+	leases.checkpoints["1"] = persist.NewCheckpoint("100", 10, time.Unix(10, 0))
+	b := newTestBalancer(leases, "a", []string{"0", "1"})
+
+	// the partitions without checkpoint are received from the end of the stream
+	checkpoint, err := b.Read("namespace", "hub", "$Default", "0")
+	require.NoError(t, err)
+	assert.Equal(t, persist.NewCheckpointFromEndOfStream(), checkpoint)
+	checkpoint, err = b.Read("namespace", "hub", "$Default", "1")
+	require.NoError(t, err)
+	assert.Equal(t, "100", checkpoint.Offset)
+
+	b.balance(context.Background())
+	require.NoError(t, b.Write("namespace", "hub", "$Default", "0", persist.NewCheckpoint("5", 1, time.Unix(1, 0))))
+	checkpoint, err = b.Read("namespace", "hub", "$Default", "0")
+	require.NoError(t, err)
+	assert.Equal(t, "5", checkpoint.Offset)
+	assert.NotContains(t, leases.checkpoints, "0")
+
+	// the checkpoints are written at the next update
+	b.balance(context.Background())
+	assert.Equal(t, "5", leases.checkpoints["0"].Offset)
+
+	require.NoError(t, b.Write("namespace", "hub", "$Default", "1", persist.NewCheckpoint("200", 20, time.Unix(20, 0))))
+	require.NoError(t, b.stop(context.Background()))
+	assert.Equal(t, "200", leases.checkpoints["1"].Offset)
+	assert.Empty(t, leases.owners)
+}
+
+func TestMetadataCheckpoint(t *testing.T) {
+	offset, sequenceNumber, enqueueTime := "100", "10", "2023-08-01T07:15:02.5Z"
+	checkpoint, ok, err := metadataCheckpoint(map[string]*string{
+		"Offset":         &offset,
+		"Sequencenumber": &sequenceNumber,
+		"Enqueuetime":    &enqueueTime,
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, persist.NewCheckpoint("100", 10, time.Date(2023, 8, 1, 7, 15, 2, 500000000, time.UTC)), checkpoint)
+
+	_, ok, err = metadataCheckpoint(map[string]*string{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	invalid := "invalid"
+	_, _, err = metadataCheckpoint(map[string]*string{"offset": &offset, "sequencenumber": &invalid})
+	assert.ErrorContains(t, err, "invalid checkpoint sequence number")
+}
@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-amqp-common-go/v4/conn"
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/lease"
+)
+
+// The metadata of the blobs of the partitions, which hold the owner of the partition and
+// its checkpoint.
+const (
+	ownerIDMetadata        = "ownerid"
+	offsetMetadata         = "offset"
+	sequenceNumberMetadata = "sequencenumber"
+	enqueueTimeMetadata    = "enqueuetime"
+)
+
+// partitionStore stores the ownership of the partitions of the Event Hub and their
+// checkpoints, shared by the receivers consuming the Event Hub.
+type partitionStore interface {
+	// ownership returns the owner of each partition having an active lease.
+	ownership(ctx context.Context) (map[string]string, error)
+	// claim takes the ownership of the partition, breaking the lease of its current
+	// owner when steal is set.
+	claim(ctx context.Context, partitionID string, steal bool) error
+	renew(ctx context.Context, partitionID string) error
+	release(ctx context.Context, partitionID string) error
+	// readCheckpoint returns the checkpoint of the partition, and false when the partition
+	// has no checkpoint.
+	readCheckpoint(ctx context.Context, partitionID string) (persist.Checkpoint, bool, error)
+	writeCheckpoint(ctx context.Context, partitionID string, checkpoint persist.Checkpoint) error
+}
+
+// blobPartitionStore stores the ownership and the checkpoint of each partition in a blob,
+// the owner holding the lease of the blob.
+type blobPartitionStore struct {
+	container     *container.Client
+	prefix        string
+	ownerID       string
+	leaseDuration int32
+}
+
+var _ partitionStore = (*blobPartitionStore)(nil)
+
+func newBlobPartitionStore(cfg *CheckpointStoreConfig, connection string, ownerID string) (*blobPartitionStore, error) {
+	parsed, err := conn.ParsedConnectionFromStr(connection)
+	if err != nil {
+		return nil, err
+	}
+	client, err := azblob.NewClientFromConnectionString(string(cfg.ConnectionString), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the checkpoint store client: %w", err)
+	}
+	return &blobPartitionStore{
+		container: client.ServiceClient().NewContainerClient(cfg.Container),
+		// the receivers only consume the default consumer group
+		prefix:        fmt.Sprintf("%s/%s/%s/", strings.ToLower(parsed.Namespace), strings.ToLower(parsed.HubName), strings.ToLower(eventhub.DefaultConsumerGroup)),
+		ownerID:       ownerID,
+		leaseDuration: int32(cfg.leaseDuration() / time.Second),
+	}, nil
+}
+
+func (s *blobPartitionStore) blobClient(partitionID string) *blockblob.Client {
+	return s.container.NewBlockBlobClient(s.prefix + partitionID)
+}
+
+func (s *blobPartitionStore) leaseClient(partitionID string) (*lease.BlobClient, error) {
+	return lease.NewBlobClient(s.blobClient(partitionID), &lease.BlobClientOptions{LeaseID: to.Ptr(s.ownerID)})
+}
+
+func (s *blobPartitionStore) leaseConditions() *blob.AccessConditions {
+	return &blob.AccessConditions{LeaseAccessConditions: &blob.LeaseAccessConditions{LeaseID: to.Ptr(s.ownerID)}}
+}
+
+func (s *blobPartitionStore) ownership(ctx context.Context) (map[string]string, error) {
+	owners := map[string]string{}
+	pager := s.container.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{
+		Prefix:  to.Ptr(s.prefix),
+		Include: container.ListBlobsInclude{Metadata: true},
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil || item.Properties == nil || item.Properties.LeaseState == nil || string(*item.Properties.LeaseState) != "leased" {
+				continue
+			}
+			owners[strings.TrimPrefix(*item.Name, s.prefix)] = metadataValue(item.Metadata, ownerIDMetadata)
+		}
+	}
+	return owners, nil
+}
+
+func (s *blobPartitionStore) claim(ctx context.Context, partitionID string, steal bool) error {
+	lc, err := s.leaseClient(partitionID)
+	if err != nil {
+		return err
+	}
+	if steal {
+		if _, err = lc.BreakLease(ctx, &lease.BlobBreakOptions{BreakPeriod: to.Ptr(int32(0))}); err != nil {
+			return err
+		}
+	}
+	_, err = lc.AcquireLease(ctx, s.leaseDuration, nil)
+	if bloberror.HasCode(err, bloberror.BlobNotFound) {
+		// the blobs of the partitions are created when they are first claimed
+		_, err = s.blobClient(partitionID).Upload(ctx, streaming.NopCloser(bytes.NewReader(nil)), &blockblob.UploadOptions{
+			AccessConditions: &blob.AccessConditions{ModifiedAccessConditions: &blob.ModifiedAccessConditions{IfNoneMatch: to.Ptr(azcore.ETagAny)}},
+		})
+		if err != nil && !bloberror.HasCode(err, bloberror.BlobAlreadyExists) {
+			return err
+		}
+		_, err = lc.AcquireLease(ctx, s.leaseDuration, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	checkpoint, _, err := s.readCheckpoint(ctx, partitionID)
+	if err != nil {
+		return err
+	}
+	return s.writeCheckpoint(ctx, partitionID, checkpoint)
+}
+
+func (s *blobPartitionStore) renew(ctx context.Context, partitionID string) error {
+	lc, err := s.leaseClient(partitionID)
+	if err != nil {
+		return err
+	}
+	_, err = lc.RenewLease(ctx, nil)
+	return err
+}
+
+func (s *blobPartitionStore) release(ctx context.Context, partitionID string) error {
+	lc, err := s.leaseClient(partitionID)
+	if err != nil {
+		return err
+	}
+	_, err = lc.ReleaseLease(ctx, nil)
+	return err
+}
+
+func (s *blobPartitionStore) readCheckpoint(ctx context.Context, partitionID string) (persist.Checkpoint, bool, error) {
+	props, err := s.blobClient(partitionID).GetProperties(ctx, nil)
+	if bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return persist.Checkpoint{}, false, nil
+	}
+	if err != nil {
+		return persist.Checkpoint{}, false, err
+	}
+	return metadataCheckpoint(props.Metadata)
+}
+
+func (s *blobPartitionStore) writeCheckpoint(ctx context.Context, partitionID string, checkpoint persist.Checkpoint) error {
+	metadata := map[string]*string{ownerIDMetadata: to.Ptr(s.ownerID)}
+	if checkpoint.Offset != "" {
+		metadata[offsetMetadata] = to.Ptr(checkpoint.Offset)
+		metadata[sequenceNumberMetadata] = to.Ptr(strconv.FormatInt(checkpoint.SequenceNumber, 10))
+		metadata[enqueueTimeMetadata] = to.Ptr(checkpoint.EnqueueTime.UTC().Format(time.RFC3339Nano))
+	}
+	_, err := s.blobClient(partitionID).SetMetadata(ctx, metadata, &blob.SetMetadataOptions{AccessConditions: s.leaseConditions()})
+	return err
+}
+
+// metadataCheckpoint returns the checkpoint stored in the blob metadata.
+func metadataCheckpoint(metadata map[string]*string) (persist.Checkpoint, bool, error) {
+	offset := metadataValue(metadata, offsetMetadata)
+	if offset == "" {
+		return persist.Checkpoint{}, false, nil
+	}
+	checkpoint := persist.Checkpoint{Offset: offset}
+	var err error
+	if v := metadataValue(metadata, sequenceNumberMetadata); v != "" {
+		if checkpoint.SequenceNumber, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return persist.Checkpoint{}, false, fmt.Errorf("invalid checkpoint sequence number %q: %w", v, err)
+		}
+	}
+	if v := metadataValue(metadata, enqueueTimeMetadata); v != "" {
+		if checkpoint.EnqueueTime, err = time.Parse(time.RFC3339Nano, v); err != nil {
+			return persist.Checkpoint{}, false, fmt.Errorf("invalid checkpoint enqueue time %q: %w", v, err)
+		}
+	}
+	return checkpoint, true, nil
+}
+
+// metadataValue returns the value of the metadata key, whose case is not preserved by the
+// HTTP headers of the blob properties.
+func metadataValue(metadata map[string]*string, key string) string {
+	for k, v := range metadata {
+		if strings.EqualFold(k, key) && v != nil {
+			return *v
+		}
+	}
+	return ""
+}
@@ -6,9 +6,11 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-amqp-common-go/v4/conn"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
 )
 
 type logFormat string
@@ -19,17 +21,57 @@ const (
 	azureLogFormat   logFormat = "azure"
 )
 
+const (
+	defaultLeaseDuration  = time.Minute
+	defaultUpdateInterval = 10 * time.Second
+
+	// the duration of the blob leases must be between 15 and 60 seconds
+	minLeaseDuration = 15 * time.Second
+	maxLeaseDuration = time.Minute
+)
+
 var (
 	validFormats         = []logFormat{defaultLogFormat, rawLogFormat, azureLogFormat}
 	errMissingConnection = errors.New("missing connection")
 )
 
 type Config struct {
-	Connection string        `mapstructure:"connection"`
-	Partition  string        `mapstructure:"partition"`
-	Offset     string        `mapstructure:"offset"`
-	StorageID  *component.ID `mapstructure:"storage"`
-	Format     string        `mapstructure:"format"`
+	Connection      string                 `mapstructure:"connection"`
+	Partition       string                 `mapstructure:"partition"`
+	Offset          string                 `mapstructure:"offset"`
+	StorageID       *component.ID          `mapstructure:"storage"`
+	Format          string                 `mapstructure:"format"`
+	CheckpointStore *CheckpointStoreConfig `mapstructure:"checkpoint_store"`
+}
+
+// CheckpointStoreConfig configures the Azure Blob Storage container where the receivers
+// sharing the Event Hub store the ownership of the partitions and their checkpoints, so
+// the partitions are balanced between the receivers.
+type CheckpointStoreConfig struct {
+	// ConnectionString is the connection string of the storage account.
+	ConnectionString configopaque.String `mapstructure:"connection_string"`
+	// Container is the container of the ownership and checkpoint blobs, which must exist.
+	Container string `mapstructure:"container"`
+	// LeaseDuration is the duration after which the partitions of a receiver that stopped
+	// renewing its leases are claimed by the other receivers, 1 minute by default.
+	LeaseDuration time.Duration `mapstructure:"lease_duration"`
+	// UpdateInterval is the interval at which the leases are renewed, the partitions are
+	// balanced and the checkpoints are written, 10 seconds by default.
+	UpdateInterval time.Duration `mapstructure:"update_interval"`
+}
+
+func (c *CheckpointStoreConfig) leaseDuration() time.Duration {
+	if c.LeaseDuration == 0 {
+		return defaultLeaseDuration
+	}
+	return c.LeaseDuration
+}
+
+func (c *CheckpointStoreConfig) updateInterval() time.Duration {
+	if c.UpdateInterval == 0 {
+		return defaultUpdateInterval
+	}
+	return c.UpdateInterval
 }
 
 func isValidFormat(format string) bool {
@@ -52,5 +94,24 @@ func (config *Config) Validate() error {
 	if !isValidFormat(config.Format) {
 		return fmt.Errorf("invalid format; must be one of %#v", validFormats)
 	}
+	if config.CheckpointStore != nil {
+		return config.CheckpointStore.validate(config)
+	}
+	return nil
+}
+
+func (c *CheckpointStoreConfig) validate(config *Config) error {
+	if config.Partition != "" || config.StorageID != nil {
+		return errors.New("checkpoint_store cannot be used with partition or storage")
+	}
+	if c.ConnectionString == "" || c.Container == "" {
+		return errors.New("checkpoint_store requires a connection_string and a container")
+	}
+	if leaseDuration := c.leaseDuration(); leaseDuration < minLeaseDuration || leaseDuration > maxLeaseDuration {
+		return fmt.Errorf("checkpoint_store lease_duration must be between %v and %v", minLeaseDuration, maxLeaseDuration)
+	}
+	if updateInterval := c.updateInterval(); updateInterval <= 0 || 2*updateInterval > c.leaseDuration() {
+		return errors.New("checkpoint_store update_interval must be positive and at most half of lease_duration")
+	}
 	return nil
 }
@@ -6,6 +6,7 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,7 +27,7 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, cfg)
 
-	assert.Equal(t, len(cfg.Receivers), 2)
+	assert.Equal(t, len(cfg.Receivers), 3)
 
 	r0 := cfg.Receivers[component.NewID(metadata.Type)]
 	assert.Equal(t, "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName", r0.(*Config).Connection)
@@ -39,6 +40,14 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, "1234-5566", r1.(*Config).Offset)
 	assert.Equal(t, "foo", r1.(*Config).Partition)
 	assert.Equal(t, rawLogFormat, logFormat(r1.(*Config).Format))
+
+	r2 := cfg.Receivers[component.NewIDWithName(metadata.Type, "checkpoint")]
+	assert.Equal(t, &CheckpointStoreConfig{
+		ConnectionString: "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=a2V5;EndpointSuffix=core.windows.net",
+		Container:        "checkpoints",
+		LeaseDuration:    30 * time.Second,
+		UpdateInterval:   5 * time.Second,
+	}, r2.(*Config).CheckpointStore)
 }
 
 func TestMissingConnection(t *testing.T) {
@@ -71,3 +80,53 @@ func TestInvalidFormat(t *testing.T) {
 	err := component.ValidateConfig(cfg)
 	assert.ErrorContains(t, err, "invalid format; must be one of")
 }
+
+func TestInvalidCheckpointStore(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(cfg *Config)
+		expected string
+	}{
+		{
+			name: "partition",
+			modify: func(cfg *Config) {
+				cfg.Partition = "foo"
+			},
+			expected: "checkpoint_store cannot be used with partition or storage",
+		},
+		{
+			name: "missing container",
+			modify: func(cfg *Config) {
+				cfg.CheckpointStore.Container = ""
+			},
+			expected: "checkpoint_store requires a connection_string and a container",
+		},
+		{
+			name: "lease duration",
+			modify: func(cfg *Config) {
+				cfg.CheckpointStore.LeaseDuration = 5 * time.Second
+			},
+			expected: "checkpoint_store lease_duration must be between 15s and 1m0s",
+		},
+		{
+			name: "update interval",
+			modify: func(cfg *Config) {
+				cfg.CheckpointStore.UpdateInterval = 40 * time.Second
+			},
+			expected: "checkpoint_store update_interval must be positive and at most half of lease_duration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Connection = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName"
+			cfg.CheckpointStore = &CheckpointStoreConfig{
+				ConnectionString: "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=a2V5;EndpointSuffix=core.windows.net",
+				Container:        "checkpoints",
+			}
+			require.NoError(t, component.ValidateConfig(cfg))
+			tt.modify(cfg)
+			assert.EqualError(t, component.ValidateConfig(cfg), tt.expected)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
@@ -46,6 +47,7 @@ func (h *hubWrapperImpl) Close(ctx context.Context) error {
 type listerHandleWrapper interface {
 	Done() <-chan struct{}
 	Err() error
+	Close(ctx context.Context) error
 }
 
 type eventhubHandler struct {
@@ -53,6 +55,9 @@ type eventhubHandler struct {
 	dataConsumer dataConsumer
 	config       *Config
 	settings     receiver.CreateSettings
+	// store is the partition store of the checkpoint store, set manually for testing
+	store    partitionStore
+	balancer *partitionBalancer
 }
 
 // Implement eventHandler Interface
@@ -60,6 +65,10 @@ var _ eventHandler = (*eventhubHandler)(nil)
 
 func (h *eventhubHandler) run(ctx context.Context, host component.Host) error {
 
+	if h.config.CheckpointStore != nil {
+		return h.runWithCheckpointStore(ctx)
+	}
+
 	storageClient, err := adapter.GetStorageClient(ctx, host, h.config.StorageID, h.settings.ID)
 	if err != nil {
 		h.settings.Logger.Debug("Error connecting to Storage", zap.Error(err))
@@ -129,6 +138,46 @@ func (h *eventhubHandler) run(ctx context.Context, host component.Host) error {
 	return nil
 }
 
+// runWithCheckpointStore receives the share of the partitions of the receiver, balanced
+// with the other receivers sharing the checkpoint store.
+func (h *eventhubHandler) runWithCheckpointStore(ctx context.Context) error {
+	ownerID := uuid.NewString()
+	if h.store == nil { // set manually for testing.
+		store, err := newBlobPartitionStore(h.config.CheckpointStore, h.config.Connection, ownerID)
+		if err != nil {
+			h.settings.Logger.Debug("Error connecting to the checkpoint store", zap.Error(err))
+			return err
+		}
+		h.store = store
+	}
+	balancer := newPartitionBalancer(h.store, ownerID, h.config.CheckpointStore.updateInterval(), h.settings.Logger)
+
+	if h.hub == nil { // set manually for testing.
+		hub, err := eventhub.NewHubFromConnectionString(h.config.Connection, eventhub.HubWithOffsetPersistence(balancer))
+		if err != nil {
+			h.settings.Logger.Debug("Error connecting to Event Hub", zap.Error(err))
+			return err
+		}
+		h.hub = &hubWrapperImpl{
+			hub: hub,
+		}
+	}
+
+	runtimeInfo, err := h.hub.GetRuntimeInformation(ctx)
+	if err != nil {
+		h.settings.Logger.Debug("Error getting Runtime Information", zap.Error(err))
+		return err
+	}
+	balancer.partitionIDs = runtimeInfo.PartitionIDs
+	// the partitions are received from their checkpoint
+	balancer.receive = func(ctx context.Context, partitionID string) (listerHandleWrapper, error) {
+		return h.hub.Receive(ctx, partitionID, h.newMessageHandler)
+	}
+	h.balancer = balancer
+	balancer.start()
+	return nil
+}
+
 func (h *eventhubHandler) setUpOnePartition(ctx context.Context, partitionID string, applyOffset bool) error {
 
 	offsetOption := eventhub.ReceiveWithLatestOffset()
@@ -163,6 +212,12 @@ func (h *eventhubHandler) newMessageHandler(ctx context.Context, event *eventhub
 
 func (h *eventhubHandler) close(ctx context.Context) error {
 
+	if h.balancer != nil {
+		if err := h.balancer.stop(ctx); err != nil {
+			h.settings.Logger.Warn("Error releasing Event Hub partitions", zap.Error(err))
+		}
+		h.balancer = nil
+	}
 	if h.hub != nil {
 		err := h.hub.Close(ctx)
 		if err != nil {
@@ -56,6 +56,10 @@ func (m mockListenerHandleWrapper) Err() error {
 	return nil
 }
 
+func (m mockListenerHandleWrapper) Close(_ context.Context) error {
+	return nil
+}
+
 type mockDataConsumer struct {
 	logsUnmarshaler  eventLogsUnmarshaler
 	nextLogsConsumer consumer.Logs
@@ -102,6 +106,38 @@ func TestEventhubHandler_Start(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestEventhubHandler_StartWithCheckpointStore(t *testing.T) {
+
+	config := createDefaultConfig()
+	config.(*Config).Connection = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName"
+	config.(*Config).CheckpointStore = &CheckpointStoreConfig{
+		ConnectionString: "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=a2V5;EndpointSuffix=core.windows.net",
+		Container:        "checkpoints",
+	}
+
+	leases := newFakeLeases()
+	ehHandler := &eventhubHandler{
+		settings:     receivertest.NewNopCreateSettings(),
+		dataConsumer: &mockDataConsumer{},
+		config:       config.(*Config),
+		store:        &fakePartitionStore{leases: leases, ownerID: "receiver"},
+	}
+	ehHandler.hub = &mockHubWrapper{}
+
+	err := ehHandler.run(context.Background(), componenttest.NewNopHost())
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		leases.mu.Lock()
+		defer leases.mu.Unlock()
+		return len(leases.owners) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// the partitions are released when the receiver stops
+	err = ehHandler.close(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, leases.owners)
+}
+
 func TestEventhubHandler_newMessageHandler(t *testing.T) {
 
 	config := createDefaultConfig()
@@ -8,11 +8,13 @@ import (
 	"errors"
 	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azuremonitorreceiver/internal/metadata"
 )
 
@@ -22,12 +24,18 @@ const (
 
 var errConfigNotAzureMonitor = errors.New("Config was not a Azure Monitor receiver config")
 
+// errorReporters shares the scrape error reporter of a receiver between its metrics and logs
+// pipelines, so that errors of the metrics scraper can be sent as log records.
+var errorReporters = sharedcomponent.NewSharedComponents()
+
 // NewFactory creates a new receiver factory
 func NewFactory() receiver.Factory {
+	_ = view.Register(MetricViews()...)
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -50,11 +58,36 @@ func createMetricsReceiver(_ context.Context, params receiver.CreateSettings, rC
 		return nil, errConfigNotAzureMonitor
 	}
 
+	reporter := errorReporters.GetOrAdd(cfg, func() component.Component {
+		return newScrapeErrorReporter(cfg, params.ID, params.Logger)
+	})
+
 	azureScraper := newScraper(cfg, params)
-	scraper, err := scraperhelper.NewScraper(metadata.Type, azureScraper.scrape, scraperhelper.WithStart(azureScraper.start))
+	azureScraper.errorReporter = reporter.Unwrap().(*scrapeErrorReporter)
+	scraper, err := scraperhelper.NewScraper(
+		metadata.Type,
+		azureScraper.scrape,
+		scraperhelper.WithStart(azureScraper.start),
+		scraperhelper.WithShutdown(reporter.Shutdown),
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	return scraperhelper.NewScraperControllerReceiver(&cfg.ScraperControllerSettings, params, consumer, scraperhelper.AddScraper(scraper))
 }
+
+// createLogsReceiver creates a receiver which sends the errors returned by Azure while scraping
+// metrics as log records. It must share its ID with a receiver in a metrics pipeline.
+func createLogsReceiver(_ context.Context, params receiver.CreateSettings, rConf component.Config, consumer consumer.Logs) (receiver.Logs, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotAzureMonitor
+	}
+
+	reporter := errorReporters.GetOrAdd(cfg, func() component.Component {
+		return newScrapeErrorReporter(cfg, params.ID, params.Logger)
+	})
+	reporter.Unwrap().(*scrapeErrorReporter).setLogs(consumer)
+	return reporter, nil
+}
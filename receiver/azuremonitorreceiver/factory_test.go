@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azuremonitorreceiver/internal/metadata"
 )
 
@@ -76,6 +77,45 @@ func TestNewFactory(t *testing.T) {
 				require.ErrorIs(t, err, errConfigNotAzureMonitor)
 			},
 		},
+		{
+			desc: "creates a new factory and CreateLogsReceiver shares the error reporter of the metrics receiver",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+				cfg := factory.CreateDefaultConfig()
+				_, err := factory.CreateMetricsReceiver(
+					context.Background(),
+					receivertest.NewNopCreateSettings(),
+					cfg,
+					consumertest.NewNop(),
+				)
+				require.NoError(t, err)
+
+				sink := new(consumertest.LogsSink)
+				logs, err := factory.CreateLogsReceiver(
+					context.Background(),
+					receivertest.NewNopCreateSettings(),
+					cfg,
+					sink,
+				)
+				require.NoError(t, err)
+				require.Equal(t, errorReporters.GetOrAdd(cfg, nil), logs)
+				require.Equal(t, sink, logs.(*sharedcomponent.SharedComponent).Unwrap().(*scrapeErrorReporter).logs)
+				require.NoError(t, logs.Shutdown(context.Background()))
+			},
+		},
+		{
+			desc: "creates a new factory and CreateLogsReceiver returns error with incorrect config",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+				_, err := factory.CreateLogsReceiver(
+					context.Background(),
+					receivertest.NewNopCreateSettings(),
+					nil,
+					consumertest.NewNop(),
+				)
+				require.ErrorIs(t, err, errConfigNotAzureMonitor)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -8,5 +8,6 @@ import (
 
 const (
 	Type             = "azuremonitor"
+	LogsStability    = component.StabilityLevelDevelopment
 	MetricsStability = component.StabilityLevelDevelopment
 )
@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuremonitorreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azuremonitorreceiver"
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+const (
+	operationListResources          = "list_resources"
+	operationListMetricsDefinitions = "list_metrics_definitions"
+	operationListMetricsValues      = "list_metrics_values"
+
+	errorTypeAuthorization = "authorization"
+	errorTypeThrottling    = "throttling"
+	errorTypeNotFound      = "not_found"
+	errorTypeServer        = "server"
+	errorTypeOther         = "other"
+
+	attributeResourceID = "azuremonitor.resource_id"
+	attributeNamespace  = "azuremonitor.namespace"
+	attributeOperation  = "azuremonitor.operation"
+	attributeErrorType  = "azuremonitor.error.type"
+	attributeErrorCode  = "azuremonitor.error.code"
+	attributeStatusCode = "http.status_code"
+)
+
+var (
+	mScrapeErrors = stats.Int64("azuremonitor_scrape_errors", "Number of errors returned by Azure while scraping", stats.UnitDimensionless)
+
+	receiverTagKey   = tag.MustNewKey("receiver")
+	namespaceTagKey  = tag.MustNewKey("namespace")
+	operationTagKey  = tag.MustNewKey("operation")
+	errorTypeTagKey  = tag.MustNewKey("error_type")
+	statusCodeTagKey = tag.MustNewKey("status_code")
+)
+
+// MetricViews returns the metrics views related to the Azure Monitor receiver.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        mScrapeErrors.Name(),
+			Measure:     mScrapeErrors,
+			Description: mScrapeErrors.Description(),
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{receiverTagKey, namespaceTagKey, operationTagKey, errorTypeTagKey, statusCodeTagKey},
+		},
+	}
+}
+
+// scrapeError describes an error returned by Azure for a resource.
+type scrapeError struct {
+	resourceID string
+	namespace  string
+	operation  string
+	errorType  string
+	errorCode  string
+	statusCode int
+	err        error
+}
+
+func newScrapeError(resourceID, operation string, err error) scrapeError {
+	se := scrapeError{
+		resourceID: resourceID,
+		operation:  operation,
+		errorType:  errorTypeOther,
+		err:        err,
+	}
+	if resourceID != "" {
+		if resourceType, parseErr := arm.ParseResourceType(resourceID); parseErr == nil {
+			se.namespace = resourceType.String()
+		}
+	}
+
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) {
+		se.statusCode = respErr.StatusCode
+		se.errorCode = respErr.ErrorCode
+		se.errorType = errorTypeForStatus(respErr.StatusCode)
+	}
+	return se
+}
+
+// errorTypeForStatus classifies an HTTP status code returned by Azure.
+func errorTypeForStatus(statusCode int) string {
+	switch {
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return errorTypeAuthorization
+	case statusCode == http.StatusTooManyRequests:
+		return errorTypeThrottling
+	case statusCode == http.StatusNotFound:
+		return errorTypeNotFound
+	case statusCode >= http.StatusInternalServerError:
+		return errorTypeServer
+	default:
+		return errorTypeOther
+	}
+}
+
+// scrapeErrorReporter reports scrape errors as collector telemetry and, when the receiver is
+// also part of a logs pipeline, as log records.
+type scrapeErrorReporter struct {
+	cfg         *Config
+	receiverTag tag.Mutator
+	logger      *zap.Logger
+
+	mutex sync.Mutex
+	logs  consumer.Logs
+}
+
+var _ component.Component = (*scrapeErrorReporter)(nil)
+
+func newScrapeErrorReporter(cfg *Config, id component.ID, logger *zap.Logger) *scrapeErrorReporter {
+	return &scrapeErrorReporter{
+		cfg:         cfg,
+		receiverTag: tag.Upsert(receiverTagKey, id.String()),
+		logger:      logger,
+	}
+}
+
+func (r *scrapeErrorReporter) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (r *scrapeErrorReporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// setLogs sets the consumer scrape errors are sent to as log records.
+func (r *scrapeErrorReporter) setLogs(logs consumer.Logs) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.logs = logs
+}
+
+func (r *scrapeErrorReporter) report(ctx context.Context, se scrapeError) {
+	r.mutex.Lock()
+	logs := r.logs
+	r.mutex.Unlock()
+
+	r.logger.Error("failed to get Azure data",
+		zap.String("operation", se.operation),
+		zap.String("resource_id", se.resourceID),
+		zap.String("namespace", se.namespace),
+		zap.String("error_type", se.errorType),
+		zap.Int("status_code", se.statusCode),
+		zap.Error(se.err),
+	)
+
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{
+		r.receiverTag,
+		tag.Upsert(namespaceTagKey, se.namespace),
+		tag.Upsert(operationTagKey, se.operation),
+		tag.Upsert(errorTypeTagKey, se.errorType),
+		tag.Upsert(statusCodeTagKey, strconv.Itoa(se.statusCode)),
+	}, mScrapeErrors.M(1))
+
+	if logs == nil {
+		return
+	}
+	if err := logs.ConsumeLogs(ctx, r.toLogs(se)); err != nil {
+		r.logger.Warn("failed to send scrape error as a log record", zap.Error(err))
+	}
+}
+
+func (r *scrapeErrorReporter) toLogs(se scrapeError) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("azuremonitor.tenant_id", r.cfg.TenantID)
+	rl.Resource().Attributes().PutStr("azuremonitor.subscription_id", r.cfg.SubscriptionID)
+
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	now := pcommon.NewTimestampFromTime(time.Now())
+	lr.SetTimestamp(now)
+	lr.SetObservedTimestamp(now)
+	lr.SetSeverityNumber(plog.SeverityNumberError)
+	lr.SetSeverityText("ERROR")
+	lr.Body().SetStr(se.err.Error())
+
+	attrs := lr.Attributes()
+	attrs.PutStr(attributeOperation, se.operation)
+	attrs.PutStr(attributeErrorType, se.errorType)
+	if se.resourceID != "" {
+		attrs.PutStr(attributeResourceID, se.resourceID)
+	}
+	if se.namespace != "" {
+		attrs.PutStr(attributeNamespace, se.namespace)
+	}
+	if se.errorCode != "" {
+		attrs.PutStr(attributeErrorCode, se.errorCode)
+	}
+	if se.statusCode != 0 {
+		attrs.PutInt(attributeStatusCode, int64(se.statusCode))
+	}
+	return ld
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuremonitorreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azuremonitorreceiver"
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azuremonitorreceiver/internal/metadata"
+)
+
+const testResourceID = "/subscriptions/sub/resourceGroups/group/providers/Microsoft.Compute/virtualMachines/vm"
+
+func newResponseError(statusCode int, errorCode string) *azcore.ResponseError {
+	return &azcore.ResponseError{
+		StatusCode: statusCode,
+		ErrorCode:  errorCode,
+		RawResponse: &http.Response{
+			StatusCode: statusCode,
+			Status:     http.StatusText(statusCode),
+			Request: &http.Request{
+				Method: http.MethodGet,
+				URL:    &url.URL{Scheme: "https", Host: "management.azure.com", Path: testResourceID},
+			},
+			Body: http.NoBody,
+		},
+	}
+}
+
+func TestNewScrapeError(t *testing.T) {
+	tests := []struct {
+		name       string
+		resourceID string
+		err        error
+		expected   scrapeError
+	}{
+		{
+			name:       "authorization",
+			resourceID: testResourceID,
+			err:        newResponseError(http.StatusForbidden, "AuthorizationFailed"),
+			expected: scrapeError{
+				resourceID: testResourceID,
+				namespace:  "Microsoft.Compute/virtualMachines",
+				errorType:  errorTypeAuthorization,
+				errorCode:  "AuthorizationFailed",
+				statusCode: http.StatusForbidden,
+			},
+		},
+		{
+			name:       "throttling",
+			resourceID: testResourceID,
+			err:        newResponseError(http.StatusTooManyRequests, "TooManyRequests"),
+			expected: scrapeError{
+				resourceID: testResourceID,
+				namespace:  "Microsoft.Compute/virtualMachines",
+				errorType:  errorTypeThrottling,
+				errorCode:  "TooManyRequests",
+				statusCode: http.StatusTooManyRequests,
+			},
+		},
+		{
+			name:       "server",
+			resourceID: testResourceID,
+			err:        newResponseError(http.StatusServiceUnavailable, ""),
+			expected: scrapeError{
+				resourceID: testResourceID,
+				namespace:  "Microsoft.Compute/virtualMachines",
+				errorType:  errorTypeServer,
+				statusCode: http.StatusServiceUnavailable,
+			},
+		},
+		{
+			name: "not a response error",
+			err:  errors.New("connection refused"),
+			expected: scrapeError{
+				errorType: errorTypeOther,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			se := newScrapeError(tt.resourceID, operationListMetricsValues, tt.err)
+			tt.expected.operation = operationListMetricsValues
+			tt.expected.err = tt.err
+			assert.Equal(t, tt.expected, se)
+		})
+	}
+}
+
+func TestScrapeErrorReporter(t *testing.T) {
+	views := MetricViews()
+	view.Unregister(views...)
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.TenantID = "tenant"
+	cfg.SubscriptionID = "subscription"
+	settings := receivertest.NewNopCreateSettings()
+	reporter := newScrapeErrorReporter(cfg, component.NewID(metadata.Type), settings.Logger)
+
+	// without a logs pipeline, errors are only recorded as telemetry
+	reporter.report(context.Background(), newScrapeError(testResourceID, operationListMetricsValues, newResponseError(http.StatusForbidden, "AuthorizationFailed")))
+
+	sink := new(consumertest.LogsSink)
+	reporter.setLogs(sink)
+	reporter.report(context.Background(), newScrapeError(testResourceID, operationListMetricsValues, newResponseError(http.StatusForbidden, "AuthorizationFailed")))
+
+	rows, err := view.RetrieveData(mScrapeErrors.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(2), rows[0].Data.(*view.SumData).Value)
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	rl := sink.AllLogs()[0].ResourceLogs().At(0)
+	tenantID, _ := rl.Resource().Attributes().Get("azuremonitor.tenant_id")
+	assert.Equal(t, "tenant", tenantID.Str())
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberError, lr.SeverityNumber())
+	assert.Equal(t, map[string]interface{}{
+		attributeOperation:  operationListMetricsValues,
+		attributeErrorType:  errorTypeAuthorization,
+		attributeResourceID: testResourceID,
+		attributeNamespace:  "Microsoft.Compute/virtualMachines",
+		attributeErrorCode:  "AuthorizationFailed",
+		attributeStatusCode: int64(http.StatusForbidden),
+	}, lr.Attributes().AsRaw())
+}
+
+type failingMetricsValuesClientMock struct {
+	err error
+}
+
+func (m failingMetricsValuesClientMock) List(context.Context, string, *armmonitor.MetricsClientListOptions) (armmonitor.MetricsClientListResponse, error) {
+	return armmonitor.MetricsClientListResponse{}, m.err
+}
+
+func TestAzureScraperScrapeReportsErrors(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaximumNumberOfMetricsInACall = 2
+	settings := receivertest.NewNopCreateSettings()
+
+	counters, pages := getMetricsDefinitionsMockData()
+	sink := new(consumertest.LogsSink)
+	reporter := newScrapeErrorReporter(cfg, settings.ID, settings.Logger)
+	reporter.setLogs(sink)
+
+	s := &azureScraper{
+		cfg:             cfg,
+		clientResources: &armClientMock{pages: getResourcesMockData(false)},
+		clientMetricsDefinitions: &metricsDefinitionsClientMock{
+			current: counters,
+			pages:   pages,
+		},
+		clientMetricsValues: failingMetricsValuesClientMock{err: newResponseError(http.StatusTooManyRequests, "TooManyRequests")},
+		mb:                  metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), settings),
+		mutex:               &sync.Mutex{},
+		errorReporter:       reporter,
+		resources:           map[string]*azureResource{},
+	}
+
+	_, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	// retrieving the values of a resource stops at its first error
+	require.Equal(t, 3, sink.LogRecordCount())
+	for _, ld := range sink.AllLogs() {
+		attrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+		errorType, _ := attrs.Get(attributeErrorType)
+		assert.Equal(t, errorTypeThrottling, errorType.Str())
+		operation, _ := attrs.Get(attributeOperation)
+		assert.Equal(t, operationListMetricsValues, operation.Str())
+	}
+}
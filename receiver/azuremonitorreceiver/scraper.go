@@ -23,7 +23,6 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
-	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azuremonitorreceiver/internal/metadata"
 )
@@ -83,6 +82,7 @@ func newScraper(conf *Config, settings receiver.CreateSettings) *azureScraper {
 		armMonitorDefinitionsClientFunc: armmonitor.NewMetricDefinitionsClient,
 		armMonitorMetricsClientFunc:     armmonitor.NewMetricsClient,
 		mutex:                           &sync.Mutex{},
+		errorReporter:                   newScrapeErrorReporter(conf, settings.ID, settings.Logger),
 	}
 }
 
@@ -103,6 +103,7 @@ type azureScraper struct {
 	armMonitorDefinitionsClientFunc func(azcore.TokenCredential, *arm.ClientOptions) (*armmonitor.MetricDefinitionsClient, error)
 	armMonitorMetricsClientFunc     func(azcore.TokenCredential, *arm.ClientOptions) (*armmonitor.MetricsClient, error)
 	mutex                           *sync.Mutex
+	errorReporter                   *scrapeErrorReporter
 }
 
 type ArmClient interface {
@@ -197,7 +198,7 @@ func (s *azureScraper) getResources(ctx context.Context) {
 	for pager.More() {
 		nextResult, err := pager.NextPage(ctx)
 		if err != nil {
-			s.settings.Logger.Error("failed to get Azure Resources data", zap.Error(err))
+			s.errorReporter.report(ctx, newScrapeError("", operationListResources, err))
 			return
 		}
 		for _, resource := range nextResult.Value {
@@ -246,7 +247,7 @@ func (s *azureScraper) getResourceMetricsDefinitions(ctx context.Context, resour
 	for pager.More() {
 		nextResult, err := pager.NextPage(ctx)
 		if err != nil {
-			s.settings.Logger.Error("failed to get Azure Metrics definitions data", zap.Error(err))
+			s.errorReporter.report(ctx, newScrapeError(resourceID, operationListMetricsDefinitions, err))
 			return
 		}
 
@@ -318,7 +319,7 @@ func (s *azureScraper) getResourceMetricsValues(ctx context.Context, resourceID
 				&opts,
 			)
 			if err != nil {
-				s.settings.Logger.Error("failed to get Azure Metrics values data", zap.Error(err))
+				s.errorReporter.report(ctx, newScrapeError(resourceID, operationListMetricsValues, err))
 				return
 			}
 
@@ -30,6 +30,10 @@ type Config struct {
 	// if transport being used is UDP.
 	TCPIdleTimeout time.Duration `mapstructure:"tcp_idle_timeout"`
 
+	// Protocol is the Carbon protocol of the received data, either "plaintext"
+	// (the default) or "pickle". The pickle protocol requires the TCP transport.
+	Protocol string `mapstructure:"protocol"`
+
 	// Parser specifies a parser and the respective configuration to be used
 	// by the receiver.
 	Parser *protocol.Config `mapstructure:"parser"`
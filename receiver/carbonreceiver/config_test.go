@@ -40,6 +40,22 @@ func TestLoadConfig(t *testing.T) {
 					Transport: "udp",
 				},
 				TCPIdleTimeout: 5 * time.Second,
+				Protocol:       "plaintext",
+				Parser: &protocol.Config{
+					Type:   "plaintext",
+					Config: &protocol.PlaintextConfig{},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "pickle"),
+			expected: &Config{
+				NetAddr: confignet.NetAddr{
+					Endpoint:  "localhost:2004",
+					Transport: "tcp",
+				},
+				TCPIdleTimeout: 30 * time.Second,
+				Protocol:       "pickle",
 				Parser: &protocol.Config{
 					Type:   "plaintext",
 					Config: &protocol.PlaintextConfig{},
@@ -54,6 +70,7 @@ func TestLoadConfig(t *testing.T) {
 					Transport: "tcp",
 				},
 				TCPIdleTimeout: 30 * time.Second,
+				Protocol:       "plaintext",
 				Parser: &protocol.Config{
 					Type: "regex",
 					Config: &protocol.RegexParserConfig{
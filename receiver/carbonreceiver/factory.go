@@ -33,6 +33,7 @@ func createDefaultConfig() component.Config {
 			Transport: "tcp",
 		},
 		TCPIdleTimeout: transport.TCPIdleTimeoutDefault,
+		Protocol:       "plaintext",
 		Parser: &protocol.Config{
 			Type:   "plaintext",
 			Config: &protocol.PlaintextConfig{},
@@ -50,13 +50,16 @@ func (p *PlaintextPathParser) ParsePath(path string, parsedPath *ParsedPath) err
 		return nil
 	}
 
-	if parts[1] == "" {
-		// Empty tags, nothing to do.
+	return parseTags(path, parts[1], parsedPath.Attributes)
+}
+
+// parseTags adds the tags of the metric path, in the "tag0;...;tagN" format, to
+// the attributes.
+func parseTags(path string, tags string, attributes pcommon.Map) error {
+	if tags == "" {
 		return nil
 	}
-
-	tags := strings.Split(parts[1], ";")
-	for _, tag := range tags {
+	for _, tag := range strings.Split(tags, ";") {
 		idx := strings.IndexByte(tag, '=')
 		if idx < 1 {
 			return fmt.Errorf("cannot parse metric path [%s]: incorrect key value separator for [%s]", path, tag)
@@ -64,7 +67,7 @@ func (p *PlaintextPathParser) ParsePath(path string, parsedPath *ParsedPath) err
 
 		key := tag[:idx]
 		value := tag[idx+1:] // If value is empty, ie.: tag == "k=", this will return "".
-		parsedPath.Attributes.PutStr(key, value)
+		attributes.PutStr(key, value)
 	}
 
 	return nil
@@ -160,9 +160,11 @@ type regexPathParser struct {
 // a full description of the line format) according to the RegexParserConfig
 // settings.
 func (rpp *regexPathParser) ParsePath(path string, parsedPath *ParsedPath) error {
+	// The rules are applied to the metric name, the tags being added as attributes.
+	name, tags, _ := strings.Cut(path, ";")
 	for _, rule := range rpp.rules {
-		if rule.compRegexp.MatchString(path) {
-			ms := rule.compRegexp.FindStringSubmatch(path)
+		if rule.compRegexp.MatchString(name) {
+			ms := rule.compRegexp.FindStringSubmatch(name)
 			nms := rule.compRegexp.SubexpNames() // regexp pre-computes this slice.
 			metricNameLookup := map[string]string{}
 			attributes := pcommon.NewMap()
@@ -175,6 +177,9 @@ func (rpp *regexPathParser) ParsePath(path string, parsedPath *ParsedPath) error
 				}
 			}
 
+			if err := parseTags(path, tags, attributes); err != nil {
+				return err
+			}
 			for k, v := range rule.Labels {
 				attributes.PutStr(k, v)
 			}
@@ -193,7 +198,7 @@ func (rpp *regexPathParser) ParsePath(path string, parsedPath *ParsedPath) error
 			}
 
 			if actualMetricName == "" {
-				actualMetricName = path
+				actualMetricName = name
 			}
 
 			parsedPath.MetricName = actualMetricName
@@ -158,6 +158,25 @@ func Test_regexParser_parsePath(t *testing.T) {
 			}(),
 			wantMetricType: GaugeMetricType,
 		},
+		{
+			name:     "match_rule2_with_tags",
+			path:     "svc_02.host02.avg.duration;region=us-east-1;zone=a",
+			wantName: "avgduration",
+			wantAttributes: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("svc", "svc_02")
+				m.PutStr("host", "host02")
+				m.PutStr("region", "us-east-1")
+				m.PutStr("zone", "a")
+				return m
+			}(),
+			wantMetricType: GaugeMetricType,
+		},
+		{
+			name:    "match_rule2_invalid_tag",
+			path:    "svc_02.host02.avg.duration;region",
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -21,7 +21,7 @@ var (
 	errEmptyEndpoint = errors.New("empty endpoint")
 )
 
-// carbonreceiver implements a receiver.Metrics for Carbon plaintext, aka "line", protocol.
+// carbonreceiver implements a receiver.Metrics for Carbon plaintext, aka "line", and pickle protocols.
 // see https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-plaintext-protocol.
 type carbonReceiver struct {
 	settings receiver.CreateSettings
@@ -80,6 +80,17 @@ func New(
 }
 
 func buildTransportServer(config Config) (transport.Server, error) {
+	switch strings.ToLower(config.Protocol) {
+	case "", "plaintext":
+	case "pickle":
+		if transport := strings.ToLower(config.Transport); transport != "" && transport != "tcp" {
+			return nil, fmt.Errorf("unsupported transport %q for the pickle protocol", config.Transport)
+		}
+		return transport.NewPickleServer(config.Endpoint, config.TCPIdleTimeout)
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q", config.Protocol)
+	}
+
 	switch strings.ToLower(config.Transport) {
 	case "", "tcp":
 		return transport.NewTCPServer(config.Endpoint, config.TCPIdleTimeout)
@@ -153,6 +153,42 @@ func Test_carbonreceiver_Start(t *testing.T) {
 			},
 			wantErr: errors.New("invalid idle timeout: -1s"),
 		},
+		{
+			name: "pickle_udp",
+			args: args{
+				config: Config{
+					NetAddr: confignet.NetAddr{
+						Endpoint:  "localhost:2004",
+						Transport: "udp",
+					},
+					Protocol: "pickle",
+					Parser: &protocol.Config{
+						Type:   "plaintext",
+						Config: &protocol.PlaintextConfig{},
+					},
+				},
+				nextConsumer: consumertest.NewNop(),
+			},
+			wantErr: errors.New("unsupported transport \"udp\" for the pickle protocol"),
+		},
+		{
+			name: "invalid_protocol",
+			args: args{
+				config: Config{
+					NetAddr: confignet.NetAddr{
+						Endpoint:  "localhost:2003",
+						Transport: "tcp",
+					},
+					Protocol: "unknown_protocol",
+					Parser: &protocol.Config{
+						Type:   "plaintext",
+						Config: &protocol.PlaintextConfig{},
+					},
+				},
+				nextConsumer: consumertest.NewNop(),
+			},
+			wantErr: errors.New("unsupported protocol \"unknown_protocol\""),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,586 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/transport"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// The pickle opcodes needed to unpickle the lists of metric tuples of the Carbon
+// pickle protocol, see https://docs.python.org/3/library/pickle.html. The opcodes
+// building arbitrary Python objects, such as GLOBAL and REDUCE, are never supported
+// as in Carbon's own safe unpickler.
+const (
+	opMark            = '('
+	opStop            = '.'
+	opPop             = '0'
+	opPopMark         = '1'
+	opDup             = '2'
+	opFloat           = 'F'
+	opInt             = 'I'
+	opBinInt          = 'J'
+	opBinInt1         = 'K'
+	opBinInt2         = 'M'
+	opLong            = 'L'
+	opNone            = 'N'
+	opString          = 'S'
+	opBinString       = 'T'
+	opShortBinString  = 'U'
+	opUnicode         = 'V'
+	opBinUnicode      = 'X'
+	opAppend          = 'a'
+	opAppends         = 'e'
+	opList            = 'l'
+	opEmptyList       = ']'
+	opTuple           = 't'
+	opEmptyTuple      = ')'
+	opGet             = 'g'
+	opBinGet          = 'h'
+	opLongBinGet      = 'j'
+	opPut             = 'p'
+	opBinPut          = 'q'
+	opLongBinPut      = 'r'
+	opBinFloat        = 'G'
+	opBinBytes        = 'B'
+	opShortBinBytes   = 'C'
+	opProto           = 0x80
+	opTuple1          = 0x85
+	opTuple2          = 0x86
+	opTuple3          = 0x87
+	opNewTrue         = 0x88
+	opNewFalse        = 0x89
+	opLong1           = 0x8a
+	opLong4           = 0x8b
+	opShortBinUnicode = 0x8c
+	opBinUnicode8     = 0x8d
+	opBinBytes8       = 0x8e
+	opMemoize         = 0x94
+	opFrame           = 0x95
+)
+
+var (
+	errPickleTruncated = errors.New("truncated pickle data")
+	errPickleStack     = errors.New("invalid pickle stack")
+)
+
+// pickleList is a Python list, referenced by the memo while its items are appended.
+type pickleList struct {
+	items []interface{}
+}
+
+// pickleTuple is a Python tuple.
+type pickleTuple []interface{}
+
+// pickleMark marks the start of the items of a list or tuple on the stack.
+type pickleMark struct{}
+
+type unpickler struct {
+	data  []byte
+	pos   int
+	stack []interface{}
+	memo  map[int]interface{}
+}
+
+// unpickle decodes the pickled value of the data, which can only be made of lists,
+// tuples, strings, numbers, booleans and None.
+func unpickle(data []byte) (interface{}, error) {
+	u := &unpickler{data: data, memo: map[int]interface{}{}}
+	for {
+		op, err := u.readByte()
+		if err != nil {
+			return nil, err
+		}
+		if op == opStop {
+			return u.pop()
+		}
+		if err = u.execute(op); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (u *unpickler) execute(op byte) error {
+	switch op {
+	case opProto:
+		_, err := u.read(1)
+		return err
+	case opFrame:
+		_, err := u.read(8)
+		return err
+	case opMark:
+		u.push(pickleMark{})
+	case opPop:
+		_, err := u.pop()
+		return err
+	case opPopMark:
+		_, err := u.popMark()
+		return err
+	case opDup:
+		v, err := u.top()
+		if err != nil {
+			return err
+		}
+		u.push(v)
+	case opNone:
+		u.push(nil)
+	case opNewTrue:
+		u.push(true)
+	case opNewFalse:
+		u.push(false)
+	case opInt:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		// protocol 0 pickles the booleans as the "01" and "00" integers
+		switch line {
+		case "01":
+			u.push(true)
+		case "00":
+			u.push(false)
+		default:
+			i, err := strconv.ParseInt(line, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pickle integer %q: %w", line, err)
+			}
+			u.push(i)
+		}
+	case opLong:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		i, ok := new(big.Int).SetString(strings.TrimSuffix(line, "L"), 10)
+		if !ok {
+			return fmt.Errorf("invalid pickle long %q", line)
+		}
+		u.push(bigValue(i))
+	case opBinInt:
+		b, err := u.read(4)
+		if err != nil {
+			return err
+		}
+		u.push(int64(int32(binary.LittleEndian.Uint32(b))))
+	case opBinInt1:
+		b, err := u.read(1)
+		if err != nil {
+			return err
+		}
+		u.push(int64(b[0]))
+	case opBinInt2:
+		b, err := u.read(2)
+		if err != nil {
+			return err
+		}
+		u.push(int64(binary.LittleEndian.Uint16(b)))
+	case opLong1, opLong4:
+		n, err := u.readLength(op == opLong1)
+		if err != nil {
+			return err
+		}
+		b, err := u.read(n)
+		if err != nil {
+			return err
+		}
+		u.push(decodeLong(b))
+	case opFloat:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		f, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			return fmt.Errorf("invalid pickle float %q: %w", line, err)
+		}
+		u.push(f)
+	case opBinFloat:
+		b, err := u.read(8)
+		if err != nil {
+			return err
+		}
+		u.push(math.Float64frombits(binary.BigEndian.Uint64(b)))
+	case opString:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		s, err := unquotePickleString(line)
+		if err != nil {
+			return err
+		}
+		u.push(s)
+	case opUnicode:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		u.push(line)
+	case opBinString, opBinUnicode, opBinBytes:
+		return u.pushString(4)
+	case opShortBinString, opShortBinUnicode, opShortBinBytes:
+		return u.pushString(1)
+	case opBinUnicode8, opBinBytes8:
+		return u.pushString(8)
+	case opEmptyList:
+		u.push(&pickleList{})
+	case opList:
+		items, err := u.popMark()
+		if err != nil {
+			return err
+		}
+		u.push(&pickleList{items: items})
+	case opAppend:
+		v, err := u.pop()
+		if err != nil {
+			return err
+		}
+		return u.appendItems(v)
+	case opAppends:
+		items, err := u.popMark()
+		if err != nil {
+			return err
+		}
+		return u.appendItems(items...)
+	case opEmptyTuple:
+		u.push(pickleTuple{})
+	case opTuple:
+		items, err := u.popMark()
+		if err != nil {
+			return err
+		}
+		u.push(pickleTuple(items))
+	case opTuple1, opTuple2, opTuple3:
+		n := int(op-opTuple1) + 1
+		if len(u.stack) < n {
+			return errPickleStack
+		}
+		items := make(pickleTuple, n)
+		copy(items, u.stack[len(u.stack)-n:])
+		u.stack = u.stack[:len(u.stack)-n]
+		u.push(items)
+	case opPut, opBinPut, opLongBinPut, opMemoize:
+		v, err := u.top()
+		if err != nil {
+			return err
+		}
+		index := len(u.memo)
+		if op != opMemoize {
+			if index, err = u.readMemoIndex(op == opPut, op == opBinPut); err != nil {
+				return err
+			}
+		}
+		u.memo[index] = v
+	case opGet, opBinGet, opLongBinGet:
+		index, err := u.readMemoIndex(op == opGet, op == opBinGet)
+		if err != nil {
+			return err
+		}
+		v, ok := u.memo[index]
+		if !ok {
+			return fmt.Errorf("invalid pickle memo index %d", index)
+		}
+		u.push(v)
+	default:
+		return fmt.Errorf("unsupported pickle opcode 0x%02x", op)
+	}
+	return nil
+}
+
+func (u *unpickler) push(v interface{}) {
+	u.stack = append(u.stack, v)
+}
+
+func (u *unpickler) top() (interface{}, error) {
+	if len(u.stack) == 0 {
+		return nil, errPickleStack
+	}
+	return u.stack[len(u.stack)-1], nil
+}
+
+func (u *unpickler) pop() (interface{}, error) {
+	v, err := u.top()
+	if err != nil {
+		return nil, err
+	}
+	u.stack = u.stack[:len(u.stack)-1]
+	return v, nil
+}
+
+// popMark pops the items pushed since the last mark, and the mark.
+func (u *unpickler) popMark() ([]interface{}, error) {
+	for i := len(u.stack) - 1; i >= 0; i-- {
+		if _, ok := u.stack[i].(pickleMark); ok {
+			items := make([]interface{}, len(u.stack)-i-1)
+			copy(items, u.stack[i+1:])
+			u.stack = u.stack[:i]
+			return items, nil
+		}
+	}
+	return nil, errPickleStack
+}
+
+func (u *unpickler) appendItems(items ...interface{}) error {
+	v, err := u.top()
+	if err != nil {
+		return err
+	}
+	list, ok := v.(*pickleList)
+	if !ok {
+		return errPickleStack
+	}
+	list.items = append(list.items, items...)
+	return nil
+}
+
+func (u *unpickler) pushString(lengthSize int) error {
+	var n int
+	switch lengthSize {
+	case 1:
+		b, err := u.read(1)
+		if err != nil {
+			return err
+		}
+		n = int(b[0])
+	case 4:
+		b, err := u.read(4)
+		if err != nil {
+			return err
+		}
+		n = int(binary.LittleEndian.Uint32(b))
+	default:
+		b, err := u.read(8)
+		if err != nil {
+			return err
+		}
+		length := binary.LittleEndian.Uint64(b)
+		if length > uint64(len(u.data)) {
+			return errPickleTruncated
+		}
+		n = int(length)
+	}
+	b, err := u.read(n)
+	if err != nil {
+		return err
+	}
+	u.push(string(b))
+	return nil
+}
+
+func (u *unpickler) readByte() (byte, error) {
+	b, err := u.read(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (u *unpickler) read(n int) ([]byte, error) {
+	if n < 0 || len(u.data)-u.pos < n {
+		return nil, errPickleTruncated
+	}
+	b := u.data[u.pos : u.pos+n]
+	u.pos += n
+	return b, nil
+}
+
+func (u *unpickler) readLine() (string, error) {
+	i := bytes.IndexByte(u.data[u.pos:], '\n')
+	if i < 0 {
+		return "", errPickleTruncated
+	}
+	line := string(u.data[u.pos : u.pos+i])
+	u.pos += i + 1
+	return line, nil
+}
+
+// readLength reads the 1 or 4 bytes length of the LONG1 and LONG4 opcodes.
+func (u *unpickler) readLength(short bool) (int, error) {
+	if short {
+		b, err := u.readByte()
+		return int(b), err
+	}
+	b, err := u.read(4)
+	if err != nil {
+		return 0, err
+	}
+	return int(int32(binary.LittleEndian.Uint32(b))), nil
+}
+
+// readMemoIndex reads the memo index of the text, 1 byte or 4 bytes variants of
+// the GET and PUT opcodes.
+func (u *unpickler) readMemoIndex(text bool, short bool) (int, error) {
+	switch {
+	case text:
+		line, err := u.readLine()
+		if err != nil {
+			return 0, err
+		}
+		index, err := strconv.Atoi(line)
+		if err != nil {
+			return 0, fmt.Errorf("invalid pickle memo index %q: %w", line, err)
+		}
+		return index, nil
+	case short:
+		b, err := u.readByte()
+		return int(b), err
+	default:
+		b, err := u.read(4)
+		if err != nil {
+			return 0, err
+		}
+		return int(binary.LittleEndian.Uint32(b)), nil
+	}
+}
+
+// decodeLong decodes the little-endian two's complement integers of the LONG1 and
+// LONG4 opcodes.
+func decodeLong(b []byte) interface{} {
+	if len(b) == 0 {
+		return int64(0)
+	}
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+	i := new(big.Int).SetBytes(be)
+	if b[len(b)-1]&0x80 != 0 {
+		i.Sub(i, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return bigValue(i)
+}
+
+// bigValue returns the integer as an int64 or, when it overflows, as a float64.
+func bigValue(i *big.Int) interface{} {
+	if i.IsInt64() {
+		return i.Int64()
+	}
+	f, _ := new(big.Float).SetInt(i).Float64()
+	return f
+}
+
+// unquotePickleString decodes the quoted and escaped string of the STRING opcode.
+func unquotePickleString(s string) (string, error) {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return "", fmt.Errorf("invalid pickle string %q", s)
+	}
+	s = s[1 : len(s)-1]
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			sb.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'x':
+			if i+2 >= len(s) {
+				return "", fmt.Errorf("invalid pickle string escape %q", s)
+			}
+			b, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+			if err != nil {
+				return "", fmt.Errorf("invalid pickle string escape %q: %w", s, err)
+			}
+			sb.WriteByte(byte(b))
+			i += 2
+		default:
+			// \\, \' and \"
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String(), nil
+}
+
+// pickleLines converts the pickled list of (path, (timestamp, value)) tuples of the
+// Carbon pickle protocol to the lines of the plaintext protocol, so they are parsed
+// like the plaintext metrics.
+func pickleLines(data []byte) ([]string, error) {
+	v, err := unpickle(data)
+	if err != nil {
+		return nil, err
+	}
+	var items []interface{}
+	switch t := v.(type) {
+	case *pickleList:
+		items = t.items
+	case pickleTuple:
+		items = t
+	default:
+		return nil, fmt.Errorf("invalid pickled metrics, expected a list of tuples")
+	}
+
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		path, datapoint, ok := pair(item)
+		if !ok {
+			return nil, fmt.Errorf("invalid pickled metric %v, expected (path, (timestamp, value))", item)
+		}
+		timestamp, value, ok := pair(datapoint)
+		if !ok {
+			return nil, fmt.Errorf("invalid pickled datapoint %v, expected (timestamp, value)", datapoint)
+		}
+		pathStr, ok := path.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid pickled metric path %v", path)
+		}
+		timestampStr, err := formatNumber(timestamp, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pickled timestamp of %q: %w", pathStr, err)
+		}
+		valueStr, err := formatNumber(value, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pickled value of %q: %w", pathStr, err)
+		}
+		lines = append(lines, pathStr+" "+valueStr+" "+timestampStr)
+	}
+	return lines, nil
+}
+
+// pair returns the items of a list or tuple of two items.
+func pair(v interface{}) (interface{}, interface{}, bool) {
+	var items []interface{}
+	switch t := v.(type) {
+	case *pickleList:
+		items = t.items
+	case pickleTuple:
+		items = t
+	}
+	if len(items) != 2 {
+		return nil, nil, false
+	}
+	return items[0], items[1], true
+}
+
+// formatNumber formats the number as the plaintext protocol, the timestamps being
+// truncated to seconds.
+func formatNumber(v interface{}, truncate bool) (string, error) {
+	switch n := v.(type) {
+	case int64:
+		return strconv.FormatInt(n, 10), nil
+	case float64:
+		if truncate {
+			return strconv.FormatInt(int64(n), 10), nil
+		}
+		return strconv.FormatFloat(n, 'g', -1, 64), nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		if err != nil {
+			return "", err
+		}
+		return formatNumber(f, truncate)
+	}
+	return "", fmt.Errorf("%v is not a number", v)
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/transport"
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/protocol"
+)
+
+// maxPickleMessageSize is the maximum size of a pickle message, the size accepted
+// by Carbon.
+const maxPickleMessageSize = 1 << 20
+
+// NewPickleServer creates a transport.Server receiving the Carbon pickle protocol
+// over TCP, see https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-pickle-protocol.
+func NewPickleServer(
+	addr string,
+	idleTimeout time.Duration,
+) (Server, error) {
+	t, err := newTCPServer(addr, idleTimeout)
+	if err != nil {
+		return nil, err
+	}
+	t.handleConn = t.handlePickleConnection
+	return t, nil
+}
+
+// handlePickleConnection reads the messages of the connection, each message being
+// a 4 bytes big-endian length followed by the pickled list of metric tuples. The
+// metrics of a message are passed together to the next consumer.
+func (t *tcpServer) handlePickleConnection(
+	p protocol.Parser,
+	nextConsumer consumer.Metrics,
+	conn net.Conn,
+) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	header := make([]byte, 4)
+	for {
+		if err := conn.SetDeadline(time.Now().Add(t.idleTimeout)); err != nil {
+			t.reporter.OnDebugf(
+				"TCP Transport (%s) - conn.SetDeadLine error: %v",
+				t.ln.Addr(),
+				err)
+			return
+		}
+
+		data, err := readPickleMessage(reader, header)
+		if err != nil {
+			netErr := &net.OpError{}
+			if !errors.Is(err, io.EOF) && !errors.As(err, &netErr) {
+				// The stream can't be resynchronized after an invalid message.
+				ctx := t.reporter.OnDataReceived(context.Background())
+				t.reporter.OnTranslationError(ctx, err)
+			}
+			t.reporter.OnDebugf("TCP Transport (%s) - error: %v", t.ln.Addr(), err)
+			return
+		}
+
+		ctx := t.reporter.OnDataReceived(context.Background())
+		lines, err := pickleLines(data)
+		if err != nil {
+			t.reporter.OnTranslationError(ctx, fmt.Errorf("invalid pickle message: %w", err))
+			continue
+		}
+
+		metrics := pmetric.NewMetrics()
+		ms := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+		for _, line := range lines {
+			metric, err := p.Parse(line)
+			if err != nil {
+				t.reporter.OnTranslationError(ctx, err)
+				continue
+			}
+			metric.MoveTo(ms.AppendEmpty())
+		}
+		if ms.Len() == 0 {
+			continue
+		}
+
+		err = nextConsumer.ConsumeMetrics(ctx, metrics)
+		t.reporter.OnMetricsProcessed(ctx, len(lines), err)
+		if err != nil {
+			// The protocol doesn't account for returning errors, the connection is
+			// closed as for the plaintext protocol.
+			return
+		}
+	}
+}
+
+// readPickleMessage reads the pickled payload of the next message of the reader.
+func readPickleMessage(reader io.Reader, header []byte) ([]byte, error) {
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(header)
+	if size > maxPickleMessageSize {
+		return nil, fmt.Errorf("pickle message of %d bytes exceeds the maximum size of %d bytes", size, maxPickleMessageSize)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
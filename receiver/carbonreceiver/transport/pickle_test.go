@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickleLines(t *testing.T) {
+	// The pickles of the metrics [("test.metric;env=prod", (1582230020, 1)),
+	// ("test.dbl", (1582230020.5, 1.23)), ("test.big", (1582230020, 2**40)),
+	// ("test.neg", (1582230020, -7))] with the different pickle protocols.
+	wantLines := []string{
+		"test.metric;env=prod 1 1582230020",
+		"test.dbl 1.23 1582230020",
+		"test.big 1099511627776 1582230020",
+		"test.neg -7 1582230020",
+	}
+	tests := []struct {
+		name      string
+		data      string
+		wantLines []string
+		wantErr   string
+	}{
+		{
+			name:      "protocol_0",
+			data:      "\x28\x6c\x70\x30\x0a\x28\x56\x74\x65\x73\x74\x2e\x6d\x65\x74\x72\x69\x63\x3b\x65\x6e\x76\x3d\x70\x72\x6f\x64\x0a\x70\x31\x0a\x28\x49\x31\x35\x38\x32\x32\x33\x30\x30\x32\x30\x0a\x49\x31\x0a\x74\x70\x32\x0a\x74\x70\x33\x0a\x61\x28\x56\x74\x65\x73\x74\x2e\x64\x62\x6c\x0a\x70\x34\x0a\x28\x46\x31\x35\x38\x32\x32\x33\x30\x30\x32\x30\x2e\x35\x0a\x46\x31\x2e\x32\x33\x0a\x74\x70\x35\x0a\x74\x70\x36\x0a\x61\x28\x56\x74\x65\x73\x74\x2e\x62\x69\x67\x0a\x70\x37\x0a\x28\x49\x31\x35\x38\x32\x32\x33\x30\x30\x32\x30\x0a\x4c\x31\x30\x39\x39\x35\x31\x31\x36\x32\x37\x37\x37\x36\x4c\x0a\x74\x70\x38\x0a\x74\x70\x39\x0a\x61\x28\x56\x74\x65\x73\x74\x2e\x6e\x65\x67\x0a\x70\x31\x30\x0a\x28\x49\x31\x35\x38\x32\x32\x33\x30\x30\x32\x30\x0a\x49\x2d\x37\x0a\x74\x70\x31\x31\x0a\x74\x70\x31\x32\x0a\x61\x2e",
+			wantLines: wantLines,
+		},
+		{
+			name:      "protocol_2",
+			data:      "\x80\x02\x5d\x71\x00\x28\x58\x14\x00\x00\x00\x74\x65\x73\x74\x2e\x6d\x65\x74\x72\x69\x63\x3b\x65\x6e\x76\x3d\x70\x72\x6f\x64\x71\x01\x4a\x04\xea\x4e\x5e\x4b\x01\x86\x71\x02\x86\x71\x03\x58\x08\x00\x00\x00\x74\x65\x73\x74\x2e\x64\x62\x6c\x71\x04\x47\x41\xd7\x93\xba\x81\x20\x00\x00\x47\x3f\xf3\xae\x14\x7a\xe1\x47\xae\x86\x71\x05\x86\x71\x06\x58\x08\x00\x00\x00\x74\x65\x73\x74\x2e\x62\x69\x67\x71\x07\x4a\x04\xea\x4e\x5e\x8a\x06\x00\x00\x00\x00\x00\x01\x86\x71\x08\x86\x71\x09\x58\x08\x00\x00\x00\x74\x65\x73\x74\x2e\x6e\x65\x67\x71\x0a\x4a\x04\xea\x4e\x5e\x4a\xf9\xff\xff\xff\x86\x71\x0b\x86\x71\x0c\x65\x2e",
+			wantLines: wantLines,
+		},
+		{
+			name:      "protocol_4",
+			data:      "\x80\x04\x95\x7d\x00\x00\x00\x00\x00\x00\x00\x5d\x94\x28\x8c\x14\x74\x65\x73\x74\x2e\x6d\x65\x74\x72\x69\x63\x3b\x65\x6e\x76\x3d\x70\x72\x6f\x64\x94\x4a\x04\xea\x4e\x5e\x4b\x01\x86\x94\x86\x94\x8c\x08\x74\x65\x73\x74\x2e\x64\x62\x6c\x94\x47\x41\xd7\x93\xba\x81\x20\x00\x00\x47\x3f\xf3\xae\x14\x7a\xe1\x47\xae\x86\x94\x86\x94\x8c\x08\x74\x65\x73\x74\x2e\x62\x69\x67\x94\x4a\x04\xea\x4e\x5e\x8a\x06\x00\x00\x00\x00\x00\x01\x86\x94\x86\x94\x8c\x08\x74\x65\x73\x74\x2e\x6e\x65\x67\x94\x4a\x04\xea\x4e\x5e\x4a\xf9\xff\xff\xff\x86\x94\x86\x94\x65\x2e",
+			wantLines: wantLines,
+		},
+		{
+			// Python 2 pickle of [("test.metric", (1582230020, 1.5)), ("test.\x41", (1582230020L, "7"))]
+			name: "python2_strings",
+			data: "\x28\x6c\x70\x30\x0a\x28\x53\x27\x74\x65\x73\x74\x2e\x6d\x65\x74\x72\x69\x63\x27\x0a\x70\x31\x0a\x28\x49\x31\x35\x38\x32\x32\x33\x30\x30\x32\x30\x0a\x46\x31\x2e\x35\x0a\x74\x70\x32\x0a\x74\x70\x33\x0a\x61\x28\x53\x27\x74\x65\x73\x74\x2e\x5c\x78\x34\x31\x27\x0a\x70\x34\x0a\x28\x4c\x31\x35\x38\x32\x32\x33\x30\x30\x32\x30\x4c\x0a\x53\x27\x37\x27\x0a\x74\x70\x35\x0a\x74\x70\x36\x0a\x61\x2e",
+			wantLines: []string{
+				"test.metric 1.5 1582230020",
+				"test.A 7 1582230020",
+			},
+		},
+		{
+			name:    "unsupported_opcode",
+			data:    "cos\nsystem\n(S'echo'\ntR.",
+			wantErr: "unsupported pickle opcode 0x63",
+		},
+		{
+			name:    "truncated",
+			data:    "(lp0\n(S'test.metric'\n",
+			wantErr: "truncated pickle data",
+		},
+		{
+			name:    "not_a_list",
+			data:    "I1\n.",
+			wantErr: "invalid pickled metrics, expected a list of tuples",
+		},
+		{
+			name:    "invalid_datapoint",
+			data:    "(lp0\n(S'test.metric'\nI1\ntp1\na.",
+			wantErr: "invalid pickled datapoint 1, expected (timestamp, value)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := pickleLines([]byte(tt.data))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLines, lines)
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package transport
 
 import (
+	"encoding/binary"
+	"net"
 	"runtime"
 	"sync"
 	"testing"
@@ -96,3 +98,54 @@ func Test_Server_ListenAndServe(t *testing.T) {
 		})
 	}
 }
+
+func Test_PickleServer_ListenAndServe(t *testing.T) {
+	addr := testutil.GetAvailableLocalNetworkAddress(t, "tcp")
+
+	svr, err := NewPickleServer(addr, 1*time.Second)
+	require.NoError(t, err)
+	require.NotNil(t, svr)
+
+	mc := new(consumertest.MetricsSink)
+	p, err := (&protocol.PlaintextConfig{}).BuildParser()
+	require.NoError(t, err)
+	mr := NewMockReporter(1)
+
+	wgListenAndServe := sync.WaitGroup{}
+	wgListenAndServe.Add(1)
+	go func() {
+		defer wgListenAndServe.Done()
+		assert.Error(t, svr.ListenAndServe(p, mc, mr))
+	}()
+
+	runtime.Gosched()
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+
+	// [("test.metric;key=value", (1582230020, 1)), ("test.dbl", (1582230020, 1.5))]
+	data := []byte("(lp0\n(S'test.metric;key=value'\np1\n(I1582230020\nI1\ntp2\ntp3\na(S'test.dbl'\np4\n(I1582230020\nF1.5\ntp5\ntp6\na.")
+	header := make([]byte, 4)
+	binary.BigEndian.PutUint32(header, uint32(len(data)))
+	_, err = conn.Write(append(header, data...))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	mr.WaitAllOnMetricsProcessedCalls()
+
+	err = svr.Close()
+	assert.NoError(t, err)
+
+	wgListenAndServe.Wait()
+
+	mdd := mc.AllMetrics()
+	require.Len(t, mdd, 1)
+	require.Equal(t, 2, mdd[0].MetricCount())
+	metrics := mdd[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, "test.metric", metrics.At(0).Name())
+	value, ok := metrics.At(0).Gauge().DataPoints().At(0).Attributes().Get("key")
+	require.True(t, ok)
+	assert.Equal(t, "value", value.Str())
+	assert.Equal(t, "test.dbl", metrics.At(1).Name())
+	assert.Equal(t, 1.5, metrics.At(1).Gauge().DataPoints().At(0).DoubleValue())
+}
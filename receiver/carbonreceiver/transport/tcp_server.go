@@ -31,6 +31,8 @@ type tcpServer struct {
 	wg          sync.WaitGroup
 	idleTimeout time.Duration
 	reporter    Reporter
+	// handleConn reads the metrics of a connection until it is closed or idle.
+	handleConn func(p protocol.Parser, nextConsumer consumer.Metrics, conn net.Conn)
 }
 
 var _ Server = (*tcpServer)(nil)
@@ -40,6 +42,18 @@ func NewTCPServer(
 	addr string,
 	idleTimeout time.Duration,
 ) (Server, error) {
+	t, err := newTCPServer(addr, idleTimeout)
+	if err != nil {
+		return nil, err
+	}
+	t.handleConn = t.handleConnection
+	return t, nil
+}
+
+func newTCPServer(
+	addr string,
+	idleTimeout time.Duration,
+) (*tcpServer, error) {
 	if idleTimeout < 0 {
 		return nil, fmt.Errorf("invalid idle timeout: %v", idleTimeout)
 	}
@@ -82,7 +96,7 @@ func (t *tcpServer) ListenAndServe(
 			connMapMtx.Unlock()
 			t.wg.Add(1)
 			go func(c net.Conn) {
-				t.handleConnection(parser, nextConsumer, c)
+				t.handleConn(parser, nextConsumer, c)
 				connMapMtx.Lock()
 				delete(acceptedConnMap, c)
 				connMapMtx.Unlock()
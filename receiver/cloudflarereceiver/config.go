@@ -7,7 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.uber.org/multierr"
 )
@@ -18,44 +21,113 @@ type Config struct {
 }
 
 type LogsConfig struct {
+	// Mode is either push, to listen for the requests of an HTTP destination LogPush job,
+	// or pull, to read the logs delivered by a LogPush job to an R2 or S3 bucket.
+	Mode           string                      `mapstructure:"mode"`
 	Secret         string                      `mapstructure:"secret"`
 	Endpoint       string                      `mapstructure:"endpoint"`
 	TLS            *configtls.TLSServerSetting `mapstructure:"tls"`
+	Pull           PullConfig                  `mapstructure:"pull"`
 	Attributes     map[string]string           `mapstructure:"attributes"`
 	TimestampField string                      `mapstructure:"timestamp_field"`
 }
 
+// PullConfig holds the parameters to read the log files delivered by LogPush to a bucket
+// of R2 or any other S3 compatible storage.
+type PullConfig struct {
+	Bucket string `mapstructure:"bucket"`
+	// Prefix is the prefix of the keys of the log files, which is the path of the LogPush destination.
+	Prefix   string `mapstructure:"prefix"`
+	Region   string `mapstructure:"region"`
+	Endpoint string `mapstructure:"endpoint"`
+	// AccessKeyID and SecretAccessKey are the credentials used to access the bucket,
+	// the default AWS credentials chain is used when they are not set.
+	AccessKeyID     string              `mapstructure:"access_key_id"`
+	SecretAccessKey configopaque.String `mapstructure:"secret_access_key"`
+	PollInterval    time.Duration       `mapstructure:"poll_interval"`
+	// StorageID is the ID of the storage extension used to remember the last log file read.
+	StorageID *component.ID `mapstructure:"storage"`
+}
+
+const (
+	logsModePush = "push"
+	logsModePull = "pull"
+)
+
 var (
-	errNoEndpoint = errors.New("an endpoint must be specified")
-	errNoTLS      = errors.New("tls must be configured")
-	errNoCert     = errors.New("tls was configured, but no cert file was specified")
-	errNoKey      = errors.New("tls was configured, but no key file was specified")
+	errNoEndpoint          = errors.New("an endpoint must be specified")
+	errNoTLS               = errors.New("tls must be configured")
+	errNoCert              = errors.New("tls was configured, but no cert file was specified")
+	errNoKey               = errors.New("tls was configured, but no key file was specified")
+	errNoModeRecognized    = fmt.Errorf("mode must be one of %s or %s", logsModePush, logsModePull)
+	errNoBucket            = errors.New("a bucket must be specified in pull mode")
+	errNoRegion            = errors.New("a region must be specified in pull mode")
+	errNoSecretAccessKey   = errors.New("a secret access key must be specified with the access key id")
+	errNoAccessKeyID       = errors.New("an access key id must be specified with the secret access key")
+	errInvalidPollInterval = errors.New("poll interval must be positive")
 
-	defaultTimestampField = "EdgeStartTimestamp"
+	defaultTimestampField   = "EdgeStartTimestamp"
+	defaultPullPollInterval = time.Minute
 )
 
 func (c *Config) Validate() error {
-	if c.Logs.Endpoint == "" {
+	switch c.Logs.Mode {
+	case logsModePush:
+		return c.Logs.validatePushConfig()
+	case logsModePull:
+		return c.Logs.Pull.validate()
+	default:
+		return errNoModeRecognized
+	}
+}
+
+func (l LogsConfig) validatePushConfig() error {
+	if l.Endpoint == "" {
 		return errNoEndpoint
 	}
 
-	if c.Logs.TLS == nil {
+	if l.TLS == nil {
 		return errNoTLS
 	}
 
 	var errs error
-	_, _, err := net.SplitHostPort(c.Logs.Endpoint)
+	_, _, err := net.SplitHostPort(l.Endpoint)
 	if err != nil {
 		errs = multierr.Append(errs, fmt.Errorf("failed to split endpoint into 'host:port' pair: %w", err))
 	}
 
-	if c.Logs.TLS.CertFile == "" {
+	if l.TLS.CertFile == "" {
 		errs = multierr.Append(errs, errNoCert)
 	}
 
-	if c.Logs.TLS.KeyFile == "" {
+	if l.TLS.KeyFile == "" {
 		errs = multierr.Append(errs, errNoKey)
 	}
 
 	return errs
 }
+
+func (p PullConfig) validate() error {
+	var errs error
+	if p.Bucket == "" {
+		errs = multierr.Append(errs, errNoBucket)
+	}
+
+	if p.Region == "" {
+		errs = multierr.Append(errs, errNoRegion)
+	}
+
+	if p.AccessKeyID != "" && p.SecretAccessKey == "" {
+		errs = multierr.Append(errs, errNoSecretAccessKey)
+	}
+
+	if p.AccessKeyID == "" && p.SecretAccessKey != "" {
+		errs = multierr.Append(errs, errNoAccessKeyID)
+	}
+
+	if p.PollInterval <= 0 {
+		errs = multierr.Append(errs, errInvalidPollInterval)
+	}
+
+	return errs
+}
@@ -6,11 +6,13 @@ package cloudflarereceiver // import "github.com/open-telemetry/opentelemetry-co
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver/internal/metadata"
 )
@@ -25,6 +27,7 @@ func TestValidate(t *testing.T) {
 			name: "Valid config with tls",
 			config: Config{
 				Logs: LogsConfig{
+					Mode:     logsModePush,
 					Endpoint: "0.0.0.0:9999",
 					TLS: &configtls.TLSServerSetting{
 						TLSSetting: configtls.TLSSetting{
@@ -39,6 +42,7 @@ func TestValidate(t *testing.T) {
 			name: "missing endpoint",
 			config: Config{
 				Logs: LogsConfig{
+					Mode: logsModePush,
 					TLS: &configtls.TLSServerSetting{
 						TLSSetting: configtls.TLSSetting{
 							CertFile: "some_cert_file",
@@ -53,6 +57,7 @@ func TestValidate(t *testing.T) {
 			name: "Invalid endpoint",
 			config: Config{
 				Logs: LogsConfig{
+					Mode:     logsModePush,
 					Endpoint: "9999",
 					TLS: &configtls.TLSServerSetting{
 						TLSSetting: configtls.TLSSetting{
@@ -68,6 +73,7 @@ func TestValidate(t *testing.T) {
 			name: "TLS config missing key",
 			config: Config{
 				Logs: LogsConfig{
+					Mode:     logsModePush,
 					Endpoint: "0.0.0.0:9999",
 					TLS: &configtls.TLSServerSetting{
 						TLSSetting: configtls.TLSSetting{
@@ -82,6 +88,7 @@ func TestValidate(t *testing.T) {
 			name: "TLS config missing cert",
 			config: Config{
 				Logs: LogsConfig{
+					Mode:     logsModePush,
 					Endpoint: "0.0.0.0:9999",
 					TLS: &configtls.TLSServerSetting{
 						TLSSetting: configtls.TLSSetting{
@@ -92,6 +99,68 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: errNoCert.Error(),
 		},
+		{
+			name: "Valid config with pull",
+			config: Config{
+				Logs: LogsConfig{
+					Mode: logsModePull,
+					Pull: PullConfig{
+						Bucket:       "logs",
+						Region:       "auto",
+						PollInterval: time.Minute,
+					},
+				},
+			},
+		},
+		{
+			name: "Pull config missing bucket and region",
+			config: Config{
+				Logs: LogsConfig{
+					Mode: logsModePull,
+					Pull: PullConfig{
+						PollInterval: time.Minute,
+					},
+				},
+			},
+			expectedErr: multierr.Combine(errNoBucket, errNoRegion).Error(),
+		},
+		{
+			name: "Pull config missing secret access key",
+			config: Config{
+				Logs: LogsConfig{
+					Mode: logsModePull,
+					Pull: PullConfig{
+						Bucket:       "logs",
+						Region:       "auto",
+						AccessKeyID:  "0123456789abcdef",
+						PollInterval: time.Minute,
+					},
+				},
+			},
+			expectedErr: errNoSecretAccessKey.Error(),
+		},
+		{
+			name: "Pull config invalid poll interval",
+			config: Config{
+				Logs: LogsConfig{
+					Mode: logsModePull,
+					Pull: PullConfig{
+						Bucket: "logs",
+						Region: "auto",
+					},
+				},
+			},
+			expectedErr: errInvalidPollInterval.Error(),
+		},
+		{
+			name: "Unknown mode",
+			config: Config{
+				Logs: LogsConfig{
+					Mode: "poll",
+				},
+			},
+			expectedErr: errNoModeRecognized.Error(),
+		},
 	}
 
 	for _, tc := range cases {
@@ -110,6 +179,8 @@ func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 
+	fileStorageID := component.NewID("file_storage")
+
 	cases := []struct {
 		name           string
 		expectedConfig component.Config
@@ -118,6 +189,7 @@ func TestLoadConfig(t *testing.T) {
 			name: "",
 			expectedConfig: &Config{
 				Logs: LogsConfig{
+					Mode:     logsModePush,
 					Endpoint: "0.0.0.0:12345",
 					TLS: &configtls.TLSServerSetting{
 						TLSSetting: configtls.TLSSetting{
@@ -131,6 +203,29 @@ func TestLoadConfig(t *testing.T) {
 						"ClientIP":         "http_request.client_ip",
 						"ClientRequestURI": "http_request.uri",
 					},
+					Pull: PullConfig{
+						PollInterval: time.Minute,
+					},
+				},
+			},
+		},
+		{
+			name: "pull",
+			expectedConfig: &Config{
+				Logs: LogsConfig{
+					Mode: logsModePull,
+					TLS:  &configtls.TLSServerSetting{},
+					Pull: PullConfig{
+						Bucket:          "logs",
+						Prefix:          "http_requests/",
+						Region:          "auto",
+						Endpoint:        "https://0123456789abcdef0123456789abcdef.r2.cloudflarestorage.com",
+						AccessKeyID:     "0123456789abcdef",
+						SecretAccessKey: "0123456789abcdef0123456789abcdef",
+						PollInterval:    30 * time.Second,
+						StorageID:       &fileStorageID,
+					},
+					TimestampField: "EdgeStartTimestamp",
 				},
 			},
 		},
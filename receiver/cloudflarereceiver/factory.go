@@ -36,8 +36,12 @@ func createLogsReceiver(
 func createDefaultConfig() component.Config {
 	return &Config{
 		Logs: LogsConfig{
+			Mode:           logsModePush,
 			TimestampField: defaultTimestampField,
 			TLS:            &configtls.TLSServerSetting{},
+			Pull: PullConfig{
+				PollInterval: defaultPullPollInterval,
+			},
 		},
 	}
 }
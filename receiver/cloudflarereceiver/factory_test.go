@@ -30,3 +30,18 @@ func TestCreateLogsReceiver(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+func TestCreatePullLogsReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Logs.Mode = logsModePull
+	cfg.Logs.Pull.Bucket = "logs"
+	cfg.Logs.Pull.Region = "auto"
+
+	_, err := NewFactory().CreateLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		cfg,
+		nil,
+	)
+	require.NoError(t, err)
+}
@@ -18,6 +18,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	rcvr "go.opentelemetry.io/collector/receiver"
@@ -33,6 +34,15 @@ type logsReceiver struct {
 	consumer consumer.Logs
 	wg       *sync.WaitGroup
 	id       component.ID // ID of the receiver component
+
+	// pull mode
+	objects       objectStore
+	storageClient storage.Client
+	cancel        context.CancelFunc
+	// lastKey is the key of the last log file read, and since the time before which
+	// the log files are skipped until the first checkpoint.
+	lastKey string
+	since   time.Time
 }
 
 const secretHeaderName = "X-CF-Secret"
@@ -47,6 +57,15 @@ func newLogsReceiver(params rcvr.CreateSettings, cfg *Config, consumer consumer.
 		id:       params.ID,
 	}
 
+	if recv.cfg.Mode == logsModePull {
+		objects, err := newObjectStore(&recv.cfg.Pull)
+		if err != nil {
+			return nil, err
+		}
+		recv.objects = objects
+		return recv, nil
+	}
+
 	tlsConfig, err := recv.cfg.TLS.LoadTLSConfig()
 	if err != nil {
 		return nil, err
@@ -63,10 +82,20 @@ func newLogsReceiver(params rcvr.CreateSettings, cfg *Config, consumer consumer.
 }
 
 func (l *logsReceiver) Start(ctx context.Context, host component.Host) error {
+	if l.cfg.Mode == logsModePull {
+		return l.startPulling(ctx, host)
+	}
 	return l.startListening(ctx, host)
 }
 
 func (l *logsReceiver) Shutdown(ctx context.Context) error {
+	if l.cfg.Mode == logsModePull {
+		return l.shutdownPuller(ctx)
+	}
+	return l.shutdownListener(ctx)
+}
+
+func (l *logsReceiver) shutdownListener(ctx context.Context) error {
 	l.logger.Debug("Shutting down server")
 	err := l.server.Shutdown(ctx)
 	if err != nil {
@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudflarereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver"
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+// lastObjectKey is the storage key of the key of the last log file read from the bucket.
+const lastObjectKey = "last_object"
+
+// ownershipChallengePrefix is the prefix of the files written by Cloudflare to the bucket
+// to validate the destination of a LogPush job.
+const ownershipChallengePrefix = "ownership-challenge"
+
+// objectStore is the subset of the S3 API used to read the log files.
+type objectStore interface {
+	ListObjectsV2PagesWithContext(ctx aws.Context, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, opts ...request.Option) error
+	GetObjectWithContext(ctx aws.Context, input *s3.GetObjectInput, opts ...request.Option) (*s3.GetObjectOutput, error)
+}
+
+func newObjectStore(cfg *PullConfig) (objectStore, error) {
+	sessionConfig := &aws.Config{
+		Region: aws.String(cfg.Region),
+	}
+	if cfg.Endpoint != "" {
+		sessionConfig.Endpoint = aws.String(cfg.Endpoint)
+	}
+	if cfg.AccessKeyID != "" {
+		sessionConfig.Credentials = credentials.NewStaticCredentials(cfg.AccessKeyID, string(cfg.SecretAccessKey), "")
+	}
+
+	sess, err := session.NewSession(sessionConfig)
+	if err != nil {
+		return nil, err
+	}
+	return s3.New(sess), nil
+}
+
+func (l *logsReceiver) startPulling(ctx context.Context, host component.Host) error {
+	storageClient, err := getStorageClient(ctx, host, l.cfg.Pull.StorageID, l.id)
+	if err != nil {
+		return fmt.Errorf("failed to get storage client: %w", err)
+	}
+	l.storageClient = storageClient
+
+	lastKey, err := l.storageClient.Get(ctx, lastObjectKey)
+	if err != nil {
+		return fmt.Errorf("unable to read the last log file read: %w", err)
+	}
+	if lastKey != nil {
+		l.lastKey = string(lastKey)
+	} else {
+		// the log files already in the bucket are skipped on the first start
+		l.since = time.Now()
+	}
+
+	pollCtx, cancel := context.WithCancel(context.Background())
+	l.cancel = cancel
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		t := time.NewTicker(l.cfg.Pull.PollInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := l.poll(pollCtx); err != nil {
+					l.logger.Error("error while reading log files", zap.Error(err))
+				}
+			case <-pollCtx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (l *logsReceiver) shutdownPuller(ctx context.Context) error {
+	l.logger.Debug("Shutting down puller")
+	if l.cancel != nil {
+		l.cancel()
+	}
+	l.wg.Wait()
+	if l.storageClient == nil {
+		return nil
+	}
+	return l.storageClient.Close(ctx)
+}
+
+// poll reads the log files written to the bucket after the last log file read. LogPush
+// names the log files after the time range of the logs they hold, so that the files
+// are read in the order they were written.
+func (l *logsReceiver) poll(ctx context.Context) error {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(l.cfg.Pull.Bucket),
+	}
+	if l.cfg.Pull.Prefix != "" {
+		input.Prefix = aws.String(l.cfg.Pull.Prefix)
+	}
+	if l.lastKey != "" {
+		input.StartAfter = aws.String(l.lastKey)
+	}
+
+	var keys []string
+	skippedKey := ""
+	err := l.objects.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			key := aws.StringValue(object.Key)
+			if !l.since.IsZero() && aws.TimeValue(object.LastModified).Before(l.since) {
+				skippedKey = key
+				continue
+			}
+			keys = append(keys, key)
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list log files: %w", err)
+	}
+
+	if len(keys) == 0 && skippedKey != "" {
+		return l.checkpoint(ctx, skippedKey)
+	}
+
+	for _, key := range keys {
+		if err = l.readObject(ctx, key); err != nil {
+			return err
+		}
+		if err = l.checkpoint(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *logsReceiver) checkpoint(ctx context.Context, key string) error {
+	l.lastKey = key
+	l.since = time.Time{}
+	if err := l.storageClient.Set(ctx, lastObjectKey, []byte(key)); err != nil {
+		return fmt.Errorf("unable to write checkpoint: %w", err)
+	}
+	return nil
+}
+
+// readObject reads a log file and sends its logs. Log files that cannot be parsed are skipped.
+func (l *logsReceiver) readObject(ctx context.Context, key string) error {
+	if strings.HasPrefix(path.Base(key), ownershipChallengePrefix) {
+		l.logger.Debug("Skipping ownership challenge file", zap.String("key", key))
+		return nil
+	}
+
+	output, err := l.objects.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(l.cfg.Pull.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get log file %s: %w", key, err)
+	}
+	defer output.Body.Close()
+
+	var reader io.Reader = output.Body
+	if strings.HasSuffix(key, ".gz") || aws.StringValue(output.ContentEncoding) == "gzip" {
+		gzipReader, err := gzip.NewReader(output.Body)
+		if err != nil {
+			l.logger.Error("Failed to read gzipped log file, skipping", zap.String("key", key), zap.Error(err))
+			return nil
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read log file %s: %w", key, err)
+	}
+
+	logs, err := parsePayload(payload)
+	if err != nil {
+		l.logger.Error("Failed to convert cloudflare log file to maps, skipping", zap.String("key", key), zap.Error(err))
+		return nil
+	}
+	if len(logs) == 0 {
+		return nil
+	}
+
+	if err = l.consumer.ConsumeLogs(ctx, l.processLogs(pcommon.NewTimestampFromTime(time.Now()), logs)); err != nil {
+		return fmt.Errorf("failed to consume logs of %s: %w", key, err)
+	}
+	return nil
+}
+
+func getStorageClient(ctx context.Context, host component.Host, storageID *component.ID, componentID component.ID) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+
+	extension, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindReceiver, componentID, "")
+}
@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudflarereceiver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+type mockObject struct {
+	body         string
+	lastModified time.Time
+}
+
+// mockObjectStore serves objects from memory, listing them in the lexical order of their keys.
+type mockObjectStore struct {
+	objects map[string]mockObject
+	getErr  error
+	gets    []string
+}
+
+func (m *mockObjectStore) ListObjectsV2PagesWithContext(_ aws.Context, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, _ ...request.Option) error {
+	var keys []string
+	for key := range m.objects {
+		if strings.HasPrefix(key, aws.StringValue(input.Prefix)) && key > aws.StringValue(input.StartAfter) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	// one object per page
+	for i, key := range keys {
+		page := &s3.ListObjectsV2Output{
+			Contents: []*s3.Object{{Key: aws.String(key), LastModified: aws.Time(m.objects[key].lastModified)}},
+		}
+		if !fn(page, i == len(keys)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func (m *mockObjectStore) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	key := aws.StringValue(input.Key)
+	m.gets = append(m.gets, key)
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewBufferString(m.objects[key].body))}, nil
+}
+
+func newPullReceiver(t *testing.T, objects *mockObjectStore, sink *consumertest.LogsSink, storageID *component.ID) *logsReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Logs.Mode = logsModePull
+	cfg.Logs.Pull.Bucket = "logs"
+	cfg.Logs.Pull.Prefix = "http_requests/"
+	cfg.Logs.Pull.Region = "auto"
+	cfg.Logs.Pull.PollInterval = time.Hour
+	cfg.Logs.Pull.StorageID = storageID
+
+	recv := newReceiver(t, cfg, sink)
+	recv.objects = objects
+	return recv
+}
+
+func TestPoll(t *testing.T) {
+	before := time.Now().Add(-time.Hour)
+	objects := &mockObjectStore{
+		objects: map[string]mockObject{
+			"http_requests/20230801/20230801T100000Z_20230801T100030Z_abcdef.log.gz": {
+				body:         gzippedMessage(`{"ClientIP": "89.163.253.200", "ZoneName": "otlpdev.net"}`),
+				lastModified: before,
+			},
+			"other/20230801/20230801T100000Z_20230801T100030Z_abcdef.log.gz": {
+				body: gzippedMessage(`{"ClientIP": "89.163.253.201"}`),
+			},
+		},
+	}
+	sink := &consumertest.LogsSink{}
+	recv := newPullReceiver(t, objects, sink, nil)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, recv.Shutdown(context.Background()))
+	}()
+
+	// The log files already in the bucket on the first start are skipped.
+	require.NoError(t, recv.poll(context.Background()))
+	require.Equal(t, 0, sink.LogRecordCount())
+	require.Equal(t, "http_requests/20230801/20230801T100000Z_20230801T100030Z_abcdef.log.gz", recv.lastKey)
+
+	objects.objects["http_requests/20230801/ownership-challenge-1234abcd.txt"] = mockObject{body: "challenge"}
+	objects.objects["http_requests/20230801/20230801T100030Z_20230801T100100Z_abcdef.log.gz"] = mockObject{
+		body: gzippedMessage(`{"ClientIP": "89.163.253.200", "ZoneName": "otlpdev.net"}
+{"ClientIP": "89.163.253.201", "ZoneName": "otlpdev.net"}`),
+	}
+	objects.objects["http_requests/20230801/20230801T100100Z_20230801T100130Z_abcdef.log"] = mockObject{
+		body: `{"ClientIP": "89.163.253.202", "ZoneName": "otlpdev.net"}`,
+	}
+	require.NoError(t, recv.poll(context.Background()))
+	require.Equal(t, 3, sink.LogRecordCount())
+	require.Equal(t, []string{
+		"http_requests/20230801/20230801T100030Z_20230801T100100Z_abcdef.log.gz",
+		"http_requests/20230801/20230801T100100Z_20230801T100130Z_abcdef.log",
+	}, objects.gets)
+
+	zone, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get("cloudflare.zone")
+	require.True(t, ok)
+	require.Equal(t, "otlpdev.net", zone.Str())
+
+	// The log files already read are not read again.
+	require.NoError(t, recv.poll(context.Background()))
+	require.Equal(t, 3, sink.LogRecordCount())
+	require.Equal(t, "http_requests/20230801/ownership-challenge-1234abcd.txt", recv.lastKey)
+}
+
+func TestPollCheckpoint(t *testing.T) {
+	objects := &mockObjectStore{
+		objects: map[string]mockObject{
+			"http_requests/20230801/20230801T100000Z_20230801T100030Z_abcdef.log.gz": {
+				body: gzippedMessage(`{"ClientIP": "89.163.253.200"}`),
+			},
+		},
+	}
+	storageID := storagetest.NewStorageID("test")
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("test", t.TempDir())
+
+	sink := &consumertest.LogsSink{}
+	recv := newPullReceiver(t, objects, sink, &storageID)
+	require.NoError(t, recv.Start(context.Background(), host))
+	recv.since = time.Time{}
+	require.NoError(t, recv.poll(context.Background()))
+	require.Equal(t, 1, sink.LogRecordCount())
+	require.NoError(t, recv.Shutdown(context.Background()))
+
+	// The receiver resumes after the last log file read.
+	objects.objects["http_requests/20230801/20230801T100030Z_20230801T100100Z_abcdef.log.gz"] = mockObject{
+		body: gzippedMessage(`{"ClientIP": "89.163.253.201"}`),
+	}
+	recv = newPullReceiver(t, objects, sink, &storageID)
+	require.NoError(t, recv.Start(context.Background(), host))
+	require.Equal(t, "http_requests/20230801/20230801T100000Z_20230801T100030Z_abcdef.log.gz", recv.lastKey)
+	require.NoError(t, recv.poll(context.Background()))
+	require.Equal(t, 2, sink.LogRecordCount())
+	require.NoError(t, recv.Shutdown(context.Background()))
+}
+
+func TestPollErrors(t *testing.T) {
+	objects := &mockObjectStore{
+		objects: map[string]mockObject{
+			"http_requests/20230801/20230801T100000Z_20230801T100030Z_abcdef.log.gz": {
+				body: "not gzipped",
+			},
+			"http_requests/20230801/20230801T100030Z_20230801T100100Z_abcdef.log": {
+				body: "not json",
+			},
+			"http_requests/20230801/20230801T100100Z_20230801T100130Z_abcdef.log": {
+				body: `{"ClientIP": "89.163.253.200"}`,
+			},
+		},
+	}
+	sink := &consumertest.LogsSink{}
+	recv := newPullReceiver(t, objects, sink, nil)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, recv.Shutdown(context.Background()))
+	}()
+	recv.since = time.Time{}
+
+	// The log files which cannot be read are retried.
+	objects.getErr = errors.New("access denied")
+	require.ErrorContains(t, recv.poll(context.Background()), "access denied")
+	require.Equal(t, "", recv.lastKey)
+
+	// The log files which cannot be parsed are skipped.
+	objects.getErr = nil
+	require.NoError(t, recv.poll(context.Background()))
+	require.Equal(t, 1, sink.LogRecordCount())
+	require.Equal(t, "http_requests/20230801/20230801T100100Z_20230801T100130Z_abcdef.log", recv.lastKey)
+}
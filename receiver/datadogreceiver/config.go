@@ -5,13 +5,36 @@
 package datadogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/datadogreceiver"
 
 import (
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 )
 
+var errInvalidAggregationInterval = errors.New("dogstatsd aggregation_interval must be a positive duration")
+
 type Config struct {
 	confighttp.HTTPServerSettings `mapstructure:",squash"`
 	// ReadTimeout of the http server
 	ReadTimeout time.Duration `mapstructure:"read_timeout"`
+	// DogStatsD configures the reception of the DogStatsD metrics by the metrics pipelines
+	DogStatsD DogStatsDConfig `mapstructure:"dogstatsd"`
+}
+
+// DogStatsDConfig configures the reception of the DogStatsD metrics, received on the
+// /dogstatsd/v2/proxy endpoint of the HTTP server and on a Unix domain socket.
+type DogStatsDConfig struct {
+	// Socket is the path of the Unix domain socket the DogStatsD datagrams are received on,
+	// the metrics being received by the HTTP server only when empty
+	Socket string `mapstructure:"socket"`
+	// AggregationInterval is the interval the received metrics are aggregated over
+	AggregationInterval time.Duration `mapstructure:"aggregation_interval"`
+}
+
+// Validate checks the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.DogStatsD.AggregationInterval <= 0 {
+		return errInvalidAggregationInterval
+	}
+	return nil
 }
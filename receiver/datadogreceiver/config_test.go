@@ -14,3 +14,11 @@ func TestCreateDefaultConfig(t *testing.T) {
 	cfg := factory.CreateDefaultConfig()
 	assert.NotNil(t, cfg, "failed to create default config")
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.DogStatsD.AggregationInterval = 0
+	assert.ErrorIs(t, cfg.Validate(), errInvalidAggregationInterval)
+}
@@ -0,0 +1,354 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/datadogreceiver"
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	semconv "go.opentelemetry.io/collector/semconv/v1.16.0"
+	"go.uber.org/multierr"
+)
+
+// The DogStatsD metric types, https://docs.datadoghq.com/developers/dogstatsd/datagram_shell/
+const (
+	dogStatsDCount        = "c"
+	dogStatsDGauge        = "g"
+	dogStatsDTiming       = "ms"
+	dogStatsDHistogram    = "h"
+	dogStatsDDistribution = "d"
+	dogStatsDSet          = "s"
+)
+
+// dogStatsDResourceTags are the tags of the DogStatsD metrics translated to the attributes of
+// their resource, the other tags being translated to the attributes of their data points.
+var dogStatsDResourceTags = map[string]string{
+	"host":           semconv.AttributeHostName,
+	"service":        semconv.AttributeServiceName,
+	"env":            semconv.AttributeDeploymentEnvironment,
+	"version":        semconv.AttributeServiceVersion,
+	"container_id":   semconv.AttributeContainerID,
+	"container_name": semconv.AttributeContainerName,
+	"image_name":     semconv.AttributeContainerImageName,
+	"image_tag":      semconv.AttributeContainerImageTag,
+}
+
+type dogStatsDTag struct {
+	key   string
+	value string
+}
+
+// dogStatsDMetric is a metric of a DogStatsD datagram.
+type dogStatsDMetric struct {
+	name       string
+	metricType string
+	// values are the values of the metric, several values being sent by the clients of the
+	// protocol v1.1
+	values     []string
+	sampleRate float64
+	// resourceTags and tags are the translated tags, sorted by key
+	resourceTags []dogStatsDTag
+	tags         []dogStatsDTag
+}
+
+// parseDogStatsDMetric parses a line of a DogStatsD datagram, the events and service checks
+// being ignored.
+func parseDogStatsDMetric(line string) (metric dogStatsDMetric, ok bool, err error) {
+	if strings.HasPrefix(line, "_e{") || strings.HasPrefix(line, "_sc|") {
+		return dogStatsDMetric{}, false, nil
+	}
+	fields := strings.Split(line, "|")
+	if len(fields) < 2 {
+		return dogStatsDMetric{}, false, fmt.Errorf("invalid DogStatsD metric %q: missing type", line)
+	}
+	nameAndValues := strings.Split(fields[0], ":")
+	if len(nameAndValues) < 2 || nameAndValues[0] == "" {
+		return dogStatsDMetric{}, false, fmt.Errorf("invalid DogStatsD metric %q: missing value", line)
+	}
+	metric = dogStatsDMetric{
+		name:       nameAndValues[0],
+		metricType: fields[1],
+		values:     nameAndValues[1:],
+		sampleRate: 1,
+	}
+	switch metric.metricType {
+	case dogStatsDCount, dogStatsDGauge, dogStatsDTiming, dogStatsDHistogram, dogStatsDDistribution, dogStatsDSet:
+	default:
+		return dogStatsDMetric{}, false, fmt.Errorf("invalid DogStatsD metric %q: unsupported type %q", line, metric.metricType)
+	}
+
+	for _, field := range fields[2:] {
+		switch {
+		case strings.HasPrefix(field, "@"):
+			rate, err := strconv.ParseFloat(field[1:], 64)
+			if err != nil || rate <= 0 || rate > 1 {
+				return dogStatsDMetric{}, false, fmt.Errorf("invalid DogStatsD metric %q: invalid sample rate %q", line, field)
+			}
+			metric.sampleRate = rate
+		case strings.HasPrefix(field, "#"):
+			for _, tag := range strings.Split(field[1:], ",") {
+				if tag != "" {
+					metric.addTag(tag)
+				}
+			}
+		case strings.HasPrefix(field, "c:"):
+			metric.resourceTags = append(metric.resourceTags, dogStatsDTag{key: semconv.AttributeContainerID, value: field[2:]})
+		}
+		// the other fields, such as the timestamps, are ignored
+	}
+	sortDogStatsDTags(metric.resourceTags)
+	sortDogStatsDTags(metric.tags)
+	return metric, true, nil
+}
+
+func (m *dogStatsDMetric) addTag(tag string) {
+	key, value, _ := strings.Cut(tag, ":")
+	if attr, ok := dogStatsDResourceTags[key]; ok {
+		m.resourceTags = append(m.resourceTags, dogStatsDTag{key: attr, value: value})
+		return
+	}
+	m.tags = append(m.tags, dogStatsDTag{key: translateDataDogKeyToOtel(key), value: value})
+}
+
+func sortDogStatsDTags(tags []dogStatsDTag) {
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].key < tags[j].key })
+}
+
+// dogStatsDTagsKey returns a key identifying the tags, which are sorted.
+func dogStatsDTagsKey(tags []dogStatsDTag) string {
+	var b strings.Builder
+	for _, tag := range tags {
+		b.WriteString(tag.key)
+		b.WriteByte(0)
+		b.WriteString(tag.value)
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+type dogStatsDMetricKey struct {
+	name       string
+	metricType string
+	tags       string
+}
+
+// dogStatsDPoint aggregates the values of a metric during an aggregation interval.
+type dogStatsDPoint struct {
+	tags []dogStatsDTag
+	// value is the sum of the counts, or the last value of the gauges
+	value float64
+	// count, sum, min and max summarize the values of the timings, histograms and distributions
+	count    float64
+	sum      float64
+	min      float64
+	max      float64
+	setItems map[string]struct{}
+}
+
+type dogStatsDResource struct {
+	tags   []dogStatsDTag
+	points map[dogStatsDMetricKey]*dogStatsDPoint
+}
+
+// dogStatsDAggregator aggregates the DogStatsD metrics until they are flushed, the counts
+// being sent as delta sums, the gauges as gauges, the timings, histograms and distributions
+// as delta histograms without buckets, and the sets as gauges of their number of items.
+type dogStatsDAggregator struct {
+	mu        sync.Mutex
+	resources map[string]*dogStatsDResource
+	start     time.Time
+}
+
+func newDogStatsDAggregator(start time.Time) *dogStatsDAggregator {
+	return &dogStatsDAggregator{
+		resources: map[string]*dogStatsDResource{},
+		start:     start,
+	}
+}
+
+// aggregate aggregates the metrics of the lines of the DogStatsD payload, returning the errors
+// of the invalid lines.
+func (a *dogStatsDAggregator) aggregate(payload string) error {
+	var errs []error
+	for _, line := range strings.Split(payload, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		metric, ok, err := parseDogStatsDMetric(line)
+		if err == nil && ok {
+			err = a.aggregateMetric(metric)
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return multierr.Combine(errs...)
+}
+
+func (a *dogStatsDAggregator) aggregateMetric(metric dogStatsDMetric) error {
+	values := make([]float64, 0, len(metric.values))
+	if metric.metricType != dogStatsDSet {
+		for _, v := range metric.values {
+			value, err := strconv.ParseFloat(v, 64)
+			if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+				return fmt.Errorf("invalid DogStatsD metric %q: invalid value %q", metric.name, v)
+			}
+			values = append(values, value)
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	resourceKey := dogStatsDTagsKey(metric.resourceTags)
+	resource, ok := a.resources[resourceKey]
+	if !ok {
+		resource = &dogStatsDResource{tags: metric.resourceTags, points: map[dogStatsDMetricKey]*dogStatsDPoint{}}
+		a.resources[resourceKey] = resource
+	}
+	key := dogStatsDMetricKey{name: metric.name, metricType: metric.metricType, tags: dogStatsDTagsKey(metric.tags)}
+	point, ok := resource.points[key]
+	if !ok {
+		point = &dogStatsDPoint{tags: metric.tags}
+		resource.points[key] = point
+	}
+
+	switch metric.metricType {
+	case dogStatsDCount:
+		for _, value := range values {
+			point.value += value / metric.sampleRate
+		}
+	case dogStatsDGauge:
+		point.value = values[len(values)-1]
+	case dogStatsDSet:
+		if point.setItems == nil {
+			point.setItems = map[string]struct{}{}
+		}
+		for _, v := range metric.values {
+			point.setItems[v] = struct{}{}
+		}
+	default:
+		for _, value := range values {
+			if point.count == 0 || value < point.min {
+				point.min = value
+			}
+			if point.count == 0 || value > point.max {
+				point.max = value
+			}
+			point.count += 1 / metric.sampleRate
+			point.sum += value / metric.sampleRate
+		}
+	}
+	return nil
+}
+
+// flush returns the metrics aggregated since the last flush.
+func (a *dogStatsDAggregator) flush(now time.Time) pmetric.Metrics {
+	a.mu.Lock()
+	resources := a.resources
+	start := a.start
+	a.resources = map[string]*dogStatsDResource{}
+	a.start = now
+	a.mu.Unlock()
+
+	md := pmetric.NewMetrics()
+	resourceKeys := make([]string, 0, len(resources))
+	for key := range resources {
+		resourceKeys = append(resourceKeys, key)
+	}
+	sort.Strings(resourceKeys)
+	for _, resourceKey := range resourceKeys {
+		resource := resources[resourceKey]
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.SetSchemaUrl(semconv.SchemaURL)
+		for _, tag := range resource.tags {
+			rm.Resource().Attributes().PutStr(tag.key, tag.value)
+		}
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName("DogStatsD")
+
+		keys := make([]dogStatsDMetricKey, 0, len(resource.points))
+		for key := range resource.points {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].name != keys[j].name {
+				return keys[i].name < keys[j].name
+			}
+			if keys[i].metricType != keys[j].metricType {
+				return keys[i].metricType < keys[j].metricType
+			}
+			return keys[i].tags < keys[j].tags
+		})
+		var m pmetric.Metric
+		for i, key := range keys {
+			// the points of a metric with different tags are the data points of the same metric
+			if i == 0 || key.name != keys[i-1].name || key.metricType != keys[i-1].metricType {
+				m = newDogStatsDMetric(sm.Metrics().AppendEmpty(), key)
+			}
+			appendDogStatsDPoint(m, key, resource.points[key], start, now)
+		}
+	}
+	return md
+}
+
+func newDogStatsDMetric(m pmetric.Metric, key dogStatsDMetricKey) pmetric.Metric {
+	m.SetName(key.name)
+	switch key.metricType {
+	case dogStatsDCount:
+		sum := m.SetEmptySum()
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		sum.SetIsMonotonic(true)
+	case dogStatsDGauge, dogStatsDSet:
+		m.SetEmptyGauge()
+	default:
+		if key.metricType == dogStatsDTiming {
+			m.SetUnit("ms")
+		}
+		m.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	}
+	return m
+}
+
+func appendDogStatsDPoint(m pmetric.Metric, key dogStatsDMetricKey, point *dogStatsDPoint, start, now time.Time) {
+	var attrs pcommon.Map
+	startTimestamp, timestamp := pcommon.NewTimestampFromTime(start), pcommon.NewTimestampFromTime(now)
+	switch key.metricType {
+	case dogStatsDCount:
+		dp := m.Sum().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(startTimestamp)
+		dp.SetTimestamp(timestamp)
+		dp.SetDoubleValue(point.value)
+		attrs = dp.Attributes()
+	case dogStatsDGauge:
+		dp := m.Gauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(timestamp)
+		dp.SetDoubleValue(point.value)
+		attrs = dp.Attributes()
+	case dogStatsDSet:
+		dp := m.Gauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(timestamp)
+		dp.SetIntValue(int64(len(point.setItems)))
+		attrs = dp.Attributes()
+	default:
+		dp := m.Histogram().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(startTimestamp)
+		dp.SetTimestamp(timestamp)
+		dp.SetCount(uint64(math.Round(point.count)))
+		dp.SetSum(point.sum)
+		dp.SetMin(point.min)
+		dp.SetMax(point.max)
+		attrs = dp.Attributes()
+	}
+	for _, tag := range point.tags {
+		attrs.PutStr(tag.key, tag.value)
+	}
+}
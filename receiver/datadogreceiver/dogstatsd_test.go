@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestParseDogStatsDMetric(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		expected dogStatsDMetric
+		ignored  bool
+		err      string
+	}{
+		{
+			name: "count",
+			line: "page.views:1|c",
+			expected: dogStatsDMetric{
+				name:       "page.views",
+				metricType: "c",
+				values:     []string{"1"},
+				sampleRate: 1,
+			},
+		},
+		{
+			name: "tags and sample rate",
+			line: "request.latency:12.5:20|ms|@0.5|#env:prod,route:/cart,service:checkout,beta|c:abc123|T1656581400",
+			expected: dogStatsDMetric{
+				name:       "request.latency",
+				metricType: "ms",
+				values:     []string{"12.5", "20"},
+				sampleRate: 0.5,
+				resourceTags: []dogStatsDTag{
+					{key: "container.id", value: "abc123"},
+					{key: "deployment.environment", value: "prod"},
+					{key: "service.name", value: "checkout"},
+				},
+				tags: []dogStatsDTag{
+					{key: "beta", value: ""},
+					{key: "route", value: "/cart"},
+				},
+			},
+		},
+		{
+			name:    "event",
+			line:    "_e{5,4}:title|text",
+			ignored: true,
+		},
+		{
+			name:    "service check",
+			line:    "_sc|my.check|0",
+			ignored: true,
+		},
+		{
+			name: "missing type",
+			line: "page.views:1",
+			err:  `invalid DogStatsD metric "page.views:1": missing type`,
+		},
+		{
+			name: "missing value",
+			line: "page.views|c",
+			err:  `invalid DogStatsD metric "page.views|c": missing value`,
+		},
+		{
+			name: "unsupported type",
+			line: "page.views:1|x",
+			err:  `invalid DogStatsD metric "page.views:1|x": unsupported type "x"`,
+		},
+		{
+			name: "invalid sample rate",
+			line: "page.views:1|c|@2",
+			err:  `invalid DogStatsD metric "page.views:1|c|@2": invalid sample rate "@2"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metric, ok, err := parseDogStatsDMetric(tt.line)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, !tt.ignored, ok)
+			if !tt.ignored {
+				assert.Equal(t, tt.expected, metric)
+			}
+		})
+	}
+}
+
+func TestDogStatsDAggregator(t *testing.T) {
+	start := time.Unix(1000, 0)
+	a := newDogStatsDAggregator(start)
+	require.NoError(t, a.aggregate(`page.views:1|c|#route:/cart
+page.views:2|c|@0.5|#route:/cart
+page.views:1|c|#route:/home
+queue.size:10|g|#host:worker-1
+queue.size:7|g|#host:worker-1
+request.latency:10:30|ms|#host:worker-1
+request.latency:20|ms|@0.5|#host:worker-1
+users:alice|s
+users:bob|s
+users:alice|s
+_e{5,4}:title|text
+`))
+	assert.EqualError(t, a.aggregate("page.views:one|c\nqueue.size|g"), `invalid DogStatsD metric "page.views": invalid value "one"; invalid DogStatsD metric "queue.size|g": missing value`)
+
+	now := start.Add(10 * time.Second)
+	md := a.flush(now)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	// the metrics without resource tags
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, 0, rm.Resource().Attributes().Len())
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	views := metrics.At(0)
+	assert.Equal(t, "page.views", views.Name())
+	require.Equal(t, pmetric.MetricTypeSum, views.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, views.Sum().AggregationTemporality())
+	assert.True(t, views.Sum().IsMonotonic())
+	require.Equal(t, 2, views.Sum().DataPoints().Len())
+	cart := views.Sum().DataPoints().At(0)
+	assert.Equal(t, 5.0, cart.DoubleValue())
+	assert.Equal(t, map[string]interface{}{"route": "/cart"}, cart.Attributes().AsRaw())
+	assert.Equal(t, start.UnixNano(), int64(cart.StartTimestamp()))
+	assert.Equal(t, now.UnixNano(), int64(cart.Timestamp()))
+	assert.Equal(t, 1.0, views.Sum().DataPoints().At(1).DoubleValue())
+
+	users := metrics.At(1)
+	assert.Equal(t, "users", users.Name())
+	require.Equal(t, pmetric.MetricTypeGauge, users.Type())
+	assert.Equal(t, int64(2), users.Gauge().DataPoints().At(0).IntValue())
+
+	// the metrics of the host
+	rm = md.ResourceMetrics().At(1)
+	assert.Equal(t, map[string]interface{}{"host.name": "worker-1"}, rm.Resource().Attributes().AsRaw())
+	metrics = rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	size := metrics.At(0)
+	assert.Equal(t, "queue.size", size.Name())
+	require.Equal(t, pmetric.MetricTypeGauge, size.Type())
+	assert.Equal(t, 7.0, size.Gauge().DataPoints().At(0).DoubleValue())
+
+	latency := metrics.At(1)
+	assert.Equal(t, "request.latency", latency.Name())
+	assert.Equal(t, "ms", latency.Unit())
+	require.Equal(t, pmetric.MetricTypeHistogram, latency.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, latency.Histogram().AggregationTemporality())
+	dp := latency.Histogram().DataPoints().At(0)
+	assert.Equal(t, uint64(4), dp.Count())
+	assert.Equal(t, 80.0, dp.Sum())
+	assert.Equal(t, 10.0, dp.Min())
+	assert.Equal(t, 30.0, dp.Max())
+
+	// the aggregation restarts after the flush
+	assert.Equal(t, 0, a.flush(now.Add(10*time.Second)).DataPointCount())
+}
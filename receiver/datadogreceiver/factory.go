@@ -21,7 +21,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithTraces(createTracesReceiver, metadata.TracesStability))
+		receiver.WithTraces(createTracesReceiver, metadata.TracesStability),
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
 
 }
 
@@ -31,15 +32,46 @@ func createDefaultConfig() component.Config {
 			Endpoint: "localhost:8126",
 		},
 		ReadTimeout: 60 * time.Second,
+		DogStatsD: DogStatsDConfig{
+			AggregationInterval: 10 * time.Second,
+		},
+	}
+}
+
+func createTracesReceiver(_ context.Context, params receiver.CreateSettings, cfg component.Config, consumer consumer.Traces) (receiver.Traces, error) {
+	r, err := getOrAddReceiver(cfg.(*Config), params)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.Unwrap().(*datadogReceiver).registerTracesConsumer(consumer); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func createMetricsReceiver(_ context.Context, params receiver.CreateSettings, cfg component.Config, consumer consumer.Metrics) (receiver.Metrics, error) {
+	r, err := getOrAddReceiver(cfg.(*Config), params)
+	if err != nil {
+		return nil, err
 	}
+	if err = r.Unwrap().(*datadogReceiver).registerMetricsConsumer(consumer); err != nil {
+		return nil, err
+	}
+	return r, nil
 }
 
-func createTracesReceiver(_ context.Context, params receiver.CreateSettings, cfg component.Config, consumer consumer.Traces) (r receiver.Traces, err error) {
-	rcfg := cfg.(*Config)
-	r = receivers.GetOrAdd(cfg, func() component.Component {
-		dd, _ := newDataDogReceiver(rcfg, consumer, params)
+// getOrAddReceiver returns the receiver of the configuration, shared by the traces and the
+// metrics pipelines.
+func getOrAddReceiver(cfg *Config, params receiver.CreateSettings) (*sharedcomponent.SharedComponent, error) {
+	var err error
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		var dd *datadogReceiver
+		dd, err = newDataDogReceiver(cfg, params)
 		return dd
 	})
+	if err != nil {
+		return nil, err
+	}
 	return r, nil
 }
 
@@ -7,6 +7,7 @@ import (
 )
 
 const (
-	Type            = "datadog"
-	TracesStability = component.StabilityLevelAlpha
+	Type             = "datadog"
+	TracesStability  = component.StabilityLevelAlpha
+	MetricsStability = component.StabilityLevelDevelopment
 )
@@ -7,51 +7,98 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/trace/pb"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
 )
 
+// dogStatsDMaxDatagramSize is the maximum size of the DogStatsD datagrams received on the
+// Unix domain socket
+const dogStatsDMaxDatagramSize = 65535
+
 type datadogReceiver struct {
-	address      string
-	config       *Config
-	params       receiver.CreateSettings
-	nextConsumer consumer.Traces
-	server       *http.Server
-	tReceiver    *obsreport.Receiver
-}
+	address             string
+	config              *Config
+	params              receiver.CreateSettings
+	nextTracesConsumer  consumer.Traces
+	nextMetricsConsumer consumer.Metrics
+	server              *http.Server
+	tReceiver           *obsreport.Receiver
+	mReceiver           *obsreport.Receiver
 
-func newDataDogReceiver(config *Config, nextConsumer consumer.Traces, params receiver.CreateSettings) (receiver.Traces, error) {
-	if nextConsumer == nil {
-		return nil, component.ErrNilNextConsumer
-	}
+	// aggregator aggregates the DogStatsD metrics when the receiver has a metrics consumer
+	aggregator *dogStatsDAggregator
+	conn       net.PacketConn
+	// readWg waits for the reading of the Unix domain socket, wg for the flushing
+	readWg sync.WaitGroup
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
 
+func newDataDogReceiver(config *Config, params receiver.CreateSettings) (*datadogReceiver, error) {
 	instance, err := obsreport.NewReceiver(obsreport.ReceiverSettings{LongLivedCtx: false, ReceiverID: params.ID, Transport: "http", ReceiverCreateSettings: params})
 	if err != nil {
 		return nil, err
 	}
+	mInstance, err := obsreport.NewReceiver(obsreport.ReceiverSettings{LongLivedCtx: false, ReceiverID: params.ID, Transport: "dogstatsd", ReceiverCreateSettings: params})
+	if err != nil {
+		return nil, err
+	}
 
 	return &datadogReceiver{
-		params:       params,
-		config:       config,
-		nextConsumer: nextConsumer,
+		params: params,
+		config: config,
 		server: &http.Server{
 			ReadTimeout: config.ReadTimeout,
 		},
 		tReceiver: instance,
+		mReceiver: mInstance,
+		stopCh:    make(chan struct{}),
 	}, nil
 }
 
+func (ddr *datadogReceiver) registerTracesConsumer(nextConsumer consumer.Traces) error {
+	if nextConsumer == nil {
+		return component.ErrNilNextConsumer
+	}
+	ddr.nextTracesConsumer = nextConsumer
+	return nil
+}
+
+func (ddr *datadogReceiver) registerMetricsConsumer(nextConsumer consumer.Metrics) error {
+	if nextConsumer == nil {
+		return component.ErrNilNextConsumer
+	}
+	ddr.nextMetricsConsumer = nextConsumer
+	return nil
+}
+
 func (ddr *datadogReceiver) Start(_ context.Context, host component.Host) error {
 	ddmux := http.NewServeMux()
-	ddmux.HandleFunc("/v0.3/traces", ddr.handleTraces)
-	ddmux.HandleFunc("/v0.4/traces", ddr.handleTraces)
-	ddmux.HandleFunc("/v0.5/traces", ddr.handleTraces)
-	ddmux.HandleFunc("/v0.7/traces", ddr.handleTraces)
+	if ddr.nextTracesConsumer != nil {
+		ddmux.HandleFunc("/v0.3/traces", ddr.handleTraces)
+		ddmux.HandleFunc("/v0.4/traces", ddr.handleTraces)
+		ddmux.HandleFunc("/v0.5/traces", ddr.handleTraces)
+		ddmux.HandleFunc("/v0.7/traces", ddr.handleTraces)
+	}
+	if ddr.nextMetricsConsumer != nil {
+		ddr.aggregator = newDogStatsDAggregator(time.Now())
+		ddmux.HandleFunc("/dogstatsd/v2/proxy", ddr.handleDogStatsD)
+		if err := ddr.startDogStatsD(); err != nil {
+			return err
+		}
+	}
 
 	var err error
 	ddr.server, err = ddr.config.HTTPServerSettings.ToServer(
@@ -77,8 +124,99 @@ func (ddr *datadogReceiver) Start(_ context.Context, host component.Host) error
 	return nil
 }
 
+// startDogStatsD starts receiving the DogStatsD datagrams on the Unix domain socket, when
+// configured, and flushing the aggregated metrics.
+func (ddr *datadogReceiver) startDogStatsD() error {
+	if socket := ddr.config.DogStatsD.Socket; socket != "" {
+		// the socket of a previous run is removed, as the Datadog Agent does
+		if fi, err := os.Stat(socket); err == nil && fi.Mode()&os.ModeSocket != 0 {
+			if err = os.Remove(socket); err != nil {
+				return fmt.Errorf("failed to remove the dogstatsd socket: %w", err)
+			}
+		}
+		conn, err := net.ListenPacket("unixgram", socket)
+		if err != nil {
+			return fmt.Errorf("failed to create dogstatsd listener: %w", err)
+		}
+		ddr.conn = conn
+		ddr.readWg.Add(1)
+		go ddr.receiveDogStatsD()
+	}
+
+	ddr.wg.Add(1)
+	go func() {
+		defer ddr.wg.Done()
+		ticker := time.NewTicker(ddr.config.DogStatsD.AggregationInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ddr.stopCh:
+				ddr.flushDogStatsD()
+				return
+			case <-ticker.C:
+				ddr.flushDogStatsD()
+			}
+		}
+	}()
+	return nil
+}
+
+func (ddr *datadogReceiver) receiveDogStatsD() {
+	defer ddr.readWg.Done()
+	buf := make([]byte, dogStatsDMaxDatagramSize)
+	for {
+		n, _, err := ddr.conn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				ddr.params.Logger.Error("Failed to read dogstatsd datagram", zap.Error(err))
+			}
+			return
+		}
+		if err = ddr.aggregator.aggregate(string(buf[:n])); err != nil {
+			ddr.params.Logger.Debug("Invalid dogstatsd metrics", zap.Error(err))
+		}
+	}
+}
+
+// flushDogStatsD sends the metrics aggregated since the last flush.
+func (ddr *datadogReceiver) flushDogStatsD() {
+	md := ddr.aggregator.flush(time.Now())
+	if md.DataPointCount() == 0 {
+		return
+	}
+	ctx := ddr.mReceiver.StartMetricsOp(context.Background())
+	err := ddr.nextMetricsConsumer.ConsumeMetrics(ctx, md)
+	ddr.mReceiver.EndMetricsOp(ctx, "dogstatsd", md.DataPointCount(), err)
+	if err != nil {
+		ddr.params.Logger.Error("Metrics consumer errored out", zap.Error(err))
+	}
+}
+
 func (ddr *datadogReceiver) Shutdown(ctx context.Context) (err error) {
-	return ddr.server.Shutdown(ctx)
+	// The listeners are stopped before the last flush, so that the metrics received
+	// until then are flushed too.
+	err = ddr.server.Shutdown(ctx)
+	if ddr.aggregator != nil {
+		if ddr.conn != nil {
+			err = multierr.Combine(err, ddr.conn.Close(), os.Remove(ddr.config.DogStatsD.Socket))
+			ddr.readWg.Wait()
+		}
+		close(ddr.stopCh)
+		ddr.wg.Wait()
+	}
+	return err
+}
+
+func (ddr *datadogReceiver) handleDogStatsD(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, "Unable to read dogstatsd payload", http.StatusBadRequest)
+		return
+	}
+	if err = ddr.aggregator.aggregate(string(body)); err != nil {
+		ddr.params.Logger.Debug("Invalid dogstatsd metrics", zap.Error(err))
+	}
+	_, _ = w.Write([]byte("OK"))
 }
 
 func (ddr *datadogReceiver) handleTraces(w http.ResponseWriter, req *http.Request) {
@@ -99,7 +237,7 @@ func (ddr *datadogReceiver) handleTraces(w http.ResponseWriter, req *http.Reques
 
 	otelTraces := toTraces(ddTraces, req)
 	spanCount = otelTraces.SpanCount()
-	err = ddr.nextConsumer.ConsumeTraces(obsCtx, otelTraces)
+	err = ddr.nextTracesConsumer.ConsumeTraces(obsCtx, otelTraces)
 	if err != nil {
 		http.Error(w, "Trace consumer errored out", http.StatusInternalServerError)
 		ddr.params.Logger.Error("Trace consumer errored out")
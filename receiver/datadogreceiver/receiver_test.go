@@ -7,9 +7,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,10 +43,10 @@ func TestDatadogServer(t *testing.T) {
 	cfg.Endpoint = "localhost:0" // Using a randomly assigned address
 	dd, err := newDataDogReceiver(
 		cfg,
-		consumertest.NewNop(),
 		receivertest.NewNopCreateSettings(),
 	)
 	require.NoError(t, err, "Must not error when creating receiver")
+	require.NoError(t, dd.registerTracesConsumer(consumertest.NewNop()))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	t.Cleanup(cancel)
@@ -72,7 +76,7 @@ func TestDatadogServer(t *testing.T) {
 
 			req, err := http.NewRequest(
 				http.MethodPost,
-				fmt.Sprintf("http://%s/v0.7/traces", dd.(*datadogReceiver).address),
+				fmt.Sprintf("http://%s/v0.7/traces", dd.address),
 				tc.op,
 			)
 			require.NoError(t, err, "Must not error when creating request")
@@ -88,3 +92,77 @@ func TestDatadogServer(t *testing.T) {
 		})
 	}
 }
+
+func TestDatadogReceiverDogStatsD(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:0"
+	cfg.DogStatsD.Socket = filepath.Join(t.TempDir(), "dsd.socket")
+	cfg.DogStatsD.AggregationInterval = 10 * time.Millisecond
+	dd, err := newDataDogReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err, "Must not error when creating receiver")
+	sink := new(consumertest.MetricsSink)
+	require.NoError(t, dd.registerMetricsConsumer(sink))
+
+	require.NoError(t, dd.Start(context.Background(), componenttest.NewNopHost()))
+
+	conn, err := net.Dial("unixgram", cfg.DogStatsD.Socket)
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("page.views:1|c|#env:prod\npage.views:2|c|#env:prod"))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	resp, err := http.Post(fmt.Sprintf("http://%s/dogstatsd/v2/proxy", dd.address), "text/plain", strings.NewReader("queue.size:3|g"))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// the traces endpoints are only served to the traces pipelines
+	resp, err = http.Post(fmt.Sprintf("http://%s/v0.7/traces", dd.address), "application/msgpack", strings.NewReader(""))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	received := func(name string) bool {
+		for _, md := range sink.AllMetrics() {
+			rms := md.ResourceMetrics()
+			for i := 0; i < rms.Len(); i++ {
+				metrics := rms.At(i).ScopeMetrics().At(0).Metrics()
+				for j := 0; j < metrics.Len(); j++ {
+					if metrics.At(j).Name() == name {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+	assert.Eventually(t, func() bool {
+		return received("page.views") && received("queue.size")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, dd.Shutdown(context.Background()))
+	_, err = os.Stat(cfg.DogStatsD.Socket)
+	assert.True(t, os.IsNotExist(err), "the socket must be removed")
+}
+
+func TestDatadogReceiverDogStatsDFlushOnShutdown(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:0"
+	cfg.DogStatsD.AggregationInterval = time.Hour
+	dd, err := newDataDogReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err, "Must not error when creating receiver")
+	sink := new(consumertest.MetricsSink)
+	require.NoError(t, dd.registerMetricsConsumer(sink))
+
+	require.NoError(t, dd.Start(context.Background(), componenttest.NewNopHost()))
+
+	resp, err := http.Post(fmt.Sprintf("http://%s/dogstatsd/v2/proxy", dd.address), "text/plain", strings.NewReader("queue.size:3|g"))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 0, sink.DataPointCount())
+
+	// the metrics received before the shutdown are flushed by it
+	require.NoError(t, dd.Shutdown(context.Background()))
+	assert.Equal(t, 1, sink.DataPointCount())
+}
@@ -35,8 +35,19 @@ const (
 	// Requirement Level: Optional
 	// Examples: '228114450199004348'
 	attributeDatadogSpanID = "datadog.span.id"
+	// datadogTraceIDHighKey is the meta holding the hexadecimal high 64 bits of the
+	// 128-bit trace IDs
+	datadogTraceIDHighKey = "_dd.p.tid"
 )
 
+// intAttributes are the attributes whose Datadog meta values are translated to integers,
+// as in the OpenTelemetry semantic conventions.
+var intAttributes = map[string]bool{
+	semconv.AttributeHTTPStatusCode: true,
+	semconv.AttributeNetPeerPort:    true,
+	semconv.AttributeProcessPID:     true,
+}
+
 func upsertHeadersAttributes(req *http.Request, attrs pcommon.Map) {
 	if ddTracerVersion := req.Header.Get("Datadog-Meta-Tracer-Version"); ddTracerVersion != "" {
 		attrs.PutStr(semconv.AttributeTelemetrySDKVersion, "Datadog-"+ddTracerVersion)
@@ -90,7 +101,7 @@ func toTraces(payload *pb.TracerPayload, req *http.Request) ptrace.Traces {
 			}
 			newSpan := slice.AppendEmpty()
 
-			newSpan.SetTraceID(uInt64ToTraceID(0, span.TraceID))
+			newSpan.SetTraceID(uInt64ToTraceID(traceIDHigh(span), span.TraceID))
 			newSpan.SetSpanID(uInt64ToSpanID(span.SpanID))
 			newSpan.SetStartTimestamp(pcommon.Timestamp(span.Start))
 			newSpan.SetEndTimestamp(pcommon.Timestamp(span.Start + span.Duration))
@@ -105,8 +116,20 @@ func toTraces(payload *pb.TracerPayload, req *http.Request) ptrace.Traces {
 			newSpan.Attributes().PutStr(attributeDatadogSpanID, strconv.FormatUint(span.SpanID, 10))
 			newSpan.Attributes().PutStr(attributeDatadogTraceID, strconv.FormatUint(span.TraceID, 10))
 			for k, v := range span.GetMeta() {
+				if k == datadogTraceIDHighKey {
+					continue
+				}
+				if k = translateDataDogKeyToOtel(k); len(k) > 0 {
+					putMetaAttribute(newSpan.Attributes(), k, v)
+				}
+			}
+			for k, v := range span.GetMetrics() {
 				if k = translateDataDogKeyToOtel(k); len(k) > 0 {
-					newSpan.Attributes().PutStr(k, v)
+					if intAttributes[k] {
+						newSpan.Attributes().PutInt(k, int64(v))
+					} else {
+						newSpan.Attributes().PutDouble(k, v)
+					}
 				}
 			}
 
@@ -150,6 +173,32 @@ func toTraces(payload *pb.TracerPayload, req *http.Request) ptrace.Traces {
 	return results
 }
 
+// traceIDHigh returns the high 64 bits of the 128-bit trace ID of the span, zero for the
+// 64-bit trace IDs.
+func traceIDHigh(span *pb.Span) uint64 {
+	tid, ok := span.GetMeta()[datadogTraceIDHighKey]
+	if !ok {
+		return 0
+	}
+	high, err := strconv.ParseUint(tid, 16, 64)
+	if err != nil {
+		return 0
+	}
+	return high
+}
+
+// putMetaAttribute puts the attribute of a Datadog meta, whose value is an integer when the
+// semantic conventions define an integer attribute.
+func putMetaAttribute(attrs pcommon.Map, k, v string) {
+	if intAttributes[k] {
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			attrs.PutInt(k, i)
+			return
+		}
+	}
+	attrs.PutStr(k, v)
+}
+
 func translateDataDogKeyToOtel(k string) string {
 	switch strings.ToLower(k) {
 	case "env":
@@ -170,6 +219,16 @@ func translateDataDogKeyToOtel(k string) string {
 		return semconv.AttributeExceptionStacktrace
 	case "error.msg":
 		return semconv.AttributeExceptionMessage
+	case "error.type":
+		return semconv.AttributeExceptionType
+	case "db.type":
+		return semconv.AttributeDBSystem
+	case "http.useragent":
+		return semconv.AttributeHTTPUserAgent
+	case "out.host":
+		return semconv.AttributeNetPeerName
+	case "out.port":
+		return semconv.AttributeNetPeerPort
 	default:
 		return k
 	}
@@ -74,7 +74,10 @@ func TestTracePayloadV05Unmarshalling(t *testing.T) {
 	assert.Equal(t, 1, translated.SpanCount(), "Span Count wrong")
 	span := translated.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
 	assert.NotNil(t, span)
-	assert.Equal(t, 7, span.Attributes().Len(), "missing attributes")
+	assert.Equal(t, 8, span.Attributes().Len(), "missing attributes")
+	metric, exists := span.Attributes().Get("X")
+	assert.True(t, exists, "span metric missing")
+	assert.Equal(t, 1.2, metric.Double(), "span metric value incorrect")
 	value, exists := span.Attributes().Get("service.name")
 	assert.True(t, exists, "service.name missing")
 	assert.Equal(t, "my-service", value.AsString(), "service.name attribute value incorrect")
@@ -111,6 +114,56 @@ func TestTracePayloadV07Unmarshalling(t *testing.T) {
 	assert.Equal(t, "my-resource", span.GetResource())
 }
 
+func TestTranslateConventions(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "/v0.4/traces", nil)
+	translated := toTraces(&pb.TracerPayload{
+		Chunks: []*pb.TraceChunk{{
+			Spans: []*pb.Span{{
+				Service: "my-service",
+				Name:    "http.request",
+				TraceID: 2,
+				SpanID:  3,
+				Meta: map[string]string{
+					"_dd.p.tid":        "6501b38400000000",
+					"http.status_code": "503",
+					"http.useragent":   "curl/8.0",
+					"db.type":          "postgresql",
+					"error.type":       "ConnectionError",
+					"out.host":         "db",
+					"out.port":         "not-a-port",
+				},
+				Metrics: map[string]float64{
+					"process_id":            42,
+					"_sampling_priority_v1": 1,
+				},
+			}},
+		}},
+	}, req)
+
+	require.Equal(t, 1, translated.SpanCount())
+	span := translated.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, uInt64ToTraceID(0x6501b38400000000, 2), span.TraceID())
+	assert.Equal(t, map[string]interface{}{
+		"dd.span.Resource":      "",
+		"datadog.span.id":       "3",
+		"datadog.trace.id":      "2",
+		"http.status_code":      int64(503),
+		"http.user_agent":       "curl/8.0",
+		"db.system":             "postgresql",
+		"exception.type":        "ConnectionError",
+		"net.peer.name":         "db",
+		"net.peer.port":         "not-a-port",
+		"process.pid":           int64(42),
+		"_sampling_priority_v1": float64(1),
+	}, span.Attributes().AsRaw())
+}
+
+func TestTraceIDHigh(t *testing.T) {
+	assert.Equal(t, uint64(0), traceIDHigh(&pb.Span{}))
+	assert.Equal(t, uint64(0), traceIDHigh(&pb.Span{Meta: map[string]string{"_dd.p.tid": "invalid"}}))
+	assert.Equal(t, uint64(0x6501b38400000000), traceIDHigh(&pb.Span{Meta: map[string]string{"_dd.p.tid": "6501b38400000000"}}))
+}
+
 func BenchmarkTranslatorv05(b *testing.B) {
 	b.StartTimer()
 	for n := 0; n < b.N; n++ {
@@ -45,6 +45,10 @@ type Config struct {
 	// Docker client API version. Default is 1.22
 	DockerAPIVersion float64 `mapstructure:"api_version"`
 
+	// The container events collected as log records when the receiver is used in a logs pipeline.
+	// Default is start, stop, die and oom.
+	ContainerEvents []string `mapstructure:"container_events"`
+
 	// MetricsBuilderConfig config. Enable or disable stats by name.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
@@ -59,5 +63,10 @@ func (config Config) Validate() error {
 	if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
 		return fmt.Errorf("api_version must be at least %v", minimalRequiredDockerAPIVersion)
 	}
+	for _, event := range config.ContainerEvents {
+		if _, ok := supportedContainerEvents[event]; !ok {
+			return fmt.Errorf("container_events contains unsupported event %q", event)
+		}
+	}
 	return nil
 }
@@ -48,6 +48,8 @@ func TestLoadConfig(t *testing.T) {
 					"another-*-container",
 				},
 
+				ContainerEvents: []string{"start", "oom"},
+
 				ContainerLabelsToMetricLabels: map[string]string{
 					"my.container.label":       "my-metric-label",
 					"my.other.container.label": "my-other-metric-label",
@@ -97,4 +99,7 @@ func TestValidateErrors(t *testing.T) {
 
 	cfg = &Config{ScraperControllerSettings: scraperhelper.ScraperControllerSettings{CollectionInterval: 1 * time.Second}, Endpoint: "someEndpoint", DockerAPIVersion: 1.21}
 	assert.Equal(t, "api_version must be at least 1.22", component.ValidateConfig(cfg).Error())
+
+	cfg = &Config{ScraperControllerSettings: scraperhelper.ScraperControllerSettings{CollectionInterval: 1 * time.Second}, Endpoint: "someEndpoint", DockerAPIVersion: 1.24, ContainerEvents: []string{"start", "boot"}}
+	assert.Equal(t, `container_events contains unsupported event "boot"`, component.ValidateConfig(cfg).Error())
 }
@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver"
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dtypes "github.com/docker/docker/api/types"
+	devents "github.com/docker/docker/api/types/events"
+	dfilters "github.com/docker/docker/api/types/filters"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	rcvr "go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
+)
+
+const (
+	eventDomainAttribute = "event.domain"
+	eventNameAttribute   = "event.name"
+	exitCodeAttribute    = "container.exit_code"
+
+	eventsRetryDelay = 3 * time.Second
+)
+
+// supportedContainerEvents are the container events reported by the Docker engine.
+var supportedContainerEvents = map[string]struct{}{
+	"attach": {}, "commit": {}, "copy": {}, "create": {}, "destroy": {}, "detach": {}, "die": {},
+	"exec_create": {}, "exec_detach": {}, "exec_die": {}, "exec_start": {}, "export": {},
+	"health_status": {}, "kill": {}, "oom": {}, "pause": {}, "rename": {}, "resize": {},
+	"restart": {}, "start": {}, "stop": {}, "top": {}, "unpause": {}, "update": {},
+}
+
+// eventsReceiver watches the container events of the Docker engine and emits them as log records.
+type eventsReceiver struct {
+	config     *Config
+	settings   rcvr.CreateSettings
+	consumer   consumer.Logs
+	obsrecv    *obsreport.Receiver
+	client     *docker.Client
+	retryDelay time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ rcvr.Logs = (*eventsReceiver)(nil)
+
+func newEventsReceiver(set rcvr.CreateSettings, config *Config, consumer consumer.Logs) (*eventsReceiver, error) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             set.ID,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &eventsReceiver{
+		config:     config,
+		settings:   set,
+		consumer:   consumer,
+		obsrecv:    obsrecv,
+		retryDelay: eventsRetryDelay,
+	}, nil
+}
+
+func (r *eventsReceiver) Start(_ context.Context, _ component.Host) error {
+	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	r.client, err = docker.NewDockerClient(dConfig, r.settings.Logger)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.eventLoop(ctx)
+	}()
+	return nil
+}
+
+func (r *eventsReceiver) Shutdown(_ context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+// eventLoop consumes the container events until the context is canceled. The events are
+// watched again since the last received event when the connection to the engine is lost.
+func (r *eventsReceiver) eventLoop(ctx context.Context) {
+	filters := dfilters.NewArgs(dfilters.Arg("type", "container"))
+	for _, event := range r.config.ContainerEvents {
+		filters.Add("event", event)
+	}
+	since := time.Now()
+	var lastEventNano int64
+
+EVENT_LOOP:
+	for {
+		options := dtypes.EventsOptions{
+			Filters: filters,
+			Since:   since.Format(time.RFC3339Nano),
+		}
+		eventCh, errCh := r.client.Events(ctx, options)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventCh:
+				// the events at the since time are sent again when watching the events again
+				if event.TimeNano <= lastEventNano {
+					continue
+				}
+				lastEventNano = event.TimeNano
+				since = time.Unix(0, event.TimeNano)
+				r.consumeEvent(ctx, event)
+			case err := <-errCh:
+				if ctx.Err() != nil {
+					return
+				}
+				r.settings.Logger.Error("Error watching docker container events", zap.Error(err))
+				select {
+				case <-time.After(r.retryDelay):
+					continue EVENT_LOOP
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+func (r *eventsReceiver) consumeEvent(ctx context.Context, event devents.Message) {
+	if r.client.ShouldBeExcluded(event.Actor.Attributes["image"]) {
+		return
+	}
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	r.eventResource(event).MoveTo(rl.Resource())
+	r.eventToLogRecord(event, rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty())
+
+	obsCtx := r.obsrecv.StartLogsOp(ctx)
+	err := r.consumer.ConsumeLogs(ctx, logs)
+	r.obsrecv.EndLogsOp(obsCtx, metadata.Type, 1, err)
+	if err != nil {
+		r.settings.Logger.Error("Failed to send docker container event", zap.Error(err), zap.String("id", event.Actor.ID))
+	}
+}
+
+// eventResource returns the resource of the container of the event. Its attributes are read from
+// the event since the container may not exist anymore.
+func (r *eventsReceiver) eventResource(event devents.Message) pcommon.Resource {
+	rb := metadata.NewResourceBuilder(r.config.MetricsBuilderConfig.ResourceAttributes)
+	rb.SetContainerRuntime("docker")
+	rb.SetContainerID(event.Actor.ID)
+	if name := event.Actor.Attributes["name"]; name != "" {
+		rb.SetContainerName(name)
+	}
+	if image := event.Actor.Attributes["image"]; image != "" {
+		rb.SetContainerImageName(image)
+	}
+	if project := event.Actor.Attributes[composeProjectLabel]; project != "" {
+		rb.SetContainerComposeProject(project)
+	}
+	if service := event.Actor.Attributes[composeServiceLabel]; service != "" {
+		rb.SetContainerComposeService(service)
+	}
+	resource := rb.Emit()
+
+	// the labels of the container are part of the event attributes
+	for k, label := range r.config.ContainerLabelsToMetricLabels {
+		if v := event.Actor.Attributes[k]; v != "" {
+			resource.Attributes().PutStr(label, v)
+		}
+	}
+	return resource
+}
+
+func (r *eventsReceiver) eventToLogRecord(event devents.Message, lr plog.LogRecord) {
+	// actions such as health_status have details after a colon, "health_status: healthy"
+	name, _, _ := strings.Cut(event.Action, ":")
+
+	lr.SetTimestamp(pcommon.Timestamp(event.TimeNano))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	lr.Body().SetStr(event.Action)
+	lr.Attributes().PutStr(eventDomainAttribute, "docker")
+	lr.Attributes().PutStr(eventNameAttribute, "container."+name)
+
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	switch name {
+	case "oom":
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+	case "die":
+		if exitCode, err := strconv.ParseInt(event.Actor.Attributes["exitCode"], 10, 64); err == nil {
+			lr.Attributes().PutInt(exitCodeAttribute, exitCode)
+			if exitCode != 0 {
+				lr.SetSeverityNumber(plog.SeverityNumberWarn)
+			}
+		}
+	}
+	lr.SetSeverityText(lr.SeverityNumber().String())
+}
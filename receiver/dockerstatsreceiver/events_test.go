@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+// +build !windows
+
+// TODO review if tests should succeed on Windows
+
+package dockerstatsreceiver
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	devents "github.com/docker/docker/api/types/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestEventsReceiver(t *testing.T) {
+	mockDockerEngine, err := dockerMockServer(&map[string]string{
+		"/v1.23/events": filepath.Join(mockFolder, "events", "events.json"),
+	})
+	require.NoError(t, err)
+	defer mockDockerEngine.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = mockDockerEngine.URL
+	cfg.ExcludedImages = []string{"undesired-container"}
+	cfg.ContainerLabelsToMetricLabels = map[string]string{"container.label": "my-label"}
+	cfg.MetricsBuilderConfig.ResourceAttributes.ContainerComposeProject.Enabled = true
+	cfg.MetricsBuilderConfig.ResourceAttributes.ContainerComposeService.Enabled = true
+
+	sink := &consumertest.LogsSink{}
+	recv, err := newEventsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	// the mock engine closes the connection after sending the events, they must not be sent again
+	recv.retryDelay = time.Hour
+
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 3
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, recv.Shutdown(context.Background()))
+
+	var records []plog.LogRecord
+	for _, logs := range sink.AllLogs() {
+		rl := logs.ResourceLogs().At(0)
+		assert.Equal(t, map[string]any{
+			"container.runtime":         "docker",
+			"container.id":              "10b703fb312b",
+			"container.name":            "shop-web-1",
+			"container.image.name":      "nginx:1.25",
+			"container.compose.project": "shop",
+			"container.compose.service": "web",
+			"my-label":                  "container-label",
+		}, rl.Resource().Attributes().AsRaw())
+		records = append(records, rl.ScopeLogs().At(0).LogRecords().At(0))
+	}
+
+	require.Len(t, records, 3)
+	assert.Equal(t, "start", records[0].Body().Str())
+	assert.Equal(t, pcommon.Timestamp(1687762436337809000), records[0].Timestamp())
+	assert.Equal(t, plog.SeverityNumberInfo, records[0].SeverityNumber())
+	assert.Equal(t, map[string]any{"event.domain": "docker", "event.name": "container.start"}, records[0].Attributes().AsRaw())
+
+	assert.Equal(t, "oom", records[1].Body().Str())
+	assert.Equal(t, plog.SeverityNumberError, records[1].SeverityNumber())
+
+	assert.Equal(t, "die", records[2].Body().Str())
+	assert.Equal(t, plog.SeverityNumberWarn, records[2].SeverityNumber())
+	assert.Equal(t, map[string]any{"event.domain": "docker", "event.name": "container.die", "container.exit_code": int64(137)}, records[2].Attributes().AsRaw())
+}
+
+func TestEventToLogRecordHealthStatus(t *testing.T) {
+	recv, err := newEventsReceiver(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config), consumertest.NewNop())
+	require.NoError(t, err)
+
+	lr := plog.NewLogRecord()
+	recv.eventToLogRecord(devents.Message{Action: "health_status: unhealthy", TimeNano: 1687762436337809000}, lr)
+	assert.Equal(t, "health_status: unhealthy", lr.Body().Str())
+	assert.Equal(t, map[string]any{"event.domain": "docker", "event.name": "container.health_status"}, lr.Attributes().AsRaw())
+}
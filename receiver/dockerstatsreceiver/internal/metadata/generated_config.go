@@ -307,13 +307,15 @@ type ResourceAttributeConfig struct {
 
 // ResourceAttributesConfig provides config for docker_stats resource attributes.
 type ResourceAttributesConfig struct {
-	ContainerCommandLine ResourceAttributeConfig `mapstructure:"container.command_line"`
-	ContainerHostname    ResourceAttributeConfig `mapstructure:"container.hostname"`
-	ContainerID          ResourceAttributeConfig `mapstructure:"container.id"`
-	ContainerImageID     ResourceAttributeConfig `mapstructure:"container.image.id"`
-	ContainerImageName   ResourceAttributeConfig `mapstructure:"container.image.name"`
-	ContainerName        ResourceAttributeConfig `mapstructure:"container.name"`
-	ContainerRuntime     ResourceAttributeConfig `mapstructure:"container.runtime"`
+	ContainerCommandLine    ResourceAttributeConfig `mapstructure:"container.command_line"`
+	ContainerComposeProject ResourceAttributeConfig `mapstructure:"container.compose.project"`
+	ContainerComposeService ResourceAttributeConfig `mapstructure:"container.compose.service"`
+	ContainerHostname       ResourceAttributeConfig `mapstructure:"container.hostname"`
+	ContainerID             ResourceAttributeConfig `mapstructure:"container.id"`
+	ContainerImageID        ResourceAttributeConfig `mapstructure:"container.image.id"`
+	ContainerImageName      ResourceAttributeConfig `mapstructure:"container.image.name"`
+	ContainerName           ResourceAttributeConfig `mapstructure:"container.name"`
+	ContainerRuntime        ResourceAttributeConfig `mapstructure:"container.runtime"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
@@ -321,6 +323,12 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		ContainerCommandLine: ResourceAttributeConfig{
 			Enabled: false,
 		},
+		ContainerComposeProject: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		ContainerComposeService: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		ContainerHostname: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -95,13 +95,15 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerUptime:                            MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					ContainerCommandLine: ResourceAttributeConfig{Enabled: true},
-					ContainerHostname:    ResourceAttributeConfig{Enabled: true},
-					ContainerID:          ResourceAttributeConfig{Enabled: true},
-					ContainerImageID:     ResourceAttributeConfig{Enabled: true},
-					ContainerImageName:   ResourceAttributeConfig{Enabled: true},
-					ContainerName:        ResourceAttributeConfig{Enabled: true},
-					ContainerRuntime:     ResourceAttributeConfig{Enabled: true},
+					ContainerCommandLine:    ResourceAttributeConfig{Enabled: true},
+					ContainerComposeProject: ResourceAttributeConfig{Enabled: true},
+					ContainerComposeService: ResourceAttributeConfig{Enabled: true},
+					ContainerHostname:       ResourceAttributeConfig{Enabled: true},
+					ContainerID:             ResourceAttributeConfig{Enabled: true},
+					ContainerImageID:        ResourceAttributeConfig{Enabled: true},
+					ContainerImageName:      ResourceAttributeConfig{Enabled: true},
+					ContainerName:           ResourceAttributeConfig{Enabled: true},
+					ContainerRuntime:        ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -178,13 +180,15 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerUptime:                            MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					ContainerCommandLine: ResourceAttributeConfig{Enabled: false},
-					ContainerHostname:    ResourceAttributeConfig{Enabled: false},
-					ContainerID:          ResourceAttributeConfig{Enabled: false},
-					ContainerImageID:     ResourceAttributeConfig{Enabled: false},
-					ContainerImageName:   ResourceAttributeConfig{Enabled: false},
-					ContainerName:        ResourceAttributeConfig{Enabled: false},
-					ContainerRuntime:     ResourceAttributeConfig{Enabled: false},
+					ContainerCommandLine:    ResourceAttributeConfig{Enabled: false},
+					ContainerComposeProject: ResourceAttributeConfig{Enabled: false},
+					ContainerComposeService: ResourceAttributeConfig{Enabled: false},
+					ContainerHostname:       ResourceAttributeConfig{Enabled: false},
+					ContainerID:             ResourceAttributeConfig{Enabled: false},
+					ContainerImageID:        ResourceAttributeConfig{Enabled: false},
+					ContainerImageName:      ResourceAttributeConfig{Enabled: false},
+					ContainerName:           ResourceAttributeConfig{Enabled: false},
+					ContainerRuntime:        ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -221,25 +225,29 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
-				ContainerCommandLine: ResourceAttributeConfig{Enabled: true},
-				ContainerHostname:    ResourceAttributeConfig{Enabled: true},
-				ContainerID:          ResourceAttributeConfig{Enabled: true},
-				ContainerImageID:     ResourceAttributeConfig{Enabled: true},
-				ContainerImageName:   ResourceAttributeConfig{Enabled: true},
-				ContainerName:        ResourceAttributeConfig{Enabled: true},
-				ContainerRuntime:     ResourceAttributeConfig{Enabled: true},
+				ContainerCommandLine:    ResourceAttributeConfig{Enabled: true},
+				ContainerComposeProject: ResourceAttributeConfig{Enabled: true},
+				ContainerComposeService: ResourceAttributeConfig{Enabled: true},
+				ContainerHostname:       ResourceAttributeConfig{Enabled: true},
+				ContainerID:             ResourceAttributeConfig{Enabled: true},
+				ContainerImageID:        ResourceAttributeConfig{Enabled: true},
+				ContainerImageName:      ResourceAttributeConfig{Enabled: true},
+				ContainerName:           ResourceAttributeConfig{Enabled: true},
+				ContainerRuntime:        ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
-				ContainerCommandLine: ResourceAttributeConfig{Enabled: false},
-				ContainerHostname:    ResourceAttributeConfig{Enabled: false},
-				ContainerID:          ResourceAttributeConfig{Enabled: false},
-				ContainerImageID:     ResourceAttributeConfig{Enabled: false},
-				ContainerImageName:   ResourceAttributeConfig{Enabled: false},
-				ContainerName:        ResourceAttributeConfig{Enabled: false},
-				ContainerRuntime:     ResourceAttributeConfig{Enabled: false},
+				ContainerCommandLine:    ResourceAttributeConfig{Enabled: false},
+				ContainerComposeProject: ResourceAttributeConfig{Enabled: false},
+				ContainerComposeService: ResourceAttributeConfig{Enabled: false},
+				ContainerHostname:       ResourceAttributeConfig{Enabled: false},
+				ContainerID:             ResourceAttributeConfig{Enabled: false},
+				ContainerImageID:        ResourceAttributeConfig{Enabled: false},
+				ContainerImageName:      ResourceAttributeConfig{Enabled: false},
+				ContainerName:           ResourceAttributeConfig{Enabled: false},
+				ContainerRuntime:        ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
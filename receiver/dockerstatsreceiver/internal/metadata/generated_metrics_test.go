@@ -279,6 +279,8 @@ func TestMetricsBuilder(t *testing.T) {
 
 			rb := mb.NewResourceBuilder()
 			rb.SetContainerCommandLine("container.command_line-val")
+			rb.SetContainerComposeProject("container.compose.project-val")
+			rb.SetContainerComposeService("container.compose.service-val")
 			rb.SetContainerHostname("container.hostname-val")
 			rb.SetContainerID("container.id-val")
 			rb.SetContainerImageID("container.image.id-val")
@@ -28,6 +28,20 @@ func (rb *ResourceBuilder) SetContainerCommandLine(val string) {
 	}
 }
 
+// SetContainerComposeProject sets provided value as "container.compose.project" attribute.
+func (rb *ResourceBuilder) SetContainerComposeProject(val string) {
+	if rb.config.ContainerComposeProject.Enabled {
+		rb.res.Attributes().PutStr("container.compose.project", val)
+	}
+}
+
+// SetContainerComposeService sets provided value as "container.compose.service" attribute.
+func (rb *ResourceBuilder) SetContainerComposeService(val string) {
+	if rb.config.ContainerComposeService.Enabled {
+		rb.res.Attributes().PutStr("container.compose.service", val)
+	}
+}
+
 // SetContainerHostname sets provided value as "container.hostname" attribute.
 func (rb *ResourceBuilder) SetContainerHostname(val string) {
 	if rb.config.ContainerHostname.Enabled {
@@ -14,6 +14,8 @@ func TestResourceBuilder(t *testing.T) {
 			cfg := loadResourceAttributesConfig(t, test)
 			rb := NewResourceBuilder(cfg)
 			rb.SetContainerCommandLine("container.command_line-val")
+			rb.SetContainerComposeProject("container.compose.project-val")
+			rb.SetContainerComposeService("container.compose.service-val")
 			rb.SetContainerHostname("container.hostname-val")
 			rb.SetContainerID("container.id-val")
 			rb.SetContainerImageID("container.image.id-val")
@@ -28,7 +30,7 @@ func TestResourceBuilder(t *testing.T) {
 			case "default":
 				assert.Equal(t, 5, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 7, res.Attributes().Len())
+				assert.Equal(t, 9, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -41,6 +43,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "container.command_line-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("container.compose.project")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "container.compose.project-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("container.compose.service")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "container.compose.service-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("container.hostname")
 			assert.True(t, ok)
 			if ok {
@@ -27,6 +27,9 @@ import (
 const (
 	defaultDockerAPIVersion         = 1.23
 	minimalRequiredDockerAPIVersion = 1.22
+
+	composeProjectLabel = "com.docker.compose.project"
+	composeServiceLabel = "com.docker.compose.service"
 )
 
 type resultV2 struct {
@@ -131,6 +134,12 @@ func (r *receiver) recordContainerStats(now pcommon.Timestamp, containerStats *d
 	rb.SetContainerName(strings.TrimPrefix(container.Name, "/"))
 	rb.SetContainerImageID(container.Image)
 	rb.SetContainerCommandLine(strings.Join(container.Config.Cmd, " "))
+	if project := container.Config.Labels[composeProjectLabel]; project != "" {
+		rb.SetContainerComposeProject(project)
+	}
+	if service := container.Config.Labels[composeServiceLabel]; service != "" {
+		rb.SetContainerComposeService(service)
+	}
 	resource := rb.Emit()
 
 	for k, label := range r.config.EnvVarsToMetricLabels {
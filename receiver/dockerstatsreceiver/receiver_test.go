@@ -105,13 +105,15 @@ var (
 
 	resourceAttributeEnabled     = metadata.ResourceAttributeConfig{Enabled: true}
 	allResourceAttributesEnabled = metadata.ResourceAttributesConfig{
-		ContainerCommandLine: resourceAttributeEnabled,
-		ContainerHostname:    resourceAttributeEnabled,
-		ContainerID:          resourceAttributeEnabled,
-		ContainerImageID:     resourceAttributeEnabled,
-		ContainerImageName:   resourceAttributeEnabled,
-		ContainerName:        resourceAttributeEnabled,
-		ContainerRuntime:     resourceAttributeEnabled,
+		ContainerCommandLine:    resourceAttributeEnabled,
+		ContainerComposeProject: resourceAttributeEnabled,
+		ContainerComposeService: resourceAttributeEnabled,
+		ContainerHostname:       resourceAttributeEnabled,
+		ContainerID:             resourceAttributeEnabled,
+		ContainerImageID:        resourceAttributeEnabled,
+		ContainerImageName:      resourceAttributeEnabled,
+		ContainerName:           resourceAttributeEnabled,
+		ContainerRuntime:        resourceAttributeEnabled,
 	}
 )
 
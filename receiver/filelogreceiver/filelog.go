@@ -4,11 +4,15 @@
 package filelogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver"
 
 import (
+	"fmt"
+
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/consumerretry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/file"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver/internal/metadata"
@@ -16,6 +20,7 @@ import (
 
 // NewFactory creates a factory for filelog receiver
 func NewFactory() receiver.Factory {
+	_ = view.Register(fileconsumer.MetricViews()...)
 	return adapter.NewFactory(ReceiverType{}, metadata.LogsStability)
 }
 
@@ -42,15 +47,32 @@ func createDefaultConfig() *FileLogConfig {
 	}
 }
 
-// BaseConfig gets the base config from config, for now
+// BaseConfig gets the base config from config, with the severity parsers of severity_from
+// after the configured operators
 func (f ReceiverType) BaseConfig(cfg component.Config) adapter.BaseConfig {
-	return cfg.(*FileLogConfig).BaseConfig
+	fileLogConfig := cfg.(*FileLogConfig)
+	baseConfig := fileLogConfig.BaseConfig
+	if fileLogConfig.SeverityFrom == severityFromAuto {
+		baseConfig.Operators = append(append([]operator.Config{}, baseConfig.Operators...), severityOperators()...)
+	}
+	return baseConfig
 }
 
 // FileLogConfig defines configuration for the filelog receiver
 type FileLogConfig struct {
 	InputConfig        file.Config `mapstructure:",squash"`
 	adapter.BaseConfig `mapstructure:",squash"`
+
+	// SeverityFrom enables the parsing of the severity from the common level attributes when set to "auto".
+	SeverityFrom string `mapstructure:"severity_from"`
+}
+
+// Validate checks the receiver configuration is valid
+func (cfg *FileLogConfig) Validate() error {
+	if cfg.SeverityFrom != "" && cfg.SeverityFrom != severityFromAuto {
+		return fmt.Errorf("unsupported severity_from %q, expected %q", cfg.SeverityFrom, severityFromAuto)
+	}
+	return nil
 }
 
 // InputConfig unmarshals the input operator
@@ -277,6 +277,9 @@ func testdataConfigYaml() *FileLogConfig {
 				MaxInterval:     30 * time.Second,
 				MaxElapsedTime:  5 * time.Minute,
 			},
+			ConverterWorkers: 2,
+			MaxBatchSize:     50,
+			FlushInterval:    50 * time.Millisecond,
 		},
 		InputConfig: func() file.Config {
 			c := file.NewConfig()
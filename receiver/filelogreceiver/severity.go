@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filelogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver"
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/severity"
+)
+
+// severityFromAuto parses the severity from the first common level attribute of the entries.
+const severityFromAuto = "auto"
+
+// severityAttributes are the common level attributes, by order of preference.
+var severityAttributes = []string{"level", "severity", "loglevel", "priority"}
+
+// levelMapping complements the default severity mapping with the level names of the common
+// logging libraries. The syslog level names are mapped as the syslog parser does.
+var levelMapping = map[string]interface{}{
+	"trace":  "verbose",
+	"info":   []interface{}{"information", "informational"},
+	"info2":  "notice",
+	"error2": []interface{}{"critical", "crit"},
+	"error3": "alert",
+	"fatal":  []interface{}{"emerg", "emergency", "panic", "dpanic"},
+}
+
+// priorityMapping maps the syslog priorities, as in the journald entries, as the syslog parser does.
+var priorityMapping = map[string]interface{}{
+	"fatal":  []interface{}{0, "emerg"},
+	"error3": []interface{}{1, "alert"},
+	"error2": []interface{}{2, "crit"},
+	"error":  []interface{}{3, "err"},
+	"warn":   []interface{}{4, "warning"},
+	"info2":  []interface{}{5, "notice"},
+	"info":   []interface{}{6, "info"},
+	"debug":  []interface{}{7, "debug"},
+}
+
+// severityOperators returns the severity parsers of the common level attributes. Each parser
+// only runs when its attribute is present and none of the preferred attributes is.
+func severityOperators() []operator.Config {
+	operators := make([]operator.Config, 0, len(severityAttributes))
+	for i, attr := range severityAttributes {
+		conditions := []string{fmt.Sprintf("attributes?.%s != nil", attr)}
+		for _, preferred := range severityAttributes[:i] {
+			conditions = append(conditions, fmt.Sprintf("attributes?.%s == nil", preferred))
+		}
+
+		cfg := severity.NewConfigWithID("severity_from_" + attr)
+		cfg.IfExpr = strings.Join(conditions, " && ")
+		field := entry.NewAttributeField(attr)
+		cfg.ParseFrom = &field
+		cfg.Mapping = levelMapping
+		if attr == "priority" {
+			cfg.Preset = "none"
+			cfg.Mapping = priorityMapping
+		}
+		operators = append(operators, operator.NewConfig(cfg))
+	}
+	return operators
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filelogreceiver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/json"
+)
+
+func TestSeverityFromAuto(t *testing.T) {
+	tempDir := t.TempDir()
+	lines := []string{
+		`{"level":"warning","msg":"level"}`,
+		`{"severity":"CRITICAL","msg":"severity"}`,
+		`{"level":"crit","msg":"crit"}`,
+		`{"level":"alert","msg":"alert"}`,
+		`{"level":"emerg","msg":"emerg"}`,
+		`{"priority":"crit","msg":"priority crit"}`,
+		`{"priority":"alert","msg":"priority alert"}`,
+		`{"loglevel":"notice","msg":"loglevel"}`,
+		`{"priority":3,"msg":"priority"}`,
+		`{"level":"error","priority":"6","msg":"preferred"}`,
+		`{"msg":"none"}`,
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "test.log"), []byte(strings.Join(lines, "\n")+"\n"), 0600))
+
+	cfg := createDefaultConfig()
+	cfg.InputConfig.Include = []string{filepath.Join(tempDir, "*")}
+	cfg.InputConfig.StartAt = "beginning"
+	cfg.Operators = []operator.Config{{Builder: json.NewConfig()}}
+	cfg.SeverityFrom = severityFromAuto
+
+	sink := new(consumertest.LogsSink)
+	rcvr, err := NewFactory().CreateLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, rcvr.Shutdown(context.Background()))
+	}()
+
+	require.Eventually(t, expectNLogs(sink, len(lines)), 2*time.Second, 5*time.Millisecond)
+
+	severities := map[string]plog.SeverityNumber{}
+	for _, logs := range sink.AllLogs() {
+		for i := 0; i < logs.ResourceLogs().Len(); i++ {
+			sls := logs.ResourceLogs().At(i).ScopeLogs()
+			for j := 0; j < sls.Len(); j++ {
+				lrs := sls.At(j).LogRecords()
+				for k := 0; k < lrs.Len(); k++ {
+					msg, ok := lrs.At(k).Attributes().Get("msg")
+					require.True(t, ok)
+					severities[msg.Str()] = lrs.At(k).SeverityNumber()
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[string]plog.SeverityNumber{
+		"level":          plog.SeverityNumberWarn,
+		"severity":       plog.SeverityNumberError2,
+		"crit":           plog.SeverityNumberError2,
+		"alert":          plog.SeverityNumberError3,
+		"emerg":          plog.SeverityNumberFatal,
+		"loglevel":       plog.SeverityNumberInfo2,
+		"priority":       plog.SeverityNumberError,
+		"priority crit":  plog.SeverityNumberError2,
+		"priority alert": plog.SeverityNumberError3,
+		"preferred":      plog.SeverityNumberError,
+		"none":           plog.SeverityNumberUnspecified,
+	}, severities)
+}
+
+func TestSeverityFromInvalid(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.SeverityFrom = "attributes.level"
+	assert.EqualError(t, component.ValidateConfig(cfg), `unsupported severity_from "attributes.level", expected "auto"`)
+}
@@ -25,10 +25,13 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for filestats metrics.
 type MetricsConfig struct {
-	FileAtime MetricConfig `mapstructure:"file.atime"`
-	FileCtime MetricConfig `mapstructure:"file.ctime"`
-	FileMtime MetricConfig `mapstructure:"file.mtime"`
-	FileSize  MetricConfig `mapstructure:"file.size"`
+	FileAtime      MetricConfig `mapstructure:"file.atime"`
+	FileChecksum   MetricConfig `mapstructure:"file.checksum"`
+	FileCtime      MetricConfig `mapstructure:"file.ctime"`
+	FileGrowthRate MetricConfig `mapstructure:"file.growth_rate"`
+	FileLineCount  MetricConfig `mapstructure:"file.line_count"`
+	FileMtime      MetricConfig `mapstructure:"file.mtime"`
+	FileSize       MetricConfig `mapstructure:"file.size"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -36,9 +39,18 @@ func DefaultMetricsConfig() MetricsConfig {
 		FileAtime: MetricConfig{
 			Enabled: false,
 		},
+		FileChecksum: MetricConfig{
+			Enabled: false,
+		},
 		FileCtime: MetricConfig{
 			Enabled: false,
 		},
+		FileGrowthRate: MetricConfig{
+			Enabled: false,
+		},
+		FileLineCount: MetricConfig{
+			Enabled: false,
+		},
 		FileMtime: MetricConfig{
 			Enabled: true,
 		},
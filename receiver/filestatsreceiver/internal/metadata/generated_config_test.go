@@ -26,10 +26,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					FileAtime: MetricConfig{Enabled: true},
-					FileCtime: MetricConfig{Enabled: true},
-					FileMtime: MetricConfig{Enabled: true},
-					FileSize:  MetricConfig{Enabled: true},
+					FileAtime:      MetricConfig{Enabled: true},
+					FileChecksum:   MetricConfig{Enabled: true},
+					FileCtime:      MetricConfig{Enabled: true},
+					FileGrowthRate: MetricConfig{Enabled: true},
+					FileLineCount:  MetricConfig{Enabled: true},
+					FileMtime:      MetricConfig{Enabled: true},
+					FileSize:       MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					FileName: ResourceAttributeConfig{Enabled: true},
@@ -41,10 +44,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					FileAtime: MetricConfig{Enabled: false},
-					FileCtime: MetricConfig{Enabled: false},
-					FileMtime: MetricConfig{Enabled: false},
-					FileSize:  MetricConfig{Enabled: false},
+					FileAtime:      MetricConfig{Enabled: false},
+					FileChecksum:   MetricConfig{Enabled: false},
+					FileCtime:      MetricConfig{Enabled: false},
+					FileGrowthRate: MetricConfig{Enabled: false},
+					FileLineCount:  MetricConfig{Enabled: false},
+					FileMtime:      MetricConfig{Enabled: false},
+					FileSize:       MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					FileName: ResourceAttributeConfig{Enabled: false},
@@ -62,6 +62,55 @@ func newMetricFileAtime(cfg MetricConfig) metricFileAtime {
 	return m
 }
 
+type metricFileChecksum struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills file.checksum metric with initial data.
+func (m *metricFileChecksum) init() {
+	m.data.SetName("file.checksum")
+	m.data.SetDescription("The CRC-32 checksum of the content of the file, which changes when the content changes. Computing it reads the whole file on each scrape.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFileChecksum) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFileChecksum) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFileChecksum) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFileChecksum(cfg MetricConfig) metricFileChecksum {
+	m := metricFileChecksum{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricFileCtime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -115,6 +164,104 @@ func newMetricFileCtime(cfg MetricConfig) metricFileCtime {
 	return m
 }
 
+type metricFileGrowthRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills file.growth_rate metric with initial data.
+func (m *metricFileGrowthRate) init() {
+	m.data.SetName("file.growth_rate")
+	m.data.SetDescription("The average rate at which the size of the file changed since the previous scrape, in bytes per second. Negative if the file was truncated.")
+	m.data.SetUnit("b/s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFileGrowthRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFileGrowthRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFileGrowthRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFileGrowthRate(cfg MetricConfig) metricFileGrowthRate {
+	m := metricFileGrowthRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricFileLineCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills file.line_count metric with initial data.
+func (m *metricFileLineCount) init() {
+	m.data.SetName("file.line_count")
+	m.data.SetDescription("The number of lines of the file. Counting them reads the whole file on each scrape.")
+	m.data.SetUnit("{line}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFileLineCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFileLineCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFileLineCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFileLineCount(cfg MetricConfig) metricFileLineCount {
+	m := metricFileLineCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricFileMtime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -218,15 +365,18 @@ func newMetricFileSize(cfg MetricConfig) metricFileSize {
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config          MetricsBuilderConfig // config of the metrics builder.
-	startTime       pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity int                  // maximum observed number of metrics per resource.
-	metricsBuffer   pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo       component.BuildInfo  // contains version information.
-	metricFileAtime metricFileAtime
-	metricFileCtime metricFileCtime
-	metricFileMtime metricFileMtime
-	metricFileSize  metricFileSize
+	config               MetricsBuilderConfig // config of the metrics builder.
+	startTime            pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity      int                  // maximum observed number of metrics per resource.
+	metricsBuffer        pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo            component.BuildInfo  // contains version information.
+	metricFileAtime      metricFileAtime
+	metricFileChecksum   metricFileChecksum
+	metricFileCtime      metricFileCtime
+	metricFileGrowthRate metricFileGrowthRate
+	metricFileLineCount  metricFileLineCount
+	metricFileMtime      metricFileMtime
+	metricFileSize       metricFileSize
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -241,14 +391,17 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:          mbc,
-		startTime:       pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:   pmetric.NewMetrics(),
-		buildInfo:       settings.BuildInfo,
-		metricFileAtime: newMetricFileAtime(mbc.Metrics.FileAtime),
-		metricFileCtime: newMetricFileCtime(mbc.Metrics.FileCtime),
-		metricFileMtime: newMetricFileMtime(mbc.Metrics.FileMtime),
-		metricFileSize:  newMetricFileSize(mbc.Metrics.FileSize),
+		config:               mbc,
+		startTime:            pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:        pmetric.NewMetrics(),
+		buildInfo:            settings.BuildInfo,
+		metricFileAtime:      newMetricFileAtime(mbc.Metrics.FileAtime),
+		metricFileChecksum:   newMetricFileChecksum(mbc.Metrics.FileChecksum),
+		metricFileCtime:      newMetricFileCtime(mbc.Metrics.FileCtime),
+		metricFileGrowthRate: newMetricFileGrowthRate(mbc.Metrics.FileGrowthRate),
+		metricFileLineCount:  newMetricFileLineCount(mbc.Metrics.FileLineCount),
+		metricFileMtime:      newMetricFileMtime(mbc.Metrics.FileMtime),
+		metricFileSize:       newMetricFileSize(mbc.Metrics.FileSize),
 	}
 	for _, op := range options {
 		op(mb)
@@ -311,7 +464,10 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricFileAtime.emit(ils.Metrics())
+	mb.metricFileChecksum.emit(ils.Metrics())
 	mb.metricFileCtime.emit(ils.Metrics())
+	mb.metricFileGrowthRate.emit(ils.Metrics())
+	mb.metricFileLineCount.emit(ils.Metrics())
 	mb.metricFileMtime.emit(ils.Metrics())
 	mb.metricFileSize.emit(ils.Metrics())
 
@@ -339,11 +495,26 @@ func (mb *MetricsBuilder) RecordFileAtimeDataPoint(ts pcommon.Timestamp, val int
 	mb.metricFileAtime.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordFileChecksumDataPoint adds a data point to file.checksum metric.
+func (mb *MetricsBuilder) RecordFileChecksumDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricFileChecksum.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordFileCtimeDataPoint adds a data point to file.ctime metric.
 func (mb *MetricsBuilder) RecordFileCtimeDataPoint(ts pcommon.Timestamp, val int64, filePermissionsAttributeValue string) {
 	mb.metricFileCtime.recordDataPoint(mb.startTime, ts, val, filePermissionsAttributeValue)
 }
 
+// RecordFileGrowthRateDataPoint adds a data point to file.growth_rate metric.
+func (mb *MetricsBuilder) RecordFileGrowthRateDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricFileGrowthRate.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordFileLineCountDataPoint adds a data point to file.line_count metric.
+func (mb *MetricsBuilder) RecordFileLineCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricFileLineCount.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordFileMtimeDataPoint adds a data point to file.mtime metric.
 func (mb *MetricsBuilder) RecordFileMtimeDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricFileMtime.recordDataPoint(mb.startTime, ts, val)
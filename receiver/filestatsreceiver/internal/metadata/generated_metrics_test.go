@@ -57,9 +57,18 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordFileAtimeDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordFileChecksumDataPoint(ts, 1)
+
 			allMetricsCount++
 			mb.RecordFileCtimeDataPoint(ts, 1, "file.permissions-val")
 
+			allMetricsCount++
+			mb.RecordFileGrowthRateDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordFileLineCountDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordFileMtimeDataPoint(ts, 1)
@@ -107,6 +116,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "file.checksum":
+					assert.False(t, validatedMetrics["file.checksum"], "Found a duplicate in the metrics slice: file.checksum")
+					validatedMetrics["file.checksum"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The CRC-32 checksum of the content of the file, which changes when the content changes. Computing it reads the whole file on each scrape.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "file.ctime":
 					assert.False(t, validatedMetrics["file.ctime"], "Found a duplicate in the metrics slice: file.ctime")
 					validatedMetrics["file.ctime"] = true
@@ -124,6 +145,30 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("file.permissions")
 					assert.True(t, ok)
 					assert.EqualValues(t, "file.permissions-val", attrVal.Str())
+				case "file.growth_rate":
+					assert.False(t, validatedMetrics["file.growth_rate"], "Found a duplicate in the metrics slice: file.growth_rate")
+					validatedMetrics["file.growth_rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The average rate at which the size of the file changed since the previous scrape, in bytes per second. Negative if the file was truncated.", ms.At(i).Description())
+					assert.Equal(t, "b/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "file.line_count":
+					assert.False(t, validatedMetrics["file.line_count"], "Found a duplicate in the metrics slice: file.line_count")
+					validatedMetrics["file.line_count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of lines of the file. Counting them reads the whole file on each scrape.", ms.At(i).Description())
+					assert.Equal(t, "{line}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "file.mtime":
 					assert.False(t, validatedMetrics["file.mtime"], "Found a duplicate in the metrics slice: file.mtime")
 					validatedMetrics["file.mtime"] = true
@@ -4,7 +4,11 @@
 package filestatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filestatsreceiver"
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"hash/crc32"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -24,6 +28,14 @@ type scraper struct {
 	include string
 	logger  *zap.Logger
 	mb      *metadata.MetricsBuilder
+	metrics metadata.MetricsConfig
+	// sizes holds the size of the files observed at the previous scrape, to compute their growth rate.
+	sizes map[string]fileSize
+}
+
+type fileSize struct {
+	size int64
+	time time.Time
 }
 
 func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
@@ -34,7 +46,9 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 
 	var scrapeErrors []error
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	scrapeTime := time.Now()
+	now := pcommon.NewTimestampFromTime(scrapeTime)
+	sizes := make(map[string]fileSize, len(matches))
 
 	for _, match := range matches {
 		fileinfo, err := os.Stat(match)
@@ -51,12 +65,34 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		s.mb.RecordFileMtimeDataPoint(now, fileinfo.ModTime().Unix())
 		collectStats(now, fileinfo, s.mb, s.logger)
 
+		if s.metrics.FileGrowthRate.Enabled {
+			if previous, ok := s.sizes[match]; ok {
+				if elapsed := scrapeTime.Sub(previous.time).Seconds(); elapsed > 0 {
+					s.mb.RecordFileGrowthRateDataPoint(now, float64(fileinfo.Size()-previous.size)/elapsed)
+				}
+			}
+			sizes[match] = fileSize{size: fileinfo.Size(), time: scrapeTime}
+		}
+
+		if (s.metrics.FileChecksum.Enabled || s.metrics.FileLineCount.Enabled) && fileinfo.Mode().IsRegular() {
+			checksum, lines, err := readContent(match)
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			} else {
+				s.mb.RecordFileChecksumDataPoint(now, int64(checksum))
+				s.mb.RecordFileLineCountDataPoint(now, lines)
+			}
+		}
+
 		rb := s.mb.NewResourceBuilder()
 		rb.SetFileName(fileinfo.Name())
 		rb.SetFilePath(path)
 		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 	}
 
+	// the files no longer matched are forgotten
+	s.sizes = sizes
+
 	if len(scrapeErrors) > 0 {
 		return s.mb.Emit(), scrapererror.NewPartialScrapeError(multierr.Combine(scrapeErrors...), len(scrapeErrors))
 	}
@@ -68,5 +104,40 @@ func newScraper(cfg *Config, settings receiver.CreateSettings) *scraper {
 		include: cfg.Include,
 		logger:  settings.TelemetrySettings.Logger,
 		mb:      metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		metrics: cfg.Metrics,
+		sizes:   map[string]fileSize{},
+	}
+}
+
+// readContent reads the file to compute the CRC-32 checksum of its content and count its lines,
+// the last line being counted even if it does not end with a newline.
+func readContent(path string) (uint32, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	hash := crc32.NewIEEE()
+	buf := make([]byte, 32*1024)
+	var lines int64
+	var last byte = '\n'
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+			lines += int64(bytes.Count(buf[:n], []byte{'\n'}))
+			last = buf[n-1]
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if last != '\n' {
+		lines++
 	}
+	return hash.Sum32(), lines, nil
 }
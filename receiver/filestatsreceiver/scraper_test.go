@@ -5,12 +5,16 @@ package filestatsreceiver
 
 import (
 	"context"
+	"hash/crc32"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/bmatcuk/doublestar/v4"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -86,3 +90,69 @@ func Test_Scrape_All(t *testing.T) {
 	require.Equal(t, "file.size", sizeMetric.Name())
 	require.Equal(t, int64(9), sizeMetric.Gauge().DataPoints().At(0).IntValue())
 }
+
+func Test_Scrape_Content(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := newDefaultConfig().(*Config)
+	cfg.Include = filepath.Join(tmpDir, "*.log")
+	cfg.Metrics.FileChecksum.Enabled = true
+	cfg.Metrics.FileLineCount.Enabled = true
+	cfg.Metrics.FileGrowthRate.Enabled = true
+
+	logFile := filepath.Join(tmpDir, "my.log")
+	content := []byte("first\nsecond\nthird")
+	require.NoError(t, os.WriteFile(logFile, content, 0600))
+
+	s := newScraper(cfg, receivertest.NewNopCreateSettings())
+	metrics, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+	values := metricValues(metrics)
+	require.Equal(t, int64(crc32.ChecksumIEEE(content)), values["file.checksum"])
+	require.Equal(t, int64(3), values["file.line_count"])
+	// The growth rate is only known from the second scrape.
+	require.NotContains(t, values, "file.growth_rate")
+
+	// Backdate the previous scrape to control the elapsed time.
+	s.sizes[logFile] = fileSize{size: int64(len(content)), time: time.Now().Add(-10 * time.Second)}
+	f, err := os.OpenFile(logFile, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString("\n" + strings.Repeat("a", 99))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	metrics, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	values = metricValues(metrics)
+	require.Equal(t, int64(4), values["file.line_count"])
+	require.NotEqual(t, int64(crc32.ChecksumIEEE(content)), values["file.checksum"])
+	require.InDelta(t, 10, values["file.growth_rate"], 0.1)
+
+	// The files no longer matched are forgotten.
+	require.NoError(t, os.Remove(logFile))
+	metrics, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, metrics.ResourceMetrics().Len())
+	require.Empty(t, s.sizes)
+}
+
+// metricValues returns the value of the first data point of each metric of the first resource.
+func metricValues(metrics pmetric.Metrics) map[string]any {
+	values := map[string]any{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		var dp pmetric.NumberDataPoint
+		if m.Type() == pmetric.MetricTypeGauge {
+			dp = m.Gauge().DataPoints().At(0)
+		} else {
+			dp = m.Sum().DataPoints().At(0)
+		}
+		if dp.ValueType() == pmetric.NumberDataPointValueTypeDouble {
+			values[m.Name()] = dp.DoubleValue()
+		} else {
+			values[m.Name()] = dp.IntValue()
+		}
+	}
+	return values
+}
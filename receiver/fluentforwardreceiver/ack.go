@@ -23,3 +23,11 @@ func (z AckResponse) EncodeMsg(en *msgp.Writer) error {
 	}
 	return nil
 }
+
+// ackableEvent is an event with the 'chunk' option, which is acknowledged once
+// its log records are consumed.
+type ackableEvent struct {
+	Event
+	// consumed receives the result of the consumption of the event
+	consumed chan<- error
+}
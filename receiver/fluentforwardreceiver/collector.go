@@ -49,26 +49,41 @@ func (c *Collector) processEvents(ctx context.Context) {
 			rls := out.ResourceLogs().AppendEmpty()
 			logSlice := rls.ScopeLogs().AppendEmpty().LogRecords()
 			e.LogRecords().MoveAndAppendTo(logSlice)
+			consumed := appendConsumed(nil, e)
 
 			// Pull out anything waiting on the eventCh to get better
 			// efficiency on LogResource allocations.
-			c.fillBufferUntilChanEmpty(logSlice)
+			consumed = c.fillBufferUntilChanEmpty(logSlice, consumed)
 
 			stats.Record(context.Background(), observ.RecordsGenerated.M(int64(out.LogRecordCount())))
 			obsCtx := c.obsrecv.StartLogsOp(ctx)
 			err := c.nextConsumer.ConsumeLogs(obsCtx, out)
 			c.obsrecv.EndLogsOp(obsCtx, "fluent", out.LogRecordCount(), err)
+
+			for _, ch := range consumed {
+				ch <- err
+			}
 		}
 	}
 }
 
-func (c *Collector) fillBufferUntilChanEmpty(dest plog.LogRecordSlice) {
+func (c *Collector) fillBufferUntilChanEmpty(dest plog.LogRecordSlice, consumed []chan<- error) []chan<- error {
 	for {
 		select {
 		case e := <-c.eventCh:
 			e.LogRecords().MoveAndAppendTo(dest)
+			consumed = appendConsumed(consumed, e)
 		default:
-			return
+			return consumed
 		}
 	}
 }
+
+// appendConsumed appends the channel receiving the result of the consumption of
+// the event when it is acknowledged.
+func appendConsumed(consumed []chan<- error, e Event) []chan<- error {
+	if ae, ok := e.(*ackableEvent); ok {
+		return append(consumed, ae.consumed)
+	}
+	return consumed
+}
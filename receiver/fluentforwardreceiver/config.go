@@ -3,6 +3,15 @@
 
 package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
 
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+var errMissingSharedKey = errors.New("security requires a shared_key")
+
 // Config defines configuration for the fluentforward receiver.
 type Config struct {
 
@@ -10,4 +19,30 @@ type Config struct {
 	// of the form `<ip addr>:<port>` (TCP) or `unix://<socket_path>` (Unix
 	// domain socket).
 	ListenAddress string `mapstructure:"endpoint"`
+
+	// TLSSetting configures TLS on the connections the events are received on,
+	// disabled when not set.
+	TLSSetting *configtls.TLSServerSetting `mapstructure:"tls"`
+
+	// Security configures the handshake of the forward protocol authenticating
+	// the clients with a shared key, disabled when not set.
+	Security *SecurityConfig `mapstructure:"security"`
+}
+
+// SecurityConfig configures the handshake of the forward protocol.
+type SecurityConfig struct {
+	// SelfHostname is the hostname the receiver authenticates itself with, the
+	// hostname of the host by default.
+	SelfHostname string `mapstructure:"self_hostname"`
+
+	// SharedKey is the key shared by the clients and the receiver.
+	SharedKey configopaque.String `mapstructure:"shared_key"`
+}
+
+// Validate checks the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Security != nil && cfg.Security.SharedKey == "" {
+		return errMissingSharedKey
+	}
+	return nil
 }
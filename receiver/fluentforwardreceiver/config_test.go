@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 )
 
@@ -27,3 +28,35 @@ func TestLoadConfig(t *testing.T) {
 	assert.NoError(t, component.ValidateConfig(cfg))
 	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
 }
+
+func TestLoadSecureConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName("fluentforward", "secure").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	assert.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t, &Config{
+		ListenAddress: "0.0.0.0:24224",
+		TLSSetting: &configtls.TLSServerSetting{
+			TLSSetting: configtls.TLSSetting{
+				CertFile: "server.crt",
+				KeyFile:  "server.key",
+			},
+		},
+		Security: &SecurityConfig{
+			SelfHostname: "collector.example.com",
+			SharedKey:    "secret",
+		},
+	}, cfg)
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := &Config{Security: &SecurityConfig{}}
+	assert.ErrorIs(t, cfg.Validate(), errMissingSharedKey)
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// The nonce of the HELO messages, as in Fluentd
+const nonceSize = 16
+
+var errAuthenticationFailed = errors.New("shared key mismatch")
+
+// handshake authenticates the client with the shared key of the forward protocol, as in
+// https://github.com/fluent/fluentd/wiki/Forward-Protocol-Specification-v1#handshake-messages,
+// the receiver sending a HELO message, the client a PING message, and the receiver a PONG
+// message with the result of the authentication.
+func handshake(rw io.ReadWriter, reader *msgp.Reader, security *SecurityConfig, selfHostname string) error {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	writer := msgp.NewWriter(rw)
+	if err := writeHelo(writer, nonce); err != nil {
+		return fmt.Errorf("failed to send HELO: %w", err)
+	}
+
+	ping, err := readPing(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read PING: %w", err)
+	}
+
+	sharedKey := string(security.SharedKey)
+	expected := sharedKeyDigest(ping.sharedKeySalt, ping.hostname, nonce, sharedKey)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(ping.sharedKeyDigest)) != 1 {
+		_ = writePong(writer, false, errAuthenticationFailed.Error(), "", "")
+		return fmt.Errorf("failed to authenticate %q: %w", ping.hostname, errAuthenticationFailed)
+	}
+	if err = writePong(writer, true, "", selfHostname, sharedKeyDigest(ping.sharedKeySalt, selfHostname, nonce, sharedKey)); err != nil {
+		return fmt.Errorf("failed to send PONG: %w", err)
+	}
+	return nil
+}
+
+// sharedKeyDigest is the hex encoded SHA-512 digest authenticating the hostname.
+func sharedKeyDigest(salt []byte, hostname string, nonce []byte, sharedKey string) string {
+	h := sha512.New()
+	h.Write(salt)
+	h.Write([]byte(hostname))
+	h.Write(nonce)
+	h.Write([]byte(sharedKey))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeHelo writes the HELO message, the clients not being authenticated with a username
+// and password.
+func writeHelo(writer *msgp.Writer, nonce []byte) error {
+	if err := writer.WriteArrayHeader(2); err != nil {
+		return err
+	}
+	if err := writer.WriteString("HELO"); err != nil {
+		return err
+	}
+	if err := writer.WriteMapHeader(3); err != nil {
+		return err
+	}
+	if err := writer.WriteString("nonce"); err != nil {
+		return err
+	}
+	if err := writer.WriteBytes(nonce); err != nil {
+		return err
+	}
+	if err := writer.WriteString("auth"); err != nil {
+		return err
+	}
+	if err := writer.WriteBytes([]byte{}); err != nil {
+		return err
+	}
+	if err := writer.WriteString("keepalive"); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(true); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+type pingMessage struct {
+	hostname        string
+	sharedKeySalt   []byte
+	sharedKeyDigest string
+}
+
+// readPing reads the PING message, such as
+// ["PING", "client.hostname", "salt", "digest", "username", "password digest"].
+func readPing(reader *msgp.Reader) (pingMessage, error) {
+	size, err := reader.ReadArrayHeader()
+	if err != nil {
+		return pingMessage{}, err
+	}
+	if size != 6 {
+		return pingMessage{}, fmt.Errorf("PING has %d elements instead of 6", size)
+	}
+	var fields [6][]byte
+	for i := range fields {
+		// the fields are strings or binaries, depending on the client
+		if fields[i], err = readStringOrBytes(reader); err != nil {
+			return pingMessage{}, msgp.WrapError(err, "PING", i)
+		}
+	}
+	if string(fields[0]) != "PING" {
+		return pingMessage{}, fmt.Errorf("unexpected %q message", fields[0])
+	}
+	return pingMessage{
+		hostname:        string(fields[1]),
+		sharedKeySalt:   fields[2],
+		sharedKeyDigest: string(fields[3]),
+	}, nil
+}
+
+func readStringOrBytes(reader *msgp.Reader) ([]byte, error) {
+	t, err := reader.NextType()
+	if err != nil {
+		return nil, err
+	}
+	if t == msgp.BinType {
+		return reader.ReadBytes(nil)
+	}
+	return reader.ReadStringAsBytes(nil)
+}
+
+// writePong writes the PONG message with the result of the authentication.
+func writePong(writer *msgp.Writer, authenticated bool, reason, selfHostname, digest string) error {
+	if err := writer.WriteArrayHeader(5); err != nil {
+		return err
+	}
+	if err := writer.WriteString("PONG"); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(authenticated); err != nil {
+		return err
+	}
+	if err := writer.WriteString(reason); err != nil {
+		return err
+	}
+	if err := writer.WriteString(selfHostname); err != nil {
+		return err
+	}
+	if err := writer.WriteString(digest); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
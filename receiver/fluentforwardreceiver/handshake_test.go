@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fluentforwardreceiver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tinylib/msgp/msgp"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+const testSharedKey = "secret"
+
+// clientHandshake performs the client side of the handshake, returning the PONG message.
+func clientHandshake(t *testing.T, conn net.Conn, sharedKey string) []interface{} {
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+	reader := msgp.NewReader(conn)
+
+	helo, err := reader.ReadIntf()
+	require.NoError(t, err)
+	heloFields := helo.([]interface{})
+	require.Equal(t, "HELO", heloFields[0])
+	options := heloFields[1].(map[string]interface{})
+	nonce := options["nonce"].([]byte)
+	require.Len(t, nonce, nonceSize)
+	assert.Empty(t, options["auth"])
+	assert.Equal(t, true, options["keepalive"])
+
+	salt := []byte("client-salt")
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 6)
+	b = msgp.AppendString(b, "PING")
+	b = msgp.AppendString(b, "client.example.com")
+	b = msgp.AppendBytes(b, salt)
+	b = msgp.AppendString(b, sharedKeyDigest(salt, "client.example.com", nonce, sharedKey))
+	b = msgp.AppendString(b, "")
+	b = msgp.AppendString(b, "")
+	_, err = conn.Write(b)
+	require.NoError(t, err)
+
+	pong, err := reader.ReadIntf()
+	require.NoError(t, err)
+	pongFields := pong.([]interface{})
+	require.Len(t, pongFields, 5)
+	require.Equal(t, "PONG", pongFields[0])
+	if pongFields[1] == true {
+		assert.Equal(t, sharedKeyDigest(salt, "collector.example.com", nonce, sharedKey), pongFields[4])
+	}
+	return pongFields
+}
+
+func TestHandshake(t *testing.T) {
+	next := new(consumertest.LogsSink)
+	connect, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		Security: &SecurityConfig{
+			SelfHostname: "collector.example.com",
+			SharedKey:    testSharedKey,
+		},
+	}, next)
+	defer cancel()
+
+	conn := connect()
+	defer conn.Close()
+	pong := clientHandshake(t, conn, testSharedKey)
+	require.Equal(t, true, pong[1])
+	assert.Equal(t, "", pong[2])
+	assert.Equal(t, "collector.example.com", pong[3])
+
+	_, err := conn.Write(makeChunkEvent("chunk-1"))
+	require.NoError(t, err)
+	resp := map[string]interface{}{}
+	require.NoError(t, msgp.NewReader(conn).ReadMapStrIntf(resp))
+	require.Equal(t, "chunk-1", resp["ack"])
+	require.Equal(t, 1, next.LogRecordCount())
+}
+
+func TestHandshakeSharedKeyMismatch(t *testing.T) {
+	next := new(consumertest.LogsSink)
+	connect, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		Security: &SecurityConfig{
+			SelfHostname: "collector.example.com",
+			SharedKey:    testSharedKey,
+		},
+	}, next)
+	defer cancel()
+
+	conn := connect()
+	defer conn.Close()
+	pong := clientHandshake(t, conn, "wrong")
+	require.Equal(t, false, pong[1])
+	assert.Equal(t, "shared key mismatch", pong[2])
+	waitForConnectionClose(t, conn)
+}
+
+func TestHandshakeRejectsEvents(t *testing.T) {
+	next := new(consumertest.LogsSink)
+	connect, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		Security: &SecurityConfig{
+			SelfHostname: "collector.example.com",
+			SharedKey:    testSharedKey,
+		},
+	}, next)
+	defer cancel()
+
+	conn := connect()
+	defer conn.Close()
+	reader := msgp.NewReader(conn)
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+	_, err := reader.ReadIntf()
+	require.NoError(t, err)
+
+	// events sent before the handshake completes aren't consumed
+	_, err = conn.Write(makeSampleEvent("tag"))
+	require.NoError(t, err)
+	waitForConnectionClose(t, conn)
+	assert.Equal(t, 0, next.LogRecordCount())
+}
+
+func TestTLS(t *testing.T) {
+	next := new(consumertest.LogsSink)
+	r, err := newFluentReceiver(receivertest.NewNopCreateSettings(), &Config{
+		ListenAddress: "127.0.0.1:0",
+		TLSSetting: &configtls.TLSServerSetting{
+			TLSSetting: configtls.TLSSetting{
+				CertFile: filepath.Join("testdata", "certs", "server.crt"),
+				KeyFile:  filepath.Join("testdata", "certs", "server.key"),
+			},
+		},
+	}, next)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+	caPEM, err := os.ReadFile(filepath.Join("testdata", "certs", "ca.crt"))
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(caPEM))
+	_, port, err := net.SplitHostPort(r.(*fluentReceiver).listener.Addr().String())
+	require.NoError(t, err)
+
+	conn, err := tls.Dial("tcp", net.JoinHostPort("localhost", port), &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write(makeChunkEvent("chunk-1"))
+	require.NoError(t, err)
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+	resp := map[string]interface{}{}
+	require.NoError(t, msgp.NewReader(conn).ReadMapStrIntf(resp))
+	require.Equal(t, "chunk-1", resp["ack"])
+	require.Equal(t, 1, next.LogRecordCount())
+}
+
+func TestTLSInvalidCertificate(t *testing.T) {
+	r, err := newFluentReceiver(receivertest.NewNopCreateSettings(), &Config{
+		ListenAddress: "127.0.0.1:0",
+		TLSSetting: &configtls.TLSServerSetting{
+			TLSSetting: configtls.TLSSetting{
+				CertFile: filepath.Join("testdata", "certs", "missing.crt"),
+				KeyFile:  filepath.Join("testdata", "certs", "missing.key"),
+			},
+		},
+	}, new(consumertest.LogsSink))
+	require.NoError(t, err)
+	assert.Error(t, r.Start(context.Background(), componenttest.NewNopHost()))
+}
@@ -59,6 +59,18 @@ var (
 		Aggregation: view.Sum(),
 	}
 
+	// FailedToAuthenticate measure for number of times Fluent clients failed to be authenticated.
+	FailedToAuthenticate = stats.Int64(
+		"fluent_authentication_failures",
+		"Number of times Fluent clients failed to be authenticated by the handshake",
+		stats.UnitDimensionless)
+	failedToAuthenticateView = &view.View{
+		Name:        FailedToAuthenticate.Name(),
+		Measure:     FailedToAuthenticate,
+		Description: FailedToAuthenticate.Description(),
+		Aggregation: view.Sum(),
+	}
+
 	// RecordsGenerated measure for number of log records generated from Fluent forward input.
 	RecordsGenerated = stats.Int64(
 		"fluent_records_generated",
@@ -78,6 +90,7 @@ func MetricViews() []*view.View {
 		connectionsClosedView,
 		eventsParsedView,
 		failedToParseView,
+		failedToAuthenticateView,
 		recordsGeneratedView,
 	}
 }
@@ -5,7 +5,9 @@ package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
+	"os"
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
@@ -40,7 +42,15 @@ func newFluentReceiver(set receiver.CreateSettings, conf *Config, next consumer.
 	eventCh := make(chan Event, eventChannelLength)
 	collector := newCollector(eventCh, next, set.Logger, obsrecv)
 
-	server := newServer(eventCh, set.Logger)
+	selfHostname := ""
+	if conf.Security != nil {
+		if selfHostname = conf.Security.SelfHostname; selfHostname == "" {
+			if selfHostname, err = os.Hostname(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	server := newServer(eventCh, set.Logger, conf.Security, selfHostname)
 
 	return &fluentReceiver{
 		collector: collector,
@@ -74,6 +84,18 @@ func (r *fluentReceiver) Start(ctx context.Context, _ component.Host) error {
 		return err
 	}
 
+	if r.conf.TLSSetting != nil {
+		tlsConfig, err := r.conf.TLSSetting.LoadTLSConfig()
+		if err != nil {
+			listener.Close()
+			if udpListener != nil {
+				udpListener.Close()
+			}
+			return err
+		}
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
 	r.listener = listener
 
 	r.server.Start(receiverCtx, listener)
@@ -5,6 +5,7 @@ package fluentforwardreceiver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tinylib/msgp/msgp"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -26,19 +28,22 @@ import (
 )
 
 func setupServer(t *testing.T) (func() net.Conn, *consumertest.LogsSink, *observer.ObservedLogs, context.CancelFunc) {
+	next := new(consumertest.LogsSink)
+	connect, logObserver, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+	}, next)
+	return connect, next, logObserver, cancel
+}
+
+func setupServerWithConfig(t *testing.T, conf *Config, next consumer.Logs) (func() net.Conn, *observer.ObservedLogs, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 
-	next := new(consumertest.LogsSink)
 	logCore, logObserver := observer.New(zap.DebugLevel)
 	logger := zap.New(logCore)
 
 	set := receivertest.NewNopCreateSettings()
 	set.Logger = logger
 
-	conf := &Config{
-		ListenAddress: "127.0.0.1:0",
-	}
-
 	receiver, err := newFluentReceiver(set, conf, next)
 	require.NoError(t, err)
 	require.NoError(t, receiver.Start(ctx, nil))
@@ -54,7 +59,7 @@ func setupServer(t *testing.T) (func() net.Conn, *consumertest.LogsSink, *observ
 		require.NoError(t, receiver.Shutdown(ctx))
 	}()
 
-	return connect, next, logObserver, cancel
+	return connect, logObserver, cancel
 }
 
 func waitForConnectionClose(t *testing.T, conn net.Conn) {
@@ -199,6 +204,53 @@ func TestEventAcknowledgment(t *testing.T) {
 	require.Equal(t, chunkValue, resp["ack"])
 }
 
+func makeChunkEvent(chunk string) []byte {
+	var b []byte
+
+	b = msgp.AppendArrayHeader(b, 4)
+	b = msgp.AppendString(b, "my-tag")
+	b = msgp.AppendInt(b, 5000)
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "a")
+	b = msgp.AppendFloat64(b, 5.0)
+	b = msgp.AppendMapStrStr(b, map[string]string{"chunk": chunk})
+	return b
+}
+
+func TestEventAcknowledgmentAfterConsumption(t *testing.T) {
+	next := new(consumertest.LogsSink)
+	failing := true
+	var mu sync.Mutex
+	failingNext, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			failing = false
+			return errors.New("consumer failure")
+		}
+		return next.ConsumeLogs(ctx, ld)
+	})
+	require.NoError(t, err)
+	connect, _, cancel := setupServerWithConfig(t, &Config{ListenAddress: "127.0.0.1:0"}, failingNext)
+	defer cancel()
+
+	conn := connect()
+	defer conn.Close()
+	reader := msgp.NewReader(conn)
+
+	// the chunk failing to be consumed isn't acknowledged, and is sent again by the client
+	_, err = conn.Write(makeChunkEvent("chunk-1"))
+	require.NoError(t, err)
+	_, err = conn.Write(makeChunkEvent("chunk-1"))
+	require.NoError(t, err)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	resp := map[string]interface{}{}
+	require.NoError(t, reader.ReadMapStrIntf(resp))
+	require.Equal(t, "chunk-1", resp["ack"])
+	require.Equal(t, 1, len(next.AllLogs()), "the chunk must be acknowledged once consumed")
+}
+
 func TestForwardPackedEvent(t *testing.T) {
 	connect, next, _, cancel := setupServer(t)
 	defer cancel()
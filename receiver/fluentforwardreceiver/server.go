@@ -26,12 +26,17 @@ const readBufferSize = 10 * 1024
 type server struct {
 	outCh  chan<- Event
 	logger *zap.Logger
+	// security enables the handshake authenticating the clients when not nil
+	security     *SecurityConfig
+	selfHostname string
 }
 
-func newServer(outCh chan<- Event, logger *zap.Logger) *server {
+func newServer(outCh chan<- Event, logger *zap.Logger, security *SecurityConfig, selfHostname string) *server {
 	return &server{
-		outCh:  outCh,
-		logger: logger,
+		outCh:        outCh,
+		logger:       logger,
+		security:     security,
+		selfHostname: selfHostname,
 	}
 }
 
@@ -80,6 +85,13 @@ func (s *server) handleConnections(ctx context.Context, listener net.Listener) {
 func (s *server) handleConn(ctx context.Context, conn net.Conn) error {
 	reader := msgp.NewReaderSize(conn, readBufferSize)
 
+	if s.security != nil {
+		if err := handshake(conn, reader, s.security, s.selfHostname); err != nil {
+			stats.Record(ctx, observ.FailedToAuthenticate.M(1))
+			return err
+		}
+	}
+
 	for {
 		mode, err := DetermineNextEventMode(reader.R)
 		if err != nil {
@@ -110,17 +122,30 @@ func (s *server) handleConn(ctx context.Context, conn net.Conn) error {
 
 		stats.Record(ctx, observ.EventsParsed.M(1))
 
-		s.outCh <- event
+		if event.Chunk() == "" {
+			s.outCh <- event
+			continue
+		}
 
-		// We must acknowledge the 'chunk' option if given. We could do this in
-		// another goroutine if it is too much of a bottleneck to reading
-		// messages -- this is the only thing that sends data back to the
-		// client.
-		if event.Chunk() != "" {
-			err := msgp.Encode(conn, AckResponse{Ack: event.Chunk()})
-			if err != nil {
-				return fmt.Errorf("failed to acknowledge chunk %s: %w", event.Chunk(), err)
-			}
+		// We must acknowledge the 'chunk' option if given, once the event is
+		// consumed, so the client sends the events again when they fail to be
+		// consumed. The next events of the connection are read after the
+		// acknowledgment, as the clients wait for it before sending the next
+		// chunk.
+		consumed := make(chan error, 1)
+		s.outCh <- &ackableEvent{Event: event, consumed: consumed}
+		select {
+		case err = <-consumed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err != nil {
+			s.logger.Debug("Not acknowledging chunk of events failing to be consumed", zap.String("chunk", event.Chunk()), zap.Error(err))
+			continue
+		}
+		err = msgp.Encode(conn, AckResponse{Ack: event.Chunk()})
+		if err != nil {
+			return fmt.Errorf("failed to acknowledge chunk %s: %w", event.Chunk(), err)
 		}
 	}
 }
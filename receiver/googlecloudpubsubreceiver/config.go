@@ -86,9 +86,9 @@ func (config *Config) validate() error {
 	}
 	switch config.Compression {
 	case "":
-	case "gzip":
+	case "gzip", "zstd", "snappy":
 	default:
-		return fmt.Errorf("compression %v is not supported.  supported compression formats include [gzip]", config.Compression)
+		return fmt.Errorf("compression %v is not supported.  supported compression formats include [gzip, zstd, snappy]", config.Compression)
 	}
 	return nil
 }
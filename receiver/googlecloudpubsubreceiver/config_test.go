@@ -72,6 +72,12 @@ func TestConfigValidation(t *testing.T) {
 	assert.Error(t, c.validate())
 	c.Subscription = "projects/my-project/subscriptions/my-subscription"
 	assert.NoError(t, c.validate())
+	for _, compression := range []string{"gzip", "zstd", "snappy"} {
+		c.Compression = compression
+		assert.NoError(t, c.validate())
+	}
+	c.Compression = "br"
+	assert.Error(t, c.validate())
 }
 
 func TestTraceConfigValidation(t *testing.T) {
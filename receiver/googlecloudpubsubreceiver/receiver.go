@@ -15,6 +15,8 @@ import (
 
 	pubsub "cloud.google.com/go/pubsub/apiv1"
 	"cloud.google.com/go/pubsub/apiv1/pubsubpb"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
@@ -60,10 +62,30 @@ const (
 type compression int
 
 const (
-	uncompressed compression = iota
-	gZip                     = iota
+	uncompressed      compression = iota
+	gZip                          = iota
+	zstdCompression               = iota
+	snappyCompression             = iota
 )
 
+// zstdDecoder decompresses the zstd messages, it is shared by the receivers as it holds
+// large buffers and can be used concurrently.
+var zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+
+// compressionFromContentEncoding returns the compression of the content-encoding attribute
+// set by the Pub/Sub exporter.
+func compressionFromContentEncoding(contentEncoding string) compression {
+	switch contentEncoding {
+	case "gzip":
+		return gZip
+	case "zstd":
+		return zstdCompression
+	case "snappy":
+		return snappyCompression
+	}
+	return uncompressed
+}
+
 func (receiver *pubsubReceiver) generateClientOptions() (copts []option.ClientOption) {
 	if receiver.userAgent != "" {
 		copts = append(copts, option.WithUserAgent(receiver.userAgent))
@@ -137,12 +159,18 @@ func (receiver *pubsubReceiver) handleLogStrings(ctx context.Context, message *p
 }
 
 func decompress(payload []byte, compression compression) ([]byte, error) {
-	if compression == gZip {
+	switch compression {
+	case gZip:
 		reader, err := gzip.NewReader(bytes.NewReader(payload))
 		if err != nil {
 			return nil, err
 		}
 		return io.ReadAll(reader)
+	case zstdCompression:
+		return zstdDecoder.DecodeAll(payload, nil)
+	case snappyCompression:
+		// the payload is compressed with the snappy block format, as the Pub/Sub exporter does
+		return snappy.Decode(nil, payload)
 	}
 	return payload, nil
 }
@@ -197,7 +225,6 @@ func (receiver *pubsubReceiver) handleLog(ctx context.Context, payload []byte, c
 
 func (receiver *pubsubReceiver) detectEncoding(attributes map[string]string) (encoding, compression) {
 	otlpEncoding := unknown
-	otlpCompression := uncompressed
 
 	ceType := attributes["ce-type"]
 	ceContentType := attributes["content-type"]
@@ -227,15 +254,10 @@ func (receiver *pubsubReceiver) detectEncoding(attributes map[string]string) (en
 		}
 	}
 
-	ceContentEncoding := attributes["content-encoding"]
-	if ceContentEncoding == "gzip" {
-		otlpCompression = gZip
-	}
+	otlpCompression := compressionFromContentEncoding(attributes["content-encoding"])
 
 	if otlpCompression == uncompressed && receiver.config.Compression != "" {
-		if receiver.config.Compression == "gzip" {
-			otlpCompression = gZip
-		}
+		otlpCompression = compressionFromContentEncoding(receiver.config.Compression)
 	}
 	return otlpEncoding, otlpCompression
 }
@@ -4,12 +4,16 @@
 package googlecloudpubsubreceiver
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"testing"
 	"time"
 
 	pb "cloud.google.com/go/pubsub/apiv1/pubsubpb"
 	"cloud.google.com/go/pubsub/pstest"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -156,3 +160,57 @@ func TestReceiver(t *testing.T) {
 	assert.Nil(t, receiver.Shutdown(ctx))
 	assert.Nil(t, receiver.Shutdown(ctx))
 }
+
+func TestDetectCompression(t *testing.T) {
+	receiver := &pubsubReceiver{config: &Config{}}
+	for contentEncoding, expected := range map[string]compression{
+		"":       uncompressed,
+		"gzip":   gZip,
+		"zstd":   zstdCompression,
+		"snappy": snappyCompression,
+		"br":     uncompressed,
+	} {
+		_, detected := receiver.detectEncoding(map[string]string{"content-encoding": contentEncoding})
+		assert.Equal(t, expected, detected, contentEncoding)
+	}
+
+	// the configured compression is only a fallback
+	receiver.config.Compression = "zstd"
+	_, detected := receiver.detectEncoding(map[string]string{})
+	assert.Equal(t, compression(zstdCompression), detected)
+	_, detected = receiver.detectEncoding(map[string]string{"content-encoding": "snappy"})
+	assert.Equal(t, compression(snappyCompression), detected)
+}
+
+func TestDecompress(t *testing.T) {
+	payload := testdata.CreateTraceExport()
+
+	var gzipped bytes.Buffer
+	writer := gzip.NewWriter(&gzipped)
+	_, err := writer.Write(payload)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	defer encoder.Close()
+
+	for name, tt := range map[string]struct {
+		compression compression
+		compressed  []byte
+	}{
+		"uncompressed": {compression: uncompressed, compressed: payload},
+		"gzip":         {compression: gZip, compressed: gzipped.Bytes()},
+		"zstd":         {compression: zstdCompression, compressed: encoder.EncodeAll(payload, nil)},
+		"snappy":       {compression: snappyCompression, compressed: snappy.Encode(nil, payload)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			decompressed, err := decompress(tt.compressed, tt.compression)
+			require.NoError(t, err)
+			assert.Equal(t, payload, decompressed)
+		})
+	}
+
+	_, err = decompress([]byte("not zstd"), zstdCompression)
+	assert.Error(t, err)
+}
@@ -37,6 +37,13 @@ type Config struct {
 	// ExcludeMountPoints specifies a filter on the mount points that should be excluded from the generated metrics.
 	// When `root_path` is set, the mount points must be from the host's perspective.
 	ExcludeMountPoints MountPointMatchConfig `mapstructure:"exclude_mount_points"`
+
+	// IncludeMountOptions specifies a filter on the mount options that should be included in the generated metrics.
+	// A mount point is included if any of its options matches.
+	IncludeMountOptions MountOptionMatchConfig `mapstructure:"include_mount_options"`
+	// ExcludeMountOptions specifies a filter on the mount options that should be excluded from the generated metrics.
+	// A mount point is excluded if any of its options matches.
+	ExcludeMountOptions MountOptionMatchConfig `mapstructure:"exclude_mount_options"`
 }
 
 type DeviceMatchConfig struct {
@@ -57,14 +64,22 @@ type MountPointMatchConfig struct {
 	MountPoints []string `mapstructure:"mount_points"`
 }
 
+type MountOptionMatchConfig struct {
+	filterset.Config `mapstructure:",squash"`
+
+	MountOptions []string `mapstructure:"mount_options"`
+}
+
 type fsFilter struct {
-	includeDeviceFilter     filterset.FilterSet
-	excludeDeviceFilter     filterset.FilterSet
-	includeFSTypeFilter     filterset.FilterSet
-	excludeFSTypeFilter     filterset.FilterSet
-	includeMountPointFilter filterset.FilterSet
-	excludeMountPointFilter filterset.FilterSet
-	filtersExist            bool
+	includeDeviceFilter      filterset.FilterSet
+	excludeDeviceFilter      filterset.FilterSet
+	includeFSTypeFilter      filterset.FilterSet
+	excludeFSTypeFilter      filterset.FilterSet
+	includeMountPointFilter  filterset.FilterSet
+	excludeMountPointFilter  filterset.FilterSet
+	includeMountOptionFilter filterset.FilterSet
+	excludeMountOptionFilter filterset.FilterSet
+	filtersExist             bool
 }
 
 func (cfg *Config) createFilter() (*fsFilter, error) {
@@ -114,6 +129,20 @@ func (cfg *Config) createFilter() (*fsFilter, error) {
 		}
 	}
 
+	if len(cfg.IncludeMountOptions.MountOptions) > 0 {
+		filter.includeMountOptionFilter, err = filterset.CreateFilterSet(cfg.IncludeMountOptions.MountOptions, &cfg.IncludeMountOptions.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating include_mount_options filter: %w", err)
+		}
+	}
+
+	if len(cfg.ExcludeMountOptions.MountOptions) > 0 {
+		filter.excludeMountOptionFilter, err = filterset.CreateFilterSet(cfg.ExcludeMountOptions.MountOptions, &cfg.ExcludeMountOptions.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating exclude_mount_options filter: %w", err)
+		}
+	}
+
 	filter.setFiltersExist()
 	return &filter, nil
 }
@@ -121,5 +150,6 @@ func (cfg *Config) createFilter() (*fsFilter, error) {
 func (f *fsFilter) setFiltersExist() {
 	f.filtersExist = f.includeMountPointFilter != nil || f.excludeMountPointFilter != nil ||
 		f.includeFSTypeFilter != nil || f.excludeFSTypeFilter != nil ||
-		f.includeDeviceFilter != nil || f.excludeDeviceFilter != nil
+		f.includeDeviceFilter != nil || f.excludeDeviceFilter != nil ||
+		f.includeMountOptionFilter != nil || f.excludeMountOptionFilter != nil
 }
@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper/internal/metadata"
 )
 
@@ -139,7 +140,8 @@ func (f *fsFilter) includePartition(partition disk.PartitionStat) bool {
 	// If filters do not exist, return early.
 	if !f.filtersExist || (f.includeDevice(partition.Device) &&
 		f.includeFSType(partition.Fstype) &&
-		f.includeMountPoint(partition.Mountpoint)) {
+		f.includeMountPoint(partition.Mountpoint) &&
+		f.includeMountOptions(partition.Opts)) {
 		return true
 	}
 	return false
@@ -160,6 +162,20 @@ func (f *fsFilter) includeMountPoint(mountPoint string) bool {
 		(f.excludeMountPointFilter == nil || !f.excludeMountPointFilter.Matches(mountPoint))
 }
 
+func (f *fsFilter) includeMountOptions(options []string) bool {
+	return (f.includeMountOptionFilter == nil || matchesAny(f.includeMountOptionFilter, options)) &&
+		(f.excludeMountOptionFilter == nil || !matchesAny(f.excludeMountOptionFilter, options))
+}
+
+func matchesAny(filter filterset.FilterSet, values []string) bool {
+	for _, v := range values {
+		if filter.Matches(v) {
+			return true
+		}
+	}
+	return false
+}
+
 // translateMountsRootPath translates a mountpoint from the host perspective to the chrooted perspective.
 func translateMountpoint(rootPath, mountpoint string) string {
 	return filepath.Join(rootPath, mountpoint)
@@ -161,6 +161,106 @@ func TestScrape(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Filter by mount options and regexp filesystem types",
+			config: Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				ExcludeMountOptions: MountOptionMatchConfig{
+					Config: filterset.Config{
+						MatchType: filterset.Strict,
+					},
+					MountOptions: []string{"ro"},
+				},
+				ExcludeFSTypes: FSTypeMatchConfig{
+					Config: filterset.Config{
+						MatchType: filterset.Regexp,
+					},
+					FSTypes: []string{"^(squashfs|overlay)$"},
+				},
+			},
+			usageFunc: func(_ context.Context, s string) (*disk.UsageStat, error) {
+				return &disk.UsageStat{}, nil
+			},
+			partitionsFunc: func(_ context.Context, b bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{
+					{
+						Device:     "device_a",
+						Mountpoint: "mount_point_a",
+						Fstype:     "ext4",
+						Opts:       []string{"rw", "relatime"},
+					},
+					{
+						Device:     "device_b",
+						Mountpoint: "mount_point_b",
+						Fstype:     "ext4",
+						Opts:       []string{"ro", "relatime"},
+					},
+					{
+						Device:     "device_c",
+						Mountpoint: "mount_point_c",
+						Fstype:     "squashfs",
+						Opts:       []string{"rw"},
+					},
+					{
+						Device:     "overlay",
+						Mountpoint: "mount_point_d",
+						Fstype:     "overlay",
+						Opts:       []string{"rw"},
+					},
+				}, nil
+			},
+			expectMetrics:            true,
+			expectedDeviceDataPoints: 1,
+			expectedDeviceAttributes: []map[string]pcommon.Value{
+				{
+					"device":     pcommon.NewValueStr("device_a"),
+					"mountpoint": pcommon.NewValueStr("mount_point_a"),
+					"type":       pcommon.NewValueStr("ext4"),
+					"mode":       pcommon.NewValueStr("rw"),
+				},
+			},
+		},
+		{
+			name: "Include mount options",
+			config: Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				IncludeMountOptions: MountOptionMatchConfig{
+					Config: filterset.Config{
+						MatchType: filterset.Regexp,
+					},
+					MountOptions: []string{"^nodev$"},
+				},
+			},
+			usageFunc: func(_ context.Context, s string) (*disk.UsageStat, error) {
+				return &disk.UsageStat{}, nil
+			},
+			partitionsFunc: func(_ context.Context, b bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{
+					{
+						Device:     "device_a",
+						Mountpoint: "mount_point_a",
+						Fstype:     "ext4",
+						Opts:       []string{"rw", "nodev"},
+					},
+					{
+						Device:     "device_b",
+						Mountpoint: "mount_point_b",
+						Fstype:     "ext4",
+						Opts:       []string{"rw"},
+					},
+				}, nil
+			},
+			expectMetrics:            true,
+			expectedDeviceDataPoints: 1,
+			expectedDeviceAttributes: []map[string]pcommon.Value{
+				{
+					"device":     pcommon.NewValueStr("device_a"),
+					"mountpoint": pcommon.NewValueStr("mount_point_a"),
+					"type":       pcommon.NewValueStr("ext4"),
+					"mode":       pcommon.NewValueStr("rw"),
+				},
+			},
+		},
 		{
 			name: "RootPath at /hostfs",
 			config: Config{
@@ -243,6 +343,22 @@ func TestScrape(t *testing.T) {
 			},
 			newErrRegex: "^error creating exclude_mount_points filter:",
 		},
+		{
+			name: "Invalid Include Mount Options Filter",
+			config: Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				IncludeMountOptions:  MountOptionMatchConfig{MountOptions: []string{"test"}},
+			},
+			newErrRegex: "^error creating include_mount_options filter:",
+		},
+		{
+			name: "Invalid Exclude Mount Options Filter",
+			config: Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				ExcludeMountOptions:  MountOptionMatchConfig{MountOptions: []string{"test"}},
+			},
+			newErrRegex: "^error creating exclude_mount_options filter:",
+		},
 		{
 			name:           "Partitions Error",
 			partitionsFunc: func(context.Context, bool) ([]disk.PartitionStat, error) { return nil, errors.New("err1") },
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"runtime"
 	"time"
 
@@ -25,18 +26,19 @@ import (
 )
 
 const (
-	cpuMetricsLen               = 1
-	memoryMetricsLen            = 2
-	memoryUtilizationMetricsLen = 1
-	diskMetricsLen              = 1
-	pagingMetricsLen            = 1
-	threadMetricsLen            = 1
-	contextSwitchMetricsLen     = 1
-	fileDescriptorMetricsLen    = 1
-	handleMetricsLen            = 1
-	signalMetricsLen            = 1
-
-	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen + memoryUtilizationMetricsLen + pagingMetricsLen + threadMetricsLen + contextSwitchMetricsLen + fileDescriptorMetricsLen + signalMetricsLen
+	cpuMetricsLen                 = 1
+	memoryMetricsLen              = 2
+	memoryUtilizationMetricsLen   = 1
+	diskMetricsLen                = 1
+	pagingMetricsLen              = 1
+	threadMetricsLen              = 1
+	contextSwitchMetricsLen       = 1
+	fileDescriptorMetricsLen      = 1
+	fileDescriptorLimitMetricsLen = 1
+	handleMetricsLen              = 1
+	signalMetricsLen              = 1
+
+	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen + memoryUtilizationMetricsLen + pagingMetricsLen + threadMetricsLen + contextSwitchMetricsLen + fileDescriptorMetricsLen + fileDescriptorLimitMetricsLen + signalMetricsLen
 )
 
 // scraper for Process Metrics
@@ -144,6 +146,10 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 			errs.AddPartial(fileDescriptorMetricsLen, fmt.Errorf("error reading open file descriptor count for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
 
+		if err = s.scrapeAndAppendOpenFileDescriptorsLimitMetric(now, md.handle); err != nil {
+			errs.AddPartial(fileDescriptorLimitMetricsLen, fmt.Errorf("error reading open file descriptor limit for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
 		if err = s.scrapeAndAppendHandlesMetric(now, int64(md.pid)); err != nil {
 			errs.AddPartial(handleMetricsLen, fmt.Errorf("error reading handle count for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
@@ -391,6 +397,27 @@ func (s *scraper) scrapeAndAppendOpenFileDescriptorsMetric(now pcommon.Timestamp
 	return nil
 }
 
+func (s *scraper) scrapeAndAppendOpenFileDescriptorsLimitMetric(now pcommon.Timestamp, handle processHandle) error {
+	if !s.config.MetricsBuilderConfig.Metrics.ProcessOpenFileDescriptorsLimit.Enabled {
+		return nil
+	}
+
+	rlimitStats, err := handle.RlimitUsage(false)
+	if err != nil {
+		return err
+	}
+
+	for _, rlimitStat := range rlimitStats {
+		// an unlimited limit does not fit in an int64 and is not reported
+		if rlimitStat.Resource == process.RLIMIT_NOFILE && rlimitStat.Soft <= math.MaxInt64 {
+			s.mb.RecordProcessOpenFileDescriptorsLimitDataPoint(now, int64(rlimitStat.Soft))
+			break
+		}
+	}
+
+	return nil
+}
+
 func (s *scraper) refreshHandleCounts() error {
 	if !s.config.MetricsBuilderConfig.Metrics.ProcessHandles.Enabled {
 		return nil
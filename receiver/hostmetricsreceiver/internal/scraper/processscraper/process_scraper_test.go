@@ -42,6 +42,7 @@ func enableLinuxOnlyMetrics(ms *metadata.MetricsConfig) {
 	ms.ProcessPagingFaults.Enabled = true
 	ms.ProcessContextSwitches.Enabled = true
 	ms.ProcessOpenFileDescriptors.Enabled = true
+	ms.ProcessOpenFileDescriptorsLimit.Enabled = true
 	ms.ProcessSignalsPending.Enabled = true
 }
 
@@ -152,6 +153,9 @@ func TestScrape(t *testing.T) {
 			}
 			if metricsBuilderConfig.Metrics.ProcessOpenFileDescriptors.Enabled {
 				assertOpenFileDescriptorMetricValid(t, md.ResourceMetrics(), expectedStartTime)
+			}
+			if metricsBuilderConfig.Metrics.ProcessOpenFileDescriptorsLimit.Enabled {
+				assertOpenFileDescriptorsLimitMetricValid(t, md.ResourceMetrics(), expectedStartTime)
 			} else {
 				assertMetricMissing(t, md.ResourceMetrics(), "process.open_file_descriptors")
 			}
@@ -306,6 +310,16 @@ func assertOpenFileDescriptorMetricValid(t *testing.T, resourceMetrics pmetric.R
 	}
 }
 
+func assertOpenFileDescriptorsLimitMetricValid(t *testing.T, resourceMetrics pmetric.ResourceMetricsSlice, startTime pcommon.Timestamp) {
+	openFileDescriptorsLimitMetric := getMetric(t, "process.open_file_descriptors.limit", resourceMetrics)
+	assert.Equal(t, "process.open_file_descriptors.limit", openFileDescriptorsLimitMetric.Name())
+	assert.Greater(t, openFileDescriptorsLimitMetric.Sum().DataPoints().At(0).IntValue(), int64(0))
+
+	if startTime != 0 {
+		internal.AssertSumMetricStartTimeEquals(t, openFileDescriptorsLimitMetric, startTime)
+	}
+}
+
 func assertSameTimeStampForAllMetricsWithinResource(t *testing.T, resourceMetrics pmetric.ResourceMetricsSlice) {
 	for i := 0; i < resourceMetrics.Len(); i++ {
 		ilms := resourceMetrics.At(i).ScopeMetrics()
@@ -628,6 +642,7 @@ func enableOptionalMetrics(ms *metadata.MetricsConfig) {
 	ms.ProcessPagingFaults.Enabled = true
 	ms.ProcessContextSwitches.Enabled = true
 	ms.ProcessOpenFileDescriptors.Enabled = true
+	ms.ProcessOpenFileDescriptorsLimit.Enabled = true
 	ms.ProcessSignalsPending.Enabled = true
 }
 
@@ -743,10 +758,11 @@ func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 			expectedError: `error reading open file descriptor count for process "test" (pid 1): err10`,
 		},
 		{
-			name:          "Signals Pending Error",
-			osFilter:      "darwin",
-			rlimitError:   errors.New("err-rlimit"),
-			expectedError: `error reading pending signals for process "test" (pid 1): err-rlimit`,
+			name:        "Rlimit Error",
+			osFilter:    "darwin",
+			rlimitError: errors.New("err-rlimit"),
+			expectedError: `error reading open file descriptor limit for process "test" (pid 1): err-rlimit; ` +
+				`error reading pending signals for process "test" (pid 1): err-rlimit`,
 		},
 		{
 			name:                "Multiple Errors",
@@ -774,6 +790,7 @@ func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 				`error reading thread info for process "test" (pid 1): err8; ` +
 				`error reading context switch counts for process "test" (pid 1): err9; ` +
 				`error reading open file descriptor count for process "test" (pid 1): err10; ` +
+				`error reading open file descriptor limit for process "test" (pid 1): err-rlimit; ` +
 				`error reading pending signals for process "test" (pid 1): err-rlimit`,
 		},
 	}
@@ -833,6 +850,10 @@ func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 					Resource: process.RLIMIT_SIGPENDING,
 					Used:     0,
 				},
+				{
+					Resource: process.RLIMIT_NOFILE,
+					Soft:     1024,
+				},
 			}, test.rlimitError)
 
 			scraper.getProcessHandles = func(context.Context) (processHandles, error) {
@@ -890,7 +911,7 @@ func getExpectedLengthOfReturnedMetrics(nameError, exeError, timeError, memError
 		expectedLen += pagingMetricsLen
 	}
 	if rlimitError == nil && runtime.GOOS != "darwin" {
-		expectedLen += signalMetricsLen
+		expectedLen += signalMetricsLen + fileDescriptorLimitMetricsLen
 	}
 	if threadError == nil && runtime.GOOS != "darwin" {
 		expectedLen += threadMetricsLen
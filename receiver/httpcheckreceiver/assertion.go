@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
+)
+
+// assertion is a compiled assertion on the body of a response.
+type assertion struct {
+	assertionType metadata.AttributeAssertionType
+	expression    string
+	bodyRegexp    *regexp.Regexp
+	path          []pathElement
+	value         string
+}
+
+// pathElement is either a key of an object or an index of an array.
+type pathElement struct {
+	key   string
+	index int
+}
+
+func newAssertion(cfg *assertionConfig) (*assertion, error) {
+	if cfg.BodyMatches != "" {
+		re, err := regexp.Compile(cfg.BodyMatches)
+		if err != nil {
+			return nil, fmt.Errorf("invalid body_matches %q: %w", cfg.BodyMatches, err)
+		}
+		return &assertion{
+			assertionType: metadata.AttributeAssertionTypeBodyMatches,
+			expression:    cfg.BodyMatches,
+			bodyRegexp:    re,
+		}, nil
+	}
+
+	path, err := parseJSONPath(cfg.JSONPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid json_path %q: %w", cfg.JSONPath, err)
+	}
+	return &assertion{
+		assertionType: metadata.AttributeAssertionTypeJSONPath,
+		expression:    cfg.JSONPath,
+		path:          path,
+		value:         cfg.Value,
+	}, nil
+}
+
+// check returns whether the body passes the assertion.
+func (a *assertion) check(body []byte) bool {
+	if a.bodyRegexp != nil {
+		return a.bodyRegexp.Match(body)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return false
+	}
+	for _, elem := range a.path {
+		var ok bool
+		if v, ok = elem.lookup(v); !ok {
+			return false
+		}
+	}
+	if a.value == "" {
+		return true
+	}
+	return jsonValueString(v) == a.value
+}
+
+func (e pathElement) lookup(v interface{}) (interface{}, bool) {
+	if e.key != "" {
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value, ok := object[e.key]
+		return value, ok
+	}
+	array, ok := v.([]interface{})
+	if !ok || e.index >= len(array) {
+		return nil, false
+	}
+	return array[e.index], true
+}
+
+// jsonValueString returns strings as is, and the JSON encoding of the other values.
+func jsonValueString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// parseJSONPath parses a path made of object keys and array indexes, as in $.items[0].status.
+func parseJSONPath(path string) ([]pathElement, error) {
+	rest := strings.TrimPrefix(path, "$")
+	var elems []pathElement
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("empty key")
+			}
+			elems = append(elems, pathElement{key: key})
+			rest = rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("missing ]")
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid index %q", rest[1:end])
+			}
+			elems = append(elems, pathElement{index: index})
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected character %q", rest[0])
+		}
+	}
+	return elems, nil
+}
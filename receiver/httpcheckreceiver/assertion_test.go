@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssertionCheck(t *testing.T) {
+	body := []byte(`{"status": "ok", "version": 2, "ready": true, "items": [{"name": "db"}, {"name": "cache", "up": false}]}`)
+
+	testCases := []struct {
+		desc     string
+		cfg      assertionConfig
+		expected bool
+	}{
+		{
+			desc:     "body matches",
+			cfg:      assertionConfig{BodyMatches: `"status":\s*"ok"`},
+			expected: true,
+		},
+		{
+			desc:     "body does not match",
+			cfg:      assertionConfig{BodyMatches: `"status":\s*"failed"`},
+			expected: false,
+		},
+		{
+			desc:     "path exists",
+			cfg:      assertionConfig{JSONPath: "$.items[1].up"},
+			expected: true,
+		},
+		{
+			desc:     "path does not exist",
+			cfg:      assertionConfig{JSONPath: "$.items[2].name"},
+			expected: false,
+		},
+		{
+			desc:     "string value",
+			cfg:      assertionConfig{JSONPath: "$.items[0].name", Value: "db"},
+			expected: true,
+		},
+		{
+			desc:     "number value",
+			cfg:      assertionConfig{JSONPath: "$.version", Value: "2"},
+			expected: true,
+		},
+		{
+			desc:     "boolean value",
+			cfg:      assertionConfig{JSONPath: "$.items[1].up", Value: "true"},
+			expected: false,
+		},
+		{
+			desc:     "key of an array",
+			cfg:      assertionConfig{JSONPath: "$.items.name"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			a, err := newAssertion(&tc.cfg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, a.check(body))
+		})
+	}
+}
+
+func TestAssertionCheckInvalidJSON(t *testing.T) {
+	a, err := newAssertion(&assertionConfig{JSONPath: "$"})
+	require.NoError(t, err)
+	require.True(t, a.check([]byte(`[]`)))
+	require.False(t, a.check([]byte(`<html></html>`)))
+}
+
+func TestParseJSONPath(t *testing.T) {
+	path, err := parseJSONPath("$.items[1].name")
+	require.NoError(t, err)
+	require.Equal(t, []pathElement{{key: "items"}, {index: 1}, {key: "name"}}, path)
+
+	for _, invalid := range []string{"items", "$..items", "$.items[", "$.items[-1]", "$.items[a]"} {
+		_, err = parseJSONPath(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
 
@@ -17,8 +18,11 @@ import (
 
 // Predefined error responses for configuration validation failures
 var (
-	errMissingEndpoint = errors.New(`"endpoint" must be specified`)
-	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>[:<port>]`)
+	errMissingEndpoint   = errors.New(`"endpoint" must be specified`)
+	errInvalidEndpoint   = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>[:<port>]`)
+	errMissingAssertion  = errors.New(`one of "body_matches" or "json_path" must be specified`)
+	errMultipleAssertion = errors.New(`only one of "body_matches" or "json_path" can be specified`)
+	errValueWithoutPath  = errors.New(`"value" can only be specified with "json_path"`)
 )
 
 // Config defines the configuration for the various elements of the receiver agent.
@@ -31,16 +35,80 @@ type Config struct {
 type targetConfig struct {
 	confighttp.HTTPClientSettings `mapstructure:",squash"`
 	Method                        string `mapstructure:"method"`
+	// Body is the body of the request to the endpoint.
+	Body string `mapstructure:"body"`
+	// Assertions are the assertions the body of the response of the endpoint must pass.
+	Assertions []*assertionConfig `mapstructure:"assertions"`
+	// Steps are the requests sent in order before the request to the endpoint, sharing the
+	// cookies set by their responses, for example to log in. The check stops at the first
+	// step which fails.
+	Steps []*stepConfig `mapstructure:"steps"`
+}
+
+// stepConfig is a request sent with the HTTP client of the target before the request to its endpoint.
+type stepConfig struct {
+	Endpoint   string                         `mapstructure:"endpoint"`
+	Method     string                         `mapstructure:"method"`
+	Headers    map[string]configopaque.String `mapstructure:"headers"`
+	Body       string                         `mapstructure:"body"`
+	Assertions []*assertionConfig             `mapstructure:"assertions"`
+}
+
+// assertionConfig is an assertion on the body of a response, either a regular expression
+// the body must match, or a JSON path which must exist in the body and optionally hold a value.
+type assertionConfig struct {
+	BodyMatches string `mapstructure:"body_matches"`
+	JSONPath    string `mapstructure:"json_path"`
+	Value       string `mapstructure:"value"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
 func (cfg *targetConfig) Validate() error {
+	err := validateEndpoint(cfg.Endpoint)
+
+	for _, assertion := range cfg.Assertions {
+		err = multierr.Append(err, assertion.Validate())
+	}
+
+	for _, step := range cfg.Steps {
+		err = multierr.Append(err, step.Validate())
+	}
+
+	return err
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *stepConfig) Validate() error {
+	err := validateEndpoint(cfg.Endpoint)
+
+	for _, assertion := range cfg.Assertions {
+		err = multierr.Append(err, assertion.Validate())
+	}
+
+	return err
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *assertionConfig) Validate() error {
+	switch {
+	case cfg.BodyMatches == "" && cfg.JSONPath == "":
+		return errMissingAssertion
+	case cfg.BodyMatches != "" && cfg.JSONPath != "":
+		return errMultipleAssertion
+	case cfg.BodyMatches != "" && cfg.Value != "":
+		return errValueWithoutPath
+	}
+	_, err := newAssertion(cfg)
+	return err
+}
+
+func validateEndpoint(endpoint string) error {
 	var err error
 
-	if cfg.Endpoint == "" {
+	if endpoint == "" {
 		err = multierr.Append(err, errMissingEndpoint)
 	} else {
-		_, parseErr := url.ParseRequestURI(cfg.Endpoint)
+		_, parseErr := url.ParseRequestURI(endpoint)
 		if parseErr != nil {
 			err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr))
 		}
@@ -107,6 +107,65 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			desc: "invalid steps and assertions",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{
+							Endpoint: "https://opentelemetry.io",
+						},
+						Assertions: []*assertionConfig{
+							{},
+							{BodyMatches: "ok", JSONPath: "$.status"},
+							{BodyMatches: "ok", Value: "ok"},
+						},
+						Steps: []*stepConfig{
+							{
+								Assertions: []*assertionConfig{
+									{JSONPath: "status"},
+								},
+							},
+						},
+					},
+				},
+				ScraperControllerSettings: scraperhelper.NewDefaultScraperControllerSettings(metadata.Type),
+			},
+			expectedErr: multierr.Combine(
+				errMissingAssertion,
+				errMultipleAssertion,
+				errValueWithoutPath,
+				errMissingEndpoint,
+				fmt.Errorf(`invalid json_path "status": unexpected character 's'`),
+			),
+		},
+		{
+			desc: "valid config with steps and assertions",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{
+							Endpoint: "https://opentelemetry.io/api/me",
+						},
+						Assertions: []*assertionConfig{
+							{JSONPath: "$.user.name", Value: "otel"},
+						},
+						Steps: []*stepConfig{
+							{
+								Endpoint: "https://opentelemetry.io/login",
+								Method:   "POST",
+								Body:     `{"username": "otel"}`,
+								Assertions: []*assertionConfig{
+									{BodyMatches: "logged in"},
+								},
+							},
+						},
+					},
+				},
+				ScraperControllerSettings: scraperhelper.NewDefaultScraperControllerSettings(metadata.Type),
+			},
+			expectedErr: nil,
+		},
 	}
 
 	for _, tc := range testCases {
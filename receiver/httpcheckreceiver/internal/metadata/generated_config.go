@@ -25,13 +25,18 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for httpcheck metrics.
 type MetricsConfig struct {
-	HttpcheckDuration MetricConfig `mapstructure:"httpcheck.duration"`
-	HttpcheckError    MetricConfig `mapstructure:"httpcheck.error"`
-	HttpcheckStatus   MetricConfig `mapstructure:"httpcheck.status"`
+	HttpcheckAssertionStatus  MetricConfig `mapstructure:"httpcheck.assertion.status"`
+	HttpcheckDuration         MetricConfig `mapstructure:"httpcheck.duration"`
+	HttpcheckError            MetricConfig `mapstructure:"httpcheck.error"`
+	HttpcheckStatus           MetricConfig `mapstructure:"httpcheck.status"`
+	HttpcheckTLSCertRemaining MetricConfig `mapstructure:"httpcheck.tls.cert_remaining"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		HttpcheckAssertionStatus: MetricConfig{
+			Enabled: false,
+		},
 		HttpcheckDuration: MetricConfig{
 			Enabled: true,
 		},
@@ -41,6 +46,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		HttpcheckStatus: MetricConfig{
 			Enabled: true,
 		},
+		HttpcheckTLSCertRemaining: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
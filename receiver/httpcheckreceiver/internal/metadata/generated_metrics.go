@@ -11,6 +11,87 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributeAssertionType specifies the a value assertion.type attribute.
+type AttributeAssertionType int
+
+const (
+	_ AttributeAssertionType = iota
+	AttributeAssertionTypeBodyMatches
+	AttributeAssertionTypeJSONPath
+)
+
+// String returns the string representation of the AttributeAssertionType.
+func (av AttributeAssertionType) String() string {
+	switch av {
+	case AttributeAssertionTypeBodyMatches:
+		return "body_matches"
+	case AttributeAssertionTypeJSONPath:
+		return "json_path"
+	}
+	return ""
+}
+
+// MapAttributeAssertionType is a helper map of string to AttributeAssertionType attribute value.
+var MapAttributeAssertionType = map[string]AttributeAssertionType{
+	"body_matches": AttributeAssertionTypeBodyMatches,
+	"json_path":    AttributeAssertionTypeJSONPath,
+}
+
+type metricHttpcheckAssertionStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.assertion.status metric with initial data.
+func (m *metricHttpcheckAssertionStatus) init() {
+	m.data.SetName("httpcheck.assertion.status")
+	m.data.SetDescription("1 if the response body passed the assertion, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckAssertionStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string, assertionTypeAttributeValue string, assertionExpressionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+	dp.Attributes().PutStr("assertion.type", assertionTypeAttributeValue)
+	dp.Attributes().PutStr("assertion.expression", assertionExpressionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckAssertionStatus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckAssertionStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckAssertionStatus(cfg MetricConfig) metricHttpcheckAssertionStatus {
+	m := metricHttpcheckAssertionStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHttpcheckDuration struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -172,17 +253,72 @@ func newMetricHttpcheckStatus(cfg MetricConfig) metricHttpcheckStatus {
 	return m
 }
 
+type metricHttpcheckTLSCertRemaining struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.tls.cert_remaining metric with initial data.
+func (m *metricHttpcheckTLSCertRemaining) init() {
+	m.data.SetName("httpcheck.tls.cert_remaining")
+	m.data.SetDescription("Time until the TLS certificate of the endpoint which expires first, out of the certificate chain, expires. Negative if the certificate has expired.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTLSCertRemaining) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string, httpTLSIssuerAttributeValue string, httpTLSCnAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+	dp.Attributes().PutStr("http.tls.issuer", httpTLSIssuerAttributeValue)
+	dp.Attributes().PutStr("http.tls.cn", httpTLSCnAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTLSCertRemaining) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTLSCertRemaining) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTLSCertRemaining(cfg MetricConfig) metricHttpcheckTLSCertRemaining {
+	m := metricHttpcheckTLSCertRemaining{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                  MetricsBuilderConfig // config of the metrics builder.
-	startTime               pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity         int                  // maximum observed number of metrics per resource.
-	metricsBuffer           pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo               component.BuildInfo  // contains version information.
-	metricHttpcheckDuration metricHttpcheckDuration
-	metricHttpcheckError    metricHttpcheckError
-	metricHttpcheckStatus   metricHttpcheckStatus
+	config                          MetricsBuilderConfig // config of the metrics builder.
+	startTime                       pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                 int                  // maximum observed number of metrics per resource.
+	metricsBuffer                   pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                       component.BuildInfo  // contains version information.
+	metricHttpcheckAssertionStatus  metricHttpcheckAssertionStatus
+	metricHttpcheckDuration         metricHttpcheckDuration
+	metricHttpcheckError            metricHttpcheckError
+	metricHttpcheckStatus           metricHttpcheckStatus
+	metricHttpcheckTLSCertRemaining metricHttpcheckTLSCertRemaining
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -197,13 +333,15 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                  mbc,
-		startTime:               pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:           pmetric.NewMetrics(),
-		buildInfo:               settings.BuildInfo,
-		metricHttpcheckDuration: newMetricHttpcheckDuration(mbc.Metrics.HttpcheckDuration),
-		metricHttpcheckError:    newMetricHttpcheckError(mbc.Metrics.HttpcheckError),
-		metricHttpcheckStatus:   newMetricHttpcheckStatus(mbc.Metrics.HttpcheckStatus),
+		config:                          mbc,
+		startTime:                       pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                   pmetric.NewMetrics(),
+		buildInfo:                       settings.BuildInfo,
+		metricHttpcheckAssertionStatus:  newMetricHttpcheckAssertionStatus(mbc.Metrics.HttpcheckAssertionStatus),
+		metricHttpcheckDuration:         newMetricHttpcheckDuration(mbc.Metrics.HttpcheckDuration),
+		metricHttpcheckError:            newMetricHttpcheckError(mbc.Metrics.HttpcheckError),
+		metricHttpcheckStatus:           newMetricHttpcheckStatus(mbc.Metrics.HttpcheckStatus),
+		metricHttpcheckTLSCertRemaining: newMetricHttpcheckTLSCertRemaining(mbc.Metrics.HttpcheckTLSCertRemaining),
 	}
 	for _, op := range options {
 		op(mb)
@@ -260,9 +398,11 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/httpcheckreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricHttpcheckAssertionStatus.emit(ils.Metrics())
 	mb.metricHttpcheckDuration.emit(ils.Metrics())
 	mb.metricHttpcheckError.emit(ils.Metrics())
 	mb.metricHttpcheckStatus.emit(ils.Metrics())
+	mb.metricHttpcheckTLSCertRemaining.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -283,6 +423,11 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordHttpcheckAssertionStatusDataPoint adds a data point to httpcheck.assertion.status metric.
+func (mb *MetricsBuilder) RecordHttpcheckAssertionStatusDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, assertionTypeAttributeValue AttributeAssertionType, assertionExpressionAttributeValue string) {
+	mb.metricHttpcheckAssertionStatus.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, assertionTypeAttributeValue.String(), assertionExpressionAttributeValue)
+}
+
 // RecordHttpcheckDurationDataPoint adds a data point to httpcheck.duration metric.
 func (mb *MetricsBuilder) RecordHttpcheckDurationDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
 	mb.metricHttpcheckDuration.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue)
@@ -298,6 +443,11 @@ func (mb *MetricsBuilder) RecordHttpcheckStatusDataPoint(ts pcommon.Timestamp, v
 	mb.metricHttpcheckStatus.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, httpStatusCodeAttributeValue, httpMethodAttributeValue, httpStatusClassAttributeValue)
 }
 
+// RecordHttpcheckTLSCertRemainingDataPoint adds a data point to httpcheck.tls.cert_remaining metric.
+func (mb *MetricsBuilder) RecordHttpcheckTLSCertRemainingDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, httpTLSIssuerAttributeValue string, httpTLSCnAttributeValue string) {
+	mb.metricHttpcheckTLSCertRemaining.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, httpTLSIssuerAttributeValue, httpTLSCnAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -54,6 +54,9 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordHttpcheckAssertionStatusDataPoint(ts, 1, "http.url-val", AttributeAssertionTypeBodyMatches, "assertion.expression-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordHttpcheckDurationDataPoint(ts, 1, "http.url-val")
@@ -66,6 +69,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHttpcheckStatusDataPoint(ts, 1, "http.url-val", 16, "http.method-val", "http.status_class-val")
 
+			allMetricsCount++
+			mb.RecordHttpcheckTLSCertRemainingDataPoint(ts, 1, "http.url-val", "http.tls.issuer-val", "http.tls.cn-val")
+
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
 
@@ -88,6 +94,29 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "httpcheck.assertion.status":
+					assert.False(t, validatedMetrics["httpcheck.assertion.status"], "Found a duplicate in the metrics slice: httpcheck.assertion.status")
+					validatedMetrics["httpcheck.assertion.status"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "1 if the response body passed the assertion, otherwise 0.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("http.url")
+					assert.True(t, ok)
+					assert.EqualValues(t, "http.url-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("assertion.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "body_matches", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("assertion.expression")
+					assert.True(t, ok)
+					assert.EqualValues(t, "assertion.expression-val", attrVal.Str())
 				case "httpcheck.duration":
 					assert.False(t, validatedMetrics["httpcheck.duration"], "Found a duplicate in the metrics slice: httpcheck.duration")
 					validatedMetrics["httpcheck.duration"] = true
@@ -149,6 +178,27 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("http.status_class")
 					assert.True(t, ok)
 					assert.EqualValues(t, "http.status_class-val", attrVal.Str())
+				case "httpcheck.tls.cert_remaining":
+					assert.False(t, validatedMetrics["httpcheck.tls.cert_remaining"], "Found a duplicate in the metrics slice: httpcheck.tls.cert_remaining")
+					validatedMetrics["httpcheck.tls.cert_remaining"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Time until the TLS certificate of the endpoint which expires first, out of the certificate chain, expires. Negative if the certificate has expired.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("http.url")
+					assert.True(t, ok)
+					assert.EqualValues(t, "http.url-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("http.tls.issuer")
+					assert.True(t, ok)
+					assert.EqualValues(t, "http.tls.issuer-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("http.tls.cn")
+					assert.True(t, ok)
+					assert.EqualValues(t, "http.tls.cn-val", attrVal.Str())
 				}
 			}
 		})
@@ -5,12 +5,18 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/cookiejar"
+	"strings"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
@@ -27,11 +33,22 @@ var (
 
 type httpcheckScraper struct {
 	clients  []*http.Client
+	requests [][]*checkRequest
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
 }
 
+// checkRequest is one of the requests of the check of a target, either one of its steps or the
+// request to its endpoint, which is the last one.
+type checkRequest struct {
+	endpoint   string
+	method     string
+	headers    map[string]configopaque.String
+	body       string
+	assertions []*assertion
+}
+
 // start starts the scraper by creating a new HTTP Client on the scraper
 func (h *httpcheckScraper) start(_ context.Context, host component.Host) (err error) {
 	for _, target := range h.cfg.Targets {
@@ -40,10 +57,56 @@ func (h *httpcheckScraper) start(_ context.Context, host component.Host) (err er
 			err = multierr.Append(err, clentErr)
 		}
 		h.clients = append(h.clients, client)
+
+		requests, reqErr := newCheckRequests(target)
+		if reqErr != nil {
+			err = multierr.Append(err, reqErr)
+		}
+		h.requests = append(h.requests, requests)
 	}
 	return
 }
 
+func newCheckRequests(target *targetConfig) ([]*checkRequest, error) {
+	var requests []*checkRequest
+	for _, step := range target.Steps {
+		assertions, err := newAssertions(step.Assertions)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, &checkRequest{
+			endpoint:   step.Endpoint,
+			method:     step.Method,
+			headers:    step.Headers,
+			body:       step.Body,
+			assertions: assertions,
+		})
+	}
+
+	assertions, err := newAssertions(target.Assertions)
+	if err != nil {
+		return nil, err
+	}
+	return append(requests, &checkRequest{
+		endpoint:   target.Endpoint,
+		method:     target.Method,
+		body:       target.Body,
+		assertions: assertions,
+	}), nil
+}
+
+func newAssertions(cfgs []*assertionConfig) ([]*assertion, error) {
+	var assertions []*assertion
+	for _, cfg := range cfgs {
+		a, err := newAssertion(cfg)
+		if err != nil {
+			return nil, err
+		}
+		assertions = append(assertions, a)
+	}
+	return assertions, nil
+}
+
 // scrape connects to the endpoint and produces metrics based on the response
 func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	if h.clients == nil || len(h.clients) == 0 {
@@ -58,34 +121,25 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 		go func(targetClient *http.Client, targetIndex int) {
 			defer wg.Done()
 
-			now := pcommon.NewTimestampFromTime(time.Now())
-
-			req, err := http.NewRequestWithContext(ctx, h.cfg.Targets[targetIndex].Method, h.cfg.Targets[targetIndex].Endpoint, http.NoBody)
-			if err != nil {
-				h.settings.Logger.Error("failed to create request", zap.Error(err))
-				return
-			}
-
-			start := time.Now()
-			resp, err := targetClient.Do(req)
-			mux.Lock()
-			h.mb.RecordHttpcheckDurationDataPoint(now, time.Since(start).Milliseconds(), h.cfg.Targets[targetIndex].Endpoint)
-
-			statusCode := 0
-			if err != nil {
-				h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), h.cfg.Targets[targetIndex].Endpoint, err.Error())
-			} else {
-				statusCode = resp.StatusCode
+			requests := h.requests[targetIndex]
+			if len(requests) > 1 {
+				// the steps share the cookies of the check only
+				jar, _ := cookiejar.New(nil)
+				stepsClient := *targetClient
+				stepsClient.Jar = jar
+				targetClient = &stepsClient
 			}
 
-			for class, intVal := range httpResponseClasses {
-				if statusCode/100 == intVal {
-					h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), h.cfg.Targets[targetIndex].Endpoint, int64(statusCode), req.Method, class)
-				} else {
-					h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), h.cfg.Targets[targetIndex].Endpoint, int64(statusCode), req.Method, class)
+			for i, r := range requests {
+				if h.check(ctx, targetClient, r, &mux) || i == len(requests)-1 {
+					continue
 				}
+				now := pcommon.NewTimestampFromTime(time.Now())
+				mux.Lock()
+				h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), h.cfg.Targets[targetIndex].Endpoint, fmt.Sprintf("step %d (%s) failed", i+1, r.endpoint))
+				mux.Unlock()
+				return
 			}
-			mux.Unlock()
 		}(client, idx)
 	}
 
@@ -94,6 +148,89 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 	return h.mb.Emit(), nil
 }
 
+// check sends the request and records its metrics. It returns whether the request got a response
+// without an error status code, which passed the assertions.
+func (h *httpcheckScraper) check(ctx context.Context, client *http.Client, r *checkRequest, mux *sync.Mutex) bool {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	var body io.Reader = http.NoBody
+	if r.body != "" {
+		body = strings.NewReader(r.body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.method, r.endpoint, body)
+	if err != nil {
+		h.settings.Logger.Error("failed to create request", zap.Error(err))
+		return false
+	}
+	for k, v := range r.headers {
+		req.Header.Set(k, string(v))
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+
+	var respBody []byte
+	if err == nil {
+		if len(r.assertions) > 0 {
+			respBody, err = io.ReadAll(resp.Body)
+		}
+		_ = resp.Body.Close()
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+	h.mb.RecordHttpcheckDurationDataPoint(now, time.Since(start).Milliseconds(), r.endpoint)
+
+	statusCode := 0
+	if err != nil {
+		h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), r.endpoint, err.Error())
+	} else {
+		statusCode = resp.StatusCode
+	}
+
+	for class, intVal := range httpResponseClasses {
+		if statusCode/100 == intVal {
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), r.endpoint, int64(statusCode), req.Method, class)
+		} else {
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), r.endpoint, int64(statusCode), req.Method, class)
+		}
+	}
+
+	if err != nil {
+		return false
+	}
+
+	if resp.TLS != nil {
+		h.recordCertRemaining(now, r.endpoint, resp.TLS.PeerCertificates)
+	}
+
+	passed := statusCode < http.StatusBadRequest
+	for _, a := range r.assertions {
+		if a.check(respBody) {
+			h.mb.RecordHttpcheckAssertionStatusDataPoint(now, int64(1), r.endpoint, a.assertionType, a.expression)
+		} else {
+			h.mb.RecordHttpcheckAssertionStatusDataPoint(now, int64(0), r.endpoint, a.assertionType, a.expression)
+			passed = false
+		}
+	}
+	return passed
+}
+
+// recordCertRemaining records the time remaining until the certificate of the chain which expires first expires.
+func (h *httpcheckScraper) recordCertRemaining(now pcommon.Timestamp, endpoint string, certs []*x509.Certificate) {
+	var earliest *x509.Certificate
+	for _, cert := range certs {
+		if earliest == nil || cert.NotAfter.Before(earliest.NotAfter) {
+			earliest = cert
+		}
+	}
+	if earliest == nil {
+		return
+	}
+	remaining := int64(earliest.NotAfter.Sub(now.AsTime()).Seconds())
+	h.mb.RecordHttpcheckTLSCertRemainingDataPoint(now, remaining, endpoint, earliest.Issuer.String(), earliest.Subject.CommonName)
+}
+
 func newScraper(conf *Config, settings receiver.CreateSettings) *httpcheckScraper {
 	return &httpcheckScraper{
 		cfg:      conf,
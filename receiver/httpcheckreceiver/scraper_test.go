@@ -9,10 +9,12 @@ import (
 	"net/http/httptest"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
@@ -233,3 +235,178 @@ func TestScraperMultipleTargets(t *testing.T) {
 		pmetrictest.IgnoreTimestamp(),
 	))
 }
+
+func newStepsServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.Header.Get("Content-Type") != "application/json" {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		http.SetCookie(rw, &http.Cookie{Name: "session", Value: "1234"})
+		_, err := rw.Write([]byte(`{"logged_in": true}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/probe", func(rw http.ResponseWriter, req *http.Request) {
+		if _, err := req.Cookie("session"); err != nil {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := rw.Write([]byte(`{"status": "ok", "checks": [{"name": "db", "up": true}]}`))
+		require.NoError(t, err)
+	})
+	return httptest.NewServer(mux)
+}
+
+// dataPoints returns the data points of the metric with the given name.
+func dataPoints(t *testing.T, metrics pmetric.Metrics, name string) pmetric.NumberDataPointSlice {
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		if m.Name() != name {
+			continue
+		}
+		if m.Type() == pmetric.MetricTypeGauge {
+			return m.Gauge().DataPoints()
+		}
+		return m.Sum().DataPoints()
+	}
+	require.Failf(t, "metric not found", "metric %s not found", name)
+	return pmetric.NewNumberDataPointSlice()
+}
+
+// statusClassValue returns the value of the httpcheck.status data point of the url and status class.
+func statusClassValue(t *testing.T, metrics pmetric.Metrics, url string, class string) (int64, int64) {
+	dps := dataPoints(t, metrics, "httpcheck.status")
+	for i := 0; i < dps.Len(); i++ {
+		attrs := dps.At(i).Attributes().AsRaw()
+		if attrs["http.url"] == url && attrs["http.status_class"] == class {
+			return dps.At(i).IntValue(), attrs["http.status_code"].(int64)
+		}
+	}
+	require.Failf(t, "data point not found", "no status for %s", url)
+	return 0, 0
+}
+
+func TestScraperSteps(t *testing.T) {
+	ms := newStepsServer(t)
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.HttpcheckAssertionStatus.Enabled = true
+	cfg.Targets = []*targetConfig{{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ms.URL + "/probe",
+		},
+		Assertions: []*assertionConfig{
+			{JSONPath: "$.status", Value: "ok"},
+			{JSONPath: "$.checks[0].up", Value: "false"},
+		},
+		Steps: []*stepConfig{{
+			Endpoint: ms.URL + "/login",
+			Method:   http.MethodPost,
+			Headers:  map[string]configopaque.String{"Content-Type": "application/json"},
+			Body:     `{"username": "otel"}`,
+			Assertions: []*assertionConfig{
+				{BodyMatches: `"logged_in":\s*true`},
+			},
+		}},
+	}}
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	value, code := statusClassValue(t, actualMetrics, ms.URL+"/login", "2xx")
+	require.Equal(t, int64(1), value)
+	require.Equal(t, int64(200), code)
+	value, code = statusClassValue(t, actualMetrics, ms.URL+"/probe", "2xx")
+	require.Equal(t, int64(1), value)
+	require.Equal(t, int64(200), code)
+
+	assertions := map[string]int64{}
+	dps := dataPoints(t, actualMetrics, "httpcheck.assertion.status")
+	for i := 0; i < dps.Len(); i++ {
+		attrs := dps.At(i).Attributes().AsRaw()
+		assertions[attrs["http.url"].(string)+" "+attrs["assertion.expression"].(string)] = dps.At(i).IntValue()
+	}
+	require.Equal(t, map[string]int64{
+		ms.URL + "/login " + `"logged_in":\s*true`: 1,
+		ms.URL + "/probe $.status":                 1,
+		ms.URL + "/probe $.checks[0].up":           0,
+	}, assertions)
+
+	// The steps are sent again on each scrape, with a new cookie jar.
+	actualMetrics, err = scraper.scrape(context.Background())
+	require.NoError(t, err)
+	value, _ = statusClassValue(t, actualMetrics, ms.URL+"/probe", "2xx")
+	require.Equal(t, int64(1), value)
+}
+
+func TestScraperFailedStep(t *testing.T) {
+	ms := newStepsServer(t)
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ms.URL + "/probe",
+		},
+		Steps: []*stepConfig{{
+			// missing method and content type
+			Endpoint: ms.URL + "/login",
+		}},
+	}}
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	value, code := statusClassValue(t, actualMetrics, ms.URL+"/login", "4xx")
+	require.Equal(t, int64(1), value)
+	require.Equal(t, int64(400), code)
+
+	// The endpoint is not checked after the failed step.
+	dps := dataPoints(t, actualMetrics, "httpcheck.error")
+	require.Equal(t, 1, dps.Len())
+	require.Equal(t, map[string]any{
+		"http.url":      ms.URL + "/probe",
+		"error.message": "step 1 (" + ms.URL + "/login) failed",
+	}, dps.At(0).Attributes().AsRaw())
+	require.Equal(t, 5, dataPoints(t, actualMetrics, "httpcheck.status").Len())
+}
+
+func TestScraperTLSCertRemaining(t *testing.T) {
+	ms := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.HttpcheckTLSCertRemaining.Enabled = true
+	cfg.Targets = []*targetConfig{{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ms.URL,
+			TLSSetting: configtls.TLSClientSetting{
+				InsecureSkipVerify: true,
+			},
+		},
+	}}
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	dps := dataPoints(t, actualMetrics, "httpcheck.tls.cert_remaining")
+	require.Equal(t, 1, dps.Len())
+	cert := ms.Certificate()
+	require.InDelta(t, time.Until(cert.NotAfter).Seconds(), float64(dps.At(0).IntValue()), 60)
+	require.Equal(t, map[string]any{
+		"http.url":        ms.URL,
+		"http.tls.issuer": cert.Issuer.String(),
+		"http.tls.cn":     cert.Subject.CommonName,
+	}, dps.At(0).Attributes().AsRaw())
+}
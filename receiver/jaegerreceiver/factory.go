@@ -78,6 +78,9 @@ func createTracesReceiver(
 	// Error handling for the conversion is done in the Validate function from the Config object itself.
 
 	rCfg := cfg.(*Config)
+	if rCfg.RemoteSampling != nil {
+		set.Logger.Warn("The remote_sampling settings are ignored since remote sampling isn't served by the Jaeger receiver anymore, use the jaegerremotesampling extension instead")
+	}
 
 	var config configuration
 	// Set ports
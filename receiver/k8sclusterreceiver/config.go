@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
@@ -37,6 +38,10 @@ type Config struct {
 	// metadata collection on changes).
 	MetadataCollectionInterval time.Duration `mapstructure:"metadata_collection_interval"`
 
+	// CustomResources are the custom resources whose objects are reported, with the metrics
+	// extracted from each object.
+	CustomResources []customresource.Config `mapstructure:"custom_resources"`
+
 	// MetricsBuilderConfig allows customizing scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
@@ -48,5 +53,10 @@ func (cfg *Config) Validate() error {
 	default:
 		return fmt.Errorf("\"%s\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", cfg.Distribution)
 	}
+	for _, cr := range cfg.CustomResources {
+		if err := cr.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
@@ -60,6 +61,33 @@ func TestLoadConfig(t *testing.T) {
 				MetricsBuilderConfig:       metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom_resources"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.CustomResources = []customresource.Config{
+					{
+						Group:    "argoproj.io",
+						Version:  "v1alpha1",
+						Resource: "rollouts",
+						Metrics: []customresource.MetricConfig{
+							{
+								Name:        "argo.rollout.replicas.available",
+								Description: "The number of available replicas of the rollout.",
+								Unit:        "{replica}",
+								Path:        "{.status.availableReplicas}",
+							},
+							{
+								Name:         "argo.rollout.paused",
+								Path:         ".status.pauseConditions[0].reason",
+								ValueMapping: map[string]float64{"CanaryPauseStep": 1},
+							},
+						},
+					},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -96,4 +124,20 @@ func TestInvalidConfig(t *testing.T) {
 	err = component.ValidateConfig(cfg)
 	assert.NotNil(t, err)
 	assert.Equal(t, "\"wrong\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", err.Error())
+
+	// Invalid custom resource path
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		CustomResources: []customresource.Config{
+			{
+				Version:  "v1",
+				Resource: "certificates",
+				Metrics:  []customresource.MetricConfig{{Name: "certificate.ready", Path: "{.status.conditions["}},
+			},
+		},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.ErrorContains(t, err, "invalid path of the metric \"certificate.ready\"")
 }
@@ -16,9 +16,11 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/clusterresourcequota"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/cronjob"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/demonset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deployment"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
@@ -43,17 +45,20 @@ type DataCollector struct {
 	metadataStore            *metadata.Store
 	nodeConditionsToReport   []string
 	allocatableTypesToReport []string
+	customResources          []*customresource.Collector
 	metricsBuilder           *metadata.MetricsBuilder
 }
 
 // NewDataCollector returns a DataCollector.
 func NewDataCollector(set receiver.CreateSettings, ms *metadata.Store,
-	metricsBuilderConfig metadata.MetricsBuilderConfig, nodeConditionsToReport, allocatableTypesToReport []string) *DataCollector {
+	metricsBuilderConfig metadata.MetricsBuilderConfig, nodeConditionsToReport, allocatableTypesToReport []string,
+	customResources []*customresource.Collector) *DataCollector {
 	return &DataCollector{
 		settings:                 set,
 		metadataStore:            ms,
 		nodeConditionsToReport:   nodeConditionsToReport,
 		allocatableTypesToReport: allocatableTypesToReport,
+		customResources:          customResources,
 		metricsBuilder:           metadata.NewMetricsBuilder(metricsBuilderConfig, set),
 	}
 }
@@ -112,6 +117,15 @@ func (dc *DataCollector) CollectMetricData(currentTime time.Time) pmetric.Metric
 		clusterresourcequota.RecordMetrics(dc.metricsBuilder, o.(*quotav1.ClusterResourceQuota), ts)
 	})
 
+	for _, cr := range dc.customResources {
+		dc.metadataStore.ForEachCustomResource(cr.GroupVersionResource(), func(o any) {
+			crm := cr.CustomMetrics(dc.settings, dc.metricsBuilder.NewResourceBuilder(), o.(*unstructured.Unstructured), ts)
+			if crm.ScopeMetrics().Len() > 0 {
+				crm.MoveTo(customRMs.AppendEmpty())
+			}
+		})
+	}
+
 	m := dc.metricsBuilder.Emit()
 	customRMs.MoveAndAppendTo(m.ResourceMetrics())
 	return m
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// Config defines the metrics reported about the objects of a custom resource.
+type Config struct {
+	// Group, Version and Resource identify the custom resource, such as the
+	// argoproj.io group, the v1alpha1 version and the rollouts resource.
+	Group    string `mapstructure:"group"`
+	Version  string `mapstructure:"version"`
+	Resource string `mapstructure:"resource"`
+	// Metrics are the metrics reported for each object of the resource.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+}
+
+// MetricConfig defines a gauge whose value is extracted from the objects of a custom resource.
+type MetricConfig struct {
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+	// Path is the JSONPath of the value in the object, such as {.status.readyReplicas}. The
+	// enclosing braces are optional.
+	Path string `mapstructure:"path"`
+	// ValueMapping maps the string values found at the path to the values of the metric,
+	// such as "True" to 1 for the status of a condition. The other string values are parsed
+	// as numbers or quantities.
+	ValueMapping map[string]float64 `mapstructure:"value_mapping"`
+}
+
+// GroupVersionResource returns the group version resource of the custom resource.
+func (c Config) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: c.Group, Version: c.Version, Resource: c.Resource}
+}
+
+func (c Config) Validate() error {
+	if c.Version == "" || c.Resource == "" {
+		return errors.New("the version and the resource of the custom resource are required")
+	}
+	if len(c.Metrics) == 0 {
+		return fmt.Errorf("no metrics defined for the custom resource %q", c.GroupVersionResource())
+	}
+	for _, m := range c.Metrics {
+		if m.Name == "" {
+			return fmt.Errorf("a metric of the custom resource %q has no name", c.GroupVersionResource())
+		}
+		if _, err := m.jsonPath(); err != nil {
+			return fmt.Errorf("invalid path of the metric %q: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m MetricConfig) jsonPath() (*jsonpath.JSONPath, error) {
+	if m.Path == "" {
+		return nil, errors.New("the path is required")
+	}
+	path := m.Path
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	p := jsonpath.New(m.Name).AllowMissingKeys(true)
+	if err := p.Parse(path); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+type metric struct {
+	MetricConfig
+	path *jsonpath.JSONPath
+}
+
+// Collector reports the metrics of the objects of a custom resource.
+type Collector struct {
+	gvr     schema.GroupVersionResource
+	metrics []metric
+}
+
+// NewCollector returns the Collector of the metrics of the custom resource.
+func NewCollector(cfg Config) (*Collector, error) {
+	c := &Collector{gvr: cfg.GroupVersionResource()}
+	for _, m := range cfg.Metrics {
+		path, err := m.jsonPath()
+		if err != nil {
+			return nil, fmt.Errorf("invalid path of the metric %q: %w", m.Name, err)
+		}
+		c.metrics = append(c.metrics, metric{MetricConfig: m, path: path})
+	}
+	return c, nil
+}
+
+// GroupVersionResource returns the group version resource of the custom resource.
+func (c *Collector) GroupVersionResource() schema.GroupVersionResource {
+	return c.gvr
+}
+
+// CustomMetrics returns the metrics of the object, the metrics whose value isn't found
+// in the object being skipped.
+func (c *Collector) CustomMetrics(set receiver.CreateSettings, rb *metadata.ResourceBuilder, obj *unstructured.Unstructured,
+	ts pcommon.Timestamp) pmetric.ResourceMetrics {
+	rm := pmetric.NewResourceMetrics()
+
+	sm := rm.ScopeMetrics().AppendEmpty()
+	for _, mc := range c.metrics {
+		value, err := mc.value(obj)
+		if err != nil {
+			set.Logger.Debug("Failed to extract the value of the custom resource metric",
+				zap.String("metric", mc.Name), zap.String("object", obj.GetName()), zap.Error(err))
+			continue
+		}
+		m := sm.Metrics().AppendEmpty()
+		m.SetName(mc.Name)
+		m.SetDescription(mc.Description)
+		m.SetUnit(mc.Unit)
+		dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetDoubleValue(value)
+		dp.SetTimestamp(ts)
+	}
+
+	if sm.Metrics().Len() == 0 {
+		return pmetric.NewResourceMetrics()
+	}
+
+	rm.SetSchemaUrl(conventions.SchemaURL)
+	sm.Scope().SetName("otelcol/k8sclusterreceiver")
+	sm.Scope().SetVersion(set.BuildInfo.Version)
+
+	if obj.GetNamespace() != "" {
+		rb.SetK8sNamespaceName(obj.GetNamespace())
+	}
+	rb.SetK8sObjectKind(obj.GetKind())
+	rb.SetK8sObjectName(obj.GetName())
+	rb.SetK8sObjectUID(string(obj.GetUID()))
+	rb.SetOpencensusResourcetype("k8s")
+	rb.Emit().MoveTo(rm.Resource())
+	return rm
+}
+
+// value returns the value at the path of the metric in the object, the first one when the
+// path matches several values.
+func (m metric) value(obj *unstructured.Unstructured) (float64, error) {
+	results, err := m.path.FindResults(obj.Object)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return 0, fmt.Errorf("no value found at %v", m.Path)
+	}
+	v := results[0][0]
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return 0, fmt.Errorf("no value found at %v", m.Path)
+	}
+	return m.toFloat(v.Interface())
+}
+
+func (m metric) toFloat(v any) (float64, error) {
+	switch t := v.(type) {
+	case int64:
+		return float64(t), nil
+	case float64:
+		return t, nil
+	case bool:
+		if t {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		if f, ok := m.ValueMapping[t]; ok {
+			return f, nil
+		}
+		if f, err := strconv.ParseFloat(t, 64); err == nil {
+			return f, nil
+		}
+		q, err := resource.ParseQuantity(t)
+		if err != nil {
+			return 0, fmt.Errorf("the value %q at %v is not a number", t, m.Path)
+		}
+		return q.AsApproximateFloat64(), nil
+	default:
+		return 0, fmt.Errorf("the value at %v is a %T, not a number", m.Path, v)
+	}
+}
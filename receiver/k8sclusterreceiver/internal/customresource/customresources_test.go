@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         Config
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			cfg: Config{
+				Group:    "cert-manager.io",
+				Version:  "v1",
+				Resource: "certificates",
+				Metrics:  []MetricConfig{{Name: "certificate.ready", Path: `{.status.conditions[?(@.type=="Ready")].status}`}},
+			},
+		},
+		{
+			name:        "no resource",
+			cfg:         Config{Version: "v1", Metrics: []MetricConfig{{Name: "m", Path: ".spec.replicas"}}},
+			expectedErr: "the version and the resource of the custom resource are required",
+		},
+		{
+			name:        "no metrics",
+			cfg:         Config{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"},
+			expectedErr: `no metrics defined for the custom resource "argoproj.io/v1alpha1, Resource=rollouts"`,
+		},
+		{
+			name:        "no metric name",
+			cfg:         Config{Version: "v1", Resource: "widgets", Metrics: []MetricConfig{{Path: ".spec.replicas"}}},
+			expectedErr: `a metric of the custom resource "/v1, Resource=widgets" has no name`,
+		},
+		{
+			name:        "no path",
+			cfg:         Config{Version: "v1", Resource: "widgets", Metrics: []MetricConfig{{Name: "m"}}},
+			expectedErr: `invalid path of the metric "m": the path is required`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCustomMetrics(t *testing.T) {
+	c, err := NewCollector(Config{
+		Group:    "cert-manager.io",
+		Version:  "v1",
+		Resource: "certificates",
+		Metrics: []MetricConfig{
+			{
+				Name:         "certificate.ready",
+				Unit:         "1",
+				Path:         `{.status.conditions[?(@.type=="Ready")].status}`,
+				ValueMapping: map[string]float64{"True": 1, "False": 0},
+			},
+			{Name: "certificate.revision", Path: ".status.revision"},
+			{Name: "certificate.private_key.size", Unit: "By", Path: ".spec.privateKey.size"},
+			{Name: "certificate.renewal.enabled", Path: ".spec.renewal.enabled"},
+			{Name: "certificate.missing", Path: ".status.missing"},
+			{Name: "certificate.not_a_number", Path: ".spec.secretName"},
+		},
+	})
+	require.NoError(t, err)
+
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata": map[string]any{
+			"name":      "example-com",
+			"namespace": "default",
+			"uid":       "test-certificate-uid",
+		},
+		"spec": map[string]any{
+			"secretName": "example-com-tls",
+			"privateKey": map[string]any{"size": "2Ki"},
+			"renewal":    map[string]any{"enabled": true},
+		},
+		"status": map[string]any{
+			"revision": int64(3),
+			"conditions": []any{
+				map[string]any{"type": "Issuing", "status": "False"},
+				map[string]any{"type": "Ready", "status": "True"},
+			},
+		},
+	}}
+
+	ts := pcommon.Timestamp(1)
+	rm := c.CustomMetrics(receivertest.NewNopCreateSettings(), metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig()), obj, ts)
+
+	assert.Equal(t, map[string]any{
+		"k8s.namespace.name":      "default",
+		"k8s.object.kind":         "Certificate",
+		"k8s.object.name":         "example-com",
+		"k8s.object.uid":          "test-certificate-uid",
+		"opencensus.resourcetype": "k8s",
+	}, rm.Resource().Attributes().AsRaw())
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	assert.Equal(t, "otelcol/k8sclusterreceiver", rm.ScopeMetrics().At(0).Scope().Name())
+	values := map[string]float64{}
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		require.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+		dp := ms.At(i).Gauge().DataPoints().At(0)
+		assert.Equal(t, ts, dp.Timestamp())
+		values[ms.At(i).Name()] = dp.DoubleValue()
+	}
+	assert.Equal(t, map[string]float64{
+		"certificate.ready":            1,
+		"certificate.revision":         3,
+		"certificate.private_key.size": 2048,
+		"certificate.renewal.enabled":  1,
+	}, values)
+}
+
+func TestCustomMetricsNoValue(t *testing.T) {
+	c, err := NewCollector(Config{
+		Group:    "argoproj.io",
+		Version:  "v1alpha1",
+		Resource: "rollouts",
+		Metrics:  []MetricConfig{{Name: "argo.rollout.replicas.available", Path: "{.status.availableReplicas}"}},
+	})
+	require.NoError(t, err)
+
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"kind":     "Rollout",
+		"metadata": map[string]any{"name": "rollout"},
+	}}
+	rm := c.CustomMetrics(receivertest.NewNopCreateSettings(), metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig()), obj, pcommon.Timestamp(1))
+	assert.Equal(t, 0, rm.ScopeMetrics().Len())
+}
@@ -225,6 +225,9 @@ type ResourceAttributesConfig struct {
 	K8sNamespaceUID              ResourceAttributeConfig `mapstructure:"k8s.namespace.uid"`
 	K8sNodeName                  ResourceAttributeConfig `mapstructure:"k8s.node.name"`
 	K8sNodeUID                   ResourceAttributeConfig `mapstructure:"k8s.node.uid"`
+	K8sObjectKind                ResourceAttributeConfig `mapstructure:"k8s.object.kind"`
+	K8sObjectName                ResourceAttributeConfig `mapstructure:"k8s.object.name"`
+	K8sObjectUID                 ResourceAttributeConfig `mapstructure:"k8s.object.uid"`
 	K8sPodName                   ResourceAttributeConfig `mapstructure:"k8s.pod.name"`
 	K8sPodUID                    ResourceAttributeConfig `mapstructure:"k8s.pod.uid"`
 	K8sReplicasetName            ResourceAttributeConfig `mapstructure:"k8s.replicaset.name"`
@@ -296,6 +299,15 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sNodeUID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sObjectKind: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sObjectName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sObjectUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		K8sPodName: ResourceAttributeConfig{
 			Enabled: true,
 		},
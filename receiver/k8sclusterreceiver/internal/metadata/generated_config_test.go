@@ -88,6 +88,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNamespaceUID:              ResourceAttributeConfig{Enabled: true},
 					K8sNodeName:                  ResourceAttributeConfig{Enabled: true},
 					K8sNodeUID:                   ResourceAttributeConfig{Enabled: true},
+					K8sObjectKind:                ResourceAttributeConfig{Enabled: true},
+					K8sObjectName:                ResourceAttributeConfig{Enabled: true},
+					K8sObjectUID:                 ResourceAttributeConfig{Enabled: true},
 					K8sPodName:                   ResourceAttributeConfig{Enabled: true},
 					K8sPodUID:                    ResourceAttributeConfig{Enabled: true},
 					K8sReplicasetName:            ResourceAttributeConfig{Enabled: true},
@@ -170,6 +173,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNamespaceUID:              ResourceAttributeConfig{Enabled: false},
 					K8sNodeName:                  ResourceAttributeConfig{Enabled: false},
 					K8sNodeUID:                   ResourceAttributeConfig{Enabled: false},
+					K8sObjectKind:                ResourceAttributeConfig{Enabled: false},
+					K8sObjectName:                ResourceAttributeConfig{Enabled: false},
+					K8sObjectUID:                 ResourceAttributeConfig{Enabled: false},
 					K8sPodName:                   ResourceAttributeConfig{Enabled: false},
 					K8sPodUID:                    ResourceAttributeConfig{Enabled: false},
 					K8sReplicasetName:            ResourceAttributeConfig{Enabled: false},
@@ -237,6 +243,9 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sNamespaceUID:              ResourceAttributeConfig{Enabled: true},
 				K8sNodeName:                  ResourceAttributeConfig{Enabled: true},
 				K8sNodeUID:                   ResourceAttributeConfig{Enabled: true},
+				K8sObjectKind:                ResourceAttributeConfig{Enabled: true},
+				K8sObjectName:                ResourceAttributeConfig{Enabled: true},
+				K8sObjectUID:                 ResourceAttributeConfig{Enabled: true},
 				K8sPodName:                   ResourceAttributeConfig{Enabled: true},
 				K8sPodUID:                    ResourceAttributeConfig{Enabled: true},
 				K8sReplicasetName:            ResourceAttributeConfig{Enabled: true},
@@ -273,6 +282,9 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sNamespaceUID:              ResourceAttributeConfig{Enabled: false},
 				K8sNodeName:                  ResourceAttributeConfig{Enabled: false},
 				K8sNodeUID:                   ResourceAttributeConfig{Enabled: false},
+				K8sObjectKind:                ResourceAttributeConfig{Enabled: false},
+				K8sObjectName:                ResourceAttributeConfig{Enabled: false},
+				K8sObjectUID:                 ResourceAttributeConfig{Enabled: false},
 				K8sPodName:                   ResourceAttributeConfig{Enabled: false},
 				K8sPodUID:                    ResourceAttributeConfig{Enabled: false},
 				K8sReplicasetName:            ResourceAttributeConfig{Enabled: false},
@@ -241,6 +241,9 @@ func TestMetricsBuilder(t *testing.T) {
 			rb.SetK8sNamespaceUID("k8s.namespace.uid-val")
 			rb.SetK8sNodeName("k8s.node.name-val")
 			rb.SetK8sNodeUID("k8s.node.uid-val")
+			rb.SetK8sObjectKind("k8s.object.kind-val")
+			rb.SetK8sObjectName("k8s.object.name-val")
+			rb.SetK8sObjectUID("k8s.object.uid-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
 			rb.SetK8sReplicasetName("k8s.replicaset.name-val")
@@ -147,6 +147,27 @@ func (rb *ResourceBuilder) SetK8sNodeUID(val string) {
 	}
 }
 
+// SetK8sObjectKind sets provided value as "k8s.object.kind" attribute.
+func (rb *ResourceBuilder) SetK8sObjectKind(val string) {
+	if rb.config.K8sObjectKind.Enabled {
+		rb.res.Attributes().PutStr("k8s.object.kind", val)
+	}
+}
+
+// SetK8sObjectName sets provided value as "k8s.object.name" attribute.
+func (rb *ResourceBuilder) SetK8sObjectName(val string) {
+	if rb.config.K8sObjectName.Enabled {
+		rb.res.Attributes().PutStr("k8s.object.name", val)
+	}
+}
+
+// SetK8sObjectUID sets provided value as "k8s.object.uid" attribute.
+func (rb *ResourceBuilder) SetK8sObjectUID(val string) {
+	if rb.config.K8sObjectUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.object.uid", val)
+	}
+}
+
 // SetK8sPodName sets provided value as "k8s.pod.name" attribute.
 func (rb *ResourceBuilder) SetK8sPodName(val string) {
 	if rb.config.K8sPodName.Enabled {
@@ -31,6 +31,9 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sNamespaceUID("k8s.namespace.uid-val")
 			rb.SetK8sNodeName("k8s.node.name-val")
 			rb.SetK8sNodeUID("k8s.node.uid-val")
+			rb.SetK8sObjectKind("k8s.object.kind-val")
+			rb.SetK8sObjectName("k8s.object.name-val")
+			rb.SetK8sObjectUID("k8s.object.uid-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
 			rb.SetK8sReplicasetName("k8s.replicaset.name-val")
@@ -50,9 +53,9 @@ func TestResourceBuilder(t *testing.T) {
 
 			switch test {
 			case "default":
-				assert.Equal(t, 31, res.Attributes().Len())
+				assert.Equal(t, 34, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 31, res.Attributes().Len())
+				assert.Equal(t, 34, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -150,6 +153,21 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.node.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.object.kind")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.object.kind-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.object.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.object.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.object.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.object.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.pod.name")
 			assert.True(t, ok)
 			if ok {
@@ -13,12 +13,16 @@ import (
 // to correlate other Kubernetes objects with a Pod.
 type Store struct {
 	stores map[schema.GroupVersionKind]cache.Store
+	// customResourceStores are the caches of the custom resources, whose kind is not known
+	// before the objects are listed.
+	customResourceStores map[schema.GroupVersionResource]cache.Store
 }
 
 // NewStore creates a new Store.
 func NewStore() *Store {
 	return &Store{
-		stores: make(map[schema.GroupVersionKind]cache.Store),
+		stores:               make(map[schema.GroupVersionKind]cache.Store),
+		customResourceStores: make(map[schema.GroupVersionResource]cache.Store),
 	}
 }
 
@@ -43,3 +47,20 @@ func (ms *Store) ForEach(gvk schema.GroupVersionKind, f func(o any)) {
 		f(obj)
 	}
 }
+
+// SetupCustomResource tracks the objects of a custom resource.
+func (ms *Store) SetupCustomResource(gvr schema.GroupVersionResource, store cache.Store) {
+	ms.customResourceStores[gvr] = store
+}
+
+// ForEachCustomResource iterates over all the objects of a custom resource.
+func (ms *Store) ForEachCustomResource(gvr schema.GroupVersionResource, f func(o any)) {
+	store := ms.customResourceStores[gvr]
+	if store == nil {
+		// The custom resource is not served by the cluster.
+		return
+	}
+	for _, obj := range store.List() {
+		f(obj)
+	}
+}
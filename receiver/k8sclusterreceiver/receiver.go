@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
@@ -164,10 +165,18 @@ func newReceiver(_ context.Context, set receiver.CreateSettings, cfg component.C
 	if err != nil {
 		return nil, err
 	}
+	var customResources []*customresource.Collector
+	for _, crCfg := range rCfg.CustomResources {
+		cr, err := customresource.NewCollector(crCfg)
+		if err != nil {
+			return nil, err
+		}
+		customResources = append(customResources, cr)
+	}
 	ms := metadata.NewStore()
 	return &kubernetesReceiver{
 		dataCollector: collection.NewDataCollector(set, ms, rCfg.MetricsBuilderConfig,
-			rCfg.NodeConditionTypesToReport, rCfg.AllocatableTypesToReport),
+			rCfg.NodeConditionTypesToReport, rCfg.AllocatableTypesToReport, customResources),
 		resourceWatcher: newResourceWatcher(set, rCfg, ms),
 		settings:        set,
 		config:          rCfg,
@@ -19,11 +19,16 @@ import (
 	"go.opentelemetry.io/collector/obsreport/obsreporttest"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
@@ -78,6 +83,83 @@ func TestReceiver(t *testing.T) {
 	require.NoError(t, r.Shutdown(ctx))
 }
 
+func TestReceiverWithCustomResources(t *testing.T) {
+	tt, err := obsreporttest.SetupTelemetry(component.NewID(metadata.Type))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tt.Shutdown(context.Background()))
+	}()
+
+	rollouts := schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}
+	client := newFakeClientWithAllResources()
+	client.Resources = append(client.Resources, &v1.APIResourceList{
+		GroupVersion: "argoproj.io/v1alpha1",
+		APIResources: []v1.APIResource{{Name: "rollouts", Kind: "Rollout", Namespaced: true}},
+	})
+	rollout := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Rollout",
+		"metadata":   map[string]any{"name": "rollout", "namespace": "default", "uid": "test-rollout-uid"},
+		"status":     map[string]any{"availableReplicas": int64(3)},
+	}}
+	dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{rollouts: "RolloutList"}, rollout)
+	sink := new(consumertest.MetricsSink)
+
+	config := &Config{
+		CollectionInterval: 1 * time.Second,
+		Distribution:       distributionKubernetes,
+		CustomResources: []customresource.Config{
+			{
+				Group:    rollouts.Group,
+				Version:  rollouts.Version,
+				Resource: rollouts.Resource,
+				Metrics: []customresource.MetricConfig{
+					{Name: "argo.rollout.replicas.available", Path: "{.status.availableReplicas}"},
+				},
+			},
+			{
+				// not served by the cluster
+				Group:    "cert-manager.io",
+				Version:  "v1",
+				Resource: "certificates",
+				Metrics: []customresource.MetricConfig{
+					{Name: "certificate.revision", Path: "{.status.revision}"},
+				},
+			},
+		},
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	}
+	r, err := newReceiver(context.Background(), tt.ToReceiverCreateSettings(), config)
+	require.NoError(t, err)
+	kr := r.(*kubernetesReceiver)
+	kr.metricsConsumer = sink
+	kr.resourceWatcher.makeClient = func(_ k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return client, nil
+	}
+	kr.resourceWatcher.makeDynamicClient = func(_ k8sconfig.APIConfig) (dynamic.Interface, error) {
+		return dynamicClient, nil
+	}
+
+	ctx := context.Background()
+	require.NoError(t, kr.Start(ctx, componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		for _, m := range sink.AllMetrics() {
+			rms := m.ResourceMetrics()
+			for i := 0; i < rms.Len(); i++ {
+				name, ok := rms.At(i).Resource().Attributes().Get("k8s.object.name")
+				if ok && name.Str() == "rollout" {
+					metric := rms.At(i).ScopeMetrics().At(0).Metrics().At(0)
+					return metric.Name() == "argo.rollout.replicas.available" &&
+						metric.Gauge().DataPoints().At(0).DoubleValue() == 3
+				}
+			}
+		}
+		return false
+	}, 10*time.Second, 100*time.Millisecond, "custom resource metrics not collected")
+	require.NoError(t, kr.Shutdown(ctx))
+}
+
 func TestReceiverTimesOutAfterStartup(t *testing.T) {
 	tt, err := obsreporttest.SetupTelemetry(component.NewID(metadata.Type))
 	require.NoError(t, err)
@@ -26,6 +26,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -55,6 +57,7 @@ type sharedInformer interface {
 type resourceWatcher struct {
 	client              kubernetes.Interface
 	osQuotaClient       quotaclientset.Interface
+	dynamicClient       dynamic.Interface
 	informerFactories   []sharedInformer
 	metadataStore       *metadata.Store
 	logger              *zap.Logger
@@ -69,6 +72,7 @@ type resourceWatcher struct {
 	// For mocking.
 	makeClient               func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error)
 	makeOpenShiftQuotaClient func(apiConf k8sconfig.APIConfig) (quotaclientset.Interface, error)
+	makeDynamicClient        func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error)
 }
 
 type metadataConsumer func(metadata []*experimentalmetricmetadata.MetadataUpdate) error
@@ -94,6 +98,7 @@ func newResourceWatcher(set receiver.CreateSettings, cfg *Config, metadataStore
 		config:                   cfg,
 		makeClient:               k8sconfig.MakeClient,
 		makeOpenShiftQuotaClient: k8sconfig.MakeOpenShiftQuotaClient,
+		makeDynamicClient:        k8sconfig.MakeDynamicClient,
 	}
 }
 
@@ -111,6 +116,13 @@ func (rw *resourceWatcher) initialize() error {
 		}
 	}
 
+	if len(rw.config.CustomResources) > 0 {
+		rw.dynamicClient, err = rw.makeDynamicClient(rw.config.APIConfig)
+		if err != nil {
+			return fmt.Errorf("Failed to create Kubernetes dynamic client: %w", err)
+		}
+	}
+
 	err = rw.prepareSharedInformerFactory()
 	if err != nil {
 		return err
@@ -167,9 +179,63 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 	}
 	rw.informerFactories = append(rw.informerFactories, factory)
 
+	if rw.dynamicClient != nil {
+		if err := rw.setupCustomResourceInformers(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// setupCustomResourceInformers sets up the informers of the custom resources served by the
+// cluster, a warning message being thrown for the other ones.
+func (rw *resourceWatcher) setupCustomResourceInformers() error {
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(rw.dynamicClient, rw.config.MetadataCollectionInterval)
+	for _, cr := range rw.config.CustomResources {
+		gvr := cr.GroupVersionResource()
+		supported, err := rw.isResourceSupported(gvr)
+		if err != nil {
+			return err
+		}
+		if !supported {
+			rw.logger.Warn("Server doesn't support the custom resource", zap.String("resource", gvr.String()))
+			continue
+		}
+		rw.metadataStore.SetupCustomResource(gvr, factory.ForResource(gvr).Informer().GetStore())
+	}
+	rw.informerFactories = append(rw.informerFactories, dynamicSharedInformer{factory})
+	return nil
+}
+
+// dynamicSharedInformer adapts the dynamic informer factory to the sharedInformer interface.
+type dynamicSharedInformer struct {
+	dynamicinformer.DynamicSharedInformerFactory
+}
+
+func (f dynamicSharedInformer) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
+	f.DynamicSharedInformerFactory.WaitForCacheSync(stopCh)
+	return nil
+}
+
+func (rw *resourceWatcher) isResourceSupported(gvr schema.GroupVersionResource) (bool, error) {
+	resources, err := rw.client.Discovery().ServerResourcesForGroupVersion(gvr.GroupVersion().String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			rw.logger.Debug("Group version is not supported", zap.String("group", gvr.GroupVersion().String()))
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to fetch group version details: %w", err)
+	}
+
+	for _, r := range resources.APIResources {
+		if r.Name == gvr.Resource {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (rw *resourceWatcher) isKindSupported(gvk schema.GroupVersionKind) (bool, error) {
 	resources, err := rw.client.Discovery().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
 	if err != nil {
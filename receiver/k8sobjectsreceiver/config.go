@@ -40,7 +40,10 @@ type K8sObjectsConfig struct {
 	FieldSelector   string        `mapstructure:"field_selector"`
 	Interval        time.Duration `mapstructure:"interval"`
 	ResourceVersion string        `mapstructure:"resource_version"`
-	gvr             *schema.GroupVersionResource
+	// ExcludeFields is a list of dot-separated field paths (e.g. metadata.managedFields)
+	// removed from each object before it is converted to a log record.
+	ExcludeFields []string `mapstructure:"exclude_fields"`
+	gvr           *schema.GroupVersionResource
 }
 
 type Config struct {
@@ -87,6 +90,14 @@ func (c *Config) Validate() error {
 			object.Interval = defaultPullInterval
 		}
 
+		for _, field := range object.ExcludeFields {
+			for _, segment := range strings.Split(field, ".") {
+				if segment == "" {
+					return fmt.Errorf("invalid exclude_fields entry %q for resource %v", field, object.Name)
+				}
+			}
+		}
+
 		object.gvr = gvr
 	}
 	return nil
@@ -56,6 +56,7 @@ func TestLoadConfig(t *testing.T) {
 			Namespaces:      []string{"default"},
 			Group:           "events.k8s.io",
 			ResourceVersion: "",
+			ExcludeFields:   []string{"metadata.managedFields", "status"},
 			gvr: &schema.GroupVersionResource{
 				Group:    "events.k8s.io",
 				Version:  "v1",
@@ -85,6 +86,15 @@ func TestValidConfigs(t *testing.T) {
 	err = component.ValidateConfig(cfg)
 	assert.ErrorContains(t, err, "resource fake_resource not found")
 
+	cfg = factory.CreateDefaultConfig().(*Config)
+	sub, err = cm.Sub("k8sobjects/invalid_exclude_fields")
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	cfg.makeDiscoveryClient = getMockDiscoveryClient
+
+	err = component.ValidateConfig(cfg)
+	assert.ErrorContains(t, err, `invalid exclude_fields entry "metadata..managedFields" for resource pods`)
 }
 
 func TestValidateResourceConflict(t *testing.T) {
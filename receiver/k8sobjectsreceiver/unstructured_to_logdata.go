@@ -5,6 +5,7 @@ package k8sobjectsreceiver // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -21,6 +22,8 @@ func watchObjectsToLogData(event *watch.Event, observedAt time.Time, config *K8s
 	if !ok {
 		return plog.Logs{}, fmt.Errorf("received data that wasnt unstructure, %v", event)
 	}
+	name := udata.GetName()
+	removeExcludedFields(udata.Object, config)
 
 	ul := unstructured.UnstructuredList{
 		Items: []unstructured.Unstructured{{
@@ -32,8 +35,6 @@ func watchObjectsToLogData(event *watch.Event, observedAt time.Time, config *K8s
 	}
 
 	return unstructuredListToLogData(&ul, observedAt, config, func(attrs pcommon.Map) {
-		objectMeta := udata.Object["metadata"].(map[string]interface{})
-		name := objectMeta["name"].(string)
 		if name != "" {
 			attrs.PutStr("event.domain", "k8s")
 			attrs.PutStr("event.name", name)
@@ -42,9 +43,19 @@ func watchObjectsToLogData(event *watch.Event, observedAt time.Time, config *K8s
 }
 
 func pullObjectsToLogData(event *unstructured.UnstructuredList, observedAt time.Time, config *K8sObjectsConfig) plog.Logs {
+	for i := range event.Items {
+		removeExcludedFields(event.Items[i].Object, config)
+	}
 	return unstructuredListToLogData(event, observedAt, config)
 }
 
+// removeExcludedFields drops the sub-trees configured in exclude_fields from the object.
+func removeExcludedFields(object map[string]interface{}, config *K8sObjectsConfig) {
+	for _, field := range config.ExcludeFields {
+		unstructured.RemoveNestedField(object, strings.Split(field, ".")...)
+	}
+}
+
 func unstructuredListToLogData(event *unstructured.UnstructuredList, observedAt time.Time, config *K8sObjectsConfig, attrUpdaters ...attrUpdaterFunc) plog.Logs {
 	out := plog.NewLogs()
 	resourceLogs := out.ResourceLogs()
@@ -169,4 +169,80 @@ func TestUnstructuredListToLogData(t *testing.T) {
 		assert.Equal(t, logRecords.At(0).ObservedTimestamp().AsTime().Unix(), observedAt.Unix())
 	})
 
+	t.Run("Test excluded fields are removed from pulled objects", func(t *testing.T) {
+		objects := unstructured.UnstructuredList{
+			Items: []unstructured.Unstructured{{
+				Object: map[string]interface{}{
+					"kind":       "Pod",
+					"apiVersion": "v1",
+					"metadata": map[string]interface{}{
+						"name":          "pod-0",
+						"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+					},
+					"spec":   map[string]interface{}{"nodeName": "node-0"},
+					"status": map[string]interface{}{"phase": "Running"},
+				},
+			}},
+		}
+
+		config := &K8sObjectsConfig{
+			ExcludeFields: []string{"metadata.managedFields", "status"},
+			gvr: &schema.GroupVersionResource{
+				Group:    "",
+				Version:  "v1",
+				Resource: "pods",
+			},
+		}
+
+		logs := pullObjectsToLogData(&objects, time.Now(), config)
+		assert.Equal(t, logs.LogRecordCount(), 1)
+
+		body := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Map().AsRaw()
+		assert.Equal(t, map[string]interface{}{
+			"kind":       "Pod",
+			"apiVersion": "v1",
+			"metadata": map[string]interface{}{
+				"name": "pod-0",
+			},
+			"spec": map[string]interface{}{"nodeName": "node-0"},
+		}, body)
+	})
+
+	t.Run("Test excluded fields are removed from watched objects", func(t *testing.T) {
+		config := &K8sObjectsConfig{
+			ExcludeFields: []string{"metadata"},
+			gvr: &schema.GroupVersionResource{
+				Group:    "",
+				Version:  "v1",
+				Resource: "events",
+			},
+		}
+		event := &watch.Event{
+			Type: watch.Added,
+			Object: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind":       "Event",
+					"apiVersion": "v1",
+					"metadata": map[string]interface{}{
+						"name": "generic-name",
+					},
+				},
+			},
+		}
+
+		logs, err := watchObjectsToLogData(event, time.Now(), config)
+		assert.NoError(t, err)
+		assert.Equal(t, logs.LogRecordCount(), 1)
+
+		logRecord := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+		eventName, ok := logRecord.Attributes().Get("event.name")
+		require.True(t, ok)
+		assert.EqualValues(t, "generic-name", eventName.AsRaw())
+
+		object, ok := logRecord.Body().Map().Get("object")
+		require.True(t, ok)
+		_, ok = object.Map().Get("metadata")
+		assert.False(t, ok)
+	})
+
 }
@@ -29,6 +29,8 @@ type consumerScraper struct {
 	saramaConfig *sarama.Config
 	config       Config
 	mb           *metadata.MetricsBuilder
+	// offsetHistory is only set when the lag time of the consumer groups is enabled
+	offsetHistory *offsetHistory
 }
 
 func (s *consumerScraper) Name() string {
@@ -88,6 +90,7 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 		}
 	}
 	var scrapeError error
+	scrapeTime := time.Now()
 	// partitionIds in matchedTopics
 	topicPartitions := map[string][]int32{}
 	// currentOffset for each partition in matchedTopics
@@ -108,14 +111,20 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 			}
 			topicPartitions[topic] = append(topicPartitions[topic], p)
 			topicPartitionOffset[topic][p] = offset
+			if s.offsetHistory != nil {
+				s.offsetHistory.record(topic, p, offset, scrapeTime)
+			}
 		}
 	}
+	if s.offsetHistory != nil {
+		s.offsetHistory.retain(topicPartitionOffset)
+	}
 	consumerGroups, listErr := s.clusterAdmin.DescribeConsumerGroups(matchedGrpIds)
 	if listErr != nil {
 		return pmetric.Metrics{}, listErr
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	now := pcommon.NewTimestampFromTime(scrapeTime)
 
 	for _, group := range consumerGroups {
 		s.mb.RecordKafkaConsumerGroupMembersDataPoint(now, int64(len(group.Members)), group.GroupId)
@@ -154,6 +163,11 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 						}
 					}
 					s.mb.RecordKafkaConsumerGroupLagDataPoint(now, consumerLag, group.GroupId, topic, int64(partition))
+					if s.offsetHistory != nil && consumerLag != -1 {
+						if lagTime, ok := s.offsetHistory.lagTime(topic, partition, consumerOffset, scrapeTime); ok {
+							s.mb.RecordKafkaConsumerGroupLagTimeDataPoint(now, lagTime.Seconds(), group.GroupId, topic, int64(partition))
+						}
+					}
 				}
 				s.mb.RecordKafkaConsumerGroupOffsetSumDataPoint(now, offsetSum, group.GroupId, topic)
 				s.mb.RecordKafkaConsumerGroupLagSumDataPoint(now, lagSum, group.GroupId, topic)
@@ -181,6 +195,9 @@ func createConsumerScraper(_ context.Context, cfg Config, saramaConfig *sarama.C
 		config:       cfg,
 		saramaConfig: saramaConfig,
 	}
+	if cfg.MetricsBuilderConfig.Metrics.KafkaConsumerGroupLagTime.Enabled {
+		s.offsetHistory = newOffsetHistory(maxOffsetSamples)
+	}
 	return scraperhelper.NewScraper(
 		s.Name(),
 		s.scrape,
@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -146,6 +148,49 @@ func TestConsumerScraper_scrape(t *testing.T) {
 	assert.NotNil(t, md)
 }
 
+func TestConsumerScraper_scrapesLagTime(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.Metrics.KafkaConsumerGroupLagTime.Enabled = true
+	client := newMockClient()
+	filter := regexp.MustCompile(defaultGroupMatch)
+	cs := consumerScraper{
+		client:        client,
+		settings:      receivertest.NewNopCreateSettings(),
+		clusterAdmin:  newMockClusterAdmin(),
+		topicFilter:   filter,
+		groupFilter:   filter,
+		config:        *config,
+		offsetHistory: newOffsetHistory(maxOffsetSamples),
+	}
+	require.NoError(t, cs.start(context.Background(), componenttest.NewNopHost()))
+
+	lagTime := func(md pmetric.Metrics) (float64, bool) {
+		ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for i := 0; i < ms.Len(); i++ {
+			if ms.At(i).Name() == "kafka.consumer_group.lag_time" {
+				return ms.At(i).Gauge().DataPoints().At(0).DoubleValue(), true
+			}
+		}
+		return 0, false
+	}
+
+	// the consumer group is at the current offset of the partition
+	md, err := cs.scrape(context.Background())
+	require.NoError(t, err)
+	lag, ok := lagTime(md)
+	require.True(t, ok)
+	assert.Equal(t, 0.0, lag)
+
+	// messages are produced but not consumed
+	time.Sleep(10 * time.Millisecond)
+	client.offset = 11
+	md, err = cs.scrape(context.Background())
+	require.NoError(t, err)
+	lag, ok = lagTime(md)
+	require.True(t, ok)
+	assert.Greater(t, lag, 0.0)
+}
+
 func TestConsumerScraper_scrape_handlesListTopicError(t *testing.T) {
 	filter := regexp.MustCompile(defaultGroupMatch)
 	clusterAdmin := newMockClusterAdmin()
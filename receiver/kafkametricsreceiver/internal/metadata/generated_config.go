@@ -28,6 +28,7 @@ type MetricsConfig struct {
 	KafkaBrokers                 MetricConfig `mapstructure:"kafka.brokers"`
 	KafkaConsumerGroupLag        MetricConfig `mapstructure:"kafka.consumer_group.lag"`
 	KafkaConsumerGroupLagSum     MetricConfig `mapstructure:"kafka.consumer_group.lag_sum"`
+	KafkaConsumerGroupLagTime    MetricConfig `mapstructure:"kafka.consumer_group.lag_time"`
 	KafkaConsumerGroupMembers    MetricConfig `mapstructure:"kafka.consumer_group.members"`
 	KafkaConsumerGroupOffset     MetricConfig `mapstructure:"kafka.consumer_group.offset"`
 	KafkaConsumerGroupOffsetSum  MetricConfig `mapstructure:"kafka.consumer_group.offset_sum"`
@@ -35,7 +36,11 @@ type MetricsConfig struct {
 	KafkaPartitionOldestOffset   MetricConfig `mapstructure:"kafka.partition.oldest_offset"`
 	KafkaPartitionReplicas       MetricConfig `mapstructure:"kafka.partition.replicas"`
 	KafkaPartitionReplicasInSync MetricConfig `mapstructure:"kafka.partition.replicas_in_sync"`
+	KafkaTopicLogRetentionPeriod MetricConfig `mapstructure:"kafka.topic.log_retention_period"`
+	KafkaTopicLogRetentionSize   MetricConfig `mapstructure:"kafka.topic.log_retention_size"`
+	KafkaTopicMinInsyncReplicas  MetricConfig `mapstructure:"kafka.topic.min_insync_replicas"`
 	KafkaTopicPartitions         MetricConfig `mapstructure:"kafka.topic.partitions"`
+	KafkaTopicReplicationFactor  MetricConfig `mapstructure:"kafka.topic.replication_factor"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -49,6 +54,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		KafkaConsumerGroupLagSum: MetricConfig{
 			Enabled: true,
 		},
+		KafkaConsumerGroupLagTime: MetricConfig{
+			Enabled: false,
+		},
 		KafkaConsumerGroupMembers: MetricConfig{
 			Enabled: true,
 		},
@@ -70,9 +78,21 @@ func DefaultMetricsConfig() MetricsConfig {
 		KafkaPartitionReplicasInSync: MetricConfig{
 			Enabled: true,
 		},
+		KafkaTopicLogRetentionPeriod: MetricConfig{
+			Enabled: false,
+		},
+		KafkaTopicLogRetentionSize: MetricConfig{
+			Enabled: false,
+		},
+		KafkaTopicMinInsyncReplicas: MetricConfig{
+			Enabled: false,
+		},
 		KafkaTopicPartitions: MetricConfig{
 			Enabled: true,
 		},
+		KafkaTopicReplicationFactor: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -29,6 +29,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaBrokers:                 MetricConfig{Enabled: true},
 					KafkaConsumerGroupLag:        MetricConfig{Enabled: true},
 					KafkaConsumerGroupLagSum:     MetricConfig{Enabled: true},
+					KafkaConsumerGroupLagTime:    MetricConfig{Enabled: true},
 					KafkaConsumerGroupMembers:    MetricConfig{Enabled: true},
 					KafkaConsumerGroupOffset:     MetricConfig{Enabled: true},
 					KafkaConsumerGroupOffsetSum:  MetricConfig{Enabled: true},
@@ -36,7 +37,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaPartitionOldestOffset:   MetricConfig{Enabled: true},
 					KafkaPartitionReplicas:       MetricConfig{Enabled: true},
 					KafkaPartitionReplicasInSync: MetricConfig{Enabled: true},
+					KafkaTopicLogRetentionPeriod: MetricConfig{Enabled: true},
+					KafkaTopicLogRetentionSize:   MetricConfig{Enabled: true},
+					KafkaTopicMinInsyncReplicas:  MetricConfig{Enabled: true},
 					KafkaTopicPartitions:         MetricConfig{Enabled: true},
+					KafkaTopicReplicationFactor:  MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -47,6 +52,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaBrokers:                 MetricConfig{Enabled: false},
 					KafkaConsumerGroupLag:        MetricConfig{Enabled: false},
 					KafkaConsumerGroupLagSum:     MetricConfig{Enabled: false},
+					KafkaConsumerGroupLagTime:    MetricConfig{Enabled: false},
 					KafkaConsumerGroupMembers:    MetricConfig{Enabled: false},
 					KafkaConsumerGroupOffset:     MetricConfig{Enabled: false},
 					KafkaConsumerGroupOffsetSum:  MetricConfig{Enabled: false},
@@ -54,7 +60,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaPartitionOldestOffset:   MetricConfig{Enabled: false},
 					KafkaPartitionReplicas:       MetricConfig{Enabled: false},
 					KafkaPartitionReplicasInSync: MetricConfig{Enabled: false},
+					KafkaTopicLogRetentionPeriod: MetricConfig{Enabled: false},
+					KafkaTopicLogRetentionSize:   MetricConfig{Enabled: false},
+					KafkaTopicMinInsyncReplicas:  MetricConfig{Enabled: false},
 					KafkaTopicPartitions:         MetricConfig{Enabled: false},
+					KafkaTopicReplicationFactor:  MetricConfig{Enabled: false},
 				},
 			},
 		},
@@ -66,6 +66,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordKafkaConsumerGroupLagSumDataPoint(ts, 1, "group-val", "topic-val")
 
+			allMetricsCount++
+			mb.RecordKafkaConsumerGroupLagTimeDataPoint(ts, 1, "group-val", "topic-val", 9)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordKafkaConsumerGroupMembersDataPoint(ts, 1, "group-val")
@@ -94,10 +97,22 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordKafkaPartitionReplicasInSyncDataPoint(ts, 1, "topic-val", 9)
 
+			allMetricsCount++
+			mb.RecordKafkaTopicLogRetentionPeriodDataPoint(ts, 1, "topic-val")
+
+			allMetricsCount++
+			mb.RecordKafkaTopicLogRetentionSizeDataPoint(ts, 1, "topic-val")
+
+			allMetricsCount++
+			mb.RecordKafkaTopicMinInsyncReplicasDataPoint(ts, 1, "topic-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordKafkaTopicPartitionsDataPoint(ts, 1, "topic-val")
 
+			allMetricsCount++
+			mb.RecordKafkaTopicReplicationFactorDataPoint(ts, 1, "topic-val")
+
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
 
@@ -173,6 +188,27 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("topic")
 					assert.True(t, ok)
 					assert.EqualValues(t, "topic-val", attrVal.Str())
+				case "kafka.consumer_group.lag_time":
+					assert.False(t, validatedMetrics["kafka.consumer_group.lag_time"], "Found a duplicate in the metrics slice: kafka.consumer_group.lag_time")
+					validatedMetrics["kafka.consumer_group.lag_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Estimated time since the message at the offset of the consumer group at partition of topic was produced, from the times the current offsets of the partition were observed", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("group")
+					assert.True(t, ok)
+					assert.EqualValues(t, "group-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("topic")
+					assert.True(t, ok)
+					assert.EqualValues(t, "topic-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("partition")
+					assert.True(t, ok)
+					assert.EqualValues(t, 9, attrVal.Int())
 				case "kafka.consumer_group.members":
 					assert.False(t, validatedMetrics["kafka.consumer_group.members"], "Found a duplicate in the metrics slice: kafka.consumer_group.members")
 					validatedMetrics["kafka.consumer_group.members"] = true
@@ -305,6 +341,51 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("partition")
 					assert.True(t, ok)
 					assert.EqualValues(t, 9, attrVal.Int())
+				case "kafka.topic.log_retention_period":
+					assert.False(t, validatedMetrics["kafka.topic.log_retention_period"], "Found a duplicate in the metrics slice: kafka.topic.log_retention_period")
+					validatedMetrics["kafka.topic.log_retention_period"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The log retention period of the topic, from its retention.ms configuration. The value is -1 when the period is not limited", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("topic")
+					assert.True(t, ok)
+					assert.EqualValues(t, "topic-val", attrVal.Str())
+				case "kafka.topic.log_retention_size":
+					assert.False(t, validatedMetrics["kafka.topic.log_retention_size"], "Found a duplicate in the metrics slice: kafka.topic.log_retention_size")
+					validatedMetrics["kafka.topic.log_retention_size"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The log retention size of the topic, from its retention.bytes configuration. The value is -1 when the size is not limited", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("topic")
+					assert.True(t, ok)
+					assert.EqualValues(t, "topic-val", attrVal.Str())
+				case "kafka.topic.min_insync_replicas":
+					assert.False(t, validatedMetrics["kafka.topic.min_insync_replicas"], "Found a duplicate in the metrics slice: kafka.topic.min_insync_replicas")
+					validatedMetrics["kafka.topic.min_insync_replicas"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The minimum number of in-sync replicas of the topic, from its min.insync.replicas configuration", ms.At(i).Description())
+					assert.Equal(t, "{replicas}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("topic")
+					assert.True(t, ok)
+					assert.EqualValues(t, "topic-val", attrVal.Str())
 				case "kafka.topic.partitions":
 					assert.False(t, validatedMetrics["kafka.topic.partitions"], "Found a duplicate in the metrics slice: kafka.topic.partitions")
 					validatedMetrics["kafka.topic.partitions"] = true
@@ -322,6 +403,21 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("topic")
 					assert.True(t, ok)
 					assert.EqualValues(t, "topic-val", attrVal.Str())
+				case "kafka.topic.replication_factor":
+					assert.False(t, validatedMetrics["kafka.topic.replication_factor"], "Found a duplicate in the metrics slice: kafka.topic.replication_factor")
+					validatedMetrics["kafka.topic.replication_factor"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The replication factor of the topic", ms.At(i).Description())
+					assert.Equal(t, "{replicas}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("topic")
+					assert.True(t, ok)
+					assert.EqualValues(t, "topic-val", attrVal.Str())
 				}
 			}
 		})
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkametricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver"
+
+import (
+	"sort"
+	"time"
+)
+
+// maxOffsetSamples is the number of samples kept per partition, the lag time
+// of the consumers lagging behind the oldest sample is only a lower bound.
+const maxOffsetSamples = 120
+
+// offsetSample is the newest offset of a partition and the time it was first observed.
+type offsetSample struct {
+	offset int64
+	time   time.Time
+}
+
+type topicPartition struct {
+	topic     string
+	partition int32
+}
+
+// offsetHistory keeps samples of the newest offsets of the partitions, to estimate
+// when the messages at the offsets of the consumer groups were produced.
+type offsetHistory struct {
+	maxSamples int
+	samples    map[topicPartition][]offsetSample
+}
+
+func newOffsetHistory(maxSamples int) *offsetHistory {
+	return &offsetHistory{
+		maxSamples: maxSamples,
+		samples:    map[topicPartition][]offsetSample{},
+	}
+}
+
+// record adds the newest offset of the partition observed at the given time.
+// The offset is ignored if it did not increase since the last sample, so that
+// the samples keep the time the offsets were first observed.
+func (h *offsetHistory) record(topic string, partition int32, offset int64, t time.Time) {
+	key := topicPartition{topic: topic, partition: partition}
+	samples := h.samples[key]
+	if n := len(samples); n > 0 {
+		switch last := samples[n-1].offset; {
+		case offset == last:
+			return
+		case offset < last:
+			// the topic was recreated, previous samples are meaningless
+			samples = samples[:0]
+		}
+	}
+	samples = append(samples, offsetSample{offset: offset, time: t})
+	if len(samples) > h.maxSamples {
+		samples = samples[len(samples)-h.maxSamples:]
+	}
+	h.samples[key] = samples
+}
+
+// retain removes the samples of the partitions which are not in the given partition offsets.
+func (h *offsetHistory) retain(topicPartitionOffset map[string]map[int32]int64) {
+	for key := range h.samples {
+		if _, ok := topicPartitionOffset[key.topic][key.partition]; !ok {
+			delete(h.samples, key)
+		}
+	}
+}
+
+// lagTime estimates the time elapsed since the message at the consumer offset was produced,
+// by interpolating the times the surrounding offsets were observed. It returns false if the
+// time cannot be estimated because the partition was not observed long enough.
+func (h *offsetHistory) lagTime(topic string, partition int32, consumerOffset int64, now time.Time) (time.Duration, bool) {
+	samples := h.samples[topicPartition{topic: topic, partition: partition}]
+	n := len(samples)
+	if n == 0 {
+		return 0, false
+	}
+	if consumerOffset >= samples[n-1].offset {
+		return 0, true
+	}
+
+	// index of the first sample with an offset greater than the consumer offset
+	i := sort.Search(n, func(i int) bool { return samples[i].offset > consumerOffset })
+	if i == 0 {
+		if n == 1 {
+			return 0, false
+		}
+		// the consumer lags behind the oldest sample, only a lower bound is known
+		return now.Sub(samples[0].time), true
+	}
+	prev, next := samples[i-1], samples[i]
+	ratio := float64(consumerOffset-prev.offset) / float64(next.offset-prev.offset)
+	produced := prev.time.Add(time.Duration(ratio * float64(next.time.Sub(prev.time))))
+	return now.Sub(produced), true
+}
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkametricsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffsetHistory_record(t *testing.T) {
+	start := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+	h := newOffsetHistory(3)
+	h.record(testTopic, testPartition, 10, start)
+	// unchanged offsets keep the time they were first observed
+	h.record(testTopic, testPartition, 10, start.Add(time.Minute))
+	h.record(testTopic, testPartition, 20, start.Add(2*time.Minute))
+	h.record(testTopic, testPartition, 30, start.Add(3*time.Minute))
+	h.record(testTopic, testPartition, 40, start.Add(4*time.Minute))
+
+	key := topicPartition{topic: testTopic, partition: testPartition}
+	assert.Equal(t, []offsetSample{
+		{offset: 20, time: start.Add(2 * time.Minute)},
+		{offset: 30, time: start.Add(3 * time.Minute)},
+		{offset: 40, time: start.Add(4 * time.Minute)},
+	}, h.samples[key])
+
+	// a decreasing offset resets the samples of the partition
+	h.record(testTopic, testPartition, 5, start.Add(5*time.Minute))
+	assert.Equal(t, []offsetSample{{offset: 5, time: start.Add(5 * time.Minute)}}, h.samples[key])
+
+	h.record("other_topic", 0, 5, start)
+	h.retain(map[string]map[int32]int64{testTopic: {testPartition: 5}})
+	assert.Len(t, h.samples, 1)
+	assert.Contains(t, h.samples, key)
+}
+
+func TestOffsetHistory_lagTime(t *testing.T) {
+	start := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+	now := start.Add(10 * time.Minute)
+	h := newOffsetHistory(maxOffsetSamples)
+
+	_, ok := h.lagTime(testTopic, testPartition, 10, now)
+	assert.False(t, ok, "unknown partition")
+
+	h.record(testTopic, testPartition, 100, start)
+	_, ok = h.lagTime(testTopic, testPartition, 10, now)
+	assert.False(t, ok, "single sample")
+
+	h.record(testTopic, testPartition, 200, start.Add(time.Minute))
+	h.record(testTopic, testPartition, 1200, start.Add(2*time.Minute))
+
+	testCases := []struct {
+		desc           string
+		consumerOffset int64
+		expected       time.Duration
+	}{
+		{
+			desc:           "caught up",
+			consumerOffset: 1200,
+			expected:       0,
+		},
+		{
+			desc:           "between samples",
+			consumerOffset: 700,
+			expected:       8*time.Minute + 30*time.Second,
+		},
+		{
+			desc:           "at sample",
+			consumerOffset: 200,
+			expected:       9 * time.Minute,
+		},
+		{
+			desc:           "behind oldest sample",
+			consumerOffset: 50,
+			expected:       10 * time.Minute,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			lag, ok := h.lagTime(testTopic, testPartition, tc.consumerOffset, now)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, lag)
+		})
+	}
+}
@@ -123,6 +123,18 @@ type mockClusterAdmin struct {
 	consumerGroups            map[string]string
 	consumerGroupDescriptions []*sarama.GroupDescription
 	consumerGroupOffsets      *sarama.OffsetFetchResponse
+	topicConfigs              []sarama.ConfigEntry
+}
+
+func (s *mockClusterAdmin) Close() error {
+	return nil
+}
+
+func (s *mockClusterAdmin) DescribeConfig(sarama.ConfigResource) ([]sarama.ConfigEntry, error) {
+	if s.topicConfigs == nil {
+		return nil, fmt.Errorf("error describing config")
+	}
+	return s.topicConfigs, nil
 }
 
 func (s *mockClusterAdmin) ListTopics() (map[string]sarama.TopicDetail, error) {
@@ -188,5 +200,11 @@ func newMockClusterAdmin() *mockClusterAdmin {
 	}
 	clusterAdmin.consumerGroupOffsets = &offsetRes
 
+	clusterAdmin.topicConfigs = []sarama.ConfigEntry{
+		{Name: minInsyncReplicasConfig, Value: "2"},
+		{Name: retentionMsConfig, Value: "604800000"},
+		{Name: retentionBytesConfig, Value: "-1"},
+	}
+
 	return clusterAdmin
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/IBM/sarama"
@@ -16,13 +17,23 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver/internal/metadata"
 )
 
+// Names of the topic configurations recorded as metrics
+const (
+	minInsyncReplicasConfig = "min.insync.replicas"
+	retentionMsConfig       = "retention.ms"
+	retentionBytesConfig    = "retention.bytes"
+)
+
 type topicScraper struct {
-	client       sarama.Client
+	client sarama.Client
+	// clusterAdmin is only used to describe the configuration of the topics
+	clusterAdmin sarama.ClusterAdmin
 	settings     receiver.CreateSettings
 	topicFilter  *regexp.Regexp
 	saramaConfig *sarama.Config
@@ -35,10 +46,14 @@ func (s *topicScraper) Name() string {
 }
 
 func (s *topicScraper) shutdown(context.Context) error {
+	var err error
+	if s.clusterAdmin != nil {
+		err = s.clusterAdmin.Close()
+	}
 	if s.client != nil && !s.client.Closed() {
-		return s.client.Close()
+		err = multierr.Append(err, s.client.Close())
 	}
-	return nil
+	return err
 }
 
 func (s *topicScraper) start(_ context.Context, _ component.Host) error {
@@ -54,6 +69,13 @@ func (s *topicScraper) scrape(context.Context) (pmetric.Metrics, error) {
 		}
 		s.client = client
 	}
+	if s.clusterAdmin == nil && s.topicConfigEnabled() {
+		clusterAdmin, err := newClusterAdmin(s.config.Brokers, s.saramaConfig)
+		if err != nil {
+			return pmetric.Metrics{}, fmt.Errorf("failed to create cluster admin in topics scraper: %w", err)
+		}
+		s.clusterAdmin = clusterAdmin
+	}
 
 	topics, err := s.client.Topics()
 	if err != nil {
@@ -76,6 +98,7 @@ func (s *topicScraper) scrape(context.Context) (pmetric.Metrics, error) {
 		}
 
 		s.mb.RecordKafkaTopicPartitionsDataPoint(now, int64(len(partitions)), topic)
+		var replicationFactor int
 		for _, partition := range partitions {
 			currentOffset, err := s.client.GetOffset(topic, partition, sarama.OffsetNewest)
 			if err != nil {
@@ -94,6 +117,9 @@ func (s *topicScraper) scrape(context.Context) (pmetric.Metrics, error) {
 				scrapeErrors.AddPartial(1, err)
 			} else {
 				s.mb.RecordKafkaPartitionReplicasDataPoint(now, int64(len(replicas)), topic, int64(partition))
+				if len(replicas) > replicationFactor {
+					replicationFactor = len(replicas)
+				}
 			}
 			replicasInSync, err := s.client.InSyncReplicas(topic, partition)
 			if err != nil {
@@ -102,10 +128,60 @@ func (s *topicScraper) scrape(context.Context) (pmetric.Metrics, error) {
 				s.mb.RecordKafkaPartitionReplicasInSyncDataPoint(now, int64(len(replicasInSync)), topic, int64(partition))
 			}
 		}
+		if replicationFactor > 0 {
+			s.mb.RecordKafkaTopicReplicationFactorDataPoint(now, int64(replicationFactor), topic)
+		}
+		if s.clusterAdmin != nil {
+			if err := s.scrapeTopicConfig(now, topic); err != nil {
+				scrapeErrors.AddPartial(1, err)
+			}
+		}
 	}
 	return s.mb.Emit(), scrapeErrors.Combine()
 }
 
+// topicConfigEnabled returns whether any of the metrics from the configuration of the topics is enabled.
+func (s *topicScraper) topicConfigEnabled() bool {
+	metrics := s.config.MetricsBuilderConfig.Metrics
+	return metrics.KafkaTopicMinInsyncReplicas.Enabled ||
+		metrics.KafkaTopicLogRetentionPeriod.Enabled ||
+		metrics.KafkaTopicLogRetentionSize.Enabled
+}
+
+// scrapeTopicConfig records the metrics from the effective configuration of the topic,
+// which includes the values inherited from the broker configuration.
+func (s *topicScraper) scrapeTopicConfig(now pcommon.Timestamp, topic string) error {
+	entries, err := s.clusterAdmin.DescribeConfig(sarama.ConfigResource{
+		Type:        sarama.TopicResource,
+		Name:        topic,
+		ConfigNames: []string{minInsyncReplicasConfig, retentionMsConfig, retentionBytesConfig},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe the configuration of topic %s: %w", topic, err)
+	}
+
+	var errs error
+	for _, entry := range entries {
+		value, err := strconv.ParseInt(entry.Value, 10, 64)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid %s configuration of topic %s: %w", entry.Name, topic, err))
+			continue
+		}
+		switch entry.Name {
+		case minInsyncReplicasConfig:
+			s.mb.RecordKafkaTopicMinInsyncReplicasDataPoint(now, value, topic)
+		case retentionMsConfig:
+			if value > 0 {
+				value /= 1000
+			}
+			s.mb.RecordKafkaTopicLogRetentionPeriodDataPoint(now, value, topic)
+		case retentionBytesConfig:
+			s.mb.RecordKafkaTopicLogRetentionSizeDataPoint(now, value, topic)
+		}
+	}
+	return errs
+}
+
 func createTopicsScraper(_ context.Context, cfg Config, saramaConfig *sarama.Config, settings receiver.CreateSettings) (scraperhelper.Scraper, error) {
 	topicFilter, err := regexp.Compile(cfg.TopicMatch)
 	if err != nil {
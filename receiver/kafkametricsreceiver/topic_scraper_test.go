@@ -13,7 +13,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 )
 
 func TestTopicShutdown(t *testing.T) {
@@ -184,3 +186,61 @@ func TestTopicScraper_scrape_handlesPartialScrapeErrors(t *testing.T) {
 	_, err := scraper.scrape(context.Background())
 	assert.Error(t, err)
 }
+
+func TestTopicScraper_scrapesTopicConfig(t *testing.T) {
+	newClusterAdmin = mockNewClusterAdmin
+	client := newMockClient()
+	client.Mock.
+		On("Close").Return(nil).
+		On("Closed").Return(false)
+	config := createDefaultConfig().(*Config)
+	config.Metrics.KafkaTopicReplicationFactor.Enabled = true
+	config.Metrics.KafkaTopicMinInsyncReplicas.Enabled = true
+	config.Metrics.KafkaTopicLogRetentionPeriod.Enabled = true
+	config.Metrics.KafkaTopicLogRetentionSize.Enabled = true
+	match := regexp.MustCompile(config.TopicMatch)
+	scraper := topicScraper{
+		client:      client,
+		settings:    receivertest.NewNopCreateSettings(),
+		config:      *config,
+		topicFilter: match,
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, scraper.clusterAdmin)
+
+	values := map[string]int64{}
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if m := ms.At(i); m.Type() == pmetric.MetricTypeGauge {
+			values[m.Name()] = m.Gauge().DataPoints().At(0).IntValue()
+		}
+	}
+	assert.Equal(t, int64(len(testReplicas)), values["kafka.topic.replication_factor"])
+	assert.Equal(t, int64(2), values["kafka.topic.min_insync_replicas"])
+	assert.Equal(t, int64(604800), values["kafka.topic.log_retention_period"])
+	assert.Equal(t, int64(-1), values["kafka.topic.log_retention_size"])
+	assert.NoError(t, scraper.shutdown(context.Background()))
+	client.AssertExpectations(t)
+}
+
+func TestTopicScraper_scrape_handlesDescribeConfigError(t *testing.T) {
+	clusterAdmin := newMockClusterAdmin()
+	clusterAdmin.topicConfigs = nil
+	config := createDefaultConfig().(*Config)
+	config.Metrics.KafkaTopicMinInsyncReplicas.Enabled = true
+	match := regexp.MustCompile(config.TopicMatch)
+	scraper := topicScraper{
+		client:       newMockClient(),
+		clusterAdmin: clusterAdmin,
+		settings:     receivertest.NewNopCreateSettings(),
+		config:       *config,
+		topicFilter:  match,
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	md, err := scraper.scrape(context.Background())
+	assert.ErrorContains(t, err, "failed to describe the configuration of topic test_topic")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, 1, md.ResourceMetrics().Len())
+}
@@ -4,6 +4,7 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -31,6 +32,23 @@ type MessageMarking struct {
 	OnError bool `mapstructure:"on_error"`
 }
 
+type HeaderExtraction struct {
+	// If true, the headers listed in Headers are copied from each Kafka record
+	// into the attributes of the telemetry it contains.
+	ExtractHeaders bool `mapstructure:"extract_headers"`
+	// Headers is the list of header keys to extract. Each one is added as the
+	// `kafka.header.<key>` attribute.
+	Headers []string `mapstructure:"headers"`
+	// AttachTo is where the headers are added: `resource` (the default) for the resource
+	// attributes, or `record` for the attributes of each span, metric data point or log record.
+	AttachTo string `mapstructure:"attach_to"`
+}
+
+const (
+	headerAttachToResource = "resource"
+	headerAttachToRecord   = "record"
+)
+
 // Config defines configuration for Kafka receiver.
 type Config struct {
 	// The list of kafka brokers (default localhost:9092)
@@ -60,6 +78,9 @@ type Config struct {
 
 	// Controls the way the messages are marked as consumed
 	MessageMarking MessageMarking `mapstructure:"message_marking"`
+
+	// Extract headers from kafka records
+	HeaderExtraction HeaderExtraction `mapstructure:"header_extraction"`
 }
 
 const (
@@ -71,5 +92,11 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
-	return nil
+	switch cfg.HeaderExtraction.AttachTo {
+	case "", headerAttachToResource, headerAttachToRecord:
+		return nil
+	default:
+		return fmt.Errorf("header_extraction: attach_to must be either %q or %q, got %q",
+			headerAttachToResource, headerAttachToRecord, cfg.HeaderExtraction.AttachTo)
+	}
 }
@@ -90,6 +90,11 @@ func TestLoadConfig(t *testing.T) {
 					Enable:   true,
 					Interval: 1 * time.Second,
 				},
+				HeaderExtraction: HeaderExtraction{
+					ExtractHeaders: true,
+					Headers:        []string{"tenant", "source"},
+					AttachTo:       headerAttachToRecord,
+				},
 			},
 		},
 	}
@@ -108,3 +113,9 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHeaderExtraction(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.HeaderExtraction.AttachTo = "scope"
+	assert.EqualError(t, component.ValidateConfig(cfg), `header_extraction: attach_to must be either "resource" or "record", got "scope"`)
+}
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func getAttribute(key string) string {
+	return fmt.Sprintf("kafka.header.%s", key)
+}
+
+// HeaderExtractor copies Kafka record headers into the resource, or record, attributes
+// of the telemetry unmarshaled from the record.
+type HeaderExtractor interface {
+	extractHeadersTraces(ptrace.Traces, *sarama.ConsumerMessage)
+	extractHeadersMetrics(pmetric.Metrics, *sarama.ConsumerMessage)
+	extractHeadersLogs(plog.Logs, *sarama.ConsumerMessage)
+}
+
+type headerExtractor struct {
+	logger  *zap.Logger
+	headers []string
+	// toRecord adds the headers to the attributes of the spans, metric data points
+	// and log records rather than to the resource attributes.
+	toRecord bool
+}
+
+func (he *headerExtractor) extractHeadersTraces(traces ptrace.Traces, message *sarama.ConsumerMessage) {
+	for _, header := range he.headers {
+		value, ok := getHeaderValue(message.Headers, header)
+		if !ok {
+			he.logger.Debug("Header key not found in the trace: ", zap.String("key", header))
+			continue
+		}
+		for i := 0; i < traces.ResourceSpans().Len(); i++ {
+			rs := traces.ResourceSpans().At(i)
+			if !he.toRecord {
+				rs.Resource().Attributes().PutStr(getAttribute(header), value)
+				continue
+			}
+			for j := 0; j < rs.ScopeSpans().Len(); j++ {
+				spans := rs.ScopeSpans().At(j).Spans()
+				for k := 0; k < spans.Len(); k++ {
+					spans.At(k).Attributes().PutStr(getAttribute(header), value)
+				}
+			}
+		}
+	}
+}
+
+func (he *headerExtractor) extractHeadersMetrics(metrics pmetric.Metrics, message *sarama.ConsumerMessage) {
+	for _, header := range he.headers {
+		value, ok := getHeaderValue(message.Headers, header)
+		if !ok {
+			he.logger.Debug("Header key not found in the metric: ", zap.String("key", header))
+			continue
+		}
+		for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+			rm := metrics.ResourceMetrics().At(i)
+			if !he.toRecord {
+				rm.Resource().Attributes().PutStr(getAttribute(header), value)
+				continue
+			}
+			for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+				ms := rm.ScopeMetrics().At(j).Metrics()
+				for k := 0; k < ms.Len(); k++ {
+					forEachDataPointAttributes(ms.At(k), func(attrs pcommon.Map) {
+						attrs.PutStr(getAttribute(header), value)
+					})
+				}
+			}
+		}
+	}
+}
+
+func (he *headerExtractor) extractHeadersLogs(logs plog.Logs, message *sarama.ConsumerMessage) {
+	for _, header := range he.headers {
+		value, ok := getHeaderValue(message.Headers, header)
+		if !ok {
+			he.logger.Debug("Header key not found in the log: ", zap.String("key", header))
+			continue
+		}
+		for i := 0; i < logs.ResourceLogs().Len(); i++ {
+			rl := logs.ResourceLogs().At(i)
+			if !he.toRecord {
+				rl.Resource().Attributes().PutStr(getAttribute(header), value)
+				continue
+			}
+			for j := 0; j < rl.ScopeLogs().Len(); j++ {
+				records := rl.ScopeLogs().At(j).LogRecords()
+				for k := 0; k < records.Len(); k++ {
+					records.At(k).Attributes().PutStr(getAttribute(header), value)
+				}
+			}
+		}
+	}
+}
+
+// forEachDataPointAttributes calls fn with the attributes of each data point of the metric.
+func forEachDataPointAttributes(metric pmetric.Metric, fn func(pcommon.Map)) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	}
+}
+
+func getHeaderValue(headers []*sarama.RecordHeader, header string) (string, bool) {
+	for _, kafkaHeader := range headers {
+		headerKey := string(kafkaHeader.Key)
+		if headerKey == header {
+			// matching header found
+			return string(kafkaHeader.Value), true
+		}
+	}
+	// no header found matching the key, report to the user
+	return "", false
+}
+
+type nopHeaderExtractor struct{}
+
+func (he *nopHeaderExtractor) extractHeadersTraces(_ ptrace.Traces, _ *sarama.ConsumerMessage) {
+}
+
+func (he *nopHeaderExtractor) extractHeadersMetrics(_ pmetric.Metrics, _ *sarama.ConsumerMessage) {
+}
+
+func (he *nopHeaderExtractor) extractHeadersLogs(_ plog.Logs, _ *sarama.ConsumerMessage) {
+}
@@ -0,0 +1,225 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+var testHeaders = []*sarama.RecordHeader{
+	{Key: []byte("key1"), Value: []byte("value1")},
+	{Key: []byte("key2"), Value: []byte("value2")},
+}
+
+func TestHeaderExtractionTraces(t *testing.T) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
+	require.NoError(t, err)
+	nextConsumer := &consumertest.TracesSink{}
+	c := tracesConsumerGroupHandler{
+		unmarshaler:  newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
+		logger:       zap.NewNop(),
+		ready:        make(chan bool),
+		nextConsumer: nextConsumer,
+		obsrecv:      obsrecv,
+		headerExtractor: &headerExtractor{
+			logger:  zap.NewNop(),
+			headers: []string{"key1", "missing"},
+		},
+	}
+	groupClaim := &testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		assert.NoError(t, c.ConsumeClaim(testConsumerGroupSession{ctx: context.Background()}, groupClaim))
+		wg.Done()
+	}()
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty()
+	td.ResourceSpans().AppendEmpty()
+	marshaler := &ptrace.ProtoMarshaler{}
+	bts, err := marshaler.MarshalTraces(td)
+	require.NoError(t, err)
+	groupClaim.messageChan <- &sarama.ConsumerMessage{
+		Headers: testHeaders,
+		Value:   bts,
+	}
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	require.Len(t, nextConsumer.AllTraces(), 1)
+	resourceSpans := nextConsumer.AllTraces()[0].ResourceSpans()
+	require.Equal(t, 2, resourceSpans.Len())
+	for i := 0; i < resourceSpans.Len(); i++ {
+		validateHeader(t, resourceSpans.At(i).Resource(), "kafka.header.key1", "value1")
+		_, ok := resourceSpans.At(i).Resource().Attributes().Get("kafka.header.key2")
+		assert.False(t, ok)
+		_, ok = resourceSpans.At(i).Resource().Attributes().Get("kafka.header.missing")
+		assert.False(t, ok)
+	}
+}
+
+func TestHeaderExtractionMetrics(t *testing.T) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
+	require.NoError(t, err)
+	nextConsumer := &consumertest.MetricsSink{}
+	c := metricsConsumerGroupHandler{
+		unmarshaler:  newPdataMetricsUnmarshaler(&pmetric.ProtoUnmarshaler{}, defaultEncoding),
+		logger:       zap.NewNop(),
+		ready:        make(chan bool),
+		nextConsumer: nextConsumer,
+		obsrecv:      obsrecv,
+		headerExtractor: &headerExtractor{
+			logger:  zap.NewNop(),
+			headers: []string{"key1", "key2"},
+		},
+	}
+	groupClaim := &testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		assert.NoError(t, c.ConsumeClaim(testConsumerGroupSession{ctx: context.Background()}, groupClaim))
+		wg.Done()
+	}()
+
+	md := testdata.GenerateMetricsOneMetric()
+	marshaler := &pmetric.ProtoMarshaler{}
+	bts, err := marshaler.MarshalMetrics(md)
+	require.NoError(t, err)
+	groupClaim.messageChan <- &sarama.ConsumerMessage{
+		Headers: testHeaders,
+		Value:   bts,
+	}
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	require.Len(t, nextConsumer.AllMetrics(), 1)
+	resource := nextConsumer.AllMetrics()[0].ResourceMetrics().At(0).Resource()
+	validateHeader(t, resource, "kafka.header.key1", "value1")
+	validateHeader(t, resource, "kafka.header.key2", "value2")
+}
+
+func TestHeaderExtractionLogs(t *testing.T) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
+	require.NoError(t, err)
+	nextConsumer := &consumertest.LogsSink{}
+	unmarshaler := newTextLogsUnmarshaler()
+	unmarshaler, err = unmarshaler.WithEnc("utf-8")
+	require.NoError(t, err)
+	c := logsConsumerGroupHandler{
+		unmarshaler:  unmarshaler,
+		logger:       zap.NewNop(),
+		ready:        make(chan bool),
+		nextConsumer: nextConsumer,
+		obsrecv:      obsrecv,
+		headerExtractor: &headerExtractor{
+			logger:  zap.NewNop(),
+			headers: []string{"key1", "key2"},
+		},
+	}
+	groupClaim := &testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		assert.NoError(t, c.ConsumeClaim(testConsumerGroupSession{ctx: context.Background()}, groupClaim))
+		wg.Done()
+	}()
+
+	groupClaim.messageChan <- &sarama.ConsumerMessage{
+		Headers: testHeaders,
+		Value:   []byte("hello world"),
+	}
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	require.Len(t, nextConsumer.AllLogs(), 1)
+	resource := nextConsumer.AllLogs()[0].ResourceLogs().At(0).Resource()
+	validateHeader(t, resource, "kafka.header.key1", "value1")
+	validateHeader(t, resource, "kafka.header.key2", "value2")
+}
+
+func TestHeaderExtractionRecord(t *testing.T) {
+	he := &headerExtractor{
+		logger:   zap.NewNop(),
+		headers:  []string{"key1"},
+		toRecord: true,
+	}
+	message := &sarama.ConsumerMessage{Headers: testHeaders}
+
+	traces := testdata.GenerateTracesTwoSpansSameResource()
+	he.extractHeadersTraces(traces, message)
+	rs := traces.ResourceSpans().At(0)
+	_, ok := rs.Resource().Attributes().Get("kafka.header.key1")
+	assert.False(t, ok)
+	spans := rs.ScopeSpans().At(0).Spans()
+	require.Equal(t, 2, spans.Len())
+	for i := 0; i < spans.Len(); i++ {
+		validateAttribute(t, spans.At(i).Attributes(), "kafka.header.key1", "value1")
+	}
+
+	metrics := testdata.GenerateMetricsAllTypesEmptyDataPoint()
+	he.extractHeadersMetrics(metrics, message)
+	rm := metrics.ResourceMetrics().At(0)
+	_, ok = rm.Resource().Attributes().Get("kafka.header.key1")
+	assert.False(t, ok)
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		count := 0
+		forEachDataPointAttributes(ms.At(i), func(attrs pcommon.Map) {
+			validateAttribute(t, attrs, "kafka.header.key1", "value1")
+			count++
+		})
+		assert.NotZero(t, count, ms.At(i).Name())
+	}
+
+	logs := testdata.GenerateLogsTwoLogRecordsSameResource()
+	he.extractHeadersLogs(logs, message)
+	rl := logs.ResourceLogs().At(0)
+	_, ok = rl.Resource().Attributes().Get("kafka.header.key1")
+	assert.False(t, ok)
+	records := rl.ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	for i := 0; i < records.Len(); i++ {
+		validateAttribute(t, records.At(i).Attributes(), "kafka.header.key1", "value1")
+	}
+}
+
+func TestNopHeaderExtractor(t *testing.T) {
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty()
+	(&nopHeaderExtractor{}).extractHeadersLogs(logs, &sarama.ConsumerMessage{Headers: testHeaders})
+	assert.Equal(t, 0, logs.ResourceLogs().At(0).Resource().Attributes().Len())
+}
+
+func validateHeader(t *testing.T, resource pcommon.Resource, key string, value string) {
+	validateAttribute(t, resource.Attributes(), key, value)
+}
+
+func validateAttribute(t *testing.T, attrs pcommon.Map, key string, value string) {
+	val, ok := attrs.Get(key)
+	require.True(t, ok)
+	assert.Equal(t, value, val.Str())
+}
@@ -40,6 +40,9 @@ type kafkaTracesConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtraction  bool
+	headers           []string
+	headersToRecord   bool
 }
 
 // kafkaMetricsConsumer uses sarama to consume and handle messages from kafka.
@@ -54,6 +57,9 @@ type kafkaMetricsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtraction  bool
+	headers           []string
+	headersToRecord   bool
 }
 
 // kafkaLogsConsumer uses sarama to consume and handle messages from kafka.
@@ -68,6 +74,9 @@ type kafkaLogsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtraction  bool
+	headers           []string
+	headersToRecord   bool
 }
 
 var _ receiver.Traces = (*kafkaTracesConsumer)(nil)
@@ -114,6 +123,9 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshalers
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		headerExtraction:  config.HeaderExtraction.ExtractHeaders,
+		headers:           config.HeaderExtraction.Headers,
+		headersToRecord:   config.HeaderExtraction.AttachTo == headerAttachToRecord,
 	}, nil
 }
 
@@ -136,6 +148,14 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, host component.Host) erro
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		headerExtractor:   &nopHeaderExtractor{},
+	}
+	if c.headerExtraction {
+		consumerGroup.headerExtractor = &headerExtractor{
+			logger:   c.settings.Logger,
+			headers:  c.headers,
+			toRecord: c.headersToRecord,
+		}
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, consumerGroup); err != nil {
@@ -207,6 +227,9 @@ func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		headerExtraction:  config.HeaderExtraction.ExtractHeaders,
+		headers:           config.HeaderExtraction.Headers,
+		headersToRecord:   config.HeaderExtraction.AttachTo == headerAttachToRecord,
 	}, nil
 }
 
@@ -229,6 +252,14 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, host component.Host) err
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		headerExtractor:   &nopHeaderExtractor{},
+	}
+	if c.headerExtraction {
+		metricsConsumerGroup.headerExtractor = &headerExtractor{
+			logger:   c.settings.Logger,
+			headers:  c.headers,
+			toRecord: c.headersToRecord,
+		}
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, metricsConsumerGroup); err != nil {
@@ -300,6 +331,9 @@ func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers ma
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		headerExtraction:  config.HeaderExtraction.ExtractHeaders,
+		headers:           config.HeaderExtraction.Headers,
+		headersToRecord:   config.HeaderExtraction.AttachTo == headerAttachToRecord,
 	}, nil
 }
 
@@ -350,6 +384,14 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, host component.Host) error
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		headerExtractor:   &nopHeaderExtractor{},
+	}
+	if c.headerExtraction {
+		logsConsumerGroup.headerExtractor = &headerExtractor{
+			logger:   c.settings.Logger,
+			headers:  c.headers,
+			toRecord: c.headersToRecord,
+		}
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, logsConsumerGroup); err != nil {
@@ -394,6 +436,7 @@ type tracesConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   HeaderExtractor
 }
 
 type metricsConsumerGroupHandler struct {
@@ -409,6 +452,7 @@ type metricsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   HeaderExtractor
 }
 
 type logsConsumerGroupHandler struct {
@@ -424,6 +468,7 @@ type logsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   HeaderExtractor
 }
 
 var _ sarama.ConsumerGroupHandler = (*tracesConsumerGroupHandler)(nil)
@@ -480,6 +525,8 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 				return err
 			}
 
+			c.headerExtractor.extractHeadersTraces(traces, message)
+
 			spanCount := traces.SpanCount()
 			err = c.nextConsumer.ConsumeTraces(session.Context(), traces)
 			c.obsrecv.EndTracesOp(ctx, c.unmarshaler.Encoding(), spanCount, err)
@@ -555,6 +602,8 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 				return err
 			}
 
+			c.headerExtractor.extractHeadersMetrics(metrics, message)
+
 			dataPointCount := metrics.DataPointCount()
 			err = c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
 			c.obsrecv.EndMetricsOp(ctx, c.unmarshaler.Encoding(), dataPointCount, err)
@@ -635,6 +684,8 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 				return err
 			}
 
+			c.headerExtractor.extractHeadersLogs(logs, message)
+
 			err = c.nextConsumer.ConsumeLogs(session.Context(), logs)
 			// TODO
 			c.obsrecv.EndLogsOp(ctx, c.unmarshaler.Encoding(), logs.LogRecordCount(), err)
@@ -105,7 +105,8 @@ func TestTracesReceiverStartConsume(t *testing.T) {
 	c.cancelConsumeLoop = cancelFunc
 	require.NoError(t, c.Shutdown(context.Background()))
 	err := c.consumeLoop(ctx, &tracesConsumerGroupHandler{
-		ready: make(chan bool),
+		ready:           make(chan bool),
+		headerExtractor: &nopHeaderExtractor{},
 	})
 	assert.EqualError(t, err, context.Canceled.Error())
 }
@@ -139,11 +140,12 @@ func TestTracesConsumerGroupHandler(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := tracesConsumerGroupHandler{
-		unmarshaler:  newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewNop(),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewNop(),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	testSession := testConsumerGroupSession{ctx: context.Background()}
@@ -188,11 +190,12 @@ func TestTracesConsumerGroupHandler_session_done(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := tracesConsumerGroupHandler{
-		unmarshaler:  newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewNop(),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewNop(),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -234,11 +237,12 @@ func TestTracesConsumerGroupHandler_error_unmarshal(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := tracesConsumerGroupHandler{
-		unmarshaler:  newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewNop(),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewNop(),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	wg := sync.WaitGroup{}
@@ -261,11 +265,12 @@ func TestTracesConsumerGroupHandler_error_nextConsumer(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := tracesConsumerGroupHandler{
-		unmarshaler:  newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewErr(consumerError),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewErr(consumerError),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	wg := sync.WaitGroup{}
@@ -362,7 +367,8 @@ func TestMetricsReceiverStartConsume(t *testing.T) {
 	c.cancelConsumeLoop = cancelFunc
 	require.NoError(t, c.Shutdown(context.Background()))
 	err := c.consumeLoop(ctx, &logsConsumerGroupHandler{
-		ready: make(chan bool),
+		ready:           make(chan bool),
+		headerExtractor: &nopHeaderExtractor{},
 	})
 	assert.EqualError(t, err, context.Canceled.Error())
 }
@@ -396,11 +402,12 @@ func TestMetricsConsumerGroupHandler(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := metricsConsumerGroupHandler{
-		unmarshaler:  newPdataMetricsUnmarshaler(&pmetric.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewNop(),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataMetricsUnmarshaler(&pmetric.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewNop(),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	testSession := testConsumerGroupSession{ctx: context.Background()}
@@ -445,11 +452,12 @@ func TestMetricsConsumerGroupHandler_session_done(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := metricsConsumerGroupHandler{
-		unmarshaler:  newPdataMetricsUnmarshaler(&pmetric.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewNop(),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataMetricsUnmarshaler(&pmetric.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewNop(),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -490,11 +498,12 @@ func TestMetricsConsumerGroupHandler_error_unmarshal(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := metricsConsumerGroupHandler{
-		unmarshaler:  newPdataMetricsUnmarshaler(&pmetric.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewNop(),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataMetricsUnmarshaler(&pmetric.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewNop(),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	wg := sync.WaitGroup{}
@@ -517,11 +526,12 @@ func TestMetricsConsumerGroupHandler_error_nextConsumer(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := metricsConsumerGroupHandler{
-		unmarshaler:  newPdataMetricsUnmarshaler(&pmetric.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewErr(consumerError),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataMetricsUnmarshaler(&pmetric.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewErr(consumerError),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	wg := sync.WaitGroup{}
@@ -617,7 +627,8 @@ func TestLogsReceiverStartConsume(t *testing.T) {
 	c.cancelConsumeLoop = cancelFunc
 	require.NoError(t, c.Shutdown(context.Background()))
 	err := c.consumeLoop(ctx, &logsConsumerGroupHandler{
-		ready: make(chan bool),
+		ready:           make(chan bool),
+		headerExtractor: &nopHeaderExtractor{},
 	})
 	assert.EqualError(t, err, context.Canceled.Error())
 }
@@ -651,11 +662,12 @@ func TestLogsConsumerGroupHandler(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := logsConsumerGroupHandler{
-		unmarshaler:  newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewNop(),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewNop(),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	testSession := testConsumerGroupSession{ctx: context.Background()}
@@ -700,11 +712,12 @@ func TestLogsConsumerGroupHandler_session_done(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := logsConsumerGroupHandler{
-		unmarshaler:  newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewNop(),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewNop(),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -745,11 +758,12 @@ func TestLogsConsumerGroupHandler_error_unmarshal(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := logsConsumerGroupHandler{
-		unmarshaler:  newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewNop(),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewNop(),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	wg := sync.WaitGroup{}
@@ -772,11 +786,12 @@ func TestLogsConsumerGroupHandler_error_nextConsumer(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
 	require.NoError(t, err)
 	c := logsConsumerGroupHandler{
-		unmarshaler:  newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
-		logger:       zap.NewNop(),
-		ready:        make(chan bool),
-		nextConsumer: consumertest.NewErr(consumerError),
-		obsrecv:      obsrecv,
+		unmarshaler:     newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    consumertest.NewErr(consumerError),
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
 	}
 
 	wg := sync.WaitGroup{}
@@ -842,11 +857,12 @@ func TestLogsConsumerGroupHandler_unmarshal_text(t *testing.T) {
 			require.NoError(t, err)
 			sink := &consumertest.LogsSink{}
 			c := logsConsumerGroupHandler{
-				unmarshaler:  unmarshaler,
-				logger:       zap.NewNop(),
-				ready:        make(chan bool),
-				nextConsumer: sink,
-				obsrecv:      obsrecv,
+				unmarshaler:     unmarshaler,
+				logger:          zap.NewNop(),
+				ready:           make(chan bool),
+				nextConsumer:    sink,
+				obsrecv:         obsrecv,
+				headerExtractor: &nopHeaderExtractor{},
 			}
 
 			wg := sync.WaitGroup{}
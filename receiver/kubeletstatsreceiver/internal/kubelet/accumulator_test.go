@@ -237,7 +237,7 @@ func TestNilHandling(t *testing.T) {
 		},
 	}
 	assert.NotPanics(t, func() {
-		acc.nodeStats(stats.NodeStats{})
+		acc.nodeStats(stats.NodeStats{}, &NodePressure{})
 	})
 	assert.NotPanics(t, func() {
 		acc.podStats(stats.PodStats{})
@@ -19,3 +19,20 @@ func addFilesystemMetrics(mb *metadata.MetricsBuilder, filesystemMetrics metadat
 	recordIntDataPoint(mb, filesystemMetrics.Capacity, s.CapacityBytes, currentTime)
 	recordIntDataPoint(mb, filesystemMetrics.Usage, s.UsedBytes, currentTime)
 }
+
+// addContainerEphemeralStorageMetric records the ephemeral storage used by the container,
+// its writable layer and its logs, like the kubelet does for the ephemeral storage limits.
+func addContainerEphemeralStorageMetric(mb *metadata.MetricsBuilder, s stats.ContainerStats, currentTime pcommon.Timestamp) {
+	var used uint64
+	var ok bool
+	for _, fs := range []*stats.FsStats{s.Rootfs, s.Logs} {
+		if fs != nil && fs.UsedBytes != nil {
+			used += *fs.UsedBytes
+			ok = true
+		}
+	}
+	if !ok {
+		return
+	}
+	mb.RecordContainerEphemeralStorageUsageDataPoint(currentTime, int64(used))
+}
@@ -8,13 +8,12 @@ import (
 
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
-	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
 )
 
 func MetricsData(
-	logger *zap.Logger, summary *stats.Summary,
+	logger *zap.Logger, summary *Summary,
 	metadata Metadata,
 	metricGroupsToCollect map[MetricGroup]bool,
 	mbs *metadata.MetricsBuilders) []pmetric.Metrics {
@@ -25,7 +24,7 @@ func MetricsData(
 		time:                  time.Now(),
 		mbs:                   mbs,
 	}
-	acc.nodeStats(summary.Node)
+	acc.nodeStats(summary.Node, summary.NodePressure)
 	for _, podStats := range summary.Pods {
 		acc.podStats(podStats)
 		for _, containerStats := range podStats.Containers {
@@ -7,6 +7,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -196,3 +197,94 @@ func fakeMetrics() []pmetric.Metrics {
 	}
 	return MetricsData(zap.NewNop(), summary, Metadata{}, mgs, mbs)
 }
+
+type psiRestClient struct {
+	fakeRestClient
+}
+
+func (f psiRestClient) StatsSummary() ([]byte, error) {
+	return []byte(`{
+  "node": {
+    "nodeName": "minikube",
+    "cpu": {
+      "usageNanoCores": 38604362,
+      "psi": {"some": {"total": 2500000, "avg10": 1.5, "avg60": 0.75, "avg300": 0.25}, "full": {"total": 0, "avg10": 0, "avg60": 0, "avg300": 0}}
+    },
+    "memory": {
+      "availableBytes": 171589632,
+      "psi": {"some": {"total": 1000000, "avg10": 10, "avg60": 5, "avg300": 2}, "full": {"total": 500000, "avg10": 4, "avg60": 2, "avg300": 1}}
+    }
+  }
+}`), nil
+}
+
+func TestNodePressureMetrics(t *testing.T) {
+	statsProvider := NewStatsProvider(psiRestClient{})
+	summary, err := statsProvider.StatsSummary()
+	require.NoError(t, err)
+	require.NotNil(t, summary.NodePressure)
+	require.NotNil(t, summary.NodePressure.CPU)
+	require.NotNil(t, summary.NodePressure.Memory)
+	require.Nil(t, summary.NodePressure.IO)
+
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.Metrics.K8sNodePressureStalledTime.Enabled = true
+	cfg.Metrics.K8sNodePressureStalledRatio.Enabled = true
+	mbs := &metadata.MetricsBuilders{
+		NodeMetricsBuilder: metadata.NewMetricsBuilder(cfg, receivertest.NewNopCreateSettings()),
+	}
+	mds := MetricsData(zap.NewNop(), summary, Metadata{}, map[MetricGroup]bool{NodeMetricGroup: true}, mbs)
+	require.Len(t, mds, 1)
+
+	metrics := map[string]pmetric.Metric{}
+	ms := mds[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+
+	stalledTime := metrics["k8s.node.pressure.stalled_time"].Sum().DataPoints()
+	require.Equal(t, 4, stalledTime.Len())
+	dp := stalledTime.At(0)
+	assert.Equal(t, 2.5, dp.DoubleValue())
+	resource, _ := dp.Attributes().Get("resource")
+	assert.Equal(t, "cpu", resource.Str())
+	stallType, _ := dp.Attributes().Get("stall_type")
+	assert.Equal(t, "some", stallType.Str())
+
+	stalledRatio := metrics["k8s.node.pressure.stalled_ratio"].Gauge().DataPoints()
+	require.Equal(t, 12, stalledRatio.Len())
+	dp = stalledRatio.At(0)
+	assert.Equal(t, 0.015, dp.DoubleValue())
+	window, _ := dp.Attributes().Get("window")
+	assert.Equal(t, "10s", window.Str())
+}
+
+func TestNodePressureNotReported(t *testing.T) {
+	summary, err := NewStatsProvider(&fakeRestClient{}).StatsSummary()
+	require.NoError(t, err)
+	assert.Nil(t, summary.NodePressure)
+}
+
+func TestContainerEphemeralStorageUsage(t *testing.T) {
+	summary, err := NewStatsProvider(&fakeRestClient{}).StatsSummary()
+	require.NoError(t, err)
+
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.Metrics.ContainerEphemeralStorageUsage.Enabled = true
+	mbs := &metadata.MetricsBuilders{
+		ContainerMetricsBuilder: metadata.NewMetricsBuilder(cfg, receivertest.NewNopCreateSettings()),
+	}
+	mds := MetricsData(zap.NewNop(), summary, Metadata{}, map[MetricGroup]bool{ContainerMetricGroup: true}, mbs)
+	require.NotEmpty(t, mds)
+
+	container := summary.Pods[0].Containers[0]
+	expected := int64(*container.Rootfs.UsedBytes + *container.Logs.UsedBytes)
+	ms := mds[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == "container.ephemeral_storage.usage" {
+			assert.Equal(t, expected, ms.At(i).Gauge().DataPoints().At(0).IntValue())
+			return
+		}
+	}
+	t.Fatal("container.ephemeral_storage.usage not found")
+}
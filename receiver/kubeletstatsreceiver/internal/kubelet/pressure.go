@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
+)
+
+// NodePressure is the pressure stall information (PSI) of the node, reported in the stats
+// summary by the kubelets of Kubernetes 1.33 and above with the KubeletPSI feature enabled.
+// It isn't part of the stats API of the kubelet version the receiver is built with.
+type NodePressure struct {
+	CPU    *PSIStats
+	Memory *PSIStats
+	IO     *PSIStats
+}
+
+// PSIStats is the pressure stall information of a resource.
+type PSIStats struct {
+	// Some is the stall information of the time some tasks are stalled on the resource.
+	Some PSIData `json:"some"`
+	// Full is the stall information of the time all the non-idle tasks are stalled on the resource.
+	Full PSIData `json:"full"`
+}
+
+// PSIData is the stalled time of the tasks.
+type PSIData struct {
+	// Total is the total stalled time in microseconds.
+	Total uint64 `json:"total"`
+	// Avg10, Avg60 and Avg300 are the percentages of time the tasks were stalled over the
+	// last 10, 60 and 300 seconds.
+	Avg10  float64 `json:"avg10"`
+	Avg60  float64 `json:"avg60"`
+	Avg300 float64 `json:"avg300"`
+}
+
+// psiSummary is the part of the stats summary holding the pressure stall information of the node.
+type psiSummary struct {
+	Node struct {
+		CPU *struct {
+			PSI *PSIStats `json:"psi"`
+		} `json:"cpu"`
+		Memory *struct {
+			PSI *PSIStats `json:"psi"`
+		} `json:"memory"`
+		IO *struct {
+			PSI *PSIStats `json:"psi"`
+		} `json:"io"`
+	} `json:"node"`
+}
+
+func (s psiSummary) nodePressure() *NodePressure {
+	var p NodePressure
+	if s.Node.CPU != nil {
+		p.CPU = s.Node.CPU.PSI
+	}
+	if s.Node.Memory != nil {
+		p.Memory = s.Node.Memory.PSI
+	}
+	if s.Node.IO != nil {
+		p.IO = s.Node.IO.PSI
+	}
+	if p.CPU == nil && p.Memory == nil && p.IO == nil {
+		return nil
+	}
+	return &p
+}
+
+func addNodePressureMetrics(mb *metadata.MetricsBuilder, p *NodePressure, currentTime pcommon.Timestamp) {
+	if p == nil {
+		return
+	}
+	addPSIMetrics(mb, metadata.AttributeResourceCpu, p.CPU, currentTime)
+	addPSIMetrics(mb, metadata.AttributeResourceMemory, p.Memory, currentTime)
+	addPSIMetrics(mb, metadata.AttributeResourceIo, p.IO, currentTime)
+}
+
+func addPSIMetrics(mb *metadata.MetricsBuilder, resource metadata.AttributeResource, s *PSIStats, currentTime pcommon.Timestamp) {
+	if s == nil {
+		return
+	}
+	addPSIDataMetrics(mb, resource, metadata.AttributeStallTypeSome, s.Some, currentTime)
+	addPSIDataMetrics(mb, resource, metadata.AttributeStallTypeFull, s.Full, currentTime)
+}
+
+func addPSIDataMetrics(mb *metadata.MetricsBuilder, resource metadata.AttributeResource, stallType metadata.AttributeStallType, d PSIData, currentTime pcommon.Timestamp) {
+	mb.RecordK8sNodePressureStalledTimeDataPoint(currentTime, float64(d.Total)/1_000_000, resource, stallType)
+	mb.RecordK8sNodePressureStalledRatioDataPoint(currentTime, d.Avg10/100, resource, stallType, metadata.AttributeWindow10s)
+	mb.RecordK8sNodePressureStalledRatioDataPoint(currentTime, d.Avg60/100, resource, stallType, metadata.AttributeWindow60s)
+	mb.RecordK8sNodePressureStalledRatioDataPoint(currentTime, d.Avg300/100, resource, stallType, metadata.AttributeWindow300s)
+}
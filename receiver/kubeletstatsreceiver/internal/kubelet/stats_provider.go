@@ -9,8 +9,16 @@ import (
 	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 )
 
+// Summary is the stats.Summary of the kubelet API, with the pressure stall
+// information of the node.
+type Summary struct {
+	*stats.Summary
+	// NodePressure is nil when the kubelet doesn't report the pressure stall information.
+	NodePressure *NodePressure
+}
+
 // StatsProvider wraps a RestClient, returning an unmarshaled
-// stats.Summary struct from the kubelet API.
+// Summary struct from the kubelet API.
 type StatsProvider struct {
 	rc RestClient
 }
@@ -20,8 +28,8 @@ func NewStatsProvider(rc RestClient) *StatsProvider {
 }
 
 // StatsSummary calls the /stats/summary kubelet endpoint and unmarshals the
-// results into a stats.Summary struct.
-func (p *StatsProvider) StatsSummary() (*stats.Summary, error) {
+// results into a Summary struct.
+func (p *StatsProvider) StatsSummary() (*Summary, error) {
 	summary, err := p.rc.StatsSummary()
 	if err != nil {
 		return nil, err
@@ -31,5 +39,10 @@ func (p *StatsProvider) StatsSummary() (*stats.Summary, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &out, nil
+	var psi psiSummary
+	err = json.Unmarshal(summary, &psi)
+	if err != nil {
+		return nil, err
+	}
+	return &Summary{Summary: &out, NodePressure: psi.nodePressure()}, nil
 }
@@ -27,6 +27,7 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 type MetricsConfig struct {
 	ContainerCPUTime               MetricConfig `mapstructure:"container.cpu.time"`
 	ContainerCPUUtilization        MetricConfig `mapstructure:"container.cpu.utilization"`
+	ContainerEphemeralStorageUsage MetricConfig `mapstructure:"container.ephemeral_storage.usage"`
 	ContainerFilesystemAvailable   MetricConfig `mapstructure:"container.filesystem.available"`
 	ContainerFilesystemCapacity    MetricConfig `mapstructure:"container.filesystem.capacity"`
 	ContainerFilesystemUsage       MetricConfig `mapstructure:"container.filesystem.usage"`
@@ -49,6 +50,8 @@ type MetricsConfig struct {
 	K8sNodeMemoryWorkingSet        MetricConfig `mapstructure:"k8s.node.memory.working_set"`
 	K8sNodeNetworkErrors           MetricConfig `mapstructure:"k8s.node.network.errors"`
 	K8sNodeNetworkIo               MetricConfig `mapstructure:"k8s.node.network.io"`
+	K8sNodePressureStalledRatio    MetricConfig `mapstructure:"k8s.node.pressure.stalled_ratio"`
+	K8sNodePressureStalledTime     MetricConfig `mapstructure:"k8s.node.pressure.stalled_time"`
 	K8sPodCPUTime                  MetricConfig `mapstructure:"k8s.pod.cpu.time"`
 	K8sPodCPUUtilization           MetricConfig `mapstructure:"k8s.pod.cpu.utilization"`
 	K8sPodFilesystemAvailable      MetricConfig `mapstructure:"k8s.pod.filesystem.available"`
@@ -77,6 +80,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		ContainerCPUUtilization: MetricConfig{
 			Enabled: true,
 		},
+		ContainerEphemeralStorageUsage: MetricConfig{
+			Enabled: false,
+		},
 		ContainerFilesystemAvailable: MetricConfig{
 			Enabled: true,
 		},
@@ -143,6 +149,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sNodeNetworkIo: MetricConfig{
 			Enabled: true,
 		},
+		K8sNodePressureStalledRatio: MetricConfig{
+			Enabled: false,
+		},
+		K8sNodePressureStalledTime: MetricConfig{
+			Enabled: false,
+		},
 		K8sPodCPUTime: MetricConfig{
 			Enabled: true,
 		},
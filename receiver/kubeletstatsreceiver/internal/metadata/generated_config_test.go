@@ -28,6 +28,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 				Metrics: MetricsConfig{
 					ContainerCPUTime:               MetricConfig{Enabled: true},
 					ContainerCPUUtilization:        MetricConfig{Enabled: true},
+					ContainerEphemeralStorageUsage: MetricConfig{Enabled: true},
 					ContainerFilesystemAvailable:   MetricConfig{Enabled: true},
 					ContainerFilesystemCapacity:    MetricConfig{Enabled: true},
 					ContainerFilesystemUsage:       MetricConfig{Enabled: true},
@@ -50,6 +51,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNodeMemoryWorkingSet:        MetricConfig{Enabled: true},
 					K8sNodeNetworkErrors:           MetricConfig{Enabled: true},
 					K8sNodeNetworkIo:               MetricConfig{Enabled: true},
+					K8sNodePressureStalledRatio:    MetricConfig{Enabled: true},
+					K8sNodePressureStalledTime:     MetricConfig{Enabled: true},
 					K8sPodCPUTime:                  MetricConfig{Enabled: true},
 					K8sPodCPUUtilization:           MetricConfig{Enabled: true},
 					K8sPodFilesystemAvailable:      MetricConfig{Enabled: true},
@@ -94,6 +97,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 				Metrics: MetricsConfig{
 					ContainerCPUTime:               MetricConfig{Enabled: false},
 					ContainerCPUUtilization:        MetricConfig{Enabled: false},
+					ContainerEphemeralStorageUsage: MetricConfig{Enabled: false},
 					ContainerFilesystemAvailable:   MetricConfig{Enabled: false},
 					ContainerFilesystemCapacity:    MetricConfig{Enabled: false},
 					ContainerFilesystemUsage:       MetricConfig{Enabled: false},
@@ -116,6 +120,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNodeMemoryWorkingSet:        MetricConfig{Enabled: false},
 					K8sNodeNetworkErrors:           MetricConfig{Enabled: false},
 					K8sNodeNetworkIo:               MetricConfig{Enabled: false},
+					K8sNodePressureStalledRatio:    MetricConfig{Enabled: false},
+					K8sNodePressureStalledTime:     MetricConfig{Enabled: false},
 					K8sPodCPUTime:                  MetricConfig{Enabled: false},
 					K8sPodCPUUtilization:           MetricConfig{Enabled: false},
 					K8sPodFilesystemAvailable:      MetricConfig{Enabled: false},
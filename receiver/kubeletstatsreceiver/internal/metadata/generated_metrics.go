@@ -37,6 +37,92 @@ var MapAttributeDirection = map[string]AttributeDirection{
 	"transmit": AttributeDirectionTransmit,
 }
 
+// AttributeResource specifies the a value resource attribute.
+type AttributeResource int
+
+const (
+	_ AttributeResource = iota
+	AttributeResourceCpu
+	AttributeResourceMemory
+	AttributeResourceIo
+)
+
+// String returns the string representation of the AttributeResource.
+func (av AttributeResource) String() string {
+	switch av {
+	case AttributeResourceCpu:
+		return "cpu"
+	case AttributeResourceMemory:
+		return "memory"
+	case AttributeResourceIo:
+		return "io"
+	}
+	return ""
+}
+
+// MapAttributeResource is a helper map of string to AttributeResource attribute value.
+var MapAttributeResource = map[string]AttributeResource{
+	"cpu":    AttributeResourceCpu,
+	"memory": AttributeResourceMemory,
+	"io":     AttributeResourceIo,
+}
+
+// AttributeStallType specifies the a value stall_type attribute.
+type AttributeStallType int
+
+const (
+	_ AttributeStallType = iota
+	AttributeStallTypeSome
+	AttributeStallTypeFull
+)
+
+// String returns the string representation of the AttributeStallType.
+func (av AttributeStallType) String() string {
+	switch av {
+	case AttributeStallTypeSome:
+		return "some"
+	case AttributeStallTypeFull:
+		return "full"
+	}
+	return ""
+}
+
+// MapAttributeStallType is a helper map of string to AttributeStallType attribute value.
+var MapAttributeStallType = map[string]AttributeStallType{
+	"some": AttributeStallTypeSome,
+	"full": AttributeStallTypeFull,
+}
+
+// AttributeWindow specifies the a value window attribute.
+type AttributeWindow int
+
+const (
+	_ AttributeWindow = iota
+	AttributeWindow10s
+	AttributeWindow60s
+	AttributeWindow300s
+)
+
+// String returns the string representation of the AttributeWindow.
+func (av AttributeWindow) String() string {
+	switch av {
+	case AttributeWindow10s:
+		return "10s"
+	case AttributeWindow60s:
+		return "60s"
+	case AttributeWindow300s:
+		return "300s"
+	}
+	return ""
+}
+
+// MapAttributeWindow is a helper map of string to AttributeWindow attribute value.
+var MapAttributeWindow = map[string]AttributeWindow{
+	"10s":  AttributeWindow10s,
+	"60s":  AttributeWindow60s,
+	"300s": AttributeWindow300s,
+}
+
 type metricContainerCPUTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -137,6 +223,55 @@ func newMetricContainerCPUUtilization(cfg MetricConfig) metricContainerCPUUtiliz
 	return m
 }
 
+type metricContainerEphemeralStorageUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.ephemeral_storage.usage metric with initial data.
+func (m *metricContainerEphemeralStorageUsage) init() {
+	m.data.SetName("container.ephemeral_storage.usage")
+	m.data.SetDescription("Container ephemeral storage usage, the usage of its writable layer and of its logs")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricContainerEphemeralStorageUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerEphemeralStorageUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerEphemeralStorageUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerEphemeralStorageUsage(cfg MetricConfig) metricContainerEphemeralStorageUsage {
+	m := metricContainerEphemeralStorageUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerFilesystemAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1227,6 +1362,113 @@ func newMetricK8sNodeNetworkIo(cfg MetricConfig) metricK8sNodeNetworkIo {
 	return m
 }
 
+type metricK8sNodePressureStalledRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.pressure.stalled_ratio metric with initial data.
+func (m *metricK8sNodePressureStalledRatio) init() {
+	m.data.SetName("k8s.node.pressure.stalled_ratio")
+	m.data.SetDescription("The ratio of time the tasks of the node were stalled on the resource over the window, as reported by the pressure stall information (PSI) of the kubelet. Requires the KubeletPSI feature of Kubernetes 1.33 or above.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sNodePressureStalledRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, resourceAttributeValue string, stallTypeAttributeValue string, windowAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
+	dp.Attributes().PutStr("stall_type", stallTypeAttributeValue)
+	dp.Attributes().PutStr("window", windowAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodePressureStalledRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodePressureStalledRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodePressureStalledRatio(cfg MetricConfig) metricK8sNodePressureStalledRatio {
+	m := metricK8sNodePressureStalledRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNodePressureStalledTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.pressure.stalled_time metric with initial data.
+func (m *metricK8sNodePressureStalledTime) init() {
+	m.data.SetName("k8s.node.pressure.stalled_time")
+	m.data.SetDescription("The total time the tasks of the node were stalled on the resource, as reported by the pressure stall information (PSI) of the kubelet. Requires the KubeletPSI feature of Kubernetes 1.33 or above.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sNodePressureStalledTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, resourceAttributeValue string, stallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
+	dp.Attributes().PutStr("stall_type", stallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodePressureStalledTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodePressureStalledTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodePressureStalledTime(cfg MetricConfig) metricK8sNodePressureStalledTime {
+	m := metricK8sNodePressureStalledTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodCPUTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2131,6 +2373,7 @@ type MetricsBuilder struct {
 	buildInfo                            component.BuildInfo  // contains version information.
 	metricContainerCPUTime               metricContainerCPUTime
 	metricContainerCPUUtilization        metricContainerCPUUtilization
+	metricContainerEphemeralStorageUsage metricContainerEphemeralStorageUsage
 	metricContainerFilesystemAvailable   metricContainerFilesystemAvailable
 	metricContainerFilesystemCapacity    metricContainerFilesystemCapacity
 	metricContainerFilesystemUsage       metricContainerFilesystemUsage
@@ -2153,6 +2396,8 @@ type MetricsBuilder struct {
 	metricK8sNodeMemoryWorkingSet        metricK8sNodeMemoryWorkingSet
 	metricK8sNodeNetworkErrors           metricK8sNodeNetworkErrors
 	metricK8sNodeNetworkIo               metricK8sNodeNetworkIo
+	metricK8sNodePressureStalledRatio    metricK8sNodePressureStalledRatio
+	metricK8sNodePressureStalledTime     metricK8sNodePressureStalledTime
 	metricK8sPodCPUTime                  metricK8sPodCPUTime
 	metricK8sPodCPUUtilization           metricK8sPodCPUUtilization
 	metricK8sPodFilesystemAvailable      metricK8sPodFilesystemAvailable
@@ -2191,6 +2436,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		buildInfo:                            settings.BuildInfo,
 		metricContainerCPUTime:               newMetricContainerCPUTime(mbc.Metrics.ContainerCPUTime),
 		metricContainerCPUUtilization:        newMetricContainerCPUUtilization(mbc.Metrics.ContainerCPUUtilization),
+		metricContainerEphemeralStorageUsage: newMetricContainerEphemeralStorageUsage(mbc.Metrics.ContainerEphemeralStorageUsage),
 		metricContainerFilesystemAvailable:   newMetricContainerFilesystemAvailable(mbc.Metrics.ContainerFilesystemAvailable),
 		metricContainerFilesystemCapacity:    newMetricContainerFilesystemCapacity(mbc.Metrics.ContainerFilesystemCapacity),
 		metricContainerFilesystemUsage:       newMetricContainerFilesystemUsage(mbc.Metrics.ContainerFilesystemUsage),
@@ -2213,6 +2459,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricK8sNodeMemoryWorkingSet:        newMetricK8sNodeMemoryWorkingSet(mbc.Metrics.K8sNodeMemoryWorkingSet),
 		metricK8sNodeNetworkErrors:           newMetricK8sNodeNetworkErrors(mbc.Metrics.K8sNodeNetworkErrors),
 		metricK8sNodeNetworkIo:               newMetricK8sNodeNetworkIo(mbc.Metrics.K8sNodeNetworkIo),
+		metricK8sNodePressureStalledRatio:    newMetricK8sNodePressureStalledRatio(mbc.Metrics.K8sNodePressureStalledRatio),
+		metricK8sNodePressureStalledTime:     newMetricK8sNodePressureStalledTime(mbc.Metrics.K8sNodePressureStalledTime),
 		metricK8sPodCPUTime:                  newMetricK8sPodCPUTime(mbc.Metrics.K8sPodCPUTime),
 		metricK8sPodCPUUtilization:           newMetricK8sPodCPUUtilization(mbc.Metrics.K8sPodCPUUtilization),
 		metricK8sPodFilesystemAvailable:      newMetricK8sPodFilesystemAvailable(mbc.Metrics.K8sPodFilesystemAvailable),
@@ -2294,6 +2542,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricContainerCPUTime.emit(ils.Metrics())
 	mb.metricContainerCPUUtilization.emit(ils.Metrics())
+	mb.metricContainerEphemeralStorageUsage.emit(ils.Metrics())
 	mb.metricContainerFilesystemAvailable.emit(ils.Metrics())
 	mb.metricContainerFilesystemCapacity.emit(ils.Metrics())
 	mb.metricContainerFilesystemUsage.emit(ils.Metrics())
@@ -2316,6 +2565,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricK8sNodeMemoryWorkingSet.emit(ils.Metrics())
 	mb.metricK8sNodeNetworkErrors.emit(ils.Metrics())
 	mb.metricK8sNodeNetworkIo.emit(ils.Metrics())
+	mb.metricK8sNodePressureStalledRatio.emit(ils.Metrics())
+	mb.metricK8sNodePressureStalledTime.emit(ils.Metrics())
 	mb.metricK8sPodCPUTime.emit(ils.Metrics())
 	mb.metricK8sPodCPUUtilization.emit(ils.Metrics())
 	mb.metricK8sPodFilesystemAvailable.emit(ils.Metrics())
@@ -2364,6 +2615,11 @@ func (mb *MetricsBuilder) RecordContainerCPUUtilizationDataPoint(ts pcommon.Time
 	mb.metricContainerCPUUtilization.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordContainerEphemeralStorageUsageDataPoint adds a data point to container.ephemeral_storage.usage metric.
+func (mb *MetricsBuilder) RecordContainerEphemeralStorageUsageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerEphemeralStorageUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordContainerFilesystemAvailableDataPoint adds a data point to container.filesystem.available metric.
 func (mb *MetricsBuilder) RecordContainerFilesystemAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricContainerFilesystemAvailable.recordDataPoint(mb.startTime, ts, val)
@@ -2474,6 +2730,16 @@ func (mb *MetricsBuilder) RecordK8sNodeNetworkIoDataPoint(ts pcommon.Timestamp,
 	mb.metricK8sNodeNetworkIo.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue, directionAttributeValue.String())
 }
 
+// RecordK8sNodePressureStalledRatioDataPoint adds a data point to k8s.node.pressure.stalled_ratio metric.
+func (mb *MetricsBuilder) RecordK8sNodePressureStalledRatioDataPoint(ts pcommon.Timestamp, val float64, resourceAttributeValue AttributeResource, stallTypeAttributeValue AttributeStallType, windowAttributeValue AttributeWindow) {
+	mb.metricK8sNodePressureStalledRatio.recordDataPoint(mb.startTime, ts, val, resourceAttributeValue.String(), stallTypeAttributeValue.String(), windowAttributeValue.String())
+}
+
+// RecordK8sNodePressureStalledTimeDataPoint adds a data point to k8s.node.pressure.stalled_time metric.
+func (mb *MetricsBuilder) RecordK8sNodePressureStalledTimeDataPoint(ts pcommon.Timestamp, val float64, resourceAttributeValue AttributeResource, stallTypeAttributeValue AttributeStallType) {
+	mb.metricK8sNodePressureStalledTime.recordDataPoint(mb.startTime, ts, val, resourceAttributeValue.String(), stallTypeAttributeValue.String())
+}
+
 // RecordK8sPodCPUTimeDataPoint adds a data point to k8s.pod.cpu.time metric.
 func (mb *MetricsBuilder) RecordK8sPodCPUTimeDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricK8sPodCPUTime.recordDataPoint(mb.startTime, ts, val)
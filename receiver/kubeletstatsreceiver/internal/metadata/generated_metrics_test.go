@@ -62,6 +62,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordContainerCPUUtilizationDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordContainerEphemeralStorageUsageDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordContainerFilesystemAvailableDataPoint(ts, 1)
@@ -150,6 +153,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sNodeNetworkIoDataPoint(ts, 1, "interface-val", AttributeDirectionReceive)
 
+			allMetricsCount++
+			mb.RecordK8sNodePressureStalledRatioDataPoint(ts, 1, AttributeResourceCpu, AttributeStallTypeSome, AttributeWindow10s)
+
+			allMetricsCount++
+			mb.RecordK8sNodePressureStalledTimeDataPoint(ts, 1, AttributeResourceCpu, AttributeStallTypeSome)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sPodCPUTimeDataPoint(ts, 1)
@@ -286,6 +295,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "container.ephemeral_storage.usage":
+					assert.False(t, validatedMetrics["container.ephemeral_storage.usage"], "Found a duplicate in the metrics slice: container.ephemeral_storage.usage")
+					validatedMetrics["container.ephemeral_storage.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Container ephemeral storage usage, the usage of its writable layer and of its logs", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "container.filesystem.available":
 					assert.False(t, validatedMetrics["container.filesystem.available"], "Found a duplicate in the metrics slice: container.filesystem.available")
 					validatedMetrics["container.filesystem.available"] = true
@@ -568,6 +589,47 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("direction")
 					assert.True(t, ok)
 					assert.EqualValues(t, "receive", attrVal.Str())
+				case "k8s.node.pressure.stalled_ratio":
+					assert.False(t, validatedMetrics["k8s.node.pressure.stalled_ratio"], "Found a duplicate in the metrics slice: k8s.node.pressure.stalled_ratio")
+					validatedMetrics["k8s.node.pressure.stalled_ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The ratio of time the tasks of the node were stalled on the resource over the window, as reported by the pressure stall information (PSI) of the kubelet. Requires the KubeletPSI feature of Kubernetes 1.33 or above.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("resource")
+					assert.True(t, ok)
+					assert.EqualValues(t, "cpu", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("stall_type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "some", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("window")
+					assert.True(t, ok)
+					assert.EqualValues(t, "10s", attrVal.Str())
+				case "k8s.node.pressure.stalled_time":
+					assert.False(t, validatedMetrics["k8s.node.pressure.stalled_time"], "Found a duplicate in the metrics slice: k8s.node.pressure.stalled_time")
+					validatedMetrics["k8s.node.pressure.stalled_time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total time the tasks of the node were stalled on the resource, as reported by the pressure stall information (PSI) of the kubelet. Requires the KubeletPSI feature of Kubernetes 1.33 or above.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("resource")
+					assert.True(t, ok)
+					assert.EqualValues(t, "cpu", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("stall_type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "some", attrVal.Str())
 				case "k8s.pod.cpu.time":
 					assert.False(t, validatedMetrics["k8s.pod.cpu.time"], "Found a duplicate in the metrics slice: k8s.pod.cpu.time")
 					validatedMetrics["k8s.pod.cpu.time"] = true
@@ -347,11 +347,12 @@ func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 		return nil, err
 	}
 
+	// SHOW REPLICA STATUS replaces SHOW SLAVE STATUS from MySQL 8.0.22, the "master" and
+	// "slave" of its columns being renamed to "source" and "replica"
+	query := "SHOW REPLICA STATUS"
 	if version < "8.0.22" {
-		return nil, nil
+		query = "SHOW SLAVE STATUS"
 	}
-
-	query := "SHOW REPLICA STATUS"
 	rows, err := c.client.Query(query)
 
 	if err != nil {
@@ -369,7 +370,7 @@ func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 		var s ReplicaStatusStats
 		dest := []interface{}{}
 		for _, col := range cols {
-			switch strings.ToLower(col) {
+			switch replicaStatusColumnReplacer.Replace(strings.ToLower(col)) {
 			case "replica_io_state":
 				dest = append(dest, &s.replicaIOState)
 			case "source_host":
@@ -505,6 +506,10 @@ func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 	return stats, nil
 }
 
+// replicaStatusColumnReplacer renames the columns of SHOW SLAVE STATUS to the columns of
+// SHOW REPLICA STATUS, e.g. Seconds_Behind_Master to Seconds_Behind_Source.
+var replicaStatusColumnReplacer = strings.NewReplacer("master", "source", "slave", "replica")
+
 func Query(c mySQLClient, query string) (map[string]string, error) {
 	rows, err := c.client.Query(query)
 	if err != nil {
@@ -26,11 +26,13 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 // MetricsConfig provides config for mysql metrics.
 type MetricsConfig struct {
 	MysqlBufferPoolDataPages     MetricConfig `mapstructure:"mysql.buffer_pool.data_pages"`
+	MysqlBufferPoolHitRatio      MetricConfig `mapstructure:"mysql.buffer_pool.hit_ratio"`
 	MysqlBufferPoolLimit         MetricConfig `mapstructure:"mysql.buffer_pool.limit"`
 	MysqlBufferPoolOperations    MetricConfig `mapstructure:"mysql.buffer_pool.operations"`
 	MysqlBufferPoolPageFlushes   MetricConfig `mapstructure:"mysql.buffer_pool.page_flushes"`
 	MysqlBufferPoolPages         MetricConfig `mapstructure:"mysql.buffer_pool.pages"`
 	MysqlBufferPoolUsage         MetricConfig `mapstructure:"mysql.buffer_pool.usage"`
+	MysqlBufferPoolUtilization   MetricConfig `mapstructure:"mysql.buffer_pool.utilization"`
 	MysqlClientNetworkIo         MetricConfig `mapstructure:"mysql.client.network.io"`
 	MysqlCommands                MetricConfig `mapstructure:"mysql.commands"`
 	MysqlConnectionCount         MetricConfig `mapstructure:"mysql.connection.count"`
@@ -51,8 +53,13 @@ type MetricsConfig struct {
 	MysqlQueryClientCount        MetricConfig `mapstructure:"mysql.query.client.count"`
 	MysqlQueryCount              MetricConfig `mapstructure:"mysql.query.count"`
 	MysqlQuerySlowCount          MetricConfig `mapstructure:"mysql.query.slow.count"`
+	MysqlReplicaRelayLogSize     MetricConfig `mapstructure:"mysql.replica.relay_log.size"`
 	MysqlReplicaSQLDelay         MetricConfig `mapstructure:"mysql.replica.sql_delay"`
+	MysqlReplicaThreadRunning    MetricConfig `mapstructure:"mysql.replica.thread.running"`
 	MysqlReplicaTimeBehindSource MetricConfig `mapstructure:"mysql.replica.time_behind_source"`
+	MysqlRowLockCurrentWaits     MetricConfig `mapstructure:"mysql.row_lock.current_waits"`
+	MysqlRowLockTimeAverage      MetricConfig `mapstructure:"mysql.row_lock.time.average"`
+	MysqlRowLockTimeMax          MetricConfig `mapstructure:"mysql.row_lock.time.max"`
 	MysqlRowLocks                MetricConfig `mapstructure:"mysql.row_locks"`
 	MysqlRowOperations           MetricConfig `mapstructure:"mysql.row_operations"`
 	MysqlSorts                   MetricConfig `mapstructure:"mysql.sorts"`
@@ -75,6 +82,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		MysqlBufferPoolDataPages: MetricConfig{
 			Enabled: true,
 		},
+		MysqlBufferPoolHitRatio: MetricConfig{
+			Enabled: false,
+		},
 		MysqlBufferPoolLimit: MetricConfig{
 			Enabled: true,
 		},
@@ -90,6 +100,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		MysqlBufferPoolUsage: MetricConfig{
 			Enabled: true,
 		},
+		MysqlBufferPoolUtilization: MetricConfig{
+			Enabled: false,
+		},
 		MysqlClientNetworkIo: MetricConfig{
 			Enabled: false,
 		},
@@ -150,12 +163,27 @@ func DefaultMetricsConfig() MetricsConfig {
 		MysqlQuerySlowCount: MetricConfig{
 			Enabled: false,
 		},
+		MysqlReplicaRelayLogSize: MetricConfig{
+			Enabled: false,
+		},
 		MysqlReplicaSQLDelay: MetricConfig{
 			Enabled: false,
 		},
+		MysqlReplicaThreadRunning: MetricConfig{
+			Enabled: false,
+		},
 		MysqlReplicaTimeBehindSource: MetricConfig{
 			Enabled: false,
 		},
+		MysqlRowLockCurrentWaits: MetricConfig{
+			Enabled: false,
+		},
+		MysqlRowLockTimeAverage: MetricConfig{
+			Enabled: false,
+		},
+		MysqlRowLockTimeMax: MetricConfig{
+			Enabled: false,
+		},
 		MysqlRowLocks: MetricConfig{
 			Enabled: true,
 		},
@@ -27,11 +27,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					MysqlBufferPoolDataPages:     MetricConfig{Enabled: true},
+					MysqlBufferPoolHitRatio:      MetricConfig{Enabled: true},
 					MysqlBufferPoolLimit:         MetricConfig{Enabled: true},
 					MysqlBufferPoolOperations:    MetricConfig{Enabled: true},
 					MysqlBufferPoolPageFlushes:   MetricConfig{Enabled: true},
 					MysqlBufferPoolPages:         MetricConfig{Enabled: true},
 					MysqlBufferPoolUsage:         MetricConfig{Enabled: true},
+					MysqlBufferPoolUtilization:   MetricConfig{Enabled: true},
 					MysqlClientNetworkIo:         MetricConfig{Enabled: true},
 					MysqlCommands:                MetricConfig{Enabled: true},
 					MysqlConnectionCount:         MetricConfig{Enabled: true},
@@ -52,8 +54,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlQueryClientCount:        MetricConfig{Enabled: true},
 					MysqlQueryCount:              MetricConfig{Enabled: true},
 					MysqlQuerySlowCount:          MetricConfig{Enabled: true},
+					MysqlReplicaRelayLogSize:     MetricConfig{Enabled: true},
 					MysqlReplicaSQLDelay:         MetricConfig{Enabled: true},
+					MysqlReplicaThreadRunning:    MetricConfig{Enabled: true},
 					MysqlReplicaTimeBehindSource: MetricConfig{Enabled: true},
+					MysqlRowLockCurrentWaits:     MetricConfig{Enabled: true},
+					MysqlRowLockTimeAverage:      MetricConfig{Enabled: true},
+					MysqlRowLockTimeMax:          MetricConfig{Enabled: true},
 					MysqlRowLocks:                MetricConfig{Enabled: true},
 					MysqlRowOperations:           MetricConfig{Enabled: true},
 					MysqlSorts:                   MetricConfig{Enabled: true},
@@ -80,11 +87,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					MysqlBufferPoolDataPages:     MetricConfig{Enabled: false},
+					MysqlBufferPoolHitRatio:      MetricConfig{Enabled: false},
 					MysqlBufferPoolLimit:         MetricConfig{Enabled: false},
 					MysqlBufferPoolOperations:    MetricConfig{Enabled: false},
 					MysqlBufferPoolPageFlushes:   MetricConfig{Enabled: false},
 					MysqlBufferPoolPages:         MetricConfig{Enabled: false},
 					MysqlBufferPoolUsage:         MetricConfig{Enabled: false},
+					MysqlBufferPoolUtilization:   MetricConfig{Enabled: false},
 					MysqlClientNetworkIo:         MetricConfig{Enabled: false},
 					MysqlCommands:                MetricConfig{Enabled: false},
 					MysqlConnectionCount:         MetricConfig{Enabled: false},
@@ -105,8 +114,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlQueryClientCount:        MetricConfig{Enabled: false},
 					MysqlQueryCount:              MetricConfig{Enabled: false},
 					MysqlQuerySlowCount:          MetricConfig{Enabled: false},
+					MysqlReplicaRelayLogSize:     MetricConfig{Enabled: false},
 					MysqlReplicaSQLDelay:         MetricConfig{Enabled: false},
+					MysqlReplicaThreadRunning:    MetricConfig{Enabled: false},
 					MysqlReplicaTimeBehindSource: MetricConfig{Enabled: false},
+					MysqlRowLockCurrentWaits:     MetricConfig{Enabled: false},
+					MysqlRowLockTimeAverage:      MetricConfig{Enabled: false},
+					MysqlRowLockTimeMax:          MetricConfig{Enabled: false},
 					MysqlRowLocks:                MetricConfig{Enabled: false},
 					MysqlRowOperations:           MetricConfig{Enabled: false},
 					MysqlSorts:                   MetricConfig{Enabled: false},
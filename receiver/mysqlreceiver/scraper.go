@@ -119,6 +119,7 @@ func (m *mySQLScraper) scrapeGlobalStats(now pcommon.Timestamp, errs *scrapererr
 
 	m.recordDataPages(now, globalStats, errs)
 	m.recordDataUsage(now, globalStats, errs)
+	m.recordBufferPoolEfficiency(now, globalStats, errs)
 
 	for k, v := range globalStats {
 		switch k {
@@ -312,6 +313,12 @@ func (m *mySQLScraper) scrapeGlobalStats(now pcommon.Timestamp, errs *scrapererr
 			addPartialIfError(errs, m.mb.RecordMysqlRowLocksDataPoint(now, v, metadata.AttributeRowLocksWaits))
 		case "Innodb_row_lock_time":
 			addPartialIfError(errs, m.mb.RecordMysqlRowLocksDataPoint(now, v, metadata.AttributeRowLocksTime))
+		case "Innodb_row_lock_current_waits":
+			addPartialIfError(errs, m.mb.RecordMysqlRowLockCurrentWaitsDataPoint(now, v))
+		case "Innodb_row_lock_time_avg":
+			addPartialIfError(errs, m.mb.RecordMysqlRowLockTimeAverageDataPoint(now, v))
+		case "Innodb_row_lock_time_max":
+			addPartialIfError(errs, m.mb.RecordMysqlRowLockTimeMaxDataPoint(now, v))
 
 		// row_operations
 		case "Innodb_rows_deleted":
@@ -553,6 +560,9 @@ func (m *mySQLScraper) scrapeReplicaStatusStats(now pcommon.Timestamp) {
 		}
 
 		m.mb.RecordMysqlReplicaSQLDelayDataPoint(now, s.sqlDelay)
+		m.mb.RecordMysqlReplicaThreadRunningDataPoint(now, boolToInt(s.replicaIORunning == "Yes"), metadata.AttributeReplicaThreadIo)
+		m.mb.RecordMysqlReplicaThreadRunningDataPoint(now, boolToInt(s.replicaSQLRunning == "Yes"), metadata.AttributeReplicaThreadSql)
+		m.mb.RecordMysqlReplicaRelayLogSizeDataPoint(now, s.relayLogSpace)
 	}
 }
 
@@ -594,6 +604,39 @@ func (m *mySQLScraper) recordDataUsage(now pcommon.Timestamp, globalStats map[st
 	m.mb.RecordMysqlBufferPoolUsageDataPoint(now, data-dirty, metadata.AttributeBufferPoolDataClean)
 }
 
+// recordBufferPoolEfficiency records the ratio of the read requests satisfied by the buffer pool,
+// and the ratio of its pages in use, when their metrics are enabled.
+func (m *mySQLScraper) recordBufferPoolEfficiency(now pcommon.Timestamp, globalStats map[string]string, errors *scrapererror.ScrapeErrors) {
+	if m.config.MetricsBuilderConfig.Metrics.MysqlBufferPoolHitRatio.Enabled {
+		requests, err := parseInt(globalStats["Innodb_buffer_pool_read_requests"])
+		if err != nil {
+			errors.AddPartial(1, err)
+		} else if reads, err := parseInt(globalStats["Innodb_buffer_pool_reads"]); err != nil {
+			errors.AddPartial(1, err)
+		} else if requests > 0 {
+			m.mb.RecordMysqlBufferPoolHitRatioDataPoint(now, 1-float64(reads)/float64(requests))
+		}
+	}
+
+	if m.config.MetricsBuilderConfig.Metrics.MysqlBufferPoolUtilization.Enabled {
+		total, err := parseInt(globalStats["Innodb_buffer_pool_pages_total"])
+		if err != nil {
+			errors.AddPartial(1, err)
+		} else if free, err := parseInt(globalStats["Innodb_buffer_pool_pages_free"]); err != nil {
+			errors.AddPartial(1, err)
+		} else if total > 0 {
+			m.mb.RecordMysqlBufferPoolUtilizationDataPoint(now, float64(total-free)/float64(total))
+		}
+	}
+}
+
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 // parseInt converts string to int64.
 func parseInt(value string) (int64, error) {
 	return strconv.ParseInt(value, 10, 64)
@@ -50,6 +50,14 @@ func TestScrape(t *testing.T) {
 
 		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaSQLDelay.Enabled = true
 		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaTimeBehindSource.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaThreadRunning.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaRelayLogSize.Enabled = true
+
+		cfg.MetricsBuilderConfig.Metrics.MysqlRowLockCurrentWaits.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlRowLockTimeAverage.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlRowLockTimeMax.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlBufferPoolHitRatio.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlBufferPoolUtilization.Enabled = true
 
 		cfg.MetricsBuilderConfig.Metrics.MysqlConnectionCount.Enabled = true
 
@@ -4,14 +4,39 @@
 package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
 )
 
+// The APIs the status of nginx can be scraped from.
+const (
+	// apiStubStatus is the status page of the ngx_http_stub_status_module.
+	apiStubStatus = "stub_status"
+	// apiPlus is the REST API of nginx Plus, the endpoint being the URL of a version of
+	// the API, e.g. http://localhost:8080/api/8.
+	apiPlus = "plus"
+	// apiVTS is the JSON status page of the nginx-module-vts community module, e.g.
+	// http://localhost:80/status/format/json.
+	apiVTS = "vts"
+)
+
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
-	MetricsBuilderConfig                    metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	// API is the API of the endpoint: stub_status, plus or vts.
+	API                  string                        `mapstructure:"api"`
+	MetricsBuilderConfig metadata.MetricsBuilderConfig `mapstructure:",squash"`
+}
+
+func (cfg *Config) Validate() error {
+	switch cfg.API {
+	case "", apiStubStatus, apiPlus, apiVTS:
+		return nil
+	default:
+		return fmt.Errorf("unknown api %q, expected one of %s, %s or %s", cfg.API, apiStubStatus, apiPlus, apiVTS)
+	}
 }
@@ -27,3 +27,26 @@ func TestLoadConfig(t *testing.T) {
 
 	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
 }
+
+func TestLoadConfigPlus(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "plus").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.Endpoint = "http://localhost:8080/api/8"
+	expected.API = apiPlus
+	assert.Equal(t, expected, cfg)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.API = "status"
+	assert.EqualError(t, component.ValidateConfig(cfg), `unknown api "status", expected one of stub_status, plus or vts`)
+}
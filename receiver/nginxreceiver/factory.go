@@ -47,6 +47,7 @@ func createDefaultConfig() component.Config {
 			Endpoint: "http://localhost:80/status",
 			Timeout:  10 * time.Second,
 		},
+		API:                  apiStubStatus,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 	}
 }
@@ -25,11 +25,19 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for nginx metrics.
 type MetricsConfig struct {
-	NginxConnectionsAccepted MetricConfig `mapstructure:"nginx.connections_accepted"`
-	NginxConnectionsCurrent  MetricConfig `mapstructure:"nginx.connections_current"`
-	NginxConnectionsHandled  MetricConfig `mapstructure:"nginx.connections_handled"`
-	NginxRequests            MetricConfig `mapstructure:"nginx.requests"`
-	TempConnectionsCurrent   MetricConfig `mapstructure:"temp.connections_current"`
+	NginxConnectionsAccepted      MetricConfig `mapstructure:"nginx.connections_accepted"`
+	NginxConnectionsCurrent       MetricConfig `mapstructure:"nginx.connections_current"`
+	NginxConnectionsHandled       MetricConfig `mapstructure:"nginx.connections_handled"`
+	NginxRequests                 MetricConfig `mapstructure:"nginx.requests"`
+	NginxServerZoneIo             MetricConfig `mapstructure:"nginx.server_zone.io"`
+	NginxServerZoneRequestTime    MetricConfig `mapstructure:"nginx.server_zone.request_time"`
+	NginxServerZoneRequests       MetricConfig `mapstructure:"nginx.server_zone.requests"`
+	NginxServerZoneResponses      MetricConfig `mapstructure:"nginx.server_zone.responses"`
+	NginxUpstreamPeerIo           MetricConfig `mapstructure:"nginx.upstream.peer.io"`
+	NginxUpstreamPeerRequests     MetricConfig `mapstructure:"nginx.upstream.peer.requests"`
+	NginxUpstreamPeerResponseTime MetricConfig `mapstructure:"nginx.upstream.peer.response_time"`
+	NginxUpstreamPeerResponses    MetricConfig `mapstructure:"nginx.upstream.peer.responses"`
+	TempConnectionsCurrent        MetricConfig `mapstructure:"temp.connections_current"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -46,6 +54,30 @@ func DefaultMetricsConfig() MetricsConfig {
 		NginxRequests: MetricConfig{
 			Enabled: true,
 		},
+		NginxServerZoneIo: MetricConfig{
+			Enabled: true,
+		},
+		NginxServerZoneRequestTime: MetricConfig{
+			Enabled: true,
+		},
+		NginxServerZoneRequests: MetricConfig{
+			Enabled: true,
+		},
+		NginxServerZoneResponses: MetricConfig{
+			Enabled: true,
+		},
+		NginxUpstreamPeerIo: MetricConfig{
+			Enabled: true,
+		},
+		NginxUpstreamPeerRequests: MetricConfig{
+			Enabled: true,
+		},
+		NginxUpstreamPeerResponseTime: MetricConfig{
+			Enabled: true,
+		},
+		NginxUpstreamPeerResponses: MetricConfig{
+			Enabled: true,
+		},
 		TempConnectionsCurrent: MetricConfig{
 			Enabled: true,
 		},
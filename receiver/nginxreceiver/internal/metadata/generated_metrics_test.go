@@ -70,6 +70,38 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordNginxRequestsDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxServerZoneIoDataPoint(ts, 1, "zone-val", AttributeDirectionReceived)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxServerZoneRequestTimeDataPoint(ts, 1, "zone-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxServerZoneRequestsDataPoint(ts, 1, "zone-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxServerZoneResponsesDataPoint(ts, 1, "zone-val", AttributeStatusRange1xx)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerIoDataPoint(ts, 1, "upstream-val", "peer-val", AttributeDirectionReceived)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerRequestsDataPoint(ts, 1, "upstream-val", "peer-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerResponseTimeDataPoint(ts, 1, "upstream-val", "peer-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerResponsesDataPoint(ts, 1, "upstream-val", "peer-val", AttributeStatusRange1xx)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordTempConnectionsCurrentDataPoint(ts, 1, AttributeStateActive)
@@ -155,6 +187,162 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "nginx.server_zone.io":
+					assert.False(t, validatedMetrics["nginx.server_zone.io"], "Found a duplicate in the metrics slice: nginx.server_zone.io")
+					validatedMetrics["nginx.server_zone.io"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total amount of data received from and sent to the clients by the server zone. Only reported by the nginx Plus API and the VTS module.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("zone")
+					assert.True(t, ok)
+					assert.EqualValues(t, "zone-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "received", attrVal.Str())
+				case "nginx.server_zone.request_time":
+					assert.False(t, validatedMetrics["nginx.server_zone.request_time"], "Found a duplicate in the metrics slice: nginx.server_zone.request_time")
+					validatedMetrics["nginx.server_zone.request_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The average time to process the requests of the server zone. Only reported by the VTS module.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("zone")
+					assert.True(t, ok)
+					assert.EqualValues(t, "zone-val", attrVal.Str())
+				case "nginx.server_zone.requests":
+					assert.False(t, validatedMetrics["nginx.server_zone.requests"], "Found a duplicate in the metrics slice: nginx.server_zone.requests")
+					validatedMetrics["nginx.server_zone.requests"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of client requests received by the server zone. Only reported by the nginx Plus API and the VTS module.", ms.At(i).Description())
+					assert.Equal(t, "requests", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("zone")
+					assert.True(t, ok)
+					assert.EqualValues(t, "zone-val", attrVal.Str())
+				case "nginx.server_zone.responses":
+					assert.False(t, validatedMetrics["nginx.server_zone.responses"], "Found a duplicate in the metrics slice: nginx.server_zone.responses")
+					validatedMetrics["nginx.server_zone.responses"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of responses sent to the clients by the server zone, by status code range. Only reported by the nginx Plus API and the VTS module.", ms.At(i).Description())
+					assert.Equal(t, "responses", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("zone")
+					assert.True(t, ok)
+					assert.EqualValues(t, "zone-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("status_range")
+					assert.True(t, ok)
+					assert.EqualValues(t, "1xx", attrVal.Str())
+				case "nginx.upstream.peer.io":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.io"], "Found a duplicate in the metrics slice: nginx.upstream.peer.io")
+					validatedMetrics["nginx.upstream.peer.io"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total amount of data received from and sent to the upstream server. Only reported by the nginx Plus API and the VTS module.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("upstream")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("peer")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "received", attrVal.Str())
+				case "nginx.upstream.peer.requests":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.requests"], "Found a duplicate in the metrics slice: nginx.upstream.peer.requests")
+					validatedMetrics["nginx.upstream.peer.requests"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of client requests forwarded to the upstream server. Only reported by the nginx Plus API and the VTS module.", ms.At(i).Description())
+					assert.Equal(t, "requests", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("upstream")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("peer")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer-val", attrVal.Str())
+				case "nginx.upstream.peer.response_time":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.response_time"], "Found a duplicate in the metrics slice: nginx.upstream.peer.response_time")
+					validatedMetrics["nginx.upstream.peer.response_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The average time to receive the responses from the upstream server. Only reported by the nginx Plus API and the VTS module.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("upstream")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("peer")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer-val", attrVal.Str())
+				case "nginx.upstream.peer.responses":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.responses"], "Found a duplicate in the metrics slice: nginx.upstream.peer.responses")
+					validatedMetrics["nginx.upstream.peer.responses"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of responses received from the upstream server, by status code range. Only reported by the nginx Plus API and the VTS module.", ms.At(i).Description())
+					assert.Equal(t, "responses", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("upstream")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("peer")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("status_range")
+					assert.True(t, ok)
+					assert.EqualValues(t, "1xx", attrVal.Str())
 				case "temp.connections_current":
 					assert.False(t, validatedMetrics["temp.connections_current"], "Found a duplicate in the metrics slice: temp.connections_current")
 					validatedMetrics["temp.connections_current"] = true
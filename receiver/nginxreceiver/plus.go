@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// plusConnections is the /connections endpoint of the nginx Plus API.
+type plusConnections struct {
+	Accepted int64 `json:"accepted"`
+	Dropped  int64 `json:"dropped"`
+	Active   int64 `json:"active"`
+	Idle     int64 `json:"idle"`
+}
+
+// plusRequests is the /http/requests endpoint of the nginx Plus API.
+type plusRequests struct {
+	Total int64 `json:"total"`
+}
+
+// plusServerZone is a server zone of the /http/server_zones endpoint of the nginx Plus API.
+type plusServerZone struct {
+	Requests  int64           `json:"requests"`
+	Responses statusResponses `json:"responses"`
+	Received  int64           `json:"received"`
+	Sent      int64           `json:"sent"`
+}
+
+// plusUpstream is an upstream group of the /http/upstreams endpoint of the nginx Plus API.
+type plusUpstream struct {
+	Peers []plusPeer `json:"peers"`
+}
+
+type plusPeer struct {
+	Server       string          `json:"server"`
+	Requests     int64           `json:"requests"`
+	Responses    statusResponses `json:"responses"`
+	Received     int64           `json:"received"`
+	Sent         int64           `json:"sent"`
+	ResponseTime int64           `json:"response_time"`
+}
+
+// scrapePlus scrapes the nginx Plus API, whose endpoints are relative to the versioned API
+// root of the receiver endpoint. The endpoints failing to be fetched are reported as
+// partial errors.
+func (r *nginxScraper) scrapePlus(ctx context.Context) (pmetric.Metrics, error) {
+	var errs scrapererror.ScrapeErrors
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	var connections plusConnections
+	if err := r.getJSON(ctx, "/connections", &connections); err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx Plus connections", zap.Error(err))
+		errs.AddPartial(4, err)
+	} else {
+		r.mb.RecordNginxConnectionsAcceptedDataPoint(now, connections.Accepted)
+		r.mb.RecordNginxConnectionsHandledDataPoint(now, connections.Accepted-connections.Dropped)
+		r.recordConnectionsCurrent(now, connections.Active, metadata.AttributeStateActive)
+		r.recordConnectionsCurrent(now, connections.Idle, metadata.AttributeStateWaiting)
+	}
+
+	var requests plusRequests
+	if err := r.getJSON(ctx, "/http/requests", &requests); err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx Plus requests", zap.Error(err))
+		errs.AddPartial(1, err)
+	} else {
+		r.mb.RecordNginxRequestsDataPoint(now, requests.Total)
+	}
+
+	var zones map[string]plusServerZone
+	if err := r.getJSON(ctx, "/http/server_zones", &zones); err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx Plus server zones", zap.Error(err))
+		errs.AddPartial(3, err)
+	} else {
+		for name, zone := range zones {
+			r.mb.RecordNginxServerZoneRequestsDataPoint(now, zone.Requests, name)
+			zone.Responses.record(now, func(ts pcommon.Timestamp, val int64, status metadata.AttributeStatusRange) {
+				r.mb.RecordNginxServerZoneResponsesDataPoint(ts, val, name, status)
+			})
+			r.mb.RecordNginxServerZoneIoDataPoint(now, zone.Received, name, metadata.AttributeDirectionReceived)
+			r.mb.RecordNginxServerZoneIoDataPoint(now, zone.Sent, name, metadata.AttributeDirectionSent)
+		}
+	}
+
+	var upstreams map[string]plusUpstream
+	if err := r.getJSON(ctx, "/http/upstreams", &upstreams); err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx Plus upstreams", zap.Error(err))
+		errs.AddPartial(4, err)
+	} else {
+		for name, upstream := range upstreams {
+			for _, peer := range upstream.Peers {
+				r.recordUpstreamPeer(now, name, peer.Server, peer.Requests, peer.Responses, peer.Received, peer.Sent, peer.ResponseTime)
+			}
+		}
+	}
+
+	return r.mb.Emit(), errs.Combine()
+}
+
+func (r *nginxScraper) recordUpstreamPeer(now pcommon.Timestamp, upstream, peer string, requests int64, responses statusResponses, received, sent, responseTime int64) {
+	r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, requests, upstream, peer)
+	responses.record(now, func(ts pcommon.Timestamp, val int64, status metadata.AttributeStatusRange) {
+		r.mb.RecordNginxUpstreamPeerResponsesDataPoint(ts, val, upstream, peer, status)
+	})
+	r.mb.RecordNginxUpstreamPeerIoDataPoint(now, received, upstream, peer, metadata.AttributeDirectionReceived)
+	r.mb.RecordNginxUpstreamPeerIoDataPoint(now, sent, upstream, peer, metadata.AttributeDirectionSent)
+	r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, responseTime, upstream, peer)
+}
@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// statusResponses are the numbers of responses by status code range, as reported by the
+// nginx Plus API and the VTS module.
+type statusResponses struct {
+	Responses1xx int64 `json:"1xx"`
+	Responses2xx int64 `json:"2xx"`
+	Responses3xx int64 `json:"3xx"`
+	Responses4xx int64 `json:"4xx"`
+	Responses5xx int64 `json:"5xx"`
+}
+
+// record calls record with the number of responses of each status code range.
+func (s statusResponses) record(now pcommon.Timestamp, record func(pcommon.Timestamp, int64, metadata.AttributeStatusRange)) {
+	record(now, s.Responses1xx, metadata.AttributeStatusRange1xx)
+	record(now, s.Responses2xx, metadata.AttributeStatusRange2xx)
+	record(now, s.Responses3xx, metadata.AttributeStatusRange3xx)
+	record(now, s.Responses4xx, metadata.AttributeStatusRange4xx)
+	record(now, s.Responses5xx, metadata.AttributeStatusRange5xx)
+}
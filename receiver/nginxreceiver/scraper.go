@@ -5,7 +5,10 @@ package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/nginxinc/nginx-prometheus-exporter/client"
@@ -54,7 +57,18 @@ func (r *nginxScraper) start(_ context.Context, host component.Host) error {
 	return nil
 }
 
-func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
+func (r *nginxScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	switch r.cfg.API {
+	case apiPlus:
+		return r.scrapePlus(ctx)
+	case apiVTS:
+		return r.scrapeVTS(ctx)
+	default:
+		return r.scrapeStubStatus()
+	}
+}
+
+func (r *nginxScraper) scrapeStubStatus() (pmetric.Metrics, error) {
 	// Init client in scrape method in case there are transient errors in the constructor.
 	if r.client == nil {
 		var err error
@@ -76,18 +90,41 @@ func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	r.mb.RecordNginxRequestsDataPoint(now, stats.Requests)
 	r.mb.RecordNginxConnectionsAcceptedDataPoint(now, stats.Connections.Accepted)
 	r.mb.RecordNginxConnectionsHandledDataPoint(now, stats.Connections.Handled)
+	r.recordConnectionsCurrent(now, stats.Connections.Active, metadata.AttributeStateActive)
+	r.recordConnectionsCurrent(now, stats.Connections.Reading, metadata.AttributeStateReading)
+	r.recordConnectionsCurrent(now, stats.Connections.Writing, metadata.AttributeStateWriting)
+	r.recordConnectionsCurrent(now, stats.Connections.Waiting, metadata.AttributeStateWaiting)
+
+	return r.mb.Emit(), nil
+}
 
+// recordConnectionsCurrent records the current connections in the state as a sum or as a
+// gauge, depending on the connectionsAsSum feature gate.
+func (r *nginxScraper) recordConnectionsCurrent(now pcommon.Timestamp, val int64, state metadata.AttributeState) {
 	if connectorsAsSumGate.IsEnabled() {
-		r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Active, metadata.AttributeStateActive)
-		r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Reading, metadata.AttributeStateReading)
-		r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Writing, metadata.AttributeStateWriting)
-		r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Waiting, metadata.AttributeStateWaiting)
+		r.mb.RecordNginxConnectionsCurrentDataPoint(now, val, state)
 	} else {
-		r.mb.RecordTempConnectionsCurrentDataPoint(now, stats.Connections.Active, metadata.AttributeStateActive)
-		r.mb.RecordTempConnectionsCurrentDataPoint(now, stats.Connections.Reading, metadata.AttributeStateReading)
-		r.mb.RecordTempConnectionsCurrentDataPoint(now, stats.Connections.Writing, metadata.AttributeStateWriting)
-		r.mb.RecordTempConnectionsCurrentDataPoint(now, stats.Connections.Waiting, metadata.AttributeStateWaiting)
+		r.mb.RecordTempConnectionsCurrentDataPoint(now, val, state)
 	}
+}
 
-	return r.mb.Emit(), nil
+// getJSON decodes the JSON document at the path of the endpoint into v.
+func (r *nginxScraper) getJSON(ctx context.Context, path string, v interface{}) error {
+	url := strings.TrimSuffix(r.cfg.HTTPClientSettings.Endpoint, "/") + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get %v: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected 200 response from %v, got %d", url, resp.StatusCode)
+	}
+	if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode the response of %v: %w", url, err)
+	}
+	return nil
 }
@@ -18,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -78,6 +79,84 @@ func TestScraperWithConnectionsAsGauge(t *testing.T) {
 		pmetrictest.IgnoreMetricsOrder()))
 }
 
+func TestScraperPlus(t *testing.T) {
+	nginxMock := newMockPlusServer(t, "")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/api/8"
+	cfg.API = apiPlus
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	scraper := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+
+	err := scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected_plus.yaml")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreMetricsOrder()))
+}
+
+func TestScraperPlusPartialError(t *testing.T) {
+	nginxMock := newMockPlusServer(t, "/api/8/http/upstreams")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/api/8/"
+	cfg.API = apiPlus
+
+	scraper := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+
+	err := scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Contains(t, err.Error(), "expected 200 response from "+nginxMock.URL+"/api/8/http/upstreams, got 404")
+	// the metrics of the other endpoints are still reported
+	require.Equal(t, 7, actualMetrics.MetricCount())
+}
+
+func TestScraperVTS(t *testing.T) {
+	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/status/format/json" {
+			http.ServeFile(rw, req, filepath.Join("testdata", "scraper", "vts.json"))
+			return
+		}
+		rw.WriteHeader(404)
+	}))
+	defer nginxMock.Close()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/status/format/json"
+	cfg.API = apiVTS
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	scraper := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+
+	err := scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected_vts.yaml")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreMetricsOrder()))
+}
+
 func TestScraperError(t *testing.T) {
 	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.Path == "/status" {
@@ -142,3 +221,23 @@ Reading: 6 Writing: 179 Waiting: 106
 		rw.WriteHeader(404)
 	}))
 }
+
+// newMockPlusServer serves the nginx Plus API from the testdata, failing the failedPath.
+func newMockPlusServer(t *testing.T, failedPath string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		files := map[string]string{
+			"/api/8/connections":       "connections.json",
+			"/api/8/http/requests":     "requests.json",
+			"/api/8/http/server_zones": "server_zones.json",
+			"/api/8/http/upstreams":    "upstreams.json",
+		}
+		file, ok := files[req.URL.Path]
+		if !ok || req.URL.Path == failedPath {
+			rw.WriteHeader(404)
+			return
+		}
+		http.ServeFile(rw, req, filepath.Join("testdata", "scraper", "plus", file))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// vtsTotalZone is the server zone of the VTS module aggregating all the server zones.
+const vtsTotalZone = "*"
+
+// vtsStatus is the JSON status page of the nginx-module-vts module.
+type vtsStatus struct {
+	Connections struct {
+		Active   int64 `json:"active"`
+		Reading  int64 `json:"reading"`
+		Writing  int64 `json:"writing"`
+		Waiting  int64 `json:"waiting"`
+		Accepted int64 `json:"accepted"`
+		Handled  int64 `json:"handled"`
+		Requests int64 `json:"requests"`
+	} `json:"connections"`
+	ServerZones   map[string]vtsServerZone     `json:"serverZones"`
+	UpstreamZones map[string][]vtsUpstreamPeer `json:"upstreamZones"`
+}
+
+type vtsServerZone struct {
+	RequestCounter int64           `json:"requestCounter"`
+	InBytes        int64           `json:"inBytes"`
+	OutBytes       int64           `json:"outBytes"`
+	Responses      statusResponses `json:"responses"`
+	RequestMsec    int64           `json:"requestMsec"`
+}
+
+type vtsUpstreamPeer struct {
+	Server         string          `json:"server"`
+	RequestCounter int64           `json:"requestCounter"`
+	InBytes        int64           `json:"inBytes"`
+	OutBytes       int64           `json:"outBytes"`
+	Responses      statusResponses `json:"responses"`
+	ResponseMsec   int64           `json:"responseMsec"`
+}
+
+// scrapeVTS scrapes the JSON status page of the VTS module, the receiver endpoint being the
+// URL of the page, such as http://localhost/status/format/json.
+func (r *nginxScraper) scrapeVTS(ctx context.Context) (pmetric.Metrics, error) {
+	var status vtsStatus
+	if err := r.getJSON(ctx, "", &status); err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx VTS status", zap.Error(err))
+		return pmetric.Metrics{}, err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	r.mb.RecordNginxRequestsDataPoint(now, status.Connections.Requests)
+	r.mb.RecordNginxConnectionsAcceptedDataPoint(now, status.Connections.Accepted)
+	r.mb.RecordNginxConnectionsHandledDataPoint(now, status.Connections.Handled)
+	r.recordConnectionsCurrent(now, status.Connections.Active, metadata.AttributeStateActive)
+	r.recordConnectionsCurrent(now, status.Connections.Reading, metadata.AttributeStateReading)
+	r.recordConnectionsCurrent(now, status.Connections.Writing, metadata.AttributeStateWriting)
+	r.recordConnectionsCurrent(now, status.Connections.Waiting, metadata.AttributeStateWaiting)
+
+	for name, zone := range status.ServerZones {
+		// the aggregate zone would count the requests twice
+		if name == vtsTotalZone {
+			continue
+		}
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, zone.RequestCounter, name)
+		zone.Responses.record(now, func(ts pcommon.Timestamp, val int64, status metadata.AttributeStatusRange) {
+			r.mb.RecordNginxServerZoneResponsesDataPoint(ts, val, name, status)
+		})
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.InBytes, name, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.OutBytes, name, metadata.AttributeDirectionSent)
+		r.mb.RecordNginxServerZoneRequestTimeDataPoint(now, zone.RequestMsec, name)
+	}
+
+	for name, peers := range status.UpstreamZones {
+		for _, peer := range peers {
+			r.recordUpstreamPeer(now, name, peer.Server, peer.RequestCounter, peer.Responses, peer.InBytes, peer.OutBytes, peer.ResponseMsec)
+		}
+	}
+
+	return r.mb.Emit(), nil
+}
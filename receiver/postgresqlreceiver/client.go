@@ -45,6 +45,7 @@ type client interface {
 	getLatestWalAgeSeconds(ctx context.Context) (int64, error)
 	getMaxConnections(ctx context.Context) (int64, error)
 	getIndexStats(ctx context.Context, database string) (map[indexIdentifer]indexStat, error)
+	getQueryStats(ctx context.Context, orderBy string, limit int) ([]queryStats, error)
 	listDatabases(ctx context.Context) ([]string, error)
 }
 
@@ -362,6 +363,59 @@ func (c *postgreSQLClient) getIndexStats(ctx context.Context, database string) (
 	return stats, multierr.Combine(errs...)
 }
 
+type queryStats struct {
+	query     string
+	calls     int64
+	totalTime float64
+	rows      int64
+}
+
+// getQueryStats returns the statistics of the queries of the database of the client taking the
+// most total time, or having the most calls or rows, from the pg_stat_statements extension. The
+// statistics collected for the different users running a query are summed up.
+func (c *postgreSQLClient) getQueryStats(ctx context.Context, orderBy string, limit int) ([]queryStats, error) {
+	var version int
+	if err := c.client.QueryRowContext(ctx, "SELECT current_setting('server_version_num')::int;").Scan(&version); err != nil {
+		return nil, err
+	}
+	// the total_time column was split into total_plan_time and total_exec_time in PostgreSQL 13
+	totalTimeColumn := "total_exec_time"
+	if version < 130000 {
+		totalTimeColumn = "total_time"
+	}
+	orderColumn := map[string]string{
+		orderByTotalTime: "total_time",
+		orderByCalls:     "calls",
+		orderByRows:      "rows",
+	}[orderBy]
+	query := fmt.Sprintf(`SELECT query,
+	sum(calls) AS calls,
+	sum(%s) AS total_time,
+	sum(rows) AS rows
+	FROM pg_stat_statements
+	WHERE dbid = (SELECT oid FROM pg_database WHERE datname = current_database())
+	GROUP BY query
+	ORDER BY %s DESC
+	LIMIT %d;`, totalTimeColumn, orderColumn, limit)
+
+	rows, err := c.client.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_stat_statements: %w", err)
+	}
+	defer rows.Close()
+	var stats []queryStats
+	var errs error
+	for rows.Next() {
+		var qs queryStats
+		if err = rows.Scan(&qs.query, &qs.calls, &qs.totalTime, &qs.rows); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		stats = append(stats, qs)
+	}
+	return stats, errs
+}
+
 type bgStat struct {
 	checkpointsReq       int64
 	checkpointsScheduled int64
@@ -24,13 +24,40 @@ const (
 	ErrNotSupported        = "invalid config: field '%s' not supported"
 	ErrTransportsSupported = "invalid config: 'transport' must be 'tcp' or 'unix'"
 	ErrHostPort            = "invalid config: 'endpoint' must be in the form <host>:<port> no matter what 'transport' is configured"
+	ErrQueryStatsTopN      = "invalid config: 'query_stats.top_n' must be between 1 and %d"
+	ErrQueryStatsOrderBy   = "invalid config: 'query_stats.order_by' must be 'total_time', 'calls' or 'rows'"
+	ErrQueryStatsLength    = "invalid config: 'query_stats.max_query_length' must be positive"
 )
 
+// maxTopQueries is the maximum number of queries reported by database, limiting the
+// cardinality of the query metrics.
+const maxTopQueries = 200
+
+// The statistics the top queries can be selected by.
+const (
+	orderByTotalTime = "total_time"
+	orderByCalls     = "calls"
+	orderByRows      = "rows"
+)
+
+// QueryStatsConfig defines the collection of the statistics of the top queries of each
+// database from the pg_stat_statements extension, when the postgresql.query.* metrics
+// are enabled.
+type QueryStatsConfig struct {
+	// TopN is the number of queries of each database reported at each scrape.
+	TopN int `mapstructure:"top_n"`
+	// OrderBy is the statistic the top queries are selected by: total_time, calls or rows.
+	OrderBy string `mapstructure:"order_by"`
+	// MaxQueryLength is the number of characters the normalized query texts are truncated to.
+	MaxQueryLength int `mapstructure:"max_query_length"`
+}
+
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	Username                                string                         `mapstructure:"username"`
 	Password                                configopaque.String            `mapstructure:"password"`
 	Databases                               []string                       `mapstructure:"databases"`
+	QueryStats                              QueryStatsConfig               `mapstructure:"query_stats"`
 	confignet.NetAddr                       `mapstructure:",squash"`       // provides Endpoint and Transport
 	configtls.TLSClientSetting              `mapstructure:"tls,omitempty"` // provides SSL details
 	metadata.MetricsBuilderConfig           `mapstructure:",squash"`
@@ -56,6 +83,18 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, fmt.Errorf(ErrNotSupported, "MinVersion"))
 	}
 
+	if cfg.QueryStats.TopN < 1 || cfg.QueryStats.TopN > maxTopQueries {
+		err = multierr.Append(err, fmt.Errorf(ErrQueryStatsTopN, maxTopQueries))
+	}
+	switch cfg.QueryStats.OrderBy {
+	case orderByTotalTime, orderByCalls, orderByRows:
+	default:
+		err = multierr.Append(err, errors.New(ErrQueryStatsOrderBy))
+	}
+	if cfg.QueryStats.MaxQueryLength <= 0 {
+		err = multierr.Append(err, errors.New(ErrQueryStatsLength))
+	}
+
 	switch cfg.Transport {
 	case "tcp", "unix":
 		_, _, endpointErr := net.SplitHostPort(cfg.Endpoint)
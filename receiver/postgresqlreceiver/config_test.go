@@ -88,6 +88,19 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf(ErrNotSupported, "MinVersion"),
 			),
 		},
+		{
+			desc: "bad query stats",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.QueryStats = QueryStatsConfig{TopN: 500, OrderBy: "mean_time"}
+			},
+			expected: multierr.Combine(
+				fmt.Errorf(ErrQueryStatsTopN, maxTopQueries),
+				errors.New(ErrQueryStatsOrderBy),
+				errors.New(ErrQueryStatsLength),
+			),
+		},
 		{
 			desc: "no error",
 			defaultConfigModifier: func(cfg *Config) {
@@ -140,6 +153,11 @@ func TestLoadConfig(t *testing.T) {
 		expected.Password = "${env:POSTGRESQL_PASSWORD}"
 		expected.Databases = []string{"otel"}
 		expected.CollectionInterval = 10 * time.Second
+		expected.QueryStats = QueryStatsConfig{
+			TopN:           25,
+			OrderBy:        "calls",
+			MaxQueryLength: 256,
+		}
 		expected.TLSClientSetting = configtls.TLSClientSetting{
 			Insecure:           false,
 			InsecureSkipVerify: false,
@@ -38,6 +38,11 @@ func createDefaultConfig() component.Config {
 			Insecure:           false,
 			InsecureSkipVerify: true,
 		},
+		QueryStats: QueryStatsConfig{
+			TopN:           10,
+			OrderBy:        orderByTotalTime,
+			MaxQueryLength: 1024,
+		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 	}
 }
@@ -39,6 +39,9 @@ type MetricsConfig struct {
 	PostgresqlIndexScans               MetricConfig `mapstructure:"postgresql.index.scans"`
 	PostgresqlIndexSize                MetricConfig `mapstructure:"postgresql.index.size"`
 	PostgresqlOperations               MetricConfig `mapstructure:"postgresql.operations"`
+	PostgresqlQueryCalls               MetricConfig `mapstructure:"postgresql.query.calls"`
+	PostgresqlQueryDuration            MetricConfig `mapstructure:"postgresql.query.duration"`
+	PostgresqlQueryRows                MetricConfig `mapstructure:"postgresql.query.rows"`
 	PostgresqlReplicationDataDelay     MetricConfig `mapstructure:"postgresql.replication.data_delay"`
 	PostgresqlRollbacks                MetricConfig `mapstructure:"postgresql.rollbacks"`
 	PostgresqlRows                     MetricConfig `mapstructure:"postgresql.rows"`
@@ -93,6 +96,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		PostgresqlOperations: MetricConfig{
 			Enabled: true,
 		},
+		PostgresqlQueryCalls: MetricConfig{
+			Enabled: false,
+		},
+		PostgresqlQueryDuration: MetricConfig{
+			Enabled: false,
+		},
+		PostgresqlQueryRows: MetricConfig{
+			Enabled: false,
+		},
 		PostgresqlReplicationDataDelay: MetricConfig{
 			Enabled: true,
 		},
@@ -40,6 +40,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					PostgresqlIndexScans:               MetricConfig{Enabled: true},
 					PostgresqlIndexSize:                MetricConfig{Enabled: true},
 					PostgresqlOperations:               MetricConfig{Enabled: true},
+					PostgresqlQueryCalls:               MetricConfig{Enabled: true},
+					PostgresqlQueryDuration:            MetricConfig{Enabled: true},
+					PostgresqlQueryRows:                MetricConfig{Enabled: true},
 					PostgresqlReplicationDataDelay:     MetricConfig{Enabled: true},
 					PostgresqlRollbacks:                MetricConfig{Enabled: true},
 					PostgresqlRows:                     MetricConfig{Enabled: true},
@@ -74,6 +77,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					PostgresqlIndexScans:               MetricConfig{Enabled: false},
 					PostgresqlIndexSize:                MetricConfig{Enabled: false},
 					PostgresqlOperations:               MetricConfig{Enabled: false},
+					PostgresqlQueryCalls:               MetricConfig{Enabled: false},
+					PostgresqlQueryDuration:            MetricConfig{Enabled: false},
+					PostgresqlQueryRows:                MetricConfig{Enabled: false},
 					PostgresqlReplicationDataDelay:     MetricConfig{Enabled: false},
 					PostgresqlRollbacks:                MetricConfig{Enabled: false},
 					PostgresqlRows:                     MetricConfig{Enabled: false},
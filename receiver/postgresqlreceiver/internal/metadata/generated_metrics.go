@@ -967,6 +967,168 @@ func newMetricPostgresqlOperations(cfg MetricConfig) metricPostgresqlOperations
 	return m
 }
 
+type metricPostgresqlQueryCalls struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.calls metric with initial data.
+func (m *metricPostgresqlQueryCalls) init() {
+	m.data.SetName("postgresql.query.calls")
+	m.data.SetDescription("The number of times the query was executed.")
+	m.data.SetUnit("{calls}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryCalls) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryAttributeValue string, queryFingerprintAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+	dp.Attributes().PutStr("query_fingerprint", queryFingerprintAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryCalls) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryCalls(cfg MetricConfig) metricPostgresqlQueryCalls {
+	m := metricPostgresqlQueryCalls{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.duration metric with initial data.
+func (m *metricPostgresqlQueryDuration) init() {
+	m.data.SetName("postgresql.query.duration")
+	m.data.SetDescription("The total time spent executing the query.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, queryAttributeValue string, queryFingerprintAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+	dp.Attributes().PutStr("query_fingerprint", queryFingerprintAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryDuration) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryDuration(cfg MetricConfig) metricPostgresqlQueryDuration {
+	m := metricPostgresqlQueryDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryRows struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.rows metric with initial data.
+func (m *metricPostgresqlQueryRows) init() {
+	m.data.SetName("postgresql.query.rows")
+	m.data.SetDescription("The total number of rows retrieved or affected by the query.")
+	m.data.SetUnit("{rows}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryRows) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryAttributeValue string, queryFingerprintAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+	dp.Attributes().PutStr("query_fingerprint", queryFingerprintAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryRows) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryRows) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryRows(cfg MetricConfig) metricPostgresqlQueryRows {
+	m := metricPostgresqlQueryRows{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlReplicationDataDelay struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1402,6 +1564,9 @@ type MetricsBuilder struct {
 	metricPostgresqlIndexScans               metricPostgresqlIndexScans
 	metricPostgresqlIndexSize                metricPostgresqlIndexSize
 	metricPostgresqlOperations               metricPostgresqlOperations
+	metricPostgresqlQueryCalls               metricPostgresqlQueryCalls
+	metricPostgresqlQueryDuration            metricPostgresqlQueryDuration
+	metricPostgresqlQueryRows                metricPostgresqlQueryRows
 	metricPostgresqlReplicationDataDelay     metricPostgresqlReplicationDataDelay
 	metricPostgresqlRollbacks                metricPostgresqlRollbacks
 	metricPostgresqlRows                     metricPostgresqlRows
@@ -1442,6 +1607,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricPostgresqlIndexScans:               newMetricPostgresqlIndexScans(mbc.Metrics.PostgresqlIndexScans),
 		metricPostgresqlIndexSize:                newMetricPostgresqlIndexSize(mbc.Metrics.PostgresqlIndexSize),
 		metricPostgresqlOperations:               newMetricPostgresqlOperations(mbc.Metrics.PostgresqlOperations),
+		metricPostgresqlQueryCalls:               newMetricPostgresqlQueryCalls(mbc.Metrics.PostgresqlQueryCalls),
+		metricPostgresqlQueryDuration:            newMetricPostgresqlQueryDuration(mbc.Metrics.PostgresqlQueryDuration),
+		metricPostgresqlQueryRows:                newMetricPostgresqlQueryRows(mbc.Metrics.PostgresqlQueryRows),
 		metricPostgresqlReplicationDataDelay:     newMetricPostgresqlReplicationDataDelay(mbc.Metrics.PostgresqlReplicationDataDelay),
 		metricPostgresqlRollbacks:                newMetricPostgresqlRollbacks(mbc.Metrics.PostgresqlRollbacks),
 		metricPostgresqlRows:                     newMetricPostgresqlRows(mbc.Metrics.PostgresqlRows),
@@ -1525,6 +1693,9 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricPostgresqlIndexScans.emit(ils.Metrics())
 	mb.metricPostgresqlIndexSize.emit(ils.Metrics())
 	mb.metricPostgresqlOperations.emit(ils.Metrics())
+	mb.metricPostgresqlQueryCalls.emit(ils.Metrics())
+	mb.metricPostgresqlQueryDuration.emit(ils.Metrics())
+	mb.metricPostgresqlQueryRows.emit(ils.Metrics())
 	mb.metricPostgresqlReplicationDataDelay.emit(ils.Metrics())
 	mb.metricPostgresqlRollbacks.emit(ils.Metrics())
 	mb.metricPostgresqlRows.emit(ils.Metrics())
@@ -1623,6 +1794,21 @@ func (mb *MetricsBuilder) RecordPostgresqlOperationsDataPoint(ts pcommon.Timesta
 	mb.metricPostgresqlOperations.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, tableAttributeValue, operationAttributeValue.String())
 }
 
+// RecordPostgresqlQueryCallsDataPoint adds a data point to postgresql.query.calls metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryCallsDataPoint(ts pcommon.Timestamp, val int64, queryAttributeValue string, queryFingerprintAttributeValue string) {
+	mb.metricPostgresqlQueryCalls.recordDataPoint(mb.startTime, ts, val, queryAttributeValue, queryFingerprintAttributeValue)
+}
+
+// RecordPostgresqlQueryDurationDataPoint adds a data point to postgresql.query.duration metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryDurationDataPoint(ts pcommon.Timestamp, val float64, queryAttributeValue string, queryFingerprintAttributeValue string) {
+	mb.metricPostgresqlQueryDuration.recordDataPoint(mb.startTime, ts, val, queryAttributeValue, queryFingerprintAttributeValue)
+}
+
+// RecordPostgresqlQueryRowsDataPoint adds a data point to postgresql.query.rows metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryRowsDataPoint(ts pcommon.Timestamp, val int64, queryAttributeValue string, queryFingerprintAttributeValue string) {
+	mb.metricPostgresqlQueryRows.recordDataPoint(mb.startTime, ts, val, queryAttributeValue, queryFingerprintAttributeValue)
+}
+
 // RecordPostgresqlReplicationDataDelayDataPoint adds a data point to postgresql.replication.data_delay metric.
 func (mb *MetricsBuilder) RecordPostgresqlReplicationDataDelayDataPoint(ts pcommon.Timestamp, val int64, replicationClientAttributeValue string) {
 	mb.metricPostgresqlReplicationDataDelay.recordDataPoint(mb.startTime, ts, val, replicationClientAttributeValue)
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package postgresqlreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/postgresqlreceiver"
+
+import (
+	"hash/fnv"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// placeholderListRegex matches the lists of placeholders, e.g. the values of an IN clause.
+var placeholderListRegex = regexp.MustCompile(`\?(\s*,\s*\?)+`)
+
+// normalizeQuery replaces the constants of the query, and the parameters of the queries already
+// normalized by pg_stat_statements, by "?", removes its comments and collapses its whitespace.
+// The lists of constants are replaced by a single "?" so that the queries only differing by the
+// number of values they match have the same text.
+func normalizeQuery(query string) string {
+	var b strings.Builder
+	b.Grow(len(query))
+	space := false
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query) - i
+			}
+			i += end
+			space = true
+			continue
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				end = len(query) - i - 2
+			}
+			i += end + 4
+			space = true
+			continue
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			space = true
+			continue
+		}
+
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		switch {
+		case c == '\'':
+			// the quotes of the strings are escaped by doubling them
+			i++
+			for i < len(query) {
+				if query[i] == '\'' {
+					if i+1 < len(query) && query[i+1] == '\'' {
+						i += 2
+						continue
+					}
+					break
+				}
+				i++
+			}
+			i++
+			b.WriteByte('?')
+		case c == '"':
+			// the quoted identifiers are kept
+			end := len(query)
+			if j := strings.IndexByte(query[i+1:], '"'); j >= 0 {
+				end = i + j + 2
+			}
+			b.WriteString(query[i:end])
+			i = end
+		case c == '$' && i+1 < len(query) && isDigit(query[i+1]):
+			i++
+			for i < len(query) && isDigit(query[i]) {
+				i++
+			}
+			b.WriteByte('?')
+		case isDigit(c):
+			// the digits of the identifiers are consumed with their identifier
+			for i < len(query) && (isDigit(query[i]) || query[i] == '.') {
+				i++
+			}
+			b.WriteByte('?')
+		case isIdentifier(c):
+			for i < len(query) && isIdentifier(query[i]) {
+				b.WriteByte(query[i])
+				i++
+			}
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return placeholderListRegex.ReplaceAllString(b.String(), "?")
+}
+
+// queryFingerprint returns the hash of the normalized query text, as a hexadecimal string.
+func queryFingerprint(normalized string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(normalized))
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// truncateQuery truncates the query text to its first maxLength characters.
+func truncateQuery(query string, maxLength int) string {
+	if utf8.RuneCountInString(query) <= maxLength {
+		return query
+	}
+	return string([]rune(query)[:maxLength])
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifier(c byte) bool {
+	return c == '_' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= utf8.RuneSelf
+}
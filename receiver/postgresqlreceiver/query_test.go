@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package postgresqlreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		query    string
+		expected string
+	}{
+		{
+			desc:     "constants",
+			query:    "SELECT * FROM users WHERE name = 'o''brien' AND age > 42 AND score < 1.5",
+			expected: "SELECT * FROM users WHERE name = ? AND age > ? AND score < ?",
+		},
+		{
+			desc:     "parameters",
+			query:    "SELECT * FROM users WHERE id = $1 AND org = $12",
+			expected: "SELECT * FROM users WHERE id = ? AND org = ?",
+		},
+		{
+			desc:     "lists",
+			query:    "SELECT * FROM users WHERE id IN (1, 2,3) OR id IN ($1, $2)",
+			expected: "SELECT * FROM users WHERE id IN (?) OR id IN (?)",
+		},
+		{
+			desc:     "identifiers with digits",
+			query:    `SELECT col1 FROM "Table2" t2 JOIN table_3 ON t2.id = table_3.id`,
+			expected: `SELECT col1 FROM "Table2" t2 JOIN table_3 ON t2.id = table_3.id`,
+		},
+		{
+			desc:     "whitespace and comments",
+			query:    "  SELECT *\n\tFROM users -- all the users\n /* limited */ LIMIT 10  ",
+			expected: "SELECT * FROM users LIMIT ?",
+		},
+		{
+			desc:     "unterminated string",
+			query:    "SELECT 'abc",
+			expected: "SELECT ?",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			require.Equal(t, tC.expected, normalizeQuery(tC.query))
+		})
+	}
+}
+
+func TestQueryFingerprint(t *testing.T) {
+	require.Equal(t, queryFingerprint(normalizeQuery("SELECT 1")), queryFingerprint(normalizeQuery("SELECT  2")))
+	require.NotEqual(t, queryFingerprint("SELECT ?"), queryFingerprint("SELECT ? FROM users"))
+}
+
+func TestTruncateQuery(t *testing.T) {
+	require.Equal(t, "SELECT", truncateQuery("SELECT", 10))
+	require.Equal(t, "SELECT 'é", truncateQuery("SELECT 'école'", 9))
+}
@@ -123,6 +123,7 @@ func (p *postgreSQLScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 		defer dbClient.Close()
 		numTables := p.collectTables(ctx, now, dbClient, database, &errs)
 
+		p.collectQueryStats(ctx, now, dbClient, &errs)
 		p.recordDatabase(now, database, r, numTables)
 		p.collectIndexes(ctx, now, dbClient, database, &errs)
 	}
@@ -235,6 +236,47 @@ func (p *postgreSQLScraper) collectIndexes(
 	}
 }
 
+// collectQueryStats records the statistics of the top queries of the database, whose metrics
+// are recorded for the resource of the database. The queries only differing by their constants
+// are reported as a single query.
+func (p *postgreSQLScraper) collectQueryStats(
+	ctx context.Context,
+	now pcommon.Timestamp,
+	client client,
+	errs *errsMux,
+) {
+	metrics := p.config.MetricsBuilderConfig.Metrics
+	if !metrics.PostgresqlQueryCalls.Enabled && !metrics.PostgresqlQueryDuration.Enabled && !metrics.PostgresqlQueryRows.Enabled {
+		return
+	}
+	stats, err := client.getQueryStats(ctx, p.config.QueryStats.OrderBy, p.config.QueryStats.TopN)
+	if err != nil {
+		errs.addPartial(err)
+	}
+
+	byFingerprint := map[string]*queryStats{}
+	var fingerprints []string
+	for _, qs := range stats {
+		normalized := normalizeQuery(qs.query)
+		fingerprint := queryFingerprint(normalized)
+		merged, ok := byFingerprint[fingerprint]
+		if !ok {
+			merged = &queryStats{query: truncateQuery(normalized, p.config.QueryStats.MaxQueryLength)}
+			byFingerprint[fingerprint] = merged
+			fingerprints = append(fingerprints, fingerprint)
+		}
+		merged.calls += qs.calls
+		merged.totalTime += qs.totalTime
+		merged.rows += qs.rows
+	}
+	for _, fingerprint := range fingerprints {
+		qs := byFingerprint[fingerprint]
+		p.mb.RecordPostgresqlQueryCallsDataPoint(now, qs.calls, qs.query, fingerprint)
+		p.mb.RecordPostgresqlQueryDurationDataPoint(now, qs.totalTime, qs.query, fingerprint)
+		p.mb.RecordPostgresqlQueryRowsDataPoint(now, qs.rows, qs.query, fingerprint)
+	}
+}
+
 func (p *postgreSQLScraper) collectBGWriterStats(
 	ctx context.Context,
 	now pcommon.Timestamp,
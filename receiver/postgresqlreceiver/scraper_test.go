@@ -122,6 +122,53 @@ func TestScraperWithResourceAttributeFeatureGateSingle(t *testing.T) {
 		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
 }
 
+func TestScraperQueryStats(t *testing.T) {
+	factory := mockClientFactory{}
+	factory.initMocks([]string{"otel"})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.Metrics.PostgresqlQueryCalls.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.PostgresqlQueryDuration.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.PostgresqlQueryRows.Enabled = true
+	cfg.QueryStats.MaxQueryLength = 32
+	scraper := newPostgreSQLScraper(receivertest.NewNopCreateSettings(), cfg, &factory)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	calls := map[string]int64{}
+	var duration float64
+	for i := 0; i < actualMetrics.ResourceMetrics().Len(); i++ {
+		rm := actualMetrics.ResourceMetrics().At(i)
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			m := ms.At(j)
+			switch m.Name() {
+			case "postgresql.query.calls":
+				database, _ := rm.Resource().Attributes().Get("postgresql.database.name")
+				require.Equal(t, "otel", database.Str())
+				for k := 0; k < m.Sum().DataPoints().Len(); k++ {
+					dp := m.Sum().DataPoints().At(k)
+					query, _ := dp.Attributes().Get("query")
+					fingerprint, _ := dp.Attributes().Get("query_fingerprint")
+					require.NotEmpty(t, fingerprint.Str())
+					calls[query.Str()] = dp.IntValue()
+				}
+			case "postgresql.query.duration":
+				for k := 0; k < m.Sum().DataPoints().Len(); k++ {
+					duration += m.Sum().DataPoints().At(k).DoubleValue()
+				}
+			}
+		}
+	}
+	// the queries only differing by their constants are merged
+	require.Equal(t, map[string]int64{
+		"SELECT * FROM users WHERE id = ?": 12,
+		"UPDATE accounts SET balance = ba": 3,
+	}, calls)
+	require.Equal(t, 180.5, duration)
+}
+
 type mockClientFactory struct{ mock.Mock }
 type mockClient struct{ mock.Mock }
 
@@ -182,6 +229,11 @@ func (m *mockClient) getReplicationStats(ctx context.Context) ([]replicationStat
 	return args.Get(0).([]replicationStats), args.Error(1)
 }
 
+func (m *mockClient) getQueryStats(_ context.Context, orderBy string, limit int) ([]queryStats, error) {
+	args := m.Called(orderBy, limit)
+	return args.Get(0).([]queryStats), args.Error(1)
+}
+
 func (m *mockClient) listDatabases(_ context.Context) ([]string, error) {
 	args := m.Called()
 	return args.Get(0).([]string), args.Error(1)
@@ -336,5 +388,12 @@ func (m *mockClient) initMocks(database string, databases []string, index int) {
 			},
 		}
 		m.On("getIndexStats", mock.Anything, database).Return(indexStats, nil)
+
+		queryStats := []queryStats{
+			{query: "SELECT * FROM users WHERE id = $1", calls: 10, totalTime: 100, rows: 10},
+			{query: "UPDATE accounts SET balance = balance - 100 WHERE id IN (1, 2, 3)", calls: 3, totalTime: 80, rows: 9},
+			{query: "SELECT *\n  FROM users\n  WHERE id = 42", calls: 2, totalTime: 0.5, rows: 2},
+		}
+		m.On("getQueryStats", orderByTotalTime, 10).Return(queryStats, nil)
 	}
 }
@@ -48,6 +48,12 @@ type Config struct {
 
 	TargetAllocator *targetAllocator `mapstructure:"target_allocator"`
 
+	// ScrapeConfigFilesReloadInterval is the interval at which the files listed in the Prometheus
+	// scrape_config_files setting are checked for changes. Changed scrape configs are applied to the
+	// running scrape manager, so unchanged jobs keep their targets, staleness state and metadata.
+	// Reloading is disabled when zero.
+	ScrapeConfigFilesReloadInterval time.Duration `mapstructure:"scrape_config_files_reload_interval"`
+
 	// ConfigPlaceholder is just an entry to make the configuration pass a check
 	// that requires that all keys present in the config actually exist on the
 	// structure, ie.: it will error if an unknown key is present.
@@ -97,6 +103,13 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	if cfg.ScrapeConfigFilesReloadInterval < 0 {
+		return errors.New("scrape_config_files_reload_interval must not be negative")
+	}
+	if cfg.ScrapeConfigFilesReloadInterval > 0 && (promConfig == nil || len(promConfig.ScrapeConfigFiles) == 0) {
+		return errors.New("scrape_config_files_reload_interval requires scrape_config_files to be set")
+	}
+
 	if cfg.TargetAllocator != nil {
 		err := cfg.validateTargetAllocatorConfig()
 		if err != nil {
@@ -107,8 +120,8 @@ func (cfg *Config) Validate() error {
 }
 
 func (cfg *Config) validatePromConfig(promConfig *promconfig.Config) error {
-	if len(promConfig.ScrapeConfigs) == 0 && cfg.TargetAllocator == nil {
-		return errors.New("no Prometheus scrape_configs or target_allocator set")
+	if len(promConfig.ScrapeConfigs) == 0 && len(promConfig.ScrapeConfigFiles) == 0 && cfg.TargetAllocator == nil {
+		return errors.New("no Prometheus scrape_configs, scrape_config_files or target_allocator set")
 	}
 
 	// Reject features that Prometheus supports but that the receiver doesn't support:
@@ -137,7 +150,16 @@ func (cfg *Config) validatePromConfig(promConfig *promconfig.Config) error {
 		return fmt.Errorf("unsupported features:\n\t%s", strings.Join(unsupportedFeatures, "\n\t"))
 	}
 
-	for _, sc := range cfg.PrometheusConfig.ScrapeConfigs {
+	// The scrape configs include the jobs loaded from scrape_config_files
+	scrapeConfigs, err := promConfig.GetScrapeConfigs()
+	if err != nil {
+		return fmt.Errorf("error loading scrape configs: %w", err)
+	}
+	return validateScrapeConfigs(scrapeConfigs)
+}
+
+func validateScrapeConfigs(scrapeConfigs []*promconfig.ScrapeConfig) error {
+	for _, sc := range scrapeConfigs {
 		for _, rc := range sc.MetricRelabelConfigs {
 			if rc.TargetLabel == "__name__" {
 				// TODO(#2297): Remove validation after renaming is fixed
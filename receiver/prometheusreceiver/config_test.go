@@ -133,6 +133,19 @@ func TestLoadConfigFailsOnRenameDisallowed(t *testing.T) {
 
 }
 
+// Renaming is not allowed in the jobs of the scrape config files either
+func TestLoadConfigFailsOnRenameDisallowedInScrapeConfigFiles(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "invalid-config-prometheus-scrape-config-files-relabel.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	assert.ErrorIs(t, component.ValidateConfig(cfg), errRenamingDisallowed)
+}
+
 func TestRejectUnsupportedPrometheusFeatures(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "invalid-config-prometheus-unsupported-features.yaml"))
 	require.NoError(t, err)
@@ -300,3 +313,35 @@ func TestFileSDConfigWithoutSDFile(t *testing.T) {
 	err = component.ValidateConfig(cfg)
 	require.NoError(t, err)
 }
+
+func TestLoadScrapeConfigFilesConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config_scrape_config_files.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	r0 := cfg.(*Config)
+	assert.Equal(t, 30*time.Second, r0.ScrapeConfigFilesReloadInterval)
+	assert.Equal(t, []string{"./testdata/scrape_configs/*.yaml"}, r0.PrometheusConfig.ScrapeConfigFiles)
+	scrapeConfigs, err := r0.PrometheusConfig.GetScrapeConfigs()
+	require.NoError(t, err)
+	require.Len(t, scrapeConfigs, 1)
+	assert.Equal(t, "demo", scrapeConfigs[0].JobName)
+
+	cfg = factory.CreateDefaultConfig()
+	sub, err = cm.Sub(component.NewIDWithName(metadata.Type, "no_files").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	assert.EqualError(t, component.ValidateConfig(cfg), "scrape_config_files_reload_interval requires scrape_config_files to be set")
+
+	cfg = factory.CreateDefaultConfig()
+	sub, err = cm.Sub(component.NewIDWithName(metadata.Type, "negative_interval").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	assert.EqualError(t, component.ValidateConfig(cfg), "scrape_config_files_reload_interval must not be negative")
+}
@@ -7,10 +7,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"sync"
 	"time"
@@ -45,6 +47,9 @@ type pReceiver struct {
 	targetAllocatorStop chan struct{}
 	configLoaded        chan struct{}
 	loadConfigOnce      sync.Once
+	// applyCfgMtx serializes updates of the scrape configs by the target
+	// allocator and the scrape config files reload.
+	applyCfgMtx sync.Mutex
 
 	settings         receiver.CreateSettings
 	scrapeManager    *scrape.Manager
@@ -94,6 +99,13 @@ func (r *pReceiver) Start(_ context.Context, host component.Host) error {
 		}
 	}
 
+	if r.cfg.ScrapeConfigFilesReloadInterval > 0 {
+		err = r.startScrapeConfigFilesReload(discoveryCtx, r.cfg.ScrapeConfigFilesReloadInterval, baseCfg)
+		if err != nil {
+			return err
+		}
+	}
+
 	r.loadConfigOnce.Do(func() {
 		close(r.configLoaded)
 	})
@@ -149,6 +161,9 @@ func (r *pReceiver) syncTargetAllocator(compareHash uint64, allocConf *targetAll
 		return hash, nil
 	}
 
+	r.applyCfgMtx.Lock()
+	defer r.applyCfgMtx.Unlock()
+
 	// Clear out the current configurations
 	baseCfg.ScrapeConfigs = []*config.ScrapeConfig{}
 
@@ -180,6 +195,82 @@ func (r *pReceiver) syncTargetAllocator(compareHash uint64, allocConf *targetAll
 	return hash, nil
 }
 
+func (r *pReceiver) startScrapeConfigFilesReload(ctx context.Context, interval time.Duration, baseCfg *config.Config) error {
+	r.settings.Logger.Info("Starting scrape config files reload", zap.Strings("files", baseCfg.ScrapeConfigFiles))
+	savedHash, err := scrapeConfigFilesHash(baseCfg)
+	if err != nil {
+		return err
+	}
+	go func() {
+		reloadTicker := time.NewTicker(interval)
+		defer reloadTicker.Stop()
+		for {
+			select {
+			case <-reloadTicker.C:
+				hash, newErr := r.reloadScrapeConfigFiles(savedHash, baseCfg)
+				if newErr != nil {
+					r.settings.Logger.Error("Failed to reload scrape config files", zap.Error(newErr))
+					continue
+				}
+				savedHash = hash
+			case <-ctx.Done():
+				r.settings.Logger.Info("Stopping scrape config files reload")
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// reloadScrapeConfigFiles applies baseCfg again if the scrape configs loaded from its scrape_config_files
+// don't match the provided compareHash. The scrape manager only restarts the scrape pools whose
+// configuration changed, so the other targets keep their staleness tracking and metadata.
+// Scrape configs which fail the validation of the receiver are rejected, keeping the current ones.
+func (r *pReceiver) reloadScrapeConfigFiles(compareHash uint64, baseCfg *config.Config) (uint64, error) {
+	r.applyCfgMtx.Lock()
+	defer r.applyCfgMtx.Unlock()
+
+	hash, err := scrapeConfigFilesHash(baseCfg)
+	if err != nil {
+		return 0, err
+	}
+	if hash == compareHash {
+		// no update needed
+		return hash, nil
+	}
+
+	if err = r.cfg.validatePromConfig(baseCfg); err != nil {
+		return 0, fmt.Errorf("invalid scrape config files, keeping the current scrape configuration: %w", err)
+	}
+
+	r.settings.Logger.Info("Scrape config files changed, applying new scrape configuration")
+	if err = r.applyCfg(baseCfg); err != nil {
+		return 0, err
+	}
+	return hash, nil
+}
+
+// scrapeConfigFilesHash returns a hash of the names and contents of the files matching the
+// scrape_config_files patterns of cfg.
+func scrapeConfigFilesHash(cfg *config.Config) (uint64, error) {
+	h := fnv.New64a()
+	for _, pattern := range cfg.ScrapeConfigFiles {
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return 0, fmt.Errorf("error retrieving scrape config files for %q: %w", pattern, err)
+		}
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return 0, err
+			}
+			_, _ = h.Write([]byte(file))
+			_, _ = h.Write(content)
+		}
+	}
+	return h.Sum64(), nil
+}
+
 // instantiateShard inserts the SHARD environment variable in the returned configuration
 func (r *pReceiver) instantiateShard(body []byte) []byte {
 	shard, ok := os.LookupEnv("SHARD")
@@ -224,8 +315,13 @@ func (r *pReceiver) applyCfg(cfg *config.Config) error {
 		return err
 	}
 
+	scrapeConfigs, err := cfg.GetScrapeConfigs()
+	if err != nil {
+		return err
+	}
+
 	discoveryCfg := make(map[string]discovery.Configs)
-	for _, scrapeConfig := range cfg.ScrapeConfigs {
+	for _, scrapeConfig := range scrapeConfigs {
 		discoveryCfg[scrapeConfig.JobName] = scrapeConfig.ServiceDiscoveryConfigs
 		r.settings.Logger.Info("Scrape job added", zap.String("jobName", scrapeConfig.JobName))
 	}
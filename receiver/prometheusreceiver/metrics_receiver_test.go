@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -15,6 +17,7 @@ import (
 	gokitlog "github.com/go-kit/log"
 	"github.com/prometheus/common/model"
 	promConfig "github.com/prometheus/prometheus/config"
+	"github.com/prometheus/prometheus/scrape"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -1591,3 +1594,99 @@ scrape_configs:
 	require.Contains(t, gotUA, set.BuildInfo.Command)
 	require.Contains(t, gotUA, set.BuildInfo.Version)
 }
+
+func TestScrapeConfigFilesReload(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("up 1\n"))
+	}))
+	defer svr.Close()
+	target := strings.TrimPrefix(svr.URL, "http://")
+
+	scrapeConfigFile := filepath.Join(t.TempDir(), "scrape_configs.yaml")
+	writeScrapeConfigs := func(jobs ...string) {
+		var sb strings.Builder
+		sb.WriteString("scrape_configs:\n")
+		for _, job := range jobs {
+			sb.WriteString(fmt.Sprintf("- job_name: %s\n  scrape_interval: 100ms\n  static_configs:\n  - targets: [%s]\n", job, target))
+		}
+		require.NoError(t, os.WriteFile(scrapeConfigFile, []byte(sb.String()), 0600))
+	}
+	writeScrapeConfigs("foo")
+
+	cfg, err := promConfig.Load(fmt.Sprintf("scrape_config_files: [%s]\n", scrapeConfigFile), false, gokitlog.NewNopLogger())
+	require.NoError(t, err)
+	receiver := newPrometheusReceiver(receivertest.NewNopCreateSettings(), &Config{
+		PrometheusConfig:                cfg,
+		ScrapeConfigFilesReloadInterval: 50 * time.Millisecond,
+	}, new(consumertest.MetricsSink))
+
+	ctx := context.Background()
+	require.NoError(t, receiver.Start(ctx, componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, receiver.Shutdown(ctx))
+	})
+
+	// The discovery and scrape managers only sync targets every 5 seconds.
+	var fooTarget *scrape.Target
+	require.Eventually(t, func() bool {
+		targets := receiver.scrapeManager.TargetsActive()["foo"]
+		if len(targets) != 1 {
+			return false
+		}
+		fooTarget = targets[0]
+		return true
+	}, 30*time.Second, 100*time.Millisecond)
+
+	writeScrapeConfigs("foo", "bar")
+	require.Eventually(t, func() bool {
+		return len(receiver.scrapeManager.TargetsActive()["bar"]) == 1
+	}, 30*time.Second, 100*time.Millisecond)
+	// The unchanged job keeps its target, and with it the scrape cache used for staleness tracking.
+	assert.Same(t, fooTarget, receiver.scrapeManager.TargetsActive()["foo"][0])
+
+	writeScrapeConfigs("bar")
+	require.Eventually(t, func() bool {
+		return len(receiver.scrapeManager.TargetsActive()["foo"]) == 0
+	}, 30*time.Second, 100*time.Millisecond)
+}
+
+func TestScrapeConfigFilesReloadRejectsInvalidConfig(t *testing.T) {
+	scrapeConfigFile := filepath.Join(t.TempDir(), "scrape_configs.yaml")
+	require.NoError(t, os.WriteFile(scrapeConfigFile, []byte("scrape_configs: []\n"), 0600))
+	cfg, err := promConfig.Load(fmt.Sprintf("scrape_config_files: [%s]\n", scrapeConfigFile), false, gokitlog.NewNopLogger())
+	require.NoError(t, err)
+	receiver := newPrometheusReceiver(receivertest.NewNopCreateSettings(), &Config{
+		PrometheusConfig:                cfg,
+		ScrapeConfigFilesReloadInterval: time.Minute,
+	}, new(consumertest.MetricsSink))
+
+	require.NoError(t, os.WriteFile(scrapeConfigFile, []byte(`scrape_configs:
+- job_name: rename
+  metric_relabel_configs:
+  - source_labels: [__name__]
+    regex: "foo_(.*)"
+    target_label: __name__
+`), 0600))
+	_, err = receiver.reloadScrapeConfigFiles(0, cfg)
+	assert.ErrorIs(t, err, errRenamingDisallowed)
+}
+
+func TestScrapeConfigFilesHash(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("scrape_configs: []"), 0600))
+	cfg := &promConfig.Config{ScrapeConfigFiles: []string{filepath.Join(dir, "*.yaml")}}
+
+	hash, err := scrapeConfigFilesHash(cfg)
+	require.NoError(t, err)
+	same, err := scrapeConfigFilesHash(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, hash, same)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yaml"), []byte("scrape_configs: []"), 0600))
+	changed, err := scrapeConfigFilesHash(cfg)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+
+	_, err = scrapeConfigFilesHash(&promConfig.Config{ScrapeConfigFiles: []string{"["}})
+	assert.Error(t, err)
+}
@@ -24,7 +24,9 @@ import (
 )
 
 const (
-	queuesAPIResponseFile = "get_queues_response.json"
+	queuesAPIResponseFile          = "get_queues_response.json"
+	shovelsAPIResponseFile         = "get_shovels_response.json"
+	federationLinksAPIResponseFile = "get_federation_links_response.json"
 )
 
 func TestNewClient(t *testing.T) {
@@ -159,6 +161,58 @@ func TestGetQueuesDetails(t *testing.T) {
 	}
 }
 
+func TestGetShovelsAndFederationLinks(t *testing.T) {
+	shovelsData := loadAPIResponseData(t, shovelsAPIResponseFile)
+	linksData := loadAPIResponseData(t, federationLinksAPIResponseFile)
+
+	// Setup test server
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		switch r.URL.Path {
+		case shovelPath:
+			_, err = w.Write(shovelsData)
+		case federationLinkPath:
+			_, err = w.Write(linksData)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	tc := createTestClient(t, ts.URL)
+
+	var expectedShovels []*models.Shovel
+	require.NoError(t, json.Unmarshal(shovelsData, &expectedShovels))
+	shovels, err := tc.GetShovels(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedShovels, shovels)
+	require.Equal(t, "orders-to-archive", shovels[0].Name)
+	require.Equal(t, "running", shovels[0].State)
+
+	var expectedLinks []*models.FederationLink
+	require.NoError(t, json.Unmarshal(linksData, &expectedLinks))
+	links, err := tc.GetFederationLinks(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedLinks, links)
+	require.Equal(t, "webq1", links[1].Queue)
+	require.Equal(t, "error", links[1].Status)
+}
+
+func TestGetShovelsPluginDisabled(t *testing.T) {
+	// Setup test server
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	tc := createTestClient(t, ts.URL)
+
+	shovels, err := tc.GetShovels(context.Background())
+	require.Nil(t, shovels)
+	require.EqualError(t, err, "non 200 code returned 404")
+}
+
 func createTestClient(t *testing.T, baseEndpoint string) client {
 	t.Helper()
 	cfg := createDefaultConfig().(*Config)
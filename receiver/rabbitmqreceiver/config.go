@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/metadata"
 )
 
@@ -33,6 +34,18 @@ type Config struct {
 	Username                                string              `mapstructure:"username"`
 	Password                                configopaque.String `mapstructure:"password"`
 	metadata.MetricsBuilderConfig           `mapstructure:",squash"`
+	// Include specifies a filter on the vhosts and queues that should be included in the generated metrics.
+	Include MatchConfig `mapstructure:"include"`
+	// Exclude specifies a filter on the vhosts and queues that should be excluded from the generated metrics.
+	Exclude MatchConfig `mapstructure:"exclude"`
+}
+
+// MatchConfig defines the names of the vhosts and queues to match.
+type MatchConfig struct {
+	filterset.Config `mapstructure:",squash"`
+
+	VHosts []string `mapstructure:"vhosts"`
+	Queues []string `mapstructure:"queues"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
@@ -52,5 +65,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, wrappedErr)
 	}
 
+	if _, filterErr := newResourceFilter(cfg.Include, cfg.Exclude); filterErr != nil {
+		err = multierr.Append(err, filterErr)
+	}
+
 	return err
 }
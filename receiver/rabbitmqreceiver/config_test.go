@@ -4,6 +4,7 @@
 package rabbitmqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -15,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.uber.org/multierr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/metadata"
 )
 
@@ -76,6 +78,20 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%w: %s", errInvalidEndpoint, `parse "invalid://endpoint:  12efg": invalid port ":  12efg" after host`),
 			),
 		},
+		{
+			desc: "invalid queue filter",
+			cfg: &Config{
+				Username: "otelu",
+				Password: "otelp",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+				},
+				Include: MatchConfig{
+					Queues: []string{"orders"},
+				},
+			},
+			expectedErr: errors.New(`error creating queue include filters: unrecognized match_type: '', valid types are: [regexp strict]`),
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
@@ -118,6 +134,14 @@ func TestLoadConfig(t *testing.T) {
 	expected.Username = "otelu"
 	expected.Password = "${env:RABBITMQ_PASSWORD}"
 	expected.CollectionInterval = 10 * time.Second
+	expected.Include = MatchConfig{
+		Config: filterset.Config{MatchType: filterset.Strict},
+		VHosts: []string{"prod"},
+	}
+	expected.Exclude = MatchConfig{
+		Config: filterset.Config{MatchType: filterset.Regexp},
+		Queues: []string{"^amq\\..*", ".*\\.tmp$"},
+	}
 
 	require.Equal(t, expected, cfg)
 }
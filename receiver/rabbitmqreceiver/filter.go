@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rabbitmqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver"
+
+import (
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+)
+
+// resourceFilter filters the vhosts and queues metrics are collected for.
+type resourceFilter struct {
+	includeVHosts filterset.FilterSet
+	excludeVHosts filterset.FilterSet
+	includeQueues filterset.FilterSet
+	excludeQueues filterset.FilterSet
+}
+
+func newResourceFilter(include, exclude MatchConfig) (*resourceFilter, error) {
+	var f resourceFilter
+	var err error
+	if f.includeVHosts, err = createFilterSet(include.VHosts, &include.Config); err != nil {
+		return nil, fmt.Errorf("error creating vhost include filters: %w", err)
+	}
+	if f.excludeVHosts, err = createFilterSet(exclude.VHosts, &exclude.Config); err != nil {
+		return nil, fmt.Errorf("error creating vhost exclude filters: %w", err)
+	}
+	if f.includeQueues, err = createFilterSet(include.Queues, &include.Config); err != nil {
+		return nil, fmt.Errorf("error creating queue include filters: %w", err)
+	}
+	if f.excludeQueues, err = createFilterSet(exclude.Queues, &exclude.Config); err != nil {
+		return nil, fmt.Errorf("error creating queue exclude filters: %w", err)
+	}
+	return &f, nil
+}
+
+func createFilterSet(names []string, cfg *filterset.Config) (filterset.FilterSet, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	return filterset.CreateFilterSet(names, cfg)
+}
+
+// includeVHost returns whether metrics are collected for the vhost.
+func (f *resourceFilter) includeVHost(vhost string) bool {
+	if f == nil {
+		return true
+	}
+	return matches(f.includeVHosts, f.excludeVHosts, vhost)
+}
+
+// includeQueue returns whether metrics are collected for the queue of the vhost.
+func (f *resourceFilter) includeQueue(vhost, queue string) bool {
+	if f == nil {
+		return true
+	}
+	return f.includeVHost(vhost) && matches(f.includeQueues, f.excludeQueues, queue)
+}
+
+func matches(include, exclude filterset.FilterSet, name string) bool {
+	return (include == nil || include.Matches(name)) &&
+		(exclude == nil || !exclude.Matches(name))
+}
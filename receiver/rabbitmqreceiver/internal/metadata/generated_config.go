@@ -25,12 +25,16 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for rabbitmq metrics.
 type MetricsConfig struct {
-	RabbitmqConsumerCount       MetricConfig `mapstructure:"rabbitmq.consumer.count"`
-	RabbitmqMessageAcknowledged MetricConfig `mapstructure:"rabbitmq.message.acknowledged"`
-	RabbitmqMessageCurrent      MetricConfig `mapstructure:"rabbitmq.message.current"`
-	RabbitmqMessageDelivered    MetricConfig `mapstructure:"rabbitmq.message.delivered"`
-	RabbitmqMessageDropped      MetricConfig `mapstructure:"rabbitmq.message.dropped"`
-	RabbitmqMessagePublished    MetricConfig `mapstructure:"rabbitmq.message.published"`
+	RabbitmqConsumerCount        MetricConfig `mapstructure:"rabbitmq.consumer.count"`
+	RabbitmqConsumerUtilization  MetricConfig `mapstructure:"rabbitmq.consumer.utilization"`
+	RabbitmqFederationLinkStatus MetricConfig `mapstructure:"rabbitmq.federation.link.status"`
+	RabbitmqMessageAcknowledged  MetricConfig `mapstructure:"rabbitmq.message.acknowledged"`
+	RabbitmqMessageAge           MetricConfig `mapstructure:"rabbitmq.message.age"`
+	RabbitmqMessageCurrent       MetricConfig `mapstructure:"rabbitmq.message.current"`
+	RabbitmqMessageDelivered     MetricConfig `mapstructure:"rabbitmq.message.delivered"`
+	RabbitmqMessageDropped       MetricConfig `mapstructure:"rabbitmq.message.dropped"`
+	RabbitmqMessagePublished     MetricConfig `mapstructure:"rabbitmq.message.published"`
+	RabbitmqShovelStatus         MetricConfig `mapstructure:"rabbitmq.shovel.status"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -38,9 +42,18 @@ func DefaultMetricsConfig() MetricsConfig {
 		RabbitmqConsumerCount: MetricConfig{
 			Enabled: true,
 		},
+		RabbitmqConsumerUtilization: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqFederationLinkStatus: MetricConfig{
+			Enabled: false,
+		},
 		RabbitmqMessageAcknowledged: MetricConfig{
 			Enabled: true,
 		},
+		RabbitmqMessageAge: MetricConfig{
+			Enabled: false,
+		},
 		RabbitmqMessageCurrent: MetricConfig{
 			Enabled: true,
 		},
@@ -53,6 +66,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		RabbitmqMessagePublished: MetricConfig{
 			Enabled: true,
 		},
+		RabbitmqShovelStatus: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
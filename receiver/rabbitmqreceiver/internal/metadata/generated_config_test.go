@@ -26,12 +26,16 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					RabbitmqConsumerCount:       MetricConfig{Enabled: true},
-					RabbitmqMessageAcknowledged: MetricConfig{Enabled: true},
-					RabbitmqMessageCurrent:      MetricConfig{Enabled: true},
-					RabbitmqMessageDelivered:    MetricConfig{Enabled: true},
-					RabbitmqMessageDropped:      MetricConfig{Enabled: true},
-					RabbitmqMessagePublished:    MetricConfig{Enabled: true},
+					RabbitmqConsumerCount:        MetricConfig{Enabled: true},
+					RabbitmqConsumerUtilization:  MetricConfig{Enabled: true},
+					RabbitmqFederationLinkStatus: MetricConfig{Enabled: true},
+					RabbitmqMessageAcknowledged:  MetricConfig{Enabled: true},
+					RabbitmqMessageAge:           MetricConfig{Enabled: true},
+					RabbitmqMessageCurrent:       MetricConfig{Enabled: true},
+					RabbitmqMessageDelivered:     MetricConfig{Enabled: true},
+					RabbitmqMessageDropped:       MetricConfig{Enabled: true},
+					RabbitmqMessagePublished:     MetricConfig{Enabled: true},
+					RabbitmqShovelStatus:         MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: true},
@@ -44,12 +48,16 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					RabbitmqConsumerCount:       MetricConfig{Enabled: false},
-					RabbitmqMessageAcknowledged: MetricConfig{Enabled: false},
-					RabbitmqMessageCurrent:      MetricConfig{Enabled: false},
-					RabbitmqMessageDelivered:    MetricConfig{Enabled: false},
-					RabbitmqMessageDropped:      MetricConfig{Enabled: false},
-					RabbitmqMessagePublished:    MetricConfig{Enabled: false},
+					RabbitmqConsumerCount:        MetricConfig{Enabled: false},
+					RabbitmqConsumerUtilization:  MetricConfig{Enabled: false},
+					RabbitmqFederationLinkStatus: MetricConfig{Enabled: false},
+					RabbitmqMessageAcknowledged:  MetricConfig{Enabled: false},
+					RabbitmqMessageAge:           MetricConfig{Enabled: false},
+					RabbitmqMessageCurrent:       MetricConfig{Enabled: false},
+					RabbitmqMessageDelivered:     MetricConfig{Enabled: false},
+					RabbitmqMessageDropped:       MetricConfig{Enabled: false},
+					RabbitmqMessagePublished:     MetricConfig{Enabled: false},
+					RabbitmqShovelStatus:         MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: false},
@@ -11,6 +11,32 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributeFederationLinkType specifies the a value federation.link.type attribute.
+type AttributeFederationLinkType int
+
+const (
+	_ AttributeFederationLinkType = iota
+	AttributeFederationLinkTypeExchange
+	AttributeFederationLinkTypeQueue
+)
+
+// String returns the string representation of the AttributeFederationLinkType.
+func (av AttributeFederationLinkType) String() string {
+	switch av {
+	case AttributeFederationLinkTypeExchange:
+		return "exchange"
+	case AttributeFederationLinkTypeQueue:
+		return "queue"
+	}
+	return ""
+}
+
+// MapAttributeFederationLinkType is a helper map of string to AttributeFederationLinkType attribute value.
+var MapAttributeFederationLinkType = map[string]AttributeFederationLinkType{
+	"exchange": AttributeFederationLinkTypeExchange,
+	"queue":    AttributeFederationLinkTypeQueue,
+}
+
 // AttributeMessageState specifies the a value message.state attribute.
 type AttributeMessageState int
 
@@ -88,6 +114,111 @@ func newMetricRabbitmqConsumerCount(cfg MetricConfig) metricRabbitmqConsumerCoun
 	return m
 }
 
+type metricRabbitmqConsumerUtilization struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.consumer.utilization metric with initial data.
+func (m *metricRabbitmqConsumerUtilization) init() {
+	m.data.SetName("rabbitmq.consumer.utilization")
+	m.data.SetDescription("The fraction of the time the queue is able to immediately deliver messages to consumers.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRabbitmqConsumerUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqConsumerUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqConsumerUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqConsumerUtilization(cfg MetricConfig) metricRabbitmqConsumerUtilization {
+	m := metricRabbitmqConsumerUtilization{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqFederationLinkStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.federation.link.status metric with initial data.
+func (m *metricRabbitmqFederationLinkStatus) init() {
+	m.data.SetName("rabbitmq.federation.link.status")
+	m.data.SetDescription("The status of a federation link. The value is always 1, the status is recorded by the status attribute.")
+	m.data.SetUnit("{links}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqFederationLinkStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, federationUpstreamAttributeValue string, federationLinkTypeAttributeValue string, federationLinkTargetAttributeValue string, federationLinkStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", federationUpstreamAttributeValue)
+	dp.Attributes().PutStr("type", federationLinkTypeAttributeValue)
+	dp.Attributes().PutStr("target", federationLinkTargetAttributeValue)
+	dp.Attributes().PutStr("status", federationLinkStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqFederationLinkStatus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqFederationLinkStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqFederationLinkStatus(cfg MetricConfig) metricRabbitmqFederationLinkStatus {
+	m := metricRabbitmqFederationLinkStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRabbitmqMessageAcknowledged struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -139,6 +270,55 @@ func newMetricRabbitmqMessageAcknowledged(cfg MetricConfig) metricRabbitmqMessag
 	return m
 }
 
+type metricRabbitmqMessageAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.message.age metric with initial data.
+func (m *metricRabbitmqMessageAge) init() {
+	m.data.SetName("rabbitmq.message.age")
+	m.data.SetDescription("The age of the oldest message in the queue, for the messages published with a timestamp.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRabbitmqMessageAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqMessageAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqMessageAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqMessageAge(cfg MetricConfig) metricRabbitmqMessageAge {
+	m := metricRabbitmqMessageAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRabbitmqMessageCurrent struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -345,20 +525,78 @@ func newMetricRabbitmqMessagePublished(cfg MetricConfig) metricRabbitmqMessagePu
 	return m
 }
 
+type metricRabbitmqShovelStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.shovel.status metric with initial data.
+func (m *metricRabbitmqShovelStatus) init() {
+	m.data.SetName("rabbitmq.shovel.status")
+	m.data.SetDescription("The status of a shovel. The value is always 1, the state is recorded by the state attribute.")
+	m.data.SetUnit("{shovels}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqShovelStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, shovelNameAttributeValue string, shovelStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("name", shovelNameAttributeValue)
+	dp.Attributes().PutStr("state", shovelStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqShovelStatus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqShovelStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqShovelStatus(cfg MetricConfig) metricRabbitmqShovelStatus {
+	m := metricRabbitmqShovelStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                            MetricsBuilderConfig // config of the metrics builder.
-	startTime                         pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                   int                  // maximum observed number of metrics per resource.
-	metricsBuffer                     pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                         component.BuildInfo  // contains version information.
-	metricRabbitmqConsumerCount       metricRabbitmqConsumerCount
-	metricRabbitmqMessageAcknowledged metricRabbitmqMessageAcknowledged
-	metricRabbitmqMessageCurrent      metricRabbitmqMessageCurrent
-	metricRabbitmqMessageDelivered    metricRabbitmqMessageDelivered
-	metricRabbitmqMessageDropped      metricRabbitmqMessageDropped
-	metricRabbitmqMessagePublished    metricRabbitmqMessagePublished
+	config                             MetricsBuilderConfig // config of the metrics builder.
+	startTime                          pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                    int                  // maximum observed number of metrics per resource.
+	metricsBuffer                      pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                          component.BuildInfo  // contains version information.
+	metricRabbitmqConsumerCount        metricRabbitmqConsumerCount
+	metricRabbitmqConsumerUtilization  metricRabbitmqConsumerUtilization
+	metricRabbitmqFederationLinkStatus metricRabbitmqFederationLinkStatus
+	metricRabbitmqMessageAcknowledged  metricRabbitmqMessageAcknowledged
+	metricRabbitmqMessageAge           metricRabbitmqMessageAge
+	metricRabbitmqMessageCurrent       metricRabbitmqMessageCurrent
+	metricRabbitmqMessageDelivered     metricRabbitmqMessageDelivered
+	metricRabbitmqMessageDropped       metricRabbitmqMessageDropped
+	metricRabbitmqMessagePublished     metricRabbitmqMessagePublished
+	metricRabbitmqShovelStatus         metricRabbitmqShovelStatus
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -373,16 +611,20 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                            mbc,
-		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                     pmetric.NewMetrics(),
-		buildInfo:                         settings.BuildInfo,
-		metricRabbitmqConsumerCount:       newMetricRabbitmqConsumerCount(mbc.Metrics.RabbitmqConsumerCount),
-		metricRabbitmqMessageAcknowledged: newMetricRabbitmqMessageAcknowledged(mbc.Metrics.RabbitmqMessageAcknowledged),
-		metricRabbitmqMessageCurrent:      newMetricRabbitmqMessageCurrent(mbc.Metrics.RabbitmqMessageCurrent),
-		metricRabbitmqMessageDelivered:    newMetricRabbitmqMessageDelivered(mbc.Metrics.RabbitmqMessageDelivered),
-		metricRabbitmqMessageDropped:      newMetricRabbitmqMessageDropped(mbc.Metrics.RabbitmqMessageDropped),
-		metricRabbitmqMessagePublished:    newMetricRabbitmqMessagePublished(mbc.Metrics.RabbitmqMessagePublished),
+		config:                             mbc,
+		startTime:                          pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                      pmetric.NewMetrics(),
+		buildInfo:                          settings.BuildInfo,
+		metricRabbitmqConsumerCount:        newMetricRabbitmqConsumerCount(mbc.Metrics.RabbitmqConsumerCount),
+		metricRabbitmqConsumerUtilization:  newMetricRabbitmqConsumerUtilization(mbc.Metrics.RabbitmqConsumerUtilization),
+		metricRabbitmqFederationLinkStatus: newMetricRabbitmqFederationLinkStatus(mbc.Metrics.RabbitmqFederationLinkStatus),
+		metricRabbitmqMessageAcknowledged:  newMetricRabbitmqMessageAcknowledged(mbc.Metrics.RabbitmqMessageAcknowledged),
+		metricRabbitmqMessageAge:           newMetricRabbitmqMessageAge(mbc.Metrics.RabbitmqMessageAge),
+		metricRabbitmqMessageCurrent:       newMetricRabbitmqMessageCurrent(mbc.Metrics.RabbitmqMessageCurrent),
+		metricRabbitmqMessageDelivered:     newMetricRabbitmqMessageDelivered(mbc.Metrics.RabbitmqMessageDelivered),
+		metricRabbitmqMessageDropped:       newMetricRabbitmqMessageDropped(mbc.Metrics.RabbitmqMessageDropped),
+		metricRabbitmqMessagePublished:     newMetricRabbitmqMessagePublished(mbc.Metrics.RabbitmqMessagePublished),
+		metricRabbitmqShovelStatus:         newMetricRabbitmqShovelStatus(mbc.Metrics.RabbitmqShovelStatus),
 	}
 	for _, op := range options {
 		op(mb)
@@ -445,11 +687,15 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricRabbitmqConsumerCount.emit(ils.Metrics())
+	mb.metricRabbitmqConsumerUtilization.emit(ils.Metrics())
+	mb.metricRabbitmqFederationLinkStatus.emit(ils.Metrics())
 	mb.metricRabbitmqMessageAcknowledged.emit(ils.Metrics())
+	mb.metricRabbitmqMessageAge.emit(ils.Metrics())
 	mb.metricRabbitmqMessageCurrent.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDelivered.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDropped.emit(ils.Metrics())
 	mb.metricRabbitmqMessagePublished.emit(ils.Metrics())
+	mb.metricRabbitmqShovelStatus.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -475,11 +721,26 @@ func (mb *MetricsBuilder) RecordRabbitmqConsumerCountDataPoint(ts pcommon.Timest
 	mb.metricRabbitmqConsumerCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqConsumerUtilizationDataPoint adds a data point to rabbitmq.consumer.utilization metric.
+func (mb *MetricsBuilder) RecordRabbitmqConsumerUtilizationDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricRabbitmqConsumerUtilization.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqFederationLinkStatusDataPoint adds a data point to rabbitmq.federation.link.status metric.
+func (mb *MetricsBuilder) RecordRabbitmqFederationLinkStatusDataPoint(ts pcommon.Timestamp, val int64, federationUpstreamAttributeValue string, federationLinkTypeAttributeValue AttributeFederationLinkType, federationLinkTargetAttributeValue string, federationLinkStatusAttributeValue string) {
+	mb.metricRabbitmqFederationLinkStatus.recordDataPoint(mb.startTime, ts, val, federationUpstreamAttributeValue, federationLinkTypeAttributeValue.String(), federationLinkTargetAttributeValue, federationLinkStatusAttributeValue)
+}
+
 // RecordRabbitmqMessageAcknowledgedDataPoint adds a data point to rabbitmq.message.acknowledged metric.
 func (mb *MetricsBuilder) RecordRabbitmqMessageAcknowledgedDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRabbitmqMessageAcknowledged.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqMessageAgeDataPoint adds a data point to rabbitmq.message.age metric.
+func (mb *MetricsBuilder) RecordRabbitmqMessageAgeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRabbitmqMessageAge.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRabbitmqMessageCurrentDataPoint adds a data point to rabbitmq.message.current metric.
 func (mb *MetricsBuilder) RecordRabbitmqMessageCurrentDataPoint(ts pcommon.Timestamp, val int64, messageStateAttributeValue AttributeMessageState) {
 	mb.metricRabbitmqMessageCurrent.recordDataPoint(mb.startTime, ts, val, messageStateAttributeValue.String())
@@ -500,6 +761,11 @@ func (mb *MetricsBuilder) RecordRabbitmqMessagePublishedDataPoint(ts pcommon.Tim
 	mb.metricRabbitmqMessagePublished.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqShovelStatusDataPoint adds a data point to rabbitmq.shovel.status metric.
+func (mb *MetricsBuilder) RecordRabbitmqShovelStatusDataPoint(ts pcommon.Timestamp, val int64, shovelNameAttributeValue string, shovelStateAttributeValue string) {
+	mb.metricRabbitmqShovelStatus.recordDataPoint(mb.startTime, ts, val, shovelNameAttributeValue, shovelStateAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
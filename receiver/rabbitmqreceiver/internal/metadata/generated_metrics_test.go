@@ -58,10 +58,19 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRabbitmqConsumerCountDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRabbitmqConsumerUtilizationDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordRabbitmqFederationLinkStatusDataPoint(ts, 1, "federation.upstream-val", AttributeFederationLinkTypeExchange, "federation.link.target-val", "federation.link.status-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRabbitmqMessageAcknowledgedDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRabbitmqMessageAgeDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRabbitmqMessageCurrentDataPoint(ts, 1, AttributeMessageStateReady)
@@ -78,6 +87,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRabbitmqMessagePublishedDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRabbitmqShovelStatusDataPoint(ts, 1, "shovel.name-val", "shovel.state-val")
+
 			rb := mb.NewResourceBuilder()
 			rb.SetRabbitmqNodeName("rabbitmq.node.name-val")
 			rb.SetRabbitmqQueueName("rabbitmq.queue.name-val")
@@ -118,6 +130,44 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.consumer.utilization":
+					assert.False(t, validatedMetrics["rabbitmq.consumer.utilization"], "Found a duplicate in the metrics slice: rabbitmq.consumer.utilization")
+					validatedMetrics["rabbitmq.consumer.utilization"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The fraction of the time the queue is able to immediately deliver messages to consumers.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "rabbitmq.federation.link.status":
+					assert.False(t, validatedMetrics["rabbitmq.federation.link.status"], "Found a duplicate in the metrics slice: rabbitmq.federation.link.status")
+					validatedMetrics["rabbitmq.federation.link.status"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The status of a federation link. The value is always 1, the status is recorded by the status attribute.", ms.At(i).Description())
+					assert.Equal(t, "{links}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("upstream")
+					assert.True(t, ok)
+					assert.EqualValues(t, "federation.upstream-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "exchange", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("target")
+					assert.True(t, ok)
+					assert.EqualValues(t, "federation.link.target-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("status")
+					assert.True(t, ok)
+					assert.EqualValues(t, "federation.link.status-val", attrVal.Str())
 				case "rabbitmq.message.acknowledged":
 					assert.False(t, validatedMetrics["rabbitmq.message.acknowledged"], "Found a duplicate in the metrics slice: rabbitmq.message.acknowledged")
 					validatedMetrics["rabbitmq.message.acknowledged"] = true
@@ -132,6 +182,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.message.age":
+					assert.False(t, validatedMetrics["rabbitmq.message.age"], "Found a duplicate in the metrics slice: rabbitmq.message.age")
+					validatedMetrics["rabbitmq.message.age"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The age of the oldest message in the queue, for the messages published with a timestamp.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "rabbitmq.message.current":
 					assert.False(t, validatedMetrics["rabbitmq.message.current"], "Found a duplicate in the metrics slice: rabbitmq.message.current")
 					validatedMetrics["rabbitmq.message.current"] = true
@@ -191,6 +253,26 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.shovel.status":
+					assert.False(t, validatedMetrics["rabbitmq.shovel.status"], "Found a duplicate in the metrics slice: rabbitmq.shovel.status")
+					validatedMetrics["rabbitmq.shovel.status"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The status of a shovel. The value is always 1, the state is recorded by the state attribute.", ms.At(i).Description())
+					assert.Equal(t, "{shovels}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "shovel.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "shovel.state-val", attrVal.Str())
 				}
 			}
 		})
@@ -15,6 +15,34 @@ type Queue struct {
 	UnacknowledgedMessages int64 `json:"messages_unacknowledged"`
 	ReadyMessages          int64 `json:"messages_ready"`
 
+	// ConsumerUtilisation is renamed ConsumerCapacity since RabbitMQ 3.12, both
+	// are null when the queue has no consumers.
+	ConsumerUtilisation *float64 `json:"consumer_utilisation"`
+	ConsumerCapacity    *float64 `json:"consumer_capacity"`
+	// HeadMessageTimestamp is the timestamp property of the oldest message in the
+	// queue in seconds, it is null when the message has no timestamp.
+	HeadMessageTimestamp *int64 `json:"head_message_timestamp"`
+
 	// Embedded Metrics
 	MessageStats map[string]interface{} `json:"message_stats"`
 }
+
+// Shovel represents a shovel in the API response
+type Shovel struct {
+	Name  string `json:"name"`
+	Node  string `json:"node"`
+	VHost string `json:"vhost"`
+	Type  string `json:"type"`
+	State string `json:"state"`
+}
+
+// FederationLink represents a federation link in the API response
+type FederationLink struct {
+	Node     string `json:"node"`
+	VHost    string `json:"vhost"`
+	Upstream string `json:"upstream"`
+	Type     string `json:"type"`
+	Exchange string `json:"exchange"`
+	Queue    string `json:"queue"`
+	Status   string `json:"status"`
+}
@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/metadata"
@@ -43,6 +44,7 @@ type rabbitmqScraper struct {
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
+	filter   *resourceFilter
 }
 
 // newScraper creates a new scraper
@@ -57,6 +59,10 @@ func newScraper(logger *zap.Logger, cfg *Config, settings receiver.CreateSetting
 
 // start starts the scraper by creating a new HTTP Client on the scraper
 func (r *rabbitmqScraper) start(_ context.Context, host component.Host) (err error) {
+	r.filter, err = newResourceFilter(r.cfg.Include, r.cfg.Exclude)
+	if err != nil {
+		return err
+	}
 	r.client, err = newClient(r.cfg, host, r.settings, r.logger)
 	return
 }
@@ -78,11 +84,30 @@ func (r *rabbitmqScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 
 	// Collect metrics for each queue
 	for _, queue := range queues {
-
+		if !r.filter.includeQueue(queue.VHost, queue.Name) {
+			continue
+		}
 		r.collectQueue(queue, now)
 	}
 
-	return r.mb.Emit(), nil
+	// The shovel and federation endpoints are only available when the management
+	// plugins are enabled, so they are only called when their metrics are enabled.
+	var errs scrapererror.ScrapeErrors
+	var shovels []*models.Shovel
+	if r.cfg.MetricsBuilderConfig.Metrics.RabbitmqShovelStatus.Enabled {
+		if shovels, err = r.client.GetShovels(ctx); err != nil {
+			errs.AddPartial(1, err)
+		}
+	}
+	var links []*models.FederationLink
+	if r.cfg.MetricsBuilderConfig.Metrics.RabbitmqFederationLinkStatus.Enabled {
+		if links, err = r.client.GetFederationLinks(ctx); err != nil {
+			errs.AddPartial(1, err)
+		}
+	}
+	r.collectVHosts(shovels, links, now)
+
+	return r.mb.Emit(), errs.Combine()
 }
 
 // collectQueue collects metrics
@@ -119,6 +144,20 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 			r.mb.RecordRabbitmqMessageDroppedDataPoint(now, val64)
 		}
 	}
+
+	if utilization := queue.ConsumerUtilisation; utilization != nil {
+		r.mb.RecordRabbitmqConsumerUtilizationDataPoint(now, *utilization)
+	} else if capacity := queue.ConsumerCapacity; capacity != nil {
+		r.mb.RecordRabbitmqConsumerUtilizationDataPoint(now, *capacity)
+	}
+	if queue.HeadMessageTimestamp != nil {
+		age := now.AsTime().Unix() - *queue.HeadMessageTimestamp
+		if age < 0 {
+			age = 0
+		}
+		r.mb.RecordRabbitmqMessageAgeDataPoint(now, age)
+	}
+
 	rb := r.mb.NewResourceBuilder()
 	rb.SetRabbitmqQueueName(queue.Name)
 	rb.SetRabbitmqNodeName(queue.Node)
@@ -126,6 +165,68 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 	r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
+// collectVHosts collects the status of the shovels and federation links, emitted per node and vhost
+func (r *rabbitmqScraper) collectVHosts(shovels []*models.Shovel, links []*models.FederationLink, now pcommon.Timestamp) {
+	var keys []resourceKey
+	seen := make(map[resourceKey]bool)
+	addKey := func(key resourceKey) {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	shovelsByKey := make(map[resourceKey][]*models.Shovel)
+	for _, shovel := range shovels {
+		if !r.filter.includeVHost(shovel.VHost) {
+			continue
+		}
+		key := resourceKey{node: shovel.Node, vhost: shovel.VHost}
+		addKey(key)
+		shovelsByKey[key] = append(shovelsByKey[key], shovel)
+	}
+	linksByKey := make(map[resourceKey][]*models.FederationLink)
+	for _, link := range links {
+		if !r.filter.includeVHost(link.VHost) {
+			continue
+		}
+		if link.Type == metadata.AttributeFederationLinkTypeQueue.String() && !r.filter.includeQueue(link.VHost, link.Queue) {
+			continue
+		}
+		key := resourceKey{node: link.Node, vhost: link.VHost}
+		addKey(key)
+		linksByKey[key] = append(linksByKey[key], link)
+	}
+
+	for _, key := range keys {
+		for _, shovel := range shovelsByKey[key] {
+			r.mb.RecordRabbitmqShovelStatusDataPoint(now, 1, shovel.Name, shovel.State)
+		}
+		for _, link := range linksByKey[key] {
+			linkType, ok := metadata.MapAttributeFederationLinkType[link.Type]
+			if !ok {
+				r.logger.Debug("unsupported federation link type", zap.String("type", link.Type), zap.String("upstream", link.Upstream))
+				continue
+			}
+			target := link.Exchange
+			if linkType == metadata.AttributeFederationLinkTypeQueue {
+				target = link.Queue
+			}
+			r.mb.RecordRabbitmqFederationLinkStatusDataPoint(now, 1, link.Upstream, linkType, target, link.Status)
+		}
+
+		rb := r.mb.NewResourceBuilder()
+		rb.SetRabbitmqNodeName(key.node)
+		rb.SetRabbitmqVhostName(key.vhost)
+		r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+}
+
+// resourceKey identifies the node and vhost of the shovels and federation links
+type resourceKey struct {
+	node  string
+	vhost string
+}
+
 // convertValToInt64 values from message state unmarshal as float64s but should be int64.
 // Need to do a double cast to get an int64.
 // This should never fail but worth checking just in case.
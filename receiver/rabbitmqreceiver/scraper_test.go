@@ -17,9 +17,11 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/mocks"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/models"
@@ -150,3 +152,73 @@ func TestScaperScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScraperScrapeOptionalMetrics(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		shovelsErr    error
+		expectedFile  string
+		expectedError string
+	}{
+		{
+			desc:         "Successful Collection",
+			expectedFile: "metrics_golden_optional.yaml",
+		},
+		{
+			desc:          "Shovels API Call Failure",
+			shovelsErr:    errors.New("non 200 code returned 404"),
+			expectedFile:  "metrics_golden_optional_no_shovels.yaml",
+			expectedError: "non 200 code returned 404",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockClient := mocks.MockClient{}
+			var queues []*models.Queue
+			require.NoError(t, json.Unmarshal(loadAPIResponseData(t, queuesAPIResponseFile), &queues))
+			mockClient.On("GetQueues", mock.Anything).Return(queues, nil)
+			if tc.shovelsErr != nil {
+				mockClient.On("GetShovels", mock.Anything).Return(nil, tc.shovelsErr)
+			} else {
+				var shovels []*models.Shovel
+				require.NoError(t, json.Unmarshal(loadAPIResponseData(t, shovelsAPIResponseFile), &shovels))
+				mockClient.On("GetShovels", mock.Anything).Return(shovels, nil)
+			}
+			var links []*models.FederationLink
+			require.NoError(t, json.Unmarshal(loadAPIResponseData(t, federationLinksAPIResponseFile), &links))
+			mockClient.On("GetFederationLinks", mock.Anything).Return(links, nil)
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.Metrics.RabbitmqConsumerUtilization.Enabled = true
+			cfg.Metrics.RabbitmqMessageAge.Enabled = true
+			cfg.Metrics.RabbitmqShovelStatus.Enabled = true
+			cfg.Metrics.RabbitmqFederationLinkStatus.Enabled = true
+			cfg.Exclude = MatchConfig{
+				Config: filterset.Config{MatchType: filterset.Strict},
+				Queues: []string{"test2"},
+			}
+
+			scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopCreateSettings())
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+			scraper.client = &mockClient
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedError)
+				require.True(t, scrapererror.IsPartialScrapeError(err))
+			}
+
+			expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "expected_metrics", tc.expectedFile))
+			require.NoError(t, err)
+			require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+				pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp(),
+				pmetrictest.IgnoreResourceMetricsOrder(),
+				pmetrictest.IgnoreMetricDataPointsOrder(),
+				pmetrictest.IgnoreMetricValues("rabbitmq.message.age"),
+			))
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cast"
@@ -78,6 +79,8 @@ type Config struct {
 	// ResourceAttributes is a map of default resource attributes to add to each resource
 	// object received by this receiver from dynamically created receivers.
 	ResourceAttributes resourceAttributes `mapstructure:"resource_attributes"`
+	// FilelogAnnotations configures the filelog receivers created for the discovered pods from their annotations.
+	FilelogAnnotations filelogAnnotationsConfig `mapstructure:"filelog_annotations"`
 }
 
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
@@ -98,6 +101,10 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 		}
 	}
 
+	if cfg.FilelogAnnotations.Enabled && cfg.FilelogAnnotations.AnnotationPrefix == "" {
+		return errors.New("filelog_annotations::annotation_prefix must not be empty")
+	}
+
 	receiversCfg, err := componentParser.Sub(receiversConfigKey)
 	if err != nil {
 		return fmt.Errorf("unable to extract key %v: %w", receiversConfigKey, err)
@@ -115,8 +115,22 @@ func TestLoadConfig(t *testing.T) {
 					observer.HostPortType:  {"hostport.key": "hostport.value"},
 					observer.K8sNodeType:   {"k8s.node.key": "k8s.node.value"},
 				},
+				FilelogAnnotations: filelogAnnotationsConfig{AnnotationPrefix: defaultFilelogAnnotationPrefix},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "filelog"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.WatchObservers = []component.ID{component.NewID("mock_observer")}
+				cfg.FilelogAnnotations = filelogAnnotationsConfig{
+					Enabled:          true,
+					AnnotationPrefix: "example.com/logs",
+					Config:           userConfigMap{"start_at": "beginning"},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -160,6 +174,17 @@ func TestInvalidReceiverResourceAttributeValueType(t *testing.T) {
 	require.Nil(t, cfg)
 }
 
+func TestInvalidFilelogAnnotationPrefix(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.Nil(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "invalid-filelog-annotations.yaml"), factories)
+	require.Contains(t, err.Error(), "error reading configuration for \"receiver_creator\": filelog_annotations::annotation_prefix must not be empty")
+	require.Nil(t, cfg)
+}
+
 type nopWithEndpointConfig struct {
 	Endpoint string `mapstructure:"endpoint"`
 	IntField int    `mapstructure:"int_field"`
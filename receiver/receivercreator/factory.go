@@ -53,6 +53,9 @@ func createDefaultConfig() component.Config {
 				conventions.AttributeK8SNodeUID:  "`uid`",
 			},
 		},
+		FilelogAnnotations: filelogAnnotationsConfig{
+			AnnotationPrefix: defaultFilelogAnnotationPrefix,
+		},
 		receiverTemplates: map[string]receiverTemplate{},
 	}
 }
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+const (
+	defaultFilelogAnnotationPrefix = "io.opentelemetry.discovery.logs"
+
+	// The annotations, after the prefix and a slash, of the pods configuring their filelog receiver.
+	filelogEnabledAnnotation   = "enabled"
+	filelogPathsAnnotation     = "paths"
+	filelogMultilineAnnotation = "multiline"
+	filelogParserAnnotation    = "parser"
+
+	// criRegex parses the lines of the container logs written by the CRI container runtimes.
+	criRegex      = `^(?P<time>[^ ]+) (?P<stream>stdout|stderr) (?P<logtag>[^ ]*) ?(?P<log>.*)$`
+	criTimeLayout = "2006-01-02T15:04:05.999999999Z07:00"
+)
+
+var filelogAnnotationsReceiverID = component.NewIDWithName("filelog", "annotations")
+
+// filelogAnnotationsConfig configures the filelog receivers created for the discovered pods from their annotations.
+type filelogAnnotationsConfig struct {
+	// Enabled starts a filelog receiver for each pod annotated with `<annotation_prefix>/enabled: "true"`.
+	Enabled bool `mapstructure:"enabled"`
+	// AnnotationPrefix is the prefix of the pod annotations configuring the filelog receivers.
+	AnnotationPrefix string `mapstructure:"annotation_prefix"`
+	// Config is the default config of the filelog receivers, overridden by the annotations of the pods.
+	Config userConfigMap `mapstructure:"config"`
+}
+
+// parserPresets are the operators of the values of the parser annotation.
+var parserPresets = map[string][]any{
+	"json": {
+		map[string]any{"type": "json_parser"},
+	},
+	"cri": criOperators(),
+	"cri_json": append(criOperators(),
+		map[string]any{"type": "json_parser", "parse_from": "body"},
+	),
+}
+
+func criOperators() []any {
+	return []any{
+		map[string]any{
+			"type":  "regex_parser",
+			"regex": criRegex,
+			"timestamp": map[string]any{
+				"parse_from":  "attributes.time",
+				"layout_type": "gotime",
+				"layout":      criTimeLayout,
+			},
+		},
+		map[string]any{"type": "move", "from": "attributes.log", "to": "body"},
+		map[string]any{"type": "move", "from": "attributes.stream", "to": `attributes["log.iostream"]`},
+		map[string]any{"type": "remove", "field": "attributes.time"},
+		map[string]any{"type": "remove", "field": "attributes.logtag"},
+	}
+}
+
+// receiverTemplate returns the template of the filelog receiver configured by the annotations of
+// the endpoint, or false if the endpoint isn't a pod annotated to enable it.
+func (cfg filelogAnnotationsConfig) receiverTemplate(e observer.Endpoint) (receiverTemplate, bool, error) {
+	pod, ok := e.Details.(*observer.Pod)
+	if !ok {
+		return receiverTemplate{}, false, nil
+	}
+	annotation := func(name string) string {
+		return strings.TrimSpace(pod.Annotations[cfg.AnnotationPrefix+"/"+name])
+	}
+	if annotation(filelogEnabledAnnotation) != "true" {
+		return receiverTemplate{}, false, nil
+	}
+
+	config := userConfigMap{}
+	for k, v := range cfg.Config {
+		config[k] = v
+	}
+
+	// The pods can only read their own logs, not any file of the node.
+	podLogsDir := fmt.Sprintf("/var/log/pods/%s_%s_%s/", pod.Namespace, pod.Name, pod.UID)
+	var paths []string
+	for _, p := range strings.Split(annotation(filelogPathsAnnotation), ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		if !strings.HasPrefix(path.Clean(p), podLogsDir) {
+			return receiverTemplate{}, false, fmt.Errorf("%s/%s annotation path %q is not in the %s directory of the pod", cfg.AnnotationPrefix, filelogPathsAnnotation, p, podLogsDir)
+		}
+		paths = append(paths, p)
+	}
+	if len(paths) == 0 {
+		if _, ok := config["include"]; !ok {
+			paths = []string{podLogsDir + "*/*.log"}
+		}
+	}
+	if len(paths) > 0 {
+		config["include"] = paths
+	}
+
+	if pattern := annotation(filelogMultilineAnnotation); pattern != "" {
+		config["multiline"] = map[string]any{"line_start_pattern": pattern}
+	}
+
+	if parser := annotation(filelogParserAnnotation); parser != "" {
+		operators, ok := parserPresets[parser]
+		if !ok {
+			return receiverTemplate{}, false, fmt.Errorf("unsupported %s/%s annotation %q, expected one of json, cri or cri_json", cfg.AnnotationPrefix, filelogParserAnnotation, parser)
+		}
+		config["operators"] = operators
+	}
+
+	return receiverTemplate{
+		receiverConfig: receiverConfig{
+			id:         filelogAnnotationsReceiverID,
+			config:     config,
+			endpointID: e.ID,
+		},
+	}, true, nil
+}
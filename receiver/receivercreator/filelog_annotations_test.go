@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func annotatedPodEndpoint(annotations map[string]string) observer.Endpoint {
+	p := pod
+	p.Annotations = annotations
+	return observer.Endpoint{
+		ID:      podEndpoint.ID,
+		Target:  podEndpoint.Target,
+		Details: &p,
+	}
+}
+
+func TestFilelogAnnotationsReceiverTemplate(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         userConfigMap
+		endpoint       observer.Endpoint
+		expectedOK     bool
+		expectedConfig userConfigMap
+		expectedError  string
+	}{
+		{
+			name:     "not a pod",
+			endpoint: portEndpoint,
+		},
+		{
+			name:     "not enabled",
+			endpoint: podEndpoint,
+		},
+		{
+			name: "disabled",
+			endpoint: annotatedPodEndpoint(map[string]string{
+				"io.opentelemetry.discovery.logs/enabled": "false",
+			}),
+		},
+		{
+			name: "default paths",
+			endpoint: annotatedPodEndpoint(map[string]string{
+				"io.opentelemetry.discovery.logs/enabled": "true",
+			}),
+			expectedOK: true,
+			expectedConfig: userConfigMap{
+				"include": []string{"/var/log/pods/default_pod-1_uid-1/*/*.log"},
+			},
+		},
+		{
+			name:   "default config include",
+			config: userConfigMap{"include": []string{"/var/log/containers/*.log"}, "start_at": "beginning"},
+			endpoint: annotatedPodEndpoint(map[string]string{
+				"io.opentelemetry.discovery.logs/enabled": "true",
+			}),
+			expectedOK: true,
+			expectedConfig: userConfigMap{
+				"include":  []string{"/var/log/containers/*.log"},
+				"start_at": "beginning",
+			},
+		},
+		{
+			name:   "annotations",
+			config: userConfigMap{"include": []string{"/var/log/containers/*.log"}, "start_at": "beginning"},
+			endpoint: annotatedPodEndpoint(map[string]string{
+				"io.opentelemetry.discovery.logs/enabled":   "true",
+				"io.opentelemetry.discovery.logs/paths":     "/var/log/pods/default_pod-1_uid-1/app/a.log, /var/log/pods/default_pod-1_uid-1/app/b.log,",
+				"io.opentelemetry.discovery.logs/multiline": `^\d{4}-\d{2}-\d{2}`,
+				"io.opentelemetry.discovery.logs/parser":    "json",
+			}),
+			expectedOK: true,
+			expectedConfig: userConfigMap{
+				"include":   []string{"/var/log/pods/default_pod-1_uid-1/app/a.log", "/var/log/pods/default_pod-1_uid-1/app/b.log"},
+				"start_at":  "beginning",
+				"multiline": map[string]any{"line_start_pattern": `^\d{4}-\d{2}-\d{2}`},
+				"operators": parserPresets["json"],
+			},
+		},
+		{
+			name: "cri_json parser",
+			endpoint: annotatedPodEndpoint(map[string]string{
+				"io.opentelemetry.discovery.logs/enabled": "true",
+				"io.opentelemetry.discovery.logs/parser":  "cri_json",
+			}),
+			expectedOK: true,
+			expectedConfig: userConfigMap{
+				"include":   []string{"/var/log/pods/default_pod-1_uid-1/*/*.log"},
+				"operators": append(criOperators(), map[string]any{"type": "json_parser", "parse_from": "body"}),
+			},
+		},
+		{
+			name: "path outside of the pod logs",
+			endpoint: annotatedPodEndpoint(map[string]string{
+				"io.opentelemetry.discovery.logs/enabled": "true",
+				"io.opentelemetry.discovery.logs/paths":   "/var/log/pods/default_pod-1_uid-1/*/*.log,/etc/shadow",
+			}),
+			expectedError: `io.opentelemetry.discovery.logs/paths annotation path "/etc/shadow" is not in the /var/log/pods/default_pod-1_uid-1/ directory of the pod`,
+		},
+		{
+			name: "path escaping the pod logs",
+			endpoint: annotatedPodEndpoint(map[string]string{
+				"io.opentelemetry.discovery.logs/enabled": "true",
+				"io.opentelemetry.discovery.logs/paths":   "/var/log/pods/default_pod-1_uid-1/../other_pod-2_uid-2/*/*.log",
+			}),
+			expectedError: `io.opentelemetry.discovery.logs/paths annotation path "/var/log/pods/default_pod-1_uid-1/../other_pod-2_uid-2/*/*.log" is not in the /var/log/pods/default_pod-1_uid-1/ directory of the pod`,
+		},
+		{
+			name: "unsupported parser",
+			endpoint: annotatedPodEndpoint(map[string]string{
+				"io.opentelemetry.discovery.logs/enabled": "true",
+				"io.opentelemetry.discovery.logs/parser":  "xml",
+			}),
+			expectedError: `unsupported io.opentelemetry.discovery.logs/parser annotation "xml", expected one of json, cri or cri_json`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := filelogAnnotationsConfig{
+				Enabled:          true,
+				AnnotationPrefix: defaultFilelogAnnotationPrefix,
+				Config:           tt.config,
+			}
+			template, ok, err := cfg.receiverTemplate(tt.endpoint)
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOK, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, filelogAnnotationsReceiverID, template.id)
+			assert.Equal(t, tt.endpoint.ID, template.endpointID)
+			assert.Equal(t, tt.expectedConfig, template.config)
+		})
+	}
+}
+
+func TestFilelogAnnotationsReceiverTemplateDoesNotModifyConfig(t *testing.T) {
+	cfg := filelogAnnotationsConfig{
+		Enabled:          true,
+		AnnotationPrefix: "example.com/logs",
+		Config:           userConfigMap{"start_at": "beginning"},
+	}
+	_, ok, err := cfg.receiverTemplate(annotatedPodEndpoint(map[string]string{
+		"example.com/logs/enabled": "true",
+		"example.com/logs/parser":  "cri",
+	}))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, userConfigMap{"start_at": "beginning"}, cfg.Config)
+}
@@ -93,76 +93,92 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 				continue
 			}
 
-			obs.params.TelemetrySettings.Logger.Info("starting receiver",
-				zap.String("name", template.id.String()),
-				zap.String("endpoint", e.Target),
-				zap.String("endpoint_id", string(e.ID)))
-
 			resolvedConfig, err := expandConfig(template.config, env)
 			if err != nil {
 				obs.params.TelemetrySettings.Logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
 				continue
 			}
+			obs.startReceiver(template, resolvedConfig, env, e)
+		}
 
-			discoveredCfg := userConfigMap{}
-			// If user didn't set endpoint set to default value as well as
-			// flag indicating we've done this for later validation.
-			if _, ok := resolvedConfig[endpointConfigKey]; !ok {
-				discoveredCfg[endpointConfigKey] = e.Target
-				discoveredCfg[tmpSetEndpointConfigKey] = struct{}{}
-			}
+		if !obs.config.FilelogAnnotations.Enabled {
+			continue
+		}
+		template, ok, err := obs.config.FilelogAnnotations.receiverTemplate(e)
+		if err != nil {
+			obs.params.TelemetrySettings.Logger.Error("invalid filelog annotations", zap.String("endpoint_id", string(e.ID)), zap.Error(err))
+			continue
+		}
+		if ok {
+			obs.startReceiver(template, template.config, env, e)
+		}
+	}
+}
 
-			// Though not necessary with contrib provided observers, nothing is stopping custom
-			// ones from using expr in their Target values.
-			discoveredConfig, err := expandConfig(discoveredCfg, env)
-			if err != nil {
-				obs.params.TelemetrySettings.Logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+// startReceiver starts a receiver instance of the template with the resolved config for the endpoint.
+func (obs *observerHandler) startReceiver(template receiverTemplate, resolvedConfig userConfigMap, env observer.EndpointEnv, e observer.Endpoint) {
+	obs.params.TelemetrySettings.Logger.Info("starting receiver",
+		zap.String("name", template.id.String()),
+		zap.String("endpoint", e.Target),
+		zap.String("endpoint_id", string(e.ID)))
+
+	discoveredCfg := userConfigMap{}
+	// If user didn't set endpoint set to default value as well as
+	// flag indicating we've done this for later validation.
+	if _, ok := resolvedConfig[endpointConfigKey]; !ok {
+		discoveredCfg[endpointConfigKey] = e.Target
+		discoveredCfg[tmpSetEndpointConfigKey] = struct{}{}
+	}
 
-			resAttrs := map[string]string{}
-			for k, v := range template.ResourceAttributes {
-				strVal, ok := v.(string)
-				if !ok {
-					obs.params.TelemetrySettings.Logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
-					continue
-				}
-				resAttrs[k] = strVal
-			}
+	// Though not necessary with contrib provided observers, nothing is stopping custom
+	// ones from using expr in their Target values.
+	discoveredConfig, err := expandConfig(discoveredCfg, env)
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
 
-			// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
-			// as telemetry is emitted.
-			var consumer *enhancingConsumer
-			if consumer, err = newEnhancingConsumer(
-				obs.config.ResourceAttributes,
-				resAttrs,
-				env,
-				e,
-				obs.nextLogsConsumer,
-				obs.nextMetricsConsumer,
-				obs.nextTracesConsumer,
-			); err != nil {
-				obs.params.TelemetrySettings.Logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+	resAttrs := map[string]string{}
+	for k, v := range template.ResourceAttributes {
+		strVal, ok := v.(string)
+		if !ok {
+			obs.params.TelemetrySettings.Logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
+			continue
+		}
+		resAttrs[k] = strVal
+	}
 
-			var receiver component.Component
-			if receiver, err = obs.runner.start(
-				receiverConfig{
-					id:         template.id,
-					config:     resolvedConfig,
-					endpointID: e.ID,
-				},
-				discoveredConfig,
-				consumer,
-			); err != nil {
-				obs.params.TelemetrySettings.Logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+	// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
+	// as telemetry is emitted.
+	var consumer *enhancingConsumer
+	if consumer, err = newEnhancingConsumer(
+		obs.config.ResourceAttributes,
+		resAttrs,
+		env,
+		e,
+		obs.nextLogsConsumer,
+		obs.nextMetricsConsumer,
+		obs.nextTracesConsumer,
+	); err != nil {
+		obs.params.TelemetrySettings.Logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
 
-			obs.receiversByEndpointID.Put(e.ID, receiver)
-		}
+	var receiver component.Component
+	if receiver, err = obs.runner.start(
+		receiverConfig{
+			id:         template.id,
+			config:     resolvedConfig,
+			endpointID: e.ID,
+		},
+		discoveredConfig,
+		consumer,
+	); err != nil {
+		obs.params.TelemetrySettings.Logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
 	}
+
+	obs.receiversByEndpointID.Put(e.ID, receiver)
 }
 
 // OnRemove responds to endpoint removal notifications.
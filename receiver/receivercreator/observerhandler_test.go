@@ -222,6 +222,67 @@ func TestOnAddForLogs(t *testing.T) {
 	}
 }
 
+type nopFilelogConfig struct {
+	Include   []string         `mapstructure:"include"`
+	StartAt   string           `mapstructure:"start_at"`
+	Multiline map[string]any   `mapstructure:"multiline"`
+	Operators []map[string]any `mapstructure:"operators"`
+}
+
+type nopFilelogFactory struct {
+	nopWithoutEndpointFactory
+}
+
+func (*nopFilelogFactory) CreateDefaultConfig() component.Config {
+	return &nopFilelogConfig{StartAt: "end"}
+}
+
+func TestOnAddFilelogAnnotations(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.FilelogAnnotations.Enabled = true
+	cfg.FilelogAnnotations.Config = userConfigMap{"start_at": "beginning"}
+
+	handler, mr := newObserverHandler(t, cfg, consumertest.NewNop(), nil, nil)
+	mr.host.(*mockHost).factories.Receivers["filelog"] = &nopFilelogFactory{nopWithoutEndpointFactory{Factory: receivertest.NewNopFactory()}}
+
+	handler.OnAdd([]observer.Endpoint{
+		podEndpoint,
+		annotatedPodEndpoint(map[string]string{
+			"io.opentelemetry.discovery.logs/enabled":   "true",
+			"io.opentelemetry.discovery.logs/multiline": "^start",
+			"io.opentelemetry.discovery.logs/parser":    "json",
+		}),
+	})
+
+	require.NoError(t, mr.lastError)
+	assert.Equal(t, 1, handler.receiversByEndpointID.Size())
+
+	wr, ok := mr.startedComponent.(*wrappedReceiver)
+	require.True(t, ok)
+	rcvr, ok := wr.logs.(*nopWithoutEndpointReceiver)
+	require.True(t, ok)
+	assert.Equal(t, &nopFilelogConfig{
+		Include:   []string{"/var/log/pods/default_pod-1_uid-1/*/*.log"},
+		StartAt:   "beginning",
+		Multiline: map[string]any{"line_start_pattern": "^start"},
+		Operators: []map[string]any{{"type": "json_parser"}},
+	}, rcvr.cfg)
+}
+
+func TestOnAddFilelogAnnotationsDisabled(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+
+	handler, mr := newObserverHandler(t, cfg, consumertest.NewNop(), nil, nil)
+	handler.OnAdd([]observer.Endpoint{
+		annotatedPodEndpoint(map[string]string{
+			"io.opentelemetry.discovery.logs/enabled": "true",
+		}),
+	})
+
+	assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+	require.Nil(t, mr.startedComponent)
+}
+
 func TestOnAddForTraces(t *testing.T) {
 	for _, test := range []struct {
 		name                   string
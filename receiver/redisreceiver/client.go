@@ -4,6 +4,10 @@
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
 import (
+	"fmt"
+	"net"
+	"strings"
+
 	"github.com/go-redis/redis/v7"
 )
 
@@ -11,6 +15,12 @@ import (
 type client interface {
 	// retrieves a string of key/value pairs of redis metadata
 	retrieveInfo() (string, error)
+	// retrieves a string of key/value pairs of the state of the cluster, as seen by the node
+	retrieveClusterInfo() (string, error)
+	// retrieves the CLUSTER NODES lines describing the nodes of the cluster known by the node
+	retrieveClusterNodes() (string, error)
+	// retrieves the addresses of the healthy replicas of the master monitored by the sentinel
+	retrieveSentinelReplicas(master string) ([]string, error)
 	// line delimiter
 	// redis lines are delimited by \r\n, files (for testing) by \n
 	delimiter() string
@@ -46,3 +56,56 @@ func (c *redisClient) retrieveInfo() (string, error) {
 func (c *redisClient) close() error {
 	return c.client.Close()
 }
+
+// Retrieve Redis CLUSTER INFO.
+func (c *redisClient) retrieveClusterInfo() (string, error) {
+	return c.client.ClusterInfo().Result()
+}
+
+// Retrieve Redis CLUSTER NODES.
+func (c *redisClient) retrieveClusterNodes() (string, error) {
+	return c.client.ClusterNodes().Result()
+}
+
+// Retrieve the replicas of the master with SENTINEL SLAVES, the alias of SENTINEL REPLICAS
+// supported by all the Redis versions. Each replica is returned as a flat list of
+// field/value pairs.
+func (c *redisClient) retrieveSentinelReplicas(master string) ([]string, error) {
+	res, err := c.client.Do("SENTINEL", "SLAVES", master).Result()
+	if err != nil {
+		return nil, err
+	}
+	replicas, ok := res.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected SENTINEL SLAVES reply %T", res)
+	}
+	var addrs []string
+	for _, replica := range replicas {
+		fields, ok := replica.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected SENTINEL SLAVES replica %T", replica)
+		}
+		values := map[string]string{}
+		for i := 0; i+1 < len(fields); i += 2 {
+			key, _ := fields[i].(string)
+			value, _ := fields[i+1].(string)
+			values[key] = value
+		}
+		if !healthyReplica(values["flags"]) {
+			continue
+		}
+		addrs = append(addrs, net.JoinHostPort(values["ip"], values["port"]))
+	}
+	return addrs, nil
+}
+
+// healthyReplica returns whether a replica with the sentinel flags can be scraped.
+func healthyReplica(flags string) bool {
+	for _, flag := range strings.Split(flags, ",") {
+		switch flag {
+		case "s_down", "o_down", "disconnected":
+			return false
+		}
+	}
+	return true
+}
@@ -33,6 +33,18 @@ func (fakeClient) retrieveInfo() (string, error) {
 	return readFile("info")
 }
 
+func (fakeClient) retrieveClusterInfo() (string, error) {
+	return readFile("cluster_info")
+}
+
+func (fakeClient) retrieveClusterNodes() (string, error) {
+	return readFile("cluster_nodes")
+}
+
+func (fakeClient) retrieveSentinelReplicas(string) ([]string, error) {
+	return nil, nil
+}
+
 func (fakeClient) close() error {
 	return nil
 }
@@ -51,3 +63,9 @@ func TestRetrieveInfo(t *testing.T) {
 	require.Nil(t, err)
 	require.True(t, strings.HasPrefix(res, "# Server"))
 }
+
+func TestHealthyReplica(t *testing.T) {
+	require.True(t, healthyReplica("slave"))
+	require.False(t, healthyReplica("s_down,slave"))
+	require.False(t, healthyReplica("slave,disconnected"))
+}
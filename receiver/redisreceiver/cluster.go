@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Holds the fields of a line of the CLUSTER NODES command: e.g.
+// "07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 slave e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 0 1426238317239 4 connected"
+type clusterNode struct {
+	id      string
+	address string
+	flags   []string
+	slots   int
+}
+
+// Returns whether the node has the flag, e.g. "myself" or "master".
+func (n clusterNode) hasFlag(flag string) bool {
+	for _, f := range n.flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns whether the node can be scraped: it has an address and is neither failing
+// nor joining the cluster.
+func (n clusterNode) reachable() bool {
+	return n.address != "" && !n.hasFlag("fail") && !n.hasFlag("noaddr") && !n.hasFlag("handshake")
+}
+
+// Turns the lines of the CLUSTER NODES command into clusterNode structs, skipping the
+// malformed lines.
+func parseClusterNodes(lines []string) []clusterNode {
+	var nodes []clusterNode
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 8 {
+			continue
+		}
+		node := clusterNode{
+			id:      fields[0],
+			address: clusterNodeAddress(fields[1]),
+			flags:   strings.Split(fields[2], ","),
+		}
+		for _, slots := range fields[8:] {
+			node.slots += countSlots(slots)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// Returns the host:port address of the node from its "ip:port@cport[,hostname]" field,
+// empty for the nodes without address, e.g. ":0@0".
+func clusterNodeAddress(field string) string {
+	if i := strings.IndexAny(field, "@,"); i >= 0 {
+		field = field[:i]
+	}
+	if strings.HasPrefix(field, ":") {
+		return ""
+	}
+	return field
+}
+
+// Returns the number of slots of a slot field of the CLUSTER NODES command, either a slot
+// "5461" or a range of slots "0-5460". The slots being migrated, e.g. "[93->-292f8b36]",
+// are not counted.
+func countSlots(field string) int {
+	if strings.HasPrefix(field, "[") {
+		return 0
+	}
+	first, last, isRange := strings.Cut(field, "-")
+	start, err := strconv.Atoi(first)
+	if err != nil {
+		return 0
+	}
+	if !isRange {
+		return 1
+	}
+	end, err := strconv.Atoi(last)
+	if err != nil || end < start {
+		return 0
+	}
+	return end - start + 1
+}
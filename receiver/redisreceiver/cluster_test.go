@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClusterNodes(t *testing.T) {
+	str, err := readFile("cluster_nodes")
+	require.NoError(t, err)
+	nodes := parseClusterNodes(strings.Split(str, "\n"))
+	require.Len(t, nodes, 7)
+
+	assert.Equal(t, "127.0.0.1:30004", nodes[0].address)
+	assert.True(t, nodes[0].hasFlag("slave"))
+	assert.Equal(t, 0, nodes[0].slots)
+	assert.True(t, nodes[0].reachable())
+
+	assert.Equal(t, 5462, nodes[1].slots)
+	assert.False(t, nodes[4].reachable())
+
+	myself := nodes[5]
+	assert.Equal(t, "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", myself.id)
+	assert.Equal(t, "127.0.0.1:30001", myself.address)
+	assert.True(t, myself.hasFlag("myself"))
+	assert.True(t, myself.hasFlag("master"))
+	assert.Equal(t, 5461, myself.slots)
+
+	assert.Equal(t, "", nodes[6].address)
+	assert.False(t, nodes[6].reachable())
+}
+
+func TestParseClusterNodes_Malformed(t *testing.T) {
+	nodes := parseClusterNodes([]string{"", "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001"})
+	assert.Empty(t, nodes)
+}
+
+func TestCountSlots(t *testing.T) {
+	tests := []struct {
+		field string
+		slots int
+	}{
+		{field: "5461", slots: 1},
+		{field: "0-5460", slots: 5461},
+		{field: "10-9", slots: 0},
+		{field: "[93->-292f8b365bb7edb5e285caf0b7e6ddc7265d2f4f]", slots: 0},
+		{field: "invalid", slots: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.field, func(t *testing.T) {
+			assert.Equal(t, test.slots, countSlots(test.field))
+		})
+	}
+}
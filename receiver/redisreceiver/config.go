@@ -4,6 +4,8 @@
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -12,6 +14,17 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
 )
 
+// The modes of the receiver, defining the nodes it scrapes.
+const (
+	// ModeStandalone scrapes the node of the endpoint.
+	ModeStandalone = "standalone"
+	// ModeCluster scrapes the nodes of the cluster the node of the endpoint belongs to.
+	ModeCluster = "cluster"
+	// ModeSentinel scrapes the sentinel of the endpoint, and the masters it monitors
+	// with their replicas.
+	ModeSentinel = "sentinel"
+)
+
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	// TODO: Use one of the configs from core.
@@ -26,5 +39,18 @@ type Config struct {
 
 	TLS configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
 
+	// Mode is one of standalone, cluster or sentinel. The nodes discovered in the cluster
+	// and sentinel modes are connected to with the password and TLS settings of the endpoint.
+	Mode string `mapstructure:"mode"`
+
 	MetricsBuilderConfig metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
+
+func (cfg *Config) Validate() error {
+	switch cfg.Mode {
+	case ModeStandalone, ModeCluster, ModeSentinel:
+		return nil
+	default:
+		return fmt.Errorf("unknown mode %q, expected one of standalone, cluster or sentinel", cfg.Mode)
+	}
+}
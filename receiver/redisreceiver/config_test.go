@@ -39,6 +39,7 @@ func TestConfig(t *testing.T) {
 				Insecure: true,
 			},
 			Password: "test",
+			Mode:     ModeStandalone,
 			ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
 				CollectionInterval: 10 * time.Second,
 				InitialDelay:       time.Second,
@@ -48,3 +49,12 @@ func TestConfig(t *testing.T) {
 		cfg,
 	)
 }
+
+func TestConfigValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, component.ValidateConfig(cfg))
+	cfg.Mode = ModeCluster
+	require.NoError(t, component.ValidateConfig(cfg))
+	cfg.Mode = "replicated"
+	assert.EqualError(t, component.ValidateConfig(cfg), `unknown mode "replicated", expected one of standalone, cluster or sentinel`)
+}
@@ -35,6 +35,7 @@ func createDefaultConfig() component.Config {
 		TLS: configtls.TLSClientSetting{
 			Insecure: true,
 		},
+		Mode:                      ModeStandalone,
 		ScraperControllerSettings: scs,
 		MetricsBuilderConfig:      metadata.DefaultMetricsBuilderConfig(),
 	}
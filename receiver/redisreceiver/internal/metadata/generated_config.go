@@ -29,6 +29,11 @@ type MetricsConfig struct {
 	RedisClientsConnected                  MetricConfig `mapstructure:"redis.clients.connected"`
 	RedisClientsMaxInputBuffer             MetricConfig `mapstructure:"redis.clients.max_input_buffer"`
 	RedisClientsMaxOutputBuffer            MetricConfig `mapstructure:"redis.clients.max_output_buffer"`
+	RedisClusterKnownNodes                 MetricConfig `mapstructure:"redis.cluster.known_nodes"`
+	RedisClusterNodeSlots                  MetricConfig `mapstructure:"redis.cluster.node.slots"`
+	RedisClusterSize                       MetricConfig `mapstructure:"redis.cluster.size"`
+	RedisClusterSlots                      MetricConfig `mapstructure:"redis.cluster.slots"`
+	RedisClusterState                      MetricConfig `mapstructure:"redis.cluster.state"`
 	RedisCmdCalls                          MetricConfig `mapstructure:"redis.cmd.calls"`
 	RedisCmdUsec                           MetricConfig `mapstructure:"redis.cmd.usec"`
 	RedisCommands                          MetricConfig `mapstructure:"redis.commands"`
@@ -41,6 +46,7 @@ type MetricsConfig struct {
 	RedisDbKeys                            MetricConfig `mapstructure:"redis.db.keys"`
 	RedisKeysEvicted                       MetricConfig `mapstructure:"redis.keys.evicted"`
 	RedisKeysExpired                       MetricConfig `mapstructure:"redis.keys.expired"`
+	RedisKeyspaceHitRatio                  MetricConfig `mapstructure:"redis.keyspace.hit_ratio"`
 	RedisKeyspaceHits                      MetricConfig `mapstructure:"redis.keyspace.hits"`
 	RedisKeyspaceMisses                    MetricConfig `mapstructure:"redis.keyspace.misses"`
 	RedisLatestFork                        MetricConfig `mapstructure:"redis.latest_fork"`
@@ -54,8 +60,13 @@ type MetricsConfig struct {
 	RedisNetOutput                         MetricConfig `mapstructure:"redis.net.output"`
 	RedisRdbChangesSinceLastSave           MetricConfig `mapstructure:"redis.rdb.changes_since_last_save"`
 	RedisReplicationBacklogFirstByteOffset MetricConfig `mapstructure:"redis.replication.backlog_first_byte_offset"`
+	RedisReplicationLag                    MetricConfig `mapstructure:"redis.replication.lag"`
 	RedisReplicationOffset                 MetricConfig `mapstructure:"redis.replication.offset"`
+	RedisReplicationOffsetLag              MetricConfig `mapstructure:"redis.replication.offset_lag"`
 	RedisRole                              MetricConfig `mapstructure:"redis.role"`
+	RedisSentinelMasterReplicas            MetricConfig `mapstructure:"redis.sentinel.master.replicas"`
+	RedisSentinelMasterSentinels           MetricConfig `mapstructure:"redis.sentinel.master.sentinels"`
+	RedisSentinelMasters                   MetricConfig `mapstructure:"redis.sentinel.masters"`
 	RedisSlavesConnected                   MetricConfig `mapstructure:"redis.slaves.connected"`
 	RedisUptime                            MetricConfig `mapstructure:"redis.uptime"`
 }
@@ -74,6 +85,21 @@ func DefaultMetricsConfig() MetricsConfig {
 		RedisClientsMaxOutputBuffer: MetricConfig{
 			Enabled: true,
 		},
+		RedisClusterKnownNodes: MetricConfig{
+			Enabled: true,
+		},
+		RedisClusterNodeSlots: MetricConfig{
+			Enabled: true,
+		},
+		RedisClusterSize: MetricConfig{
+			Enabled: true,
+		},
+		RedisClusterSlots: MetricConfig{
+			Enabled: true,
+		},
+		RedisClusterState: MetricConfig{
+			Enabled: true,
+		},
 		RedisCmdCalls: MetricConfig{
 			Enabled: false,
 		},
@@ -110,6 +136,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		RedisKeysExpired: MetricConfig{
 			Enabled: true,
 		},
+		RedisKeyspaceHitRatio: MetricConfig{
+			Enabled: false,
+		},
 		RedisKeyspaceHits: MetricConfig{
 			Enabled: true,
 		},
@@ -149,12 +178,27 @@ func DefaultMetricsConfig() MetricsConfig {
 		RedisReplicationBacklogFirstByteOffset: MetricConfig{
 			Enabled: true,
 		},
+		RedisReplicationLag: MetricConfig{
+			Enabled: true,
+		},
 		RedisReplicationOffset: MetricConfig{
 			Enabled: true,
 		},
+		RedisReplicationOffsetLag: MetricConfig{
+			Enabled: true,
+		},
 		RedisRole: MetricConfig{
 			Enabled: false,
 		},
+		RedisSentinelMasterReplicas: MetricConfig{
+			Enabled: true,
+		},
+		RedisSentinelMasterSentinels: MetricConfig{
+			Enabled: true,
+		},
+		RedisSentinelMasters: MetricConfig{
+			Enabled: true,
+		},
 		RedisSlavesConnected: MetricConfig{
 			Enabled: true,
 		},
@@ -171,11 +215,19 @@ type ResourceAttributeConfig struct {
 
 // ResourceAttributesConfig provides config for redis resource attributes.
 type ResourceAttributesConfig struct {
-	RedisVersion ResourceAttributeConfig `mapstructure:"redis.version"`
+	RedisNodeAddress ResourceAttributeConfig `mapstructure:"redis.node.address"`
+	RedisNodeRole    ResourceAttributeConfig `mapstructure:"redis.node.role"`
+	RedisVersion     ResourceAttributeConfig `mapstructure:"redis.version"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 	return ResourceAttributesConfig{
+		RedisNodeAddress: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		RedisNodeRole: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		RedisVersion: ResourceAttributeConfig{
 			Enabled: true,
 		},
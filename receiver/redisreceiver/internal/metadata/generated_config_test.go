@@ -30,6 +30,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisClientsConnected:                  MetricConfig{Enabled: true},
 					RedisClientsMaxInputBuffer:             MetricConfig{Enabled: true},
 					RedisClientsMaxOutputBuffer:            MetricConfig{Enabled: true},
+					RedisClusterKnownNodes:                 MetricConfig{Enabled: true},
+					RedisClusterNodeSlots:                  MetricConfig{Enabled: true},
+					RedisClusterSize:                       MetricConfig{Enabled: true},
+					RedisClusterSlots:                      MetricConfig{Enabled: true},
+					RedisClusterState:                      MetricConfig{Enabled: true},
 					RedisCmdCalls:                          MetricConfig{Enabled: true},
 					RedisCmdUsec:                           MetricConfig{Enabled: true},
 					RedisCommands:                          MetricConfig{Enabled: true},
@@ -42,6 +47,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisDbKeys:                            MetricConfig{Enabled: true},
 					RedisKeysEvicted:                       MetricConfig{Enabled: true},
 					RedisKeysExpired:                       MetricConfig{Enabled: true},
+					RedisKeyspaceHitRatio:                  MetricConfig{Enabled: true},
 					RedisKeyspaceHits:                      MetricConfig{Enabled: true},
 					RedisKeyspaceMisses:                    MetricConfig{Enabled: true},
 					RedisLatestFork:                        MetricConfig{Enabled: true},
@@ -55,13 +61,20 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisNetOutput:                         MetricConfig{Enabled: true},
 					RedisRdbChangesSinceLastSave:           MetricConfig{Enabled: true},
 					RedisReplicationBacklogFirstByteOffset: MetricConfig{Enabled: true},
+					RedisReplicationLag:                    MetricConfig{Enabled: true},
 					RedisReplicationOffset:                 MetricConfig{Enabled: true},
+					RedisReplicationOffsetLag:              MetricConfig{Enabled: true},
 					RedisRole:                              MetricConfig{Enabled: true},
+					RedisSentinelMasterReplicas:            MetricConfig{Enabled: true},
+					RedisSentinelMasterSentinels:           MetricConfig{Enabled: true},
+					RedisSentinelMasters:                   MetricConfig{Enabled: true},
 					RedisSlavesConnected:                   MetricConfig{Enabled: true},
 					RedisUptime:                            MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					RedisVersion: ResourceAttributeConfig{Enabled: true},
+					RedisNodeAddress: ResourceAttributeConfig{Enabled: true},
+					RedisNodeRole:    ResourceAttributeConfig{Enabled: true},
+					RedisVersion:     ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -73,6 +86,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisClientsConnected:                  MetricConfig{Enabled: false},
 					RedisClientsMaxInputBuffer:             MetricConfig{Enabled: false},
 					RedisClientsMaxOutputBuffer:            MetricConfig{Enabled: false},
+					RedisClusterKnownNodes:                 MetricConfig{Enabled: false},
+					RedisClusterNodeSlots:                  MetricConfig{Enabled: false},
+					RedisClusterSize:                       MetricConfig{Enabled: false},
+					RedisClusterSlots:                      MetricConfig{Enabled: false},
+					RedisClusterState:                      MetricConfig{Enabled: false},
 					RedisCmdCalls:                          MetricConfig{Enabled: false},
 					RedisCmdUsec:                           MetricConfig{Enabled: false},
 					RedisCommands:                          MetricConfig{Enabled: false},
@@ -85,6 +103,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisDbKeys:                            MetricConfig{Enabled: false},
 					RedisKeysEvicted:                       MetricConfig{Enabled: false},
 					RedisKeysExpired:                       MetricConfig{Enabled: false},
+					RedisKeyspaceHitRatio:                  MetricConfig{Enabled: false},
 					RedisKeyspaceHits:                      MetricConfig{Enabled: false},
 					RedisKeyspaceMisses:                    MetricConfig{Enabled: false},
 					RedisLatestFork:                        MetricConfig{Enabled: false},
@@ -98,13 +117,20 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisNetOutput:                         MetricConfig{Enabled: false},
 					RedisRdbChangesSinceLastSave:           MetricConfig{Enabled: false},
 					RedisReplicationBacklogFirstByteOffset: MetricConfig{Enabled: false},
+					RedisReplicationLag:                    MetricConfig{Enabled: false},
 					RedisReplicationOffset:                 MetricConfig{Enabled: false},
+					RedisReplicationOffsetLag:              MetricConfig{Enabled: false},
 					RedisRole:                              MetricConfig{Enabled: false},
+					RedisSentinelMasterReplicas:            MetricConfig{Enabled: false},
+					RedisSentinelMasterSentinels:           MetricConfig{Enabled: false},
+					RedisSentinelMasters:                   MetricConfig{Enabled: false},
 					RedisSlavesConnected:                   MetricConfig{Enabled: false},
 					RedisUptime:                            MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					RedisVersion: ResourceAttributeConfig{Enabled: false},
+					RedisNodeAddress: ResourceAttributeConfig{Enabled: false},
+					RedisNodeRole:    ResourceAttributeConfig{Enabled: false},
+					RedisVersion:     ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -141,13 +167,17 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
-				RedisVersion: ResourceAttributeConfig{Enabled: true},
+				RedisNodeAddress: ResourceAttributeConfig{Enabled: true},
+				RedisNodeRole:    ResourceAttributeConfig{Enabled: true},
+				RedisVersion:     ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
-				RedisVersion: ResourceAttributeConfig{Enabled: false},
+				RedisNodeAddress: ResourceAttributeConfig{Enabled: false},
+				RedisNodeRole:    ResourceAttributeConfig{Enabled: false},
+				RedisVersion:     ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
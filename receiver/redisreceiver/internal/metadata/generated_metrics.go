@@ -37,6 +37,36 @@ var MapAttributeRole = map[string]AttributeRole{
 	"primary": AttributeRolePrimary,
 }
 
+// AttributeSlotState specifies the a value slot_state attribute.
+type AttributeSlotState int
+
+const (
+	_ AttributeSlotState = iota
+	AttributeSlotStateOk
+	AttributeSlotStatePfail
+	AttributeSlotStateFail
+)
+
+// String returns the string representation of the AttributeSlotState.
+func (av AttributeSlotState) String() string {
+	switch av {
+	case AttributeSlotStateOk:
+		return "ok"
+	case AttributeSlotStatePfail:
+		return "pfail"
+	case AttributeSlotStateFail:
+		return "fail"
+	}
+	return ""
+}
+
+// MapAttributeSlotState is a helper map of string to AttributeSlotState attribute value.
+var MapAttributeSlotState = map[string]AttributeSlotState{
+	"ok":    AttributeSlotStateOk,
+	"pfail": AttributeSlotStatePfail,
+	"fail":  AttributeSlotStateFail,
+}
+
 // AttributeState specifies the a value state attribute.
 type AttributeState int
 
@@ -279,6 +309,261 @@ func newMetricRedisClientsMaxOutputBuffer(cfg MetricConfig) metricRedisClientsMa
 	return m
 }
 
+type metricRedisClusterKnownNodes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.known_nodes metric with initial data.
+func (m *metricRedisClusterKnownNodes) init() {
+	m.data.SetName("redis.cluster.known_nodes")
+	m.data.SetDescription("Number of nodes of the cluster known by the node")
+	m.data.SetUnit("{node}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRedisClusterKnownNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterKnownNodes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterKnownNodes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterKnownNodes(cfg MetricConfig) metricRedisClusterKnownNodes {
+	m := metricRedisClusterKnownNodes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClusterNodeSlots struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.node.slots metric with initial data.
+func (m *metricRedisClusterNodeSlots) init() {
+	m.data.SetName("redis.cluster.node.slots")
+	m.data.SetDescription("Number of slots served by the node")
+	m.data.SetUnit("{slot}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRedisClusterNodeSlots) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterNodeSlots) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterNodeSlots) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterNodeSlots(cfg MetricConfig) metricRedisClusterNodeSlots {
+	m := metricRedisClusterNodeSlots{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClusterSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.size metric with initial data.
+func (m *metricRedisClusterSize) init() {
+	m.data.SetName("redis.cluster.size")
+	m.data.SetDescription("Number of primary nodes serving at least one slot of the cluster")
+	m.data.SetUnit("{node}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRedisClusterSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterSize(cfg MetricConfig) metricRedisClusterSize {
+	m := metricRedisClusterSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClusterSlots struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.slots metric with initial data.
+func (m *metricRedisClusterSlots) init() {
+	m.data.SetName("redis.cluster.slots")
+	m.data.SetDescription("Number of slots of the cluster by state, as seen by the node")
+	m.data.SetUnit("{slot}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisClusterSlots) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, slotStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("slot_state", slotStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterSlots) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterSlots) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterSlots(cfg MetricConfig) metricRedisClusterSlots {
+	m := metricRedisClusterSlots{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClusterState struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.state metric with initial data.
+func (m *metricRedisClusterState) init() {
+	m.data.SetName("redis.cluster.state")
+	m.data.SetDescription("Whether the cluster is able to receive queries as seen by the node, 1 when it is ok and 0 otherwise")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRedisClusterState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterState(cfg MetricConfig) metricRedisClusterState {
+	m := metricRedisClusterState{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisCmdCalls struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -895,6 +1180,55 @@ func newMetricRedisKeysExpired(cfg MetricConfig) metricRedisKeysExpired {
 	return m
 }
 
+type metricRedisKeyspaceHitRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.keyspace.hit_ratio metric with initial data.
+func (m *metricRedisKeyspaceHitRatio) init() {
+	m.data.SetName("redis.keyspace.hit_ratio")
+	m.data.SetDescription("Ratio of the successful lookups of keys among all the lookups of keys in the main dictionary")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRedisKeyspaceHitRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisKeyspaceHitRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisKeyspaceHitRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisKeyspaceHitRatio(cfg MetricConfig) metricRedisKeyspaceHitRatio {
+	m := metricRedisKeyspaceHitRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisKeyspaceHits struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1542,6 +1876,57 @@ func newMetricRedisReplicationBacklogFirstByteOffset(cfg MetricConfig) metricRed
 	return m
 }
 
+type metricRedisReplicationLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.replication.lag metric with initial data.
+func (m *metricRedisReplicationLag) init() {
+	m.data.SetName("redis.replication.lag")
+	m.data.SetDescription("Number of seconds since the last interaction of the replica with the primary, reported by the primary")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisReplicationLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("replica", replicaAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisReplicationLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisReplicationLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisReplicationLag(cfg MetricConfig) metricRedisReplicationLag {
+	m := metricRedisReplicationLag{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisReplicationOffset struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1591,6 +1976,57 @@ func newMetricRedisReplicationOffset(cfg MetricConfig) metricRedisReplicationOff
 	return m
 }
 
+type metricRedisReplicationOffsetLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.replication.offset_lag metric with initial data.
+func (m *metricRedisReplicationOffsetLag) init() {
+	m.data.SetName("redis.replication.offset_lag")
+	m.data.SetDescription("Difference between the replication offset of the primary and the offset acknowledged by the replica")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisReplicationOffsetLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("replica", replicaAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisReplicationOffsetLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisReplicationOffsetLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisReplicationOffsetLag(cfg MetricConfig) metricRedisReplicationOffsetLag {
+	m := metricRedisReplicationOffsetLag{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisRole struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1644,6 +2080,163 @@ func newMetricRedisRole(cfg MetricConfig) metricRedisRole {
 	return m
 }
 
+type metricRedisSentinelMasterReplicas struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.sentinel.master.replicas metric with initial data.
+func (m *metricRedisSentinelMasterReplicas) init() {
+	m.data.SetName("redis.sentinel.master.replicas")
+	m.data.SetDescription("Number of replicas of the master monitored by the sentinel")
+	m.data.SetUnit("{replica}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisSentinelMasterReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, masterAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("master", masterAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisSentinelMasterReplicas) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisSentinelMasterReplicas) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisSentinelMasterReplicas(cfg MetricConfig) metricRedisSentinelMasterReplicas {
+	m := metricRedisSentinelMasterReplicas{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisSentinelMasterSentinels struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.sentinel.master.sentinels metric with initial data.
+func (m *metricRedisSentinelMasterSentinels) init() {
+	m.data.SetName("redis.sentinel.master.sentinels")
+	m.data.SetDescription("Number of sentinels monitoring the master")
+	m.data.SetUnit("{sentinel}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisSentinelMasterSentinels) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, masterAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("master", masterAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisSentinelMasterSentinels) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisSentinelMasterSentinels) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisSentinelMasterSentinels(cfg MetricConfig) metricRedisSentinelMasterSentinels {
+	m := metricRedisSentinelMasterSentinels{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisSentinelMasters struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.sentinel.masters metric with initial data.
+func (m *metricRedisSentinelMasters) init() {
+	m.data.SetName("redis.sentinel.masters")
+	m.data.SetDescription("Number of masters monitored by the sentinel")
+	m.data.SetUnit("{master}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRedisSentinelMasters) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisSentinelMasters) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisSentinelMasters) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisSentinelMasters(cfg MetricConfig) metricRedisSentinelMasters {
+	m := metricRedisSentinelMasters{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisSlavesConnected struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1758,6 +2351,11 @@ type MetricsBuilder struct {
 	metricRedisClientsConnected                  metricRedisClientsConnected
 	metricRedisClientsMaxInputBuffer             metricRedisClientsMaxInputBuffer
 	metricRedisClientsMaxOutputBuffer            metricRedisClientsMaxOutputBuffer
+	metricRedisClusterKnownNodes                 metricRedisClusterKnownNodes
+	metricRedisClusterNodeSlots                  metricRedisClusterNodeSlots
+	metricRedisClusterSize                       metricRedisClusterSize
+	metricRedisClusterSlots                      metricRedisClusterSlots
+	metricRedisClusterState                      metricRedisClusterState
 	metricRedisCmdCalls                          metricRedisCmdCalls
 	metricRedisCmdUsec                           metricRedisCmdUsec
 	metricRedisCommands                          metricRedisCommands
@@ -1770,6 +2368,7 @@ type MetricsBuilder struct {
 	metricRedisDbKeys                            metricRedisDbKeys
 	metricRedisKeysEvicted                       metricRedisKeysEvicted
 	metricRedisKeysExpired                       metricRedisKeysExpired
+	metricRedisKeyspaceHitRatio                  metricRedisKeyspaceHitRatio
 	metricRedisKeyspaceHits                      metricRedisKeyspaceHits
 	metricRedisKeyspaceMisses                    metricRedisKeyspaceMisses
 	metricRedisLatestFork                        metricRedisLatestFork
@@ -1783,8 +2382,13 @@ type MetricsBuilder struct {
 	metricRedisNetOutput                         metricRedisNetOutput
 	metricRedisRdbChangesSinceLastSave           metricRedisRdbChangesSinceLastSave
 	metricRedisReplicationBacklogFirstByteOffset metricRedisReplicationBacklogFirstByteOffset
+	metricRedisReplicationLag                    metricRedisReplicationLag
 	metricRedisReplicationOffset                 metricRedisReplicationOffset
+	metricRedisReplicationOffsetLag              metricRedisReplicationOffsetLag
 	metricRedisRole                              metricRedisRole
+	metricRedisSentinelMasterReplicas            metricRedisSentinelMasterReplicas
+	metricRedisSentinelMasterSentinels           metricRedisSentinelMasterSentinels
+	metricRedisSentinelMasters                   metricRedisSentinelMasters
 	metricRedisSlavesConnected                   metricRedisSlavesConnected
 	metricRedisUptime                            metricRedisUptime
 }
@@ -1809,6 +2413,11 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricRedisClientsConnected:                  newMetricRedisClientsConnected(mbc.Metrics.RedisClientsConnected),
 		metricRedisClientsMaxInputBuffer:             newMetricRedisClientsMaxInputBuffer(mbc.Metrics.RedisClientsMaxInputBuffer),
 		metricRedisClientsMaxOutputBuffer:            newMetricRedisClientsMaxOutputBuffer(mbc.Metrics.RedisClientsMaxOutputBuffer),
+		metricRedisClusterKnownNodes:                 newMetricRedisClusterKnownNodes(mbc.Metrics.RedisClusterKnownNodes),
+		metricRedisClusterNodeSlots:                  newMetricRedisClusterNodeSlots(mbc.Metrics.RedisClusterNodeSlots),
+		metricRedisClusterSize:                       newMetricRedisClusterSize(mbc.Metrics.RedisClusterSize),
+		metricRedisClusterSlots:                      newMetricRedisClusterSlots(mbc.Metrics.RedisClusterSlots),
+		metricRedisClusterState:                      newMetricRedisClusterState(mbc.Metrics.RedisClusterState),
 		metricRedisCmdCalls:                          newMetricRedisCmdCalls(mbc.Metrics.RedisCmdCalls),
 		metricRedisCmdUsec:                           newMetricRedisCmdUsec(mbc.Metrics.RedisCmdUsec),
 		metricRedisCommands:                          newMetricRedisCommands(mbc.Metrics.RedisCommands),
@@ -1821,6 +2430,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricRedisDbKeys:                            newMetricRedisDbKeys(mbc.Metrics.RedisDbKeys),
 		metricRedisKeysEvicted:                       newMetricRedisKeysEvicted(mbc.Metrics.RedisKeysEvicted),
 		metricRedisKeysExpired:                       newMetricRedisKeysExpired(mbc.Metrics.RedisKeysExpired),
+		metricRedisKeyspaceHitRatio:                  newMetricRedisKeyspaceHitRatio(mbc.Metrics.RedisKeyspaceHitRatio),
 		metricRedisKeyspaceHits:                      newMetricRedisKeyspaceHits(mbc.Metrics.RedisKeyspaceHits),
 		metricRedisKeyspaceMisses:                    newMetricRedisKeyspaceMisses(mbc.Metrics.RedisKeyspaceMisses),
 		metricRedisLatestFork:                        newMetricRedisLatestFork(mbc.Metrics.RedisLatestFork),
@@ -1834,8 +2444,13 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricRedisNetOutput:                         newMetricRedisNetOutput(mbc.Metrics.RedisNetOutput),
 		metricRedisRdbChangesSinceLastSave:           newMetricRedisRdbChangesSinceLastSave(mbc.Metrics.RedisRdbChangesSinceLastSave),
 		metricRedisReplicationBacklogFirstByteOffset: newMetricRedisReplicationBacklogFirstByteOffset(mbc.Metrics.RedisReplicationBacklogFirstByteOffset),
+		metricRedisReplicationLag:                    newMetricRedisReplicationLag(mbc.Metrics.RedisReplicationLag),
 		metricRedisReplicationOffset:                 newMetricRedisReplicationOffset(mbc.Metrics.RedisReplicationOffset),
+		metricRedisReplicationOffsetLag:              newMetricRedisReplicationOffsetLag(mbc.Metrics.RedisReplicationOffsetLag),
 		metricRedisRole:                              newMetricRedisRole(mbc.Metrics.RedisRole),
+		metricRedisSentinelMasterReplicas:            newMetricRedisSentinelMasterReplicas(mbc.Metrics.RedisSentinelMasterReplicas),
+		metricRedisSentinelMasterSentinels:           newMetricRedisSentinelMasterSentinels(mbc.Metrics.RedisSentinelMasterSentinels),
+		metricRedisSentinelMasters:                   newMetricRedisSentinelMasters(mbc.Metrics.RedisSentinelMasters),
 		metricRedisSlavesConnected:                   newMetricRedisSlavesConnected(mbc.Metrics.RedisSlavesConnected),
 		metricRedisUptime:                            newMetricRedisUptime(mbc.Metrics.RedisUptime),
 	}
@@ -1903,6 +2518,11 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricRedisClientsConnected.emit(ils.Metrics())
 	mb.metricRedisClientsMaxInputBuffer.emit(ils.Metrics())
 	mb.metricRedisClientsMaxOutputBuffer.emit(ils.Metrics())
+	mb.metricRedisClusterKnownNodes.emit(ils.Metrics())
+	mb.metricRedisClusterNodeSlots.emit(ils.Metrics())
+	mb.metricRedisClusterSize.emit(ils.Metrics())
+	mb.metricRedisClusterSlots.emit(ils.Metrics())
+	mb.metricRedisClusterState.emit(ils.Metrics())
 	mb.metricRedisCmdCalls.emit(ils.Metrics())
 	mb.metricRedisCmdUsec.emit(ils.Metrics())
 	mb.metricRedisCommands.emit(ils.Metrics())
@@ -1915,6 +2535,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricRedisDbKeys.emit(ils.Metrics())
 	mb.metricRedisKeysEvicted.emit(ils.Metrics())
 	mb.metricRedisKeysExpired.emit(ils.Metrics())
+	mb.metricRedisKeyspaceHitRatio.emit(ils.Metrics())
 	mb.metricRedisKeyspaceHits.emit(ils.Metrics())
 	mb.metricRedisKeyspaceMisses.emit(ils.Metrics())
 	mb.metricRedisLatestFork.emit(ils.Metrics())
@@ -1928,8 +2549,13 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricRedisNetOutput.emit(ils.Metrics())
 	mb.metricRedisRdbChangesSinceLastSave.emit(ils.Metrics())
 	mb.metricRedisReplicationBacklogFirstByteOffset.emit(ils.Metrics())
+	mb.metricRedisReplicationLag.emit(ils.Metrics())
 	mb.metricRedisReplicationOffset.emit(ils.Metrics())
+	mb.metricRedisReplicationOffsetLag.emit(ils.Metrics())
 	mb.metricRedisRole.emit(ils.Metrics())
+	mb.metricRedisSentinelMasterReplicas.emit(ils.Metrics())
+	mb.metricRedisSentinelMasterSentinels.emit(ils.Metrics())
+	mb.metricRedisSentinelMasters.emit(ils.Metrics())
 	mb.metricRedisSlavesConnected.emit(ils.Metrics())
 	mb.metricRedisUptime.emit(ils.Metrics())
 
@@ -1972,6 +2598,31 @@ func (mb *MetricsBuilder) RecordRedisClientsMaxOutputBufferDataPoint(ts pcommon.
 	mb.metricRedisClientsMaxOutputBuffer.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisClusterKnownNodesDataPoint adds a data point to redis.cluster.known_nodes metric.
+func (mb *MetricsBuilder) RecordRedisClusterKnownNodesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRedisClusterKnownNodes.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisClusterNodeSlotsDataPoint adds a data point to redis.cluster.node.slots metric.
+func (mb *MetricsBuilder) RecordRedisClusterNodeSlotsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRedisClusterNodeSlots.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisClusterSizeDataPoint adds a data point to redis.cluster.size metric.
+func (mb *MetricsBuilder) RecordRedisClusterSizeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRedisClusterSize.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisClusterSlotsDataPoint adds a data point to redis.cluster.slots metric.
+func (mb *MetricsBuilder) RecordRedisClusterSlotsDataPoint(ts pcommon.Timestamp, val int64, slotStateAttributeValue AttributeSlotState) {
+	mb.metricRedisClusterSlots.recordDataPoint(mb.startTime, ts, val, slotStateAttributeValue.String())
+}
+
+// RecordRedisClusterStateDataPoint adds a data point to redis.cluster.state metric.
+func (mb *MetricsBuilder) RecordRedisClusterStateDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRedisClusterState.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisCmdCallsDataPoint adds a data point to redis.cmd.calls metric.
 func (mb *MetricsBuilder) RecordRedisCmdCallsDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
 	mb.metricRedisCmdCalls.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
@@ -2032,6 +2683,11 @@ func (mb *MetricsBuilder) RecordRedisKeysExpiredDataPoint(ts pcommon.Timestamp,
 	mb.metricRedisKeysExpired.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisKeyspaceHitRatioDataPoint adds a data point to redis.keyspace.hit_ratio metric.
+func (mb *MetricsBuilder) RecordRedisKeyspaceHitRatioDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricRedisKeyspaceHitRatio.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisKeyspaceHitsDataPoint adds a data point to redis.keyspace.hits metric.
 func (mb *MetricsBuilder) RecordRedisKeyspaceHitsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRedisKeyspaceHits.recordDataPoint(mb.startTime, ts, val)
@@ -2097,16 +2753,41 @@ func (mb *MetricsBuilder) RecordRedisReplicationBacklogFirstByteOffsetDataPoint(
 	mb.metricRedisReplicationBacklogFirstByteOffset.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisReplicationLagDataPoint adds a data point to redis.replication.lag metric.
+func (mb *MetricsBuilder) RecordRedisReplicationLagDataPoint(ts pcommon.Timestamp, val int64, replicaAttributeValue string) {
+	mb.metricRedisReplicationLag.recordDataPoint(mb.startTime, ts, val, replicaAttributeValue)
+}
+
 // RecordRedisReplicationOffsetDataPoint adds a data point to redis.replication.offset metric.
 func (mb *MetricsBuilder) RecordRedisReplicationOffsetDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRedisReplicationOffset.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisReplicationOffsetLagDataPoint adds a data point to redis.replication.offset_lag metric.
+func (mb *MetricsBuilder) RecordRedisReplicationOffsetLagDataPoint(ts pcommon.Timestamp, val int64, replicaAttributeValue string) {
+	mb.metricRedisReplicationOffsetLag.recordDataPoint(mb.startTime, ts, val, replicaAttributeValue)
+}
+
 // RecordRedisRoleDataPoint adds a data point to redis.role metric.
 func (mb *MetricsBuilder) RecordRedisRoleDataPoint(ts pcommon.Timestamp, val int64, roleAttributeValue AttributeRole) {
 	mb.metricRedisRole.recordDataPoint(mb.startTime, ts, val, roleAttributeValue.String())
 }
 
+// RecordRedisSentinelMasterReplicasDataPoint adds a data point to redis.sentinel.master.replicas metric.
+func (mb *MetricsBuilder) RecordRedisSentinelMasterReplicasDataPoint(ts pcommon.Timestamp, val int64, masterAttributeValue string) {
+	mb.metricRedisSentinelMasterReplicas.recordDataPoint(mb.startTime, ts, val, masterAttributeValue)
+}
+
+// RecordRedisSentinelMasterSentinelsDataPoint adds a data point to redis.sentinel.master.sentinels metric.
+func (mb *MetricsBuilder) RecordRedisSentinelMasterSentinelsDataPoint(ts pcommon.Timestamp, val int64, masterAttributeValue string) {
+	mb.metricRedisSentinelMasterSentinels.recordDataPoint(mb.startTime, ts, val, masterAttributeValue)
+}
+
+// RecordRedisSentinelMastersDataPoint adds a data point to redis.sentinel.masters metric.
+func (mb *MetricsBuilder) RecordRedisSentinelMastersDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRedisSentinelMasters.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisSlavesConnectedDataPoint adds a data point to redis.slaves.connected metric.
 func (mb *MetricsBuilder) RecordRedisSlavesConnectedDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRedisSlavesConnected.recordDataPoint(mb.startTime, ts, val)
@@ -70,6 +70,26 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisClientsMaxOutputBufferDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisClusterKnownNodesDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisClusterNodeSlotsDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisClusterSizeDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisClusterSlotsDataPoint(ts, 1, AttributeSlotStateOk)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisClusterStateDataPoint(ts, 1)
+
 			allMetricsCount++
 			mb.RecordRedisCmdCallsDataPoint(ts, 1, "cmd-val")
 
@@ -116,6 +136,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisKeysExpiredDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRedisKeyspaceHitRatioDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRedisKeyspaceHitsDataPoint(ts, 1)
@@ -167,13 +190,33 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisReplicationBacklogFirstByteOffsetDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisReplicationLagDataPoint(ts, 1, "replica-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRedisReplicationOffsetDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisReplicationOffsetLagDataPoint(ts, 1, "replica-val")
+
 			allMetricsCount++
 			mb.RecordRedisRoleDataPoint(ts, 1, AttributeRoleReplica)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisSentinelMasterReplicasDataPoint(ts, 1, "master-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisSentinelMasterSentinelsDataPoint(ts, 1, "master-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordRedisSentinelMastersDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRedisSlavesConnectedDataPoint(ts, 1)
@@ -183,6 +226,8 @@ func TestMetricsBuilder(t *testing.T) {
 			mb.RecordRedisUptimeDataPoint(ts, 1)
 
 			rb := mb.NewResourceBuilder()
+			rb.SetRedisNodeAddress("redis.node.address-val")
+			rb.SetRedisNodeRole("redis.node.role-val")
 			rb.SetRedisVersion("redis.version-val")
 			res := rb.Emit()
 			metrics := mb.Emit(WithResource(res))
@@ -258,6 +303,77 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.cluster.known_nodes":
+					assert.False(t, validatedMetrics["redis.cluster.known_nodes"], "Found a duplicate in the metrics slice: redis.cluster.known_nodes")
+					validatedMetrics["redis.cluster.known_nodes"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of nodes of the cluster known by the node", ms.At(i).Description())
+					assert.Equal(t, "{node}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.cluster.node.slots":
+					assert.False(t, validatedMetrics["redis.cluster.node.slots"], "Found a duplicate in the metrics slice: redis.cluster.node.slots")
+					validatedMetrics["redis.cluster.node.slots"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of slots served by the node", ms.At(i).Description())
+					assert.Equal(t, "{slot}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.cluster.size":
+					assert.False(t, validatedMetrics["redis.cluster.size"], "Found a duplicate in the metrics slice: redis.cluster.size")
+					validatedMetrics["redis.cluster.size"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of primary nodes serving at least one slot of the cluster", ms.At(i).Description())
+					assert.Equal(t, "{node}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.cluster.slots":
+					assert.False(t, validatedMetrics["redis.cluster.slots"], "Found a duplicate in the metrics slice: redis.cluster.slots")
+					validatedMetrics["redis.cluster.slots"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of slots of the cluster by state, as seen by the node", ms.At(i).Description())
+					assert.Equal(t, "{slot}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("slot_state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ok", attrVal.Str())
+				case "redis.cluster.state":
+					assert.False(t, validatedMetrics["redis.cluster.state"], "Found a duplicate in the metrics slice: redis.cluster.state")
+					validatedMetrics["redis.cluster.state"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the cluster is able to receive queries as seen by the node, 1 when it is ok and 0 otherwise", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "redis.cmd.calls":
 					assert.False(t, validatedMetrics["redis.cmd.calls"], "Found a duplicate in the metrics slice: redis.cmd.calls")
 					validatedMetrics["redis.cmd.calls"] = true
@@ -436,6 +552,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.keyspace.hit_ratio":
+					assert.False(t, validatedMetrics["redis.keyspace.hit_ratio"], "Found a duplicate in the metrics slice: redis.keyspace.hit_ratio")
+					validatedMetrics["redis.keyspace.hit_ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Ratio of the successful lookups of keys among all the lookups of keys in the main dictionary", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "redis.keyspace.hits":
 					assert.False(t, validatedMetrics["redis.keyspace.hits"], "Found a duplicate in the metrics slice: redis.keyspace.hits")
 					validatedMetrics["redis.keyspace.hits"] = true
@@ -602,6 +730,21 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.replication.lag":
+					assert.False(t, validatedMetrics["redis.replication.lag"], "Found a duplicate in the metrics slice: redis.replication.lag")
+					validatedMetrics["redis.replication.lag"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of seconds since the last interaction of the replica with the primary, reported by the primary", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("replica")
+					assert.True(t, ok)
+					assert.EqualValues(t, "replica-val", attrVal.Str())
 				case "redis.replication.offset":
 					assert.False(t, validatedMetrics["redis.replication.offset"], "Found a duplicate in the metrics slice: redis.replication.offset")
 					validatedMetrics["redis.replication.offset"] = true
@@ -614,6 +757,21 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.replication.offset_lag":
+					assert.False(t, validatedMetrics["redis.replication.offset_lag"], "Found a duplicate in the metrics slice: redis.replication.offset_lag")
+					validatedMetrics["redis.replication.offset_lag"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Difference between the replication offset of the primary and the offset acknowledged by the replica", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("replica")
+					assert.True(t, ok)
+					assert.EqualValues(t, "replica-val", attrVal.Str())
 				case "redis.role":
 					assert.False(t, validatedMetrics["redis.role"], "Found a duplicate in the metrics slice: redis.role")
 					validatedMetrics["redis.role"] = true
@@ -631,6 +789,54 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("role")
 					assert.True(t, ok)
 					assert.EqualValues(t, "replica", attrVal.Str())
+				case "redis.sentinel.master.replicas":
+					assert.False(t, validatedMetrics["redis.sentinel.master.replicas"], "Found a duplicate in the metrics slice: redis.sentinel.master.replicas")
+					validatedMetrics["redis.sentinel.master.replicas"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of replicas of the master monitored by the sentinel", ms.At(i).Description())
+					assert.Equal(t, "{replica}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("master")
+					assert.True(t, ok)
+					assert.EqualValues(t, "master-val", attrVal.Str())
+				case "redis.sentinel.master.sentinels":
+					assert.False(t, validatedMetrics["redis.sentinel.master.sentinels"], "Found a duplicate in the metrics slice: redis.sentinel.master.sentinels")
+					validatedMetrics["redis.sentinel.master.sentinels"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of sentinels monitoring the master", ms.At(i).Description())
+					assert.Equal(t, "{sentinel}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("master")
+					assert.True(t, ok)
+					assert.EqualValues(t, "master-val", attrVal.Str())
+				case "redis.sentinel.masters":
+					assert.False(t, validatedMetrics["redis.sentinel.masters"], "Found a duplicate in the metrics slice: redis.sentinel.masters")
+					validatedMetrics["redis.sentinel.masters"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of masters monitored by the sentinel", ms.At(i).Description())
+					assert.Equal(t, "{master}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "redis.slaves.connected":
 					assert.False(t, validatedMetrics["redis.slaves.connected"], "Found a duplicate in the metrics slice: redis.slaves.connected")
 					validatedMetrics["redis.slaves.connected"] = true
@@ -21,6 +21,20 @@ func NewResourceBuilder(rac ResourceAttributesConfig) *ResourceBuilder {
 	}
 }
 
+// SetRedisNodeAddress sets provided value as "redis.node.address" attribute.
+func (rb *ResourceBuilder) SetRedisNodeAddress(val string) {
+	if rb.config.RedisNodeAddress.Enabled {
+		rb.res.Attributes().PutStr("redis.node.address", val)
+	}
+}
+
+// SetRedisNodeRole sets provided value as "redis.node.role" attribute.
+func (rb *ResourceBuilder) SetRedisNodeRole(val string) {
+	if rb.config.RedisNodeRole.Enabled {
+		rb.res.Attributes().PutStr("redis.node.role", val)
+	}
+}
+
 // SetRedisVersion sets provided value as "redis.version" attribute.
 func (rb *ResourceBuilder) SetRedisVersion(val string) {
 	if rb.config.RedisVersion.Enabled {
@@ -13,6 +13,8 @@ func TestResourceBuilder(t *testing.T) {
 		t.Run(test, func(t *testing.T) {
 			cfg := loadResourceAttributesConfig(t, test)
 			rb := NewResourceBuilder(cfg)
+			rb.SetRedisNodeAddress("redis.node.address-val")
+			rb.SetRedisNodeRole("redis.node.role-val")
 			rb.SetRedisVersion("redis.version-val")
 
 			res := rb.Emit()
@@ -20,9 +22,9 @@ func TestResourceBuilder(t *testing.T) {
 
 			switch test {
 			case "default":
-				assert.Equal(t, 1, res.Attributes().Len())
+				assert.Equal(t, 3, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 1, res.Attributes().Len())
+				assert.Equal(t, 3, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -30,7 +32,17 @@ func TestResourceBuilder(t *testing.T) {
 				assert.Failf(t, "unexpected test case: %s", test)
 			}
 
-			val, ok := res.Attributes().Get("redis.version")
+			val, ok := res.Attributes().Get("redis.node.address")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "redis.node.address-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("redis.node.role")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "redis.node.role-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("redis.version")
 			assert.True(t, ok)
 			if ok {
 				assert.EqualValues(t, "redis.version-val", val.Str())
@@ -25,6 +25,8 @@ import (
 type redisScraper struct {
 	client   client
 	redisSvc *redisSvc
+	// address of the node, reported as the redis.node.address resource attribute
+	address  string
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
 	uptime   time.Duration
@@ -43,16 +45,20 @@ func newRedisScraper(cfg *Config, settings receiver.CreateSettings) (scraperhelp
 	if opts.TLSConfig, err = cfg.TLS.LoadTLSConfig(); err != nil {
 		return nil, err
 	}
+	if cfg.Mode == ModeCluster || cfg.Mode == ModeSentinel {
+		// the discovered nodes are connected to with the options of the endpoint
+		newClient := func(address string) client {
+			nodeOpts := *opts
+			nodeOpts.Addr = address
+			return newRedisClient(&nodeOpts)
+		}
+		return newTopologyScraperWithClient(newRedisClient(opts), newClient, settings, cfg)
+	}
 	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
 }
 
 func newRedisScraperWithClient(client client, settings receiver.CreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
-	rs := &redisScraper{
-		client:   client,
-		redisSvc: newRedisSvc(client),
-		settings: settings.TelemetrySettings,
-		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
-	}
+	rs := newNodeScraper(client, cfg.Endpoint, settings, cfg)
 	return scraperhelper.NewScraper(
 		metadata.Type,
 		rs.Scrape,
@@ -60,6 +66,17 @@ func newRedisScraperWithClient(client client, settings receiver.CreateSettings,
 	)
 }
 
+// Creates the scraper of the node at the address, connected to with the passed in client.
+func newNodeScraper(client client, address string, settings receiver.CreateSettings, cfg *Config) *redisScraper {
+	return &redisScraper{
+		client:   client,
+		redisSvc: newRedisSvc(client),
+		address:  address,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+	}
+}
+
 func (rs *redisScraper) shutdown(context.Context) error {
 	if rs.client != nil {
 		return rs.client.close()
@@ -93,8 +110,18 @@ func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
 	rs.recordKeyspaceMetrics(now, inf)
 	rs.recordRoleMetrics(now, inf)
 	rs.recordCmdStatsMetrics(now, inf)
+	rs.recordKeyspaceHitRatio(now, inf)
+	rs.recordReplicationMetrics(now, inf)
+	if inf["cluster_enabled"] == "1" {
+		rs.recordClusterMetrics(now)
+	}
+	if inf["redis_mode"] == "sentinel" {
+		rs.recordSentinelMetrics(now, inf)
+	}
 	rb := rs.mb.NewResourceBuilder()
 	rb.SetRedisVersion(rs.getRedisVersion(inf))
+	rb.SetRedisNodeAddress(rs.address)
+	rb.SetRedisNodeRole(getNodeRole(inf))
 	return rs.mb.Emit(metadata.WithResource(rb.Emit())), nil
 }
 
@@ -156,6 +183,18 @@ func (rs *redisScraper) getRedisVersion(inf info) string {
 	return "unknown"
 }
 
+// getNodeRole retrieves the role of the node from 'redis_mode' and 'role' Redis info
+// key-value pairs, e.g. "redis_mode:sentinel" or "role:master"
+func getNodeRole(inf info) string {
+	if inf["redis_mode"] == "sentinel" {
+		return "sentinel"
+	}
+	if inf["role"] == "master" {
+		return metadata.AttributeRolePrimary.String()
+	}
+	return metadata.AttributeRoleReplica.String()
+}
+
 // recordRoleMetrics records metrics from 'role' Redis info key-value pairs
 // e.g. "role:master"
 func (rs *redisScraper) recordRoleMetrics(ts pcommon.Timestamp, inf info) {
@@ -197,3 +236,106 @@ func (rs *redisScraper) recordCmdStatsMetrics(ts pcommon.Timestamp, inf info) {
 		}
 	}
 }
+
+// recordKeyspaceHitRatio records the ratio of 'keyspace_hits' among the lookups of keys
+// counted by the 'keyspace_hits' and 'keyspace_misses' Redis info key-value pairs.
+func (rs *redisScraper) recordKeyspaceHitRatio(ts pcommon.Timestamp, inf info) {
+	hits, err := strconv.ParseInt(inf["keyspace_hits"], 10, 64)
+	if err != nil {
+		return
+	}
+	misses, err := strconv.ParseInt(inf["keyspace_misses"], 10, 64)
+	if err != nil || hits+misses == 0 {
+		return
+	}
+	rs.mb.RecordRedisKeyspaceHitRatioDataPoint(ts, float64(hits)/float64(hits+misses))
+}
+
+// recordReplicationMetrics records the lag of the replicas connected to a primary from
+// 'slave' Redis info key-value pairs,
+// e.g. "slave0:ip=127.0.0.1,port=6380,state=online,offset=1234,lag=0"
+func (rs *redisScraper) recordReplicationMetrics(ts pcommon.Timestamp, inf info) {
+	if inf["role"] != "master" {
+		return
+	}
+	primaryOffset, err := strconv.ParseInt(inf["master_repl_offset"], 10, 64)
+	if err != nil {
+		return
+	}
+	for i := 0; ; i++ {
+		key := "slave" + strconv.Itoa(i)
+		str, ok := inf[key]
+		if !ok {
+			break
+		}
+		r, err := parseReplicaString(str)
+		if err != nil {
+			rs.settings.Logger.Warn("failed to parse replica string", zap.String("key", key),
+				zap.String("val", str), zap.Error(err))
+			continue
+		}
+		if r.state != "online" {
+			continue
+		}
+		rs.mb.RecordRedisReplicationLagDataPoint(ts, r.lag, r.address)
+		rs.mb.RecordRedisReplicationOffsetLagDataPoint(ts, primaryOffset-r.offset, r.address)
+	}
+}
+
+// recordClusterMetrics records the state of the cluster as seen by the node from the
+// CLUSTER INFO key-value pairs, and the slots served by the node from its CLUSTER NODES line.
+func (rs *redisScraper) recordClusterMetrics(ts pcommon.Timestamp) {
+	clusterInf, err := rs.redisSvc.clusterInfo()
+	if err != nil {
+		rs.settings.Logger.Warn("failed to retrieve cluster info", zap.Error(err))
+	} else {
+		if clusterInf["cluster_state"] == "ok" {
+			rs.mb.RecordRedisClusterStateDataPoint(ts, 1)
+		} else {
+			rs.mb.RecordRedisClusterStateDataPoint(ts, 0)
+		}
+		slotStates := map[string]metadata.AttributeSlotState{
+			"cluster_slots_ok":    metadata.AttributeSlotStateOk,
+			"cluster_slots_pfail": metadata.AttributeSlotStatePfail,
+			"cluster_slots_fail":  metadata.AttributeSlotStateFail,
+		}
+		for key, state := range slotStates {
+			if val, err := strconv.ParseInt(clusterInf[key], 10, 64); err == nil {
+				rs.mb.RecordRedisClusterSlotsDataPoint(ts, val, state)
+			}
+		}
+		if val, err := strconv.ParseInt(clusterInf["cluster_known_nodes"], 10, 64); err == nil {
+			rs.mb.RecordRedisClusterKnownNodesDataPoint(ts, val)
+		}
+		if val, err := strconv.ParseInt(clusterInf["cluster_size"], 10, 64); err == nil {
+			rs.mb.RecordRedisClusterSizeDataPoint(ts, val)
+		}
+	}
+
+	nodes, err := rs.redisSvc.clusterNodes()
+	if err != nil {
+		rs.settings.Logger.Warn("failed to retrieve cluster nodes", zap.Error(err))
+		return
+	}
+	for _, node := range nodes {
+		if node.hasFlag("myself") {
+			rs.mb.RecordRedisClusterNodeSlotsDataPoint(ts, int64(node.slots))
+			break
+		}
+	}
+}
+
+// recordSentinelMetrics records the masters monitored by a sentinel from 'master' Redis
+// info key-value pairs,
+// e.g. "master0:name=mymaster,status=ok,address=127.0.0.1:6379,slaves=1,sentinels=3"
+func (rs *redisScraper) recordSentinelMetrics(ts pcommon.Timestamp, inf info) {
+	masters, errs := parseSentinelMasters(inf)
+	for _, err := range errs {
+		rs.settings.Logger.Warn("failed to parse sentinel master string", zap.Error(err))
+	}
+	rs.mb.RecordRedisSentinelMastersDataPoint(ts, int64(len(masters)))
+	for _, master := range masters {
+		rs.mb.RecordRedisSentinelMasterReplicasDataPoint(ts, int64(master.replicas), master.name)
+		rs.mb.RecordRedisSentinelMasterSentinelsDataPoint(ts, int64(master.sentinels), master.name)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 
@@ -35,6 +36,61 @@ func TestRedisRunnable(t *testing.T) {
 	assert.Equal(t, "otelcol/redisreceiver", il.Name())
 }
 
+func TestRedisScraper_ClusterNode(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.Metrics.RedisKeyspaceHitRatio.Enabled = true
+	rs := newNodeScraper(&fakeNodeClient{info: "cluster_node_info"}, "127.0.0.1:30001", receivertest.NewNopCreateSettings(), cfg)
+	md, err := rs.Scrape(context.Background())
+	require.NoError(t, err)
+
+	rm := md.ResourceMetrics().At(0)
+	address, _ := rm.Resource().Attributes().Get("redis.node.address")
+	assert.Equal(t, "127.0.0.1:30001", address.Str())
+	role, _ := rm.Resource().Attributes().Get("redis.node.role")
+	assert.Equal(t, "primary", role.Str())
+
+	metrics := map[string]pmetric.Metric{}
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	assert.Equal(t, 0.75, metrics["redis.keyspace.hit_ratio"].Gauge().DataPoints().At(0).DoubleValue())
+
+	// the replicas not online are skipped
+	lag := metrics["redis.replication.lag"].Gauge().DataPoints()
+	require.Equal(t, 1, lag.Len())
+	assert.Equal(t, int64(1), lag.At(0).IntValue())
+	replica, _ := lag.At(0).Attributes().Get("replica")
+	assert.Equal(t, "127.0.0.1:30004", replica.Str())
+	assert.Equal(t, int64(500), metrics["redis.replication.offset_lag"].Gauge().DataPoints().At(0).IntValue())
+
+	assert.Equal(t, int64(1), metrics["redis.cluster.state"].Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, 3, metrics["redis.cluster.slots"].Sum().DataPoints().Len())
+	assert.Equal(t, int64(7), metrics["redis.cluster.known_nodes"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(3), metrics["redis.cluster.size"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(5461), metrics["redis.cluster.node.slots"].Sum().DataPoints().At(0).IntValue())
+}
+
+func TestRedisScraper_Sentinel(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	rs := newNodeScraper(&fakeNodeClient{info: "sentinel_info"}, "localhost:26379", receivertest.NewNopCreateSettings(), cfg)
+	md, err := rs.Scrape(context.Background())
+	require.NoError(t, err)
+
+	rm := md.ResourceMetrics().At(0)
+	role, _ := rm.Resource().Attributes().Get("redis.node.role")
+	assert.Equal(t, "sentinel", role.Str())
+
+	metrics := map[string]pmetric.Metric{}
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	assert.Equal(t, int64(2), metrics["redis.sentinel.masters"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, 2, metrics["redis.sentinel.master.replicas"].Sum().DataPoints().Len())
+	assert.Equal(t, 2, metrics["redis.sentinel.master.sentinels"].Sum().DataPoints().Len())
+}
+
 func TestNewReceiver_invalid_auth_error(t *testing.T) {
 	c := createDefaultConfig().(*Config)
 	c.TLS = configtls.TLSClientSetting{
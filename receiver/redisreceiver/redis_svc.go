@@ -28,16 +28,41 @@ func (p *redisSvc) info() (info, error) {
 	if err != nil {
 		return nil, err
 	}
+	return p.parse(str), nil
+}
+
+// Calls the Redis CLUSTER INFO command on the client and returns an `info` map.
+func (p *redisSvc) clusterInfo() (info, error) {
+	str, err := p.client.retrieveClusterInfo()
+	if err != nil {
+		return nil, err
+	}
+	return p.parse(str), nil
+}
+
+// Calls the Redis CLUSTER NODES command on the client and returns the parsed nodes.
+func (p *redisSvc) clusterNodes() ([]clusterNode, error) {
+	str, err := p.client.retrieveClusterNodes()
+	if err != nil {
+		return nil, err
+	}
+	// the CLUSTER NODES lines are delimited by \n, even by Redis
+	return parseClusterNodes(strings.Split(str, "\n")), nil
+}
+
+// parse returns the key value pairs of the lines, the values possibly containing colons,
+// e.g. the address of the masters monitored by a sentinel.
+func (p *redisSvc) parse(str string) info {
 	lines := strings.Split(str, p.delimiter)
 	attrs := make(map[string]string)
 	for _, line := range lines {
 		if len(line) == 0 || strings.HasPrefix(line, "#") {
 			continue
 		}
-		pair := strings.Split(line, ":")
+		pair := strings.SplitN(line, ":", 2)
 		if len(pair) == 2 { // defensive, should always == 2
 			attrs[pair[0]] = pair[1]
 		}
 	}
-	return attrs, nil
+	return attrs
 }
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// Holds the fields of the replicas connected to a primary, returned by the Replication
+// section of the INFO command: e.g.
+// "slave0:ip=127.0.0.1,port=6380,state=online,offset=1234,lag=0"
+type replica struct {
+	address string
+	state   string
+	offset  int64
+	lag     int64
+}
+
+// Turns a replica value (the part after the colon
+// e.g. "ip=127.0.0.1,port=6380,state=online,offset=1234,lag=0") into a replica struct.
+func parseReplicaString(str string) (*replica, error) {
+	fields, err := parseFieldsString(str)
+	if err != nil {
+		return nil, err
+	}
+	if fields["ip"] == "" || fields["port"] == "" {
+		return nil, fmt.Errorf("missing ip or port in '%s'", str)
+	}
+	r := replica{
+		address: net.JoinHostPort(fields["ip"], fields["port"]),
+		state:   fields["state"],
+	}
+	if r.offset, err = strconv.ParseInt(fields["offset"], 10, 64); err != nil {
+		return nil, err
+	}
+	// lag is only reported by Redis 3.0 and above
+	if lag, ok := fields["lag"]; ok {
+		if r.lag, err = strconv.ParseInt(lag, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
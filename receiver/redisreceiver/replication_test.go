@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReplicaString(t *testing.T) {
+	r, err := parseReplicaString("ip=127.0.0.1,port=6380,state=online,offset=1234,lag=2")
+	require.NoError(t, err)
+	assert.Equal(t, &replica{address: "127.0.0.1:6380", state: "online", offset: 1234, lag: 2}, r)
+
+	r, err = parseReplicaString("ip=::1,port=6380,state=online,offset=1234")
+	require.NoError(t, err)
+	assert.Equal(t, "[::1]:6380", r.address)
+}
+
+func TestParseReplicaString_Malformed(t *testing.T) {
+	_, err := parseReplicaString("port=6380,state=online,offset=1234,lag=2")
+	assert.Error(t, err)
+	_, err = parseReplicaString("ip=127.0.0.1,port=6380,state=online,offset=x")
+	assert.Error(t, err)
+	_, err = parseReplicaString("ip=127.0.0.1,port=6380,online")
+	assert.Error(t, err)
+}
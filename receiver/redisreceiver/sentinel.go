@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Holds the fields of the masters monitored by a sentinel, returned by the Sentinel
+// section of the INFO command: e.g.
+// "master0:name=mymaster,status=ok,address=127.0.0.1:6379,slaves=1,sentinels=3"
+type sentinelMaster struct {
+	name      string
+	status    string
+	address   string
+	replicas  int
+	sentinels int
+}
+
+// Returns the masters monitored by the sentinel listed in the info, skipping the
+// malformed masters.
+func parseSentinelMasters(inf info) ([]sentinelMaster, []error) {
+	var masters []sentinelMaster
+	var errs []error
+	for i := 0; ; i++ {
+		key := "master" + strconv.Itoa(i)
+		str, ok := inf[key]
+		if !ok {
+			break
+		}
+		master, err := parseSentinelMasterString(str)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse %s: %w", key, err))
+			continue
+		}
+		masters = append(masters, *master)
+	}
+	return masters, errs
+}
+
+// Turns a sentinel master value (the part after the colon
+// e.g. "name=mymaster,status=ok,address=127.0.0.1:6379,slaves=1,sentinels=3") into a
+// sentinelMaster struct.
+func parseSentinelMasterString(str string) (*sentinelMaster, error) {
+	fields, err := parseFieldsString(str)
+	if err != nil {
+		return nil, err
+	}
+	master := sentinelMaster{
+		name:    fields["name"],
+		status:  fields["status"],
+		address: fields["address"],
+	}
+	if master.name == "" || master.address == "" {
+		return nil, fmt.Errorf("missing name or address in '%s'", str)
+	}
+	if master.replicas, err = strconv.Atoi(fields["slaves"]); err != nil {
+		return nil, err
+	}
+	if master.sentinels, err = strconv.Atoi(fields["sentinels"]); err != nil {
+		return nil, err
+	}
+	return &master, nil
+}
+
+// Turns a comma separated list of field=value pairs into a map.
+func parseFieldsString(str string) (map[string]string, error) {
+	fields := map[string]string{}
+	for _, pairStr := range strings.Split(str, ",") {
+		key, value, ok := strings.Cut(pairStr, "=")
+		if !ok {
+			return nil, fmt.Errorf("unexpected pair '%s'", pairStr)
+		}
+		fields[key] = value
+	}
+	return fields, nil
+}
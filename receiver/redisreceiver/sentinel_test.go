@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSentinelMasters(t *testing.T) {
+	svc := newRedisSvc(newFakeClient())
+	str, err := readFile("sentinel_info")
+	require.NoError(t, err)
+	masters, errs := parseSentinelMasters(svc.parse(str))
+	require.Empty(t, errs)
+	assert.Equal(t, []sentinelMaster{
+		{name: "mymaster", status: "ok", address: "127.0.0.1:6379", replicas: 2, sentinels: 3},
+		{name: "othermaster", status: "odown", address: "127.0.0.1:6390", replicas: 0, sentinels: 3},
+	}, masters)
+}
+
+func TestParseSentinelMasters_Malformed(t *testing.T) {
+	masters, errs := parseSentinelMasters(info{
+		"master0": "name=mymaster,status=ok,address=127.0.0.1:6379,slaves=x,sentinels=3",
+		"master1": "name=othermaster,status=ok,slaves=1,sentinels=3",
+		"master2": "name=lastmaster,status=ok,address=127.0.0.1:6390,slaves=1,sentinels=3",
+	})
+	assert.Len(t, errs, 2)
+	require.Len(t, masters, 1)
+	assert.Equal(t, "lastmaster", masters[0].name)
+}
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+// Discovers the nodes of a Redis cluster, or the masters monitored by a Redis sentinel
+// and their replicas, from the node of the endpoint at each scrape, and scrapes each
+// node as a resource. The scrapers of the nodes are kept between the scrapes for the
+// start time of their cumulative metrics.
+type topologyScraper struct {
+	mode     string
+	seed     *redisScraper
+	settings receiver.CreateSettings
+	cfg      *Config
+	// newClient creates the client of a discovered node
+	newClient func(address string) client
+	nodes     map[string]*redisScraper
+}
+
+func newTopologyScraperWithClient(seed client, newClient func(address string) client, settings receiver.CreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
+	ts := &topologyScraper{
+		mode:      cfg.Mode,
+		seed:      newNodeScraper(seed, cfg.Endpoint, settings, cfg),
+		settings:  settings,
+		cfg:       cfg,
+		newClient: newClient,
+		nodes:     map[string]*redisScraper{},
+	}
+	return scraperhelper.NewScraper(
+		metadata.Type,
+		ts.Scrape,
+		scraperhelper.WithShutdown(ts.shutdown),
+	)
+}
+
+func (ts *topologyScraper) shutdown(ctx context.Context) error {
+	err := ts.seed.shutdown(ctx)
+	for _, node := range ts.nodes {
+		err = multierr.Append(err, node.shutdown(ctx))
+	}
+	return err
+}
+
+// Scrape discovers the nodes and scrapes them, the nodes failing to be scraped being
+// reported as a partial scrape error.
+func (ts *topologyScraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var addresses []string
+	var err error
+	if ts.mode == ModeCluster {
+		addresses, err = ts.discoverClusterNodes()
+	} else {
+		addresses, err = ts.discoverSentinelNodes()
+	}
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+
+	md := pmetric.NewMetrics()
+	var errs scrapererror.ScrapeErrors
+	scrape := func(address string, node *redisScraper) {
+		nodeMetrics, err := node.Scrape(ctx)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to scrape node %s: %w", address, err))
+			return
+		}
+		nodeMetrics.ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+	}
+	if ts.mode == ModeSentinel {
+		scrape(ts.cfg.Endpoint, ts.seed)
+	}
+
+	discovered := map[string]bool{}
+	for _, address := range addresses {
+		if discovered[address] {
+			continue
+		}
+		discovered[address] = true
+		node, ok := ts.nodes[address]
+		if !ok {
+			node = newNodeScraper(ts.newClient(address), address, ts.settings, ts.cfg)
+			ts.nodes[address] = node
+		}
+		scrape(address, node)
+	}
+	// the nodes removed from the topology are forgotten
+	for address, node := range ts.nodes {
+		if !discovered[address] {
+			_ = node.shutdown(ctx)
+			delete(ts.nodes, address)
+		}
+	}
+	return md, errs.Combine()
+}
+
+// Returns the addresses of the reachable nodes of the cluster of the endpoint.
+func (ts *topologyScraper) discoverClusterNodes() ([]string, error) {
+	nodes, err := ts.seed.redisSvc.clusterNodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover the cluster nodes: %w", err)
+	}
+	var addresses []string
+	for _, node := range nodes {
+		if node.reachable() {
+			addresses = append(addresses, node.address)
+		}
+	}
+	return addresses, nil
+}
+
+// Returns the addresses of the masters monitored by the sentinel of the endpoint, and the
+// addresses of their healthy replicas.
+func (ts *topologyScraper) discoverSentinelNodes() ([]string, error) {
+	inf, err := ts.seed.redisSvc.info()
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover the sentinel masters: %w", err)
+	}
+	if inf["redis_mode"] != "sentinel" {
+		return nil, errors.New("the endpoint is not a sentinel")
+	}
+	masters, errs := parseSentinelMasters(inf)
+	for _, err := range errs {
+		ts.settings.Logger.Warn("failed to parse sentinel master string", zap.Error(err))
+	}
+	var addresses []string
+	for _, master := range masters {
+		// the masters objectively down are unreachable
+		if master.status != "odown" {
+			addresses = append(addresses, master.address)
+		}
+		replicas, err := ts.seed.client.retrieveSentinelReplicas(master.name)
+		if err != nil {
+			ts.settings.Logger.Warn("failed to discover the sentinel master replicas", zap.String("master", master.name), zap.Error(err))
+			continue
+		}
+		addresses = append(addresses, replicas...)
+	}
+	return addresses, nil
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+// fakeNodeClient is a fakeClient of a node of a cluster or sentinel topology.
+type fakeNodeClient struct {
+	fakeClient
+	// info is the testdata file of the INFO of the node
+	info string
+	// clusterNodes replaces the CLUSTER NODES of the testdata when set
+	clusterNodes string
+	replicas     map[string][]string
+	err          error
+	closed       bool
+}
+
+func (c *fakeNodeClient) retrieveInfo() (string, error) {
+	if c.err != nil {
+		return "", c.err
+	}
+	return readFile(c.info)
+}
+
+func (c *fakeNodeClient) retrieveClusterNodes() (string, error) {
+	if c.clusterNodes != "" {
+		return c.clusterNodes, nil
+	}
+	return c.fakeClient.retrieveClusterNodes()
+}
+
+func (c *fakeNodeClient) retrieveSentinelReplicas(master string) ([]string, error) {
+	return c.replicas[master], nil
+}
+
+func (c *fakeNodeClient) close() error {
+	c.closed = true
+	return nil
+}
+
+func nodeAddresses(t *testing.T, md pmetric.Metrics) map[string]string {
+	roles := map[string]string{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		attrs := md.ResourceMetrics().At(i).Resource().Attributes()
+		address, ok := attrs.Get("redis.node.address")
+		require.True(t, ok)
+		role, ok := attrs.Get("redis.node.role")
+		require.True(t, ok)
+		roles[address.Str()] = role.Str()
+	}
+	return roles
+}
+
+func TestTopologyScraper_Cluster(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:30001"
+	cfg.Mode = ModeCluster
+	seed := &fakeNodeClient{info: "cluster_node_info"}
+	clients := map[string]*fakeNodeClient{}
+	newClient := func(address string) client {
+		c := &fakeNodeClient{info: "cluster_node_info"}
+		if address == "127.0.0.1:30003" {
+			c.err = errors.New("connection refused")
+		}
+		clients[address] = c
+		return c
+	}
+	scraper, err := newTopologyScraperWithClient(seed, newClient, receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := scraper.Scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Contains(t, err.Error(), "127.0.0.1:30003")
+	assert.Equal(t, map[string]string{
+		"127.0.0.1:30001": "primary",
+		"127.0.0.1:30002": "primary",
+		"127.0.0.1:30004": "primary",
+		"127.0.0.1:30005": "primary",
+	}, nodeAddresses(t, md))
+	assert.Len(t, clients, 5)
+
+	// the nodes removed from the cluster are closed
+	seed.clusterNodes = "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 0-16383\n"
+	md, err = scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"127.0.0.1:30001": "primary"}, nodeAddresses(t, md))
+	assert.False(t, clients["127.0.0.1:30001"].closed)
+	assert.True(t, clients["127.0.0.1:30002"].closed)
+
+	require.NoError(t, scraper.Shutdown(context.Background()))
+	assert.True(t, seed.closed)
+	assert.True(t, clients["127.0.0.1:30001"].closed)
+}
+
+func TestTopologyScraper_Sentinel(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:26379"
+	cfg.Mode = ModeSentinel
+	seed := &fakeNodeClient{
+		info: "sentinel_info",
+		replicas: map[string][]string{
+			"mymaster": {"127.0.0.1:6380", "127.0.0.1:6381"},
+		},
+	}
+	newClient := func(address string) client {
+		return &fakeNodeClient{info: "info"}
+	}
+	scraper, err := newTopologyScraperWithClient(seed, newClient, receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"localhost:26379": "sentinel",
+		"127.0.0.1:6379":  "primary",
+		"127.0.0.1:6380":  "primary",
+		"127.0.0.1:6381":  "primary",
+	}, nodeAddresses(t, md))
+}
+
+func TestTopologyScraper_NotSentinel(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Mode = ModeSentinel
+	scraper, err := newTopologyScraperWithClient(&fakeNodeClient{info: "info"}, nil, receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	_, err = scraper.Scrape(context.Background())
+	assert.EqualError(t, err, "the endpoint is not a sentinel")
+}
@@ -4,6 +4,7 @@
 package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -139,6 +140,11 @@ func setV3ClientConfigs(client goSNMPWrapper, cfg *Config) {
 		client.SetMsgFlags(gosnmp.NoAuthNoPriv)
 	}
 	client.SetSecurityParameters(securityParams)
+
+	// Set goSNMP context based on config. The engine ID was validated in config
+	client.SetContextName(cfg.ContextName)
+	contextEngineID, _ := hex.DecodeString(strings.TrimPrefix(cfg.ContextEngineID, "0x"))
+	client.SetContextEngineID(string(contextEngineID))
 }
 
 // getAuthProtocol gets gosnmp auth protocol based on config auth type
@@ -4,6 +4,7 @@
 package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
@@ -59,6 +60,24 @@ func TestNewClient(t *testing.T) {
 			settings:    componenttest.NewNopTelemetrySettings(),
 			logger:      zap.NewNop(),
 			expectError: nil,
+		}, {
+			desc: "Valid v3 configuration with context",
+			cfg: &Config{
+				Version:         "v3",
+				Endpoint:        "tcp://localhost:161",
+				User:            "user",
+				SecurityLevel:   "auth_priv",
+				AuthType:        "MD5",
+				AuthPassword:    "authpass",
+				PrivacyType:     "DES",
+				PrivacyPassword: "privacypass",
+				ContextName:     "vlan-10",
+				ContextEngineID: "0x80001f8880e9",
+			},
+			host:        componenttest.NewNopHost(),
+			settings:    componenttest.NewNopTelemetrySettings(),
+			logger:      zap.NewNop(),
+			expectError: nil,
 		},
 	}
 
@@ -97,6 +116,10 @@ func compareConfigToClient(t *testing.T, client *snmpClient, cfg *Config) {
 		require.Equal(t, gosnmp.Version3, client.client.GetVersion())
 		securityParams := client.client.GetSecurityParameters().(*gosnmp.UsmSecurityParameters)
 		require.Equal(t, cfg.User, securityParams.UserName)
+		require.Equal(t, cfg.ContextName, client.client.GetContextName())
+		contextEngineID, err := hex.DecodeString(strings.TrimPrefix(cfg.ContextEngineID, "0x"))
+		require.NoError(t, err)
+		require.Equal(t, string(contextEngineID), client.client.GetContextEngineID())
 		switch cfg.SecurityLevel {
 		case "no_auth_no_priv":
 			require.Equal(t, gosnmp.NoAuthNoPriv, client.client.GetMsgFlags())
@@ -3,6 +3,7 @@
 package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/url"
@@ -48,6 +49,10 @@ var (
 	errMsgColumnAttributeBadValue          = `metric '%s' column_oid attribute '%s' value '%s' must match one of the possible enum values for the attribute config`
 	errMsgColumnResourceAttributeBadName   = `metric '%s' column_oid resource_attribute '%s' must match a resource_attribute config`
 	errMsgColumnIndexedAttributeRequired   = `metric '%s' column_oid must either have a resource_attribute or an indexed_value_prefix/oid attribute`
+	errMsgMetricScaleBadValueType          = `metric '%s' scale requires a double value_type`
+	errMsgIndexedOIDOptionsNoOID           = `%s '%s' index_components, value_format and value_mapping require an oid`
+	errMsgBadIndexComponent                = `%s '%s' index_components must not be negative`
+	errMsgBadValueFormat                   = `%s '%s' value_format must be either hex or mac`
 
 	// Config errors
 	errEmptyEndpoint        = errors.New("endpoint must be specified")
@@ -63,6 +68,7 @@ var (
 	errEmptyPrivacyType     = errors.New("privacy_type must be specified when security_level is auth_priv")
 	errBadPrivacyType       = errors.New("privacy_type must be either DES, AES, AES192, AES192C, AES256, AES256C")
 	errEmptyPrivacyPassword = errors.New("privacy_password must be specified when security_level is auth_priv")
+	errBadContextEngineID   = errors.New("context_engine_id must be a hexadecimal string")
 	errMetricRequired       = errors.New("must have at least one config under metrics")
 )
 
@@ -116,6 +122,16 @@ type Config struct {
 	// Only valid for version “v3” and if "auth_priv" is selected for SecurityLevel
 	PrivacyPassword configopaque.String `mapstructure:"privacy_password"`
 
+	// ContextName is the SNMPv3 context name of the requests, which selects the MIB view of the
+	// agent, for example a VLAN or a routing instance.
+	// Only valid for version “v3”
+	ContextName string `mapstructure:"context_name"`
+
+	// ContextEngineID is the SNMPv3 context engine ID of the requests as a hexadecimal string, for
+	// example to reach an agent through an SNMP proxy. Defaults to the authoritative engine ID.
+	// Only valid for version “v3”
+	ContextEngineID string `mapstructure:"context_engine_id"`
+
 	// ResourceAttributes defines what resource attributes will be used for this receiver and is composed
 	// of resource attribute names along with their resource attribute configurations
 	ResourceAttributes map[string]*ResourceAttributeConfig `mapstructure:"resource_attributes"`
@@ -144,6 +160,8 @@ type ResourceAttributeConfig struct {
 	// as an attribute on that resource. The related indexed metric values will then be used to associate metric datapoints to
 	// those resources.
 	IndexedValuePrefix string `mapstructure:"indexed_value_prefix"` // required and valid if no oid field
+	// IndexedOIDOptions are optional and only valid if OID is defined
+	IndexedOIDOptions `mapstructure:",squash"`
 }
 
 // AttributeConfig contains config info about all of the metric attributes that will be used by this receiver.
@@ -161,6 +179,23 @@ type AttributeConfig struct {
 	// IndexedValuePrefix is required only if Enum and OID are not defined.
 	// This is used alongside metrics with ColumnOIDs to assign attribute values using this prefix + the OID index of the metric value
 	IndexedValuePrefix string `mapstructure:"indexed_value_prefix"`
+	// IndexedOIDOptions are optional and only valid if OID is defined
+	IndexedOIDOptions `mapstructure:",squash"`
+}
+
+// IndexedOIDOptions contains info about how the indexed values of a {resource} attribute column OID
+// are matched to the indexed metric values and transformed into {resource} attribute values.
+type IndexedOIDOptions struct {
+	// IndexComponents is optional and is used when the column OID belongs to a different table than the
+	// metric column OIDs. It lists the positions (starting at 0) of the metric OID index components that
+	// form the index of this column OID. Ex: [0] matches the metric index .3.12 to the index .3
+	IndexComponents []int `mapstructure:"index_components"`
+	// ValueFormat is optional and transforms OctetString values. Valid values are hex, which formats the value
+	// as a hexadecimal string, and mac, which formats it as colon separated hexadecimal bytes (Ex: MAC addresses)
+	ValueFormat string `mapstructure:"value_format"`
+	// ValueMapping is optional and maps the (formatted) values to other values. Ex: {"1": "up", "2": "down"}
+	// Values without a mapping are kept as is
+	ValueMapping map[string]string `mapstructure:"value_mapping"`
 }
 
 // MetricConfig contains config info about a given metric
@@ -178,6 +213,9 @@ type MetricConfig struct {
 	// for this metric.
 	ScalarOIDs []ScalarOID `mapstructure:"scalar_oids"`
 	ColumnOIDs []ColumnOID `mapstructure:"column_oids"`
+	// Scale is optional and multiplies the values of the OIDs, Ex: 0.1 for values reported in tenths.
+	// It requires a double value_type
+	Scale float64 `mapstructure:"scale"`
 }
 
 // GaugeMetric contains info about the value of the gauge metric
@@ -238,6 +276,7 @@ func (cfg *Config) Validate() error {
 	combinedErr = multierr.Append(combinedErr, validateVersion(cfg))
 	if strings.ToUpper(cfg.Version) == "V3" {
 		combinedErr = multierr.Append(combinedErr, validateSecurity(cfg))
+		combinedErr = multierr.Append(combinedErr, validateContext(cfg))
 	}
 	combinedErr = multierr.Append(combinedErr, validateMetricConfigs(cfg))
 
@@ -314,6 +353,14 @@ func validateSecurity(cfg *Config) error {
 	}
 }
 
+// validateContext validates the ContextEngineID
+func validateContext(cfg *Config) error {
+	if _, err := hex.DecodeString(strings.TrimPrefix(cfg.ContextEngineID, "0x")); err != nil {
+		return errBadContextEngineID
+	}
+	return nil
+}
+
 // validateAuth validates the AuthType and AuthPassword
 func validateAuth(cfg *Config) error {
 	var combinedErr error
@@ -396,6 +443,10 @@ func validateMetricConfigs(cfg *Config) error {
 			combinedErr = multierr.Append(combinedErr, validateSum(metricName, metricCfg.Sum))
 		}
 
+		if metricCfg.Scale != 0 && !strings.EqualFold(metricCfg.valueType(), "double") {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgMetricScaleBadValueType, metricName))
+		}
+
 		for _, scalarOID := range metricCfg.ScalarOIDs {
 			combinedErr = multierr.Append(combinedErr, validateScalarOID(metricName, scalarOID, cfg))
 		}
@@ -548,6 +599,7 @@ func validateAttributeConfigs(cfg *Config) error {
 		if len(attrCfg.Enum) == 0 && attrCfg.OID == "" && attrCfg.IndexedValuePrefix == "" {
 			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgAttributeConfigNoEnumOIDOrPrefix, attrName))
 		}
+		combinedErr = multierr.Append(combinedErr, validateIndexedOIDOptions("attribute", attrName, attrCfg.OID, attrCfg.IndexedOIDOptions))
 	}
 
 	return combinedErr
@@ -567,11 +619,59 @@ func validateResourceAttributeConfigs(cfg *Config) error {
 		if attrCfg.OID == "" && attrCfg.IndexedValuePrefix == "" {
 			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgResourceAttributeNoOIDOrPrefix, attrName))
 		}
+		combinedErr = multierr.Append(combinedErr, validateIndexedOIDOptions("resource_attribute", attrName, attrCfg.OID, attrCfg.IndexedOIDOptions))
+	}
+
+	return combinedErr
+}
+
+// validateIndexedOIDOptions validates the IndexedOIDOptions of an AttributeConfig or ResourceAttributeConfig
+func validateIndexedOIDOptions(kind string, name string, oid string, options IndexedOIDOptions) error {
+	if len(options.IndexComponents) == 0 && options.ValueFormat == "" && len(options.ValueMapping) == 0 {
+		return nil
+	}
+
+	if oid == "" {
+		return fmt.Errorf(errMsgIndexedOIDOptionsNoOID, kind, name)
+	}
+
+	var combinedErr error
+	for _, component := range options.IndexComponents {
+		if component < 0 {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgBadIndexComponent, kind, name))
+			break
+		}
+	}
+
+	switch strings.ToUpper(options.ValueFormat) {
+	case "", "HEX", "MAC": // ok
+	default:
+		combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgBadValueFormat, kind, name))
 	}
 
 	return combinedErr
 }
 
+// valueType returns the value type of the gauge or sum of the MetricConfig
+func (m *MetricConfig) valueType() string {
+	switch {
+	case m.Gauge != nil:
+		return m.Gauge.ValueType
+	case m.Sum != nil:
+		return m.Sum.ValueType
+	default:
+		return ""
+	}
+}
+
+// applyScale multiplies the value by the Scale of the MetricConfig, if any
+func (m *MetricConfig) applyScale(value float64) float64 {
+	if m.Scale == 0 {
+		return value
+	}
+	return value * m.Scale
+}
+
 // contains checks if string slice contains a string value
 func contains(elements []string, value string) bool {
 	for _, element := range elements {
@@ -4,6 +4,8 @@
 package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
 
 import (
+	"encoding/hex"
+	"fmt"
 	"strings"
 )
 
@@ -165,6 +167,26 @@ func (h configHelper) getResourceAttributeConfigIndexedValuePrefix(name string)
 	return attrConfig.IndexedValuePrefix
 }
 
+// getAttributeConfigIndexedOIDOptions returns the IndexedOIDOptions of the attribute config
+func (h configHelper) getAttributeConfigIndexedOIDOptions(name string) IndexedOIDOptions {
+	attrConfig := h.cfg.Attributes[name]
+	if attrConfig == nil {
+		return IndexedOIDOptions{}
+	}
+
+	return attrConfig.IndexedOIDOptions
+}
+
+// getResourceAttributeConfigIndexedOIDOptions returns the IndexedOIDOptions of the resource attribute config
+func (h configHelper) getResourceAttributeConfigIndexedOIDOptions(name string) IndexedOIDOptions {
+	attrConfig := h.cfg.ResourceAttributes[name]
+	if attrConfig == nil {
+		return IndexedOIDOptions{}
+	}
+
+	return attrConfig.IndexedOIDOptions
+}
+
 // getResourceAttributeConfigOID returns the column OID of a resource attribute config
 func (h configHelper) getResourceAttributeConfigOID(name string) string {
 	attrConfig := h.cfg.ResourceAttributes[name]
@@ -184,3 +206,47 @@ func (h configHelper) getMetricConfigAttributes(oid string) []Attribute {
 func (h configHelper) getResourceAttributeNames(oid string) []string {
 	return h.resourceAttributesByOID[oid]
 }
+
+// attributeIndex returns the index of the {resource} attribute column OID value matching the index of an indexed
+// metric value, made of the IndexComponents of the metric index. It returns an empty index if the metric index
+// doesn't have these components.
+func (o IndexedOIDOptions) attributeIndex(metricIndex string) string {
+	if len(o.IndexComponents) == 0 {
+		return metricIndex
+	}
+
+	components := strings.Split(strings.TrimPrefix(metricIndex, "."), ".")
+	var sb strings.Builder
+	for _, i := range o.IndexComponents {
+		if i >= len(components) {
+			return ""
+		}
+		sb.WriteString(".")
+		sb.WriteString(components[i])
+	}
+	return sb.String()
+}
+
+// transformValue formats and maps a {resource} attribute column OID value
+func (o IndexedOIDOptions) transformValue(value string) string {
+	if value == "" {
+		return value
+	}
+
+	switch strings.ToUpper(o.ValueFormat) {
+	case "HEX":
+		value = hex.EncodeToString([]byte(value))
+	case "MAC":
+		bytes := []byte(value)
+		hexBytes := make([]string, len(bytes))
+		for i, b := range bytes {
+			hexBytes[i] = fmt.Sprintf("%02x", b)
+		}
+		value = strings.Join(hexBytes, ":")
+	}
+
+	if mapped, ok := o.ValueMapping[value]; ok {
+		return mapped
+	}
+	return value
+}
@@ -997,3 +997,89 @@ func TestGetResourceAttributeNames(t *testing.T) {
 		t.Run(tc.desc, tc.testFunc)
 	}
 }
+
+func TestIndexedOIDOptionsAttributeIndex(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		options     IndexedOIDOptions
+		metricIndex string
+		expected    string
+	}{
+		{
+			desc:        "Returns metric index without index components",
+			options:     IndexedOIDOptions{},
+			metricIndex: ".3.12",
+			expected:    ".3.12",
+		},
+		{
+			desc:        "Returns index components of the metric index",
+			options:     IndexedOIDOptions{IndexComponents: []int{2, 0}},
+			metricIndex: ".3.12.7",
+			expected:    ".7.3",
+		},
+		{
+			desc:        "Returns empty index when the metric index is too short",
+			options:     IndexedOIDOptions{IndexComponents: []int{1}},
+			metricIndex: ".3",
+			expected:    "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.options.attributeIndex(tc.metricIndex))
+		})
+	}
+}
+
+func TestIndexedOIDOptionsTransformValue(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		options  IndexedOIDOptions
+		value    string
+		expected string
+	}{
+		{
+			desc:     "Returns value without options",
+			options:  IndexedOIDOptions{},
+			value:    "eth0",
+			expected: "eth0",
+		},
+		{
+			desc:     "Formats value as hex",
+			options:  IndexedOIDOptions{ValueFormat: "hex"},
+			value:    "\x01\xab",
+			expected: "01ab",
+		},
+		{
+			desc:     "Formats value as mac",
+			options:  IndexedOIDOptions{ValueFormat: "MAC"},
+			value:    "\x00\x1a\x2b\x3c\x4d\x5e",
+			expected: "00:1a:2b:3c:4d:5e",
+		},
+		{
+			desc:     "Maps value",
+			options:  IndexedOIDOptions{ValueMapping: map[string]string{"1": "up", "2": "down"}},
+			value:    "2",
+			expected: "down",
+		},
+		{
+			desc:     "Keeps value without mapping",
+			options:  IndexedOIDOptions{ValueMapping: map[string]string{"1": "up", "2": "down"}},
+			value:    "3",
+			expected: "3",
+		},
+		{
+			desc:     "Maps formatted value",
+			options:  IndexedOIDOptions{ValueFormat: "hex", ValueMapping: map[string]string{"01": "first"}},
+			value:    "\x01",
+			expected: "first",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.options.transformValue(tc.value))
+		})
+	}
+}
@@ -130,6 +130,25 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 	expectedConfigV3NoPrivacyPassword.AuthPassword = "p"
 	expectedConfigV3NoPrivacyPassword.Metrics = metrics
 
+	expectedConfigV3Context := factory.CreateDefaultConfig().(*Config)
+	expectedConfigV3Context.Version = "v3"
+	expectedConfigV3Context.User = "u"
+	expectedConfigV3Context.SecurityLevel = "auth_priv"
+	expectedConfigV3Context.AuthPassword = "p"
+	expectedConfigV3Context.PrivacyPassword = "pp"
+	expectedConfigV3Context.ContextName = "vlan-10"
+	expectedConfigV3Context.ContextEngineID = "0x80001f8880e9"
+	expectedConfigV3Context.Metrics = metrics
+
+	expectedConfigV3BadContextEngineID := factory.CreateDefaultConfig().(*Config)
+	expectedConfigV3BadContextEngineID.Version = "v3"
+	expectedConfigV3BadContextEngineID.User = "u"
+	expectedConfigV3BadContextEngineID.SecurityLevel = "auth_priv"
+	expectedConfigV3BadContextEngineID.AuthPassword = "p"
+	expectedConfigV3BadContextEngineID.PrivacyPassword = "pp"
+	expectedConfigV3BadContextEngineID.ContextEngineID = "engine"
+	expectedConfigV3BadContextEngineID.Metrics = metrics
+
 	testCases := []testCase{
 		{
 			name:        "NoEndpointUsesDefault",
@@ -245,6 +264,18 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 			expectedCfg: expectedConfigV3Simple,
 			expectedErr: "",
 		},
+		{
+			name:        "GoodV3ContextNoErrors",
+			nameVal:     "v3_context_good",
+			expectedCfg: expectedConfigV3Context,
+			expectedErr: "",
+		},
+		{
+			name:        "BadV3ContextEngineIDErrors",
+			nameVal:     "v3_bad_context_engine_id",
+			expectedCfg: expectedConfigV3BadContextEngineID,
+			expectedErr: errBadContextEngineID.Error(),
+		},
 	}
 
 	for _, test := range testCases {
@@ -684,6 +715,70 @@ func TestLoadConfigMetricConfigs(t *testing.T) {
 		},
 	}
 
+	expectedConfigScaleIntValueType := factory.CreateDefaultConfig().(*Config)
+	expectedConfigScaleIntValueType.Metrics = getBaseMetricConfig(true, true)
+	expectedConfigScaleIntValueType.Metrics["m3"].Gauge.ValueType = "int"
+	expectedConfigScaleIntValueType.Metrics["m3"].Scale = 0.1
+
+	expectedConfigIndexedOIDOptionsNoOID := factory.CreateDefaultConfig().(*Config)
+	expectedConfigIndexedOIDOptionsNoOID.Attributes = map[string]*AttributeConfig{
+		"a2": {
+			IndexedValuePrefix: "p",
+			IndexedOIDOptions: IndexedOIDOptions{
+				ValueFormat: "hex",
+			},
+		},
+	}
+	expectedConfigIndexedOIDOptionsNoOID.Metrics = getBaseMetricConfig(true, false)
+	expectedConfigIndexedOIDOptionsNoOID.Metrics["m3"].ColumnOIDs[0].Attributes = []Attribute{
+		{
+			Name: "a2",
+		},
+	}
+
+	expectedConfigIndexedOIDOptionsBad := factory.CreateDefaultConfig().(*Config)
+	expectedConfigIndexedOIDOptionsBad.ResourceAttributes = map[string]*ResourceAttributeConfig{
+		"ra1": {
+			OID: "2",
+			IndexedOIDOptions: IndexedOIDOptions{
+				IndexComponents: []int{-1},
+				ValueFormat:     "base64",
+			},
+		},
+	}
+	expectedConfigIndexedOIDOptionsBad.Metrics = getBaseMetricConfig(true, false)
+	expectedConfigIndexedOIDOptionsBad.Metrics["m3"].ColumnOIDs[0].ResourceAttributes = []string{"ra1"}
+
+	expectedConfigIndexedOIDOptionsGood := factory.CreateDefaultConfig().(*Config)
+	expectedConfigIndexedOIDOptionsGood.ResourceAttributes = map[string]*ResourceAttributeConfig{
+		"ra1": {
+			OID: "2",
+			IndexedOIDOptions: IndexedOIDOptions{
+				IndexComponents: []int{0},
+				ValueFormat:     "mac",
+			},
+		},
+	}
+	expectedConfigIndexedOIDOptionsGood.Attributes = map[string]*AttributeConfig{
+		"a2": {
+			OID: "3",
+			IndexedOIDOptions: IndexedOIDOptions{
+				ValueMapping: map[string]string{
+					"1": "up",
+					"2": "down",
+				},
+			},
+		},
+	}
+	expectedConfigIndexedOIDOptionsGood.Metrics = getBaseMetricConfig(true, false)
+	expectedConfigIndexedOIDOptionsGood.Metrics["m3"].Scale = 0.1
+	expectedConfigIndexedOIDOptionsGood.Metrics["m3"].ColumnOIDs[0].ResourceAttributes = []string{"ra1"}
+	expectedConfigIndexedOIDOptionsGood.Metrics["m3"].ColumnOIDs[0].Attributes = []Attribute{
+		{
+			Name: "a2",
+		},
+	}
+
 	testCases := []testCase{
 		{
 			name:        "NoMetricConfigsErrors",
@@ -829,6 +924,30 @@ func TestLoadConfigMetricConfigs(t *testing.T) {
 			expectedCfg: expectedConfigComplexGood,
 			expectedErr: "",
 		},
+		{
+			name:        "ScaleIntValueTypeErrors",
+			nameVal:     "scale_int_value_type",
+			expectedCfg: expectedConfigScaleIntValueType,
+			expectedErr: fmt.Sprintf(errMsgMetricScaleBadValueType, "m3"),
+		},
+		{
+			name:        "IndexedOIDOptionsWithoutOIDErrors",
+			nameVal:     "indexed_oid_options_no_oid",
+			expectedCfg: expectedConfigIndexedOIDOptionsNoOID,
+			expectedErr: fmt.Sprintf(errMsgIndexedOIDOptionsNoOID, "attribute", "a2"),
+		},
+		{
+			name:        "BadIndexedOIDOptionsErrors",
+			nameVal:     "indexed_oid_options_bad",
+			expectedCfg: expectedConfigIndexedOIDOptionsBad,
+			expectedErr: fmt.Sprintf(errMsgBadValueFormat, "resource_attribute", "ra1"),
+		},
+		{
+			name:        "IndexedOIDOptionsGood",
+			nameVal:     "indexed_oid_options_good",
+			expectedCfg: expectedConfigIndexedOIDOptionsGood,
+			expectedErr: "",
+		},
 	}
 
 	for _, test := range testCases {
@@ -97,6 +97,18 @@ type goSNMPWrapper interface {
 
 	// SetSecurityParameters sets the SecurityParameters
 	SetSecurityParameters(securityParameters gosnmp.SnmpV3SecurityParameters)
+
+	// GetContextEngineID gets the ContextEngineID
+	GetContextEngineID() string
+
+	// SetContextEngineID sets the ContextEngineID
+	SetContextEngineID(contextEngineID string)
+
+	// GetContextName gets the ContextName
+	GetContextName() string
+
+	// SetContextName sets the ContextName
+	SetContextName(contextName string)
 }
 
 // otelGoSNMPWrapper is a wrapper around gosnmp
@@ -217,3 +229,23 @@ func (w *otelGoSNMPWrapper) GetSecurityParameters() gosnmp.SnmpV3SecurityParamet
 func (w *otelGoSNMPWrapper) SetSecurityParameters(securityParameters gosnmp.SnmpV3SecurityParameters) {
 	w.GoSNMP.SecurityParameters = securityParameters
 }
+
+// GetContextEngineID gets the ContextEngineID
+func (w *otelGoSNMPWrapper) GetContextEngineID() string {
+	return w.GoSNMP.ContextEngineID
+}
+
+// SetContextEngineID sets the ContextEngineID
+func (w *otelGoSNMPWrapper) SetContextEngineID(contextEngineID string) {
+	w.GoSNMP.ContextEngineID = contextEngineID
+}
+
+// GetContextName gets the ContextName
+func (w *otelGoSNMPWrapper) GetContextName() string {
+	return w.GoSNMP.ContextName
+}
+
+// SetContextName sets the ContextName
+func (w *otelGoSNMPWrapper) SetContextName(contextName string) {
+	w.GoSNMP.ContextName = contextName
+}
@@ -185,7 +185,7 @@ func (h *otelMetricHelper) addMetricDataPoint(resourceKey string, metricName str
 	case floatVal:
 		rawValue := data.value.(float64)
 		if valueType == "double" {
-			dp.SetDoubleValue(rawValue)
+			dp.SetDoubleValue(metricCfg.applyScale(rawValue))
 		} else {
 			dp.SetIntValue(int64(rawValue))
 		}
@@ -194,7 +194,7 @@ func (h *otelMetricHelper) addMetricDataPoint(resourceKey string, metricName str
 		if valueType == "int" {
 			dp.SetIntValue(rawValue)
 		} else {
-			dp.SetDoubleValue(float64(rawValue))
+			dp.SetDoubleValue(metricCfg.applyScale(float64(rawValue)))
 		}
 	case stringVal:
 		return nil, fmt.Errorf("cannot create data point for metric %q from string value", metricName)
@@ -283,7 +283,8 @@ func getIndexedDataPointAttributes(
 		case prefix != "":
 			attributeValue = prefix + indexString
 		case oid != "":
-			attributeValue = columnOIDIndexedAttributeValues[oid][indexString]
+			options := configHelper.getAttributeConfigIndexedOIDOptions(attributeName)
+			attributeValue = options.transformValue(columnOIDIndexedAttributeValues[oid][options.attributeIndex(indexString)])
 		default:
 			attributeValue = attribute.Value
 		}
@@ -317,7 +318,8 @@ func getResourceAttributes(
 		case prefix != "":
 			resourceAttributes[attributeName] = prefix + indexString
 		case oid != "":
-			attributeValue := columnOIDIndexedResourceAttributeValues[oid][indexString]
+			options := configHelper.getResourceAttributeConfigIndexedOIDOptions(attributeName)
+			attributeValue := options.transformValue(columnOIDIndexedResourceAttributeValues[oid][options.attributeIndex(indexString)])
 
 			if attributeValue == "" {
 				return nil, errors.New(errMsgResourceAttributeEmptyValue)
@@ -2194,6 +2194,88 @@ func TestScrape(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			desc: "Resource attribute with column OID of another table, value mapping and metric scale creates metrics (19)",
+			testFunc: func(t *testing.T) {
+				mockClient := new(MockClient)
+				snmpData0 := SNMPData{
+					columnOID: ".0",
+					oid:       ".0.1",
+					value:     "1",
+					valueType: stringVal,
+				}
+				snmpData1 := SNMPData{
+					columnOID: ".0",
+					oid:       ".0.2",
+					value:     "2",
+					valueType: stringVal,
+				}
+				snmpData2 := SNMPData{
+					columnOID: ".1",
+					oid:       ".1.1.5",
+					value:     float64(10.0),
+					valueType: floatVal,
+				}
+				snmpData3 := SNMPData{
+					columnOID: ".1",
+					oid:       ".1.2.5",
+					value:     float64(20.0),
+					valueType: floatVal,
+				}
+				mockClient.On("Connect").Return(nil)
+				mockClient.On("Close").Return(nil)
+				mockClient.On("GetIndexedData", []string{".0"}, mock.Anything).Return([]SNMPData{snmpData0, snmpData1}).Once()
+				mockClient.On("GetIndexedData", []string{".1"}, mock.Anything).Return([]SNMPData{snmpData2, snmpData3}).Once()
+				scraper := &snmpScraper{
+					cfg: &Config{
+						ResourceAttributes: map[string]*ResourceAttributeConfig{
+							"rattr1": {
+								OID: ".0",
+								IndexedOIDOptions: IndexedOIDOptions{
+									IndexComponents: []int{0},
+									ValueMapping: map[string]string{
+										"1": "up",
+										"2": "down",
+									},
+								},
+							},
+						},
+						Metrics: map[string]*MetricConfig{
+							"metric1": {
+								Description: "test description",
+								Unit:        "By",
+								Gauge: &GaugeMetric{
+									ValueType: "double",
+								},
+								Scale: 0.1,
+								ColumnOIDs: []ColumnOID{
+									{
+										OID:                ".1",
+										ResourceAttributes: []string{"rattr1"},
+									},
+								},
+							},
+						},
+					},
+					settings: receivertest.NewNopCreateSettings(),
+					client:   mockClient,
+					logger:   zap.NewNop(),
+				}
+
+				expectedMetricGen := func(t *testing.T) pmetric.Metrics {
+					goldenPath := filepath.Join("testdata", "expected_metrics",
+						"19_indexed_oid_res_attr_index_components_golden.yaml")
+					expectedMetrics, err := golden.ReadMetrics(goldenPath)
+					require.NoError(t, err)
+					return expectedMetrics
+				}
+				expectedMetrics := expectedMetricGen(t)
+				metrics, err := scraper.scrape(context.Background())
+				require.NoError(t, err)
+				err = pmetrictest.CompareMetrics(expectedMetrics, metrics, pmetrictest.IgnoreTimestamp())
+				require.NoError(t, err)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
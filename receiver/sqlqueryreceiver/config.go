@@ -57,6 +57,12 @@ func (q Query) Validate() error {
 	if len(q.Logs) == 0 && len(q.Metrics) == 0 {
 		errs = multierr.Append(errs, errors.New("at least one of 'query.logs' and 'query.metrics' must not be empty"))
 	}
+	if q.TrackingStartValue != "" && q.TrackingColumn == "" {
+		errs = multierr.Append(errs, errors.New("'query.tracking_start_value' requires 'query.tracking_column'"))
+	}
+	if q.TrackingColumn != "" && len(q.Logs) == 0 {
+		errs = multierr.Append(errs, errors.New("'query.tracking_column' applies only to logs and requires 'query.logs'"))
+	}
 	for _, logs := range q.Logs {
 		if err := logs.Validate(); err != nil {
 			errs = multierr.Append(errs, err)
@@ -71,7 +77,8 @@ func (q Query) Validate() error {
 }
 
 type LogsCfg struct {
-	BodyColumn string `mapstructure:"body_column"`
+	BodyColumn       string   `mapstructure:"body_column"`
+	AttributeColumns []string `mapstructure:"attribute_columns"`
 }
 
 func (config LogsCfg) Validate() error {
@@ -122,13 +122,24 @@ func TestLoadConfig(t *testing.T) {
 						TrackingStartValue: "10",
 						Logs: []LogsCfg{
 							{
-								BodyColumn: "log_body",
+								BodyColumn:       "log_body",
+								AttributeColumns: []string{"log_level"},
 							},
 						},
 					},
 				},
 			},
 		},
+		{
+			fname:        "config-invalid-tracking-start-value.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'query.tracking_start_value' requires 'query.tracking_column'",
+		},
+		{
+			fname:        "config-invalid-tracking-column-metrics.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'query.tracking_column' applies only to logs and requires 'query.logs'",
+		},
 		{
 			fname:        "config-logs-missing-body-column.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
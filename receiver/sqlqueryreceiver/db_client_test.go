@@ -150,9 +150,11 @@ type fakeDBClient struct {
 	requestCounter int
 	stringMaps     [][]stringMap
 	err            error
+	queryArgs      [][]any
 }
 
-func (c *fakeDBClient) queryRows(context.Context, ...any) ([]stringMap, error) {
+func (c *fakeDBClient) queryRows(_ context.Context, args ...any) ([]stringMap, error) {
+	c.queryArgs = append(c.queryArgs, args)
 	if c.err != nil {
 		return nil, c.err
 	}
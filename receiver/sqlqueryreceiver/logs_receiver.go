@@ -160,7 +160,15 @@ func (receiver *logsReceiver) collect() {
 		err := receiver.nextConsumer.ConsumeLogs(context.Background(), allLogs)
 		receiver.obsrecv.EndLogsOp(ctx, metadata.Type, logRecordCount, err)
 		if err != nil {
+			// The tracking values are not committed, so the same rows are queried again on the next collection.
 			receiver.settings.Logger.Error("failed to send logs: %w", zap.Error(err))
+			return
+		}
+	}
+
+	for _, queryReceiver := range receiver.queryReceivers {
+		if err := queryReceiver.commitTrackingValue(context.Background()); err != nil {
+			receiver.settings.Logger.Error("failed to store tracking value", zap.Error(err), zap.String("query", queryReceiver.ID()))
 		}
 	}
 }
@@ -205,6 +213,10 @@ type logsQueryReceiver struct {
 	db            *sql.DB
 	client        dbClient
 	trackingValue string
+	// pendingTrackingValue is the tracking value of the last collected row, committed once the logs
+	// of the collection have been accepted by the next consumer.
+	pendingTrackingValue    string
+	hasPendingTrackingValue bool
 	// TODO: Extract persistence into its own component
 	storageClient           storage.Client
 	trackingValueStorageKey string
@@ -267,6 +279,7 @@ func (queryReceiver *logsQueryReceiver) retrieveTrackingValue(ctx context.Contex
 
 func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs, error) {
 	logs := plog.NewLogs()
+	queryReceiver.hasPendingTrackingValue = false
 
 	var rows []stringMap
 	var err error
@@ -281,33 +294,57 @@ func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs,
 
 	var errs error
 	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
-	for logsConfigIndex, logsConfig := range queryReceiver.query.Logs {
+	for _, logsConfig := range queryReceiver.query.Logs {
 		for _, row := range rows {
-			rowToLog(row, logsConfig, scopeLogs.AppendEmpty())
-			if logsConfigIndex == 0 {
-				errs = multierr.Append(errs, queryReceiver.storeTrackingValue(ctx, row))
+			logRecord := plog.NewLogRecord()
+			if err := rowToLog(row, logsConfig, logRecord); err != nil {
+				errs = multierr.Append(errs, err)
+				continue
 			}
+			logRecord.MoveTo(scopeLogs.AppendEmpty())
+		}
+	}
+
+	if queryReceiver.query.TrackingColumn != "" && len(rows) > 0 {
+		trackingValue, found := rows[len(rows)-1][queryReceiver.query.TrackingColumn]
+		if !found {
+			return logs, multierr.Append(errs, fmt.Errorf("tracking_column '%s' not found in result set", queryReceiver.query.TrackingColumn))
 		}
+		queryReceiver.pendingTrackingValue = trackingValue
+		queryReceiver.hasPendingTrackingValue = true
 	}
-	return logs, nil
+	return logs, errs
 }
 
-func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context, row stringMap) error {
-	if queryReceiver.query.TrackingColumn == "" {
+// commitTrackingValue makes the tracking value of the last collection the value of the query parameter
+// of the next query runs, and persists it if storage is configured.
+func (queryReceiver *logsQueryReceiver) commitTrackingValue(ctx context.Context) error {
+	if !queryReceiver.hasPendingTrackingValue {
 		return nil
 	}
-	queryReceiver.trackingValue = row[queryReceiver.query.TrackingColumn]
+	queryReceiver.trackingValue = queryReceiver.pendingTrackingValue
+	queryReceiver.hasPendingTrackingValue = false
 	if queryReceiver.storageClient != nil {
-		err := queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, []byte(queryReceiver.trackingValue))
-		if err != nil {
-			return err
-		}
+		return queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, []byte(queryReceiver.trackingValue))
 	}
 	return nil
 }
 
-func rowToLog(row stringMap, config LogsCfg, logRecord plog.LogRecord) {
-	logRecord.Body().SetStr(row[config.BodyColumn])
+func rowToLog(row stringMap, config LogsCfg, logRecord plog.LogRecord) error {
+	body, found := row[config.BodyColumn]
+	if !found {
+		return fmt.Errorf("rowToLog: body_column '%s' not found in result set", config.BodyColumn)
+	}
+	logRecord.Body().SetStr(body)
+	attrs := logRecord.Attributes()
+	for _, columnName := range config.AttributeColumns {
+		attrVal, found := row[columnName]
+		if !found {
+			return fmt.Errorf("rowToLog: attribute_column '%s' not found in result set", columnName)
+		}
+		attrs.PutStr(columnName, attrVal)
+	}
+	return nil
 }
 
 func (queryReceiver *logsQueryReceiver) shutdown(_ context.Context) {
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver/internal/metadata"
+)
+
+func newTestLogsQueryReceiver(client *fakeDBClient, query Query, storageClient *storagetest.TestClient) *logsQueryReceiver {
+	queryReceiver := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), nil)
+	if storageClient != nil {
+		queryReceiver.storageClient = storageClient
+	}
+	queryReceiver.client = client
+	return queryReceiver
+}
+
+func TestLogsQueryReceiver_Collect(t *testing.T) {
+	client := &fakeDBClient{stringMaps: [][]stringMap{{
+		{"id": "1", "body": "first", "level": "info"},
+		{"id": "2", "body": "second", "level": "error"},
+	}}}
+	queryReceiver := newTestLogsQueryReceiver(client, Query{
+		SQL:  "select * from logs",
+		Logs: []LogsCfg{{BodyColumn: "body", AttributeColumns: []string{"level"}}},
+	}, nil)
+
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+
+	records := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	assert.Equal(t, "first", records.At(0).Body().Str())
+	assert.Equal(t, map[string]any{"level": "info"}, records.At(0).Attributes().AsRaw())
+	assert.Equal(t, "second", records.At(1).Body().Str())
+	assert.Equal(t, map[string]any{"level": "error"}, records.At(1).Attributes().AsRaw())
+}
+
+func TestLogsQueryReceiver_CollectMissingColumns(t *testing.T) {
+	client := &fakeDBClient{stringMaps: [][]stringMap{{
+		{"id": "1", "body": "first"},
+		{"id": "2", "message": "second"},
+	}}}
+	queryReceiver := newTestLogsQueryReceiver(client, Query{
+		SQL:            "select * from logs where id > ?",
+		TrackingColumn: "log_id",
+		Logs:           []LogsCfg{{BodyColumn: "body"}},
+	}, nil)
+
+	logs, err := queryReceiver.collect(context.Background())
+	assert.ErrorContains(t, err, "body_column 'body' not found in result set")
+	assert.ErrorContains(t, err, "tracking_column 'log_id' not found in result set")
+	assert.Equal(t, 1, logs.LogRecordCount(), "the rows with the body column must still be emitted")
+	assert.False(t, queryReceiver.hasPendingTrackingValue)
+}
+
+func TestLogsQueryReceiver_TrackingValue(t *testing.T) {
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.NewID(metadata.Type), "")
+	client := &fakeDBClient{stringMaps: [][]stringMap{
+		{{"id": "11", "body": "first"}, {"id": "12", "body": "second"}},
+		{{"id": "12", "body": "second"}},
+		{},
+	}}
+	queryReceiver := newTestLogsQueryReceiver(client, Query{
+		SQL:                "select * from logs where id > ?",
+		TrackingColumn:     "id",
+		TrackingStartValue: "10",
+		Logs:               []LogsCfg{{BodyColumn: "body"}},
+	}, storageClient)
+	ctx := context.Background()
+
+	// the tracking value is not used before it is committed
+	_, err := queryReceiver.collect(ctx)
+	require.NoError(t, err)
+	_, err = queryReceiver.collect(ctx)
+	require.NoError(t, err)
+	require.NoError(t, queryReceiver.commitTrackingValue(ctx))
+	assert.Equal(t, [][]any{{"10"}, {"10"}}, client.queryArgs)
+
+	stored, err := storageClient.Get(ctx, queryReceiver.trackingValueStorageKey)
+	require.NoError(t, err)
+	assert.Equal(t, "12", string(stored))
+
+	// an empty result keeps the tracking value
+	_, err = queryReceiver.collect(ctx)
+	require.NoError(t, err)
+	require.NoError(t, queryReceiver.commitTrackingValue(ctx))
+	assert.Equal(t, []any{"12"}, client.queryArgs[2])
+	assert.Equal(t, "12", queryReceiver.trackingValue)
+
+	// a new receiver starts from the stored tracking value
+	restarted := newTestLogsQueryReceiver(client, queryReceiver.query, storageClient)
+	assert.Equal(t, "12", restarted.retrieveTrackingValue(ctx))
+}
+
+func TestLogsReceiver_CommitsTrackingValueOnlyWhenConsumed(t *testing.T) {
+	client := &fakeDBClient{stringMaps: [][]stringMap{
+		{{"id": "1", "body": "first"}},
+		{{"id": "1", "body": "first"}},
+	}}
+	query := Query{
+		SQL:                "select * from logs where id > ?",
+		TrackingColumn:     "id",
+		TrackingStartValue: "0",
+		Logs:               []LogsCfg{{BodyColumn: "body"}},
+	}
+	sink := &consumertest.LogsSink{}
+	consumeErr := errors.New("consumer failed")
+	failing := true
+	nextConsumer, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		if failing {
+			return consumeErr
+		}
+		return sink.ConsumeLogs(ctx, ld)
+	})
+	require.NoError(t, err)
+
+	receiver, err := newLogsReceiver(&Config{Queries: []Query{query}}, receivertest.NewNopCreateSettings(), nil, nil, nextConsumer)
+	require.NoError(t, err)
+	queryReceiver := newTestLogsQueryReceiver(client, query, nil)
+	receiver.queryReceivers = []*logsQueryReceiver{queryReceiver}
+
+	receiver.collect()
+	assert.Equal(t, "0", queryReceiver.trackingValue)
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	failing = false
+	receiver.collect()
+	assert.Equal(t, "1", queryReceiver.trackingValue)
+	assert.Equal(t, 1, sink.LogRecordCount())
+	assert.Equal(t, [][]any{{"0"}, {"0"}}, client.queryArgs)
+}
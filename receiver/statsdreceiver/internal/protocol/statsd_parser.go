@@ -33,16 +33,22 @@ type (
 const (
 	tagMetricType = "metric_type"
 
-	CounterType   MetricType = "c"
-	GaugeType     MetricType = "g"
-	HistogramType MetricType = "h"
-	TimingType    MetricType = "ms"
-
-	CounterTypeName   TypeName = "counter"
-	GaugeTypeName     TypeName = "gauge"
-	HistogramTypeName TypeName = "histogram"
-	TimingTypeName    TypeName = "timing"
-	TimingAltTypeName TypeName = "timer"
+	// containerIDAttribute is the resource attribute of the metrics sent with the
+	// DogStatsD container ID extension.
+	containerIDAttribute = "container.id"
+
+	CounterType      MetricType = "c"
+	GaugeType        MetricType = "g"
+	HistogramType    MetricType = "h"
+	TimingType       MetricType = "ms"
+	DistributionType MetricType = "d"
+
+	CounterTypeName      TypeName = "counter"
+	GaugeTypeName        TypeName = "gauge"
+	HistogramTypeName    TypeName = "histogram"
+	TimingTypeName       TypeName = "timing"
+	TimingAltTypeName    TypeName = "timer"
+	DistributionTypeName TypeName = "distribution"
 
 	GaugeObserver     ObserverType = "gauge"
 	SummaryObserver   ObserverType = "summary"
@@ -73,19 +79,35 @@ var defaultObserverCategory = ObserverCategory{
 	method: DefaultObserverType,
 }
 
+// defaultDistributionObserverCategory aggregates the DogStatsD distributions, which are
+// meant to be aggregated by the server, when they have no mapping.
+var defaultDistributionObserverCategory = ObserverCategory{
+	method:          HistogramObserver,
+	histogramConfig: expoHistogramConfig(HistogramConfig{}),
+}
+
 // StatsDParser supports the Parse method for parsing StatsD messages with Tags.
 type StatsDParser struct {
-	instrumentsByAddress map[netAddr]*instruments
+	instrumentsByAddress map[instrumentsKey]*instruments
 	enableMetricType     bool
 	isMonotonicCounter   bool
 	timerEvents          ObserverCategory
 	histogramEvents      ObserverCategory
+	distributionEvents   ObserverCategory
 	lastIntervalTime     time.Time
 	BuildInfo            component.BuildInfo
 }
 
+// instrumentsKey identifies the instruments of the metrics sent from an address, and
+// from a container with the DogStatsD container ID extension.
+type instrumentsKey struct {
+	addr        netAddr
+	containerID string
+}
+
 type instruments struct {
 	addr                   net.Addr
+	containerID            string
 	gauges                 map[statsDMetricDescription]pmetric.ScopeMetrics
 	counters               map[statsDMetricDescription]pmetric.ScopeMetrics
 	summaries              map[statsDMetricDescription]summaryMetric
@@ -93,13 +115,14 @@ type instruments struct {
 	timersAndDistributions []pmetric.ScopeMetrics
 }
 
-func newInstruments(addr net.Addr) *instruments {
+func newInstruments(addr net.Addr, containerID string) *instruments {
 	return &instruments{
-		addr:       addr,
-		gauges:     make(map[statsDMetricDescription]pmetric.ScopeMetrics),
-		counters:   make(map[statsDMetricDescription]pmetric.ScopeMetrics),
-		summaries:  make(map[statsDMetricDescription]summaryMetric),
-		histograms: make(map[statsDMetricDescription]histogramMetric),
+		addr:        addr,
+		containerID: containerID,
+		gauges:      make(map[statsDMetricDescription]pmetric.ScopeMetrics),
+		counters:    make(map[statsDMetricDescription]pmetric.ScopeMetrics),
+		summaries:   make(map[statsDMetricDescription]summaryMetric),
+		histograms:  make(map[statsDMetricDescription]histogramMetric),
 	}
 }
 
@@ -125,6 +148,7 @@ type statsDMetric struct {
 	addition    bool
 	unit        string
 	sampleRate  float64
+	containerID string
 }
 
 type statsDMetricDescription struct {
@@ -143,13 +167,15 @@ func (t MetricType) FullName() TypeName {
 		return TimingTypeName
 	case HistogramType:
 		return HistogramTypeName
+	case DistributionType:
+		return DistributionTypeName
 	}
 	return TypeName(fmt.Sprintf("unknown(%s)", t))
 }
 
 func (p *StatsDParser) resetState(when time.Time) {
 	p.lastIntervalTime = when
-	p.instrumentsByAddress = make(map[netAddr]*instruments)
+	p.instrumentsByAddress = make(map[instrumentsKey]*instruments)
 }
 
 func (p *StatsDParser) Initialize(enableMetricType bool, isMonotonicCounter bool, sendTimerHistogram []TimerHistogramMapping) error {
@@ -157,6 +183,7 @@ func (p *StatsDParser) Initialize(enableMetricType bool, isMonotonicCounter bool
 
 	p.histogramEvents = defaultObserverCategory
 	p.timerEvents = defaultObserverCategory
+	p.distributionEvents = defaultDistributionObserverCategory
 	p.enableMetricType = enableMetricType
 	p.isMonotonicCounter = isMonotonicCounter
 	// Note: validation occurs in ("../".Config).validate()
@@ -168,6 +195,9 @@ func (p *StatsDParser) Initialize(enableMetricType bool, isMonotonicCounter bool
 		case TimingTypeName, TimingAltTypeName:
 			p.timerEvents.method = eachMap.ObserverType
 			p.timerEvents.histogramConfig = expoHistogramConfig(eachMap.Histogram)
+		case DistributionTypeName:
+			p.distributionEvents.method = eachMap.ObserverType
+			p.distributionEvents.histogramConfig = expoHistogramConfig(eachMap.Histogram)
 		case CounterTypeName, GaugeTypeName:
 		}
 	}
@@ -194,6 +224,9 @@ func (p *StatsDParser) GetMetrics() []BatchMetrics {
 			Metrics: pmetric.NewMetrics(),
 		}
 		rm := batch.Metrics.ResourceMetrics().AppendEmpty()
+		if instrument.containerID != "" {
+			rm.Resource().Attributes().PutStr(containerIDAttribute, instrument.containerID)
+		}
 		for _, metric := range instrument.gauges {
 			p.copyMetricAndScope(rm, metric)
 		}
@@ -259,6 +292,8 @@ func (p *StatsDParser) observerCategoryFor(t MetricType) ObserverCategory {
 		return p.histogramEvents
 	case TimingType:
 		return p.timerEvents
+	case DistributionType:
+		return p.distributionEvents
 	case CounterType, GaugeType:
 	}
 	return defaultObserverCategory
@@ -271,11 +306,11 @@ func (p *StatsDParser) Aggregate(line string, addr net.Addr) error {
 		return err
 	}
 
-	addrKey := newNetAddr(addr)
-	instrument, ok := p.instrumentsByAddress[addrKey]
+	key := instrumentsKey{addr: newNetAddr(addr), containerID: parsedMetric.containerID}
+	instrument, ok := p.instrumentsByAddress[key]
 	if !ok {
-		instrument = newInstruments(addr)
-		p.instrumentsByAddress[addrKey] = instrument
+		instrument = newInstruments(addr, parsedMetric.containerID)
+		p.instrumentsByAddress[key] = instrument
 	}
 
 	switch parsedMetric.description.metricType {
@@ -301,7 +336,7 @@ func (p *StatsDParser) Aggregate(line string, addr net.Addr) error {
 			point.SetIntValue(point.IntValue() + parsedMetric.counterValue())
 		}
 
-	case TimingType, HistogramType:
+	case TimingType, HistogramType, DistributionType:
 		category := p.observerCategoryFor(parsedMetric.description.metricType)
 		switch category.method {
 		case GaugeObserver:
@@ -372,7 +407,7 @@ func parseMessageToMetric(line string, enableMetricType bool) (statsDMetric, err
 
 	inType := MetricType(parts[1])
 	switch inType {
-	case CounterType, GaugeType, HistogramType, TimingType:
+	case CounterType, GaugeType, HistogramType, TimingType, DistributionType:
 		result.description.metricType = inType
 	default:
 		return result, fmt.Errorf("unsupported metric type: %s", inType)
@@ -407,6 +442,9 @@ func parseMessageToMetric(line string, enableMetricType bool) (statsDMetric, err
 				v := tagParts[1]
 				kvs = append(kvs, attribute.String(k, v))
 			}
+		case strings.HasPrefix(part, "c:"):
+			// The DogStatsD container ID extension
+			result.containerID = strings.TrimPrefix(part, "c:")
 		default:
 			return result, fmt.Errorf("unrecognized message part: %s", part)
 		}
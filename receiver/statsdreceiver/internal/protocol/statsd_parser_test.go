@@ -222,6 +222,34 @@ func Test_ParseMessageToMetric(t *testing.T) {
 				false,
 				"h", 0, nil, nil),
 		},
+		{
+			name:  "distribution",
+			input: "test.metric:42.5|d|@0.5|#key:value",
+			wantMetric: testStatsDMetric(
+				"test.metric",
+				42.5,
+				false,
+				"d",
+				0.5,
+				[]string{"key"},
+				[]string{"value"}),
+		},
+		{
+			name:  "container id",
+			input: "test.metric:42|c|#key:value|c:8af1a9b2c3",
+			wantMetric: func() statsDMetric {
+				m := testStatsDMetric(
+					"test.metric",
+					42,
+					false,
+					"c",
+					0,
+					[]string{"key"},
+					[]string{"value"})
+				m.containerID = "8af1a9b2c3"
+				return m
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -656,7 +684,7 @@ func TestStatsDParser_Aggregate(t *testing.T) {
 			assert.NoError(t, p.Initialize(false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-			addrKey := newNetAddr(addr)
+			addrKey := instrumentsKey{addr: newNetAddr(addr)}
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
 			}
@@ -730,7 +758,7 @@ func TestStatsDParser_AggregateByAddress(t *testing.T) {
 				}
 			}
 			for i, addr := range tt.addresses {
-				addrKey := newNetAddr(addr)
+				addrKey := instrumentsKey{addr: newNetAddr(addr)}
 				assert.Equal(t, tt.expectedGauges[i], p.instrumentsByAddress[addrKey].gauges)
 			}
 		})
@@ -793,7 +821,7 @@ func TestStatsDParser_AggregateWithMetricType(t *testing.T) {
 			assert.NoError(t, p.Initialize(true, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-			addrKey := newNetAddr(addr)
+			addrKey := instrumentsKey{addr: newNetAddr(addr)}
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
 			}
@@ -843,7 +871,7 @@ func TestStatsDParser_AggregateWithIsMonotonicCounter(t *testing.T) {
 			assert.NoError(t, p.Initialize(false, true, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-			addrKey := newNetAddr(addr)
+			addrKey := instrumentsKey{addr: newNetAddr(addr)}
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
 			}
@@ -940,7 +968,7 @@ func TestStatsDParser_AggregateTimerWithSummary(t *testing.T) {
 			p := &StatsDParser{}
 			assert.NoError(t, p.Initialize(false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "summary"}, {StatsdType: "histogram", ObserverType: "summary"}}))
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-			addrKey := newNetAddr(addr)
+			addrKey := instrumentsKey{addr: newNetAddr(addr)}
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
 			}
@@ -962,8 +990,8 @@ func TestStatsDParser_Initialize(t *testing.T) {
 		attrs:      *attribute.EmptySet(),
 	}
 	addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-	addrKey := newNetAddr(addr)
-	instrument := newInstruments(addr)
+	addrKey := instrumentsKey{addr: newNetAddr(addr)}
+	instrument := newInstruments(addr, "")
 	instrument.gauges[teststatsdDMetricdescription] = pmetric.ScopeMetrics{}
 	p.instrumentsByAddress[addrKey] = instrument
 	assert.Equal(t, 1, len(p.instrumentsByAddress))
@@ -975,7 +1003,7 @@ func TestStatsDParser_Initialize(t *testing.T) {
 func TestStatsDParser_GetMetricsWithMetricType(t *testing.T) {
 	p := &StatsDParser{}
 	assert.NoError(t, p.Initialize(true, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
-	instrument := newInstruments(nil)
+	instrument := newInstruments(nil, "")
 	instrument.gauges[testDescription("statsdTestMetric1", "g",
 		[]string{"mykey", "metric_type"}, []string{"myvalue", "gauge"})] = buildGaugeMetric(testStatsDMetric("testGauge1", 1, false, "g", 0, []string{"mykey", "metric_type"}, []string{"myvalue", "gauge"}), time.Unix(711, 0))
 	instrument.gauges[testDescription("statsdTestMetric1", "g",
@@ -990,7 +1018,7 @@ func TestStatsDParser_GetMetricsWithMetricType(t *testing.T) {
 			weights: []float64{1, 1, 1, 1},
 		},
 	}
-	p.instrumentsByAddress[netAddr{}] = instrument
+	p.instrumentsByAddress[instrumentsKey{}] = instrument
 	metrics := p.GetMetrics()[0].Metrics
 	assert.Equal(t, 5, metrics.ResourceMetrics().At(0).ScopeMetrics().Len())
 }
@@ -1010,8 +1038,9 @@ func TestStatsDParser_Mappings(t *testing.T) {
 				{StatsdType: "histogram", ObserverType: "summary"},
 			},
 			expect: map[string]string{
-				"Summary": "H",
-				"Gauge":   "T",
+				"Summary":              "H",
+				"Gauge":                "T",
+				"ExponentialHistogram": "D",
 			},
 		},
 		{
@@ -1020,7 +1049,8 @@ func TestStatsDParser_Mappings(t *testing.T) {
 				{StatsdType: "histogram", ObserverType: "summary"},
 			},
 			expect: map[string]string{
-				"Summary": "H",
+				"Summary":              "H",
+				"ExponentialHistogram": "D",
 			},
 		},
 		{
@@ -1030,8 +1060,9 @@ func TestStatsDParser_Mappings(t *testing.T) {
 				{StatsdType: "histogram", ObserverType: "gauge"},
 			},
 			expect: map[string]string{
-				"Summary": "T",
-				"Gauge":   "H",
+				"Summary":              "T",
+				"Gauge":                "H",
+				"ExponentialHistogram": "D",
 			},
 		},
 		{
@@ -1040,7 +1071,17 @@ func TestStatsDParser_Mappings(t *testing.T) {
 				{StatsdType: "timer", ObserverType: "gauge"},
 			},
 			expect: map[string]string{
-				"Gauge": "T",
+				"Gauge":                "T",
+				"ExponentialHistogram": "D",
+			},
+		},
+		{
+			name: "distribution-to-summary",
+			mapping: []TimerHistogramMapping{
+				{StatsdType: "distribution", ObserverType: "summary"},
+			},
+			expect: map[string]string{
+				"Summary": "D",
 			},
 		},
 	} {
@@ -1052,6 +1093,7 @@ func TestStatsDParser_Mappings(t *testing.T) {
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 			assert.NoError(t, p.Aggregate("H:10|h", addr))
 			assert.NoError(t, p.Aggregate("T:10|ms", addr))
+			assert.NoError(t, p.Aggregate("D:10|d", addr))
 
 			typeNames := map[string]string{}
 
@@ -1070,6 +1112,28 @@ func TestStatsDParser_Mappings(t *testing.T) {
 	}
 }
 
+func TestStatsDParser_AggregateByContainerID(t *testing.T) {
+	p := &StatsDParser{}
+	assert.NoError(t, p.Initialize(false, false, []TimerHistogramMapping{}))
+	addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+	assert.NoError(t, p.Aggregate("test.metric:1|c|c:container1", addr))
+	assert.NoError(t, p.Aggregate("test.metric:2|c|c:container1", addr))
+	assert.NoError(t, p.Aggregate("test.metric:3|c|c:container2", addr))
+	assert.NoError(t, p.Aggregate("test.metric:4|c", addr))
+
+	counts := map[string]int64{}
+	for _, batch := range p.GetMetrics() {
+		rm := batch.Metrics.ResourceMetrics().At(0)
+		containerID := ""
+		if v, ok := rm.Resource().Attributes().Get("container.id"); ok {
+			containerID = v.Str()
+		}
+		counts[containerID] = rm.ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue()
+		assert.Equal(t, addr, batch.Info.Addr)
+	}
+	assert.Equal(t, map[string]int64{"container1": 3, "container2": 3, "": 4}, counts)
+}
+
 func TestStatsDParser_ScopeIsIncluded(t *testing.T) {
 
 	const devVersion = "dev-0.0.1"
@@ -4,6 +4,7 @@
 package tcplogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tcplogreceiver"
 
 import (
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver"
 
@@ -15,6 +16,7 @@ import (
 
 // NewFactory creates a factory for tcp receiver
 func NewFactory() receiver.Factory {
+	_ = view.Register(tcp.MetricViews()...)
 	return adapter.NewFactory(ReceiverType{}, metadata.LogsStability)
 }
 
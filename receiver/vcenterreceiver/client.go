@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/performance"
@@ -116,6 +118,40 @@ func (vc *vcenterClient) VMs(ctx context.Context) ([]*object.VirtualMachine, err
 	return vms, err
 }
 
+// eventsPageSize is the number of events read at once, the most vCenter returns
+const eventsPageSize = 1000
+
+// Events returns the events created since the time, restricted to the event types if any.
+// The events are read page by page through an event history collector, oldest first.
+func (vc *vcenterClient) Events(ctx context.Context, since time.Time, eventTypes []string) ([]vt.BaseEvent, error) {
+	filter := vt.EventFilterSpec{
+		Time:        &vt.EventFilterSpecByTime{BeginTime: &since},
+		EventTypeId: eventTypes,
+	}
+	collector, err := event.NewManager(vc.vimDriver).CreateCollectorForEvents(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create event collector: %w", err)
+	}
+	defer func() {
+		_ = collector.Destroy(ctx)
+	}()
+	if err = collector.Rewind(ctx); err != nil {
+		return nil, fmt.Errorf("unable to rewind event collector: %w", err)
+	}
+
+	var events []vt.BaseEvent
+	for {
+		page, err := collector.ReadNextEvents(ctx, eventsPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve events: %w", err)
+		}
+		if len(page) == 0 {
+			return events, nil
+		}
+		events = append(events, page...)
+	}
+}
+
 type perfSampleResult struct {
 	counters map[string]*vt.PerfCounterInfo
 	results  []performance.EntityMetric
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -24,6 +25,16 @@ type Config struct {
 	Endpoint                                string              `mapstructure:"endpoint"`
 	Username                                string              `mapstructure:"username"`
 	Password                                configopaque.String `mapstructure:"password"`
+	Events                                  EventsConfig        `mapstructure:"events"`
+}
+
+// EventsConfig is the configuration of the collection of vCenter events and alarms as logs
+type EventsConfig struct {
+	// PollInterval is the interval between two queries of the events created in vCenter
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Types restricts the collected events to the event types, such as VmPoweredOnEvent or
+	// AlarmStatusChangedEvent. All the events are collected when empty.
+	Types []string `mapstructure:"types"`
 }
 
 // Validate checks to see if the supplied config will work for the receiver
@@ -51,6 +62,10 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("password not provided and is required"))
 	}
 
+	if c.Events.PollInterval <= 0 {
+		err = multierr.Append(err, errors.New("events poll_interval must be positive"))
+	}
+
 	if _, tlsErr := c.LoadTLSConfig(); err != nil {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
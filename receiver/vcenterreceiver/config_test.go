@@ -76,6 +76,16 @@ func TestConfigValidation(t *testing.T) {
 			},
 			expectedErr: errors.New("password not provided"),
 		},
+		{
+			desc: "no events poll interval",
+			cfg: Config{
+				Endpoint:                  "https://vcsa.some-host",
+				Username:                  "otelu",
+				Password:                  "otelp",
+				ScraperControllerSettings: scraperhelper.NewDefaultScraperControllerSettings(metadata.Type),
+			},
+			expectedErr: errors.New("events poll_interval must be positive"),
+		},
 	}
 
 	for _, tc := range cases {
@@ -106,6 +116,10 @@ func TestLoadConfig(t *testing.T) {
 	expected.MetricsBuilderConfig = metadata.DefaultMetricsBuilderConfig()
 	expected.MetricsBuilderConfig.Metrics.VcenterHostCPUUtilization.Enabled = false
 	expected.CollectionInterval = 5 * time.Minute
+	expected.Events = EventsConfig{
+		PollInterval: 30 * time.Second,
+		Types:        []string{"VmPoweredOnEvent", "AlarmStatusChangedEvent"},
+	}
 
 	if diff := cmp.Diff(expected, cfg, cmpopts.IgnoreUnexported(metadata.MetricConfig{})); diff != "" {
 		t.Errorf("Config mismatch (-expected +actual):\n%s", diff)
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+)
+
+const defaultEventsPollInterval = time.Minute
+
+var _ receiver.Logs = (*vcenterEventsReceiver)(nil)
+
+// vcenterEventsReceiver polls the events created in vCenter, including the
+// alarm status changes, and sends them as log records.
+type vcenterEventsReceiver struct {
+	client   *vcenterClient
+	config   *Config
+	consumer consumer.Logs
+	logger   *zap.Logger
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+
+	// since is the creation time of the newest event received, and lastKey its key.
+	// Events are queried from this time which is inclusive, lastKey is used to skip
+	// the events already received as event keys are increasing.
+	since   time.Time
+	lastKey int32
+}
+
+func newEventsReceiver(settings receiver.CreateSettings, config *Config, consumer consumer.Logs) *vcenterEventsReceiver {
+	return &vcenterEventsReceiver{
+		client:   newVcenterClient(config),
+		config:   config,
+		consumer: consumer,
+		logger:   settings.Logger,
+	}
+}
+
+func (r *vcenterEventsReceiver) Start(ctx context.Context, _ component.Host) error {
+	// don't fail to start if we cannot establish connection, just log an error
+	if err := r.client.EnsureConnection(ctx); err != nil {
+		r.logger.Error(fmt.Sprintf("unable to establish a connection to the vSphere SDK %s", err.Error()))
+	}
+	r.since = time.Now()
+
+	pollCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go r.startPolling(pollCtx)
+	return nil
+}
+
+func (r *vcenterEventsReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return r.client.Disconnect(ctx)
+}
+
+func (r *vcenterEventsReceiver) startPolling(ctx context.Context) {
+	defer r.wg.Done()
+	t := time.NewTicker(r.config.Events.PollInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if err := r.poll(ctx); err != nil {
+				r.logger.Error("error while polling for events", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *vcenterEventsReceiver) poll(ctx context.Context) error {
+	if err := r.client.EnsureConnection(ctx); err != nil {
+		return fmt.Errorf("unable to connect to vSphere SDK: %w", err)
+	}
+	events, err := r.client.Events(ctx, r.since, r.config.Events.Types)
+	if err != nil {
+		return err
+	}
+
+	logs := plog.NewLogs()
+	records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	since, lastKey := r.since, r.lastKey
+	for _, e := range events {
+		base := e.GetEvent()
+		if !base.CreatedTime.After(r.since) && base.Key <= r.lastKey {
+			continue
+		}
+		eventToLogRecord(e, observed, records.AppendEmpty())
+		if base.CreatedTime.After(since) {
+			since = base.CreatedTime
+		}
+		if base.Key > lastKey {
+			lastKey = base.Key
+		}
+	}
+	if records.Len() == 0 {
+		return nil
+	}
+	if err = r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		return err
+	}
+	r.since, r.lastKey = since, lastKey
+	return nil
+}
+
+// eventToLogRecord converts a vCenter event to a log record, whose attributes hold
+// the names of the inventory objects the event is about.
+func eventToLogRecord(e types.BaseEvent, observed pcommon.Timestamp, lr plog.LogRecord) {
+	base := e.GetEvent()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(base.CreatedTime))
+	lr.SetObservedTimestamp(observed)
+	lr.Body().SetStr(base.FullFormattedMessage)
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+
+	attrs := lr.Attributes()
+	attrs.PutStr("vcenter.event.type", eventType(e))
+	attrs.PutInt("vcenter.event.key", int64(base.Key))
+	attrs.PutInt("vcenter.event.chain_id", int64(base.ChainId))
+	putNonEmptyStr(attrs, "vcenter.event.user", base.UserName)
+	if base.Datacenter != nil {
+		putNonEmptyStr(attrs, "vcenter.datacenter.name", base.Datacenter.Name)
+	}
+	if base.ComputeResource != nil {
+		putNonEmptyStr(attrs, "vcenter.cluster.name", base.ComputeResource.Name)
+	}
+	if base.Host != nil {
+		putNonEmptyStr(attrs, "vcenter.host.name", base.Host.Name)
+	}
+	if base.Vm != nil {
+		putNonEmptyStr(attrs, "vcenter.vm.name", base.Vm.Name)
+	}
+	if base.Ds != nil {
+		putNonEmptyStr(attrs, "vcenter.datastore.name", base.Ds.Name)
+	}
+
+	switch ev := e.(type) {
+	case *types.EventEx:
+		lr.SetSeverityText(ev.Severity)
+		lr.SetSeverityNumber(eventSeverity(ev.Severity))
+	case *types.AlarmStatusChangedEvent:
+		putNonEmptyStr(attrs, "vcenter.alarm.entity", ev.Entity.Name)
+		putNonEmptyStr(attrs, "vcenter.alarm.status", ev.To)
+		lr.SetSeverityText(ev.To)
+		lr.SetSeverityNumber(alarmStatusSeverity(ev.To))
+	}
+	if ae, ok := e.(types.BaseAlarmEvent); ok {
+		putNonEmptyStr(attrs, "vcenter.alarm.name", ae.GetAlarmEvent().Alarm.Name)
+	}
+}
+
+// eventType returns the identifier of the type of the event, which is
+// the name of its class unless it is an extended event.
+func eventType(e types.BaseEvent) string {
+	switch ev := e.(type) {
+	case *types.EventEx:
+		return ev.EventTypeId
+	case *types.ExtendedEvent:
+		return ev.EventTypeId
+	}
+	t := reflect.TypeOf(e)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+func eventSeverity(severity string) plog.SeverityNumber {
+	switch types.EventEventSeverity(severity) {
+	case types.EventEventSeverityError:
+		return plog.SeverityNumberError
+	case types.EventEventSeverityWarning:
+		return plog.SeverityNumberWarn
+	default:
+		return plog.SeverityNumberInfo
+	}
+}
+
+func alarmStatusSeverity(status string) plog.SeverityNumber {
+	switch types.ManagedEntityStatus(status) {
+	case types.ManagedEntityStatusRed:
+		return plog.SeverityNumberError
+	case types.ManagedEntityStatusYellow:
+		return plog.SeverityNumberWarn
+	default:
+		return plog.SeverityNumberInfo
+	}
+}
+
+func putNonEmptyStr(attrs pcommon.Map, key, value string) {
+	if value != "" {
+		attrs.PutStr(key, value)
+	}
+}
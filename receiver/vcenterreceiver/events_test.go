@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenterreceiver // import github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	mock "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver/internal/mockserver"
+)
+
+func TestPollEvents(t *testing.T) {
+	ctx := context.Background()
+	mockServer := mock.MockServer(t, false)
+	defer mockServer.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = mockServer.URL
+	cfg.Username = mock.MockUsername
+	cfg.Password = mock.MockPassword
+
+	sink := &consumertest.LogsSink{}
+	r := newEventsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	r.since = time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, r.poll(ctx))
+
+	require.Equal(t, 3, sink.LogRecordCount())
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+
+	poweredOn := records.At(0)
+	require.Equal(t, time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC), poweredOn.Timestamp().AsTime())
+	require.Equal(t, "CentOS 7 on esxi-27971.cf5e88ac.australia-southeast1.gve.goog in Datacenter is powered on", poweredOn.Body().Str())
+	require.Equal(t, plog.SeverityNumberInfo, poweredOn.SeverityNumber())
+	require.Equal(t, map[string]any{
+		"vcenter.event.type":      "VmPoweredOnEvent",
+		"vcenter.event.key":       int64(2001),
+		"vcenter.event.chain_id":  int64(2000),
+		"vcenter.event.user":      `VSPHERE.LOCAL\Administrator`,
+		"vcenter.datacenter.name": "Datacenter",
+		"vcenter.cluster.name":    "Cluster",
+		"vcenter.host.name":       "esxi-27971.cf5e88ac.australia-southeast1.gve.goog",
+		"vcenter.vm.name":         "CentOS 7",
+	}, poweredOn.Attributes().AsRaw())
+
+	alarm := records.At(1)
+	require.Equal(t, plog.SeverityNumberError, alarm.SeverityNumber())
+	require.Equal(t, "red", alarm.SeverityText())
+	require.Equal(t, map[string]any{
+		"vcenter.event.type":      "AlarmStatusChangedEvent",
+		"vcenter.event.key":       int64(2002),
+		"vcenter.event.chain_id":  int64(2002),
+		"vcenter.datacenter.name": "Datacenter",
+		"vcenter.datastore.name":  "vsanDatastore",
+		"vcenter.alarm.name":      "Datastore usage on disk",
+		"vcenter.alarm.entity":    "vsanDatastore",
+		"vcenter.alarm.status":    "red",
+	}, alarm.Attributes().AsRaw())
+
+	ex := records.At(2)
+	require.Equal(t, plog.SeverityNumberError, ex.SeverityNumber())
+	eventType, _ := ex.Attributes().Get("vcenter.event.type")
+	require.Equal(t, "com.vmware.vc.HA.DasHostFailedEvent", eventType.Str())
+
+	// The events already received are skipped.
+	require.Equal(t, time.Date(2023, 8, 1, 10, 2, 0, 0, time.UTC), r.since)
+	require.NoError(t, r.poll(ctx))
+	require.Equal(t, 3, sink.LogRecordCount())
+
+	require.NoError(t, r.Shutdown(ctx))
+}
+
+func TestEventsReceiverStartShutdown(t *testing.T) {
+	mockServer := mock.MockServer(t, false)
+	defer mockServer.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = mockServer.URL
+	cfg.Username = mock.MockUsername
+	cfg.Password = mock.MockPassword
+
+	r := newEventsReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, r.Shutdown(context.Background()))
+}
@@ -23,6 +23,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -34,6 +35,9 @@ func createDefaultConfig() component.Config {
 		ScraperControllerSettings: cfg,
 		TLSClientSetting:          configtls.TLSClientSetting{},
 		MetricsBuilderConfig:      metadata.DefaultMetricsBuilderConfig(),
+		Events: EventsConfig{
+			PollInterval: defaultEventsPollInterval,
+		},
 	}
 }
 
@@ -68,3 +72,19 @@ func createMetricsReceiver(
 		scraperhelper.AddScraper(scraper),
 	)
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotVcenter
+	}
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	return newEventsReceiver(params, cfg, consumer), nil
+}
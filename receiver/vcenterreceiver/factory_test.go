@@ -13,6 +13,32 @@ import (
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
+func TestCreateLogsReceiver(t *testing.T) {
+	_, err := createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		createDefaultConfig(),
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+
+	_, err = createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		nil,
+		consumertest.NewNop(),
+	)
+	require.ErrorIs(t, err, errConfigNotVcenter)
+
+	_, err = createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		createDefaultConfig(),
+		nil,
+	)
+	require.ErrorIs(t, err, component.ErrNilNextConsumer)
+}
+
 func TestCreateMetricsReceiver(t *testing.T) {
 	testCases := []struct {
 		desc   string
@@ -51,6 +51,7 @@ type MetricsConfig struct {
 	VcenterResourcePoolMemoryUsage  MetricConfig `mapstructure:"vcenter.resource_pool.memory.usage"`
 	VcenterVMCPUUsage               MetricConfig `mapstructure:"vcenter.vm.cpu.usage"`
 	VcenterVMCPUUtilization         MetricConfig `mapstructure:"vcenter.vm.cpu.utilization"`
+	VcenterVMDatastoreLatencyAvg    MetricConfig `mapstructure:"vcenter.vm.datastore.latency.avg"`
 	VcenterVMDiskLatencyAvg         MetricConfig `mapstructure:"vcenter.vm.disk.latency.avg"`
 	VcenterVMDiskLatencyMax         MetricConfig `mapstructure:"vcenter.vm.disk.latency.max"`
 	VcenterVMDiskThroughput         MetricConfig `mapstructure:"vcenter.vm.disk.throughput"`
@@ -146,6 +147,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		VcenterVMCPUUtilization: MetricConfig{
 			Enabled: true,
 		},
+		VcenterVMDatastoreLatencyAvg: MetricConfig{
+			Enabled: false,
+		},
 		VcenterVMDiskLatencyAvg: MetricConfig{
 			Enabled: true,
 		},
@@ -52,6 +52,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterResourcePoolMemoryUsage:  MetricConfig{Enabled: true},
 					VcenterVMCPUUsage:               MetricConfig{Enabled: true},
 					VcenterVMCPUUtilization:         MetricConfig{Enabled: true},
+					VcenterVMDatastoreLatencyAvg:    MetricConfig{Enabled: true},
 					VcenterVMDiskLatencyAvg:         MetricConfig{Enabled: true},
 					VcenterVMDiskLatencyMax:         MetricConfig{Enabled: true},
 					VcenterVMDiskThroughput:         MetricConfig{Enabled: true},
@@ -106,6 +107,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterResourcePoolMemoryUsage:  MetricConfig{Enabled: false},
 					VcenterVMCPUUsage:               MetricConfig{Enabled: false},
 					VcenterVMCPUUtilization:         MetricConfig{Enabled: false},
+					VcenterVMDatastoreLatencyAvg:    MetricConfig{Enabled: false},
 					VcenterVMDiskLatencyAvg:         MetricConfig{Enabled: false},
 					VcenterVMDiskLatencyMax:         MetricConfig{Enabled: false},
 					VcenterVMDiskThroughput:         MetricConfig{Enabled: false},
@@ -1471,6 +1471,58 @@ func newMetricVcenterVMCPUUtilization(cfg MetricConfig) metricVcenterVMCPUUtiliz
 	return m
 }
 
+type metricVcenterVMDatastoreLatencyAvg struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.vm.datastore.latency.avg metric with initial data.
+func (m *metricVcenterVMDatastoreLatencyAvg) init() {
+	m.data.SetName("vcenter.vm.datastore.latency.avg")
+	m.data.SetDescription("The latency of operations of the virtual machine to a datastore.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricVcenterVMDatastoreLatencyAvg) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, diskDirectionAttributeValue string, datastoreIDAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", diskDirectionAttributeValue)
+	dp.Attributes().PutStr("datastore_id", datastoreIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterVMDatastoreLatencyAvg) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterVMDatastoreLatencyAvg) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterVMDatastoreLatencyAvg(cfg MetricConfig) metricVcenterVMDatastoreLatencyAvg {
+	m := metricVcenterVMDatastoreLatencyAvg{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricVcenterVMDiskLatencyAvg struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2169,6 +2221,7 @@ type MetricsBuilder struct {
 	metricVcenterResourcePoolMemoryUsage  metricVcenterResourcePoolMemoryUsage
 	metricVcenterVMCPUUsage               metricVcenterVMCPUUsage
 	metricVcenterVMCPUUtilization         metricVcenterVMCPUUtilization
+	metricVcenterVMDatastoreLatencyAvg    metricVcenterVMDatastoreLatencyAvg
 	metricVcenterVMDiskLatencyAvg         metricVcenterVMDiskLatencyAvg
 	metricVcenterVMDiskLatencyMax         metricVcenterVMDiskLatencyMax
 	metricVcenterVMDiskThroughput         metricVcenterVMDiskThroughput
@@ -2226,6 +2279,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricVcenterResourcePoolMemoryUsage:  newMetricVcenterResourcePoolMemoryUsage(mbc.Metrics.VcenterResourcePoolMemoryUsage),
 		metricVcenterVMCPUUsage:               newMetricVcenterVMCPUUsage(mbc.Metrics.VcenterVMCPUUsage),
 		metricVcenterVMCPUUtilization:         newMetricVcenterVMCPUUtilization(mbc.Metrics.VcenterVMCPUUtilization),
+		metricVcenterVMDatastoreLatencyAvg:    newMetricVcenterVMDatastoreLatencyAvg(mbc.Metrics.VcenterVMDatastoreLatencyAvg),
 		metricVcenterVMDiskLatencyAvg:         newMetricVcenterVMDiskLatencyAvg(mbc.Metrics.VcenterVMDiskLatencyAvg),
 		metricVcenterVMDiskLatencyMax:         newMetricVcenterVMDiskLatencyMax(mbc.Metrics.VcenterVMDiskLatencyMax),
 		metricVcenterVMDiskThroughput:         newMetricVcenterVMDiskThroughput(mbc.Metrics.VcenterVMDiskThroughput),
@@ -2326,6 +2380,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricVcenterResourcePoolMemoryUsage.emit(ils.Metrics())
 	mb.metricVcenterVMCPUUsage.emit(ils.Metrics())
 	mb.metricVcenterVMCPUUtilization.emit(ils.Metrics())
+	mb.metricVcenterVMDatastoreLatencyAvg.emit(ils.Metrics())
 	mb.metricVcenterVMDiskLatencyAvg.emit(ils.Metrics())
 	mb.metricVcenterVMDiskLatencyMax.emit(ils.Metrics())
 	mb.metricVcenterVMDiskThroughput.emit(ils.Metrics())
@@ -2489,6 +2544,11 @@ func (mb *MetricsBuilder) RecordVcenterVMCPUUtilizationDataPoint(ts pcommon.Time
 	mb.metricVcenterVMCPUUtilization.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordVcenterVMDatastoreLatencyAvgDataPoint adds a data point to vcenter.vm.datastore.latency.avg metric.
+func (mb *MetricsBuilder) RecordVcenterVMDatastoreLatencyAvgDataPoint(ts pcommon.Timestamp, val int64, diskDirectionAttributeValue AttributeDiskDirection, datastoreIDAttributeValue string) {
+	mb.metricVcenterVMDatastoreLatencyAvg.recordDataPoint(mb.startTime, ts, val, diskDirectionAttributeValue.String(), datastoreIDAttributeValue)
+}
+
 // RecordVcenterVMDiskLatencyAvgDataPoint adds a data point to vcenter.vm.disk.latency.avg metric.
 func (mb *MetricsBuilder) RecordVcenterVMDiskLatencyAvgDataPoint(ts pcommon.Timestamp, val int64, diskDirectionAttributeValue AttributeDiskDirection, diskTypeAttributeValue AttributeDiskType) {
 	mb.metricVcenterVMDiskLatencyAvg.recordDataPoint(mb.startTime, ts, val, diskDirectionAttributeValue.String(), diskTypeAttributeValue.String())
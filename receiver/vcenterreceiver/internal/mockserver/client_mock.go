@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 
 	xj "github.com/basgys/goxml2json"
@@ -33,8 +34,35 @@ type soapEnvelope struct {
 	Body map[string]interface{} `json:"Body"`
 }
 
+// eventPages are the responses of the successive ReadNextEvents calls on an event history collector
+var eventPages = []string{"events-page-1.xml", "events-page-2.xml"}
+
+// eventHistoryCollector tracks the page of events read next
+type eventHistoryCollector struct {
+	mu   sync.Mutex
+	page int
+}
+
+func (c *eventHistoryCollector) rewind() ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.page = 0
+	return loadResponse("rewind-collector.xml")
+}
+
+func (c *eventHistoryCollector) readNext() ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.page >= len(eventPages) {
+		return loadResponse("events-empty.xml")
+	}
+	c.page++
+	return loadResponse(eventPages[c.page-1])
+}
+
 // MockServer has access to recorded SOAP responses and will serve them over http based off the scraper's API calls
 func MockServer(t *testing.T, useTLS bool) *httptest.Server {
+	events := &eventHistoryCollector{}
 	handlerFunc := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// converting to JSON in order to iterate over map keys
 		jsonified, err := xj.Convert(r.Body)
@@ -50,7 +78,7 @@ func MockServer(t *testing.T, useTLS bool) *httptest.Server {
 		}
 		require.NotEmpty(t, requestType)
 
-		body, err := routeBody(t, requestType, sr.Envelope.Body)
+		body, err := routeBody(t, requestType, sr.Envelope.Body, events)
 		if errors.Is(err, errNotFound) {
 			w.WriteHeader(404)
 			return
@@ -67,7 +95,7 @@ func MockServer(t *testing.T, useTLS bool) *httptest.Server {
 	return httptest.NewServer(handlerFunc)
 }
 
-func routeBody(t *testing.T, requestType string, body map[string]interface{}) ([]byte, error) {
+func routeBody(t *testing.T, requestType string, body map[string]interface{}, events *eventHistoryCollector) ([]byte, error) {
 	switch requestType {
 	case "RetrieveServiceContent":
 		return loadResponse("service-content.xml")
@@ -79,6 +107,14 @@ func routeBody(t *testing.T, requestType string, body map[string]interface{}) ([
 		return routeRetreiveProperties(t, body)
 	case "QueryPerf":
 		return routePerformanceQuery(t, body)
+	case "CreateCollectorForEvents":
+		return loadResponse("event-collector.xml")
+	case "RewindCollector":
+		return events.rewind()
+	case "ReadNextEvents":
+		return events.readNext()
+	case "DestroyCollector":
+		return loadResponse("destroy-collector.xml")
 	}
 
 	return []byte{}, errNotFound
@@ -171,6 +171,10 @@ var vmPerfMetricList = []string{
 	"disk.maxTotalLatency.latest",
 	"virtualDisk.totalWriteLatency.average",
 	"virtualDisk.totalReadLatency.average",
+
+	// datastore metrics
+	"datastore.totalReadLatency.average",
+	"datastore.totalWriteLatency.average",
 }
 
 func (v *vcenterMetricScraper) recordVMPerformance(
@@ -225,6 +229,10 @@ func (v *vcenterMetricScraper) processVMPerformanceMetrics(info *perfSampleResul
 					v.mb.RecordVcenterVMDiskLatencyAvgDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), nestedValue, metadata.AttributeDiskDirectionWrite, metadata.AttributeDiskTypeVirtual)
 				case "disk.maxTotalLatency.latest":
 					v.mb.RecordVcenterVMDiskLatencyMaxDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), nestedValue)
+				case "datastore.totalReadLatency.average":
+					v.mb.RecordVcenterVMDatastoreLatencyAvgDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), nestedValue, metadata.AttributeDiskDirectionRead, val.Instance)
+				case "datastore.totalWriteLatency.average":
+					v.mb.RecordVcenterVMDatastoreLatencyAvgDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), nestedValue, metadata.AttributeDiskDirectionWrite, val.Instance)
 				}
 			}
 		}
@@ -51,6 +51,44 @@ func TestScrape_TLS(t *testing.T) {
 	testScrape(ctx, t, cfg)
 }
 
+func TestScrape_VMDatastoreLatency(t *testing.T) {
+	ctx := context.Background()
+	mockServer := mock.MockServer(t, false)
+	defer mockServer.Close()
+
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Endpoint:             mockServer.URL,
+		Username:             mock.MockUsername,
+		Password:             mock.MockPassword,
+	}
+	cfg.Metrics.VcenterVMDatastoreLatencyAvg.Enabled = true
+
+	scraper := newVmwareVcenterScraper(zap.NewNop(), cfg, receivertest.NewNopCreateSettings())
+	metrics, err := scraper.scrape(ctx)
+	require.NoError(t, err)
+
+	latencies := map[string]int64{}
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ms := rms.At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Name() != "vcenter.vm.datastore.latency.avg" {
+				continue
+			}
+			dps := ms.At(j).Gauge().DataPoints()
+			for k := 0; k < dps.Len(); k++ {
+				direction, _ := dps.At(k).Attributes().Get("direction")
+				datastore, _ := dps.At(k).Attributes().Get("datastore_id")
+				require.Equal(t, "52a9fa9bb23554ec-600746f1f7361622", datastore.Str())
+				latencies[direction.Str()] = dps.At(k).IntValue()
+			}
+		}
+	}
+	require.Equal(t, map[string]int64{"read": 2, "write": 5}, latencies)
+	require.NoError(t, scraper.Shutdown(ctx))
+}
+
 func testScrape(ctx context.Context, t *testing.T, cfg *Config) {
 	scraper := newVmwareVcenterScraper(zap.NewNop(), cfg, receivertest.NewNopCreateSettings())
 
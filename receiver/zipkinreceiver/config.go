@@ -15,6 +15,10 @@ type Config struct {
 	// If enabled the zipkin receiver will attempt to parse string tags/binary annotations into int/bool/float.
 	// Disabled by default
 	ParseStringTags bool `mapstructure:"parse_string_tags"`
+	// If enabled the server halves of the Zipkin v2 spans shared by the client and the server of an RPC
+	// get their own span ID, their parent being the client half, as OTLP spans don't share their IDs.
+	// Disabled by default
+	ReassembleSharedSpans bool `mapstructure:"reassemble_shared_spans"`
 }
 
 var _ component.Config = (*Config)(nil)
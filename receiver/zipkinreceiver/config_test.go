@@ -48,6 +48,15 @@ func TestLoadConfig(t *testing.T) {
 				ParseStringTags: true,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "reassemble_shared_spans"),
+			expected: &Config{
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: defaultBindEndpoint,
+				},
+				ReassembleSharedSpans: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"hash/fnv"
+
+	zipkinmodel "github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
+)
+
+// sharedSpansUnmarshaler unmarshals Zipkin v2 spans, reassembling the shared spans before
+// they are translated.
+type sharedSpansUnmarshaler struct {
+	decode       func([]byte) ([]*zipkinmodel.SpanModel, error)
+	toTranslator zipkinv2.ToTranslator
+}
+
+var _ ptrace.Unmarshaler = (*sharedSpansUnmarshaler)(nil)
+
+func newSharedSpansJSONUnmarshaler(parseStringTags bool) ptrace.Unmarshaler {
+	return sharedSpansUnmarshaler{
+		decode: func(buf []byte) ([]*zipkinmodel.SpanModel, error) {
+			var spans []*zipkinmodel.SpanModel
+			err := json.Unmarshal(buf, &spans)
+			return spans, err
+		},
+		toTranslator: zipkinv2.ToTranslator{ParseStringTags: parseStringTags},
+	}
+}
+
+func newSharedSpansProtobufUnmarshaler(debugWasSet, parseStringTags bool) ptrace.Unmarshaler {
+	return sharedSpansUnmarshaler{
+		decode: func(buf []byte) ([]*zipkinmodel.SpanModel, error) {
+			return zipkin_proto3.ParseSpans(buf, debugWasSet)
+		},
+		toTranslator: zipkinv2.ToTranslator{ParseStringTags: parseStringTags},
+	}
+}
+
+func (u sharedSpansUnmarshaler) UnmarshalTraces(buf []byte) (ptrace.Traces, error) {
+	spans, err := u.decode(buf)
+	if err != nil {
+		return ptrace.Traces{}, err
+	}
+	reassembleSharedSpans(spans)
+	return u.toTranslator.ToTraces(spans)
+}
+
+// sharedSpanKey identifies the server half of a shared span, the server side of an RPC
+// reporting the span ID of the client side.
+type sharedSpanKey struct {
+	traceID     zipkinmodel.TraceID
+	spanID      zipkinmodel.ID
+	serviceName string
+}
+
+// reassembleSharedSpans gives the server halves of the shared spans their own span ID, their
+// parent being the client half, as OTLP spans don't share their IDs. The children of the server
+// halves reported by the same service are reparented to the new span IDs, and the kinds of the
+// halves without kind are inferred.
+func reassembleSharedSpans(spans []*zipkinmodel.SpanModel) {
+	newIDs := make(map[sharedSpanKey]zipkinmodel.ID)
+	sharedIDs := make(map[sharedSpanKey]struct{})
+	for _, span := range spans {
+		if span == nil || !span.Shared {
+			continue
+		}
+		key := sharedSpanKey{traceID: span.TraceID, spanID: span.ID, serviceName: localServiceName(span)}
+		newID := sharedSpanID(key)
+		newIDs[key] = newID
+		sharedIDs[sharedSpanKey{traceID: span.TraceID, spanID: span.ID}] = struct{}{}
+
+		parentID := span.ID
+		span.ParentID = &parentID
+		span.ID = newID
+		span.Shared = false
+		if undeterminedKind(span.Kind) {
+			span.Kind = zipkinmodel.Server
+		}
+	}
+	if len(newIDs) == 0 {
+		return
+	}
+
+	for _, span := range spans {
+		if span == nil {
+			continue
+		}
+		// the client half keeps the shared span ID
+		if _, ok := sharedIDs[sharedSpanKey{traceID: span.TraceID, spanID: span.ID}]; ok && undeterminedKind(span.Kind) {
+			span.Kind = zipkinmodel.Client
+		}
+		if span.ParentID == nil {
+			continue
+		}
+		if newID, ok := newIDs[sharedSpanKey{traceID: span.TraceID, spanID: *span.ParentID, serviceName: localServiceName(span)}]; ok && newID != span.ID {
+			// the server half itself keeps the client half as parent
+			span.ParentID = &newID
+		}
+	}
+}
+
+// sharedSpanID derives the span ID of the server half of a shared span, the same for each
+// batch reporting it.
+func sharedSpanID(key sharedSpanKey) zipkinmodel.ID {
+	h := fnv.New64a()
+	var buf [24]byte
+	binary.BigEndian.PutUint64(buf[:8], key.traceID.High)
+	binary.BigEndian.PutUint64(buf[8:16], key.traceID.Low)
+	binary.BigEndian.PutUint64(buf[16:], uint64(key.spanID))
+	_, _ = h.Write(buf[:])
+	_, _ = h.Write([]byte(key.serviceName))
+	id := zipkinmodel.ID(h.Sum64())
+	if id == 0 || id == key.spanID {
+		id++
+	}
+	return id
+}
+
+func localServiceName(span *zipkinmodel.SpanModel) string {
+	if span.LocalEndpoint == nil {
+		return ""
+	}
+	return span.LocalEndpoint.ServiceName
+}
+
+// undeterminedKind returns whether the span has no kind, the kind of the protobuf spans without kind
+// being SPAN_KIND_UNSPECIFIED.
+func undeterminedKind(kind zipkinmodel.Kind) bool {
+	return kind == zipkinmodel.Undetermined || kind == zipkinmodel.Kind(zipkin_proto3.Span_SPAN_KIND_UNSPECIFIED.String())
+}
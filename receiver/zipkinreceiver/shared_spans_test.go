@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	zipkinmodel "github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+const sharedSpansJSON = `[
+  {"traceId": "4d1e00c0db9010db", "parentId": "86154a4ba6e91385", "id": "4d1e00c0db9010db", "name": "get /cart", "timestamp": 1472470996199000, "duration": 207000, "localEndpoint": {"serviceName": "frontend"}},
+  {"traceId": "4d1e00c0db9010db", "id": "4d1e00c0db9010db", "name": "get /cart", "timestamp": 1472470996250000, "duration": 150000, "shared": true, "localEndpoint": {"serviceName": "backend"}},
+  {"traceId": "4d1e00c0db9010db", "parentId": "4d1e00c0db9010db", "id": "5e2f11d1ec0a21ec", "name": "select", "kind": "CLIENT", "timestamp": 1472470996260000, "duration": 50000, "localEndpoint": {"serviceName": "backend"}}
+]`
+
+func TestReassembleSharedSpans(t *testing.T) {
+	clientID := zipkinmodel.ID(0x4d1e00c0db9010db)
+	grandparentID := zipkinmodel.ID(0x86154a4ba6e91385)
+	traceID := zipkinmodel.TraceID{Low: 0x4d1e00c0db9010db}
+	spans := []*zipkinmodel.SpanModel{
+		{
+			SpanContext:   zipkinmodel.SpanContext{TraceID: traceID, ID: clientID, ParentID: &grandparentID},
+			LocalEndpoint: &zipkinmodel.Endpoint{ServiceName: "frontend"},
+		},
+		{
+			SpanContext:   zipkinmodel.SpanContext{TraceID: traceID, ID: clientID},
+			Shared:        true,
+			LocalEndpoint: &zipkinmodel.Endpoint{ServiceName: "backend"},
+		},
+		{
+			SpanContext:   zipkinmodel.SpanContext{TraceID: traceID, ID: 2, ParentID: &clientID},
+			Kind:          zipkinmodel.Client,
+			LocalEndpoint: &zipkinmodel.Endpoint{ServiceName: "backend"},
+		},
+		{
+			SpanContext:   zipkinmodel.SpanContext{TraceID: traceID, ID: 3, ParentID: &clientID},
+			LocalEndpoint: &zipkinmodel.Endpoint{ServiceName: "frontend"},
+		},
+		nil,
+	}
+
+	reassembleSharedSpans(spans)
+
+	client, server, child, clientChild := spans[0], spans[1], spans[2], spans[3]
+	assert.Equal(t, clientID, client.ID)
+	assert.Equal(t, grandparentID, *client.ParentID)
+	assert.Equal(t, zipkinmodel.Client, client.Kind)
+
+	assert.NotEqual(t, clientID, server.ID)
+	assert.Equal(t, sharedSpanID(sharedSpanKey{traceID: traceID, spanID: clientID, serviceName: "backend"}), server.ID)
+	assert.Equal(t, clientID, *server.ParentID)
+	assert.Equal(t, zipkinmodel.Server, server.Kind)
+	assert.False(t, server.Shared)
+
+	assert.Equal(t, server.ID, *child.ParentID)
+	assert.Equal(t, zipkinmodel.Client, child.Kind)
+
+	// the children of the client half aren't reparented
+	assert.Equal(t, clientID, *clientChild.ParentID)
+	assert.Equal(t, zipkinmodel.Undetermined, clientChild.Kind)
+}
+
+func TestReassembleSharedSpansKeepsKinds(t *testing.T) {
+	traceID := zipkinmodel.TraceID{Low: 1}
+	spans := []*zipkinmodel.SpanModel{
+		{SpanContext: zipkinmodel.SpanContext{TraceID: traceID, ID: 1}, Kind: zipkinmodel.Producer},
+		{SpanContext: zipkinmodel.SpanContext{TraceID: traceID, ID: 1}, Kind: zipkinmodel.Consumer, Shared: true},
+	}
+
+	reassembleSharedSpans(spans)
+
+	assert.Equal(t, zipkinmodel.Producer, spans[0].Kind)
+	assert.Equal(t, zipkinmodel.Consumer, spans[1].Kind)
+	assert.Equal(t, zipkinmodel.ID(1), *spans[1].ParentID)
+}
+
+func TestReassembleSharedSpansWithoutSharedSpans(t *testing.T) {
+	parentID := zipkinmodel.ID(1)
+	spans := []*zipkinmodel.SpanModel{
+		{SpanContext: zipkinmodel.SpanContext{TraceID: zipkinmodel.TraceID{Low: 1}, ID: 2, ParentID: &parentID}},
+	}
+
+	reassembleSharedSpans(spans)
+
+	assert.Equal(t, zipkinmodel.ID(2), spans[0].ID)
+	assert.Equal(t, parentID, *spans[0].ParentID)
+	assert.Equal(t, zipkinmodel.Undetermined, spans[0].Kind)
+}
+
+func TestReceiverReassemblesSharedSpans(t *testing.T) {
+	var spans []*zipkinmodel.SpanModel
+	require.NoError(t, json.Unmarshal([]byte(sharedSpansJSON), &spans))
+	protoBlob, err := zipkin_proto3.SpanSerializer{}.Serialize(spans)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		blob        []byte
+		contentType string
+	}{
+		{
+			name:        "json",
+			blob:        []byte(sharedSpansJSON),
+			contentType: "application/json",
+		},
+		{
+			name:        "protobuf",
+			blob:        protoBlob,
+			contentType: "application/x-protobuf",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.ReassembleSharedSpans = true
+			zr, err := newReceiver(cfg, consumertest.NewNop(), receivertest.NewNopCreateSettings())
+			require.NoError(t, err)
+
+			hdr := http.Header{}
+			hdr.Set("Content-Type", tt.contentType)
+			td, err := zr.v2ToTraceSpans(tt.blob, hdr)
+			require.NoError(t, err)
+			require.Equal(t, 3, td.SpanCount())
+
+			byName := map[string]ptrace.Span{}
+			rss := td.ResourceSpans()
+			for i := 0; i < rss.Len(); i++ {
+				service, _ := rss.At(i).Resource().Attributes().Get("service.name")
+				span := rss.At(i).ScopeSpans().At(0).Spans()
+				for j := 0; j < span.Len(); j++ {
+					byName[service.Str()+" "+span.At(j).Name()] = span.At(j)
+				}
+			}
+			client, server, child := byName["frontend get /cart"], byName["backend get /cart"], byName["backend select"]
+			clientID := pcommon.SpanID([8]byte{0x4d, 0x1e, 0x00, 0xc0, 0xdb, 0x90, 0x10, 0xdb})
+
+			assert.Equal(t, clientID, client.SpanID())
+			assert.Equal(t, ptrace.SpanKindClient, client.Kind())
+			assert.NotEqual(t, clientID, server.SpanID())
+			assert.Equal(t, clientID, server.ParentSpanID())
+			assert.Equal(t, ptrace.SpanKindServer, server.Kind())
+			assert.Equal(t, server.SpanID(), child.ParentSpanID())
+		})
+	}
+}
+
+func TestReceiverDoesNotReassembleSharedSpansByDefault(t *testing.T) {
+	zr, err := newReceiver(createDefaultConfig().(*Config), consumertest.NewNop(), receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+
+	td, err := zr.v2ToTraceSpans([]byte(sharedSpansJSON), http.Header{})
+	require.NoError(t, err)
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		spans := rss.At(i).ScopeSpans().At(0).Spans()
+		for j := 0; j < spans.Len(); j++ {
+			if spans.At(j).Name() == "get /cart" {
+				assert.Equal(t, pcommon.SpanID([8]byte{0x4d, 0x1e, 0x00, 0xc0, 0xdb, 0x90, 0x10, 0xdb}), spans.At(j).SpanID())
+			}
+		}
+	}
+}
@@ -84,6 +84,11 @@ func newReceiver(config *Config, nextConsumer consumer.Traces, settings receiver
 		settings:                 settings,
 		obsrecvrs:                obsrecvrs,
 	}
+	if config.ReassembleSharedSpans {
+		zr.jsonUnmarshaler = newSharedSpansJSONUnmarshaler(config.ParseStringTags)
+		zr.protobufUnmarshaler = newSharedSpansProtobufUnmarshaler(false, config.ParseStringTags)
+		zr.protobufDebugUnmarshaler = newSharedSpansProtobufUnmarshaler(true, config.ParseStringTags)
+	}
 	return zr, nil
 }
 